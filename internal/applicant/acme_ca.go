@@ -22,6 +22,13 @@ var sslProviderUrls = map[string]string{
 	sslProviderGoogleTrustServices: gtsUrl,
 }
 
+// 各 ACME CA 对应的测试环境。
+// 未在此声明的 CA 不支持测试运行：ZeroSSL 未提供测试环境；Google Trust Services 的测试环境需另行申请 EAB 凭据，暂不支持。
+var sslProviderStagings = map[string]string{
+	sslProviderLetsEncrypt:        sslProviderLetsEncryptStaging,
+	sslProviderLetsEncryptStaging: sslProviderLetsEncryptStaging,
+}
+
 type acmeSSLProviderConfig struct {
 	Config   acmeSSLProviderConfigContent `json:"config"`
 	Provider string                       `json:"provider"`
@@ -0,0 +1,141 @@
+package applicant
+
+import (
+	"errors"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-acme/lego/v4/challenge"
+	"github.com/go-acme/lego/v4/challenge/dns01"
+	"golang.org/x/exp/slices"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+// 规范化待申请证书的域名列表，以便将其合并到同一个订单中。
+// 会移除空白项和重复项，并移除已被列表中的泛域名覆盖的子域名（如已包含 "*.example.com" 时的 "www.example.com"），以减少需要验证的授权数量。
+// 注意，泛域名不覆盖其主域名，因此 "example.com" 和 "*.example.com" 会同时保留。
+func normalizeDomains(domains []string) []string {
+	temp := make([]string, 0, len(domains))
+	for _, domain := range domains {
+		domain = strings.ToLower(strings.TrimSpace(domain))
+		if domain == "" || slices.Contains(temp, domain) {
+			continue
+		}
+
+		temp = append(temp, domain)
+	}
+
+	result := make([]string, 0, len(temp))
+	for _, domain := range temp {
+		covered := false
+		if !strings.HasPrefix(domain, "*.") {
+			for _, san := range temp {
+				if san != domain && certs.MatchHostname(san, domain) {
+					covered = true
+					break
+				}
+			}
+		}
+
+		if !covered {
+			result = append(result, domain)
+		}
+	}
+
+	return result
+}
+
+// 表示对同一 TXT 记录名去重的 DNS-01 质询提供者。
+// 当同一订单中包含主域名和泛域名（如 "example.com" 和 "*.example.com"）时，它们的质询记录均为 "_acme-challenge.example.com"。
+// 部分 DNS 提供商在清理时会按记录名删除所有记录值，导致另一个尚未完成验证的质询失败。
+// 此处对每个记录名进行引用计数，待该记录名下的全部质询完成后再统一清理。
+type dedupChallengeProvider struct {
+	provider challenge.Provider
+
+	mutex     sync.Mutex
+	refs      map[string]int
+	cleanups  map[string][]func() error
+	presented map[string]struct{}
+}
+
+var _ challenge.ProviderTimeout = (*dedupChallengeProvider)(nil)
+
+// 创建去重的 DNS-01 质询提供者。
+// 如果原提供者要求按顺序逐个验证（即实现了 Sequential 方法），则同一时刻只存在一条质询记录，无需去重，将直接返回原提供者。
+func newDedupChallengeProvider(provider challenge.Provider) challenge.Provider {
+	if _, ok := provider.(interface{ Sequential() time.Duration }); ok {
+		return provider
+	}
+
+	return &dedupChallengeProvider{
+		provider:  provider,
+		refs:      make(map[string]int),
+		cleanups:  make(map[string][]func() error),
+		presented: make(map[string]struct{}),
+	}
+}
+
+func (p *dedupChallengeProvider) Present(domain, token, keyAuth string) error {
+	fqdn := dns01.GetChallengeInfo(domain, keyAuth).EffectiveFQDN
+
+	if err := p.provider.Present(domain, token, keyAuth); err != nil {
+		return err
+	}
+
+	p.mutex.Lock()
+	p.refs[fqdn]++
+	p.presented[dedupChallengeKey(domain, token)] = struct{}{}
+	p.mutex.Unlock()
+
+	return nil
+}
+
+func (p *dedupChallengeProvider) CleanUp(domain, token, keyAuth string) error {
+	fqdn := dns01.GetChallengeInfo(domain, keyAuth).EffectiveFQDN
+
+	p.mutex.Lock()
+	// lego 对发布失败的质询同样会调用 CleanUp，此时该质询未计入引用计数，不应触发清理
+	key := dedupChallengeKey(domain, token)
+	if _, ok := p.presented[key]; !ok {
+		p.mutex.Unlock()
+		return nil
+	}
+	delete(p.presented, key)
+
+	p.cleanups[fqdn] = append(p.cleanups[fqdn], func() error {
+		return p.provider.CleanUp(domain, token, keyAuth)
+	})
+	if p.refs[fqdn] > 1 {
+		p.refs[fqdn]--
+		p.mutex.Unlock()
+		return nil
+	}
+
+	cleanups := p.cleanups[fqdn]
+	delete(p.refs, fqdn)
+	delete(p.cleanups, fqdn)
+	p.mutex.Unlock()
+
+	var errs []error
+	for _, cleanup := range cleanups {
+		if err := cleanup(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func dedupChallengeKey(domain, token string) string {
+	return domain + "\x00" + token
+}
+
+func (p *dedupChallengeProvider) Timeout() (timeout, interval time.Duration) {
+	if pt, ok := p.provider.(challenge.ProviderTimeout); ok {
+		return pt.Timeout()
+	}
+
+	return dns01.DefaultPropagationTimeout, dns01.DefaultPollingInterval
+}
@@ -0,0 +1,195 @@
+package applicant
+
+import (
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v -run TestNormalizeDomains .
+*/
+func TestNormalizeDomains(t *testing.T) {
+	tests := []struct {
+		name    string
+		domains []string
+		want    []string
+	}{
+		{
+			name:    "Empty",
+			domains: []string{},
+			want:    []string{},
+		},
+		{
+			name:    "TrimsAndLowercases",
+			domains: []string{" Example.COM ", "", "  "},
+			want:    []string{"example.com"},
+		},
+		{
+			name:    "RemovesDuplicates",
+			domains: []string{"example.com", "EXAMPLE.com", "www.example.com", "example.com"},
+			want:    []string{"example.com", "www.example.com"},
+		},
+		{
+			name:    "WildcardCoversSubdomain",
+			domains: []string{"www.example.com", "*.example.com", "api.example.com"},
+			want:    []string{"*.example.com"},
+		},
+		{
+			name:    "WildcardDoesNotCoverApex",
+			domains: []string{"example.com", "*.example.com"},
+			want:    []string{"example.com", "*.example.com"},
+		},
+		{
+			name:    "WildcardDoesNotCoverDeeperSubdomain",
+			domains: []string{"*.example.com", "a.b.example.com"},
+			want:    []string{"*.example.com", "a.b.example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeDomains(tt.domains)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("normalizeDomains() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+type fakeChallengeProvider struct {
+	mutex      sync.Mutex
+	failTokens map[string]bool
+	presents   []string
+	cleanups   []string
+}
+
+func (p *fakeChallengeProvider) Present(domain, token, keyAuth string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if p.failTokens[token] {
+		return errors.New("present failed")
+	}
+
+	p.presents = append(p.presents, token)
+	return nil
+}
+
+func (p *fakeChallengeProvider) CleanUp(domain, token, keyAuth string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.cleanups = append(p.cleanups, token)
+	return nil
+}
+
+/*
+Shell command to run this test:
+
+	go test -v -run TestDedupChallengeProvider .
+*/
+func TestDedupChallengeProvider(t *testing.T) {
+	// 避免测试过程中查询 CNAME 记录
+	t.Setenv("LEGO_DISABLE_CNAME_SUPPORT", "true")
+
+	type challengeStep struct {
+		cleanup bool
+		domain  string
+		token   string
+		// 该步骤完成后，底层提供者累计被清理的质询令牌
+		wantCleanups []string
+	}
+
+	tests := []struct {
+		name       string
+		failTokens []string
+		steps      []challengeStep
+	}{
+		{
+			name: "SingleChallenge",
+			steps: []challengeStep{
+				{domain: "example.com", token: "t1"},
+				{cleanup: true, domain: "example.com", token: "t1", wantCleanups: []string{"t1"}},
+			},
+		},
+		{
+			name: "SharedRecordIsCleanedUpAfterLastChallenge",
+			steps: []challengeStep{
+				{domain: "example.com", token: "apex"},
+				{domain: "example.com", token: "wildcard"},
+				{cleanup: true, domain: "example.com", token: "apex", wantCleanups: []string{}},
+				{cleanup: true, domain: "example.com", token: "wildcard", wantCleanups: []string{"apex", "wildcard"}},
+			},
+		},
+		{
+			name: "DifferentRecordsAreIndependent",
+			steps: []challengeStep{
+				{domain: "example.com", token: "t1"},
+				{domain: "example.org", token: "t2"},
+				{cleanup: true, domain: "example.org", token: "t2", wantCleanups: []string{"t2"}},
+				{cleanup: true, domain: "example.com", token: "t1", wantCleanups: []string{"t2", "t1"}},
+			},
+		},
+		{
+			name:       "FailedPresentCleanedUpFirst",
+			failTokens: []string{"wildcard"},
+			steps: []challengeStep{
+				{domain: "example.com", token: "apex"},
+				{domain: "example.com", token: "wildcard"},
+				{cleanup: true, domain: "example.com", token: "wildcard", wantCleanups: []string{}},
+				{cleanup: true, domain: "example.com", token: "apex", wantCleanups: []string{"apex"}},
+			},
+		},
+		{
+			name:       "FailedPresentCleanedUpLast",
+			failTokens: []string{"wildcard"},
+			steps: []challengeStep{
+				{domain: "example.com", token: "apex"},
+				{domain: "example.com", token: "wildcard"},
+				{cleanup: true, domain: "example.com", token: "apex", wantCleanups: []string{"apex"}},
+				{cleanup: true, domain: "example.com", token: "wildcard", wantCleanups: []string{"apex"}},
+			},
+		},
+		{
+			name: "RepeatedCleanUpIsNoop",
+			steps: []challengeStep{
+				{domain: "example.com", token: "apex"},
+				{domain: "example.com", token: "wildcard"},
+				{cleanup: true, domain: "example.com", token: "apex", wantCleanups: []string{}},
+				{cleanup: true, domain: "example.com", token: "apex", wantCleanups: []string{}},
+				{cleanup: true, domain: "example.com", token: "wildcard", wantCleanups: []string{"apex", "wildcard"}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := &fakeChallengeProvider{failTokens: make(map[string]bool)}
+			for _, token := range tt.failTokens {
+				fake.failTokens[token] = true
+			}
+
+			provider := newDedupChallengeProvider(fake)
+			for i, step := range tt.steps {
+				if !step.cleanup {
+					err := provider.Present(step.domain, step.token, step.token)
+					if wantErr := fake.failTokens[step.token]; (err != nil) != wantErr {
+						t.Fatalf("step #%d: Present() error = %v, wantErr %v", i, err, wantErr)
+					}
+					continue
+				}
+
+				if err := provider.CleanUp(step.domain, step.token, step.token); err != nil {
+					t.Fatalf("step #%d: CleanUp() error = %v", i, err)
+				}
+				if !slices.Equal(fake.cleanups, step.wantCleanups) {
+					t.Fatalf("step #%d: cleanups = %v, want %v", i, fake.cleanups, step.wantCleanups)
+				}
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package applicant
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"os"
@@ -18,6 +19,7 @@ import (
 	"golang.org/x/time/rate"
 
 	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
 	uslices "github.com/usual2970/certimate/internal/pkg/utils/slices"
 	"github.com/usual2970/certimate/internal/repository"
 )
@@ -37,28 +39,51 @@ type Applicant interface {
 }
 
 type applicantOptions struct {
-	Domains               []string
-	ContactEmail          string
-	Provider              domain.ApplyDNSProviderType
-	ProviderAccessConfig  map[string]any
-	ProviderApplyConfig   map[string]any
-	KeyAlgorithm          string
-	Nameservers           []string
-	DnsPropagationTimeout int32
-	DnsTTL                int32
-	DisableFollowCNAME    bool
-	ReplacedARIAcctId     string
-	ReplacedARICertId     string
+	Domains                 []string
+	ContactEmail            string
+	Provider                domain.ApplyDNSProviderType
+	ProviderAccessConfig    map[string]any
+	ProviderApplyConfig     map[string]any
+	KeyAlgorithm            string
+	Nameservers             []string
+	DnsPropagationTimeout   int32
+	DnsTTL                  int32
+	DisableFollowCNAME      bool
+	ReplacedARIAcctId       string
+	ReplacedARICertId       string
+	UseStaging              bool
+	CSRProvider             domain.ApplyCSRProviderType
+	CSRProviderAccessConfig map[string]any
+	CSRProviderConfig       map[string]any
 }
 
-func NewWithApplyNode(node *domain.WorkflowNode) (Applicant, error) {
+// 校验申请节点的 DNS 提供商配置项。
+// 校验失败时返回 [*jsonschema.ValidationError]，其字段路径相对于节点配置，如 "providerConfig.region"。
+func ValidateApplyNode(node *domain.WorkflowNode) error {
+	if node.Type != domain.WorkflowNodeTypeApply {
+		return fmt.Errorf("node type is not apply")
+	}
+
+	nodeConfig := node.GetConfigForApply()
+	descriptor := GetProviderDescriptor(domain.ApplyDNSProviderType(nodeConfig.Provider))
+	if descriptor == nil {
+		return nil
+	}
+
+	return jsonschema.WithFieldPrefix(descriptor.ValidateConfig(nodeConfig.ProviderConfig), "providerConfig")
+}
+
+// 根据申请节点创建申请器。
+// 当 staging 为 true 时，将向所配置 ACME CA 的测试环境申请证书，其签发的证书不受信任，仅用于验证申请流程及 DNS 配置。
+// 目前仅 Let's Encrypt 提供测试环境，其他 CA 将在申请时返回错误。
+func NewWithApplyNode(node *domain.WorkflowNode, staging bool) (Applicant, error) {
 	if node.Type != domain.WorkflowNodeTypeApply {
 		return nil, fmt.Errorf("node type is not apply")
 	}
 
 	nodeConfig := node.GetConfigForApply()
 	options := &applicantOptions{
-		Domains:               uslices.Filter(strings.Split(nodeConfig.Domains, ";"), func(s string) bool { return s != "" }),
+		Domains:               normalizeDomains(strings.Split(nodeConfig.Domains, ";")),
 		ContactEmail:          nodeConfig.ContactEmail,
 		Provider:              domain.ApplyDNSProviderType(nodeConfig.Provider),
 		ProviderApplyConfig:   nodeConfig.ProviderConfig,
@@ -67,6 +92,9 @@ func NewWithApplyNode(node *domain.WorkflowNode) (Applicant, error) {
 		DnsPropagationTimeout: nodeConfig.DnsPropagationTimeout,
 		DnsTTL:                nodeConfig.DnsTTL,
 		DisableFollowCNAME:    nodeConfig.DisableFollowCNAME,
+		UseStaging:            staging,
+		CSRProvider:           domain.ApplyCSRProviderType(nodeConfig.CSRProvider),
+		CSRProviderConfig:     nodeConfig.CSRProviderConfig,
 	}
 
 	accessRepo := repository.NewAccessRepository()
@@ -81,9 +109,23 @@ func NewWithApplyNode(node *domain.WorkflowNode) (Applicant, error) {
 		options.ProviderAccessConfig = accessConfig
 	}
 
+	if options.CSRProvider != "" {
+		access, err := accessRepo.GetById(context.Background(), nodeConfig.CSRProviderAccessId)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get access #%s record: %w", nodeConfig.CSRProviderAccessId, err)
+		}
+
+		accessConfig, err := access.UnmarshalConfigToMap()
+		if err != nil {
+			return nil, fmt.Errorf("failed to unmarshal access config: %w", err)
+		}
+
+		options.CSRProviderAccessConfig = accessConfig
+	}
+
 	certRepo := repository.NewCertificateRepository()
 	lastCertificate, _ := certRepo.GetByWorkflowNodeId(context.Background(), node.Id)
-	if lastCertificate != nil {
+	if lastCertificate != nil && !staging {
 		newCertSan := slices.Clone(options.Domains)
 		oldCertSan := strings.Split(lastCertificate.SubjectAltNames, ";")
 		slices.Sort(newCertSan)
@@ -110,9 +152,26 @@ func NewWithApplyNode(node *domain.WorkflowNode) (Applicant, error) {
 	}, nil
 }
 
-func apply(challengeProvider challenge.Provider, options *applicantOptions) (*ApplyCertResult, error) {
+// 检查当前配置的 ACME CA 是否提供可用于测试运行的测试环境。
+//
+// 入参：
+//   - ctx：上下文。
+//
+// 出参：
+//   - 错误。CA 不提供测试环境时返回错误。
+func CheckStagingSupported(ctx context.Context) error {
+	sslProviderConfig, err := getSSLProviderConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = resolveStagingSSLProvider(sslProviderConfig.Provider)
+	return err
+}
+
+func getSSLProviderConfig(ctx context.Context) (*acmeSSLProviderConfig, error) {
 	settingsRepo := repository.NewSettingsRepository()
-	settings, _ := settingsRepo.GetByName(context.Background(), "sslProvider")
+	settings, _ := settingsRepo.GetByName(ctx, "sslProvider")
 
 	sslProviderConfig := &acmeSSLProviderConfig{
 		Config:   acmeSSLProviderConfigContent{},
@@ -128,6 +187,43 @@ func apply(challengeProvider challenge.Provider, options *applicantOptions) (*Ap
 		sslProviderConfig.Provider = defaultSSLProvider
 	}
 
+	return sslProviderConfig, nil
+}
+
+func resolveStagingSSLProvider(provider string) (string, error) {
+	stagingProvider, ok := sslProviderStagings[provider]
+	if !ok {
+		return "", fmt.Errorf("ssl provider '%s' does not provide a staging environment", provider)
+	}
+
+	return stagingProvider, nil
+}
+
+func apply(challengeProvider challenge.Provider, options *applicantOptions) (*ApplyCertResult, error) {
+	sslProviderConfig, err := getSSLProviderConfig(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// 测试运行时使用所配置 CA 的测试环境，CA 不提供测试环境时拒绝申请，以免误向正式环境申请证书
+	if options.UseStaging {
+		stagingProvider, err := resolveStagingSSLProvider(sslProviderConfig.Provider)
+		if err != nil {
+			return nil, err
+		}
+
+		sslProviderConfig.Provider = stagingProvider
+	}
+
+	// 使用外部 CSR 时，私钥由外部提供商生成并保管，仅基于其 CSR 申请证书
+	var csr *x509.CertificateRequest
+	if options.CSRProvider != "" {
+		csr, err = getExternalCSR(options)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	acmeUser, err := newAcmeUser(sslProviderConfig.Provider, options.ContactEmail)
 	if err != nil {
 		return nil, err
@@ -154,7 +250,7 @@ func apply(challengeProvider challenge.Provider, options *applicantOptions) (*Ap
 		challengeOptions = append(challengeOptions, dns01.AddRecursiveNameservers(dns01.ParseNameservers(options.Nameservers)))
 		challengeOptions = append(challengeOptions, dns01.DisableAuthoritativeNssPropagationRequirement())
 	}
-	client.Challenge.SetDNS01Provider(challengeProvider, challengeOptions...)
+	client.Challenge.SetDNS01Provider(newDedupChallengeProvider(challengeProvider), challengeOptions...)
 
 	// New users need to register first
 	if !acmeUser.hasRegistration() {
@@ -166,14 +262,25 @@ func apply(challengeProvider challenge.Provider, options *applicantOptions) (*Ap
 	}
 
 	// Obtain a certificate
-	certRequest := certificate.ObtainRequest{
-		Domains: options.Domains,
-		Bundle:  true,
-	}
+	replacesCertId := ""
 	if options.ReplacedARICertId != "" && options.ReplacedARIAcctId != acmeUser.Registration.URI {
-		certRequest.ReplacesCertID = options.ReplacedARICertId
+		replacesCertId = options.ReplacedARICertId
+	}
+
+	var certResource *certificate.Resource
+	if csr != nil {
+		certResource, err = client.Certificate.ObtainForCSR(certificate.ObtainForCSRRequest{
+			CSR:            csr,
+			Bundle:         true,
+			ReplacesCertID: replacesCertId,
+		})
+	} else {
+		certResource, err = client.Certificate.Obtain(certificate.ObtainRequest{
+			Domains:        options.Domains,
+			Bundle:         true,
+			ReplacesCertID: replacesCertId,
+		})
 	}
-	certResource, err := client.Certificate.Obtain(certRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -189,6 +296,29 @@ func apply(challengeProvider challenge.Provider, options *applicantOptions) (*Ap
 	}, nil
 }
 
+func getExternalCSR(options *applicantOptions) (*x509.CertificateRequest, error) {
+	provider, err := createCSRProvider(options)
+	if err != nil {
+		return nil, err
+	}
+
+	csr, err := provider.GetCSR(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get csr from provider '%s': %w", options.CSRProvider, err)
+	}
+
+	// 证书的域名由 CSR 决定，须与节点所配置的域名一致，以免续期判断及后续部署与实际签发的证书不符
+	csrDomains := normalizeDomains(certcrypto.ExtractDomainsCSR(csr))
+	nodeDomains := slices.Clone(options.Domains)
+	slices.Sort(csrDomains)
+	slices.Sort(nodeDomains)
+	if !slices.Equal(csrDomains, nodeDomains) {
+		return nil, fmt.Errorf("domains of the csr (%s) do not match the configured domains (%s)", strings.Join(csrDomains, ";"), strings.Join(nodeDomains, ";"))
+	}
+
+	return csr, nil
+}
+
 func parseKeyAlgorithm(algo domain.CertificateKeyAlgorithmType) certcrypto.KeyType {
 	switch algo {
 	case domain.CertificateKeyAlgorithmTypeRSA2048:
@@ -0,0 +1,52 @@
+package applicant
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	"github.com/usual2970/certimate/internal/domain"
+	pAkamaiCPS "github.com/usual2970/certimate/internal/pkg/core/applicant/acme-csr/akamai-cps"
+	"github.com/usual2970/certimate/internal/pkg/utils/maps"
+)
+
+// 表示外部 CSR 提供商。
+// 外部 CSR 提供商自行生成并保管私钥，申请时将基于其 CSR 签发证书。
+type csrProvider interface {
+	GetCSR(ctx context.Context) (*x509.CertificateRequest, error)
+}
+
+func createCSRProvider(options *applicantOptions) (csrProvider, error) {
+	/*
+	  注意：如果追加新的常量值，请保持以 ASCII 排序。
+	  NOTICE: If you add new constant, please keep ASCII order.
+	*/
+	switch options.CSRProvider {
+	case domain.ApplyCSRProviderTypeAkamaiCPS:
+		{
+			access := domain.AccessConfigForAkamai{}
+			if err := maps.Populate(options.CSRProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate csr provider access config: %w", err)
+			}
+
+			// CPS 双证书（RSA 及 ECDSA）注册会同时提供两种 CSR，按节点所配置的密钥算法优先选用
+			preferredKeyAlgorithm := "RSA"
+			if strings.HasPrefix(options.KeyAlgorithm, "EC") {
+				preferredKeyAlgorithm = "ECDSA"
+			}
+
+			provider, err := pAkamaiCPS.NewCSRProvider(&pAkamaiCPS.CSRProviderConfig{
+				Host:                  access.Host,
+				ClientToken:           access.ClientToken,
+				ClientSecret:          access.ClientSecret,
+				AccessToken:           access.AccessToken,
+				EnrollmentId:          maps.GetValueAsInt64(options.CSRProviderConfig, "enrollmentId"),
+				PreferredKeyAlgorithm: preferredKeyAlgorithm,
+			})
+			return provider, err
+		}
+	}
+
+	return nil, fmt.Errorf("unsupported csr provider: %s", string(options.CSRProvider))
+}
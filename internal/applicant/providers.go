@@ -30,6 +30,7 @@ import (
 	pVolcEngine "github.com/usual2970/certimate/internal/pkg/core/applicant/acme-dns-01/lego-providers/volcengine"
 	pWestcn "github.com/usual2970/certimate/internal/pkg/core/applicant/acme-dns-01/lego-providers/westcn"
 	"github.com/usual2970/certimate/internal/pkg/utils/maps"
+	aliyunsdk "github.com/usual2970/certimate/internal/pkg/vendors/aliyun-sdk"
 )
 
 func createApplicant(options *applicantOptions) (challenge.Provider, error) {
@@ -61,10 +62,31 @@ func createApplicant(options *applicantOptions) (challenge.Provider, error) {
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
+			if access.CredentialMode == domain.AccessCredentialModeTypeEnvironment {
+				credentials, err := aliyunsdk.GetEnvironmentCredentials(access.EcsRamRoleName)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get aliyun environment credentials: %w", err)
+				}
+
+				access.AccessKeyId = credentials.AccessKeyId
+				access.AccessKeySecret = credentials.AccessKeySecret
+				access.SecurityToken = credentials.SecurityToken
+			}
+			if access.RoleArn != "" {
+				credentials, err := aliyunsdk.AssumeRole(access.AccessKeyId, access.AccessKeySecret, access.SecurityToken, access.RoleArn, access.RoleSessionName)
+				if err != nil {
+					return nil, fmt.Errorf("failed to assume aliyun ram role: %w", err)
+				}
+
+				access.AccessKeyId = credentials.AccessKeyId
+				access.AccessKeySecret = credentials.AccessKeySecret
+				access.SecurityToken = credentials.SecurityToken
+			}
 
 			applicant, err := pAliyun.NewChallengeProvider(&pAliyun.ChallengeProviderConfig{
 				AccessKeyId:           access.AccessKeyId,
 				AccessKeySecret:       access.AccessKeySecret,
+				SecurityToken:         access.SecurityToken,
 				DnsPropagationTimeout: options.DnsPropagationTimeout,
 				DnsTTL:                options.DnsTTL,
 			})
@@ -77,6 +99,11 @@ func createApplicant(options *applicantOptions) (challenge.Provider, error) {
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
+			if access.CredentialMode == domain.AccessCredentialModeTypeEnvironment {
+				// 留空以使用 AWS SDK 默认凭据链（环境变量、IRSA、实例配置文件等）
+				access.AccessKeyId = ""
+				access.SecretAccessKey = ""
+			}
 
 			applicant, err := pAWSRoute53.NewChallengeProvider(&pAWSRoute53.ChallengeProviderConfig{
 				AccessKeyId:           access.AccessKeyId,
@@ -0,0 +1,84 @@
+package applicant
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
+)
+
+/*
+申请证书 DNS 提供商的描述信息。
+兼容旧值的提供商类型不会在此注册。
+
+	注意：如果追加新的 DNS 提供商，请同时在此处注册，并保持以 ASCII 排序。
+	NOTICE: If you add new DNS provider, please register it here too and keep ASCII order.
+*/
+var providerDescriptors = []*domain.ProviderDescriptor{
+	newProviderDescriptor(domain.ApplyDNSProviderTypeACMEHttpReq, domain.AccessProviderTypeACMEHttpReq),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeAliyunDNS, domain.AccessProviderTypeAliyun),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeAWSRoute53, domain.AccessProviderTypeAWS),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeAzureDNS, domain.AccessProviderTypeAzure),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeBaiduCloudDNS, domain.AccessProviderTypeBaiduCloud),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeCloudflare, domain.AccessProviderTypeCloudflare),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeClouDNS, domain.AccessProviderTypeClouDNS),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeCMCCCloud, domain.AccessProviderTypeCMCCCloud),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeDNSLA, domain.AccessProviderTypeDNSLA),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeGcore, domain.AccessProviderTypeGcore),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeGname, domain.AccessProviderTypeGname),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeGoDaddy, domain.AccessProviderTypeGoDaddy),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeHuaweiCloudDNS, domain.AccessProviderTypeHuaweiCloud),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeJDCloudDNS, domain.AccessProviderTypeJDCloud),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeNamecheap, domain.AccessProviderTypeNamecheap),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeNameDotCom, domain.AccessProviderTypeNameDotCom),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeNameSilo, domain.AccessProviderTypeNameSilo),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeNS1, domain.AccessProviderTypeNS1),
+	newProviderDescriptor(domain.ApplyDNSProviderTypePowerDNS, domain.AccessProviderTypePowerDNS),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeRainYun, domain.AccessProviderTypeRainYun),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeTencentCloudDNS, domain.AccessProviderTypeTencentCloud),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeVolcEngineDNS, domain.AccessProviderTypeVolcEngine),
+	newProviderDescriptor(domain.ApplyDNSProviderTypeWestcn, domain.AccessProviderTypeWestcn),
+}
+
+// 各 DNS 提供商配置项的 JSON Schema，文件名与提供商类型一致。
+// 每个已注册的提供商都必须声明，即使没有任何校验规则；缺失时将在启动时 panic。
+//
+//go:embed schemas/*.json
+var providerConfigSchemas embed.FS
+
+func newProviderDescriptor(provider domain.ApplyDNSProviderType, access domain.AccessProviderType) *domain.ProviderDescriptor {
+	return &domain.ProviderDescriptor{
+		Kind:           domain.ProviderKindApplicant,
+		Type:           string(provider),
+		AccessProvider: access,
+		// DNS-01 质询均支持泛域名，且一个订单中可包含多个域名
+		Capabilities:     []domain.ProviderCapability{domain.ProviderCapabilityWildcard, domain.ProviderCapabilityMultipleDomains},
+		ConfigJSONSchema: mustLoadProviderConfigJSONSchema(provider),
+	}
+}
+
+func mustLoadProviderConfigJSONSchema(provider domain.ApplyDNSProviderType) *jsonschema.Schema {
+	schema, err := jsonschema.ParseFS(providerConfigSchemas, fmt.Sprintf("schemas/%s.json", provider))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load config schema of provider '%s': %v", provider, err))
+	}
+
+	return schema
+}
+
+// 获取全部申请证书 DNS 提供商的描述信息。
+func GetProviderDescriptors() []*domain.ProviderDescriptor {
+	return providerDescriptors
+}
+
+// 获取指定申请证书 DNS 提供商的描述信息，未注册时返回 nil。
+func GetProviderDescriptor(provider domain.ApplyDNSProviderType) *domain.ProviderDescriptor {
+	for _, descriptor := range providerDescriptors {
+		if descriptor.Type == string(provider) {
+			return descriptor
+		}
+	}
+
+	return nil
+}
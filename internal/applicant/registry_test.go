@@ -0,0 +1,46 @@
+package applicant
+
+import (
+	"io/fs"
+	"strings"
+	"testing"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v -run TestProviderConfigJSONSchemas .
+*/
+func TestProviderConfigJSONSchemas(t *testing.T) {
+	t.Run("EveryProviderHasSchema", func(t *testing.T) {
+		for _, descriptor := range GetProviderDescriptors() {
+			if descriptor.ConfigJSONSchema == nil {
+				t.Errorf("provider '%s' has no config schema", descriptor.Type)
+			}
+		}
+	})
+
+	t.Run("EverySchemaParsesAndIsRegistered", func(t *testing.T) {
+		registered := make(map[string]struct{})
+		for _, descriptor := range GetProviderDescriptors() {
+			registered[descriptor.Type] = struct{}{}
+		}
+
+		entries, err := fs.ReadDir(providerConfigSchemas, "schemas")
+		if err != nil {
+			t.Fatalf("failed to read schemas: %v", err)
+		}
+
+		for _, entry := range entries {
+			if _, err := jsonschema.ParseFS(providerConfigSchemas, "schemas/"+entry.Name()); err != nil {
+				t.Errorf("schema '%s' failed to parse: %v", entry.Name(), err)
+			}
+
+			if _, ok := registered[strings.TrimSuffix(entry.Name(), ".json")]; !ok {
+				t.Errorf("schema '%s' does not belong to any registered provider", entry.Name())
+			}
+		}
+	})
+}
@@ -25,8 +25,11 @@ const (
 )
 
 type certificateRepository interface {
+	List(ctx context.Context, options *repository.CertificateListOptions) (*domain.CursorPage[*domain.Certificate], error)
 	ListExpireSoon(ctx context.Context) ([]*domain.Certificate, error)
 	GetById(ctx context.Context, id string) (*domain.Certificate, error)
+	UpdateByIds(ctx context.Context, ids []string, patch *repository.CertificatePatch) (int, error)
+	DeleteByIds(ctx context.Context, ids []string) (int, error)
 }
 
 type CertificateService struct {
@@ -59,6 +62,52 @@ func (s *CertificateService) InitSchedule(ctx context.Context) error {
 	return nil
 }
 
+func (s *CertificateService) List(ctx context.Context, req *dtos.CertificateListReq) (*dtos.CertificateListResp, error) {
+	return s.certRepo.List(ctx, &repository.CertificateListOptions{
+		Keyword:    req.Keyword,
+		State:      req.State,
+		WorkflowId: req.WorkflowId,
+		Sort:       req.Sort,
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+	})
+}
+
+func (s *CertificateService) BatchDelete(ctx context.Context, req *dtos.CertificateBatchDeleteReq) (*dtos.CertificateBatchDeleteResp, error) {
+	if len(req.CertificateIds) > 1000 {
+		return nil, domain.ErrInvalidParams
+	}
+
+	count, err := s.certRepo.DeleteByIds(ctx, req.CertificateIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.CertificateBatchDeleteResp{
+		DeletedCount: count,
+	}, nil
+}
+
+func (s *CertificateService) BatchUpdate(ctx context.Context, req *dtos.CertificateBatchUpdateReq) (*dtos.CertificateBatchUpdateResp, error) {
+	if len(req.CertificateIds) > 1000 {
+		return nil, domain.ErrInvalidParams
+	}
+	if req.Archived == nil {
+		return nil, domain.ErrInvalidParams
+	}
+
+	count, err := s.certRepo.UpdateByIds(ctx, req.CertificateIds, &repository.CertificatePatch{
+		Archived: req.Archived,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.CertificateBatchUpdateResp{
+		UpdatedCount: count,
+	}, nil
+}
+
 func (s *CertificateService) ArchiveFile(ctx context.Context, req *dtos.CertificateArchiveFileReq) (*dtos.CertificateArchiveFileResp, error) {
 	certificate, err := s.certRepo.GetById(ctx, req.CertificateId)
 	if err != nil {
@@ -0,0 +1,98 @@
+package deployer
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/utils/cache"
+	"github.com/usual2970/certimate/internal/pkg/utils/maps"
+)
+
+type deployerCacheKey struct {
+	AccessId          string
+	AccessFingerprint string
+	DeployFingerprint string
+}
+
+// 缓存已创建的部署器（及其持有的 SDK 客户端），避免每次执行工作流时都重新构造客户端。
+// 缓存键包含授权配置的指纹，授权凭据变更后将生成新的缓存项，旧的缓存项会被同时淘汰。
+var deployerCache = cache.NewTTLCache[deployerCacheKey, deployer.Deployer](getDeployerCacheTTL())
+
+func getDeployerCacheTTL() time.Duration {
+	ttl := 30 * time.Minute
+
+	// 单位：秒。设置为 0 表示禁用缓存
+	if envTTL := os.Getenv("CERTIMATE_DEPLOYER_CACHE_TTL"); envTTL != "" {
+		if n, err := strconv.Atoi(envTTL); err == nil && n >= 0 {
+			ttl = time.Duration(n) * time.Second
+		}
+	}
+
+	return ttl
+}
+
+func getOrCreateDeployer(accessId string, options *deployerOptions) (deployer.Deployer, error) {
+	if getDeployerCacheTTL() == 0 || hasTemporaryCredentials(options.ProviderAccessConfig) {
+		return createDeployer(options)
+	}
+
+	accessFingerprint, err := computeFingerprint(options.ProviderAccessConfig)
+	if err != nil {
+		return createDeployer(options)
+	}
+
+	deployFingerprint, err := computeFingerprint(map[string]any{"provider": options.Provider, "config": options.ProviderDeployConfig})
+	if err != nil {
+		return createDeployer(options)
+	}
+
+	key := deployerCacheKey{
+		AccessId:          accessId,
+		AccessFingerprint: accessFingerprint,
+		DeployFingerprint: deployFingerprint,
+	}
+	if cached, ok := deployerCache.Get(key); ok {
+		return cached, nil
+	}
+
+	// 淘汰同一授权下使用旧凭据创建的部署器
+	deployerCache.RemoveFunc(func(k deployerCacheKey, _ deployer.Deployer) bool {
+		return k.AccessId == accessId && k.AccessFingerprint != accessFingerprint
+	})
+
+	return deployerCache.GetOrCreate(key, func() (deployer.Deployer, error) {
+		return createDeployer(options)
+	})
+}
+
+// 通过扮演角色、实例元数据等方式获取的临时凭据存在有效期，不宜跨越多次执行缓存。
+func hasTemporaryCredentials(accessConfig map[string]any) bool {
+	return maps.GetValueAsString(accessConfig, "roleArn") != "" ||
+		maps.GetValueAsString(accessConfig, "credentialMode") == string(domain.AccessCredentialModeTypeEnvironment)
+}
+
+// 清除指定授权下的全部部署器缓存。
+//
+// 入参：
+//   - accessId: 授权记录 ID。
+func InvalidateCacheByAccessId(accessId string) {
+	deployerCache.RemoveFunc(func(k deployerCacheKey, _ deployer.Deployer) bool {
+		return k.AccessId == accessId
+	})
+}
+
+func computeFingerprint(v any) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:]), nil
+}
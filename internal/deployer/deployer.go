@@ -2,16 +2,61 @@ package deployer
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
 	"github.com/usual2970/certimate/internal/domain"
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
 	"github.com/usual2970/certimate/internal/repository"
 )
 
+// 全局仅校验模式。开启后所有部署节点都只执行校验，而不会实际部署证书
+var globalDryRun = false
+
+// 部署器不支持仅校验模式时返回的错误
+var ErrDryRunNotSupported = errors.New("deployer does not support dry-run")
+
+// 部署器不支持查询部署目标当前所用证书时返回的错误
+var ErrDeployedCertificateNotSupported = errors.New("deployer does not support getting deployed certificate")
+
+func init() {
+	// 部署目标较多时（如多域名、多监听器），单个部署器内部的最大并发数
+	envMaxConcurrency := os.Getenv("CERTIMATE_DEPLOYER_MAX_CONCURRENCY")
+	if n, err := strconv.Atoi(envMaxConcurrency); err == nil && n > 0 {
+		concurrent.DefaultLimit = n
+	}
+
+	envDryRun := os.Getenv("CERTIMATE_DEPLOYER_DRY_RUN")
+	if b, err := strconv.ParseBool(envDryRun); err == nil {
+		globalDryRun = b
+	}
+}
+
 type Deployer interface {
-	Deploy(ctx context.Context) error
+	// 部署证书。
+	// 部署结果中的扩展数据（如多主机部署时各主机的部署情况）即使在部署失败时也可能返回。
+	Deploy(ctx context.Context) (*deployer.DeployResult, error)
+
+	// 清理已被本次部署的证书取代的旧证书，返回已清理的证书 ID 列表。
+	// 应在部署成功后调用。部署器不支持时返回 [uploader.ErrCleanupNotSupported]。
+	CleanupCertificates(ctx context.Context) ([]string, error)
+
+	// 获取部署目标当前所用的证书，尚未部署过证书时返回 nil。
+	// 部署器不支持时返回 [ErrDeployedCertificateNotSupported]。
+	GetDeployedCertificate(ctx context.Context) (*x509.Certificate, error)
+}
+
+// 判断部署节点是否以仅校验模式执行。
+func IsDryRun(node *domain.WorkflowNode) bool {
+	return globalDryRun || node.GetConfigForDeploy().DryRun
 }
 
 type deployerOptions struct {
@@ -20,16 +65,97 @@ type deployerOptions struct {
 	ProviderDeployConfig map[string]any
 }
 
+// 根据部署节点创建部署器。
+// 当 dryRun 为 true 时，部署器将以仅校验模式执行，参见 [IsDryRun]。
 func NewWithDeployNode(node *domain.WorkflowNode, certdata struct {
 	Certificate string
 	PrivateKey  string
-},
+}, dryRun bool,
+) (Deployer, error) {
+	if node.Type != domain.WorkflowNodeTypeDeploy {
+		return nil, fmt.Errorf("node type is not deploy")
+	}
+
+	nodeConfig := node.GetConfigForDeploy()
+	return newWithDeployNodeConfig(nodeConfig, nodeConfig.ProviderConfig, certdata, dryRun)
+}
+
+// 根据部署节点的批量部署目标创建部署器。
+// 部署目标的额外配置将覆盖部署节点的同名配置项。
+func NewWithDeployNodeTarget(node *domain.WorkflowNode, target domain.WorkflowNodeConfigForDeployTarget, certdata struct {
+	Certificate string
+	PrivateKey  string
+}, dryRun bool,
 ) (Deployer, error) {
 	if node.Type != domain.WorkflowNodeTypeDeploy {
 		return nil, fmt.Errorf("node type is not deploy")
 	}
 
 	nodeConfig := node.GetConfigForDeploy()
+	return newWithDeployNodeConfig(nodeConfig, mergeDeployTargetProviderConfig(nodeConfig, target), certdata, dryRun)
+}
+
+// 校验部署节点的提供商配置项，包括各批量部署目标合并后的配置项。
+// 校验失败时返回 [*jsonschema.ValidationError]，其字段路径相对于节点配置，如 "providerConfig.domain"。
+func ValidateDeployNode(node *domain.WorkflowNode) error {
+	if node.Type != domain.WorkflowNodeTypeDeploy {
+		return fmt.Errorf("node type is not deploy")
+	}
+
+	nodeConfig := node.GetConfigForDeploy()
+	descriptor := GetProviderDescriptor(domain.DeployProviderType(nodeConfig.Provider))
+	if descriptor == nil {
+		return nil
+	}
+
+	if len(nodeConfig.BatchTargets) == 0 {
+		return jsonschema.WithFieldPrefix(descriptor.ValidateConfig(nodeConfig.ProviderConfig), "providerConfig")
+	}
+
+	fieldErrs := make([]jsonschema.FieldError, 0)
+	for i, target := range nodeConfig.BatchTargets {
+		err := jsonschema.WithFieldPrefix(descriptor.ValidateConfig(mergeDeployTargetProviderConfig(nodeConfig, target)), fmt.Sprintf("batchTargets[%d].providerConfig", i))
+		if err == nil {
+			continue
+		}
+
+		var verr *jsonschema.ValidationError
+		if !errors.As(err, &verr) {
+			return err
+		}
+		fieldErrs = append(fieldErrs, verr.Errors...)
+	}
+	if len(fieldErrs) > 0 {
+		return &jsonschema.ValidationError{Errors: fieldErrs}
+	}
+
+	return nil
+}
+
+func mergeDeployTargetProviderConfig(nodeConfig domain.WorkflowNodeConfigForDeploy, target domain.WorkflowNodeConfigForDeployTarget) map[string]any {
+	providerConfig := make(map[string]any, len(nodeConfig.ProviderConfig)+len(target.ProviderConfig))
+	for k, v := range nodeConfig.ProviderConfig {
+		providerConfig[k] = v
+	}
+	for k, v := range target.ProviderConfig {
+		providerConfig[k] = v
+	}
+
+	return providerConfig
+}
+
+func newWithDeployNodeConfig(nodeConfig domain.WorkflowNodeConfigForDeploy, providerConfig map[string]any, certdata struct {
+	Certificate string
+	PrivateKey  string
+}, dryRun bool,
+) (Deployer, error) {
+	// 基于外部 CSR 签发的证书不含私钥，仅能部署到自行保管私钥的提供商
+	if certdata.PrivateKey == "" {
+		descriptor := GetProviderDescriptor(domain.DeployProviderType(nodeConfig.Provider))
+		if descriptor == nil || !descriptor.HasCapability(domain.ProviderCapabilityExternalKey) {
+			return nil, fmt.Errorf("the certificate has no private key, provider '%s' cannot deploy it", nodeConfig.Provider)
+		}
+	}
 
 	accessRepo := repository.NewAccessRepository()
 	access, err := accessRepo.GetById(context.Background(), nodeConfig.ProviderAccessId)
@@ -42,10 +168,10 @@ func NewWithDeployNode(node *domain.WorkflowNode, certdata struct {
 		return nil, fmt.Errorf("failed to unmarshal access config: %w", err)
 	}
 
-	deployer, err := createDeployer(&deployerOptions{
+	providerDeployer, err := getOrCreateDeployer(access.Id, &deployerOptions{
 		Provider:             domain.DeployProviderType(nodeConfig.Provider),
 		ProviderAccessConfig: accessConfig,
-		ProviderDeployConfig: nodeConfig.ProviderConfig,
+		ProviderDeployConfig: providerConfig,
 	})
 	if err != nil {
 		return nil, err
@@ -53,9 +179,14 @@ func NewWithDeployNode(node *domain.WorkflowNode, certdata struct {
 
 	return &proxyDeployer{
 		logger:            logger.NewNilLogger(),
-		deployer:          deployer,
+		deployer:          providerDeployer,
 		deployCertificate: certdata.Certificate,
 		deployPrivateKey:  certdata.PrivateKey,
+		dryRun:            dryRun,
+		retryPolicy: deployer.RetryPolicy{
+			MaxRetries:      int(nodeConfig.RetryCount),
+			InitialInterval: time.Duration(nodeConfig.RetryInterval) * time.Second,
+		},
 	}, nil
 }
 
@@ -65,9 +196,45 @@ type proxyDeployer struct {
 	deployer          deployer.Deployer
 	deployCertificate string
 	deployPrivateKey  string
+	dryRun            bool
+	retryPolicy       deployer.RetryPolicy
 }
 
-func (d *proxyDeployer) Deploy(ctx context.Context) error {
-	_, err := d.deployer.Deploy(ctx, d.deployCertificate, d.deployPrivateKey)
-	return err
+func (d *proxyDeployer) Deploy(ctx context.Context) (*deployer.DeployResult, error) {
+	opts := deployer.Options{DryRun: d.dryRun}
+	if opts.DryRun && !deployer.SupportsDryRun(d.deployer) {
+		return nil, ErrDryRunNotSupported
+	}
+
+	startedAt := time.Now()
+	res, err := deployer.DeployWithRetry(ctx, d.deployer, d.deployCertificate, d.deployPrivateKey, d.retryPolicy, opts)
+	if res == nil {
+		if err != nil {
+			return nil, err
+		}
+
+		res = &deployer.DeployResult{}
+	}
+
+	res.StartedAt = startedAt
+	res.FinishedAt = time.Now()
+	return res, err
+}
+
+func (d *proxyDeployer) CleanupCertificates(ctx context.Context) ([]string, error) {
+	u := deployer.GetUploader(d.deployer)
+	if u == nil {
+		return nil, uploader.ErrCleanupNotSupported
+	}
+
+	return uploader.CleanupCertificates(ctx, u, d.deployCertificate)
+}
+
+func (d *proxyDeployer) GetDeployedCertificate(ctx context.Context) (*x509.Certificate, error) {
+	getter, ok := d.deployer.(deployer.DeployedCertificateGetter)
+	if !ok {
+		return nil, ErrDeployedCertificateNotSupported
+	}
+
+	return getter.GetDeployedCertificate(ctx)
 }
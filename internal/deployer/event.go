@@ -0,0 +1,21 @@
+package deployer
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/domain"
+)
+
+func Register() {
+	app := app.GetApp()
+	app.OnRecordAfterUpdateSuccess(domain.CollectionNameAccess).BindFunc(func(e *core.RecordEvent) error {
+		// 授权信息变更时，清除相关的部署器缓存
+		InvalidateCacheByAccessId(e.Record.Id)
+		return e.Next()
+	})
+	app.OnRecordAfterDeleteSuccess(domain.CollectionNameAccess).BindFunc(func(e *core.RecordEvent) error {
+		InvalidateCacheByAccessId(e.Record.Id)
+		return e.Next()
+	})
+}
@@ -8,11 +8,13 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	p1PanelConsole "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/1panel-console"
 	p1PanelSite "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/1panel-site"
+	pAkamaiCPS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/akamai-cps"
 	pAliyunALB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-alb"
 	pAliyunCASDeploy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-cas-deploy"
 	pAliyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-cdn"
 	pAliyunCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-clb"
 	pAliyunDCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-dcdn"
+	pAliyunDDoS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-ddos"
 	pAliyunESA "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-esa"
 	pAliyunFC "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-fc"
 	pAliyunLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-live"
@@ -21,6 +23,8 @@ import (
 	pAliyunVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-vod"
 	pAliyunWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-waf"
 	pAWSCloudFront "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aws-cloudfront"
+	pAWSELB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aws-elb"
+	pBaiduCloudBLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baiducloud-blb"
 	pBaiduCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baiducloud-cdn"
 	pBaishanCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baishan-cdn"
 	pBaotaPanelConsole "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baotapanel-console"
@@ -28,9 +32,26 @@ import (
 	pBytePlusCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/byteplus-cdn"
 	pCacheFly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cachefly"
 	pCdnfly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cdnfly"
+	pCiscoIOSXE "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cisco-iosxe"
+	pCloudflareSaaS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cloudflare-saas"
+	pCloudflareSSL "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cloudflare-ssl"
+	pConsulKV "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/consul-kv"
+	pCPanelSSL "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cpanel-ssl"
+	pDockerSwarm "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/docker-swarm"
 	pDogeCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/dogecloud-cdn"
 	pEdgioApplications "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/edgio-applications"
+	pEtcd "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/etcd"
+	pF5BigIP "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/f5-bigip"
+	pFastly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/fastly"
+	pFortinetFortiGate "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/fortinet-fortigate"
+	pFTP "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ftp"
 	pGcoreCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcore-cdn"
+	pGCPCertificateManager "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-certificatemanager"
+	pGCPLoadBalancer "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-loadbalancer"
+	pGitLab "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gitlab"
+	pGRPCAgent "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/grpc-agent"
+	pHAProxy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/haproxy"
+	pHeroku "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/heroku"
 	pHuaweiCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-cdn"
 	pHuaweiCloudELB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-elb"
 	pHuaweiCloudWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-waf"
@@ -38,12 +59,39 @@ import (
 	pJDCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-cdn"
 	pJDCloudLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-live"
 	pJDCloudVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-vod"
+	pJenkinsCredential "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jenkins-credential"
+	pK8sHarbor "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-harbor"
+	pK8sIngress "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-ingress"
+	pK8sOpenShiftRoute "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-openshift-route"
 	pK8sSecret "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-secret"
+	pKempLoadMaster "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/kemp-loadmaster"
+	pKeyCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/keycdn"
+	pKSyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ksyun-cdn"
 	pLocal "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/local"
+	pMikrotik "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/mikrotik"
+	pNetlifySite "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/netlify-site"
+	pNomadVariable "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/nomad-variable"
+	pOpenStackOctavia "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/openstack-octavia"
+	pOPNsense "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/opnsense"
+	pOracleCloudCertificates "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-certificates"
+	pOracleCloudLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-lb"
+	pOVHcloudIPLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-iplb"
+	pOVHcloudWebHosting "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-webhosting"
+	pPfSense "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/pfsense"
+	pPlesk "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/plesk"
 	pQiniuCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-cdn"
+	pQiniuKodo "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-kodo"
 	pQiniuPili "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-pili"
+	pRancherHarvester "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/rancher-harvester"
+	pRancherSecret "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/rancher-secret"
 	pSafeLine "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/safeline"
+	pSoftEther "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/softether"
+	pSophosFirewall "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/sophos-firewall"
 	pSSH "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh"
+	pSSHHarbor "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-harbor"
+	pSSHMailServer "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-mailserver"
+	pSSHMinIO "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-minio"
+	pTencentCloudAPIGateway "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-apigateway"
 	pTencentCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-cdn"
 	pTencentCloudCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-clb"
 	pTencentCloudCOS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-cos"
@@ -54,8 +102,12 @@ import (
 	pTencentCloudSSLDeploy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-ssl-deploy"
 	pTencentCloudVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-vod"
 	pTencentCloudWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-waf"
+	pTrueNAS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/truenas"
 	pUCloudUCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ucloud-ucdn"
 	pUCloudUS3 "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ucloud-us3"
+	pUpyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/upyun-cdn"
+	pVault "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vault"
+	pVercelProject "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vercel-project"
 	pVolcEngineCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-cdn"
 	pVolcEngineCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-clb"
 	pVolcEngineDCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-dcdn"
@@ -63,8 +115,15 @@ import (
 	pVolcEngineLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-live"
 	pVolcEngineTOS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-tos"
 	pWebhook "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/webhook"
+	pWHMService "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/whm-service"
+	pWinRMCertStore "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/winrm-certstore"
+	pWinRMIIS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/winrm-iis"
+	pZooKeeper "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/zookeeper"
 	"github.com/usual2970/certimate/internal/pkg/utils/maps"
 	"github.com/usual2970/certimate/internal/pkg/utils/slices"
+	ussh "github.com/usual2970/certimate/internal/pkg/utils/ssh"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	aliyunsdk "github.com/usual2970/certimate/internal/pkg/vendors/aliyun-sdk"
 )
 
 func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
@@ -96,6 +155,7 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 					ApiKey:                   access.ApiKey,
 					AllowInsecureConnections: access.AllowInsecureConnections,
 					WebsiteId:                maps.GetValueAsInt64(options.ProviderDeployConfig, "websiteId"),
+					WebsiteDomain:            maps.GetValueAsString(options.ProviderDeployConfig, "websiteDomain"),
 				})
 				return deployer, err
 
@@ -104,70 +164,144 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 		}
 
-	case domain.DeployProviderTypeAliyunALB, domain.DeployProviderTypeAliyunCASDeploy, domain.DeployProviderTypeAliyunCDN, domain.DeployProviderTypeAliyunCLB, domain.DeployProviderTypeAliyunDCDN, domain.DeployProviderTypeAliyunESA, domain.DeployProviderTypeAliyunFC, domain.DeployProviderTypeAliyunLive, domain.DeployProviderTypeAliyunNLB, domain.DeployProviderTypeAliyunOSS, domain.DeployProviderTypeAliyunVOD, domain.DeployProviderTypeAliyunWAF:
+	case domain.DeployProviderTypeAkamaiCPS:
+		{
+			access := domain.AccessConfigForAkamai{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pAkamaiCPS.NewDeployer(&pAkamaiCPS.DeployerConfig{
+				Host:                                access.Host,
+				ClientToken:                         access.ClientToken,
+				ClientSecret:                        access.ClientSecret,
+				AccessToken:                         access.AccessToken,
+				EnrollmentId:                        maps.GetValueAsInt64(options.ProviderDeployConfig, "enrollmentId"),
+				AcknowledgePostVerificationWarnings: maps.GetValueAsBool(options.ProviderDeployConfig, "acknowledgePostVerificationWarnings"),
+				AcknowledgeChangeManagement:         maps.GetValueAsBool(options.ProviderDeployConfig, "acknowledgeChangeManagement"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeAliyunALB, domain.DeployProviderTypeAliyunCASDeploy, domain.DeployProviderTypeAliyunCDN, domain.DeployProviderTypeAliyunCLB, domain.DeployProviderTypeAliyunDCDN, domain.DeployProviderTypeAliyunDDoS, domain.DeployProviderTypeAliyunESA, domain.DeployProviderTypeAliyunFC, domain.DeployProviderTypeAliyunLive, domain.DeployProviderTypeAliyunNLB, domain.DeployProviderTypeAliyunOSS, domain.DeployProviderTypeAliyunVOD, domain.DeployProviderTypeAliyunWAF:
 		{
 			access := domain.AccessConfigForAliyun{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
+			if access.CredentialMode == domain.AccessCredentialModeTypeEnvironment {
+				credentials, err := aliyunsdk.GetEnvironmentCredentials(access.EcsRamRoleName)
+				if err != nil {
+					return nil, fmt.Errorf("failed to get aliyun environment credentials: %w", err)
+				}
+
+				access.AccessKeyId = credentials.AccessKeyId
+				access.AccessKeySecret = credentials.AccessKeySecret
+				access.SecurityToken = credentials.SecurityToken
+			}
+			if access.RoleArn != "" {
+				credentials, err := aliyunsdk.AssumeRole(access.AccessKeyId, access.AccessKeySecret, access.SecurityToken, access.RoleArn, access.RoleSessionName)
+				if err != nil {
+					return nil, fmt.Errorf("failed to assume aliyun ram role: %w", err)
+				}
+
+				access.AccessKeyId = credentials.AccessKeyId
+				access.AccessKeySecret = credentials.AccessKeySecret
+				access.SecurityToken = credentials.SecurityToken
+			}
 
 			switch options.Provider {
 			case domain.DeployProviderTypeAliyunALB:
 				deployer, err := pAliyunALB.NewDeployer(&pAliyunALB.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					ResourceType:    pAliyunALB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
-					LoadbalancerId:  maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
-					ListenerId:      maps.GetValueAsString(options.ProviderDeployConfig, "listenerId"),
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ResourceType:     pAliyunALB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+					LoadbalancerId:   maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerId:       maps.GetValueAsString(options.ProviderDeployConfig, "listenerId"),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunCASDeploy:
 				deployer, err := pAliyunCASDeploy.NewDeployer(&pAliyunCASDeploy.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					ResourceIds:     slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "resourceIds"), ";"), func(s string) bool { return s != "" }),
-					ContactIds:      slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "contactIds"), ";"), func(s string) bool { return s != "" }),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ResourceIds:      slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "resourceIds"), ";"), func(s string) bool { return s != "" }),
+					ContactIds:       slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "contactIds"), ";"), func(s string) bool { return s != "" }),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunCDN:
 				deployer, err := pAliyunCDN.NewDeployer(&pAliyunCDN.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:         access.AccessKeyId,
+					AccessKeySecret:     access.AccessKeySecret,
+					SecurityToken:       access.SecurityToken,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunCLB:
 				deployer, err := pAliyunCLB.NewDeployer(&pAliyunCLB.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					ResourceType:    pAliyunCLB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
-					LoadbalancerId:  maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
-					ListenerPort:    maps.GetValueOrDefaultAsInt32(options.ProviderDeployConfig, "listenerPort", 443),
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ResourceType:     pAliyunCLB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+					LoadbalancerId:   maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerPort:     maps.GetValueOrDefaultAsInt32(options.ProviderDeployConfig, "listenerPort", 443),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunDCDN:
 				deployer, err := pAliyunDCDN.NewDeployer(&pAliyunDCDN.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:         access.AccessKeyId,
+					AccessKeySecret:     access.AccessKeySecret,
+					SecurityToken:       access.SecurityToken,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					Domains:             slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "domains"), ";"), func(s string) bool { return s != "" }),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
+					CertMode:            maps.GetValueAsString(options.ProviderDeployConfig, "certMode"),
+					CasRegion:           maps.GetValueAsString(options.ProviderDeployConfig, "casRegion"),
+					ResourceGroupId:     maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate:    maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeAliyunDDoS:
+				deployer, err := pAliyunDDoS.NewDeployer(&pAliyunDDoS.DeployerConfig{
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunESA:
 				deployer, err := pAliyunESA.NewDeployer(&pAliyunESA.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					SiteId:          maps.GetValueAsInt64(options.ProviderDeployConfig, "siteId"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					SiteId:           maps.GetValueAsInt64(options.ProviderDeployConfig, "siteId"),
+					SiteName:         maps.GetValueAsString(options.ProviderDeployConfig, "siteName"),
+					CertMode:         maps.GetValueAsString(options.ProviderDeployConfig, "certMode"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
@@ -175,6 +309,7 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				deployer, err := pAliyunFC.NewDeployer(&pAliyunFC.DeployerConfig{
 					AccessKeyId:     access.AccessKeyId,
 					AccessKeySecret: access.AccessKeySecret,
+					SecurityToken:   access.SecurityToken,
 					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
 					ServiceVersion:  maps.GetValueAsString(options.ProviderDeployConfig, "serviceVersion"),
 					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
@@ -185,19 +320,24 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				deployer, err := pAliyunLive.NewDeployer(&pAliyunLive.DeployerConfig{
 					AccessKeyId:     access.AccessKeyId,
 					AccessKeySecret: access.AccessKeySecret,
+					SecurityToken:   access.SecurityToken,
 					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
 					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					Domains:         slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "domains"), ";"), func(s string) bool { return s != "" }),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunNLB:
 				deployer, err := pAliyunNLB.NewDeployer(&pAliyunNLB.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					ResourceType:    pAliyunNLB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
-					LoadbalancerId:  maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
-					ListenerId:      maps.GetValueAsString(options.ProviderDeployConfig, "listenerId"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ResourceType:     pAliyunNLB.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+					LoadbalancerId:   maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerId:       maps.GetValueAsString(options.ProviderDeployConfig, "listenerId"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
@@ -205,6 +345,7 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				deployer, err := pAliyunOSS.NewDeployer(&pAliyunOSS.DeployerConfig{
 					AccessKeyId:     access.AccessKeyId,
 					AccessKeySecret: access.AccessKeySecret,
+					SecurityToken:   access.SecurityToken,
 					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
 					Bucket:          maps.GetValueAsString(options.ProviderDeployConfig, "bucket"),
 					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
@@ -213,20 +354,37 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 
 			case domain.DeployProviderTypeAliyunVOD:
 				deployer, err := pAliyunVOD.NewDeployer(&pAliyunVOD.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					CertMode:         maps.GetValueAsString(options.ProviderDeployConfig, "certMode"),
+					CasRegion:        maps.GetValueAsString(options.ProviderDeployConfig, "casRegion"),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
 			case domain.DeployProviderTypeAliyunWAF:
+				var enableTLSv3 *bool
+				if v, ok := options.ProviderDeployConfig["enableTLSv3"]; ok && v != nil {
+					enableTLSv3 = types.ToPtr(maps.GetValueAsBool(options.ProviderDeployConfig, "enableTLSv3"))
+				}
+
 				deployer, err := pAliyunWAF.NewDeployer(&pAliyunWAF.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.AccessKeySecret,
-					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					InstanceId:      maps.GetValueAsString(options.ProviderDeployConfig, "instanceId"),
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:      access.AccessKeyId,
+					AccessKeySecret:  access.AccessKeySecret,
+					SecurityToken:    access.SecurityToken,
+					Region:           maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					InstanceId:       maps.GetValueAsString(options.ProviderDeployConfig, "instanceId"),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					TLSVersion:       maps.GetValueAsString(options.ProviderDeployConfig, "tlsVersion"),
+					EnableTLSv3:      enableTLSv3,
+					CipherSuite:      maps.GetValueAsInt32(options.ProviderDeployConfig, "cipherSuite"),
+					CustomCiphers:    slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "customCiphers"), ";"), func(s string) bool { return s != "" }),
+					ResourceGroupId:  maps.GetValueAsString(options.ProviderDeployConfig, "resourceGroupId"),
+					CertNameTemplate: maps.GetValueAsString(options.ProviderDeployConfig, "certNameTemplate"),
 				})
 				return deployer, err
 
@@ -235,12 +393,17 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 		}
 
-	case domain.DeployProviderTypeAWSCloudFront:
+	case domain.DeployProviderTypeAWSCloudFront, domain.DeployProviderTypeAWSELB:
 		{
 			access := domain.AccessConfigForAWS{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
+			if access.CredentialMode == domain.AccessCredentialModeTypeEnvironment {
+				// 留空以使用 AWS SDK 默认凭据链（环境变量、IRSA、实例配置文件等）
+				access.AccessKeyId = ""
+				access.SecretAccessKey = ""
+			}
 
 			switch options.Provider {
 			case domain.DeployProviderTypeAWSCloudFront:
@@ -252,12 +415,23 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				})
 				return deployer, err
 
+			case domain.DeployProviderTypeAWSELB:
+				deployer, err := pAWSELB.NewDeployer(&pAWSELB.DeployerConfig{
+					AccessKeyId:        access.AccessKeyId,
+					SecretAccessKey:    access.SecretAccessKey,
+					Region:             maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					CertificateSource:  pAWSELB.CertificateSourceType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateSource", string(pAWSELB.CERTIFICATE_SOURCE_ACM))),
+					ListenerArn:        maps.GetValueAsString(options.ProviderDeployConfig, "listenerArn"),
+					KeepOldCertificate: maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "keepOldCertificate", false),
+				})
+				return deployer, err
+
 			default:
 				break
 			}
 		}
 
-	case domain.DeployProviderTypeBaiduCloudCDN:
+	case domain.DeployProviderTypeBaiduCloudBLB, domain.DeployProviderTypeBaiduCloudCDN:
 		{
 			access := domain.AccessConfigForBaiduCloud{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
@@ -265,6 +439,16 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 
 			switch options.Provider {
+			case domain.DeployProviderTypeBaiduCloudBLB:
+				deployer, err := pBaiduCloudBLB.NewDeployer(&pBaiduCloudBLB.DeployerConfig{
+					AccessKeyId:     access.AccessKeyId,
+					SecretAccessKey: access.SecretAccessKey,
+					Region:          maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					LoadbalancerId:  maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerPort:    maps.GetValueAsInt32(options.ProviderDeployConfig, "listenerPort"),
+				})
+				return deployer, err
+
 			case domain.DeployProviderTypeBaiduCloudCDN:
 				deployer, err := pBaiduCloudCDN.NewDeployer(&pBaiduCloudCDN.DeployerConfig{
 					AccessKeyId:     access.AccessKeyId,
@@ -341,9 +525,10 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			switch options.Provider {
 			case domain.DeployProviderTypeBytePlusCDN:
 				deployer, err := pBytePlusCDN.NewDeployer(&pBytePlusCDN.DeployerConfig{
-					AccessKey: access.AccessKey,
-					SecretKey: access.SecretKey,
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKey:           access.AccessKey,
+					SecretKey:           access.SecretKey,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
 				})
 				return deployer, err
 
@@ -383,6 +568,113 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			return deployer, err
 		}
 
+	case domain.DeployProviderTypeCiscoIOSXE:
+		{
+			access := domain.AccessConfigForCisco{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pCiscoIOSXE.NewDeployer(&pCiscoIOSXE.DeployerConfig{
+				SshHost:              access.Host,
+				SshPort:              access.Port,
+				SshUsername:          access.Username,
+				SshPassword:          access.Password,
+				TrustpointPrefix:     maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "trustpointPrefix", "CERTIMATE"),
+				BindHttpSecureServer: maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "bindHttpSecureServer", true),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeCloudflareSaaS, domain.DeployProviderTypeCloudflareSSL:
+		{
+			access := domain.AccessConfigForCloudflare{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeCloudflareSaaS:
+				deployer, err := pCloudflareSaaS.NewDeployer(&pCloudflareSaaS.DeployerConfig{
+					ApiToken:       access.DnsApiToken,
+					ZoneId:         maps.GetValueAsString(options.ProviderDeployConfig, "zoneId"),
+					CustomHostname: maps.GetValueAsString(options.ProviderDeployConfig, "customHostname"),
+					BundleMethod:   maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "bundleMethod", "ubiquitous"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeCloudflareSSL:
+				deployer, err := pCloudflareSSL.NewDeployer(&pCloudflareSSL.DeployerConfig{
+					ApiToken:      access.DnsApiToken,
+					ZoneId:        maps.GetValueAsString(options.ProviderDeployConfig, "zoneId"),
+					CertificateId: maps.GetValueAsString(options.ProviderDeployConfig, "certificateId"),
+					BundleMethod:  maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "bundleMethod", "ubiquitous"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeConsulKV:
+		{
+			access := domain.AccessConfigForConsul{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pConsulKV.NewDeployer(&pConsulKV.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Token:                    access.Token,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Datacenter:               maps.GetValueAsString(options.ProviderDeployConfig, "datacenter"),
+				KeyPrefix:                maps.GetValueAsString(options.ProviderDeployConfig, "keyPrefix"),
+				KeyForCertificate:        maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificate"),
+				KeyForPrivateKey:         maps.GetValueAsString(options.ProviderDeployConfig, "keyForPrivateKey"),
+				KeyForCertificateChain:   maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificateChain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeCPanelSSL:
+		{
+			access := domain.AccessConfigForCPanel{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pCPanelSSL.NewDeployer(&pCPanelSSL.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				ApiToken:                 access.ApiToken,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Domain:                   maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeDockerSwarm:
+		{
+			access := domain.AccessConfigForDocker{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pDockerSwarm.NewDeployer(&pDockerSwarm.DeployerConfig{
+				DockerHost:               access.DockerHost,
+				TLSCACertificate:         access.TLSCACertificate,
+				TLSCertificate:           access.TLSCertificate,
+				TLSPrivateKey:            access.TLSPrivateKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				SecretNamePrefix:         maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretNamePrefix", "certimate"),
+				ServiceNames:             slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "serviceNames"), ";"), func(s string) bool { return s != "" }),
+				CertificateFileName:      maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateFileName", "tls.crt"),
+				PrivateKeyFileName:       maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "privateKeyFileName", "tls.key"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeployProviderTypeDogeCloudCDN:
 		{
 			access := domain.AccessConfigForDogeCloud{}
@@ -413,6 +705,109 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			return deployer, err
 		}
 
+	case domain.DeployProviderTypeEtcd:
+		{
+			access := domain.AccessConfigForEtcd{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pEtcd.NewDeployer(&pEtcd.DeployerConfig{
+				Endpoints:                access.Endpoints,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				UseTLS:                   access.UseTLS,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				KeyForCertificate:        maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificate"),
+				KeyForPrivateKey:         maps.GetValueAsString(options.ProviderDeployConfig, "keyForPrivateKey"),
+				TTL:                      maps.GetValueAsInt64(options.ProviderDeployConfig, "ttl"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeF5BigIP:
+		{
+			access := domain.AccessConfigForF5{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pF5BigIP.NewDeployer(&pF5BigIP.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Partition:                maps.GetValueAsString(options.ProviderDeployConfig, "partition"),
+				ResourceType:             pF5BigIP.ResourceType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "resourceType", string(pF5BigIP.RESOURCE_TYPE_PROFILE))),
+				ProfileName:              maps.GetValueAsString(options.ProviderDeployConfig, "profileName"),
+				ParentProfileName:        maps.GetValueAsString(options.ProviderDeployConfig, "parentProfileName"),
+				VirtualServerName:        maps.GetValueAsString(options.ProviderDeployConfig, "virtualServerName"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeFastly:
+		{
+			access := domain.AccessConfigForFastly{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pFastly.NewDeployer(&pFastly.DeployerConfig{
+				ApiToken:           access.ApiToken,
+				ResourceType:       pFastly.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+				CertificateId:      maps.GetValueAsString(options.ProviderDeployConfig, "certificateId"),
+				Domain:             maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+				TlsConfigurationId: maps.GetValueAsString(options.ProviderDeployConfig, "tlsConfigurationId"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeFortinetFortiGate:
+		{
+			access := domain.AccessConfigForFortinet{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pFortinetFortiGate.NewDeployer(&pFortinetFortiGate.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiToken:                 access.ApiToken,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Vdom:                     maps.GetValueAsString(options.ProviderDeployConfig, "vdom"),
+				ResourceType:             pFortinetFortiGate.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+				VirtualServerName:        maps.GetValueAsString(options.ProviderDeployConfig, "virtualServerName"),
+				DeletePrevious:           maps.GetValueAsBool(options.ProviderDeployConfig, "deletePrevious"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeFTP:
+		{
+			access := domain.AccessConfigForFTP{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pFTP.NewDeployer(&pFTP.DeployerConfig{
+				FtpHost:                  access.Host,
+				FtpPort:                  access.Port,
+				FtpUsername:              access.Username,
+				FtpPassword:              access.Password,
+				UseExplicitTLS:           access.UseExplicitTLS,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				TransferMode:             pFTP.TransferModeType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "transferMode", string(pFTP.TRANSFER_MODE_BINARY))),
+				OutputFormat:             pFTP.OutputFormatType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "format", string(pFTP.OUTPUT_FORMAT_PEM))),
+				OutputCertPath:           maps.GetValueAsString(options.ProviderDeployConfig, "certPath"),
+				OutputKeyPath:            maps.GetValueAsString(options.ProviderDeployConfig, "keyPath"),
+				PfxPassword:              maps.GetValueAsString(options.ProviderDeployConfig, "pfxPassword"),
+				JksAlias:                 maps.GetValueAsString(options.ProviderDeployConfig, "jksAlias"),
+				JksKeypass:               maps.GetValueAsString(options.ProviderDeployConfig, "jksKeypass"),
+				JksStorepass:             maps.GetValueAsString(options.ProviderDeployConfig, "jksStorepass"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeployProviderTypeGcoreCDN:
 		{
 			access := domain.AccessConfigForGcore{}
@@ -433,6 +828,120 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 		}
 
+	case domain.DeployProviderTypeGCPCertificateManager, domain.DeployProviderTypeGCPLoadBalancer:
+		{
+			access := domain.AccessConfigForGCP{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+			if access.CredentialMode == domain.AccessCredentialModeTypeEnvironment {
+				// 留空以使用 GCP 应用默认凭据（环境变量、工作负载身份联合、GCE 元数据服务等）
+				access.ServiceAccountKey = ""
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeGCPCertificateManager:
+				deployer, err := pGCPCertificateManager.NewDeployer(&pGCPCertificateManager.DeployerConfig{
+					ServiceAccountKey:     access.ServiceAccountKey,
+					ProjectId:             maps.GetValueAsString(options.ProviderDeployConfig, "projectId"),
+					Location:              maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "location", "global"),
+					CertificateMapId:      maps.GetValueAsString(options.ProviderDeployConfig, "certificateMapId"),
+					CertificateMapEntryId: maps.GetValueAsString(options.ProviderDeployConfig, "certificateMapEntryId"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeGCPLoadBalancer:
+				deployer, err := pGCPLoadBalancer.NewDeployer(&pGCPLoadBalancer.DeployerConfig{
+					ServiceAccountKey:     access.ServiceAccountKey,
+					ProjectId:             maps.GetValueAsString(options.ProviderDeployConfig, "projectId"),
+					TargetHttpsProxyName:  maps.GetValueAsString(options.ProviderDeployConfig, "targetHttpsProxyName"),
+					CertificateNamePrefix: maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateNamePrefix", "certimate"),
+					KeepRotations:         maps.GetValueOrDefaultAsInt32(options.ProviderDeployConfig, "keepRotations", 0),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeGitLab:
+		{
+			access := domain.AccessConfigForGitLab{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pGitLab.NewDeployer(&pGitLab.DeployerConfig{
+				ServerUrl:                 access.ServerUrl,
+				ApiToken:                  access.ApiToken,
+				AllowInsecureConnections:  access.AllowInsecureConnections,
+				ResourceType:              pGitLab.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+				ProjectId:                 maps.GetValueAsString(options.ProviderDeployConfig, "projectId"),
+				GroupId:                   maps.GetValueAsString(options.ProviderDeployConfig, "groupId"),
+				VariableKeyForCertificate: maps.GetValueAsString(options.ProviderDeployConfig, "variableKeyForCertificate"),
+				VariableKeyForPrivateKey:  maps.GetValueAsString(options.ProviderDeployConfig, "variableKeyForPrivateKey"),
+				VariableType:              pGitLab.VariableType(maps.GetValueAsString(options.ProviderDeployConfig, "variableType")),
+				VariableEnvironmentScope:  maps.GetValueAsString(options.ProviderDeployConfig, "variableEnvironmentScope"),
+				VariableProtected:         maps.GetValueAsBool(options.ProviderDeployConfig, "variableProtected"),
+				Branch:                    maps.GetValueAsString(options.ProviderDeployConfig, "branch"),
+				FilePathForCertificate:    maps.GetValueAsString(options.ProviderDeployConfig, "filePathForCertificate"),
+				FilePathForPrivateKey:     maps.GetValueAsString(options.ProviderDeployConfig, "filePathForPrivateKey"),
+				CommitMessage:             maps.GetValueAsString(options.ProviderDeployConfig, "commitMessage"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeGRPCAgent:
+		{
+			access := domain.AccessConfigForGRPC{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pGRPCAgent.NewDeployer(&pGRPCAgent.DeployerConfig{
+				Endpoint:                 access.Endpoint,
+				UseTLS:                   access.UseTLS,
+				AuthToken:                access.AuthToken,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Target:                   maps.GetValueAsString(options.ProviderDeployConfig, "target"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeHAProxy:
+		{
+			access := domain.AccessConfigForHAProxy{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pHAProxy.NewDeployer(&pHAProxy.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiVersion:               access.ApiVersion,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateName:          maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateName", "certimate.pem"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeHeroku:
+		{
+			access := domain.AccessConfigForHeroku{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pHeroku.NewDeployer(&pHeroku.DeployerConfig{
+				ApiKey:        access.ApiKey,
+				AppName:       maps.GetValueAsString(options.ProviderDeployConfig, "appName"),
+				SniEndpointId: maps.GetValueAsString(options.ProviderDeployConfig, "sniEndpointId"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeployProviderTypeHuaweiCloudCDN, domain.DeployProviderTypeHuaweiCloudELB, domain.DeployProviderTypeHuaweiCloudWAF:
 		{
 			access := domain.AccessConfigForHuaweiCloud{}
@@ -543,51 +1052,403 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			return deployer, err
 		}
 
-	case domain.DeployProviderTypeKubernetesSecret:
+	case domain.DeployProviderTypeJenkinsCredential:
 		{
-			access := domain.AccessConfigForKubernetes{}
+			access := domain.AccessConfigForJenkins{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
 
-			deployer, err := pK8sSecret.NewDeployer(&pK8sSecret.DeployerConfig{
-				KubeConfig:          access.KubeConfig,
-				Namespace:           maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "default"),
-				SecretName:          maps.GetValueAsString(options.ProviderDeployConfig, "secretName"),
-				SecretType:          maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretType", "kubernetes.io/tls"),
-				SecretDataKeyForCrt: maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretDataKeyForCrt", "tls.crt"),
-				SecretDataKeyForKey: maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretDataKeyForKey", "tls.key"),
+			deployer, err := pJenkinsCredential.NewDeployer(&pJenkinsCredential.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				ApiToken:                 access.ApiToken,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				FolderPath:               maps.GetValueAsString(options.ProviderDeployConfig, "folderPath"),
+				CredentialDomain:         maps.GetValueAsString(options.ProviderDeployConfig, "credentialDomain"),
+				CredentialId:             maps.GetValueAsString(options.ProviderDeployConfig, "credentialId"),
+				CredentialScope:          maps.GetValueAsString(options.ProviderDeployConfig, "credentialScope"),
+				CredentialDescription:    maps.GetValueAsString(options.ProviderDeployConfig, "credentialDescription"),
+				KeystorePassword:         maps.GetValueAsString(options.ProviderDeployConfig, "keystorePassword"),
 			})
 			return deployer, err
 		}
 
-	case domain.DeployProviderTypeQiniuCDN, domain.DeployProviderTypeQiniuPili:
+	case domain.DeployProviderTypeKempLoadMaster:
 		{
-			access := domain.AccessConfigForQiniu{}
+			access := domain.AccessConfigForKemp{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
 
-			switch options.Provider {
-			case domain.DeployProviderTypeQiniuCDN:
-				deployer, err := pQiniuCDN.NewDeployer(&pQiniuCDN.DeployerConfig{
-					AccessKey: access.AccessKey,
-					SecretKey: access.SecretKey,
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
-				})
-				return deployer, err
-
-			case domain.DeployProviderTypeQiniuPili:
-				deployer, err := pQiniuPili.NewDeployer(&pQiniuPili.DeployerConfig{
-					AccessKey: access.AccessKey,
-					SecretKey: access.SecretKey,
-					Hub:       maps.GetValueAsString(options.ProviderDeployConfig, "hub"),
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
-				})
-				return deployer, err
+			deployer, err := pKempLoadMaster.NewDeployer(&pKempLoadMaster.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateName:          maps.GetValueAsString(options.ProviderDeployConfig, "certificateName"),
+				IntermediateName:         maps.GetValueAsString(options.ProviderDeployConfig, "intermediateName"),
+				VirtualServiceAddress:    maps.GetValueAsString(options.ProviderDeployConfig, "virtualServiceAddress"),
+				VirtualServicePort:       maps.GetValueAsInt32(options.ProviderDeployConfig, "virtualServicePort"),
+				VirtualServiceProtocol:   maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "virtualServiceProtocol", "tcp"),
+			})
+			return deployer, err
+		}
 
-			default:
-				break
+	case domain.DeployProviderTypeKeyCDN:
+		{
+			access := domain.AccessConfigForKeyCDN{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pKeyCDN.NewDeployer(&pKeyCDN.DeployerConfig{
+				ApiKey:    access.ApiKey,
+				ZoneAlias: maps.GetValueAsString(options.ProviderDeployConfig, "zoneAlias"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeKSyunCDN:
+		{
+			access := domain.AccessConfigForKSyun{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pKSyunCDN.NewDeployer(&pKSyunCDN.DeployerConfig{
+				AccessKeyId:     access.AccessKeyId,
+				SecretAccessKey: access.SecretAccessKey,
+				Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeKubernetesHarbor, domain.DeployProviderTypeKubernetesIngress, domain.DeployProviderTypeKubernetesOpenShiftRoute, domain.DeployProviderTypeKubernetesSecret:
+		{
+			access := domain.AccessConfigForKubernetes{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeKubernetesHarbor:
+				deployer, err := pK8sHarbor.NewDeployer(&pK8sHarbor.DeployerConfig{
+					KubeConfig:            access.KubeConfig,
+					Namespace:             maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "default"),
+					SecretName:            maps.GetValueAsString(options.ProviderDeployConfig, "secretName"),
+					RestartDeploymentName: maps.GetValueAsString(options.ProviderDeployConfig, "restartDeploymentName"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeKubernetesIngress:
+				deployer, err := pK8sIngress.NewDeployer(&pK8sIngress.DeployerConfig{
+					KubeConfig:  access.KubeConfig,
+					Namespace:   maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "default"),
+					IngressName: maps.GetValueAsString(options.ProviderDeployConfig, "ingressName"),
+					SecretName:  maps.GetValueAsString(options.ProviderDeployConfig, "secretName"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeKubernetesOpenShiftRoute:
+				deployer, err := pK8sOpenShiftRoute.NewDeployer(&pK8sOpenShiftRoute.DeployerConfig{
+					KubeConfig:    access.KubeConfig,
+					Namespace:     maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "default"),
+					RouteName:     maps.GetValueAsString(options.ProviderDeployConfig, "routeName"),
+					LabelSelector: maps.GetValueAsString(options.ProviderDeployConfig, "labelSelector"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeKubernetesSecret:
+				deployer, err := pK8sSecret.NewDeployer(&pK8sSecret.DeployerConfig{
+					KubeConfig:          access.KubeConfig,
+					Namespace:           maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "default"),
+					SecretName:          maps.GetValueAsString(options.ProviderDeployConfig, "secretName"),
+					SecretType:          maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretType", "kubernetes.io/tls"),
+					SecretDataKeyForCrt: maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretDataKeyForCrt", "tls.crt"),
+					SecretDataKeyForKey: maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretDataKeyForKey", "tls.key"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeMikrotik:
+		{
+			access := domain.AccessConfigForMikrotik{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pMikrotik.NewDeployer(&pMikrotik.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				ServiceNames:             slices.Filter(strings.Split(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "serviceNames", "www-ssl"), ";"), func(s string) bool { return s != "" }),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeNetlifySite:
+		{
+			access := domain.AccessConfigForNetlify{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pNetlifySite.NewDeployer(&pNetlifySite.DeployerConfig{
+				ApiToken: access.ApiToken,
+				SiteId:   maps.GetValueAsString(options.ProviderDeployConfig, "siteId"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeNomadVariable:
+		{
+			access := domain.AccessConfigForNomad{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pNomadVariable.NewDeployer(&pNomadVariable.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Token:                    access.Token,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				Namespace:                maps.GetValueAsString(options.ProviderDeployConfig, "namespace"),
+				VariablePath:             maps.GetValueAsString(options.ProviderDeployConfig, "variablePath"),
+				KeyForCertificate:        maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificate"),
+				KeyForPrivateKey:         maps.GetValueAsString(options.ProviderDeployConfig, "keyForPrivateKey"),
+				KeyForCertificateChain:   maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificateChain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeOpenStackOctavia:
+		{
+			access := domain.AccessConfigForOpenStack{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeOpenStackOctavia:
+				deployer, err := pOpenStackOctavia.NewDeployer(&pOpenStackOctavia.DeployerConfig{
+					AuthUrl:        access.AuthUrl,
+					DomainName:     access.DomainName,
+					Username:       access.Username,
+					Password:       access.Password,
+					ProjectId:      access.ProjectId,
+					Region:         maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ResourceType:   pOpenStackOctavia.ResourceType(maps.GetValueAsString(options.ProviderDeployConfig, "resourceType")),
+					LoadbalancerId: maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerId:     maps.GetValueAsString(options.ProviderDeployConfig, "listenerId"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeOPNsense:
+		{
+			access := domain.AccessConfigForOPNsense{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pOPNsense.NewDeployer(&pOPNsense.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				ApiSecret:                access.ApiSecret,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateName:          maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateName", "certimate"),
+				RestartWebGUI:            maps.GetValueAsBool(options.ProviderDeployConfig, "restartWebGUI"),
+				ReloadHAProxy:            maps.GetValueAsBool(options.ProviderDeployConfig, "reloadHAProxy"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeOracleCloudCertificates, domain.DeployProviderTypeOracleCloudLB:
+		{
+			access := domain.AccessConfigForOracleCloud{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeOracleCloudCertificates:
+				deployer, err := pOracleCloudCertificates.NewDeployer(&pOracleCloudCertificates.DeployerConfig{
+					TenancyId:     access.TenancyId,
+					UserId:        access.UserId,
+					Fingerprint:   access.Fingerprint,
+					PrivateKey:    access.PrivateKey,
+					Region:        maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					CompartmentId: maps.GetValueAsString(options.ProviderDeployConfig, "compartmentId"),
+					CertificateId: maps.GetValueAsString(options.ProviderDeployConfig, "certificateId"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeOracleCloudLB:
+				deployer, err := pOracleCloudLB.NewDeployer(&pOracleCloudLB.DeployerConfig{
+					TenancyId:      access.TenancyId,
+					UserId:         access.UserId,
+					Fingerprint:    access.Fingerprint,
+					PrivateKey:     access.PrivateKey,
+					Region:         maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					LoadbalancerId: maps.GetValueAsString(options.ProviderDeployConfig, "loadbalancerId"),
+					ListenerName:   maps.GetValueAsString(options.ProviderDeployConfig, "listenerName"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeOVHcloudIPLB, domain.DeployProviderTypeOVHcloudWebHosting:
+		{
+			access := domain.AccessConfigForOVHcloud{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeOVHcloudIPLB:
+				deployer, err := pOVHcloudIPLB.NewDeployer(&pOVHcloudIPLB.DeployerConfig{
+					Endpoint:          access.Endpoint,
+					ApplicationKey:    access.ApplicationKey,
+					ApplicationSecret: access.ApplicationSecret,
+					ConsumerKey:       access.ConsumerKey,
+					ServiceName:       maps.GetValueAsString(options.ProviderDeployConfig, "serviceName"),
+					FrontendId:        maps.GetValueAsInt64(options.ProviderDeployConfig, "frontendId"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeOVHcloudWebHosting:
+				deployer, err := pOVHcloudWebHosting.NewDeployer(&pOVHcloudWebHosting.DeployerConfig{
+					Endpoint:          access.Endpoint,
+					ApplicationKey:    access.ApplicationKey,
+					ApplicationSecret: access.ApplicationSecret,
+					ConsumerKey:       access.ConsumerKey,
+					ServiceName:       maps.GetValueAsString(options.ProviderDeployConfig, "serviceName"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypePfSense:
+		{
+			access := domain.AccessConfigForPfSense{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pPfSense.NewDeployer(&pPfSense.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateName:          maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateName", "certimate"),
+				UpdateWebGUI:             maps.GetValueAsBool(options.ProviderDeployConfig, "updateWebGUI"),
+				ReloadHAProxy:            maps.GetValueAsBool(options.ProviderDeployConfig, "reloadHAProxy"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypePlesk:
+		{
+			access := domain.AccessConfigForPlesk{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pPlesk.NewDeployer(&pPlesk.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				DomainName:               maps.GetValueAsString(options.ProviderDeployConfig, "domainName"),
+				AssignToHosting:          maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "assignToHosting", true),
+				AssignToMail:             maps.GetValueAsBool(options.ProviderDeployConfig, "assignToMail"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeQiniuCDN, domain.DeployProviderTypeQiniuKodo, domain.DeployProviderTypeQiniuPili:
+		{
+			access := domain.AccessConfigForQiniu{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeQiniuCDN:
+				deployer, err := pQiniuCDN.NewDeployer(&pQiniuCDN.DeployerConfig{
+					AccessKey: access.AccessKey,
+					SecretKey: access.SecretKey,
+					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeQiniuKodo:
+				deployer, err := pQiniuKodo.NewDeployer(&pQiniuKodo.DeployerConfig{
+					AccessKey: access.AccessKey,
+					SecretKey: access.SecretKey,
+					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeQiniuPili:
+				deployer, err := pQiniuPili.NewDeployer(&pQiniuPili.DeployerConfig{
+					AccessKey: access.AccessKey,
+					SecretKey: access.SecretKey,
+					Hub:       maps.GetValueAsString(options.ProviderDeployConfig, "hub"),
+					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeRancherHarvester, domain.DeployProviderTypeRancherSecret:
+		{
+			access := domain.AccessConfigForRancher{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeRancherHarvester:
+				deployer, err := pRancherHarvester.NewDeployer(&pRancherHarvester.DeployerConfig{
+					ServerUrl:                access.ServerUrl,
+					ApiToken:                 access.ApiToken,
+					AllowInsecureConnections: access.AllowInsecureConnections,
+					ClusterId:                maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "clusterId", "local"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeRancherSecret:
+				deployer, err := pRancherSecret.NewDeployer(&pRancherSecret.DeployerConfig{
+					ServerUrl:                access.ServerUrl,
+					ApiToken:                 access.ApiToken,
+					AllowInsecureConnections: access.AllowInsecureConnections,
+					ClusterIds:               slices.Filter(strings.Split(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "clusterId", "local"), ";"), func(s string) bool { return s != "" }),
+					ProjectIds:               slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "projectIds"), ";"), func(s string) bool { return s != "" }),
+					Namespace:                maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "namespace", "cattle-system"),
+					SecretName:               maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretName", "tls-rancher-ingress"),
+					AllowPartialFailure:      maps.GetValueAsBool(options.ProviderDeployConfig, "allowPartialFailure"),
+				})
+				return deployer, err
+
+			default:
+				break
 			}
 		}
 
@@ -608,35 +1469,147 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			return deployer, err
 		}
 
-	case domain.DeployProviderTypeSSH:
+	case domain.DeployProviderTypeSoftEther:
 		{
-			access := domain.AccessConfigForSSH{}
+			access := domain.AccessConfigForSoftEther{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pSoftEther.NewDeployer(&pSoftEther.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				AdminPassword:            access.AdminPassword,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeSophosFirewall:
+		{
+			access := domain.AccessConfigForSophos{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
 
-			deployer, err := pSSH.NewDeployer(&pSSH.DeployerConfig{
-				SshHost:          access.Host,
-				SshPort:          access.Port,
-				SshUsername:      access.Username,
-				SshPassword:      access.Password,
-				SshKey:           access.Key,
-				SshKeyPassphrase: access.KeyPassphrase,
-				UseSCP:           maps.GetValueAsBool(options.ProviderDeployConfig, "useSCP"),
-				PreCommand:       maps.GetValueAsString(options.ProviderDeployConfig, "preCommand"),
-				PostCommand:      maps.GetValueAsString(options.ProviderDeployConfig, "postCommand"),
-				OutputFormat:     pSSH.OutputFormatType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "format", string(pSSH.OUTPUT_FORMAT_PEM))),
-				OutputCertPath:   maps.GetValueAsString(options.ProviderDeployConfig, "certPath"),
-				OutputKeyPath:    maps.GetValueAsString(options.ProviderDeployConfig, "keyPath"),
-				PfxPassword:      maps.GetValueAsString(options.ProviderDeployConfig, "pfxPassword"),
-				JksAlias:         maps.GetValueAsString(options.ProviderDeployConfig, "jksAlias"),
-				JksKeypass:       maps.GetValueAsString(options.ProviderDeployConfig, "jksKeypass"),
-				JksStorepass:     maps.GetValueAsString(options.ProviderDeployConfig, "jksStorepass"),
+			deployer, err := pSophosFirewall.NewDeployer(&pSophosFirewall.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				Password:                 access.Password,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				CertificateNamePrefix:    maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "certificateNamePrefix", "certimate"),
+				BindWebAdmin:             maps.GetValueAsBool(options.ProviderDeployConfig, "bindWebAdmin"),
 			})
 			return deployer, err
 		}
 
-	case domain.DeployProviderTypeTencentCloudCDN, domain.DeployProviderTypeTencentCloudCLB, domain.DeployProviderTypeTencentCloudCOS, domain.DeployProviderTypeTencentCloudCSS, domain.DeployProviderTypeTencentCloudECDN, domain.DeployProviderTypeTencentCloudEO, domain.DeployProviderTypeTencentCloudSCF, domain.DeployProviderTypeTencentCloudSSLDeploy, domain.DeployProviderTypeTencentCloudVOD, domain.DeployProviderTypeTencentCloudWAF:
+	case domain.DeployProviderTypeSSH, domain.DeployProviderTypeSSHHarbor, domain.DeployProviderTypeSSHMailServer, domain.DeployProviderTypeSSHMinIO:
+		{
+			access := domain.AccessConfigForSSH{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			jumpServers := make([]ussh.JumpServerConfig, len(access.JumpServers))
+			for i, jumpServer := range access.JumpServers {
+				jumpServers[i] = ussh.JumpServerConfig{
+					SshHost:          jumpServer.Host,
+					SshPort:          jumpServer.Port,
+					SshUsername:      jumpServer.Username,
+					SshPassword:      jumpServer.Password,
+					SshKey:           jumpServer.Key,
+					SshKeyPassphrase: jumpServer.KeyPassphrase,
+				}
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeSSH:
+				hosts := struct {
+					Hosts []pSSH.DeployerHostConfig `json:"hosts"`
+				}{}
+				if err := maps.Populate(options.ProviderDeployConfig, &hosts); err != nil {
+					return nil, fmt.Errorf("failed to populate provider deploy config: %w", err)
+				}
+
+				deployer, err := pSSH.NewDeployer(&pSSH.DeployerConfig{
+					SshHost:             access.Host,
+					SshPort:             access.Port,
+					SshUsername:         access.Username,
+					SshPassword:         access.Password,
+					SshKey:              access.Key,
+					SshKeyPassphrase:    access.KeyPassphrase,
+					JumpServers:         jumpServers,
+					UseSCP:              maps.GetValueAsBool(options.ProviderDeployConfig, "useSCP"),
+					UseSudo:             maps.GetValueAsBool(options.ProviderDeployConfig, "useSudo"),
+					SudoPassword:        maps.GetValueAsString(options.ProviderDeployConfig, "sudoPassword"),
+					PreCommand:          maps.GetValueAsString(options.ProviderDeployConfig, "preCommand"),
+					PostCommand:         maps.GetValueAsString(options.ProviderDeployConfig, "postCommand"),
+					OutputFormat:        pSSH.OutputFormatType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "format", string(pSSH.OUTPUT_FORMAT_PEM))),
+					OutputCertPath:      maps.GetValueAsString(options.ProviderDeployConfig, "certPath"),
+					OutputKeyPath:       maps.GetValueAsString(options.ProviderDeployConfig, "keyPath"),
+					PfxPassword:         maps.GetValueAsString(options.ProviderDeployConfig, "pfxPassword"),
+					JksAlias:            maps.GetValueAsString(options.ProviderDeployConfig, "jksAlias"),
+					JksKeypass:          maps.GetValueAsString(options.ProviderDeployConfig, "jksKeypass"),
+					JksStorepass:        maps.GetValueAsString(options.ProviderDeployConfig, "jksStorepass"),
+					Hosts:               hosts.Hosts,
+					AllowPartialFailure: maps.GetValueAsBool(options.ProviderDeployConfig, "allowPartialFailure"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeSSHHarbor:
+				deployer, err := pSSHHarbor.NewDeployer(&pSSHHarbor.DeployerConfig{
+					SshHost:          access.Host,
+					SshPort:          access.Port,
+					SshUsername:      access.Username,
+					SshPassword:      access.Password,
+					SshKey:           access.Key,
+					SshKeyPassphrase: access.KeyPassphrase,
+					JumpServers:      jumpServers,
+					UseSCP:           maps.GetValueAsBool(options.ProviderDeployConfig, "useSCP"),
+					HarborDir:        maps.GetValueAsString(options.ProviderDeployConfig, "harborDir"),
+					CertPath:         maps.GetValueAsString(options.ProviderDeployConfig, "certPath"),
+					KeyPath:          maps.GetValueAsString(options.ProviderDeployConfig, "keyPath"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeSSHMailServer:
+				deployer, err := pSSHMailServer.NewDeployer(&pSSHMailServer.DeployerConfig{
+					SshHost:          access.Host,
+					SshPort:          access.Port,
+					SshUsername:      access.Username,
+					SshPassword:      access.Password,
+					SshKey:           access.Key,
+					SshKeyPassphrase: access.KeyPassphrase,
+					JumpServers:      jumpServers,
+					UseSCP:           maps.GetValueAsBool(options.ProviderDeployConfig, "useSCP"),
+					PostfixCertPath:  maps.GetValueAsString(options.ProviderDeployConfig, "postfixCertPath"),
+					PostfixKeyPath:   maps.GetValueAsString(options.ProviderDeployConfig, "postfixKeyPath"),
+					DovecotCertPath:  maps.GetValueAsString(options.ProviderDeployConfig, "dovecotCertPath"),
+					DovecotKeyPath:   maps.GetValueAsString(options.ProviderDeployConfig, "dovecotKeyPath"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeSSHMinIO:
+				deployer, err := pSSHMinIO.NewDeployer(&pSSHMinIO.DeployerConfig{
+					SshHost:          access.Host,
+					SshPort:          access.Port,
+					SshUsername:      access.Username,
+					SshPassword:      access.Password,
+					SshKey:           access.Key,
+					SshKeyPassphrase: access.KeyPassphrase,
+					JumpServers:      jumpServers,
+					UseSCP:           maps.GetValueAsBool(options.ProviderDeployConfig, "useSCP"),
+					CertsDir:         maps.GetValueAsString(options.ProviderDeployConfig, "certsDir"),
+					Domain:           maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					PostCommand:      maps.GetValueAsString(options.ProviderDeployConfig, "postCommand"),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeTencentCloudAPIGateway, domain.DeployProviderTypeTencentCloudCDN, domain.DeployProviderTypeTencentCloudCLB, domain.DeployProviderTypeTencentCloudCOS, domain.DeployProviderTypeTencentCloudCSS, domain.DeployProviderTypeTencentCloudECDN, domain.DeployProviderTypeTencentCloudEO, domain.DeployProviderTypeTencentCloudSCF, domain.DeployProviderTypeTencentCloudSSLDeploy, domain.DeployProviderTypeTencentCloudVOD, domain.DeployProviderTypeTencentCloudWAF:
 		{
 			access := domain.AccessConfigForTencentCloud{}
 			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
@@ -644,11 +1617,22 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 
 			switch options.Provider {
-			case domain.DeployProviderTypeTencentCloudCDN:
-				deployer, err := pTencentCloudCDN.NewDeployer(&pTencentCloudCDN.DeployerConfig{
+			case domain.DeployProviderTypeTencentCloudAPIGateway:
+				deployer, err := pTencentCloudAPIGateway.NewDeployer(&pTencentCloudAPIGateway.DeployerConfig{
 					SecretId:  access.SecretId,
 					SecretKey: access.SecretKey,
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					Region:    maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					ServiceId: maps.GetValueAsString(options.ProviderDeployConfig, "serviceId"),
+					SubDomain: maps.GetValueAsString(options.ProviderDeployConfig, "subDomain"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeTencentCloudCDN:
+				deployer, err := pTencentCloudCDN.NewDeployer(&pTencentCloudCDN.DeployerConfig{
+					SecretId:            access.SecretId,
+					SecretKey:           access.SecretKey,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
 				})
 				return deployer, err
 
@@ -666,11 +1650,12 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 
 			case domain.DeployProviderTypeTencentCloudCOS:
 				deployer, err := pTencentCloudCOS.NewDeployer(&pTencentCloudCOS.DeployerConfig{
-					SecretId:  access.SecretId,
-					SecretKey: access.SecretKey,
-					Region:    maps.GetValueAsString(options.ProviderDeployConfig, "region"),
-					Bucket:    maps.GetValueAsString(options.ProviderDeployConfig, "bucket"),
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					SecretId:   access.SecretId,
+					SecretKey:  access.SecretKey,
+					Region:     maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					Bucket:     maps.GetValueAsString(options.ProviderDeployConfig, "bucket"),
+					Domain:     maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					DomainType: pTencentCloudCOS.DomainType(maps.GetValueAsString(options.ProviderDeployConfig, "domainType")),
 				})
 				return deployer, err
 
@@ -684,9 +1669,10 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 
 			case domain.DeployProviderTypeTencentCloudECDN:
 				deployer, err := pTencentCloudECDN.NewDeployer(&pTencentCloudECDN.DeployerConfig{
-					SecretId:  access.SecretId,
-					SecretKey: access.SecretKey,
-					Domain:    maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					SecretId:            access.SecretId,
+					SecretKey:           access.SecretKey,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
 				})
 				return deployer, err
 
@@ -731,6 +1717,8 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				deployer, err := pTencentCloudWAF.NewDeployer(&pTencentCloudWAF.DeployerConfig{
 					SecretId:   access.SecretId,
 					SecretKey:  access.SecretKey,
+					Region:     maps.GetValueAsString(options.ProviderDeployConfig, "region"),
+					DomainType: pTencentCloudWAF.DomainType(maps.GetValueAsString(options.ProviderDeployConfig, "domainType")),
 					Domain:     maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
 					DomainId:   maps.GetValueAsString(options.ProviderDeployConfig, "domainId"),
 					InstanceId: maps.GetValueAsString(options.ProviderDeployConfig, "instanceId"),
@@ -742,6 +1730,26 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 		}
 
+	case domain.DeployProviderTypeTrueNAS:
+		{
+			access := domain.AccessConfigForTrueNAS{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pTrueNAS.NewDeployer(&pTrueNAS.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				ApiKey:                   access.ApiKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				UpdateUICertificate:      maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "updateUICertificate", true),
+				UpdateS3Certificate:      maps.GetValueAsBool(options.ProviderDeployConfig, "updateS3Certificate"),
+				UpdateFTPCertificate:     maps.GetValueAsBool(options.ProviderDeployConfig, "updateFTPCertificate"),
+				AppNames:                 slices.Filter(strings.Split(maps.GetValueAsString(options.ProviderDeployConfig, "appNames"), ";"), func(s string) bool { return s != "" }),
+				PruneExpired:             maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "pruneExpired", true),
+			})
+			return deployer, err
+		}
+
 	case domain.DeployProviderTypeUCloudUCDN, domain.DeployProviderTypeUCloudUS3:
 		{
 			access := domain.AccessConfigForUCloud{}
@@ -775,6 +1783,63 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			}
 		}
 
+	case domain.DeployProviderTypeUpyunCDN:
+		{
+			access := domain.AccessConfigForUpyun{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pUpyunCDN.NewDeployer(&pUpyunCDN.DeployerConfig{
+				Username: access.Username,
+				Password: access.Password,
+				Domain:   maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeVault:
+		{
+			access := domain.AccessConfigForVault{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pVault.NewDeployer(&pVault.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Namespace:                access.Namespace,
+				AuthMethod:               pVault.AuthMethodType(access.AuthMethod),
+				Token:                    access.Token,
+				AppRoleMountPath:         access.AppRoleMountPath,
+				AppRoleRoleId:            access.AppRoleRoleId,
+				AppRoleSecretId:          access.AppRoleSecretId,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+				SecretsEngine:            pVault.SecretsEngineType(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "secretsEngine", string(pVault.SECRETS_ENGINE_KV2))),
+				MountPath:                maps.GetValueAsString(options.ProviderDeployConfig, "mountPath"),
+				SecretPath:               maps.GetValueAsString(options.ProviderDeployConfig, "secretPath"),
+				KeyForCertificate:        maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificate"),
+				KeyForPrivateKey:         maps.GetValueAsString(options.ProviderDeployConfig, "keyForPrivateKey"),
+				KeyForCertificateChain:   maps.GetValueAsString(options.ProviderDeployConfig, "keyForCertificateChain"),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeVercelProject:
+		{
+			access := domain.AccessConfigForVercel{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pVercelProject.NewDeployer(&pVercelProject.DeployerConfig{
+				ApiAccessToken: access.ApiAccessToken,
+				TeamId:         access.TeamId,
+				ProjectId:      maps.GetValueAsString(options.ProviderDeployConfig, "projectId"),
+				Domain:         maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+			})
+			return deployer, err
+		}
+
 	case domain.DeployProviderTypeVolcEngineCDN, domain.DeployProviderTypeVolcEngineCLB, domain.DeployProviderTypeVolcEngineDCDN, domain.DeployProviderTypeVolcEngineImageX, domain.DeployProviderTypeVolcEngineLive, domain.DeployProviderTypeVolcEngineTOS:
 		{
 			access := domain.AccessConfigForVolcEngine{}
@@ -785,9 +1850,10 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 			switch options.Provider {
 			case domain.DeployProviderTypeVolcEngineCDN:
 				deployer, err := pVolcEngineCDN.NewDeployer(&pVolcEngineCDN.DeployerConfig{
-					AccessKeyId:     access.AccessKeyId,
-					AccessKeySecret: access.SecretAccessKey,
-					Domain:          maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					AccessKeyId:         access.AccessKeyId,
+					AccessKeySecret:     access.SecretAccessKey,
+					Domain:              maps.GetValueAsString(options.ProviderDeployConfig, "domain"),
+					MatchCoveredDomains: maps.GetValueAsBool(options.ProviderDeployConfig, "matchCoveredDomains"),
 				})
 				return deployer, err
 
@@ -849,10 +1915,106 @@ func createDeployer(options *deployerOptions) (deployer.Deployer, error) {
 				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
 			}
 
+			// 请求头以每行一个 "Name: Value" 的格式存储
+			headers := make(map[string]string)
+			for _, line := range strings.Split(access.Headers, "\n") {
+				name, value, ok := strings.Cut(line, ":")
+				if !ok || strings.TrimSpace(name) == "" {
+					continue
+				}
+				headers[strings.TrimSpace(name)] = strings.TrimSpace(value)
+			}
+
 			deployer, err := pWebhook.NewDeployer(&pWebhook.DeployerConfig{
 				WebhookUrl:               access.Url,
 				WebhookData:              maps.GetValueAsString(options.ProviderDeployConfig, "webhookData"),
+				WebhookTemplate:          maps.GetValueAsString(options.ProviderDeployConfig, "webhookTemplate"),
+				ContentType:              maps.GetValueAsString(options.ProviderDeployConfig, "contentType"),
+				Headers:                  headers,
+				HmacSecret:               access.HmacSecret,
+				TlsClientCert:            access.TlsClientCert,
+				TlsClientKey:             access.TlsClientKey,
+				AllowInsecureConnections: access.AllowInsecureConnections,
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeWHMService:
+		{
+			access := domain.AccessConfigForWHM{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pWHMService.NewDeployer(&pWHMService.DeployerConfig{
+				ServerUrl:                access.ServerUrl,
+				Username:                 access.Username,
+				ApiToken:                 access.ApiToken,
 				AllowInsecureConnections: access.AllowInsecureConnections,
+				ServiceTypes: slices.Map(
+					slices.Filter(strings.Split(maps.GetValueOrDefaultAsString(options.ProviderDeployConfig, "serviceTypes", string(pWHMService.SERVICE_TYPE_CPANEL)), ";"), func(s string) bool { return s != "" }),
+					func(s string) pWHMService.ServiceType { return pWHMService.ServiceType(s) },
+				),
+				RestartServices: maps.GetValueOrDefaultAsBool(options.ProviderDeployConfig, "restartServices", true),
+			})
+			return deployer, err
+		}
+
+	case domain.DeployProviderTypeWinRMCertStore, domain.DeployProviderTypeWinRMIIS:
+		{
+			access := domain.AccessConfigForWinRM{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			switch options.Provider {
+			case domain.DeployProviderTypeWinRMCertStore:
+				deployer, err := pWinRMCertStore.NewDeployer(&pWinRMCertStore.DeployerConfig{
+					Host:                     access.Host,
+					Port:                     access.Port,
+					UseHttps:                 access.UseHttps,
+					AuthMethod:               access.AuthMethod,
+					Username:                 access.Username,
+					Password:                 access.Password,
+					AllowInsecureConnections: access.AllowInsecureConnections,
+					FriendlyName:             maps.GetValueAsString(options.ProviderDeployConfig, "friendlyName"),
+					PostCommand:              maps.GetValueAsString(options.ProviderDeployConfig, "postCommand"),
+				})
+				return deployer, err
+
+			case domain.DeployProviderTypeWinRMIIS:
+				deployer, err := pWinRMIIS.NewDeployer(&pWinRMIIS.DeployerConfig{
+					Host:                     access.Host,
+					Port:                     access.Port,
+					UseHttps:                 access.UseHttps,
+					AuthMethod:               access.AuthMethod,
+					Username:                 access.Username,
+					Password:                 access.Password,
+					AllowInsecureConnections: access.AllowInsecureConnections,
+					SiteName:                 maps.GetValueAsString(options.ProviderDeployConfig, "siteName"),
+					BindingHostname:          maps.GetValueAsString(options.ProviderDeployConfig, "bindingHostname"),
+					BindingPort:              maps.GetValueOrDefaultAsInt32(options.ProviderDeployConfig, "bindingPort", 443),
+				})
+				return deployer, err
+
+			default:
+				break
+			}
+		}
+
+	case domain.DeployProviderTypeZooKeeper:
+		{
+			access := domain.AccessConfigForZooKeeper{}
+			if err := maps.Populate(options.ProviderAccessConfig, &access); err != nil {
+				return nil, fmt.Errorf("failed to populate provider access config: %w", err)
+			}
+
+			deployer, err := pZooKeeper.NewDeployer(&pZooKeeper.DeployerConfig{
+				Servers:            access.Servers,
+				Username:           access.Username,
+				Password:           access.Password,
+				PathForCertificate: maps.GetValueAsString(options.ProviderDeployConfig, "pathForCertificate"),
+				PathForPrivateKey:  maps.GetValueAsString(options.ProviderDeployConfig, "pathForPrivateKey"),
 			})
 			return deployer, err
 		}
@@ -0,0 +1,293 @@
+package deployer
+
+import (
+	"embed"
+	"fmt"
+
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	p1PanelConsole "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/1panel-console"
+	p1PanelSite "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/1panel-site"
+	pAkamaiCPS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/akamai-cps"
+	pAliyunALB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-alb"
+	pAliyunCASDeploy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-cas-deploy"
+	pAliyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-cdn"
+	pAliyunCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-clb"
+	pAliyunDCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-dcdn"
+	pAliyunDDoS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-ddos"
+	pAliyunESA "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-esa"
+	pAliyunFC "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-fc"
+	pAliyunLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-live"
+	pAliyunNLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-nlb"
+	pAliyunOSS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-oss"
+	pAliyunVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-vod"
+	pAliyunWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aliyun-waf"
+	pAWSCloudFront "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aws-cloudfront"
+	pAWSELB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aws-elb"
+	pBaiduCloudBLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baiducloud-blb"
+	pBaiduCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baiducloud-cdn"
+	pBaishanCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baishan-cdn"
+	pBaotaPanelConsole "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baotapanel-console"
+	pBaotaPanelSite "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/baotapanel-site"
+	pBytePlusCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/byteplus-cdn"
+	pCacheFly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cachefly"
+	pCdnfly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cdnfly"
+	pCiscoIOSXE "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cisco-iosxe"
+	pCloudflareSaaS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cloudflare-saas"
+	pCloudflareSSL "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cloudflare-ssl"
+	pConsulKV "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/consul-kv"
+	pCPanelSSL "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cpanel-ssl"
+	pDockerSwarm "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/docker-swarm"
+	pDogeCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/dogecloud-cdn"
+	pEdgioApplications "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/edgio-applications"
+	pEtcd "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/etcd"
+	pF5BigIP "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/f5-bigip"
+	pFastly "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/fastly"
+	pFortinetFortiGate "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/fortinet-fortigate"
+	pFTP "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ftp"
+	pGcoreCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcore-cdn"
+	pGCPCertificateManager "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-certificatemanager"
+	pGCPLoadBalancer "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-loadbalancer"
+	pGitLab "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gitlab"
+	pGRPCAgent "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/grpc-agent"
+	pHAProxy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/haproxy"
+	pHeroku "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/heroku"
+	pHuaweiCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-cdn"
+	pHuaweiCloudELB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-elb"
+	pHuaweiCloudWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/huaweicloud-waf"
+	pJDCloudALB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-alb"
+	pJDCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-cdn"
+	pJDCloudLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-live"
+	pJDCloudVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jdcloud-vod"
+	pJenkinsCredential "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jenkins-credential"
+	pK8sHarbor "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-harbor"
+	pK8sIngress "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-ingress"
+	pK8sOpenShiftRoute "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-openshift-route"
+	pK8sSecret "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-secret"
+	pKempLoadMaster "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/kemp-loadmaster"
+	pKeyCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/keycdn"
+	pKSyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ksyun-cdn"
+	pLocal "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/local"
+	pMikrotik "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/mikrotik"
+	pNetlifySite "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/netlify-site"
+	pNomadVariable "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/nomad-variable"
+	pOpenStackOctavia "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/openstack-octavia"
+	pOPNsense "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/opnsense"
+	pOracleCloudCertificates "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-certificates"
+	pOracleCloudLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-lb"
+	pOVHcloudIPLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-iplb"
+	pOVHcloudWebHosting "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-webhosting"
+	pPfSense "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/pfsense"
+	pPlesk "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/plesk"
+	pQiniuCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-cdn"
+	pQiniuKodo "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-kodo"
+	pQiniuPili "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/qiniu-pili"
+	pRancherHarvester "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/rancher-harvester"
+	pRancherSecret "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/rancher-secret"
+	pSafeLine "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/safeline"
+	pSoftEther "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/softether"
+	pSophosFirewall "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/sophos-firewall"
+	pSSH "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh"
+	pSSHHarbor "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-harbor"
+	pSSHMailServer "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-mailserver"
+	pSSHMinIO "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-minio"
+	pTencentCloudAPIGateway "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-apigateway"
+	pTencentCloudCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-cdn"
+	pTencentCloudCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-clb"
+	pTencentCloudCOS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-cos"
+	pTencentCloudCSS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-css"
+	pTencentCloudECDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-ecdn"
+	pTencentCloudEO "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-eo"
+	pTencentCloudSCF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-scf"
+	pTencentCloudSSLDeploy "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-ssl-deploy"
+	pTencentCloudVOD "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-vod"
+	pTencentCloudWAF "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/tencentcloud-waf"
+	pTrueNAS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/truenas"
+	pUCloudUCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ucloud-ucdn"
+	pUCloudUS3 "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ucloud-us3"
+	pUpyunCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/upyun-cdn"
+	pVault "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vault"
+	pVercelProject "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vercel-project"
+	pVolcEngineCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-cdn"
+	pVolcEngineCLB "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-clb"
+	pVolcEngineDCDN "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-dcdn"
+	pVolcEngineImageX "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-imagex"
+	pVolcEngineLive "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-live"
+	pVolcEngineTOS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/volcengine-tos"
+	pWebhook "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/webhook"
+	pWHMService "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/whm-service"
+	pWinRMCertStore "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/winrm-certstore"
+	pWinRMIIS "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/winrm-iis"
+	pZooKeeper "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/zookeeper"
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
+)
+
+/*
+部署目标提供商的描述信息。
+
+	注意：如果追加新的部署目标提供商，请同时在此处注册，并保持以 ASCII 排序。
+	NOTICE: If you add new deploy provider, please register it here too and keep ASCII order.
+*/
+var providerDescriptors = []*domain.ProviderDescriptor{
+	newProviderDescriptor(domain.DeployProviderType1PanelConsole, domain.AccessProviderType1Panel, (*p1PanelConsole.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderType1PanelSite, domain.AccessProviderType1Panel, (*p1PanelSite.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAkamaiCPS, domain.AccessProviderTypeAkamai, (*pAkamaiCPS.DeployerProvider)(nil), domain.ProviderCapabilityExternalKey),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunALB, domain.AccessProviderTypeAliyun, (*pAliyunALB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunCASDeploy, domain.AccessProviderTypeAliyun, (*pAliyunCASDeploy.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunCDN, domain.AccessProviderTypeAliyun, (*pAliyunCDN.DeployerProvider)(nil), domain.ProviderCapabilityMultipleDomains),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunCLB, domain.AccessProviderTypeAliyun, (*pAliyunCLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunDCDN, domain.AccessProviderTypeAliyun, (*pAliyunDCDN.DeployerProvider)(nil), domain.ProviderCapabilityMultipleDomains),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunDDoS, domain.AccessProviderTypeAliyun, (*pAliyunDDoS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunESA, domain.AccessProviderTypeAliyun, (*pAliyunESA.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunFC, domain.AccessProviderTypeAliyun, (*pAliyunFC.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunLive, domain.AccessProviderTypeAliyun, (*pAliyunLive.DeployerProvider)(nil), domain.ProviderCapabilityMultipleDomains),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunNLB, domain.AccessProviderTypeAliyun, (*pAliyunNLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunOSS, domain.AccessProviderTypeAliyun, (*pAliyunOSS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunVOD, domain.AccessProviderTypeAliyun, (*pAliyunVOD.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAliyunWAF, domain.AccessProviderTypeAliyun, (*pAliyunWAF.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAWSCloudFront, domain.AccessProviderTypeAWS, (*pAWSCloudFront.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeAWSELB, domain.AccessProviderTypeAWS, (*pAWSELB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBaiduCloudBLB, domain.AccessProviderTypeBaiduCloud, (*pBaiduCloudBLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBaiduCloudCDN, domain.AccessProviderTypeBaiduCloud, (*pBaiduCloudCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBaishanCDN, domain.AccessProviderTypeBaishan, (*pBaishanCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBaotaPanelConsole, domain.AccessProviderTypeBaotaPanel, (*pBaotaPanelConsole.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBaotaPanelSite, domain.AccessProviderTypeBaotaPanel, (*pBaotaPanelSite.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeBytePlusCDN, domain.AccessProviderTypeBytePlus, (*pBytePlusCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCacheFly, domain.AccessProviderTypeCacheFly, (*pCacheFly.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCdnfly, domain.AccessProviderTypeCdnfly, (*pCdnfly.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCiscoIOSXE, domain.AccessProviderTypeCisco, (*pCiscoIOSXE.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCloudflareSaaS, domain.AccessProviderTypeCloudflare, (*pCloudflareSaaS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCloudflareSSL, domain.AccessProviderTypeCloudflare, (*pCloudflareSSL.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeConsulKV, domain.AccessProviderTypeConsul, (*pConsulKV.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeCPanelSSL, domain.AccessProviderTypeCPanel, (*pCPanelSSL.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeDockerSwarm, domain.AccessProviderTypeDocker, (*pDockerSwarm.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeDogeCloudCDN, domain.AccessProviderTypeDogeCloud, (*pDogeCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeEdgioApplications, domain.AccessProviderTypeEdgio, (*pEdgioApplications.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeEtcd, domain.AccessProviderTypeEtcd, (*pEtcd.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeF5BigIP, domain.AccessProviderTypeF5, (*pF5BigIP.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeFastly, domain.AccessProviderTypeFastly, (*pFastly.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeFortinetFortiGate, domain.AccessProviderTypeFortinet, (*pFortinetFortiGate.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeFTP, domain.AccessProviderTypeFTP, (*pFTP.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeGcoreCDN, domain.AccessProviderTypeGcore, (*pGcoreCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeGCPCertificateManager, domain.AccessProviderTypeGCP, (*pGCPCertificateManager.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeGCPLoadBalancer, domain.AccessProviderTypeGCP, (*pGCPLoadBalancer.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeGitLab, domain.AccessProviderTypeGitLab, (*pGitLab.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeGRPCAgent, domain.AccessProviderTypeGRPC, (*pGRPCAgent.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeHAProxy, domain.AccessProviderTypeHAProxy, (*pHAProxy.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeHeroku, domain.AccessProviderTypeHeroku, (*pHeroku.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeHuaweiCloudCDN, domain.AccessProviderTypeHuaweiCloud, (*pHuaweiCloudCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeHuaweiCloudELB, domain.AccessProviderTypeHuaweiCloud, (*pHuaweiCloudELB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeHuaweiCloudWAF, domain.AccessProviderTypeHuaweiCloud, (*pHuaweiCloudWAF.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeJDCloudALB, domain.AccessProviderTypeJDCloud, (*pJDCloudALB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeJDCloudCDN, domain.AccessProviderTypeJDCloud, (*pJDCloudCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeJDCloudLive, domain.AccessProviderTypeJDCloud, (*pJDCloudLive.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeJDCloudVOD, domain.AccessProviderTypeJDCloud, (*pJDCloudVOD.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeJenkinsCredential, domain.AccessProviderTypeJenkins, (*pJenkinsCredential.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKempLoadMaster, domain.AccessProviderTypeKemp, (*pKempLoadMaster.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKeyCDN, domain.AccessProviderTypeKeyCDN, (*pKeyCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKSyunCDN, domain.AccessProviderTypeKSyun, (*pKSyunCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKubernetesHarbor, domain.AccessProviderTypeKubernetes, (*pK8sHarbor.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKubernetesIngress, domain.AccessProviderTypeKubernetes, (*pK8sIngress.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKubernetesOpenShiftRoute, domain.AccessProviderTypeKubernetes, (*pK8sOpenShiftRoute.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeKubernetesSecret, domain.AccessProviderTypeKubernetes, (*pK8sSecret.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeLocal, domain.AccessProviderTypeLocal, (*pLocal.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeMikrotik, domain.AccessProviderTypeMikrotik, (*pMikrotik.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeNetlifySite, domain.AccessProviderTypeNetlify, (*pNetlifySite.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeNomadVariable, domain.AccessProviderTypeNomad, (*pNomadVariable.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOpenStackOctavia, domain.AccessProviderTypeOpenStack, (*pOpenStackOctavia.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOPNsense, domain.AccessProviderTypeOPNsense, (*pOPNsense.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOracleCloudCertificates, domain.AccessProviderTypeOracleCloud, (*pOracleCloudCertificates.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOracleCloudLB, domain.AccessProviderTypeOracleCloud, (*pOracleCloudLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOVHcloudIPLB, domain.AccessProviderTypeOVHcloud, (*pOVHcloudIPLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeOVHcloudWebHosting, domain.AccessProviderTypeOVHcloud, (*pOVHcloudWebHosting.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypePfSense, domain.AccessProviderTypePfSense, (*pPfSense.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypePlesk, domain.AccessProviderTypePlesk, (*pPlesk.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeQiniuCDN, domain.AccessProviderTypeQiniu, (*pQiniuCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeQiniuKodo, domain.AccessProviderTypeQiniu, (*pQiniuKodo.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeQiniuPili, domain.AccessProviderTypeQiniu, (*pQiniuPili.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeRancherHarvester, domain.AccessProviderTypeRancher, (*pRancherHarvester.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeRancherSecret, domain.AccessProviderTypeRancher, (*pRancherSecret.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSafeLine, domain.AccessProviderTypeSafeLine, (*pSafeLine.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSoftEther, domain.AccessProviderTypeSoftEther, (*pSoftEther.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSophosFirewall, domain.AccessProviderTypeSophos, (*pSophosFirewall.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSSH, domain.AccessProviderTypeSSH, (*pSSH.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSSHHarbor, domain.AccessProviderTypeSSH, (*pSSHHarbor.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSSHMailServer, domain.AccessProviderTypeSSH, (*pSSHMailServer.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeSSHMinIO, domain.AccessProviderTypeSSH, (*pSSHMinIO.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudAPIGateway, domain.AccessProviderTypeTencentCloud, (*pTencentCloudAPIGateway.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudCDN, domain.AccessProviderTypeTencentCloud, (*pTencentCloudCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudCLB, domain.AccessProviderTypeTencentCloud, (*pTencentCloudCLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudCOS, domain.AccessProviderTypeTencentCloud, (*pTencentCloudCOS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudCSS, domain.AccessProviderTypeTencentCloud, (*pTencentCloudCSS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudECDN, domain.AccessProviderTypeTencentCloud, (*pTencentCloudECDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudEO, domain.AccessProviderTypeTencentCloud, (*pTencentCloudEO.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudSCF, domain.AccessProviderTypeTencentCloud, (*pTencentCloudSCF.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudSSLDeploy, domain.AccessProviderTypeTencentCloud, (*pTencentCloudSSLDeploy.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudVOD, domain.AccessProviderTypeTencentCloud, (*pTencentCloudVOD.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTencentCloudWAF, domain.AccessProviderTypeTencentCloud, (*pTencentCloudWAF.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeTrueNAS, domain.AccessProviderTypeTrueNAS, (*pTrueNAS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeUCloudUCDN, domain.AccessProviderTypeUCloud, (*pUCloudUCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeUCloudUS3, domain.AccessProviderTypeUCloud, (*pUCloudUS3.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeUpyunCDN, domain.AccessProviderTypeUpyun, (*pUpyunCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVault, domain.AccessProviderTypeVault, (*pVault.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVercelProject, domain.AccessProviderTypeVercel, (*pVercelProject.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineCDN, domain.AccessProviderTypeVolcEngine, (*pVolcEngineCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineCLB, domain.AccessProviderTypeVolcEngine, (*pVolcEngineCLB.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineDCDN, domain.AccessProviderTypeVolcEngine, (*pVolcEngineDCDN.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineImageX, domain.AccessProviderTypeVolcEngine, (*pVolcEngineImageX.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineLive, domain.AccessProviderTypeVolcEngine, (*pVolcEngineLive.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeVolcEngineTOS, domain.AccessProviderTypeVolcEngine, (*pVolcEngineTOS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeWebhook, domain.AccessProviderTypeWebhook, (*pWebhook.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeWHMService, domain.AccessProviderTypeWHM, (*pWHMService.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeWinRMCertStore, domain.AccessProviderTypeWinRM, (*pWinRMCertStore.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeWinRMIIS, domain.AccessProviderTypeWinRM, (*pWinRMIIS.DeployerProvider)(nil)),
+	newProviderDescriptor(domain.DeployProviderTypeZooKeeper, domain.AccessProviderTypeZooKeeper, (*pZooKeeper.DeployerProvider)(nil)),
+}
+
+// 各部署目标提供商配置项的 JSON Schema，文件名与提供商类型一致。
+// 仅在此声明在执行前即可确定的校验规则（如必填项），需调用远程接口才能确定的校验仍由部署器在执行时进行。
+// 每个已注册的提供商都必须声明，即使没有任何校验规则；缺失时将在启动时 panic。
+// 部署器内部使用的上传器没有独立的节点配置，其配置项由所属部署器的 JSON Schema 一并声明。
+//
+//go:embed schemas/*.json
+var providerConfigSchemas embed.FS
+
+func newProviderDescriptor(provider domain.DeployProviderType, access domain.AccessProviderType, deployerProvider deployer.Deployer, capabilities ...domain.ProviderCapability) *domain.ProviderDescriptor {
+	capabilities = append([]domain.ProviderCapability{}, capabilities...)
+	if deployer.SupportsDryRun(deployerProvider) {
+		capabilities = append(capabilities, domain.ProviderCapabilityDryRun)
+	}
+
+	return &domain.ProviderDescriptor{
+		Kind:             domain.ProviderKindDeployer,
+		Type:             string(provider),
+		AccessProvider:   access,
+		Capabilities:     capabilities,
+		ConfigJSONSchema: mustLoadProviderConfigJSONSchema(provider),
+	}
+}
+
+func mustLoadProviderConfigJSONSchema(provider domain.DeployProviderType) *jsonschema.Schema {
+	schema, err := jsonschema.ParseFS(providerConfigSchemas, fmt.Sprintf("schemas/%s.json", provider))
+	if err != nil {
+		panic(fmt.Sprintf("failed to load config schema of provider '%s': %v", provider, err))
+	}
+
+	return schema
+}
+
+// 获取全部部署目标提供商的描述信息。
+func GetProviderDescriptors() []*domain.ProviderDescriptor {
+	return providerDescriptors
+}
+
+// 获取指定部署目标提供商的描述信息，未注册时返回 nil。
+func GetProviderDescriptor(provider domain.DeployProviderType) *domain.ProviderDescriptor {
+	for _, descriptor := range providerDescriptors {
+		if descriptor.Type == string(provider) {
+			return descriptor
+		}
+	}
+
+	return nil
+}
@@ -24,6 +24,15 @@ func (a *Access) UnmarshalConfigToMap() (map[string]any, error) {
 	return config, nil
 }
 
+type AccessCredentialModeType string
+
+const (
+	// 使用授权配置中保存的静态凭据（默认）。
+	AccessCredentialModeTypeStatic = AccessCredentialModeType("static")
+	// 从运行环境中获取凭据，如 AWS 实例配置文件或 IRSA、阿里云 ECS 实例 RAM 角色、GCP 应用默认凭据等。
+	AccessCredentialModeTypeEnvironment = AccessCredentialModeType("environment")
+)
+
 type AccessConfigFor1Panel struct {
 	ApiUrl                   string `json:"apiUrl"`
 	ApiKey                   string `json:"apiKey"`
@@ -37,14 +46,27 @@ type AccessConfigForACMEHttpReq struct {
 	Password string `json:"password,omitempty"`
 }
 
+type AccessConfigForAkamai struct {
+	Host         string `json:"host"`
+	ClientToken  string `json:"clientToken"`
+	ClientSecret string `json:"clientSecret"`
+	AccessToken  string `json:"accessToken"`
+}
+
 type AccessConfigForAliyun struct {
-	AccessKeyId     string `json:"accessKeyId"`
-	AccessKeySecret string `json:"accessKeySecret"`
+	CredentialMode  AccessCredentialModeType `json:"credentialMode,omitempty"`
+	AccessKeyId     string                   `json:"accessKeyId"`
+	AccessKeySecret string                   `json:"accessKeySecret"`
+	SecurityToken   string                   `json:"securityToken,omitempty"`
+	EcsRamRoleName  string                   `json:"ecsRamRoleName,omitempty"`
+	RoleArn         string                   `json:"roleArn,omitempty"`
+	RoleSessionName string                   `json:"roleSessionName,omitempty"`
 }
 
 type AccessConfigForAWS struct {
-	AccessKeyId     string `json:"accessKeyId"`
-	SecretAccessKey string `json:"secretAccessKey"`
+	CredentialMode  AccessCredentialModeType `json:"credentialMode,omitempty"`
+	AccessKeyId     string                   `json:"accessKeyId"`
+	SecretAccessKey string                   `json:"secretAccessKey"`
 }
 
 type AccessConfigForAzure struct {
@@ -84,6 +106,13 @@ type AccessConfigForCdnfly struct {
 	ApiSecret string `json:"apiSecret"`
 }
 
+type AccessConfigForCisco struct {
+	Host     string `json:"host"`
+	Port     int32  `json:"port,omitempty"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
 type AccessConfigForCloudflare struct {
 	DnsApiToken string `json:"dnsApiToken"`
 }
@@ -98,11 +127,32 @@ type AccessConfigForCMCCCloud struct {
 	AccessKeySecret string `json:"accessKeySecret"`
 }
 
+type AccessConfigForConsul struct {
+	ServerUrl                string `json:"serverUrl"`
+	Token                    string `json:"token,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForCPanel struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForDNSLA struct {
 	ApiId     string `json:"apiId"`
 	ApiSecret string `json:"apiSecret"`
 }
 
+type AccessConfigForDocker struct {
+	DockerHost               string `json:"dockerHost"`
+	TLSCACertificate         string `json:"tlsCaCertificate,omitempty"`
+	TLSCertificate           string `json:"tlsCertificate,omitempty"`
+	TLSPrivateKey            string `json:"tlsPrivateKey,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForDogeCloud struct {
 	AccessKey string `json:"accessKey"`
 	SecretKey string `json:"secretKey"`
@@ -113,10 +163,55 @@ type AccessConfigForEdgio struct {
 	ClientSecret string `json:"clientSecret"`
 }
 
+type AccessConfigForEtcd struct {
+	Endpoints                string `json:"endpoints"`
+	Username                 string `json:"username,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	UseTLS                   bool   `json:"useTLS,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForF5 struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForFastly struct {
+	ApiToken string `json:"apiToken"`
+}
+
+type AccessConfigForFortinet struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForFTP struct {
+	Host                     string `json:"host"`
+	Port                     int32  `json:"port"`
+	Username                 string `json:"username,omitempty"`
+	Password                 string `json:"password,omitempty"`
+	UseExplicitTLS           bool   `json:"useExplicitTLS,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForGcore struct {
 	ApiToken string `json:"apiToken"`
 }
 
+type AccessConfigForGCP struct {
+	CredentialMode    AccessCredentialModeType `json:"credentialMode,omitempty"`
+	ServiceAccountKey string                   `json:"serviceAccountKey"`
+}
+
+type AccessConfigForGitLab struct {
+	ServerUrl                string `json:"serverUrl,omitempty"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForGname struct {
 	AppId  string `json:"appId"`
 	AppKey string `json:"appKey"`
@@ -127,6 +222,25 @@ type AccessConfigForGoDaddy struct {
 	ApiSecret string `json:"apiSecret"`
 }
 
+type AccessConfigForGRPC struct {
+	Endpoint                 string `json:"endpoint"`
+	UseTLS                   bool   `json:"useTLS,omitempty"`
+	AuthToken                string `json:"authToken,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForHAProxy struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiVersion               string `json:"apiVersion,omitempty"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForHeroku struct {
+	ApiKey string `json:"apiKey"`
+}
+
 type AccessConfigForHuaweiCloud struct {
 	AccessKeyId     string `json:"accessKeyId"`
 	SecretAccessKey string `json:"secretAccessKey"`
@@ -137,12 +251,42 @@ type AccessConfigForJDCloud struct {
 	AccessKeySecret string `json:"accessKeySecret"`
 }
 
+type AccessConfigForJenkins struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForKemp struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForKeyCDN struct {
+	ApiKey string `json:"apiKey"`
+}
+
+type AccessConfigForKSyun struct {
+	AccessKeyId     string `json:"accessKeyId"`
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
 type AccessConfigForKubernetes struct {
 	KubeConfig string `json:"kubeConfig,omitempty"`
 }
 
 type AccessConfigForLocal struct{}
 
+type AccessConfigForMikrotik struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForNamecheap struct {
 	Username string `json:"username"`
 	ApiKey   string `json:"apiKey"`
@@ -157,10 +301,61 @@ type AccessConfigForNameSilo struct {
 	ApiKey string `json:"apiKey"`
 }
 
+type AccessConfigForNetlify struct {
+	ApiToken string `json:"apiToken"`
+}
+
+type AccessConfigForNomad struct {
+	ServerUrl                string `json:"serverUrl"`
+	Token                    string `json:"token,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForNS1 struct {
 	ApiKey string `json:"apiKey"`
 }
 
+type AccessConfigForOpenStack struct {
+	AuthUrl    string `json:"authUrl"`
+	DomainName string `json:"domainName,omitempty"`
+	Username   string `json:"username"`
+	Password   string `json:"password"`
+	ProjectId  string `json:"projectId"`
+}
+
+type AccessConfigForOPNsense struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	ApiSecret                string `json:"apiSecret"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForOracleCloud struct {
+	TenancyId   string `json:"tenancyId"`
+	UserId      string `json:"userId"`
+	Fingerprint string `json:"fingerprint"`
+	PrivateKey  string `json:"privateKey"`
+}
+
+type AccessConfigForOVHcloud struct {
+	Endpoint          string `json:"endpoint"`
+	ApplicationKey    string `json:"applicationKey"`
+	ApplicationSecret string `json:"applicationSecret"`
+	ConsumerKey       string `json:"consumerKey"`
+}
+
+type AccessConfigForPfSense struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForPlesk struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForPowerDNS struct {
 	ApiUrl string `json:"apiUrl"`
 	ApiKey string `json:"apiKey"`
@@ -175,13 +370,42 @@ type AccessConfigForRainYun struct {
 	ApiKey string `json:"apiKey"`
 }
 
+type AccessConfigForRancher struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForSafeLine struct {
 	ApiUrl                   string `json:"apiUrl"`
 	ApiToken                 string `json:"apiToken"`
 	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
 }
 
+type AccessConfigForSoftEther struct {
+	ServerUrl                string `json:"serverUrl"`
+	AdminPassword            string `json:"adminPassword"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForSophos struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForSSH struct {
+	Host          string                         `json:"host"`
+	Port          int32                          `json:"port"`
+	Username      string                         `json:"username"`
+	Password      string                         `json:"password,omitempty"`
+	Key           string                         `json:"key,omitempty"`
+	KeyPassphrase string                         `json:"keyPassphrase,omitempty"`
+	JumpServers   []AccessConfigForSSHJumpServer `json:"jumpServers,omitempty"`
+}
+
+type AccessConfigForSSHJumpServer struct {
 	Host          string `json:"host"`
 	Port          int32  `json:"port"`
 	Username      string `json:"username"`
@@ -195,12 +419,39 @@ type AccessConfigForTencentCloud struct {
 	SecretKey string `json:"secretKey"`
 }
 
+type AccessConfigForTrueNAS struct {
+	ServerUrl                string `json:"serverUrl"`
+	ApiKey                   string `json:"apiKey"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
 type AccessConfigForUCloud struct {
 	PrivateKey string `json:"privateKey"`
 	PublicKey  string `json:"publicKey"`
 	ProjectId  string `json:"projectId,omitempty"`
 }
 
+type AccessConfigForUpyun struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type AccessConfigForVault struct {
+	ServerUrl                string `json:"serverUrl"`
+	Namespace                string `json:"namespace,omitempty"`
+	AuthMethod               string `json:"authMethod"`
+	Token                    string `json:"token,omitempty"`
+	AppRoleMountPath         string `json:"appRoleMountPath,omitempty"`
+	AppRoleRoleId            string `json:"appRoleRoleId,omitempty"`
+	AppRoleSecretId          string `json:"appRoleSecretId,omitempty"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForVercel struct {
+	ApiAccessToken string `json:"apiAccessToken"`
+	TeamId         string `json:"teamId,omitempty"`
+}
+
 type AccessConfigForVolcEngine struct {
 	AccessKeyId     string `json:"accessKeyId"`
 	SecretAccessKey string `json:"secretAccessKey"`
@@ -208,6 +459,10 @@ type AccessConfigForVolcEngine struct {
 
 type AccessConfigForWebhook struct {
 	Url                      string `json:"url"`
+	Headers                  string `json:"headers,omitempty"`
+	HmacSecret               string `json:"hmacSecret,omitempty"`
+	TlsClientCert            string `json:"tlsClientCert,omitempty"`
+	TlsClientKey             string `json:"tlsClientKey,omitempty"`
 	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
 }
 
@@ -215,3 +470,26 @@ type AccessConfigForWestcn struct {
 	Username    string `json:"username"`
 	ApiPassword string `json:"password"`
 }
+
+type AccessConfigForWHM struct {
+	ServerUrl                string `json:"serverUrl"`
+	Username                 string `json:"username"`
+	ApiToken                 string `json:"apiToken"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForWinRM struct {
+	Host                     string `json:"host"`
+	Port                     int32  `json:"port,omitempty"`
+	UseHttps                 bool   `json:"useHttps,omitempty"`
+	AuthMethod               string `json:"authMethod,omitempty"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	AllowInsecureConnections bool   `json:"allowInsecureConnections,omitempty"`
+}
+
+type AccessConfigForZooKeeper struct {
+	Servers  string `json:"servers"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
@@ -32,6 +32,7 @@ type Certificate struct {
 	WorkflowNodeId    string                      `json:"workflowNodeId" db:"workflowNodeId"`
 	WorkflowRunId     string                      `json:"workflowRunId" db:"workflowRunId"`
 	WorkflowOutputId  string                      `json:"workflowOutputId" db:"workflowOutputId"`
+	Archived          bool                        `json:"archived" db:"archived"`
 	DeletedAt         *time.Time                  `json:"deleted" db:"deleted"`
 }
 
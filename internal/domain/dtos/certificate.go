@@ -1,5 +1,35 @@
 ﻿package dtos
 
+import "github.com/usual2970/certimate/internal/domain"
+
+type CertificateListReq struct {
+	Keyword    string `json:"keyword"`
+	State      string `json:"state"`
+	WorkflowId string `json:"workflowId"`
+	Sort       string `json:"sort"`
+	Cursor     string `json:"cursor"`
+	Limit      int    `json:"limit"`
+}
+
+type CertificateListResp = domain.CursorPage[*domain.Certificate]
+
+type CertificateBatchDeleteReq struct {
+	CertificateIds []string `json:"certificateIds"`
+}
+
+type CertificateBatchDeleteResp struct {
+	DeletedCount int `json:"deletedCount"`
+}
+
+type CertificateBatchUpdateReq struct {
+	CertificateIds []string `json:"certificateIds"`
+	Archived       *bool    `json:"archived,omitempty"`
+}
+
+type CertificateBatchUpdateResp struct {
+	UpdatedCount int `json:"updatedCount"`
+}
+
 type CertificateArchiveFileReq struct {
 	CertificateId string `json:"-"`
 	Format        string `json:"format"`
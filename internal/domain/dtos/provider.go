@@ -0,0 +1,17 @@
+﻿package dtos
+
+import "github.com/usual2970/certimate/internal/domain"
+
+type ProviderListReq struct {
+	Kind       domain.ProviderKind       `json:"kind"`
+	Capability domain.ProviderCapability `json:"capability"`
+}
+
+type ProviderListResp struct {
+	Items []*domain.ProviderDescriptor `json:"items"`
+}
+
+type ProviderGetReq struct {
+	Kind domain.ProviderKind `json:"-"`
+	Type string              `json:"-"`
+}
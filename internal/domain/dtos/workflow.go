@@ -3,11 +3,52 @@
 import "github.com/usual2970/certimate/internal/domain"
 
 type WorkflowStartRunReq struct {
-	WorkflowId string                     `json:"-"`
-	RunTrigger domain.WorkflowTriggerType `json:"trigger"`
+	WorkflowId     string                     `json:"-"`
+	RunTrigger     domain.WorkflowTriggerType `json:"trigger"`
+	IdempotencyKey string                     `json:"idempotencyKey"`
+	Staging        bool                       `json:"staging"`
+}
+
+type WorkflowStartRunResp struct {
+	RunId string `json:"runId"`
+}
+
+type WorkflowPromoteRunReq struct {
+	WorkflowId string `json:"-"`
+	RunId      string `json:"-"`
 }
 
 type WorkflowCancelRunReq struct {
 	WorkflowId string `json:"-"`
 	RunId      string `json:"-"`
 }
+
+type WorkflowRollbackNodeReq struct {
+	WorkflowId string `json:"-"`
+	NodeId     string `json:"-"`
+}
+
+type WorkflowRollbackNodeResp struct {
+	RunId string                 `json:"runId"`
+	Log   *domain.WorkflowRunLog `json:"log"`
+}
+
+type WorkflowListRunsReq struct {
+	WorkflowId string                       `json:"-"`
+	Status     domain.WorkflowRunStatusType `json:"status"`
+	Trigger    domain.WorkflowTriggerType   `json:"trigger"`
+	Sort       string                       `json:"sort"`
+	Cursor     string                       `json:"cursor"`
+	Limit      int                          `json:"limit"`
+}
+
+type WorkflowListRunsResp = domain.CursorPage[*domain.WorkflowRun]
+
+type WorkflowBatchDeleteRunsReq struct {
+	WorkflowId string   `json:"-"`
+	RunIds     []string `json:"runIds"`
+}
+
+type WorkflowBatchDeleteRunsResp struct {
+	DeletedCount int `json:"deletedCount"`
+}
@@ -0,0 +1,7 @@
+package domain
+
+// 表示基于游标的分页查询结果。
+type CursorPage[T any] struct {
+	Items      []T    `json:"items"`
+	NextCursor string `json:"nextCursor,omitempty"`
+}
@@ -11,7 +11,7 @@ type AccessProviderType string
 const (
 	AccessProviderType1Panel       = AccessProviderType("1panel")
 	AccessProviderTypeACMEHttpReq  = AccessProviderType("acmehttpreq")
-	AccessProviderTypeAkamai       = AccessProviderType("akamai") // Akamai（预留）
+	AccessProviderTypeAkamai       = AccessProviderType("akamai")
 	AccessProviderTypeAliyun       = AccessProviderType("aliyun")
 	AccessProviderTypeAWS          = AccessProviderType("aws")
 	AccessProviderTypeAzure        = AccessProviderType("azure")
@@ -21,38 +21,74 @@ const (
 	AccessProviderTypeBytePlus     = AccessProviderType("byteplus")
 	AccessProviderTypeCacheFly     = AccessProviderType("cachefly")
 	AccessProviderTypeCdnfly       = AccessProviderType("cdnfly")
+	AccessProviderTypeCisco        = AccessProviderType("cisco")
 	AccessProviderTypeCloudflare   = AccessProviderType("cloudflare")
 	AccessProviderTypeClouDNS      = AccessProviderType("cloudns")
 	AccessProviderTypeCMCCCloud    = AccessProviderType("cmcccloud")
+	AccessProviderTypeConsul       = AccessProviderType("consul")
+	AccessProviderTypeCPanel       = AccessProviderType("cpanel")
 	AccessProviderTypeCTCCCloud    = AccessProviderType("ctcccloud") // 联通云（预留）
 	AccessProviderTypeCUCCCloud    = AccessProviderType("cucccloud") // 天翼云（预留）
 	AccessProviderTypeDNSLA        = AccessProviderType("dnsla")
+	AccessProviderTypeDocker       = AccessProviderType("docker")
 	AccessProviderTypeDogeCloud    = AccessProviderType("dogecloud")
 	AccessProviderTypeEdgio        = AccessProviderType("edgio")
-	AccessProviderTypeFastly       = AccessProviderType("fastly") // Fastly（预留）
+	AccessProviderTypeEtcd         = AccessProviderType("etcd")
+	AccessProviderTypeF5           = AccessProviderType("f5")
+	AccessProviderTypeFastly       = AccessProviderType("fastly")
+	AccessProviderTypeFortinet     = AccessProviderType("fortinet")
+	AccessProviderTypeFTP          = AccessProviderType("ftp")
 	AccessProviderTypeGname        = AccessProviderType("gname")
 	AccessProviderTypeGcore        = AccessProviderType("gcore")
+	AccessProviderTypeGCP          = AccessProviderType("gcp")
+	AccessProviderTypeGitLab       = AccessProviderType("gitlab")
 	AccessProviderTypeGoDaddy      = AccessProviderType("godaddy")
 	AccessProviderTypeGoEdge       = AccessProviderType("goedge") // GoEdge（预留）
+	AccessProviderTypeGRPC         = AccessProviderType("grpc")
+	AccessProviderTypeHAProxy      = AccessProviderType("haproxy")
+	AccessProviderTypeHeroku       = AccessProviderType("heroku")
 	AccessProviderTypeHuaweiCloud  = AccessProviderType("huaweicloud")
 	AccessProviderTypeJDCloud      = AccessProviderType("jdcloud")
+	AccessProviderTypeJenkins      = AccessProviderType("jenkins")
+	AccessProviderTypeKemp         = AccessProviderType("kemp")
+	AccessProviderTypeKeyCDN       = AccessProviderType("keycdn")
+	AccessProviderTypeKSyun        = AccessProviderType("ksyun")
 	AccessProviderTypeKubernetes   = AccessProviderType("k8s")
 	AccessProviderTypeLocal        = AccessProviderType("local")
+	AccessProviderTypeMikrotik     = AccessProviderType("mikrotik")
 	AccessProviderTypeNamecheap    = AccessProviderType("namecheap")
 	AccessProviderTypeNameDotCom   = AccessProviderType("namedotcom")
 	AccessProviderTypeNameSilo     = AccessProviderType("namesilo")
+	AccessProviderTypeNetlify      = AccessProviderType("netlify")
+	AccessProviderTypeNomad        = AccessProviderType("nomad")
 	AccessProviderTypeNS1          = AccessProviderType("ns1")
+	AccessProviderTypeOpenStack    = AccessProviderType("openstack")
+	AccessProviderTypeOPNsense     = AccessProviderType("opnsense")
+	AccessProviderTypeOracleCloud  = AccessProviderType("oraclecloud")
+	AccessProviderTypeOVHcloud     = AccessProviderType("ovhcloud")
+	AccessProviderTypePfSense      = AccessProviderType("pfsense")
+	AccessProviderTypePlesk        = AccessProviderType("plesk")
 	AccessProviderTypePowerDNS     = AccessProviderType("powerdns")
 	AccessProviderTypeQiniu        = AccessProviderType("qiniu")
 	AccessProviderTypeQingCloud    = AccessProviderType("qingcloud") // 青云（预留）
 	AccessProviderTypeRainYun      = AccessProviderType("rainyun")
+	AccessProviderTypeRancher      = AccessProviderType("rancher")
 	AccessProviderTypeSafeLine     = AccessProviderType("safeline")
+	AccessProviderTypeSoftEther    = AccessProviderType("softether")
+	AccessProviderTypeSophos       = AccessProviderType("sophos")
 	AccessProviderTypeSSH          = AccessProviderType("ssh")
 	AccessProviderTypeTencentCloud = AccessProviderType("tencentcloud")
+	AccessProviderTypeTrueNAS      = AccessProviderType("truenas")
 	AccessProviderTypeUCloud       = AccessProviderType("ucloud")
+	AccessProviderTypeUpyun        = AccessProviderType("upyun")
+	AccessProviderTypeVault        = AccessProviderType("vault")
+	AccessProviderTypeVercel       = AccessProviderType("vercel")
 	AccessProviderTypeVolcEngine   = AccessProviderType("volcengine")
 	AccessProviderTypeWebhook      = AccessProviderType("webhook")
 	AccessProviderTypeWestcn       = AccessProviderType("westcn")
+	AccessProviderTypeWHM          = AccessProviderType("whm")
+	AccessProviderTypeWinRM        = AccessProviderType("winrm")
+	AccessProviderTypeZooKeeper    = AccessProviderType("zookeeper")
 )
 
 type ApplyDNSProviderType string
@@ -98,6 +134,20 @@ const (
 	ApplyDNSProviderTypeWestcn          = ApplyDNSProviderType("westcn")
 )
 
+type ApplyCSRProviderType string
+
+/*
+申请证书外部 CSR 提供商常量值。
+外部 CSR 提供商自行生成并保管私钥，申请时将基于其生成的 CSR 签发证书，所签发的证书不含私钥。
+短横线前的部分始终等于授权提供商类型。
+
+	注意：如果追加新的常量值，请保持以 ASCII 排序。
+	NOTICE: If you add new constant, please keep ASCII order.
+*/
+const (
+	ApplyCSRProviderTypeAkamaiCPS = ApplyCSRProviderType("akamai-cps")
+)
+
 type DeployProviderType string
 
 /*
@@ -108,61 +158,117 @@ type DeployProviderType string
 	NOTICE: If you add new constant, please keep ASCII order.
 */
 const (
-	DeployProviderType1PanelConsole         = DeployProviderType("1panel-console")
-	DeployProviderType1PanelSite            = DeployProviderType("1panel-site")
-	DeployProviderTypeAliyunALB             = DeployProviderType("aliyun-alb")
-	DeployProviderTypeAliyunCASDeploy       = DeployProviderType("aliyun-casdeploy")
-	DeployProviderTypeAliyunCDN             = DeployProviderType("aliyun-cdn")
-	DeployProviderTypeAliyunCLB             = DeployProviderType("aliyun-clb")
-	DeployProviderTypeAliyunDCDN            = DeployProviderType("aliyun-dcdn")
-	DeployProviderTypeAliyunESA             = DeployProviderType("aliyun-esa")
-	DeployProviderTypeAliyunFC              = DeployProviderType("aliyun-fc")
-	DeployProviderTypeAliyunLive            = DeployProviderType("aliyun-live")
-	DeployProviderTypeAliyunNLB             = DeployProviderType("aliyun-nlb")
-	DeployProviderTypeAliyunOSS             = DeployProviderType("aliyun-oss")
-	DeployProviderTypeAliyunVOD             = DeployProviderType("aliyun-vod")
-	DeployProviderTypeAliyunWAF             = DeployProviderType("aliyun-waf")
-	DeployProviderTypeAWSCloudFront         = DeployProviderType("aws-cloudfront")
-	DeployProviderTypeBaiduCloudCDN         = DeployProviderType("baiducloud-cdn")
-	DeployProviderTypeBaishanCDN            = DeployProviderType("baishan-cdn")
-	DeployProviderTypeBaotaPanelConsole     = DeployProviderType("baotapanel-console")
-	DeployProviderTypeBaotaPanelSite        = DeployProviderType("baotapanel-site")
-	DeployProviderTypeBytePlusCDN           = DeployProviderType("byteplus-cdn")
-	DeployProviderTypeCacheFly              = DeployProviderType("cachefly")
-	DeployProviderTypeCdnfly                = DeployProviderType("cdnfly")
-	DeployProviderTypeDogeCloudCDN          = DeployProviderType("dogecloud-cdn")
-	DeployProviderTypeEdgioApplications     = DeployProviderType("edgio-applications")
-	DeployProviderTypeGcoreCDN              = DeployProviderType("gcore-cdn")
-	DeployProviderTypeHuaweiCloudCDN        = DeployProviderType("huaweicloud-cdn")
-	DeployProviderTypeHuaweiCloudELB        = DeployProviderType("huaweicloud-elb")
-	DeployProviderTypeHuaweiCloudWAF        = DeployProviderType("huaweicloud-waf")
-	DeployProviderTypeJDCloudALB            = DeployProviderType("jdcloud-alb")
-	DeployProviderTypeJDCloudCDN            = DeployProviderType("jdcloud-cdn")
-	DeployProviderTypeJDCloudLive           = DeployProviderType("jdcloud-live")
-	DeployProviderTypeJDCloudVOD            = DeployProviderType("jdcloud-vod")
-	DeployProviderTypeKubernetesSecret      = DeployProviderType("k8s-secret")
-	DeployProviderTypeLocal                 = DeployProviderType("local")
-	DeployProviderTypeQiniuCDN              = DeployProviderType("qiniu-cdn")
-	DeployProviderTypeQiniuPili             = DeployProviderType("qiniu-pili")
-	DeployProviderTypeSafeLine              = DeployProviderType("safeline")
-	DeployProviderTypeSSH                   = DeployProviderType("ssh")
-	DeployProviderTypeTencentCloudCDN       = DeployProviderType("tencentcloud-cdn")
-	DeployProviderTypeTencentCloudCLB       = DeployProviderType("tencentcloud-clb")
-	DeployProviderTypeTencentCloudCOS       = DeployProviderType("tencentcloud-cos")
-	DeployProviderTypeTencentCloudCSS       = DeployProviderType("tencentcloud-css")
-	DeployProviderTypeTencentCloudECDN      = DeployProviderType("tencentcloud-ecdn")
-	DeployProviderTypeTencentCloudEO        = DeployProviderType("tencentcloud-eo")
-	DeployProviderTypeTencentCloudSCF       = DeployProviderType("tencentcloud-scf")
-	DeployProviderTypeTencentCloudSSLDeploy = DeployProviderType("tencentcloud-ssldeploy")
-	DeployProviderTypeTencentCloudVOD       = DeployProviderType("tencentcloud-vod")
-	DeployProviderTypeTencentCloudWAF       = DeployProviderType("tencentcloud-waf")
-	DeployProviderTypeUCloudUCDN            = DeployProviderType("ucloud-ucdn")
-	DeployProviderTypeUCloudUS3             = DeployProviderType("ucloud-us3")
-	DeployProviderTypeVolcEngineCDN         = DeployProviderType("volcengine-cdn")
-	DeployProviderTypeVolcEngineCLB         = DeployProviderType("volcengine-clb")
-	DeployProviderTypeVolcEngineDCDN        = DeployProviderType("volcengine-dcdn")
-	DeployProviderTypeVolcEngineImageX      = DeployProviderType("volcengine-imagex")
-	DeployProviderTypeVolcEngineLive        = DeployProviderType("volcengine-live")
-	DeployProviderTypeVolcEngineTOS         = DeployProviderType("volcengine-tos")
-	DeployProviderTypeWebhook               = DeployProviderType("webhook")
+	DeployProviderType1PanelConsole            = DeployProviderType("1panel-console")
+	DeployProviderType1PanelSite               = DeployProviderType("1panel-site")
+	DeployProviderTypeAkamaiCPS                = DeployProviderType("akamai-cps")
+	DeployProviderTypeAliyunALB                = DeployProviderType("aliyun-alb")
+	DeployProviderTypeAliyunCASDeploy          = DeployProviderType("aliyun-casdeploy")
+	DeployProviderTypeAliyunCDN                = DeployProviderType("aliyun-cdn")
+	DeployProviderTypeAliyunCLB                = DeployProviderType("aliyun-clb")
+	DeployProviderTypeAliyunDCDN               = DeployProviderType("aliyun-dcdn")
+	DeployProviderTypeAliyunDDoS               = DeployProviderType("aliyun-ddos")
+	DeployProviderTypeAliyunESA                = DeployProviderType("aliyun-esa")
+	DeployProviderTypeAliyunFC                 = DeployProviderType("aliyun-fc")
+	DeployProviderTypeAliyunLive               = DeployProviderType("aliyun-live")
+	DeployProviderTypeAliyunNLB                = DeployProviderType("aliyun-nlb")
+	DeployProviderTypeAliyunOSS                = DeployProviderType("aliyun-oss")
+	DeployProviderTypeAliyunVOD                = DeployProviderType("aliyun-vod")
+	DeployProviderTypeAliyunWAF                = DeployProviderType("aliyun-waf")
+	DeployProviderTypeAWSCloudFront            = DeployProviderType("aws-cloudfront")
+	DeployProviderTypeAWSELB                   = DeployProviderType("aws-elb")
+	DeployProviderTypeBaiduCloudBLB            = DeployProviderType("baiducloud-blb")
+	DeployProviderTypeBaiduCloudCDN            = DeployProviderType("baiducloud-cdn")
+	DeployProviderTypeBaishanCDN               = DeployProviderType("baishan-cdn")
+	DeployProviderTypeBaotaPanelConsole        = DeployProviderType("baotapanel-console")
+	DeployProviderTypeBaotaPanelSite           = DeployProviderType("baotapanel-site")
+	DeployProviderTypeBytePlusCDN              = DeployProviderType("byteplus-cdn")
+	DeployProviderTypeCacheFly                 = DeployProviderType("cachefly")
+	DeployProviderTypeCdnfly                   = DeployProviderType("cdnfly")
+	DeployProviderTypeCiscoIOSXE               = DeployProviderType("cisco-iosxe")
+	DeployProviderTypeCloudflareSaaS           = DeployProviderType("cloudflare-saas")
+	DeployProviderTypeCloudflareSSL            = DeployProviderType("cloudflare-ssl")
+	DeployProviderTypeConsulKV                 = DeployProviderType("consul-kv")
+	DeployProviderTypeCPanelSSL                = DeployProviderType("cpanel-ssl")
+	DeployProviderTypeDockerSwarm              = DeployProviderType("docker-swarm")
+	DeployProviderTypeDogeCloudCDN             = DeployProviderType("dogecloud-cdn")
+	DeployProviderTypeEdgioApplications        = DeployProviderType("edgio-applications")
+	DeployProviderTypeEtcd                     = DeployProviderType("etcd")
+	DeployProviderTypeF5BigIP                  = DeployProviderType("f5-bigip")
+	DeployProviderTypeFastly                   = DeployProviderType("fastly")
+	DeployProviderTypeFortinetFortiGate        = DeployProviderType("fortinet-fortigate")
+	DeployProviderTypeFTP                      = DeployProviderType("ftp")
+	DeployProviderTypeGcoreCDN                 = DeployProviderType("gcore-cdn")
+	DeployProviderTypeGCPCertificateManager    = DeployProviderType("gcp-certificatemanager")
+	DeployProviderTypeGCPLoadBalancer          = DeployProviderType("gcp-loadbalancer")
+	DeployProviderTypeGitLab                   = DeployProviderType("gitlab")
+	DeployProviderTypeGRPCAgent                = DeployProviderType("grpc-agent")
+	DeployProviderTypeHAProxy                  = DeployProviderType("haproxy")
+	DeployProviderTypeHeroku                   = DeployProviderType("heroku")
+	DeployProviderTypeHuaweiCloudCDN           = DeployProviderType("huaweicloud-cdn")
+	DeployProviderTypeHuaweiCloudELB           = DeployProviderType("huaweicloud-elb")
+	DeployProviderTypeHuaweiCloudWAF           = DeployProviderType("huaweicloud-waf")
+	DeployProviderTypeJDCloudALB               = DeployProviderType("jdcloud-alb")
+	DeployProviderTypeJDCloudCDN               = DeployProviderType("jdcloud-cdn")
+	DeployProviderTypeJDCloudLive              = DeployProviderType("jdcloud-live")
+	DeployProviderTypeJDCloudVOD               = DeployProviderType("jdcloud-vod")
+	DeployProviderTypeJenkinsCredential        = DeployProviderType("jenkins-credential")
+	DeployProviderTypeKempLoadMaster           = DeployProviderType("kemp-loadmaster")
+	DeployProviderTypeKeyCDN                   = DeployProviderType("keycdn")
+	DeployProviderTypeKSyunCDN                 = DeployProviderType("ksyun-cdn")
+	DeployProviderTypeKubernetesHarbor         = DeployProviderType("k8s-harbor")
+	DeployProviderTypeKubernetesIngress        = DeployProviderType("k8s-ingress")
+	DeployProviderTypeKubernetesOpenShiftRoute = DeployProviderType("k8s-openshift-route")
+	DeployProviderTypeKubernetesSecret         = DeployProviderType("k8s-secret")
+	DeployProviderTypeLocal                    = DeployProviderType("local")
+	DeployProviderTypeMikrotik                 = DeployProviderType("mikrotik")
+	DeployProviderTypeNetlifySite              = DeployProviderType("netlify-site")
+	DeployProviderTypeNomadVariable            = DeployProviderType("nomad-variable")
+	DeployProviderTypeOpenStackOctavia         = DeployProviderType("openstack-octavia")
+	DeployProviderTypeOPNsense                 = DeployProviderType("opnsense")
+	DeployProviderTypeOracleCloudCertificates  = DeployProviderType("oraclecloud-certificates")
+	DeployProviderTypeOracleCloudLB            = DeployProviderType("oraclecloud-lb")
+	DeployProviderTypeOVHcloudIPLB             = DeployProviderType("ovhcloud-iplb")
+	DeployProviderTypeOVHcloudWebHosting       = DeployProviderType("ovhcloud-webhosting")
+	DeployProviderTypePfSense                  = DeployProviderType("pfsense")
+	DeployProviderTypePlesk                    = DeployProviderType("plesk")
+	DeployProviderTypeQiniuCDN                 = DeployProviderType("qiniu-cdn")
+	DeployProviderTypeQiniuKodo                = DeployProviderType("qiniu-kodo")
+	DeployProviderTypeQiniuPili                = DeployProviderType("qiniu-pili")
+	DeployProviderTypeRancherHarvester         = DeployProviderType("rancher-harvester")
+	DeployProviderTypeRancherSecret            = DeployProviderType("rancher-secret")
+	DeployProviderTypeSafeLine                 = DeployProviderType("safeline")
+	DeployProviderTypeSoftEther                = DeployProviderType("softether")
+	DeployProviderTypeSophosFirewall           = DeployProviderType("sophos-firewall")
+	DeployProviderTypeSSH                      = DeployProviderType("ssh")
+	DeployProviderTypeSSHHarbor                = DeployProviderType("ssh-harbor")
+	DeployProviderTypeSSHMailServer            = DeployProviderType("ssh-mailserver")
+	DeployProviderTypeSSHMinIO                 = DeployProviderType("ssh-minio")
+	DeployProviderTypeTencentCloudAPIGateway   = DeployProviderType("tencentcloud-apigateway")
+	DeployProviderTypeTencentCloudCDN          = DeployProviderType("tencentcloud-cdn")
+	DeployProviderTypeTencentCloudCLB          = DeployProviderType("tencentcloud-clb")
+	DeployProviderTypeTencentCloudCOS          = DeployProviderType("tencentcloud-cos")
+	DeployProviderTypeTencentCloudCSS          = DeployProviderType("tencentcloud-css")
+	DeployProviderTypeTencentCloudECDN         = DeployProviderType("tencentcloud-ecdn")
+	DeployProviderTypeTencentCloudEO           = DeployProviderType("tencentcloud-eo")
+	DeployProviderTypeTencentCloudSCF          = DeployProviderType("tencentcloud-scf")
+	DeployProviderTypeTencentCloudSSLDeploy    = DeployProviderType("tencentcloud-ssldeploy")
+	DeployProviderTypeTencentCloudVOD          = DeployProviderType("tencentcloud-vod")
+	DeployProviderTypeTencentCloudWAF          = DeployProviderType("tencentcloud-waf")
+	DeployProviderTypeTrueNAS                  = DeployProviderType("truenas")
+	DeployProviderTypeUCloudUCDN               = DeployProviderType("ucloud-ucdn")
+	DeployProviderTypeUCloudUS3                = DeployProviderType("ucloud-us3")
+	DeployProviderTypeUpyunCDN                 = DeployProviderType("upyun-cdn")
+	DeployProviderTypeVault                    = DeployProviderType("vault")
+	DeployProviderTypeVercelProject            = DeployProviderType("vercel-project")
+	DeployProviderTypeVolcEngineCDN            = DeployProviderType("volcengine-cdn")
+	DeployProviderTypeVolcEngineCLB            = DeployProviderType("volcengine-clb")
+	DeployProviderTypeVolcEngineDCDN           = DeployProviderType("volcengine-dcdn")
+	DeployProviderTypeVolcEngineImageX         = DeployProviderType("volcengine-imagex")
+	DeployProviderTypeVolcEngineLive           = DeployProviderType("volcengine-live")
+	DeployProviderTypeVolcEngineTOS            = DeployProviderType("volcengine-tos")
+	DeployProviderTypeWebhook                  = DeployProviderType("webhook")
+	DeployProviderTypeWHMService               = DeployProviderType("whm-service")
+	DeployProviderTypeWinRMCertStore           = DeployProviderType("winrm-certstore")
+	DeployProviderTypeWinRMIIS                 = DeployProviderType("winrm-iis")
+	DeployProviderTypeZooKeeper                = DeployProviderType("zookeeper")
 )
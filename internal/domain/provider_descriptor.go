@@ -0,0 +1,62 @@
+package domain
+
+import (
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
+)
+
+type ProviderKind string
+
+const (
+	ProviderKindApplicant = ProviderKind("applicant")
+	ProviderKindDeployer  = ProviderKind("deployer")
+	ProviderKindNotifier  = ProviderKind("notifier")
+)
+
+type ProviderCapability string
+
+const (
+	ProviderCapabilityWildcard        = ProviderCapability("wildcard")         // 支持泛域名
+	ProviderCapabilityMultipleDomains = ProviderCapability("multiple_domains") // 支持一次处理多个域名
+	ProviderCapabilityDryRun          = ProviderCapability("dry_run")          // 支持仅校验模式
+	ProviderCapabilityExternalKey     = ProviderCapability("external_key")     // 私钥由提供商自行保管，部署时无需私钥
+)
+
+// 表示提供商的描述信息，包括其所需的授权类型、能力及配置项的 JSON Schema。
+type ProviderDescriptor struct {
+	Kind             ProviderKind         `json:"kind"`
+	Type             string               `json:"type"`
+	AccessProvider   AccessProviderType   `json:"accessProvider,omitempty"`
+	Capabilities     []ProviderCapability `json:"capabilities"`
+	ConfigJSONSchema *jsonschema.Schema   `json:"configJsonSchema,omitempty"` // 工作流节点中提供商配置项的 JSON Schema，未声明时为 nil
+}
+
+// 判断提供商是否具备指定能力。
+func (d *ProviderDescriptor) HasCapability(capability ProviderCapability) bool {
+	for _, c := range d.Capabilities {
+		if c == capability {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 校验工作流节点中的提供商配置项。
+// 提供商未声明配置项的 JSON Schema 时总是返回 nil。
+//
+// 入参：
+//   - config：提供商配置项。
+//
+// 出参：
+//   - 错误。校验失败时返回 [*jsonschema.ValidationError]。
+func (d *ProviderDescriptor) ValidateConfig(config map[string]any) error {
+	if d.ConfigJSONSchema == nil {
+		return nil
+	}
+
+	if config == nil {
+		config = make(map[string]any)
+	}
+
+	return d.ConfigJSONSchema.Validate(config)
+}
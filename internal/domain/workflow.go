@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/usual2970/certimate/internal/pkg/utils/maps"
@@ -62,19 +63,22 @@ type WorkflowNode struct {
 }
 
 type WorkflowNodeConfigForApply struct {
-	Domains               string         `json:"domains"`               // 域名列表，以半角分号分隔
-	ContactEmail          string         `json:"contactEmail"`          // 联系邮箱
-	ChallengeType         string         `json:"challengeType"`         // TODO: 验证方式。目前仅支持 dns-01
-	Provider              string         `json:"provider"`              // DNS 提供商
-	ProviderAccessId      string         `json:"providerAccessId"`      // DNS 提供商授权记录 ID
-	ProviderConfig        map[string]any `json:"providerConfig"`        // DNS 提供商额外配置
-	KeyAlgorithm          string         `json:"keyAlgorithm"`          // 密钥算法
-	Nameservers           string         `json:"nameservers"`           // DNS 服务器列表，以半角分号分隔
-	DnsPropagationTimeout int32          `json:"dnsPropagationTimeout"` // DNS 传播超时时间（零值取决于提供商的默认值）
-	DnsTTL                int32          `json:"dnsTTL"`                // DNS TTL（零值取决于提供商的默认值）
-	DisableFollowCNAME    bool           `json:"disableFollowCNAME"`    // 是否关闭 CNAME 跟随
-	DisableARI            bool           `json:"disableARI"`            // 是否关闭 ARI
-	SkipBeforeExpiryDays  int32          `json:"skipBeforeExpiryDays"`  // 证书到期前多少天前跳过续期（零值将使用默认值 30）
+	Domains               string         `json:"domains"`                       // 域名列表，以半角分号分隔
+	ContactEmail          string         `json:"contactEmail"`                  // 联系邮箱
+	ChallengeType         string         `json:"challengeType"`                 // TODO: 验证方式。目前仅支持 dns-01
+	Provider              string         `json:"provider"`                      // DNS 提供商
+	ProviderAccessId      string         `json:"providerAccessId"`              // DNS 提供商授权记录 ID
+	ProviderConfig        map[string]any `json:"providerConfig"`                // DNS 提供商额外配置
+	KeyAlgorithm          string         `json:"keyAlgorithm"`                  // 密钥算法
+	Nameservers           string         `json:"nameservers"`                   // DNS 服务器列表，以半角分号分隔
+	DnsPropagationTimeout int32          `json:"dnsPropagationTimeout"`         // DNS 传播超时时间（零值取决于提供商的默认值）
+	DnsTTL                int32          `json:"dnsTTL"`                        // DNS TTL（零值取决于提供商的默认值）
+	DisableFollowCNAME    bool           `json:"disableFollowCNAME"`            // 是否关闭 CNAME 跟随
+	DisableARI            bool           `json:"disableARI"`                    // 是否关闭 ARI
+	SkipBeforeExpiryDays  int32          `json:"skipBeforeExpiryDays"`          // 证书到期前多少天前跳过续期（零值将使用默认值 30）
+	CSRProvider           string         `json:"csrProvider,omitempty"`         // 外部 CSR 提供商（为空时由 Certimate 生成私钥及 CSR）
+	CSRProviderAccessId   string         `json:"csrProviderAccessId,omitempty"` // 外部 CSR 提供商授权记录 ID
+	CSRProviderConfig     map[string]any `json:"csrProviderConfig,omitempty"`   // 外部 CSR 提供商额外配置
 }
 
 type WorkflowNodeConfigForUpload struct {
@@ -84,11 +88,28 @@ type WorkflowNodeConfigForUpload struct {
 }
 
 type WorkflowNodeConfigForDeploy struct {
-	Certificate         string         `json:"certificate"`         // 前序节点输出的证书，形如“${NodeId}#certificate”
-	Provider            string         `json:"provider"`            // 主机提供商
-	ProviderAccessId    string         `json:"providerAccessId"`    // 主机提供商授权记录 ID
-	ProviderConfig      map[string]any `json:"providerConfig"`      // 主机提供商额外配置
-	SkipOnLastSucceeded bool           `json:"skipOnLastSucceeded"` // 上次部署成功时是否跳过
+	Certificate         string                              `json:"certificate"`                   // 前序节点输出的证书，形如“${NodeId}#certificate”
+	Provider            string                              `json:"provider"`                      // 主机提供商
+	ProviderAccessId    string                              `json:"providerAccessId"`              // 主机提供商授权记录 ID
+	ProviderConfig      map[string]any                      `json:"providerConfig"`                // 主机提供商额外配置
+	SkipOnLastSucceeded bool                                `json:"skipOnLastSucceeded"`           // 上次部署成功时是否跳过
+	DryRun              bool                                `json:"dryRun,omitempty"`              // 是否仅校验而不实际部署
+	CleanupCertificates bool                                `json:"cleanupCertificates,omitempty"` // 部署成功后是否清理已被取代的旧证书
+	SkipOnUnchanged     bool                                `json:"skipOnUnchanged,omitempty"`     // 部署目标当前所用证书未发生变化时是否跳过
+	UnchangedCheckAddr  string                              `json:"unchangedCheckAddr,omitempty"`  // 比对当前所用证书时进行 TLS 握手的地址，形如“example.com:443”（为空时通过部署目标查询）
+	BatchTargets        []WorkflowNodeConfigForDeployTarget `json:"batchTargets,omitempty"`        // 批量部署目标列表（为空时仅部署单个目标）
+	BatchConcurrency    int32                               `json:"batchConcurrency,omitempty"`    // 批量部署时的最大并发数（零值将使用默认值）
+	RetryCount          int32                               `json:"retryCount,omitempty"`          // 部署因临时性错误失败时的最大重试次数（零值表示不重试）
+	RetryInterval       int32                               `json:"retryInterval,omitempty"`       // 首次重试前的等待时间，单位为秒（零值将使用默认值）
+}
+
+type WorkflowNodeConfigForDeployTarget struct {
+	Name           string         `json:"name"`           // 部署目标名称
+	ProviderConfig map[string]any `json:"providerConfig"` // 主机提供商额外配置（将覆盖部署节点的同名配置项）
+}
+
+type WorkflowNodeConfigForExecuteFailure struct {
+	Rollback bool `json:"rollback,omitempty"` // 是否回滚执行失败的部署节点，即重新部署失败前所用的证书
 }
 
 type WorkflowNodeConfigForNotify struct {
@@ -119,6 +140,41 @@ func (n *WorkflowNode) getConfigValueAsMap(key string) map[string]any {
 	return make(map[string]any)
 }
 
+func (n *WorkflowNode) getConfigValueAsDeployTargets(key string) []WorkflowNodeConfigForDeployTarget {
+	targets := make([]WorkflowNodeConfigForDeployTarget, 0)
+
+	val, ok := n.Config[key].([]any)
+	if !ok {
+		return targets
+	}
+
+	for _, item := range val {
+		itemMap, ok := item.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		target := WorkflowNodeConfigForDeployTarget{
+			Name:           maps.GetValueAsString(itemMap, "name"),
+			ProviderConfig: make(map[string]any),
+		}
+
+		// 额外配置可能以对象或 JSON 字符串的形式保存
+		switch config := itemMap["providerConfig"].(type) {
+		case map[string]any:
+			target.ProviderConfig = config
+		case string:
+			if config != "" {
+				json.Unmarshal([]byte(config), &target.ProviderConfig)
+			}
+		}
+
+		targets = append(targets, target)
+	}
+
+	return targets
+}
+
 func (n *WorkflowNode) GetConfigForApply() WorkflowNodeConfigForApply {
 	skipBeforeExpiryDays := n.getConfigValueAsInt32("skipBeforeExpiryDays")
 	if skipBeforeExpiryDays == 0 {
@@ -138,6 +194,9 @@ func (n *WorkflowNode) GetConfigForApply() WorkflowNodeConfigForApply {
 		DisableFollowCNAME:    n.getConfigValueAsBool("disableFollowCNAME"),
 		DisableARI:            n.getConfigValueAsBool("disableARI"),
 		SkipBeforeExpiryDays:  skipBeforeExpiryDays,
+		CSRProvider:           n.getConfigValueAsString("csrProvider"),
+		CSRProviderAccessId:   n.getConfigValueAsString("csrProviderAccessId"),
+		CSRProviderConfig:     n.getConfigValueAsMap("csrProviderConfig"),
 	}
 }
 
@@ -156,9 +215,40 @@ func (n *WorkflowNode) GetConfigForDeploy() WorkflowNodeConfigForDeploy {
 		ProviderAccessId:    n.getConfigValueAsString("providerAccessId"),
 		ProviderConfig:      n.getConfigValueAsMap("providerConfig"),
 		SkipOnLastSucceeded: n.getConfigValueAsBool("skipOnLastSucceeded"),
+		DryRun:              n.getConfigValueAsBool("dryRun"),
+		CleanupCertificates: n.getConfigValueAsBool("cleanupCertificates"),
+		SkipOnUnchanged:     n.getConfigValueAsBool("skipOnUnchanged"),
+		UnchangedCheckAddr:  n.getConfigValueAsString("unchangedCheckAddr"),
+		BatchTargets:        n.getConfigValueAsDeployTargets("batchTargets"),
+		BatchConcurrency:    n.getConfigValueAsInt32("batchConcurrency"),
+		RetryCount:          n.getConfigValueAsInt32("retryCount"),
+		RetryInterval:       n.getConfigValueAsInt32("retryInterval"),
+	}
+}
+
+func (n *WorkflowNode) GetConfigForExecuteFailure() WorkflowNodeConfigForExecuteFailure {
+	return WorkflowNodeConfigForExecuteFailure{
+		Rollback: n.getConfigValueAsBool("rollback"),
 	}
 }
 
+// 在以当前节点为起点的工作流中按 ID 查找节点（包括各分支中的节点），未找到时返回 nil。
+func (n *WorkflowNode) FindNodeById(id string) *WorkflowNode {
+	for current := n; current != nil; current = current.Next {
+		if current.Id == id {
+			return current
+		}
+
+		for i := range current.Branches {
+			if found := current.Branches[i].FindNodeById(id); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
 func (n *WorkflowNode) GetConfigForNotify() WorkflowNodeConfigForNotify {
 	return WorkflowNodeConfigForNotify{
 		Channel: n.getConfigValueAsString("channel"),
@@ -182,3 +272,9 @@ type WorkflowNodeIOValueSelector struct {
 }
 
 const WorkflowNodeIONameCertificate string = "certificate"
+
+const WorkflowNodeIONameDeployResult string = "deployResult"
+
+const WorkflowNodeIONameDeployedCertificate string = "deployedCertificate"
+
+const WorkflowNodeIONamePreviousCertificate string = "previousCertificate"
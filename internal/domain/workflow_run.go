@@ -9,13 +9,15 @@ const CollectionNameWorkflowRun = "workflow_run"
 
 type WorkflowRun struct {
 	Meta
-	WorkflowId string                `json:"workflowId" db:"workflowId"`
-	Status     WorkflowRunStatusType `json:"status" db:"status"`
-	Trigger    WorkflowTriggerType   `json:"trigger" db:"trigger"`
-	StartedAt  time.Time             `json:"startedAt" db:"startedAt"`
-	EndedAt    time.Time             `json:"endedAt" db:"endedAt"`
-	Logs       []WorkflowRunLog      `json:"logs" db:"logs"`
-	Error      string                `json:"error" db:"error"`
+	WorkflowId     string                `json:"workflowId" db:"workflowId"`
+	Status         WorkflowRunStatusType `json:"status" db:"status"`
+	Trigger        WorkflowTriggerType   `json:"trigger" db:"trigger"`
+	StartedAt      time.Time             `json:"startedAt" db:"startedAt"`
+	EndedAt        time.Time             `json:"endedAt" db:"endedAt"`
+	Logs           []WorkflowRunLog      `json:"logs" db:"logs"`
+	Error          string                `json:"error" db:"error"`
+	Staging        bool                  `json:"staging" db:"staging"`
+	IdempotencyKey string                `json:"idempotencyKey,omitempty" db:"idempotencyKey"`
 }
 
 type WorkflowRunStatusType string
@@ -29,10 +31,11 @@ const (
 )
 
 type WorkflowRunLog struct {
-	NodeId   string                 `json:"nodeId"`
-	NodeName string                 `json:"nodeName"`
-	Records  []WorkflowRunLogRecord `json:"records"`
-	Error    string                 `json:"error"`
+	NodeId    string                 `json:"nodeId"`
+	NodeName  string                 `json:"nodeName"`
+	Records   []WorkflowRunLogRecord `json:"records"`
+	Error     string                 `json:"error"`
+	Truncated bool                   `json:"truncated,omitempty"`
 }
 
 type WorkflowRunLogRecord struct {
@@ -40,6 +43,7 @@ type WorkflowRunLogRecord struct {
 	Level   WorkflowRunLogLevel `json:"level"`
 	Content string              `json:"content"`
 	Error   string              `json:"error"`
+	Ref     string              `json:"ref,omitempty"` // 超长日志内容转储到外部存储后的引用地址
 }
 
 type WorkflowRunLogLevel string
@@ -0,0 +1,35 @@
+package notify
+
+import (
+	"github.com/usual2970/certimate/internal/domain"
+)
+
+/*
+通知渠道的描述信息。
+
+	注意：如果追加新的通知渠道，请同时在此处注册，并保持以 ASCII 排序。
+	NOTICE: If you add new notify channel, please register it here too and keep ASCII order.
+*/
+var providerDescriptors = []*domain.ProviderDescriptor{
+	newProviderDescriptor(domain.NotifyChannelTypeBark),
+	newProviderDescriptor(domain.NotifyChannelTypeDingTalk),
+	newProviderDescriptor(domain.NotifyChannelTypeEmail),
+	newProviderDescriptor(domain.NotifyChannelTypeLark),
+	newProviderDescriptor(domain.NotifyChannelTypeServerChan),
+	newProviderDescriptor(domain.NotifyChannelTypeTelegram),
+	newProviderDescriptor(domain.NotifyChannelTypeWebhook),
+	newProviderDescriptor(domain.NotifyChannelTypeWeCom),
+}
+
+func newProviderDescriptor(channel domain.NotifyChannelType) *domain.ProviderDescriptor {
+	return &domain.ProviderDescriptor{
+		Kind:         domain.ProviderKindNotifier,
+		Type:         string(channel),
+		Capabilities: []domain.ProviderCapability{},
+	}
+}
+
+// 获取全部通知渠道的描述信息。
+func GetProviderDescriptors() []*domain.ProviderDescriptor {
+	return providerDescriptors
+}
@@ -0,0 +1,142 @@
+package akamaicps
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	akamaisdk "github.com/usual2970/certimate/internal/pkg/vendors/akamai-sdk"
+)
+
+type CSRProviderConfig struct {
+	// Akamai EdgeGrid API 主机名。
+	Host string `json:"host"`
+	// Akamai EdgeGrid API 客户端令牌。
+	ClientToken string `json:"clientToken"`
+	// Akamai EdgeGrid API 客户端密钥。
+	ClientSecret string `json:"clientSecret"`
+	// Akamai EdgeGrid API 访问令牌。
+	AccessToken string `json:"accessToken"`
+	// CPS 证书注册 ID。
+	// 该证书注册须为第三方证书类型，且存在等待上传证书的变更。
+	EnrollmentId int64 `json:"enrollmentId"`
+	// 优先选用的密钥算法，可取值 "RSA"、"ECDSA"。
+	// 选填。仅在变更同时提供多种密钥算法的 CSR 时有效，零值时选用第一个。
+	PreferredKeyAlgorithm string `json:"preferredKeyAlgorithm,omitempty"`
+}
+
+type CSRProvider struct {
+	config    *CSRProviderConfig
+	sdkClient *akamaisdk.Client
+}
+
+const (
+	certificateTypeThirdParty = "third-party"
+
+	allowedInputTypeThirdPartyCertificate = "third-party-certificate"
+)
+
+func NewCSRProvider(config *CSRProviderConfig) (*CSRProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Host, config.ClientToken, config.ClientSecret, config.AccessToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &CSRProvider{
+		config:    config,
+		sdkClient: client,
+	}, nil
+}
+
+// 获取证书注册中等待上传证书的变更所对应的 CSR。
+// 第三方证书模式下私钥由 Akamai 生成并保管，Certimate 仅基于该 CSR 申请证书。
+func (p *CSRProvider) GetCSR(ctx context.Context) (*x509.CertificateRequest, error) {
+	if p.config.EnrollmentId == 0 {
+		return nil, errors.New("config `enrollmentId` is required")
+	}
+
+	// 获取证书注册信息
+	// REF: https://techdocs.akamai.com/cps/reference/get-enrollment
+	getEnrollmentResp, err := p.sdkClient.GetEnrollment(p.config.EnrollmentId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetEnrollment'")
+	} else if getEnrollmentResp.CertificateType != certificateTypeThirdParty {
+		return nil, fmt.Errorf("enrollment #%d is not a third-party enrollment (certificate type: %s)", p.config.EnrollmentId, getEnrollmentResp.CertificateType)
+	} else if len(getEnrollmentResp.PendingChanges) == 0 {
+		return nil, fmt.Errorf("enrollment #%d has no pending change, please start a renewal or modification in Akamai Control Center first", p.config.EnrollmentId)
+	}
+
+	// 获取等待上传证书的变更
+	// REF: https://techdocs.akamai.com/cps/reference/get-change-status
+	changeLocation := getEnrollmentResp.PendingChanges[len(getEnrollmentResp.PendingChanges)-1].Location
+	getChangeStatusResp, err := p.sdkClient.GetChangeStatus(changeLocation)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetChangeStatus'")
+	} else if getChangeStatusResp == nil {
+		return nil, fmt.Errorf("change '%s' not found", changeLocation)
+	}
+
+	var certInput *akamaisdk.ChangeAllowedInput
+	for _, input := range getChangeStatusResp.AllowedInput {
+		if input.Type == allowedInputTypeThirdPartyCertificate {
+			certInput = input
+			break
+		}
+	}
+	if certInput == nil {
+		return nil, fmt.Errorf("change '%s' is not waiting for a third-party certificate", changeLocation)
+	}
+
+	// 获取 CSR
+	// REF: https://techdocs.akamai.com/cps/reference/get-change-third-party-csr
+	getThirdPartyCSRResp, err := p.sdkClient.GetThirdPartyCSR(certInput.Info)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetThirdPartyCSR'")
+	} else if len(getThirdPartyCSRResp.CSRs) == 0 {
+		return nil, fmt.Errorf("change '%s' has no csr", changeLocation)
+	}
+
+	csrPem := getThirdPartyCSRResp.CSRs[0].CSR
+	for _, item := range getThirdPartyCSRResp.CSRs {
+		if strings.EqualFold(item.KeyAlgorithm, p.config.PreferredKeyAlgorithm) {
+			csrPem = item.CSR
+			break
+		}
+	}
+
+	block, _ := pem.Decode([]byte(csrPem))
+	if block == nil {
+		return nil, errors.New("failed to decode csr pem")
+	}
+
+	csr, err := x509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to parse csr")
+	}
+
+	return csr, nil
+}
+
+func createSdkClient(host, clientToken, clientSecret, accessToken string) (*akamaisdk.Client, error) {
+	if host == "" {
+		return nil, errors.New("invalid akamai edgegrid host")
+	}
+
+	if clientToken == "" || clientSecret == "" || accessToken == "" {
+		return nil, errors.New("invalid akamai edgegrid credentials")
+	}
+
+	client := akamaisdk.NewClient(host, clientToken, clientSecret, accessToken).
+		WithTimeout(30 * time.Second)
+	return client, nil
+}
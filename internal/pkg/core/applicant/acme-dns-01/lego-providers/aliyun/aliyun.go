@@ -10,6 +10,7 @@ import (
 type ChallengeProviderConfig struct {
 	AccessKeyId           string `json:"accessKeyId"`
 	AccessKeySecret       string `json:"accessKeySecret"`
+	SecurityToken         string `json:"securityToken,omitempty"`
 	DnsPropagationTimeout int32  `json:"dnsPropagationTimeout,omitempty"`
 	DnsTTL                int32  `json:"dnsTTL,omitempty"`
 }
@@ -22,6 +23,7 @@ func NewChallengeProvider(config *ChallengeProviderConfig) (challenge.Provider,
 	providerConfig := alidns.NewDefaultConfig()
 	providerConfig.APIKey = config.AccessKeyId
 	providerConfig.SecretKey = config.AccessKeySecret
+	providerConfig.SecurityToken = config.SecurityToken
 	if config.DnsPropagationTimeout != 0 {
 		providerConfig.PropagationTimeout = time.Duration(config.DnsPropagationTimeout) * time.Second
 	}
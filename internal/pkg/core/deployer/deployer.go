@@ -1,6 +1,12 @@
 ﻿package deployer
 
-import "context"
+import (
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+)
 
 // 表示定义证书部署器的抽象类型接口。
 // 注意与 `Uploader` 区分，“部署”通常为“上传”的后置操作。
@@ -11,14 +17,111 @@ type Deployer interface {
 	//   - ctx：上下文。
 	//   - certPem：证书 PEM 内容。
 	//   - privkeyPem：私钥 PEM 内容。
+	//   - opts：部署选项，可省略。多个选项将被合并，参见 [MergeOptions]。
 	//
 	// 出参：
 	//   - res：部署结果。
 	//   - err: 错误。
-	Deploy(ctx context.Context, certPem string, privkeyPem string) (res *DeployResult, err error)
+	Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...Options) (res *DeployResult, err error)
 }
 
 // 表示证书部署结果的数据结构。
 type DeployResult struct {
+	// 证书上传到云服务商证书管理服务后的证书 ID。
+	// 部署前无需上传证书时为空。
+	CertId string `json:"certId,omitempty"`
+	// 已部署证书的云资源 ID 列表，如加速域名、监听器 ID 等。
+	ResourceIds []string `json:"resourceIds,omitempty"`
+	// 云服务商接口返回的请求 ID 列表，可用于向云服务商排查问题。
+	RequestIds []string `json:"requestIds,omitempty"`
+	// 部署开始时间。
+	// 由部署框架填充，部署器无需设置。
+	StartedAt time.Time `json:"startedAt"`
+	// 部署完成时间。
+	// 由部署框架填充，部署器无需设置。
+	FinishedAt time.Time `json:"finishedAt"`
+	// 其他扩展数据。
 	ExtendedData map[string]any `json:"extendedData,omitempty"`
 }
+
+// 表示证书部署选项的数据结构。
+// 部署选项通过 [Deployer.Deploy] 的 opts 参数传递。
+type Options struct {
+	// 是否为仅校验模式。
+	// 仅校验模式下，部署器只应执行查询资源、检查权限等只读操作，而不应实际变更任何资源。
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+// 表示支持仅校验模式的部署器。
+// 未实现此接口的部署器在仅校验模式下不会被调用，而是直接视为校验失败。
+type DryRunSupporter interface {
+	// 是否支持仅校验模式。
+	SupportsDryRun() bool
+}
+
+// 表示部署前需先将证书上传到云服务商证书管理服务的部署器。
+// 实现此接口后，可在部署成功后借助其上传器清理旧证书，参见 [uploader.CertificateCleaner]。
+type UploaderHolder interface {
+	// 获取部署器内部使用的证书上传器。
+	GetUploader() uploader.Uploader
+}
+
+// 表示支持查询部署目标当前所用证书的部署器。
+// 实现此接口后，可在部署前比对证书指纹，若未发生变化则跳过部署。
+type DeployedCertificateGetter interface {
+	// 获取部署目标当前所用的证书。
+	//
+	// 入参：
+	//   - ctx：上下文。
+	//
+	// 出参：
+	//   - cert：当前所用的证书。部署目标尚未部署过证书时返回 nil。
+	//   - err: 错误。
+	GetDeployedCertificate(ctx context.Context) (cert *x509.Certificate, err error)
+}
+
+// 合并部署选项。
+// 任一选项启用仅校验模式时，合并结果即为仅校验模式。
+//
+// 入参：
+//   - opts：部署选项。
+//
+// 出参：
+//   - 合并后的部署选项。未传入任何选项时返回零值。
+func MergeOptions(opts ...Options) Options {
+	merged := Options{}
+	for _, opt := range opts {
+		merged.DryRun = merged.DryRun || opt.DryRun
+	}
+
+	return merged
+}
+
+// 判断部署器是否支持仅校验模式。
+//
+// 入参：
+//   - d：部署器。
+//
+// 出参：
+//   - 是否支持。
+func SupportsDryRun(d Deployer) bool {
+	if s, ok := d.(DryRunSupporter); ok {
+		return s.SupportsDryRun()
+	}
+
+	return false
+}
+
+// 获取部署器内部使用的证书上传器。
+//
+// 入参：
+//   - d：部署器。
+//
+// 出参：
+//   - 证书上传器。部署器未实现 [UploaderHolder] 时返回 nil。
+func GetUploader(d Deployer) uploader.Uploader {
+	if h, ok := d.(UploaderHolder); ok {
+		return h.GetUploader()
+	}
+	return nil
+}
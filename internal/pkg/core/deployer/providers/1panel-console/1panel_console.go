@@ -54,7 +54,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 设置面板 SSL 证书
 	updateSystemSSLReq := &opsdk.UpdateSystemSSLRequest{
 		Cert:    certPem,
@@ -6,6 +6,7 @@ import (
 	"errors"
 	"net/url"
 	"strconv"
+	"strings"
 
 	xerrors "github.com/pkg/errors"
 
@@ -24,7 +25,11 @@ type DeployerConfig struct {
 	// 是否允许不安全的连接。
 	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
 	// 网站 ID。
-	WebsiteId int64 `json:"websiteId"`
+	// 选填。零值时将根据 [DeployerConfig.WebsiteDomain] 查找网站。
+	WebsiteId int64 `json:"websiteId,omitempty"`
+	// 网站主域名。
+	// 选填。仅当 [DeployerConfig.WebsiteId] 为零值时有效。
+	WebsiteDomain string `json:"websiteDomain,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -34,7 +39,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -47,8 +55,9 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		ApiUrl: config.ApiUrl,
-		ApiKey: config.ApiKey,
+		ApiUrl:                   config.ApiUrl,
+		ApiKey:                   config.ApiKey,
+		AllowInsecureConnections: config.AllowInsecureConnections,
 	})
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
@@ -67,10 +76,29 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 确定网站 ID
+	websiteId := d.config.WebsiteId
+	if websiteId == 0 {
+		if d.config.WebsiteDomain == "" {
+			return nil, errors.New("config `websiteId` or `websiteDomain` is required")
+		}
+
+		id, err := d.findWebsiteIdByDomain(d.config.WebsiteDomain)
+		if err != nil {
+			return nil, err
+		}
+
+		websiteId = id
+	}
+
 	// 获取网站 HTTPS 配置
 	getHttpsConfReq := &opsdk.GetHttpsConfRequest{
-		WebsiteID: d.config.WebsiteId,
+		WebsiteID: websiteId,
 	}
 	getHttpsConfResp, err := d.sdkClient.GetHttpsConf(getHttpsConfReq)
 	if err != nil {
@@ -79,6 +107,12 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		d.logger.Logt("已获取网站 HTTPS 配置", getHttpsConfResp)
 	}
 
+	// 仅校验模式下只检查接口密钥及网站是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: certificate would be bound to website", websiteId)
+		return &deployer.DeployResult{}, nil
+	}
+
 	// 上传证书到面板
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -87,13 +121,13 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		d.logger.Logt("certificate file uploaded", upres)
 	}
 
-	// 修改网站 HTTPS 配置
+	// 修改网站 HTTPS 配置，未开启 HTTPS 的网站将一并开启
 	certId, _ := strconv.ParseInt(upres.CertId, 10, 64)
 	updateHttpsConfReq := &opsdk.UpdateHttpsConfRequest{
-		WebsiteID:    d.config.WebsiteId,
+		WebsiteID:    websiteId,
 		Type:         "existed",
 		WebsiteSSLID: certId,
-		Enable:       getHttpsConfResp.Data.Enable,
+		Enable:       true,
 		HttpConfig:   getHttpsConfResp.Data.HttpConfig,
 		SSLProtocol:  getHttpsConfResp.Data.SSLProtocol,
 		Algorithm:    getHttpsConfResp.Data.Algorithm,
@@ -103,10 +137,48 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to execute sdk request '1panel.UpdateHttpsConf'")
 	} else {
-		d.logger.Logt("已获取网站 HTTPS 配置", updateHttpsConfResp)
+		d.logger.Logt("已修改网站 HTTPS 配置", updateHttpsConfResp)
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"websiteId": websiteId,
+			"sslId":     certId,
+		},
+	}, nil
+}
+
+func (d *DeployerProvider) findWebsiteIdByDomain(domain string) (int64, error) {
+	searchWebsitePageNumber := int32(1)
+	searchWebsitePageSize := int32(100)
+	for {
+		searchWebsiteReq := &opsdk.SearchWebsiteRequest{
+			Page:     searchWebsitePageNumber,
+			PageSize: searchWebsitePageSize,
+			Name:     domain,
+			OrderBy:  "created_at",
+			Order:    "null",
+		}
+		searchWebsiteResp, err := d.sdkClient.SearchWebsite(searchWebsiteReq)
+		if err != nil {
+			return 0, xerrors.Wrap(err, "failed to execute sdk request '1panel.SearchWebsite'")
+		}
+
+		for _, websiteItem := range searchWebsiteResp.Data.Items {
+			if strings.EqualFold(websiteItem.PrimaryDomain, domain) {
+				d.logger.Logt("已查询到网站", websiteItem)
+				return websiteItem.ID, nil
+			}
+		}
+
+		if len(searchWebsiteResp.Data.Items) < int(searchWebsitePageSize) {
+			break
+		} else {
+			searchWebsitePageNumber++
+		}
 	}
 
-	return &deployer.DeployResult{}, nil
+	return 0, xerrors.Errorf("could not find website with primary domain '%s'", domain)
 }
 
 func createSdkClient(apiUrl, apiKey string, allowInsecure bool) (*opsdk.Client, error) {
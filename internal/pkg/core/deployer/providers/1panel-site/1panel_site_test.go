@@ -17,6 +17,7 @@ var (
 	fApiUrl        string
 	fApiKey        string
 	fWebsiteId     int64
+	fWebsiteDomain string
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	flag.StringVar(&fApiUrl, argsPrefix+"APIURL", "", "")
 	flag.StringVar(&fApiKey, argsPrefix+"APIKEY", "", "")
 	flag.Int64Var(&fWebsiteId, argsPrefix+"WEBSITEID", 0, "")
+	flag.StringVar(&fWebsiteDomain, argsPrefix+"WEBSITEDOMAIN", "", "")
 }
 
 /*
@@ -37,7 +39,8 @@ Shell command to run this test:
 	--CERTIMATE_DEPLOYER_1PANELCONSOLE_INPUTKEYPATH="/path/to/your-input-key.pem" \
 	--CERTIMATE_DEPLOYER_1PANELCONSOLE_APIURL="http://127.0.0.1:20410" \
 	--CERTIMATE_DEPLOYER_1PANELCONSOLE_APIKEY="your-api-key" \
-	--CERTIMATE_DEPLOYER_1PANELCONSOLE_WEBSITEID="your-website-id"
+	--CERTIMATE_DEPLOYER_1PANELCONSOLE_WEBSITEID="your-website-id" \
+	--CERTIMATE_DEPLOYER_1PANELCONSOLE_WEBSITEDOMAIN="example.com"
 */
 func TestDeploy(t *testing.T) {
 	flag.Parse()
@@ -50,12 +53,14 @@ func TestDeploy(t *testing.T) {
 			fmt.Sprintf("APIURL: %v", fApiUrl),
 			fmt.Sprintf("APIKEY: %v", fApiKey),
 			fmt.Sprintf("WEBSITEID: %v", fWebsiteId),
+			fmt.Sprintf("WEBSITEDOMAIN: %v", fWebsiteDomain),
 		}, "\n"))
 
 		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
 			ApiUrl:                   fApiUrl,
 			ApiKey:                   fApiKey,
 			WebsiteId:                fWebsiteId,
+			WebsiteDomain:            fWebsiteDomain,
 			AllowInsecureConnections: true,
 		})
 		if err != nil {
@@ -0,0 +1,317 @@
+package akamaicps
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	akamaisdk "github.com/usual2970/certimate/internal/pkg/vendors/akamai-sdk"
+)
+
+type DeployerConfig struct {
+	// Akamai EdgeGrid API 主机名。
+	Host string `json:"host"`
+	// Akamai EdgeGrid API 客户端令牌。
+	ClientToken string `json:"clientToken"`
+	// Akamai EdgeGrid API 客户端密钥。
+	ClientSecret string `json:"clientSecret"`
+	// Akamai EdgeGrid API 访问令牌。
+	AccessToken string `json:"accessToken"`
+	// CPS 证书注册 ID。
+	// 该证书注册须为第三方证书类型，且存在等待上传证书的变更。
+	EnrollmentId int64 `json:"enrollmentId"`
+	// 是否自动确认部署后校验警告。
+	// 选填。零值时遇到校验警告将停止等待，需在 Akamai Control Center 中手动确认。
+	AcknowledgePostVerificationWarnings bool `json:"acknowledgePostVerificationWarnings,omitempty"`
+	// 是否自动确认变更管理，即确认将已部署到预发布网络的证书部署到生产网络。
+	// 选填。零值时需在 Akamai Control Center 中手动确认。仅在证书注册启用了变更管理时有效。
+	AcknowledgeChangeManagement bool `json:"acknowledgeChangeManagement,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *akamaisdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+const (
+	certificateTypeThirdParty = "third-party"
+
+	allowedInputTypeThirdPartyCertificate    = "third-party-certificate"
+	allowedInputTypePostVerificationWarnings = "post-verification-warnings-acknowledgement"
+	allowedInputTypeChangeManagement         = "change-management"
+
+	changeStateError = "error"
+)
+
+// 上传证书后等待变更推进的最长时间。
+// CPS 校验证书并部署到预发布网络通常需要数十分钟，超时后不视为失败，剩余步骤可在 Akamai Control Center 中继续。
+const changeWaitTimeout = 60 * time.Minute
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Host, config.ClientToken, config.ClientSecret, config.AccessToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.EnrollmentId == 0 {
+		return nil, errors.New("config `enrollmentId` is required")
+	}
+
+	// 第三方证书模式下私钥由 Akamai 生成并保管，只需上传证书及其信任链
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	serverCertX509, err := certs.ParseCertificateFromPEM(serverCertPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to parse certificate")
+	}
+
+	// 获取证书注册信息
+	// REF: https://techdocs.akamai.com/cps/reference/get-enrollment
+	getEnrollmentResp, err := d.sdkClient.GetEnrollment(d.config.EnrollmentId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetEnrollment'")
+	} else if getEnrollmentResp.CertificateType != certificateTypeThirdParty {
+		return nil, fmt.Errorf("enrollment #%d is not a third-party enrollment (certificate type: %s)", d.config.EnrollmentId, getEnrollmentResp.CertificateType)
+	} else if len(getEnrollmentResp.PendingChanges) == 0 {
+		return nil, fmt.Errorf("enrollment #%d has no pending change, please start a renewal or modification in Akamai Control Center first", d.config.EnrollmentId)
+	}
+
+	d.logger.Logt("已获取证书注册信息", getEnrollmentResp)
+
+	// 获取等待上传证书的变更
+	// REF: https://techdocs.akamai.com/cps/reference/get-change-status
+	changeLocation := getEnrollmentResp.PendingChanges[len(getEnrollmentResp.PendingChanges)-1].Location
+	getChangeStatusResp, err := d.sdkClient.GetChangeStatus(changeLocation)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetChangeStatus'")
+	} else if getChangeStatusResp == nil {
+		return nil, fmt.Errorf("change '%s' not found", changeLocation)
+	}
+
+	certInput := findAllowedInput(getChangeStatusResp, allowedInputTypeThirdPartyCertificate)
+	if certInput == nil {
+		return nil, fmt.Errorf("change '%s' is not waiting for a third-party certificate (status: %s)", changeLocation, describeChangeStatus(getChangeStatusResp))
+	}
+
+	// 获取 CSR，并校验证书是否基于该 CSR 签发
+	// REF: https://techdocs.akamai.com/cps/reference/get-change-third-party-csr
+	getThirdPartyCSRResp, err := d.sdkClient.GetThirdPartyCSR(certInput.Info)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.GetThirdPartyCSR'")
+	}
+
+	keyAlgorithm, err := matchThirdPartyCSR(getThirdPartyCSRResp, serverCertX509)
+	if err != nil {
+		return nil, err
+	}
+
+	// 仅校验模式下只检查变更状态及 CSR，不上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: akamai cps change is waiting for this certificate, nothing uploaded", map[string]any{"enrollmentId": d.config.EnrollmentId, "change": changeLocation})
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书及其信任链
+	// REF: https://techdocs.akamai.com/cps/reference/post-change-third-party-cert
+	uploadReq := &akamaisdk.UploadThirdPartyCertAndTrustChainRequest{
+		CertificatesAndTrustChains: []*akamaisdk.CertificateAndTrustChain{
+			{
+				Certificate:  strings.TrimSpace(serverCertPem),
+				KeyAlgorithm: keyAlgorithm,
+				TrustChain:   strings.TrimSpace(interCertPem),
+			},
+		},
+	}
+	uploadResp, err := d.sdkClient.UploadThirdPartyCertAndTrustChain(certInput.Update, uploadReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cps.UploadThirdPartyCertAndTrustChain'")
+	}
+
+	d.logger.Logt("已上传证书", uploadResp)
+
+	if d.config.AcknowledgePostVerificationWarnings || (d.config.AcknowledgeChangeManagement && getEnrollmentResp.ChangeManagement) {
+		if err := d.waitForChange(ctx, changeLocation); err != nil {
+			return nil, err
+		}
+	}
+
+	return &deployer.DeployResult{
+		ResourceIds: []string{fmt.Sprintf("%d", d.config.EnrollmentId)},
+	}, nil
+}
+
+func (d *DeployerProvider) waitForChange(ctx context.Context, changeLocation string) error {
+	// 轮询变更状态，按配置确认部署后校验警告及变更管理，直至变更结束或需要人工处理
+	deadline := time.Now().Add(changeWaitTimeout)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(30 * time.Second):
+		}
+
+		if time.Now().After(deadline) {
+			d.logger.Logt("等待变更超时，请在 Akamai Control Center 中继续处理", changeLocation)
+			return nil
+		}
+
+		getChangeStatusResp, err := d.sdkClient.GetChangeStatus(changeLocation)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'cps.GetChangeStatus'")
+		} else if getChangeStatusResp == nil {
+			d.logger.Logt("变更已完成", changeLocation)
+			return nil
+		}
+
+		if getChangeStatusResp.StatusInfo != nil && getChangeStatusResp.StatusInfo.State == changeStateError {
+			return fmt.Errorf("change '%s' failed: %s", changeLocation, describeChangeStatus(getChangeStatusResp))
+		}
+
+		if input := findAllowedInput(getChangeStatusResp, allowedInputTypePostVerificationWarnings); input != nil {
+			if !d.config.AcknowledgePostVerificationWarnings {
+				d.logger.Logt("变更存在部署后校验警告，请在 Akamai Control Center 中手动确认", changeLocation)
+				return nil
+			}
+
+			// REF: https://techdocs.akamai.com/cps/reference/get-change-post-verification-warnings
+			getWarningsResp, err := d.sdkClient.GetPostVerificationWarnings(input.Info)
+			if err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'cps.GetPostVerificationWarnings'")
+			}
+
+			d.logger.Logt("已获取部署后校验警告", getWarningsResp)
+
+			// REF: https://techdocs.akamai.com/cps/reference/post-change-post-verification-warnings-ack
+			ackResp, err := d.sdkClient.AcknowledgeChangeInput(input.Update)
+			if err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'cps.AcknowledgePostVerificationWarnings'")
+			}
+
+			d.logger.Logt("已确认部署后校验警告", ackResp)
+			continue
+		}
+
+		if input := findAllowedInput(getChangeStatusResp, allowedInputTypeChangeManagement); input != nil {
+			if !d.config.AcknowledgeChangeManagement {
+				d.logger.Logt("证书已部署到预发布网络，请在 Akamai Control Center 中手动确认部署到生产网络", changeLocation)
+				return nil
+			}
+
+			// REF: https://techdocs.akamai.com/cps/reference/post-change-management-ack
+			ackResp, err := d.sdkClient.AcknowledgeChangeInput(input.Update)
+			if err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'cps.AcknowledgeChangeManagement'")
+			}
+
+			d.logger.Logt("已确认变更管理，证书将部署到生产网络", ackResp)
+			return nil
+		}
+
+		d.logger.Logt(fmt.Sprintf("waiting for change '%s' to proceed (status: %s) ...", changeLocation, describeChangeStatus(getChangeStatusResp)))
+	}
+}
+
+func findAllowedInput(change *akamaisdk.ChangeStatus, inputType string) *akamaisdk.ChangeAllowedInput {
+	for _, input := range change.AllowedInput {
+		if input.Type == inputType {
+			return input
+		}
+	}
+
+	return nil
+}
+
+func describeChangeStatus(change *akamaisdk.ChangeStatus) string {
+	if change.StatusInfo == nil {
+		return "unknown"
+	}
+
+	desc := change.StatusInfo.Status
+	if change.StatusInfo.Error != nil && change.StatusInfo.Error.Description != "" {
+		desc += ", " + change.StatusInfo.Error.Description
+	} else if change.StatusInfo.Description != "" {
+		desc += ", " + change.StatusInfo.Description
+	}
+	return desc
+}
+
+// 从变更的 CSR 中找到与证书公钥匹配的一个，并返回其密钥算法。
+// CPS 不接受自行生成的私钥，证书必须基于其生成的 CSR 签发（即申请节点使用 Akamai CPS 外部 CSR），否则上传后会校验失败。
+func matchThirdPartyCSR(csrs *akamaisdk.ThirdPartyCSR, cert *x509.Certificate) (string, error) {
+	certPubkey, ok := cert.PublicKey.(interface{ Equal(crypto.PublicKey) bool })
+	if !ok {
+		return "", errors.New("unsupported certificate public key")
+	}
+
+	for _, item := range csrs.CSRs {
+		block, _ := pem.Decode([]byte(item.CSR))
+		if block == nil {
+			continue
+		}
+
+		csr, err := x509.ParseCertificateRequest(block.Bytes)
+		if err != nil {
+			continue
+		}
+
+		if certPubkey.Equal(csr.PublicKey) {
+			return item.KeyAlgorithm, nil
+		}
+	}
+
+	return "", errors.New("the certificate was not issued against the CSR of the pending change; in third-party mode Akamai keeps the private key, so the certificate must be applied with the Akamai CPS external CSR of the same enrollment")
+}
+
+func createSdkClient(host, clientToken, clientSecret, accessToken string) (*akamaisdk.Client, error) {
+	if host == "" {
+		return nil, errors.New("invalid akamai edgegrid host")
+	}
+
+	if clientToken == "" || clientSecret == "" || accessToken == "" {
+		return nil, errors.New("invalid akamai edgegrid credentials")
+	}
+
+	client := akamaisdk.NewClient(host, clientToken, clientSecret, accessToken).
+		WithTimeout(30 * time.Second)
+	return client, nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -26,6 +27,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 部署资源类型。
@@ -39,6 +43,12 @@ type DeployerConfig struct {
 	// SNI 域名（支持泛域名）。
 	// 部署资源类型为 [RESOURCE_TYPE_LOADBALANCER]、[RESOURCE_TYPE_LISTENER] 时选填。
 	Domain string `json:"domain,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -48,7 +58,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 type wSdkClients struct {
 	alb *aliyunAlb.Client
@@ -60,12 +73,12 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 		panic("config is nil")
 	}
 
-	clients, err := createSdkClients(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	clients, err := createSdkClients(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk clients")
 	}
 
-	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
 	}
@@ -83,7 +96,11 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 上传证书到 CAS
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -108,7 +125,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId string) error {
@@ -194,16 +211,11 @@ func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId
 	if len(listenerIds) == 0 {
 		return errors.New("listener not found")
 	} else {
-		var errs []error
-
-		for _, listenerId := range listenerIds {
-			if err := d.updateListenerCertificate(ctx, listenerId, cloudCertId); err != nil {
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			return errors.Join(errs...)
+		err := concurrent.ForEach(ctx, listenerIds, 0, func(ctx context.Context, listenerId string) error {
+			return d.updateListenerCertificate(ctx, listenerId, cloudCertId)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -253,6 +265,10 @@ func (d *DeployerProvider) updateListenerCertificate(ctx context.Context, cloudL
 		}
 
 		d.logger.Logt("已更新 ALB 监听配置", updateListenerAttributeResp)
+
+		if err := d.waitForAsyncJob(ctx, updateListenerAttributeResp.Body.JobId); err != nil {
+			return err
+		}
 	} else {
 		// 指定 SNI，需部署到扩展域名
 
@@ -359,6 +375,10 @@ func (d *DeployerProvider) updateListenerCertificate(ctx context.Context, cloudL
 			}
 
 			d.logger.Logt("已关联 ALB 监听和扩展证书", associateAdditionalCertificatesFromListenerResp)
+
+			if err := d.waitForAsyncJob(ctx, associateAdditionalCertificatesFromListenerResp.Body.JobId); err != nil {
+				return err
+			}
 		}
 
 		// 解除关联监听和扩展证书
@@ -381,13 +401,55 @@ func (d *DeployerProvider) updateListenerCertificate(ctx context.Context, cloudL
 			}
 
 			d.logger.Logt("已解除关联 ALB 监听和扩展证书", dissociateAdditionalCertificatesFromListenerResp)
+
+			if err := d.waitForAsyncJob(ctx, dissociateAdditionalCertificatesFromListenerResp.Body.JobId); err != nil {
+				return err
+			}
 		}
 	}
 
 	return nil
 }
 
-func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients, error) {
+func (d *DeployerProvider) waitForAsyncJob(ctx context.Context, jobId *string) error {
+	if jobId == nil || *jobId == "" {
+		return nil
+	}
+
+	// 循环查询异步任务详情，等待任务执行完成
+	// 监听在异步任务执行期间处于配置中状态，此时无法再次修改
+	// REF: https://help.aliyun.com/zh/slb/application-load-balancer/developer-reference/api-alb-2020-06-16-listasynjobs
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		listAsynJobsReq := &aliyunAlb.ListAsynJobsRequest{
+			JobIds: []*string{jobId},
+		}
+		listAsynJobsResp, err := d.sdkClients.alb.ListAsynJobs(listAsynJobsReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'alb.ListAsynJobs'")
+		}
+
+		if len(listAsynJobsResp.Body.Jobs) > 0 {
+			job := listAsynJobsResp.Body.Jobs[0]
+			switch tea.StringValue(job.Status) {
+			case "Succeeded":
+				d.logger.Logt("ALB 异步任务已完成", job)
+				return nil
+
+			case "Failed":
+				return fmt.Errorf("alb async job %s failed: %s, %s", *jobId, tea.StringValue(job.ErrorCode), tea.StringValue(job.ErrorMessage))
+			}
+		}
+
+		d.logger.Logt("ALB 异步任务未完成 ...")
+		time.Sleep(time.Second * 5)
+	}
+}
+
+func createSdkClients(accessKeyId, accessKeySecret, securityToken, region string) (*wSdkClients, error) {
 	// 接入点一览 https://api.aliyun.com/product/Alb
 	var albEndpoint string
 	switch region {
@@ -400,6 +462,7 @@ func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients
 	albConfig := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(albEndpoint),
 	}
 	albClient, err := aliyunAlb.NewClient(albConfig)
@@ -419,6 +482,7 @@ func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients
 		Endpoint:        tea.String(casEndpoint),
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 	}
 	casClient, err := aliyunCas.NewClient(casConfig)
 	if err != nil {
@@ -431,7 +495,7 @@ func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients
 	}, nil
 }
 
-func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Uploader, error) {
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
 	casRegion := region
 	if casRegion != "" {
 		// 阿里云 CAS 服务接入点是独立于 ALB 服务的
@@ -445,9 +509,12 @@ func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Up
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     accessKeyId,
-		AccessKeySecret: accessKeySecret,
-		Region:          casRegion,
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           casRegion,
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
 	})
 	return uploader, err
 }
@@ -23,6 +23,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 阿里云云产品资源 ID 数组。
@@ -30,6 +33,10 @@ type DeployerConfig struct {
 	// 阿里云云联系人 ID 数组。
 	// 零值时默认使用账号下第一个联系人。
 	ContactIds []string `json:"contactIds"`
+	// 阿里云资源组 ID（可选）。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -39,19 +46,22 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
-	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
 	}
@@ -69,7 +79,11 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if len(d.config.ResourceIds) == 0 {
 		return nil, errors.New("config `resourceIds` is required")
 	}
@@ -143,10 +157,10 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		time.Sleep(time.Second * 5)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunCas.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunCas.Client, error) {
 	if region == "" {
 		region = "cn-hangzhou" // CAS 服务默认区域：华东一杭州
 	}
@@ -163,6 +177,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunCas.Cl
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(endpoint),
 	}
 
@@ -174,11 +189,14 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunCas.Cl
 	return client, nil
 }
 
-func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Uploader, error) {
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     accessKeyId,
-		AccessKeySecret: accessKeySecret,
-		Region:          region,
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           region,
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
 	})
 	return uploader, err
 }
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -10,9 +11,12 @@ import (
 	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	"github.com/alibabacloud-go/tea/tea"
 	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -20,8 +24,14 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 加速域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 是否部署到证书可覆盖的全部加速域名。
+	// 启用后将额外查询账号下的全部加速域名，并与证书的 SAN 进行匹配。
+	MatchCoveredDomains bool `json:"matchCoveredDomains,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -30,14 +40,17 @@ type DeployerProvider struct {
 	sdkClient *aliyunCdn.Client
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
@@ -54,34 +67,138 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// "*.example.com" → ".example.com"，适配阿里云 CDN 要求的泛域名格式
-	domain := strings.TrimPrefix(d.config.Domain, "*")
-
-	// 设置 CDN 域名域名证书
-	// REF: https://help.aliyun.com/zh/cdn/developer-reference/api-cdn-2018-05-10-setcdndomainsslcertificate
-	setCdnDomainSSLCertificateReq := &aliyunCdn.SetCdnDomainSSLCertificateRequest{
-		DomainName:  tea.String(domain),
-		CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
-		CertType:    tea.String("upload"),
-		SSLProtocol: tea.String("on"),
-		SSLPub:      tea.String(certPem),
-		SSLPri:      tea.String(privkeyPem),
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	domains := make([]string, 0)
+	if d.config.Domain != "" {
+		// "*.example.com" → ".example.com"，适配阿里云 CDN 要求的泛域名格式
+		domains = append(domains, strings.TrimPrefix(d.config.Domain, "*"))
 	}
-	setCdnDomainSSLCertificateResp, err := d.sdkClient.SetCdnDomainSSLCertificate(setCdnDomainSSLCertificateReq)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.SetCdnDomainSSLCertificate'")
+
+	if d.config.MatchCoveredDomains {
+		coveredDomains, err := d.getDomainsCoveredByCertificate(certPem)
+		if err != nil {
+			return nil, err
+		}
+
+		d.logger.Logt("已查询到证书可覆盖的加速域名", coveredDomains)
+
+		for _, domain := range coveredDomains {
+			domain = strings.TrimPrefix(domain, "*")
+			if !slices.Contains(domains, domain) {
+				domains = append(domains, domain)
+			}
+		}
+	}
+
+	if len(domains) == 0 {
+		if d.config.MatchCoveredDomains {
+			return nil, errors.New("no domains covered by the certificate")
+		}
+
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 仅校验模式下只查询域名信息，不实际配置证书
+	if deployer.MergeOptions(opts...).DryRun {
+		if err := d.validateDomains(ctx, domains); err != nil {
+			return nil, err
+		}
+
+		return &deployer.DeployResult{}, nil
 	}
 
-	d.logger.Logt("已设置 CDN 域名证书", setCdnDomainSSLCertificateResp)
+	err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 设置 CDN 域名域名证书
+		// REF: https://help.aliyun.com/zh/cdn/developer-reference/api-cdn-2018-05-10-setcdndomainsslcertificate
+		setCdnDomainSSLCertificateReq := &aliyunCdn.SetCdnDomainSSLCertificateRequest{
+			DomainName:  tea.String(domain),
+			CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
+			CertType:    tea.String("upload"),
+			SSLProtocol: tea.String("on"),
+			SSLPub:      tea.String(certPem),
+			SSLPri:      tea.String(privkeyPem),
+		}
+		setCdnDomainSSLCertificateResp, err := d.sdkClient.SetCdnDomainSSLCertificate(setCdnDomainSSLCertificateReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'cdn.SetCdnDomainSSLCertificate' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已设置 CDN 域名 %s 的证书", domain), setCdnDomainSSLCertificateResp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret string) (*aliyunCdn.Client, error) {
+func (d *DeployerProvider) validateDomains(ctx context.Context, domains []string) error {
+	return concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 获取域名配置信息
+		// REF: https://help.aliyun.com/zh/cdn/developer-reference/api-cdn-2018-05-10-describecdndomaindetail
+		describeCdnDomainDetailReq := &aliyunCdn.DescribeCdnDomainDetailRequest{
+			DomainName: tea.String(domain),
+		}
+		describeCdnDomainDetailResp, err := d.sdkClient.DescribeCdnDomainDetail(describeCdnDomainDetailReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'cdn.DescribeCdnDomainDetail' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已校验 CDN 域名 %s", domain), describeCdnDomainDetailResp)
+		return nil
+	})
+}
+
+func (d *DeployerProvider) getDomainsCoveredByCertificate(certPem string) ([]string, error) {
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]string, 0)
+	describeUserDomainsPageNumber := int32(1)
+	describeUserDomainsPageSize := int32(500)
+	for {
+		// 查询加速域名列表
+		// REF: https://help.aliyun.com/zh/cdn/developer-reference/api-cdn-2018-05-10-describeuserdomains
+		describeUserDomainsReq := &aliyunCdn.DescribeUserDomainsRequest{
+			DomainStatus: tea.String("online"),
+			PageNumber:   tea.Int32(describeUserDomainsPageNumber),
+			PageSize:     tea.Int32(describeUserDomainsPageSize),
+		}
+		describeUserDomainsResp, err := d.sdkClient.DescribeUserDomains(describeUserDomainsReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.DescribeUserDomains'")
+		}
+
+		if describeUserDomainsResp.Body.Domains == nil || len(describeUserDomainsResp.Body.Domains.PageData) == 0 {
+			break
+		}
+
+		for _, domainItem := range describeUserDomainsResp.Body.Domains.PageData {
+			domains = append(domains, tea.StringValue(domainItem.DomainName))
+		}
+
+		if len(describeUserDomainsResp.Body.Domains.PageData) < int(describeUserDomainsPageSize) {
+			break
+		} else {
+			describeUserDomainsPageNumber++
+		}
+	}
+
+	return certs.FilterHostnamesCoveredByCertificate(certX509, domains), nil
+}
+
+func createSdkClient(accessKeyId, accessKeySecret, securityToken string) (*aliyunCdn.Client, error) {
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String("cdn.aliyuncs.com"),
 	}
 
@@ -14,6 +14,7 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-slb"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -21,6 +22,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 部署资源类型。
@@ -34,6 +38,12 @@ type DeployerConfig struct {
 	// SNI 域名（支持泛域名）。
 	// 部署资源类型为 [RESOURCE_TYPE_LOADBALANCER]、[RESOURCE_TYPE_LISTENER] 时选填。
 	Domain string `json:"domain,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -50,15 +60,18 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     config.AccessKeyId,
-		AccessKeySecret: config.AccessKeySecret,
-		Region:          config.Region,
+		AccessKeyId:      config.AccessKeyId,
+		AccessKeySecret:  config.AccessKeySecret,
+		SecurityToken:    config.SecurityToken,
+		Region:           config.Region,
+		ResourceGroupId:  config.ResourceGroupId,
+		CertNameTemplate: config.CertNameTemplate,
 	})
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
@@ -77,7 +90,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 上传证书到 SLB
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -102,7 +115,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId string) error {
@@ -160,16 +173,11 @@ func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId
 	if len(listenerPorts) == 0 {
 		return errors.New("listener not found")
 	} else {
-		var errs []error
-
-		for _, listenerPort := range listenerPorts {
-			if err := d.updateListenerCertificate(ctx, d.config.LoadbalancerId, listenerPort, cloudCertId); err != nil {
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			return errors.Join(errs...)
+		err := concurrent.ForEach(ctx, listenerPorts, 0, func(ctx context.Context, listenerPort int32) error {
+			return d.updateListenerCertificate(ctx, d.config.LoadbalancerId, listenerPort, cloudCertId)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -273,7 +281,7 @@ func (d *DeployerProvider) updateListenerCertificate(ctx context.Context, cloudL
 	return nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunSlb.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunSlb.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/Slb
 	var endpoint string
 	switch region {
@@ -290,6 +298,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunSlb.Cl
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(endpoint),
 	}
 
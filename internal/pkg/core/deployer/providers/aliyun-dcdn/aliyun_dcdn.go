@@ -2,7 +2,9 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,9 +12,14 @@ import (
 	aliyunDcdn "github.com/alibabacloud-go/dcdn-20180115/v3/client"
 	"github.com/alibabacloud-go/tea/tea"
 	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -20,32 +27,64 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 加速域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 加速域名列表（支持泛域名）。
+	// 将与 [DeployerConfig.Domain] 合并后逐一部署。
+	Domains []string `json:"domains,omitempty"`
+	// 是否部署到证书可覆盖的全部加速域名。
+	// 启用后将额外查询账号下的全部加速域名，并与证书的 SAN 进行匹配。
+	MatchCoveredDomains bool `json:"matchCoveredDomains,omitempty"`
+	// 证书模式。
+	// 零值时默认为 [CERT_MODE_UPLOAD]。
+	CertMode string `json:"certMode,omitempty"`
+	// 阿里云 CAS 地域。
+	// 证书模式为 [CERT_MODE_CAS] 时选填。零值时默认为 "cn-hangzhou"。
+	CasRegion string `json:"casRegion,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
-	config    *DeployerConfig
-	logger    logger.Logger
-	sdkClient *aliyunDcdn.Client
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *aliyunDcdn.Client
+	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder  = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.CasRegion, config.ResourceGroupId, config.CertNameTemplate)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
 	return &DeployerProvider{
-		config:    config,
-		logger:    logger.NewNilLogger(),
-		sdkClient: client,
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
 	}, nil
 }
 
@@ -54,34 +93,128 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// "*.example.com" → ".example.com"，适配阿里云 DCDN 要求的泛域名格式
-	domain := strings.TrimPrefix(d.config.Domain, "*")
-
-	// 配置域名证书
-	// REF: https://help.aliyun.com/zh/edge-security-acceleration/dcdn/developer-reference/api-dcdn-2018-01-15-setdcdndomainsslcertificate
-	setDcdnDomainSSLCertificateReq := &aliyunDcdn.SetDcdnDomainSSLCertificateRequest{
-		DomainName:  tea.String(domain),
-		CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
-		CertType:    tea.String("upload"),
-		SSLProtocol: tea.String("on"),
-		SSLPub:      tea.String(certPem),
-		SSLPri:      tea.String(privkeyPem),
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	domains := d.getDomains()
+	if d.config.MatchCoveredDomains {
+		coveredDomains, err := d.getDomainsCoveredByCertificate(certPem)
+		if err != nil {
+			return nil, err
+		}
+
+		d.logger.Logt("已查询到证书可覆盖的加速域名", coveredDomains)
+
+		for _, domain := range coveredDomains {
+			domain = strings.TrimPrefix(domain, "*")
+			if !slices.Contains(domains, domain) {
+				domains = append(domains, domain)
+			}
+		}
 	}
-	setDcdnDomainSSLCertificateResp, err := d.sdkClient.SetDcdnDomainSSLCertificate(setDcdnDomainSSLCertificateReq)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'dcdn.SetDcdnDomainSSLCertificate'")
+	if len(domains) == 0 {
+		if d.config.MatchCoveredDomains {
+			return nil, errors.New("no domains covered by the certificate")
+		}
+
+		return nil, errors.New("config `domain` is required")
 	}
 
-	d.logger.Logt("已配置 DCDN 域名证书", setDcdnDomainSSLCertificateResp)
+	// 仅校验模式下只查询域名信息，不实际配置证书
+	if deployer.MergeOptions(opts...).DryRun {
+		if err := d.validateDomains(ctx, domains); err != nil {
+			return nil, err
+		}
+
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 根据证书模式决定部署方式
+	var certId int64
+	switch d.config.CertMode {
+	case "", CERT_MODE_UPLOAD:
+		break
+
+	case CERT_MODE_CAS:
+		{
+			// 上传证书到 CAS，各域名复用同一证书，避免重复上传
+			upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to upload certificate file")
+			}
+
+			d.logger.Logt("certificate file uploaded", upres)
+
+			certId, err = strconv.ParseInt(upres.CertId, 10, 64)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to parse certificate id")
+			}
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported cert mode: %s", d.config.CertMode)
+	}
+
+	err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 配置域名证书
+		// REF: https://help.aliyun.com/zh/edge-security-acceleration/dcdn/developer-reference/api-dcdn-2018-01-15-setdcdndomainsslcertificate
+		setDcdnDomainSSLCertificateReq := &aliyunDcdn.SetDcdnDomainSSLCertificateRequest{
+			DomainName:  tea.String(domain),
+			SSLProtocol: tea.String("on"),
+		}
+		if certId != 0 {
+			setDcdnDomainSSLCertificateReq.CertType = tea.String("cas")
+			setDcdnDomainSSLCertificateReq.CertId = tea.Int64(certId)
+			setDcdnDomainSSLCertificateReq.CertRegion = tea.String(normalizeCasRegion(d.config.CasRegion))
+		} else {
+			setDcdnDomainSSLCertificateReq.CertType = tea.String("upload")
+			setDcdnDomainSSLCertificateReq.CertName = tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli()))
+			setDcdnDomainSSLCertificateReq.SSLPub = tea.String(certPem)
+			setDcdnDomainSSLCertificateReq.SSLPri = tea.String(privkeyPem)
+		}
+		setDcdnDomainSSLCertificateResp, err := d.sdkClient.SetDcdnDomainSSLCertificate(setDcdnDomainSSLCertificateReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'dcdn.SetDcdnDomainSSLCertificate' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已配置 DCDN 域名 %s 的证书", domain), setDcdnDomainSSLCertificateResp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret string) (*aliyunDcdn.Client, error) {
+func (d *DeployerProvider) getDomains() []string {
+	domains := make([]string, 0, len(d.config.Domains)+1)
+	seen := make(map[string]bool)
+	for _, domain := range append([]string{d.config.Domain}, d.config.Domains...) {
+		// "*.example.com" → ".example.com"，适配阿里云 DCDN 要求的泛域名格式
+		domain = strings.TrimPrefix(strings.TrimSpace(domain), "*")
+		if domain == "" || seen[domain] {
+			continue
+		}
+
+		seen[domain] = true
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
+
+func createSdkClient(accessKeyId, accessKeySecret, securityToken string) (*aliyunDcdn.Client, error) {
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String("dcdn.aliyuncs.com"),
 	}
 
@@ -92,3 +225,83 @@ func createSdkClient(accessKeyId, accessKeySecret string) (*aliyunDcdn.Client, e
 
 	return client, nil
 }
+
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, casRegion, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           normalizeCasRegion(casRegion),
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
+	})
+	return uploader, err
+}
+
+func (d *DeployerProvider) validateDomains(ctx context.Context, domains []string) error {
+	return concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 获取域名配置信息
+		// REF: https://help.aliyun.com/zh/edge-security-acceleration/dcdn/developer-reference/api-dcdn-2018-01-15-describedcdndomaindetail
+		describeDcdnDomainDetailReq := &aliyunDcdn.DescribeDcdnDomainDetailRequest{
+			DomainName: tea.String(domain),
+		}
+		describeDcdnDomainDetailResp, err := d.sdkClient.DescribeDcdnDomainDetail(describeDcdnDomainDetailReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'dcdn.DescribeDcdnDomainDetail' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已校验 DCDN 域名 %s", domain), describeDcdnDomainDetailResp)
+		return nil
+	})
+}
+
+func (d *DeployerProvider) getDomainsCoveredByCertificate(certPem string) ([]string, error) {
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	domains := make([]string, 0)
+	describeDcdnUserDomainsPageNumber := int32(1)
+	describeDcdnUserDomainsPageSize := int32(500)
+	for {
+		// 查询加速域名列表
+		// REF: https://help.aliyun.com/zh/edge-security-acceleration/dcdn/developer-reference/api-dcdn-2018-01-15-describedcdnuserdomains
+		describeDcdnUserDomainsReq := &aliyunDcdn.DescribeDcdnUserDomainsRequest{
+			DomainStatus: tea.String("online"),
+			PageNumber:   tea.Int32(describeDcdnUserDomainsPageNumber),
+			PageSize:     tea.Int32(describeDcdnUserDomainsPageSize),
+		}
+		describeDcdnUserDomainsResp, err := d.sdkClient.DescribeDcdnUserDomains(describeDcdnUserDomainsReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'dcdn.DescribeDcdnUserDomains'")
+		}
+
+		if describeDcdnUserDomainsResp.Body.Domains == nil || len(describeDcdnUserDomainsResp.Body.Domains.PageData) == 0 {
+			break
+		}
+
+		for _, domainItem := range describeDcdnUserDomainsResp.Body.Domains.PageData {
+			domains = append(domains, tea.StringValue(domainItem.DomainName))
+		}
+
+		if len(describeDcdnUserDomainsResp.Body.Domains.PageData) < int(describeDcdnUserDomainsPageSize) {
+			break
+		} else {
+			describeDcdnUserDomainsPageNumber++
+		}
+	}
+
+	return certs.FilterHostnamesCoveredByCertificate(certX509, domains), nil
+}
+
+func normalizeCasRegion(casRegion string) string {
+	// 阿里云 CAS 服务接入点
+	// 国内版固定接入点：华东一杭州
+	// 国际版固定接入点：亚太东南一新加坡
+	if casRegion != "" && !strings.HasPrefix(casRegion, "cn-") {
+		return "ap-southeast-1"
+	}
+
+	return "cn-hangzhou"
+}
@@ -17,6 +17,7 @@ var (
 	fAccessKeyId     string
 	fAccessKeySecret string
 	fDomain          string
+	fCertMode        string
 )
 
 func init() {
@@ -27,6 +28,7 @@ func init() {
 	flag.StringVar(&fAccessKeyId, argsPrefix+"ACCESSKEYID", "", "")
 	flag.StringVar(&fAccessKeySecret, argsPrefix+"ACCESSKEYSECRET", "", "")
 	flag.StringVar(&fDomain, argsPrefix+"DOMAIN", "", "")
+	flag.StringVar(&fCertMode, argsPrefix+"CERTMODE", "", "")
 }
 
 /*
@@ -37,7 +39,8 @@ Shell command to run this test:
 	--CERTIMATE_DEPLOYER_ALIYUNDCDN_INPUTKEYPATH="/path/to/your-input-key.pem" \
 	--CERTIMATE_DEPLOYER_ALIYUNDCDN_ACCESSKEYID="your-access-key-id" \
 	--CERTIMATE_DEPLOYER_ALIYUNDCDN_ACCESSKEYSECRET="your-access-key-secret" \
-	--CERTIMATE_DEPLOYER_ALIYUNDCDN_DOMAIN="example.com"
+	--CERTIMATE_DEPLOYER_ALIYUNDCDN_DOMAIN="example.com" \
+	--CERTIMATE_DEPLOYER_ALIYUNDCDN_CERTMODE="upload"
 */
 func TestDeploy(t *testing.T) {
 	flag.Parse()
@@ -50,12 +53,14 @@ func TestDeploy(t *testing.T) {
 			fmt.Sprintf("ACCESSKEYID: %v", fAccessKeyId),
 			fmt.Sprintf("ACCESSKEYSECRET: %v", fAccessKeySecret),
 			fmt.Sprintf("DOMAIN: %v", fDomain),
+			fmt.Sprintf("CERTMODE: %v", fCertMode),
 		}, "\n"))
 
 		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
 			AccessKeyId:     fAccessKeyId,
 			AccessKeySecret: fAccessKeySecret,
 			Domain:          fDomain,
+			CertMode:        fCertMode,
 		})
 		if err != nil {
 			t.Errorf("err: %+v", err)
@@ -0,0 +1,8 @@
+﻿package aliyundcdn
+
+const (
+	// 证书模式：每个域名分别上传证书内容。
+	CERT_MODE_UPLOAD = "upload"
+	// 证书模式：上传到 CAS 后，各域名复用同一证书 ID。
+	CERT_MODE_CAS = "cas"
+)
@@ -0,0 +1,202 @@
+package aliyunddos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/tea"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
+	aliyunDdos "github.com/usual2970/certimate/internal/pkg/vendors/aliyun-sdk/ddoscoo"
+)
+
+type DeployerConfig struct {
+	// 阿里云 AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// 阿里云 AccessKeySecret。
+	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
+	// 阿里云地域。
+	// 零值时默认为 "cn-hangzhou"（DDoS 高防（中国内地））；DDoS 高防（非中国内地）请填写 "ap-southeast-1"。
+	Region string `json:"region"`
+	// 网站业务转发规则的域名。
+	Domain string `json:"domain"`
+	// 阿里云资源组 ID。
+	// 选填。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *aliyunDdos.Client
+	sslUploader uploader.Uploader
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder  = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 查询网站业务转发规则，确认域名已接入
+	// REF: https://help.aliyun.com/zh/anti-ddos/anti-ddos-pro-and-premium/developer-reference/api-ddoscoo-2020-01-01-describewebrules
+	describeWebRulesReq := &aliyunDdos.DescribeWebRulesRequest{
+		Domain:     tea.String(d.config.Domain),
+		PageNumber: tea.Int32(1),
+		PageSize:   tea.Int32(10),
+	}
+	if d.config.ResourceGroupId != "" {
+		describeWebRulesReq.ResourceGroupId = tea.String(d.config.ResourceGroupId)
+	}
+	describeWebRulesResp, err := d.sdkClient.DescribeWebRules(describeWebRulesReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ddoscoo.DescribeWebRules'")
+	}
+
+	d.logger.Logt("已查询到网站业务转发规则", describeWebRulesResp)
+
+	found := false
+	for _, webRule := range describeWebRulesResp.WebRules {
+		if strings.EqualFold(webRule.Domain, d.config.Domain) {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("could not find web rule for domain '%s'", d.config.Domain)
+	}
+
+	// 仅校验模式下只查询转发规则，不实际关联证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到 CAS
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	certId, err := strconv.ParseInt(upres.CertId, 10, 64)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to parse certificate id")
+	}
+
+	// 为网站业务转发规则关联证书
+	// REF: https://help.aliyun.com/zh/anti-ddos/anti-ddos-pro-and-premium/developer-reference/api-ddoscoo-2020-01-01-associatewebcert
+	associateWebCertReq := &aliyunDdos.AssociateWebCertRequest{
+		Domain:     tea.String(d.config.Domain),
+		CertId:     tea.Int64(certId),
+		CertRegion: tea.String(normalizeRegion(d.config.Region)),
+	}
+	if d.config.ResourceGroupId != "" {
+		associateWebCertReq.ResourceGroupId = tea.String(d.config.ResourceGroupId)
+	}
+	associateWebCertResp, err := d.sdkClient.AssociateWebCert(associateWebCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ddoscoo.AssociateWebCert'")
+	}
+
+	d.logger.Logt("已关联网站业务转发规则证书", associateWebCertResp)
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunDdos.Client, error) {
+	// 接入点一览 https://api.aliyun.com/product/ddoscoo
+	endpoint := fmt.Sprintf("ddoscoo.%s.aliyuncs.com", normalizeRegion(region))
+
+	config := &aliyunOpen.Config{
+		AccessKeyId:     tea.String(accessKeyId),
+		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
+		Endpoint:        tea.String(endpoint),
+	}
+
+	client, err := aliyunDdos.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           normalizeRegion(region),
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
+	})
+	return uploader, err
+}
+
+func normalizeRegion(region string) string {
+	// DDoS 高防仅提供两个接入点，证书需上传到对应站点的 CAS
+	// 中国内地：华东一杭州
+	// 非中国内地：亚太东南一新加坡
+	if region != "" && !strings.HasPrefix(region, "cn-") {
+		return "ap-southeast-1"
+	}
+
+	return "cn-hangzhou"
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	aliyunEsa "github.com/alibabacloud-go/esa-20240910/v2/client"
@@ -23,10 +24,25 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 阿里云 ESA 站点 ID。
 	SiteId int64 `json:"siteId"`
+	// 阿里云 ESA 站点名称。
+	// 选填。[DeployerConfig.SiteId] 为零值时将按站点名称查询站点 ID。
+	SiteName string `json:"siteName,omitempty"`
+	// 证书模式。
+	// 零值时默认为 [CERT_MODE_CAS]。
+	CertMode string `json:"certMode,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -36,19 +52,23 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder  = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
-	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
 	}
@@ -66,26 +86,68 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	if d.config.SiteId == 0 {
-		return nil, errors.New("config `siteId` is required")
-	}
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
 
-	// 上传证书到 CAS
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	siteId := d.config.SiteId
+	if siteId == 0 {
+		if d.config.SiteName == "" {
+			return nil, errors.New("config `siteId` or `siteName` is required")
+		}
+
+		var err error
+		siteId, err = d.findSiteIdByName(d.config.SiteName)
+		if err != nil {
+			return nil, err
+		}
+
+		d.logger.Logt(fmt.Sprintf("已查询到站点 %s 的 ID", d.config.SiteName), siteId)
 	}
 
-	d.logger.Logt("certificate file uploaded", upres)
+	// 仅校验模式下只查询站点信息，不实际配置证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
 
 	// 配置站点证书
 	// REF: https://help.aliyun.com/zh/edge-security-acceleration/esa/api-esa-2024-09-10-setcertificate
-	certId, _ := strconv.ParseInt(upres.CertId, 10, 64)
 	setCertificateReq := &aliyunEsa.SetCertificateRequest{
-		SiteId: tea.Int64(d.config.SiteId),
-		Type:   tea.String("cas"),
-		CasId:  tea.Int64(certId),
+		SiteId: tea.Int64(siteId),
+	}
+	switch d.config.CertMode {
+	case "", CERT_MODE_CAS:
+		{
+			// 上传证书到 CAS
+			upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to upload certificate file")
+			}
+
+			d.logger.Logt("certificate file uploaded", upres)
+
+			certId, err := strconv.ParseInt(upres.CertId, 10, 64)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to parse certificate id")
+			}
+
+			setCertificateReq.Type = tea.String("cas")
+			setCertificateReq.CasId = tea.Int64(certId)
+		}
+
+	case CERT_MODE_UPLOAD:
+		setCertificateReq.Type = tea.String("upload")
+		setCertificateReq.Name = tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli()))
+		setCertificateReq.Certificate = tea.String(certPem)
+		setCertificateReq.PrivateKey = tea.String(privkeyPem)
+
+	default:
+		return nil, fmt.Errorf("unsupported cert mode: %s", d.config.CertMode)
 	}
 	setCertificateResp, err := d.sdkClient.SetCertificate(setCertificateReq)
 	if err != nil {
@@ -97,11 +159,35 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunEsa.Client, error) {
+func (d *DeployerProvider) findSiteIdByName(siteName string) (int64, error) {
+	// 查询站点列表
+	// REF: https://help.aliyun.com/zh/edge-security-acceleration/esa/api-esa-2024-09-10-listsites
+	listSitesReq := &aliyunEsa.ListSitesRequest{
+		SiteName:       tea.String(siteName),
+		SiteSearchType: tea.String("exact"),
+		PageNumber:     tea.Int32(1),
+		PageSize:       tea.Int32(10),
+	}
+	listSitesResp, err := d.sdkClient.ListSites(listSitesReq)
+	if err != nil {
+		return 0, xerrors.Wrap(err, "failed to execute sdk request 'esa.ListSites'")
+	}
+
+	for _, site := range listSitesResp.Body.Sites {
+		if strings.EqualFold(tea.StringValue(site.SiteName), siteName) {
+			return tea.Int64Value(site.SiteId), nil
+		}
+	}
+
+	return 0, fmt.Errorf("could not find site '%s'", siteName)
+}
+
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunEsa.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/ESA
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(fmt.Sprintf("esa.%s.aliyuncs.com", region)),
 	}
 
@@ -113,7 +199,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunEsa.Cl
 	return client, nil
 }
 
-func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Uploader, error) {
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
 	casRegion := region
 	if casRegion != "" {
 		// 阿里云 CAS 服务接入点是独立于 ESA 服务的
@@ -127,9 +213,12 @@ func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Up
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     accessKeyId,
-		AccessKeySecret: accessKeySecret,
-		Region:          casRegion,
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           casRegion,
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
 	})
 	return uploader, err
 }
@@ -0,0 +1,8 @@
+package aliyunesa
+
+const (
+	// 证书模式：上传到 CAS 后，由站点引用证书 ID。
+	CERT_MODE_CAS = "cas"
+	// 证书模式：直接上传证书内容作为站点的自定义证书。
+	CERT_MODE_UPLOAD = "upload"
+)
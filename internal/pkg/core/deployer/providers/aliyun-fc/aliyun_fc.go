@@ -20,6 +20,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 服务版本。
@@ -47,7 +50,7 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 		panic("config is nil")
 	}
 
-	clients, err := createSdkClients(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	clients, err := createSdkClients(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk clients")
 	}
@@ -64,7 +67,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	switch d.config.ServiceVersion {
 	case "", "3.0":
 		if err := d.deployToFC3(ctx, certPem, privkeyPem); err != nil {
@@ -147,7 +150,7 @@ func (d *DeployerProvider) deployToFC2(ctx context.Context, certPem string, priv
 	return nil
 }
 
-func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients, error) {
+func createSdkClients(accessKeyId, accessKeySecret, securityToken, region string) (*wSdkClients, error) {
 	// 接入点一览 https://api.aliyun.com/product/FC-Open
 	var fc2Endpoint string
 	switch region {
@@ -160,6 +163,7 @@ func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients
 	fc2Config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(fc2Endpoint),
 	}
 	fc2Client, err := aliyunFc2.NewClient(fc2Config)
@@ -172,6 +176,7 @@ func createSdkClients(accessKeyId, accessKeySecret, region string) (*wSdkClients
 	fc3Config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(fc3Endpoint),
 	}
 	fc3Client, err := aliyunFc3.NewClient(fc3Config)
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -13,6 +14,7 @@ import (
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -20,10 +22,16 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 直播流域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 直播流域名列表（支持泛域名），可同时包含推流域名和播流域名。
+	// 将与 [DeployerConfig.Domain] 合并后逐一部署。
+	Domains []string `json:"domains,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -32,14 +40,17 @@ type DeployerProvider struct {
 	sdkClient *aliyunLive.Client
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
@@ -56,31 +67,86 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// "*.example.com" → ".example.com"，适配阿里云 Live 要求的泛域名格式
-	domain := strings.TrimPrefix(d.config.Domain, "*")
-
-	// 设置域名证书
-	// REF: https://help.aliyun.com/zh/live/developer-reference/api-live-2016-11-01-setlivedomaincertificate
-	setLiveDomainSSLCertificateReq := &aliyunLive.SetLiveDomainCertificateRequest{
-		DomainName:  tea.String(domain),
-		CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
-		CertType:    tea.String("upload"),
-		SSLProtocol: tea.String("on"),
-		SSLPub:      tea.String(certPem),
-		SSLPri:      tea.String(privkeyPem),
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	domains := d.getDomains()
+	if len(domains) == 0 {
+		return nil, errors.New("config `domain` is required")
 	}
-	setLiveDomainSSLCertificateResp, err := d.sdkClient.SetLiveDomainCertificate(setLiveDomainSSLCertificateReq)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'live.SetLiveDomainCertificate'")
+
+	// 仅校验模式下只查询域名信息，不实际配置证书
+	if deployer.MergeOptions(opts...).DryRun {
+		if err := d.validateDomains(ctx, domains); err != nil {
+			return nil, err
+		}
+
+		return &deployer.DeployResult{}, nil
 	}
 
-	d.logger.Logt("已设置域名证书", setLiveDomainSSLCertificateResp)
+	err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 设置域名证书
+		// REF: https://help.aliyun.com/zh/live/developer-reference/api-live-2016-11-01-setlivedomaincertificate
+		setLiveDomainSSLCertificateReq := &aliyunLive.SetLiveDomainCertificateRequest{
+			DomainName:  tea.String(domain),
+			CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
+			CertType:    tea.String("upload"),
+			SSLProtocol: tea.String("on"),
+			SSLPub:      tea.String(certPem),
+			SSLPri:      tea.String(privkeyPem),
+		}
+		setLiveDomainSSLCertificateResp, err := d.sdkClient.SetLiveDomainCertificate(setLiveDomainSSLCertificateReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'live.SetLiveDomainCertificate' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已设置直播域名 %s 的证书", domain), setLiveDomainSSLCertificateResp)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunLive.Client, error) {
+func (d *DeployerProvider) getDomains() []string {
+	domains := make([]string, 0, len(d.config.Domains)+1)
+	seen := make(map[string]bool)
+	for _, domain := range append([]string{d.config.Domain}, d.config.Domains...) {
+		// "*.example.com" → ".example.com"，适配阿里云 Live 要求的泛域名格式
+		domain = strings.TrimPrefix(strings.TrimSpace(domain), "*")
+		if domain == "" || seen[domain] {
+			continue
+		}
+
+		seen[domain] = true
+		domains = append(domains, domain)
+	}
+
+	return domains
+}
+
+func (d *DeployerProvider) validateDomains(ctx context.Context, domains []string) error {
+	return concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
+		// 查询域名配置信息
+		// REF: https://help.aliyun.com/zh/live/developer-reference/api-live-2016-11-01-describelivedomaindetail
+		describeLiveDomainDetailReq := &aliyunLive.DescribeLiveDomainDetailRequest{
+			DomainName: tea.String(domain),
+		}
+		describeLiveDomainDetailResp, err := d.sdkClient.DescribeLiveDomainDetail(describeLiveDomainDetailReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'live.DescribeLiveDomainDetail' (domain: %s)", domain)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已校验直播域名 %s", domain), describeLiveDomainDetailResp)
+		return nil
+	})
+}
+
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunLive.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/live
 	var endpoint string
 	switch region {
@@ -100,6 +166,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunLive.C
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(endpoint),
 	}
 
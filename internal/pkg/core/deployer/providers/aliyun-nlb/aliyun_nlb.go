@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
 	aliyunNlb "github.com/alibabacloud-go/nlb-20220430/v2/client"
@@ -15,6 +16,7 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -22,6 +24,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 部署资源类型。
@@ -32,6 +37,12 @@ type DeployerConfig struct {
 	// 负载均衡监听 ID。
 	// 部署资源类型为 [RESOURCE_TYPE_LISTENER] 时必填。
 	ListenerId string `json:"listenerId,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -41,19 +52,22 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
-	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
 	}
@@ -71,7 +85,11 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 上传证书到 CAS
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -96,7 +114,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId string) error {
@@ -152,16 +170,11 @@ func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId
 	if len(listenerIds) == 0 {
 		return errors.New("listener not found")
 	} else {
-		var errs []error
-
-		for _, listenerId := range listenerIds {
-			if err := d.updateListenerCertificate(ctx, listenerId, cloudCertId); err != nil {
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			return errors.Join(errs...)
+		err := concurrent.ForEach(ctx, listenerIds, 0, func(ctx context.Context, listenerId string) error {
+			return d.updateListenerCertificate(ctx, listenerId, cloudCertId)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -207,10 +220,36 @@ func (d *DeployerProvider) updateListenerCertificate(ctx context.Context, cloudL
 
 	d.logger.Logt("已更新 NLB 监听配置", updateListenerAttributeResp)
 
-	return nil
+	// 循环查询监听的属性，等待监听配置完成并确认证书已生效
+	// REF: https://help.aliyun.com/zh/slb/network-load-balancer/developer-reference/api-nlb-2022-04-30-getlistenerattribute
+	for {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		getListenerAttributeResp, err := d.sdkClient.GetListenerAttribute(getListenerAttributeReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'nlb.GetListenerAttribute'")
+		}
+
+		if tea.StringValue(getListenerAttributeResp.Body.ListenerStatus) == "Running" {
+			for _, certificateId := range getListenerAttributeResp.Body.CertificateIds {
+				// 监听证书 ID 格式：${证书 ID}-${地域}
+				if tea.StringValue(certificateId) == cloudCertId || strings.HasPrefix(tea.StringValue(certificateId), cloudCertId+"-") {
+					d.logger.Logt("NLB 监听证书已生效", getListenerAttributeResp)
+					return nil
+				}
+			}
+
+			return fmt.Errorf("nlb listener %s did not converge to certificate %s", cloudListenerId, cloudCertId)
+		}
+
+		d.logger.Logt("NLB 监听配置未完成 ...")
+		time.Sleep(time.Second * 5)
+	}
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunNlb.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunNlb.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/Nlb
 	var endpoint string
 	switch region {
@@ -221,6 +260,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunNlb.Cl
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(endpoint),
 	}
 
@@ -232,7 +272,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunNlb.Cl
 	return client, nil
 }
 
-func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Uploader, error) {
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
 	casRegion := region
 	if casRegion != "" {
 		// 阿里云 CAS 服务接入点是独立于 NLB 服务的
@@ -246,9 +286,12 @@ func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Up
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     accessKeyId,
-		AccessKeySecret: accessKeySecret,
-		Region:          casRegion,
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           casRegion,
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
 	})
 	return uploader, err
 }
@@ -17,6 +17,9 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 存储桶名。
@@ -38,7 +41,7 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
@@ -55,7 +58,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.Bucket == "" {
 		return nil, errors.New("config `bucket` is required")
 	}
@@ -80,7 +83,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*oss.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*oss.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/Oss
 	var endpoint string
 	switch region {
@@ -101,7 +104,12 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*oss.Client,
 		endpoint = fmt.Sprintf("oss-%s.aliyuncs.com", region)
 	}
 
-	client, err := oss.New(endpoint, accessKeyId, accessKeySecret)
+	options := make([]oss.ClientOption, 0)
+	if securityToken != "" {
+		options = append(options, oss.SecurityToken(securityToken))
+	}
+
+	client, err := oss.New(endpoint, accessKeyId, accessKeySecret, options...)
 	if err != nil {
 		return nil, err
 	}
@@ -3,6 +3,8 @@
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"time"
 
 	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
@@ -12,6 +14,8 @@ import (
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
 )
 
 type DeployerConfig struct {
@@ -19,34 +23,59 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// 点播加速域名（不支持泛域名）。
 	Domain string `json:"domain"`
+	// 证书模式。
+	// 零值时默认为 [CERT_MODE_UPLOAD]。
+	CertMode string `json:"certMode,omitempty"`
+	// 阿里云 CAS 地域。
+	// 证书模式为 [CERT_MODE_CAS] 时选填。零值时默认为 "cn-hangzhou"。
+	CasRegion string `json:"casRegion,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
-	config    *DeployerConfig
-	logger    logger.Logger
-	sdkClient *aliyunVod.Client
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *aliyunVod.Client
+	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.CasRegion, config.ResourceGroupId, config.CertNameTemplate)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
 	return &DeployerProvider{
-		config:    config,
-		logger:    logger.NewNilLogger(),
-		sdkClient: client,
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
 	}, nil
 }
 
@@ -55,20 +84,50 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 设置域名证书
 	// REF: https://help.aliyun.com/zh/vod/developer-reference/api-vod-2017-03-21-setvoddomainsslcertificate
 	setVodDomainSSLCertificateReq := &aliyunVod.SetVodDomainSSLCertificateRequest{
 		DomainName:  tea.String(d.config.Domain),
-		CertName:    tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
-		CertType:    tea.String("upload"),
 		SSLProtocol: tea.String("on"),
-		SSLPub:      tea.String(certPem),
-		SSLPri:      tea.String(privkeyPem),
+	}
+	switch d.config.CertMode {
+	case "", CERT_MODE_UPLOAD:
+		setVodDomainSSLCertificateReq.CertType = tea.String("upload")
+		setVodDomainSSLCertificateReq.CertName = tea.String(fmt.Sprintf("certimate-%d", time.Now().UnixMilli()))
+		setVodDomainSSLCertificateReq.SSLPub = tea.String(certPem)
+		setVodDomainSSLCertificateReq.SSLPri = tea.String(privkeyPem)
+
+	case CERT_MODE_CAS:
+		{
+			// 上传证书到 CAS
+			upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to upload certificate file")
+			}
+
+			d.logger.Logt("certificate file uploaded", upres)
+
+			certId, err := strconv.ParseInt(upres.CertId, 10, 64)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to parse certificate id")
+			}
+
+			setVodDomainSSLCertificateReq.CertType = tea.String("cas")
+			setVodDomainSSLCertificateReq.CertId = tea.Int64(certId)
+			setVodDomainSSLCertificateReq.CertRegion = tea.String(normalizeCasRegion(d.config.CasRegion))
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported cert mode: %s", d.config.CertMode)
 	}
 	setVodDomainSSLCertificateResp, err := d.sdkClient.SetVodDomainSSLCertificate(setVodDomainSSLCertificateReq)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'live.SetVodDomainSSLCertificate'")
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vod.SetVodDomainSSLCertificate'")
 	} else {
 		d.logger.Logt("已设置域名证书", setVodDomainSSLCertificateResp)
 	}
@@ -76,13 +135,14 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunVod.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunVod.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/vod
 	endpoint := fmt.Sprintf("vod.%s.aliyuncs.com", region)
 
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(endpoint),
 	}
 
@@ -93,3 +153,26 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunVod.Cl
 
 	return client, nil
 }
+
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, casRegion, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           normalizeCasRegion(casRegion),
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
+	})
+	return uploader, err
+}
+
+func normalizeCasRegion(casRegion string) string {
+	// 阿里云 CAS 服务接入点
+	// 国内版固定接入点：华东一杭州
+	// 国际版固定接入点：亚太东南一新加坡
+	if casRegion != "" && !strings.HasPrefix(casRegion, "cn-") {
+		return "ap-southeast-1"
+	}
+
+	return "cn-hangzhou"
+}
@@ -0,0 +1,8 @@
+package aliyunvod
+
+const (
+	// 证书模式：直接上传证书内容。
+	CERT_MODE_UPLOAD = "upload"
+	// 证书模式：先上传到 CAS，再引用证书 ID。
+	CERT_MODE_CAS = "cas"
+)
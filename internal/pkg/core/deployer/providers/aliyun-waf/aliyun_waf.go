@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
@@ -22,12 +23,33 @@ type DeployerConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
 	// WAF 实例 ID。
 	InstanceId string `json:"instanceId"`
 	// 接入域名（支持泛域名）。
 	Domain string `json:"domain,omitempty"`
+	// TLS 最低版本（可选）。
+	// 零值时将保留原有设置。
+	TLSVersion string `json:"tlsVersion,omitempty"`
+	// 是否启用 TLS 1.3（可选）。
+	// 零值时将保留原有设置。
+	EnableTLSv3 *bool `json:"enableTLSv3,omitempty"`
+	// 加密套件类型（可选）。
+	// 零值时将保留原有设置。
+	CipherSuite int32 `json:"cipherSuite,omitempty"`
+	// 自定义加密套件列表。
+	// 仅当 `CipherSuite` 为 [CIPHER_SUITE_CUSTOM] 时有效。
+	CustomCiphers []string `json:"customCiphers,omitempty"`
+	// 阿里云资源组 ID（可选）。
+	// 上传证书时使用。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 上传证书时使用，支持的变量及零值时的默认值参见 [uploadersp.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -37,19 +59,22 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	client, err := createSdkClient(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create sdk client")
 	}
 
-	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.Region)
+	uploader, err := createSslUploader(config.AccessKeyId, config.AccessKeySecret, config.SecurityToken, config.Region, config.ResourceGroupId, config.CertNameTemplate)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
 	}
@@ -67,10 +92,17 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.InstanceId == "" {
 		return nil, errors.New("config `instanceId` is required")
 	}
+	if err := validateTLSConfig(d.config); err != nil {
+		return nil, err
+	}
 
 	// 上传证书到 CAS
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
@@ -108,6 +140,25 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		if describeDefaultHttpsResp.Body != nil && describeDefaultHttpsResp.Body.DefaultHttps != nil {
 			modifyDefaultHttpsReq.TLSVersion = describeDefaultHttpsResp.Body.DefaultHttps.TLSVersion
 			modifyDefaultHttpsReq.EnableTLSv3 = describeDefaultHttpsResp.Body.DefaultHttps.EnableTLSv3
+			if cipherSuite, err := strconv.ParseInt(tea.StringValue(describeDefaultHttpsResp.Body.DefaultHttps.CipherSuite), 10, 32); err == nil && cipherSuite != 0 {
+				modifyDefaultHttpsReq.CipherSuite = tea.Int32(int32(cipherSuite))
+				if int32(cipherSuite) == CIPHER_SUITE_CUSTOM && tea.StringValue(describeDefaultHttpsResp.Body.DefaultHttps.CustomCiphers) != "" {
+					modifyDefaultHttpsReq.CustomCiphers = tea.StringSlice(strings.Split(tea.StringValue(describeDefaultHttpsResp.Body.DefaultHttps.CustomCiphers), ","))
+				}
+			}
+		}
+		if d.config.TLSVersion != "" {
+			modifyDefaultHttpsReq.TLSVersion = tea.String(d.config.TLSVersion)
+		}
+		if d.config.EnableTLSv3 != nil {
+			modifyDefaultHttpsReq.EnableTLSv3 = tea.Bool(*d.config.EnableTLSv3)
+		}
+		if d.config.CipherSuite != 0 {
+			modifyDefaultHttpsReq.CipherSuite = tea.Int32(d.config.CipherSuite)
+			modifyDefaultHttpsReq.CustomCiphers = nil
+			if d.config.CipherSuite == CIPHER_SUITE_CUSTOM {
+				modifyDefaultHttpsReq.CustomCiphers = tea.StringSlice(d.config.CustomCiphers)
+			}
 		}
 		modifyDefaultHttpsResp, err := d.sdkClient.ModifyDefaultHttps(modifyDefaultHttpsReq)
 		if err != nil {
@@ -149,6 +200,25 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			modifyDomainReq.Listen.TLSVersion = describeDomainDetailResp.Body.Listen.TLSVersion
 			modifyDomainReq.Listen.EnableTLSv3 = describeDomainDetailResp.Body.Listen.EnableTLSv3
 			modifyDomainReq.Listen.FocusHttps = describeDomainDetailResp.Body.Listen.FocusHttps
+			if cipherSuite := tea.Int64Value(describeDomainDetailResp.Body.Listen.CipherSuite); cipherSuite != 0 {
+				modifyDomainReq.Listen.CipherSuite = tea.Int32(int32(cipherSuite))
+				if int32(cipherSuite) == CIPHER_SUITE_CUSTOM {
+					modifyDomainReq.Listen.CustomCiphers = describeDomainDetailResp.Body.Listen.CustomCiphers
+				}
+			}
+		}
+		if d.config.TLSVersion != "" {
+			modifyDomainReq.Listen.TLSVersion = tea.String(d.config.TLSVersion)
+		}
+		if d.config.EnableTLSv3 != nil {
+			modifyDomainReq.Listen.EnableTLSv3 = tea.Bool(*d.config.EnableTLSv3)
+		}
+		if d.config.CipherSuite != 0 {
+			modifyDomainReq.Listen.CipherSuite = tea.Int32(d.config.CipherSuite)
+			modifyDomainReq.Listen.CustomCiphers = nil
+			if d.config.CipherSuite == CIPHER_SUITE_CUSTOM {
+				modifyDomainReq.Listen.CustomCiphers = tea.StringSlice(d.config.CustomCiphers)
+			}
 		}
 		modifyDomainResp, err := d.sdkClient.ModifyDomain(modifyDomainReq)
 		if err != nil {
@@ -158,14 +228,38 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		}
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func validateTLSConfig(config *DeployerConfig) error {
+	switch config.TLSVersion {
+	case "", TLS_VERSION_1_0, TLS_VERSION_1_1, TLS_VERSION_1_2:
+	default:
+		return fmt.Errorf("config `tlsVersion` is invalid: '%s'", config.TLSVersion)
+	}
+
+	switch config.CipherSuite {
+	case 0, CIPHER_SUITE_ALL, CIPHER_SUITE_STRONG:
+		if len(config.CustomCiphers) > 0 {
+			return errors.New("config `customCiphers` is only available when `cipherSuite` is custom")
+		}
+	case CIPHER_SUITE_CUSTOM:
+		if len(config.CustomCiphers) == 0 {
+			return errors.New("config `customCiphers` is required when `cipherSuite` is custom")
+		}
+	default:
+		return fmt.Errorf("config `cipherSuite` is invalid: '%d'", config.CipherSuite)
+	}
+
+	return nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunWaf.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunWaf.Client, error) {
 	// 接入点一览：https://api.aliyun.com/product/waf-openapi
 	config := &aliyunOpen.Config{
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 		Endpoint:        tea.String(fmt.Sprintf("wafopenapi.%s.aliyuncs.com", region)),
 	}
 
@@ -177,7 +271,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunWaf.Cl
 	return client, nil
 }
 
-func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Uploader, error) {
+func createSslUploader(accessKeyId, accessKeySecret, securityToken, region, resourceGroupId, certNameTemplate string) (uploader.Uploader, error) {
 	casRegion := region
 	if casRegion != "" {
 		// 阿里云 CAS 服务接入点是独立于 WAF 服务的
@@ -191,9 +285,12 @@ func createSslUploader(accessKeyId, accessKeySecret, region string) (uploader.Up
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
-		AccessKeyId:     accessKeyId,
-		AccessKeySecret: accessKeySecret,
-		Region:          casRegion,
+		AccessKeyId:      accessKeyId,
+		AccessKeySecret:  accessKeySecret,
+		SecurityToken:    securityToken,
+		Region:           casRegion,
+		ResourceGroupId:  resourceGroupId,
+		CertNameTemplate: certNameTemplate,
 	})
 	return uploader, err
 }
@@ -0,0 +1,19 @@
+﻿package aliyunwaf
+
+const (
+	// TLS 最低版本：TLS 1.0。
+	TLS_VERSION_1_0 = "tlsv1"
+	// TLS 最低版本：TLS 1.1。
+	TLS_VERSION_1_1 = "tlsv1.1"
+	// TLS 最低版本：TLS 1.2。
+	TLS_VERSION_1_2 = "tlsv1.2"
+)
+
+const (
+	// 加密套件类型：全部加密套件。
+	CIPHER_SUITE_ALL = int32(1)
+	// 加密套件类型：强加密套件。
+	CIPHER_SUITE_STRONG = int32(2)
+	// 加密套件类型：自定义加密套件。
+	CIPHER_SUITE_CUSTOM = int32(99)
+)
@@ -23,11 +23,16 @@ type DeployerConfig struct {
 	// AWS SecretAccessKey。
 	SecretAccessKey string `json:"secretAccessKey"`
 	// AWS 区域。
+	// CloudFront 只能使用 us-east-1 区域中的 ACM 证书，上传证书时将忽略该值。
 	Region string `json:"region"`
 	// AWS CloudFront 分配 ID。
 	DistributionId string `json:"distributionId"`
 }
 
+// CloudFront 只能使用 us-east-1 区域中的 ACM 证书。
+// REF: https://docs.aws.amazon.com/en_us/AmazonCloudFront/latest/DeveloperGuide/cnames-and-https-requirements.html#https-requirements-certificate-issuer
+const acmRegion = "us-east-1"
+
 type DeployerProvider struct {
 	config      *DeployerConfig
 	logger      logger.Logger
@@ -50,7 +55,7 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
 		AccessKeyId:     config.AccessKeyId,
 		SecretAccessKey: config.SecretAccessKey,
-		Region:          config.Region,
+		Region:          acmRegion,
 	})
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
@@ -69,9 +74,9 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.DistributionId == "" {
-		return nil, errors.New("config `distribuitionId` is required")
+		return nil, errors.New("config `distributionId` is required")
 	}
 
 	// 上传证书到 ACM
@@ -87,7 +92,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	getDistributionConfigReq := &awsCf.GetDistributionConfigInput{
 		Id: aws.String(d.config.DistributionId),
 	}
-	getDistributionConfigResp, err := d.sdkClient.GetDistributionConfig(context.TODO(), getDistributionConfigReq)
+	getDistributionConfigResp, err := d.sdkClient.GetDistributionConfig(ctx, getDistributionConfigReq)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudfront.GetDistributionConfig'")
 	}
@@ -104,16 +109,31 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	if updateDistributionReq.DistributionConfig.ViewerCertificate == nil {
 		updateDistributionReq.DistributionConfig.ViewerCertificate = &awsCfTypes.ViewerCertificate{}
 	}
-	updateDistributionReq.DistributionConfig.ViewerCertificate.CloudFrontDefaultCertificate = aws.Bool(false)
-	updateDistributionReq.DistributionConfig.ViewerCertificate.ACMCertificateArn = aws.String(upres.CertId)
-	updateDistributionResp, err := d.sdkClient.UpdateDistribution(context.TODO(), updateDistributionReq)
+	viewerCertificate := updateDistributionReq.DistributionConfig.ViewerCertificate
+	if aws.ToString(viewerCertificate.ACMCertificateArn) == upres.CertId {
+		d.logger.Logt("分配已绑定该证书，跳过更新")
+		return &deployer.DeployResult{CertId: upres.CertId}, nil
+	}
+	viewerCertificate.CloudFrontDefaultCertificate = aws.Bool(false)
+	viewerCertificate.ACMCertificateArn = aws.String(upres.CertId)
+	viewerCertificate.IAMCertificateId = nil
+	viewerCertificate.Certificate = nil
+	viewerCertificate.CertificateSource = ""
+	if viewerCertificate.SSLSupportMethod == "" {
+		viewerCertificate.SSLSupportMethod = awsCfTypes.SSLSupportMethodSniOnly
+	}
+	if viewerCertificate.MinimumProtocolVersion == "" || viewerCertificate.MinimumProtocolVersion == awsCfTypes.MinimumProtocolVersionTLSv1 {
+		// 使用默认证书时的最低协议版本为 TLSv1，切换到自定义证书时将其提升为推荐值
+		viewerCertificate.MinimumProtocolVersion = awsCfTypes.MinimumProtocolVersionTLSv122021
+	}
+	updateDistributionResp, err := d.sdkClient.UpdateDistribution(ctx, updateDistributionReq)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudfront.UpdateDistribution'")
 	}
 
 	d.logger.Logt("已更新分配配置", updateDistributionResp)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func createSdkClient(accessKeyId, secretAccessKey, region string) (*awsCf.Client, error) {
@@ -124,7 +144,9 @@ func createSdkClient(accessKeyId, secretAccessKey, region string) (*awsCf.Client
 
 	client := awsCf.NewFromConfig(cfg, func(o *awsCf.Options) {
 		o.Region = region
-		o.Credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+		if accessKeyId != "" || secretAccessKey != "" {
+			o.Credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+		}
 	})
 	return client, nil
 }
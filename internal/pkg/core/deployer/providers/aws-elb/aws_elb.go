@@ -0,0 +1,335 @@
+﻿package awselb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	aws "github.com/aws/aws-sdk-go-v2/aws"
+	awsArn "github.com/aws/aws-sdk-go-v2/aws/arn"
+	awsCfg "github.com/aws/aws-sdk-go-v2/config"
+	awsCred "github.com/aws/aws-sdk-go-v2/credentials"
+	awsAcm "github.com/aws/aws-sdk-go-v2/service/acm"
+	awsElb "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awsElbTypes "github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+	awsIam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploaderspacm "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aws-acm"
+	uploaderspiam "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aws-iam"
+)
+
+type DeployerConfig struct {
+	// AWS AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// AWS SecretAccessKey。
+	SecretAccessKey string `json:"secretAccessKey"`
+	// AWS 区域。
+	Region string `json:"region"`
+	// 证书来源。
+	// 选填。零值时默认为 [CERTIFICATE_SOURCE_ACM]。
+	CertificateSource CertificateSourceType `json:"certificateSource,omitempty"`
+	// 负载均衡监听器 ARN。
+	ListenerArn string `json:"listenerArn"`
+	// 是否保留旧证书。
+	// 启用后，旧证书将作为 SNI 证书继续绑定在监听器上，直至其过期后才会被移除。
+	KeepOldCertificate bool `json:"keepOldCertificate,omitempty"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClients  *wSdkClients
+	sslUploader uploader.Uploader
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+type wSdkClients struct {
+	elb *awsElb.Client
+	acm *awsAcm.Client
+	iam *awsIam.Client
+}
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	clients, err := createSdkClients(config.AccessKeyId, config.SecretAccessKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk clients")
+	}
+
+	uploader, err := createSslUploader(config.AccessKeyId, config.SecretAccessKey, config.Region, config.CertificateSource)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClients:  clients,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ListenerArn == "" {
+		return nil, errors.New("config `listenerArn` is required")
+	}
+
+	// 上传证书到 ACM 或 IAM
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	// 查询监听器详情
+	// REF: https://docs.aws.amazon.com/en_us/elasticloadbalancing/latest/APIReference/API_DescribeListeners.html
+	describeListenersReq := &awsElb.DescribeListenersInput{
+		ListenerArns: []string{d.config.ListenerArn},
+	}
+	describeListenersResp, err := d.sdkClients.elb.DescribeListeners(ctx, describeListenersReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'elbv2.DescribeListeners'")
+	} else if len(describeListenersResp.Listeners) == 0 {
+		return nil, fmt.Errorf("listener '%s' not found", d.config.ListenerArn)
+	}
+
+	d.logger.Logt("已查询到 ELB 监听器", describeListenersResp.Listeners[0])
+
+	listener := describeListenersResp.Listeners[0]
+	if listener.Protocol != awsElbTypes.ProtocolEnumHttps && listener.Protocol != awsElbTypes.ProtocolEnumTls {
+		return nil, fmt.Errorf("listener '%s' is not a HTTPS or TLS listener", d.config.ListenerArn)
+	}
+
+	// 获取监听器原默认证书
+	oldCertArn := ""
+	for _, cert := range listener.Certificates {
+		if cert.IsDefault == nil || aws.ToBool(cert.IsDefault) {
+			oldCertArn = aws.ToString(cert.CertificateArn)
+			break
+		}
+	}
+
+	if oldCertArn == upres.CertId {
+		d.logger.Logt("监听器已绑定该证书，跳过更新")
+		return &deployer.DeployResult{CertId: upres.CertId}, nil
+	}
+
+	// 修改监听器默认证书
+	// REF: https://docs.aws.amazon.com/en_us/elasticloadbalancing/latest/APIReference/API_ModifyListener.html
+	modifyListenerReq := &awsElb.ModifyListenerInput{
+		ListenerArn: aws.String(d.config.ListenerArn),
+		Certificates: []awsElbTypes.Certificate{
+			{CertificateArn: aws.String(upres.CertId)},
+		},
+	}
+	modifyListenerResp, err := d.sdkClients.elb.ModifyListener(ctx, modifyListenerReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'elbv2.ModifyListener'")
+	}
+
+	d.logger.Logt("已修改 ELB 监听器默认证书", modifyListenerResp)
+
+	if d.config.KeepOldCertificate {
+		if oldCertArn != "" {
+			// 将原默认证书保留为 SNI 证书
+			// REF: https://docs.aws.amazon.com/en_us/elasticloadbalancing/latest/APIReference/API_AddListenerCertificates.html
+			addListenerCertificatesReq := &awsElb.AddListenerCertificatesInput{
+				ListenerArn: aws.String(d.config.ListenerArn),
+				Certificates: []awsElbTypes.Certificate{
+					{CertificateArn: aws.String(oldCertArn)},
+				},
+			}
+			addListenerCertificatesResp, err := d.sdkClients.elb.AddListenerCertificates(ctx, addListenerCertificatesReq)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'elbv2.AddListenerCertificates'")
+			}
+
+			d.logger.Logt("已保留原证书为 SNI 证书", addListenerCertificatesResp)
+		}
+
+		// 移除已过期的 SNI 证书
+		if err := d.removeExpiredListenerCertificates(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) removeExpiredListenerCertificates(ctx context.Context) error {
+	// 查询监听器证书列表
+	// REF: https://docs.aws.amazon.com/en_us/elasticloadbalancing/latest/APIReference/API_DescribeListenerCertificates.html
+	expiredCertArns := make([]string, 0)
+	var describeListenerCertificatesMarker *string = nil
+	for {
+		describeListenerCertificatesReq := &awsElb.DescribeListenerCertificatesInput{
+			ListenerArn: aws.String(d.config.ListenerArn),
+			Marker:      describeListenerCertificatesMarker,
+		}
+		describeListenerCertificatesResp, err := d.sdkClients.elb.DescribeListenerCertificates(ctx, describeListenerCertificatesReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'elbv2.DescribeListenerCertificates'")
+		}
+
+		for _, cert := range describeListenerCertificatesResp.Certificates {
+			if aws.ToBool(cert.IsDefault) {
+				continue
+			}
+
+			certArn := aws.ToString(cert.CertificateArn)
+			notAfter, err := d.getCertificateNotAfter(ctx, certArn)
+			if err != nil {
+				d.logger.Logt(fmt.Sprintf("failed to get certificate '%s' expiration", certArn), err)
+				continue
+			}
+
+			if notAfter.Before(time.Now()) {
+				expiredCertArns = append(expiredCertArns, certArn)
+			}
+		}
+
+		if describeListenerCertificatesResp.NextMarker == nil {
+			break
+		} else {
+			describeListenerCertificatesMarker = describeListenerCertificatesResp.NextMarker
+		}
+	}
+
+	if len(expiredCertArns) == 0 {
+		return nil
+	}
+
+	// 移除监听器证书
+	// REF: https://docs.aws.amazon.com/en_us/elasticloadbalancing/latest/APIReference/API_RemoveListenerCertificates.html
+	removeListenerCertificatesReq := &awsElb.RemoveListenerCertificatesInput{
+		ListenerArn:  aws.String(d.config.ListenerArn),
+		Certificates: make([]awsElbTypes.Certificate, 0, len(expiredCertArns)),
+	}
+	for _, certArn := range expiredCertArns {
+		removeListenerCertificatesReq.Certificates = append(removeListenerCertificatesReq.Certificates, awsElbTypes.Certificate{CertificateArn: aws.String(certArn)})
+	}
+	removeListenerCertificatesResp, err := d.sdkClients.elb.RemoveListenerCertificates(ctx, removeListenerCertificatesReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'elbv2.RemoveListenerCertificates'")
+	}
+
+	d.logger.Logt("已移除过期的 SNI 证书", removeListenerCertificatesResp)
+
+	return nil
+}
+
+func (d *DeployerProvider) getCertificateNotAfter(ctx context.Context, certArn string) (time.Time, error) {
+	arn, err := awsArn.Parse(certArn)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	switch arn.Service {
+	case "acm":
+		// REF: https://docs.aws.amazon.com/en_us/acm/latest/APIReference/API_DescribeCertificate.html
+		describeCertificateReq := &awsAcm.DescribeCertificateInput{
+			CertificateArn: aws.String(certArn),
+		}
+		describeCertificateResp, err := d.sdkClients.acm.DescribeCertificate(ctx, describeCertificateReq)
+		if err != nil {
+			return time.Time{}, xerrors.Wrap(err, "failed to execute sdk request 'acm.DescribeCertificate'")
+		} else if describeCertificateResp.Certificate == nil || describeCertificateResp.Certificate.NotAfter == nil {
+			return time.Time{}, errors.New("certificate expiration is unknown")
+		}
+
+		return *describeCertificateResp.Certificate.NotAfter, nil
+
+	case "iam":
+		// REF: https://docs.aws.amazon.com/en_us/IAM/latest/APIReference/API_GetServerCertificate.html
+		getServerCertificateReq := &awsIam.GetServerCertificateInput{
+			ServerCertificateName: aws.String(arn.Resource[strings.LastIndex(arn.Resource, "/")+1:]),
+		}
+		getServerCertificateResp, err := d.sdkClients.iam.GetServerCertificate(ctx, getServerCertificateReq)
+		if err != nil {
+			return time.Time{}, xerrors.Wrap(err, "failed to execute sdk request 'iam.GetServerCertificate'")
+		} else if getServerCertificateResp.ServerCertificate == nil || getServerCertificateResp.ServerCertificate.ServerCertificateMetadata.Expiration == nil {
+			return time.Time{}, errors.New("certificate expiration is unknown")
+		}
+
+		return *getServerCertificateResp.ServerCertificate.ServerCertificateMetadata.Expiration, nil
+	}
+
+	return time.Time{}, fmt.Errorf("unsupported certificate arn: %s", certArn)
+}
+
+func createSdkClients(accessKeyId, secretAccessKey, region string) (*wSdkClients, error) {
+	cfg, err := awsCfg.LoadDefaultConfig(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	var credentials aws.CredentialsProvider
+	if accessKeyId != "" || secretAccessKey != "" {
+		credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+	}
+
+	elbClient := awsElb.NewFromConfig(cfg, func(o *awsElb.Options) {
+		o.Region = region
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+	})
+
+	acmClient := awsAcm.NewFromConfig(cfg, func(o *awsAcm.Options) {
+		o.Region = region
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+	})
+
+	iamClient := awsIam.NewFromConfig(cfg, func(o *awsIam.Options) {
+		o.Region = region
+		if credentials != nil {
+			o.Credentials = credentials
+		}
+	})
+
+	return &wSdkClients{
+		elb: elbClient,
+		acm: acmClient,
+		iam: iamClient,
+	}, nil
+}
+
+func createSslUploader(accessKeyId, secretAccessKey, region string, source CertificateSourceType) (uploader.Uploader, error) {
+	switch source {
+	case "", CERTIFICATE_SOURCE_ACM:
+		uploader, err := uploaderspacm.NewUploader(&uploaderspacm.UploaderConfig{
+			AccessKeyId:     accessKeyId,
+			SecretAccessKey: secretAccessKey,
+			Region:          region,
+		})
+		return uploader, err
+
+	case CERTIFICATE_SOURCE_IAM:
+		uploader, err := uploaderspiam.NewUploader(&uploaderspiam.UploaderConfig{
+			AccessKeyId:     accessKeyId,
+			SecretAccessKey: secretAccessKey,
+			Region:          region,
+		})
+		return uploader, err
+	}
+
+	return nil, fmt.Errorf("unsupported certificate source: %s", source)
+}
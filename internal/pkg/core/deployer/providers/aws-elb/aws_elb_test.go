@@ -0,0 +1,90 @@
+package awselb_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/aws-elb"
+)
+
+var (
+	fInputCertPath      string
+	fInputKeyPath       string
+	fAccessKeyId        string
+	fSecretAccessKey    string
+	fRegion             string
+	fCertificateSource  string
+	fListenerArn        string
+	fKeepOldCertificate bool
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_AWSELB_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fAccessKeyId, argsPrefix+"ACCESSKEYID", "", "")
+	flag.StringVar(&fSecretAccessKey, argsPrefix+"SECRETACCESSKEY", "", "")
+	flag.StringVar(&fRegion, argsPrefix+"REGION", "", "")
+	flag.StringVar(&fCertificateSource, argsPrefix+"CERTIFICATESOURCE", "acm", "")
+	flag.StringVar(&fListenerArn, argsPrefix+"LISTENERARN", "", "")
+	flag.BoolVar(&fKeepOldCertificate, argsPrefix+"KEEPOLDCERTIFICATE", false, "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./aws_elb_test.go -args \
+	--CERTIMATE_DEPLOYER_AWSELB_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_AWSELB_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_AWSELB_ACCESSKEYID="your-access-key-id" \
+	--CERTIMATE_DEPLOYER_AWSELB_SECRETACCESSKEY="your-secret-access-key" \
+	--CERTIMATE_DEPLOYER_AWSELB_REGION="us-east-1" \
+	--CERTIMATE_DEPLOYER_AWSELB_CERTIFICATESOURCE="acm" \
+	--CERTIMATE_DEPLOYER_AWSELB_LISTENERARN="your-elb-listener-arn" \
+	--CERTIMATE_DEPLOYER_AWSELB_KEEPOLDCERTIFICATE=true
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("ACCESSKEYID: %v", fAccessKeyId),
+			fmt.Sprintf("SECRETACCESSKEY: %v", fSecretAccessKey),
+			fmt.Sprintf("REGION: %v", fRegion),
+			fmt.Sprintf("CERTIFICATESOURCE: %v", fCertificateSource),
+			fmt.Sprintf("LISTENERARN: %v", fListenerArn),
+			fmt.Sprintf("KEEPOLDCERTIFICATE: %v", fKeepOldCertificate),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			AccessKeyId:        fAccessKeyId,
+			SecretAccessKey:    fSecretAccessKey,
+			Region:             fRegion,
+			CertificateSource:  provider.CertificateSourceType(fCertificateSource),
+			ListenerArn:        fListenerArn,
+			KeepOldCertificate: fKeepOldCertificate,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
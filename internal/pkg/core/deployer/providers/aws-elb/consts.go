@@ -0,0 +1,10 @@
+﻿package awselb
+
+type CertificateSourceType string
+
+const (
+	// 证书来源：上传到 ACM。
+	CERTIFICATE_SOURCE_ACM = CertificateSourceType("acm")
+	// 证书来源：上传到 IAM。
+	CERTIFICATE_SOURCE_IAM = CertificateSourceType("iam")
+)
@@ -0,0 +1,185 @@
+package baiducloudblb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	bceBlb "github.com/baidubce/bce-sdk-go/services/blb"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/baiducloud-cert"
+)
+
+type DeployerConfig struct {
+	// 百度智能云 AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// 百度智能云 SecretAccessKey。
+	SecretAccessKey string `json:"secretAccessKey"`
+	// 百度智能云地域。
+	// 选填。零值时默认为 "bj"。
+	Region string `json:"region"`
+	// 负载均衡实例 ID。
+	LoadbalancerId string `json:"loadbalancerId"`
+	// 负载均衡监听端口。
+	ListenerPort int32 `json:"listenerPort"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *bceBlb.Client
+	sslUploader uploader.Uploader
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.AccessKeyId, config.SecretAccessKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		AccessKeyId:     config.AccessKeyId,
+		SecretAccessKey: config.SecretAccessKey,
+	})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.LoadbalancerId == "" {
+		return nil, errors.New("config `loadbalancerId` is required")
+	}
+	if d.config.ListenerPort <= 0 || d.config.ListenerPort > 65535 {
+		return nil, errors.New("config `listenerPort` is invalid")
+	}
+
+	// 查询监听器，HTTPS 监听器和 SSL 监听器需分别查询
+	httpsListener, sslListener, err := d.findListener(d.config.LoadbalancerId, uint16(d.config.ListenerPort))
+	if err != nil {
+		return nil, err
+	}
+
+	// 仅校验模式下只查询监听器，不实际上传和更新证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到证书管理服务
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	if httpsListener != nil {
+		// 更新 HTTPS 监听器
+		// REF: https://cloud.baidu.com/doc/BLB/s/yjwvxnvl6#updatehttpslistener%E6%9B%B4%E6%96%B0https%E7%9B%91%E5%90%AC%E5%99%A8
+		updateHTTPSListenerReq := &bceBlb.UpdateHTTPSListenerArgs{
+			ListenerPort:          httpsListener.ListenerPort,
+			CertIds:               []string{upres.CertId},
+			AdditionalCertDomains: httpsListener.AdditionalCertDomains,
+		}
+		if err := d.sdkClient.UpdateHTTPSListener(d.config.LoadbalancerId, updateHTTPSListenerReq); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'blb.UpdateHTTPSListener'")
+		}
+
+		d.logger.Logt("已更新 HTTPS 监听器", updateHTTPSListenerReq)
+	} else {
+		// 更新 SSL 监听器
+		// REF: https://cloud.baidu.com/doc/BLB/s/yjwvxnvl6#updatessllistener%E6%9B%B4%E6%96%B0ssl%E7%9B%91%E5%90%AC%E5%99%A8
+		updateSSLListenerReq := &bceBlb.UpdateSSLListenerArgs{
+			ListenerPort: sslListener.ListenerPort,
+			CertIds:      []string{upres.CertId},
+		}
+		if err := d.sdkClient.UpdateSSLListener(d.config.LoadbalancerId, updateSSLListenerReq); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'blb.UpdateSSLListener'")
+		}
+
+		d.logger.Logt("已更新 SSL 监听器", updateSSLListenerReq)
+	}
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) findListener(blbId string, listenerPort uint16) (*bceBlb.HTTPSListenerModel, *bceBlb.SSLListenerModel, error) {
+	// 查询 HTTPS 监听器
+	// REF: https://cloud.baidu.com/doc/BLB/s/yjwvxnvl6#describehttpslisteners%E6%9F%A5%E8%AF%A2https%E7%9B%91%E5%90%AC%E5%99%A8
+	describeHTTPSListenersReq := &bceBlb.DescribeListenerArgs{
+		ListenerPort: listenerPort,
+	}
+	describeHTTPSListenersResp, err := d.sdkClient.DescribeHTTPSListeners(blbId, describeHTTPSListenersReq)
+	if err != nil {
+		return nil, nil, xerrors.Wrap(err, "failed to execute sdk request 'blb.DescribeHTTPSListeners'")
+	}
+
+	for _, listener := range describeHTTPSListenersResp.ListenerList {
+		if listener.ListenerPort == listenerPort {
+			d.logger.Logt("已查询到 HTTPS 监听器", listener)
+			return &listener, nil, nil
+		}
+	}
+
+	// 查询 SSL 监听器
+	// REF: https://cloud.baidu.com/doc/BLB/s/yjwvxnvl6#describessllisteners%E6%9F%A5%E8%AF%A2ssl%E7%9B%91%E5%90%AC%E5%99%A8
+	describeSSLListenersReq := &bceBlb.DescribeListenerArgs{
+		ListenerPort: listenerPort,
+	}
+	describeSSLListenersResp, err := d.sdkClient.DescribeSSLListeners(blbId, describeSSLListenersReq)
+	if err != nil {
+		return nil, nil, xerrors.Wrap(err, "failed to execute sdk request 'blb.DescribeSSLListeners'")
+	}
+
+	for _, listener := range describeSSLListenersResp.ListenerList {
+		if listener.ListenerPort == listenerPort {
+			d.logger.Logt("已查询到 SSL 监听器", listener)
+			return nil, &listener, nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("could not find https or ssl listener on port %d", listenerPort)
+}
+
+func createSdkClient(accessKeyId, secretAccessKey, region string) (*bceBlb.Client, error) {
+	if region == "" {
+		region = "bj" // BLB 服务默认区域：北京
+	}
+
+	endpoint := fmt.Sprintf("blb.%s.baidubce.com", region)
+	client, err := bceBlb.NewClient(accessKeyId, secretAccessKey, endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -2,17 +2,21 @@
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"strings"
 
 	bpCdn "github.com/byteplus-sdk/byteplus-sdk-golang/service/cdn"
 	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/byteplus-cdn"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -22,6 +26,9 @@ type DeployerConfig struct {
 	SecretKey string `json:"secretKey"`
 	// 加速域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 是否部署到证书可覆盖的全部加速域名。
+	// 启用后将额外查询证书可关联的全部加速域名，并与证书的 SAN 进行匹配。
+	MatchCoveredDomains bool `json:"matchCoveredDomains,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -63,7 +70,16 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" && !d.config.MatchCoveredDomains {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
 	// 上传证书到 CDN
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -73,7 +89,10 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	d.logger.Logt("certificate file uploaded", upres)
 
 	domains := make([]string, 0)
-	if strings.HasPrefix(d.config.Domain, "*.") {
+	if d.config.Domain != "" && !strings.HasPrefix(d.config.Domain, "*.") {
+		domains = append(domains, d.config.Domain)
+	}
+	if strings.HasPrefix(d.config.Domain, "*.") || d.config.MatchCoveredDomains {
 		// 获取指定证书可关联的域名
 		// REF: https://docs.byteplus.com/en/docs/byteplus-cdn/reference-describecertconfig-9ea17
 		describeCertConfigReq := &bpCdn.DescribeCertConfigRequest{
@@ -86,31 +105,33 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 		if describeCertConfigResp.Result.CertNotConfig != nil {
 			for i := range describeCertConfigResp.Result.CertNotConfig {
-				domains = append(domains, describeCertConfigResp.Result.CertNotConfig[i].Domain)
+				domain := describeCertConfigResp.Result.CertNotConfig[i].Domain
+				if d.matchDomain(certX509, domain) && !slices.Contains(domains, domain) {
+					domains = append(domains, domain)
+				}
 			}
 		}
 
 		if describeCertConfigResp.Result.OtherCertConfig != nil {
 			for i := range describeCertConfigResp.Result.OtherCertConfig {
-				domains = append(domains, describeCertConfigResp.Result.OtherCertConfig[i].Domain)
+				domain := describeCertConfigResp.Result.OtherCertConfig[i].Domain
+				if d.matchDomain(certX509, domain) && !slices.Contains(domains, domain) {
+					domains = append(domains, domain)
+				}
 			}
 		}
 
 		if len(domains) == 0 {
-			if len(describeCertConfigResp.Result.SpecifiedCertConfig) > 0 {
+			if slices.ContainsFunc(describeCertConfigResp.Result.SpecifiedCertConfig, func(item bpCdn.DomainCertStatus) bool { return d.matchDomain(certX509, item.Domain) }) {
 				// 所有可关联的域名都配置了该证书，跳过部署
 			} else {
 				return nil, errors.New("domain not found")
 			}
 		}
-	} else {
-		domains = append(domains, d.config.Domain)
 	}
 
 	if len(domains) > 0 {
-		var errs []error
-
-		for _, domain := range domains {
+		err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
 			// 关联证书与加速域名
 			// REF: https://docs.byteplus.com/en/docs/byteplus-cdn/reference-batchdeploycert
 			batchDeployCertReq := &bpCdn.BatchDeployCertRequest{
@@ -119,16 +140,25 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			}
 			batchDeployCertResp, err := d.sdkClient.BatchDeployCert(batchDeployCertReq)
 			if err != nil {
-				errs = append(errs, err)
-			} else {
-				d.logger.Logt(fmt.Sprintf("已关联证书到域名 %s", domain), batchDeployCertResp)
+				return err
 			}
-		}
 
-		if len(errs) > 0 {
-			return nil, errors.Join(errs...)
+			d.logger.Logt(fmt.Sprintf("已关联证书到域名 %s", domain), batchDeployCertResp)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) matchDomain(certX509 *x509.Certificate, domain string) bool {
+	// 泛域名仅匹配其下一级子域名
+	if strings.HasPrefix(d.config.Domain, "*.") && certs.MatchHostname(d.config.Domain, domain) {
+		return true
+	}
+
+	return d.config.MatchCoveredDomains && certs.IsCertificateCoversHostname(certX509, domain)
 }
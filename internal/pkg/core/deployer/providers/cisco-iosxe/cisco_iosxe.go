@@ -0,0 +1,223 @@
+package ciscoiosxe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	"github.com/povsister/scp"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// SSH 主机。
+	SshHost string `json:"sshHost"`
+	// SSH 端口。
+	// 零值时默认为 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	// 需具备 15 级特权。
+	SshUsername string `json:"sshUsername"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword"`
+	// 信任点名称前缀。
+	// 零值时默认为 "CERTIMATE"。
+	TrustpointPrefix string `json:"trustpointPrefix,omitempty"`
+	// 是否将证书绑定到 HTTPS 服务（即 WebUI 及 RESTCONF 所使用的 "ip http secure-server"）。
+	// 为 false 时仅导入证书到信任点。
+	BindHttpSecureServer bool `json:"bindHttpSecureServer,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+// IOS-XE 命令行中以 "%" 开头的输出均表示错误，如 "% Invalid input detected"。
+var cliErrorRegexp = regexp.MustCompile(`(?m)^%\s*(Invalid|Incomplete|Error|Ambiguous|Unknown|Failed).*$`)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	trustpointPrefix := d.config.TrustpointPrefix
+	if trustpointPrefix == "" {
+		trustpointPrefix = "CERTIMATE"
+	}
+
+	// 每次导入均使用新的信任点，以免覆盖已有信任点时出现交互式确认
+	trustpoint := fmt.Sprintf("%s-%d", trustpointPrefix, time.Now().Unix())
+	pfxPassword := fmt.Sprintf("certimate%d", time.Now().UnixNano())
+	pfxFileName := strings.ToLower(trustpoint) + ".pfx"
+
+	pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, pfxPassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+	}
+
+	// 连接
+	client, err := createSshClient(d.config.SshHost, d.config.SshPort, d.config.SshUsername, d.config.SshPassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer client.Close()
+
+	d.logger.Logt("SSH connected")
+
+	// 通过 SCP 上传 PKCS#12 文件到设备闪存
+	// 需在设备上启用 "ip scp server enable"
+	if err := writeFileWithSCP(client, "flash:"+pfxFileName, pfxData); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", pfxFileName)
+
+	// 导入证书到信任点，然后按需绑定到 HTTPS 服务
+	// REF: https://www.cisco.com/c/en/us/td/docs/ios-xml/ios/sec_conn_pki/configuration/xe-17/sec-pki-xe-17-book/sec-deploy-rsa-pki.html
+	commands := []string{
+		"terminal length 0",
+		fmt.Sprintf("crypto pki import %s pkcs12 flash:%s password %s", trustpoint, pfxFileName, pfxPassword),
+		"", // 确认 "Source filename" 提示
+		fmt.Sprintf("delete /force flash:%s", pfxFileName),
+	}
+	if d.config.BindHttpSecureServer {
+		commands = append(commands,
+			"configure terminal",
+			fmt.Sprintf("crypto pki trustpoint %s", trustpoint),
+			"revocation-check none",
+			"exit",
+			fmt.Sprintf("ip http secure-trustpoint %s", trustpoint),
+			"no ip http secure-server",
+			"ip http secure-server",
+			"end",
+		)
+	}
+	commands = append(commands, "write memory", "exit")
+
+	output, err := execSshShell(client, commands)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to execute commands, output: %s", output)
+	}
+
+	if !strings.Contains(output, "Imported PKCS12 file successfully") {
+		return nil, fmt.Errorf("failed to import certificate, output: %s", output)
+	}
+	if matches := cliErrorRegexp.FindAllString(output, -1); len(matches) > 0 {
+		return nil, fmt.Errorf("failed to execute commands: %s, output: %s", strings.Join(matches, "; "), output)
+	}
+
+	d.logger.Logt("certificate imported to trustpoint", trustpoint)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSshClient(host string, port int32, username string, password string) (*ssh.Client, error) {
+	if host == "" {
+		return nil, errors.New("cisco: ssh host is required")
+	}
+
+	if port == 0 {
+		port = 22
+	}
+
+	return ssh.Dial("tcp", fmt.Sprintf("%s:%d", host, port), &ssh.ClientConfig{
+		User: username,
+		Auth: []ssh.AuthMethod{
+			ssh.Password(password),
+			ssh.KeyboardInteractive(func(user, instruction string, questions []string, echos []bool) ([]string, error) {
+				answers := make([]string, len(questions))
+				for i := range questions {
+					answers[i] = password
+				}
+				return answers, nil
+			}),
+		},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         30 * time.Second,
+	})
+}
+
+func execSshShell(sshCli *ssh.Client, commands []string) (string, error) {
+	session, err := sshCli.NewSession()
+	if err != nil {
+		return "", err
+	}
+	defer session.Close()
+
+	// IOS-XE 不支持在单个会话中执行多条 exec 命令，需通过交互式 Shell 逐行输入
+	if err := session.RequestPty("vt100", 200, 512, ssh.TerminalModes{ssh.ECHO: 0}); err != nil {
+		return "", xerrors.Wrap(err, "failed to request pty")
+	}
+
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		return "", err
+	}
+
+	outputBuf := bytes.NewBuffer(nil)
+	session.Stdout = outputBuf
+	session.Stderr = outputBuf
+
+	if err := session.Shell(); err != nil {
+		return "", xerrors.Wrap(err, "failed to start shell")
+	}
+
+	for _, command := range commands {
+		if _, err := io.WriteString(stdin, command+"\n"); err != nil {
+			return outputBuf.String(), err
+		}
+
+		// 逐行输入时留出处理时间，避免设备丢弃输入缓冲
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	if err := session.Wait(); err != nil {
+		var exitErr *ssh.ExitMissingError
+		if !errors.As(err, &exitErr) {
+			return outputBuf.String(), err
+		}
+	}
+
+	return outputBuf.String(), nil
+}
+
+func writeFileWithSCP(sshCli *ssh.Client, path string, data []byte) error {
+	scpCli, err := scp.NewClientFromExistingSSH(sshCli, &scp.ClientOption{})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create scp client")
+	}
+	defer scpCli.Close()
+
+	reader := bytes.NewReader(data)
+	err = scpCli.CopyToRemote(reader, path, &scp.FileTransferOption{})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to write to remote file")
+	}
+
+	return nil
+}
@@ -0,0 +1,80 @@
+package ciscoiosxe_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cisco-iosxe"
+)
+
+var (
+	fInputCertPath        string
+	fInputKeyPath         string
+	fSshHost              string
+	fSshUsername          string
+	fSshPassword          string
+	fBindHttpSecureServer bool
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_CISCOIOSXE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fSshHost, argsPrefix+"SSHHOST", "", "")
+	flag.StringVar(&fSshUsername, argsPrefix+"SSHUSERNAME", "", "")
+	flag.StringVar(&fSshPassword, argsPrefix+"SSHPASSWORD", "", "")
+	flag.BoolVar(&fBindHttpSecureServer, argsPrefix+"BINDHTTPSECURESERVER", true, "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./cisco_iosxe_test.go -args \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_SSHHOST="192.168.1.1" \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_SSHUSERNAME="admin" \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_SSHPASSWORD="password" \
+	--CERTIMATE_DEPLOYER_CISCOIOSXE_BINDHTTPSECURESERVER=true
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SSHHOST: %v", fSshHost),
+			fmt.Sprintf("SSHUSERNAME: %v", fSshUsername),
+			fmt.Sprintf("SSHPASSWORD: %v", fSshPassword),
+			fmt.Sprintf("BINDHTTPSECURESERVER: %v", fBindHttpSecureServer),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			SshHost:              fSshHost,
+			SshUsername:          fSshUsername,
+			SshPassword:          fSshPassword,
+			BindHttpSecureServer: fBindHttpSecureServer,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,141 @@
+﻿package cloudflaresaas
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/cloudflare/cloudflare-go"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// Cloudflare API Token。
+	ApiToken string `json:"apiToken"`
+	// Cloudflare 区域 ID。
+	ZoneId string `json:"zoneId"`
+	// 自定义主机名。
+	CustomHostname string `json:"customHostname"`
+	// 证书链捆绑方式。
+	// 选填。可取值 "ubiquitous"、"optimal"、"force"；零值时默认为 "ubiquitous"。
+	BundleMethod string `json:"bundleMethod,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *cloudflare.API
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ZoneId == "" {
+		return nil, errors.New("config `zoneId` is required")
+	}
+	if d.config.CustomHostname == "" {
+		return nil, errors.New("config `customHostname` is required")
+	}
+
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询自定义主机名 ID
+	// REF: https://developers.cloudflare.com/api/resources/custom_hostnames/methods/list/
+	customHostnameId, err := d.sdkClient.CustomHostnameIDByName(ctx, d.config.ZoneId, d.config.CustomHostname)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.CustomHostnameIDByName'")
+	}
+
+	// 获取自定义主机名详情
+	// REF: https://developers.cloudflare.com/api/resources/custom_hostnames/methods/get/
+	getCustomHostnameResp, err := d.sdkClient.CustomHostname(ctx, d.config.ZoneId, customHostnameId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.CustomHostname'")
+	}
+
+	d.logger.Logt("已获取自定义主机名详情", getCustomHostnameResp)
+
+	// 如果已部署相同证书，则跳过
+	oldSSL := getCustomHostnameResp.SSL
+	if oldSSL == nil {
+		oldSSL = &cloudflare.CustomHostnameSSL{}
+	}
+	if oldSSL.SerialNumber != "" && strings.EqualFold(oldSSL.SerialNumber, certX509.SerialNumber.Text(16)) {
+		d.logger.Logt("已部署相同证书，跳过部署", oldSSL.SerialNumber)
+		return &deployer.DeployResult{}, nil
+	}
+
+	bundleMethod := d.config.BundleMethod
+	if bundleMethod == "" {
+		bundleMethod = "ubiquitous"
+	}
+
+	method := oldSSL.Method
+	if method == "" {
+		method = "http"
+	}
+
+	// 更新自定义主机名的证书
+	// 沿用原有的 TLS 设置，仅替换证书及私钥
+	// REF: https://developers.cloudflare.com/api/resources/custom_hostnames/methods/edit/
+	updateCustomHostnameSSLReq := &cloudflare.CustomHostnameSSL{
+		Method:            method,
+		Type:              "dv",
+		Wildcard:          oldSSL.Wildcard,
+		Settings:          oldSSL.Settings,
+		BundleMethod:      bundleMethod,
+		CustomCertificate: certPem,
+		CustomKey:         privkeyPem,
+	}
+	updateCustomHostnameSSLResp, err := d.sdkClient.UpdateCustomHostnameSSL(ctx, d.config.ZoneId, customHostnameId, updateCustomHostnameSSLReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.UpdateCustomHostnameSSL'")
+	}
+
+	d.logger.Logt("已更新自定义主机名证书", updateCustomHostnameSSLResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiToken string) (*cloudflare.API, error) {
+	if apiToken == "" {
+		return nil, errors.New("invalid cloudflare api token")
+	}
+
+	client, err := cloudflare.NewWithAPIToken(apiToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,75 @@
+package cloudflaresaas_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/cloudflare-saas"
+)
+
+var (
+	fInputCertPath  string
+	fInputKeyPath   string
+	fApiToken       string
+	fZoneId         string
+	fCustomHostname string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_CLOUDFLARESAAS_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+	flag.StringVar(&fZoneId, argsPrefix+"ZONEID", "", "")
+	flag.StringVar(&fCustomHostname, argsPrefix+"CUSTOMHOSTNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./cloudflare_saas_test.go -args \
+	--CERTIMATE_DEPLOYER_CLOUDFLARESAAS_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_CLOUDFLARESAAS_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_CLOUDFLARESAAS_APITOKEN="your-api-token" \
+	--CERTIMATE_DEPLOYER_CLOUDFLARESAAS_ZONEID="your-zone-id" \
+	--CERTIMATE_DEPLOYER_CLOUDFLARESAAS_CUSTOMHOSTNAME="shop.example.com"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+			fmt.Sprintf("ZONEID: %v", fZoneId),
+			fmt.Sprintf("CUSTOMHOSTNAME: %v", fCustomHostname),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ApiToken:       fApiToken,
+			ZoneId:         fZoneId,
+			CustomHostname: fCustomHostname,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
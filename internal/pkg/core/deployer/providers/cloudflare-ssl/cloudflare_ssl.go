@@ -0,0 +1,158 @@
+﻿package cloudflaressl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/cloudflare/cloudflare-go"
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// Cloudflare API Token。
+	ApiToken string `json:"apiToken"`
+	// Cloudflare 区域 ID。
+	ZoneId string `json:"zoneId"`
+	// 自定义证书 ID。
+	// 选填。零值时将替换与待部署证书域名重叠的自定义证书；如果不存在，则新建自定义证书。
+	CertificateId string `json:"certificateId,omitempty"`
+	// 证书链捆绑方式。
+	// 选填。可取值 "ubiquitous"、"optimal"、"force"；零值时默认为 "ubiquitous"。
+	BundleMethod string `json:"bundleMethod,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *cloudflare.API
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ZoneId == "" {
+		return nil, errors.New("config `zoneId` is required")
+	}
+
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取自定义证书列表
+	// REF: https://developers.cloudflare.com/api/resources/custom_certificates/methods/list/
+	listSSLResp, err := d.sdkClient.ListSSL(ctx, d.config.ZoneId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.ListSSL'")
+	}
+
+	d.logger.Logt("已获取自定义证书列表", listSSLResp)
+
+	// 查找需要被替换的自定义证书
+	var oldCert *cloudflare.ZoneCustomSSL
+	for i, cert := range listSSLResp {
+		if d.config.CertificateId != "" {
+			if cert.ID == d.config.CertificateId {
+				oldCert = &listSSLResp[i]
+				break
+			}
+
+			continue
+		}
+
+		if slices.ContainsFunc(cert.Hosts, func(host string) bool { return slices.Contains(certX509.DNSNames, host) }) {
+			oldCert = &listSSLResp[i]
+			break
+		}
+	}
+	if d.config.CertificateId != "" && oldCert == nil {
+		return nil, errors.New("custom certificate not found")
+	}
+
+	// 如果证书的域名及有效期均一致，则视为已部署相同证书
+	if oldCert != nil && oldCert.ExpiresOn.Equal(certX509.NotAfter) && len(oldCert.Hosts) == len(certX509.DNSNames) {
+		if !slices.ContainsFunc(oldCert.Hosts, func(host string) bool { return !slices.Contains(certX509.DNSNames, host) }) {
+			d.logger.Logt("已存在相同的自定义证书，跳过部署", oldCert.ID)
+			return &deployer.DeployResult{}, nil
+		}
+	}
+
+	bundleMethod := d.config.BundleMethod
+	if bundleMethod == "" {
+		bundleMethod = "ubiquitous"
+	}
+
+	if oldCert == nil {
+		// 上传自定义证书
+		// REF: https://developers.cloudflare.com/api/resources/custom_certificates/methods/create/
+		createSSLReq := cloudflare.ZoneCustomSSLOptions{
+			Certificate:  certPem,
+			PrivateKey:   privkeyPem,
+			BundleMethod: bundleMethod,
+			Type:         "sni_custom",
+		}
+		createSSLResp, err := d.sdkClient.CreateSSL(ctx, d.config.ZoneId, createSSLReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.CreateSSL'")
+		}
+
+		d.logger.Logt("已上传自定义证书", createSSLResp)
+	} else {
+		// 替换自定义证书
+		// REF: https://developers.cloudflare.com/api/resources/custom_certificates/methods/edit/
+		updateSSLReq := cloudflare.ZoneCustomSSLOptions{
+			Certificate:  certPem,
+			PrivateKey:   privkeyPem,
+			BundleMethod: bundleMethod,
+		}
+		updateSSLResp, err := d.sdkClient.UpdateSSL(ctx, d.config.ZoneId, oldCert.ID, updateSSLReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'cloudflare.UpdateSSL'")
+		}
+
+		d.logger.Logt("已替换自定义证书", updateSSLResp)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiToken string) (*cloudflare.API, error) {
+	if apiToken == "" {
+		return nil, errors.New("invalid cloudflare api token")
+	}
+
+	client, err := cloudflare.NewWithAPIToken(apiToken)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
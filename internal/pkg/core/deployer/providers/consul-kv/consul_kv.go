@@ -0,0 +1,159 @@
+package consulkv
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	consulsdk "github.com/usual2970/certimate/internal/pkg/vendors/consul-sdk"
+)
+
+type DeployerConfig struct {
+	// Consul 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Consul ACL 令牌。
+	// 选填。仅在 Consul 启用了 ACL 时需要。
+	Token string `json:"token,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// Consul 数据中心。
+	// 选填。零值时使用所连接的 Agent 所在的数据中心。
+	Datacenter string `json:"datacenter,omitempty"`
+	// 键前缀，如 "certs/example.com"。
+	KeyPrefix string `json:"keyPrefix"`
+	// 存放证书（含证书链）的键名。
+	// 零值时默认为 "certificate"。
+	KeyForCertificate string `json:"keyForCertificate,omitempty"`
+	// 存放私钥的键名。
+	// 零值时默认为 "private_key"。
+	KeyForPrivateKey string `json:"keyForPrivateKey,omitempty"`
+	// 存放中间证书链的键名。
+	// 零值时默认为 "ca_chain"。
+	KeyForCertificateChain string `json:"keyForCertificateChain,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *consulsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Token, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	keyPrefix := strings.Trim(d.config.KeyPrefix, "/")
+	if keyPrefix == "" {
+		return nil, errors.New("config `keyPrefix` is required")
+	}
+
+	keyForCertificate := d.config.KeyForCertificate
+	if keyForCertificate == "" {
+		keyForCertificate = "certificate"
+	}
+	keyForPrivateKey := d.config.KeyForPrivateKey
+	if keyForPrivateKey == "" {
+		keyForPrivateKey = "private_key"
+	}
+	keyForCertificateChain := d.config.KeyForCertificateChain
+	if keyForCertificateChain == "" {
+		keyForCertificateChain = "ca_chain"
+	}
+
+	// 提取中间证书链
+	_, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 读取证书所在的键，以检查令牌是否具有访问权限
+	// REF: https://developer.hashicorp.com/consul/api-docs/kv#read-key
+	// 注意不要将键值内容输出到日志中
+	certKey := keyPrefix + "/" + keyForCertificate
+	if _, err := d.sdkClient.KVGet(d.config.Datacenter, certKey); err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'consul.KVGet'")
+	}
+
+	// 仅校验模式下只检查键是否可读，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: consul kv is readable, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 在同一事务中写入证书、私钥和证书链，避免 consul-template 等读取到不一致的数据
+	// REF: https://developer.hashicorp.com/consul/api-docs/txn
+	txnOps := []*consulsdk.TxnOp{
+		{KV: &consulsdk.TxnKVOp{Verb: "set", Key: certKey, Value: []byte(certPem)}},
+		{KV: &consulsdk.TxnKVOp{Verb: "set", Key: keyPrefix + "/" + keyForPrivateKey, Value: []byte(privkeyPem)}},
+		{KV: &consulsdk.TxnKVOp{Verb: "set", Key: keyPrefix + "/" + keyForCertificateChain, Value: []byte(interCertPem)}},
+	}
+	txnResp, err := d.sdkClient.Txn(d.config.Datacenter, txnOps)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'consul.Txn'")
+	}
+
+	modifyIndex := uint64(0)
+	for _, result := range txnResp.Results {
+		if result.KV != nil && result.KV.ModifyIndex > modifyIndex {
+			modifyIndex = result.KV.ModifyIndex
+		}
+	}
+
+	d.logger.Logt("已写入 Consul KV", map[string]any{"keyPrefix": keyPrefix, "modifyIndex": modifyIndex})
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"modifyIndex": modifyIndex,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, token string, skipTlsVerify bool) (*consulsdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid consul server url")
+	}
+
+	client := consulsdk.NewClient(serverUrl).
+		WithToken(token).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
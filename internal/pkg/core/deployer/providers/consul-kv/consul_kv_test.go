@@ -0,0 +1,80 @@
+package consulkv_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/consul-kv"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fToken         string
+	fDatacenter    string
+	fKeyPrefix     string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_CONSULKV_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fToken, argsPrefix+"TOKEN", "", "")
+	flag.StringVar(&fDatacenter, argsPrefix+"DATACENTER", "", "")
+	flag.StringVar(&fKeyPrefix, argsPrefix+"KEYPREFIX", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./consul_kv_test.go -args \
+	--CERTIMATE_DEPLOYER_CONSULKV_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_CONSULKV_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_CONSULKV_SERVERURL="http://127.0.0.1:8500" \
+	--CERTIMATE_DEPLOYER_CONSULKV_TOKEN="your-consul-token" \
+	--CERTIMATE_DEPLOYER_CONSULKV_DATACENTER="dc1" \
+	--CERTIMATE_DEPLOYER_CONSULKV_KEYPREFIX="certs/example.com"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("TOKEN: %v", fToken),
+			fmt.Sprintf("DATACENTER: %v", fDatacenter),
+			fmt.Sprintf("KEYPREFIX: %v", fKeyPrefix),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:  fServerUrl,
+			Token:      fToken,
+			Datacenter: fDatacenter,
+			KeyPrefix:  fKeyPrefix,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,143 @@
+package cpanelssl
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	cpanelsdk "github.com/usual2970/certimate/internal/pkg/vendors/cpanel-sdk"
+)
+
+type DeployerConfig struct {
+	// cPanel 地址。
+	ServerUrl string `json:"serverUrl"`
+	// cPanel 用户名。
+	Username string `json:"username"`
+	// cPanel API 令牌。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 托管的域名。
+	// 须为当前账户下的主域名、附加域名、子域名或停放域名。
+	Domain string `json:"domain"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *cpanelsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.ApiToken, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 查询账户下托管的域名，确认目标域名存在
+	// REF: https://api.docs.cpanel.net/openapi/cpanel/operation/list_domains/
+	listDomainsResp, err := d.sdkClient.DomainInfoListDomains()
+	d.logger.Logt("已查询到托管域名列表", listDomainsResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cpanel.DomainInfoListDomains'")
+	}
+
+	hostedDomains := make([]string, 0)
+	hostedDomains = append(hostedDomains, listDomainsResp.MainDomain)
+	hostedDomains = append(hostedDomains, listDomainsResp.AddonDomains...)
+	hostedDomains = append(hostedDomains, listDomainsResp.SubDomains...)
+	hostedDomains = append(hostedDomains, listDomainsResp.ParkedDomains...)
+	if !slices.ContainsFunc(hostedDomains, func(s string) bool { return strings.EqualFold(s, d.config.Domain) }) {
+		return nil, xerrors.Errorf("could not find domain '%s' in cpanel account", d.config.Domain)
+	}
+
+	// 仅校验模式下只检查 API 令牌及域名是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: certificate would be installed for domain", d.config.Domain)
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 为域名安装证书
+	// REF: https://api.docs.cpanel.net/openapi/cpanel/operation/install_ssl/
+	installSSLReq := &cpanelsdk.SSLInstallRequest{
+		Domain:   d.config.Domain,
+		Cert:     serverCertPem,
+		Key:      privkeyPem,
+		CABundle: intermediaCertPem,
+	}
+	installSSLResp, err := d.sdkClient.SSLInstallSSL(installSSLReq)
+	d.logger.Logt("已安装证书", installSSLResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cpanel.SSLInstallSSL'")
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"domain": d.config.Domain,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, username, apiToken string, skipTlsVerify bool) (*cpanelsdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid cpanel server url")
+	}
+	if username == "" {
+		return nil, errors.New("invalid cpanel username")
+	}
+	if apiToken == "" {
+		return nil, errors.New("invalid cpanel api token")
+	}
+
+	client := cpanelsdk.NewClient(serverUrl, username, apiToken).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
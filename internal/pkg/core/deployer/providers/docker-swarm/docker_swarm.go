@@ -0,0 +1,316 @@
+package dockerswarm
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	dockersdk "github.com/usual2970/certimate/internal/pkg/vendors/docker-sdk"
+)
+
+type DeployerConfig struct {
+	// Docker 守护进程地址。
+	DockerHost string `json:"dockerHost"`
+	// TLS CA 证书内容。
+	TLSCACertificate string `json:"tlsCaCertificate,omitempty"`
+	// TLS 客户端证书内容。
+	TLSCertificate string `json:"tlsCertificate,omitempty"`
+	// TLS 客户端私钥内容。
+	TLSPrivateKey string `json:"tlsPrivateKey,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// Docker Secret 名称前缀。
+	// 选填。零值时默认为 "certimate"。
+	SecretNamePrefix string `json:"secretNamePrefix,omitempty"`
+	// Docker Swarm 服务名称或 ID 列表。
+	ServiceNames []string `json:"serviceNames"`
+	// 证书在容器内的文件名。
+	// 选填。零值时默认为 "tls.crt"。
+	CertificateFileName string `json:"certificateFileName,omitempty"`
+	// 私钥在容器内的文件名。
+	// 选填。零值时默认为 "tls.key"。
+	PrivateKeyFileName string `json:"privateKeyFileName,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *dockersdk.Client
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+const secretLabelPrefix = "certimate.secret-prefix"
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.DockerHost, config.TLSCACertificate, config.TLSCertificate, config.TLSPrivateKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if len(d.config.ServiceNames) == 0 {
+		return nil, errors.New("config `serviceNames` is required")
+	}
+
+	secretNamePrefix := d.config.SecretNamePrefix
+	if secretNamePrefix == "" {
+		secretNamePrefix = "certimate"
+	}
+	certFileName := d.config.CertificateFileName
+	if certFileName == "" {
+		certFileName = "tls.crt"
+	}
+	keyFileName := d.config.PrivateKeyFileName
+	if keyFileName == "" {
+		keyFileName = "tls.key"
+	}
+
+	// 创建新版本的 Secret
+	// REF: https://docs.docker.com/reference/api/engine/version/v1.41/#tag/Secret/operation/SecretCreate
+	version := time.Now().UnixMilli()
+	certSecret, err := d.createSecret(fmt.Sprintf("%s-crt-%d", secretNamePrefix, version), secretNamePrefix, certPem)
+	if err != nil {
+		return nil, err
+	}
+	keySecret, err := d.createSecret(fmt.Sprintf("%s-key-%d", secretNamePrefix, version), secretNamePrefix, privkeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 更新服务所引用的 Secret，并等待滚动更新完成
+	for _, serviceName := range d.config.ServiceNames {
+		if serviceName == "" {
+			continue
+		}
+
+		if err := d.updateServiceSecrets(ctx, serviceName, map[string]*dockersdk.Secret{certFileName: certSecret, keyFileName: keySecret}); err != nil {
+			return nil, err
+		}
+	}
+
+	// 移除已不再使用的旧版本 Secret
+	if err := d.removeStaleSecrets(secretNamePrefix, []string{certSecret.ID, keySecret.ID}); err != nil {
+		d.logger.Logt("移除旧版本 Secret 失败", err.Error())
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) createSecret(secretName string, secretNamePrefix string, data string) (*dockersdk.Secret, error) {
+	secretCreateReq := &dockersdk.SecretCreateRequest{
+		SecretSpec: dockersdk.SecretSpec{
+			Name:   secretName,
+			Labels: map[string]string{secretLabelPrefix: secretNamePrefix},
+			Data:   base64.StdEncoding.EncodeToString([]byte(data)),
+		},
+	}
+	secretCreateResp, err := d.sdkClient.SecretCreate(secretCreateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'docker.SecretCreate'")
+	}
+
+	d.logger.Logt("已创建 Secret", secretName)
+
+	return &dockersdk.Secret{ID: secretCreateResp.ID, Spec: secretCreateReq.SecretSpec}, nil
+}
+
+func (d *DeployerProvider) updateServiceSecrets(ctx context.Context, serviceName string, secrets map[string]*dockersdk.Secret) error {
+	// 获取服务详情
+	// REF: https://docs.docker.com/reference/api/engine/version/v1.41/#tag/Service/operation/ServiceInspect
+	serviceInspectResp, err := d.sdkClient.ServiceInspect(serviceName)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to execute sdk request 'docker.ServiceInspect' (service: %s)", serviceName)
+	}
+
+	taskTemplate, _ := serviceInspectResp.Spec["TaskTemplate"].(map[string]any)
+	if taskTemplate == nil {
+		return fmt.Errorf("service '%s' has no task template", serviceName)
+	}
+	containerSpec, _ := taskTemplate["ContainerSpec"].(map[string]any)
+	if containerSpec == nil {
+		return fmt.Errorf("service '%s' has no container spec", serviceName)
+	}
+
+	// 替换挂载到相同文件名的 Secret 引用，并沿用其原有的文件属性
+	oldSecretRefs, _ := containerSpec["Secrets"].([]any)
+	newSecretRefs := make([]any, 0, len(oldSecretRefs)+len(secrets))
+	newSecretFiles := make(map[string]map[string]any)
+	for _, ref := range oldSecretRefs {
+		refMap, _ := ref.(map[string]any)
+		refFile, _ := refMap["File"].(map[string]any)
+		if refFile != nil {
+			if fileName, _ := refFile["Name"].(string); fileName != "" {
+				if _, ok := secrets[fileName]; ok {
+					newSecretFiles[fileName] = refFile
+					continue
+				}
+			}
+		}
+
+		newSecretRefs = append(newSecretRefs, ref)
+	}
+	for fileName, secret := range secrets {
+		refFile, ok := newSecretFiles[fileName]
+		if !ok {
+			refFile = map[string]any{
+				"Name": fileName,
+				"UID":  "0",
+				"GID":  "0",
+				"Mode": 0o444,
+			}
+		}
+
+		newSecretRefs = append(newSecretRefs, map[string]any{
+			"File":       refFile,
+			"SecretID":   secret.ID,
+			"SecretName": secret.Spec.Name,
+		})
+	}
+	containerSpec["Secrets"] = newSecretRefs
+
+	// 更新服务
+	// REF: https://docs.docker.com/reference/api/engine/version/v1.41/#tag/Service/operation/ServiceUpdate
+	var lastUpdateStartedAt *time.Time
+	if serviceInspectResp.UpdateStatus != nil {
+		lastUpdateStartedAt = serviceInspectResp.UpdateStatus.StartedAt
+	}
+	serviceUpdateReq := &dockersdk.ServiceUpdateRequest{
+		Version: serviceInspectResp.Version.Index,
+		Spec:    serviceInspectResp.Spec,
+	}
+	serviceUpdateResp, err := d.sdkClient.ServiceUpdate(serviceInspectResp.ID, serviceUpdateReq)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to execute sdk request 'docker.ServiceUpdate' (service: %s)", serviceName)
+	}
+
+	d.logger.Logt(fmt.Sprintf("已更新服务 %s", serviceName), serviceUpdateResp)
+
+	// 循环查询服务详情，等待滚动更新完成
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		serviceInspectResp, err := d.sdkClient.ServiceInspect(serviceInspectResp.ID)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'docker.ServiceInspect' (service: %s)", serviceName)
+		}
+
+		updateStatus := serviceInspectResp.UpdateStatus
+		if updateStatus != nil && updateStatus.StartedAt != nil && (lastUpdateStartedAt == nil || !updateStatus.StartedAt.Equal(*lastUpdateStartedAt)) {
+			switch updateStatus.State {
+			case "completed":
+				d.logger.Logt(fmt.Sprintf("服务 %s 已完成滚动更新", serviceName), updateStatus)
+				return nil
+
+			case "paused", "rollback_started", "rollback_paused", "rollback_completed":
+				return fmt.Errorf("service '%s' update failed: %s, %s", serviceName, updateStatus.State, updateStatus.Message)
+			}
+		}
+
+		d.logger.Logt(fmt.Sprintf("服务 %s 滚动更新中，等待完成……", serviceName))
+		time.Sleep(time.Second * 5)
+	}
+}
+
+func (d *DeployerProvider) removeStaleSecrets(secretNamePrefix string, excludeSecretIds []string) error {
+	// 获取 Secret 列表
+	// REF: https://docs.docker.com/reference/api/engine/version/v1.41/#tag/Secret/operation/SecretList
+	secretListReq := &dockersdk.SecretListRequest{
+		Filters: map[string][]string{
+			"label": {fmt.Sprintf("%s=%s", secretLabelPrefix, secretNamePrefix)},
+		},
+	}
+	secretListResp, err := d.sdkClient.SecretList(secretListReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'docker.SecretList'")
+	}
+
+	var errs []error
+	for _, secret := range secretListResp {
+		if slices.Contains(excludeSecretIds, secret.ID) {
+			continue
+		}
+
+		// 删除 Secret
+		// 仍被其他服务引用的 Secret 将删除失败，此时保留即可
+		// REF: https://docs.docker.com/reference/api/engine/version/v1.41/#tag/Secret/operation/SecretDelete
+		if err := d.sdkClient.SecretDelete(secret.ID); err != nil {
+			errs = append(errs, xerrors.Wrapf(err, "failed to execute sdk request 'docker.SecretDelete' (secret: %s)", secret.Spec.Name))
+			continue
+		}
+
+		d.logger.Logt("已删除旧版本 Secret", secret.Spec.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+func createSdkClient(dockerHost, tlsCACertificate, tlsCertificate, tlsPrivateKey string, skipTlsVerify bool) (*dockersdk.Client, error) {
+	if dockerHost == "" {
+		return nil, errors.New("invalid docker host")
+	}
+
+	client, err := dockersdk.NewClient(dockerHost)
+	if err != nil {
+		return nil, err
+	}
+
+	hostUrl, _ := url.Parse(dockerHost)
+	if hostUrl.Scheme == "unix" {
+		return client, nil
+	}
+
+	if tlsCACertificate != "" || tlsCertificate != "" || tlsPrivateKey != "" || skipTlsVerify || hostUrl.Scheme == "https" {
+		tlsConfig := &tls.Config{InsecureSkipVerify: skipTlsVerify}
+
+		if tlsCACertificate != "" {
+			certPool := x509.NewCertPool()
+			if !certPool.AppendCertsFromPEM([]byte(tlsCACertificate)) {
+				return nil, errors.New("invalid tls ca certificate")
+			}
+			tlsConfig.RootCAs = certPool
+		}
+
+		if tlsCertificate != "" || tlsPrivateKey != "" {
+			tlsKeyPair, err := tls.X509KeyPair([]byte(tlsCertificate), []byte(tlsPrivateKey))
+			if err != nil {
+				return nil, xerrors.Wrap(err, "invalid tls client certificate")
+			}
+			tlsConfig.Certificates = []tls.Certificate{tlsKeyPair}
+		}
+
+		client.WithTLSConfig(tlsConfig)
+	}
+
+	return client, nil
+}
@@ -0,0 +1,70 @@
+package dockerswarm_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/docker-swarm"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fDockerHost    string
+	fServiceName   string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_DOCKERSWARM_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fDockerHost, argsPrefix+"DOCKERHOST", "unix:///var/run/docker.sock", "")
+	flag.StringVar(&fServiceName, argsPrefix+"SERVICENAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./docker_swarm_test.go -args \
+	--CERTIMATE_DEPLOYER_DOCKERSWARM_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_DOCKERSWARM_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_DOCKERSWARM_DOCKERHOST="unix:///var/run/docker.sock" \
+	--CERTIMATE_DEPLOYER_DOCKERSWARM_SERVICENAME="your-service-name"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("DOCKERHOST: %v", fDockerHost),
+			fmt.Sprintf("SERVICENAME: %v", fServiceName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			DockerHost:   fDockerHost,
+			ServiceNames: []string{fServiceName},
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -2,7 +2,9 @@
 
 import (
 	"context"
+	"errors"
 	"strconv"
+	"strings"
 
 	xerrors "github.com/pkg/errors"
 
@@ -59,7 +61,13 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	} else if strings.HasPrefix(d.config.Domain, "*.") {
+		return nil, errors.New("config `domain` does not support wildcard domain")
+	}
+
 	// 上传证书到 CDN
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -70,13 +78,17 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	// 绑定证书
 	// REF: https://docs.dogecloud.com/cdn/api-cert-bind
-	bindCdnCertId, _ := strconv.ParseInt(upres.CertId, 10, 64)
+	bindCdnCertId, err := strconv.ParseInt(upres.CertId, 10, 64)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to parse certificate id '%s'", upres.CertId)
+	}
+
 	bindCdnCertResp, err := d.sdkClient.BindCdnCertWithDomain(bindCdnCertId, d.config.Domain)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.BindCdnCert'")
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.BindCdnCertWithDomain'")
 	}
 
 	d.logger.Logt("已绑定证书", bindCdnCertResp)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
@@ -0,0 +1,152 @@
+package etcd
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	clientv3 "go.etcd.io/etcd/client/v3"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+)
+
+type DeployerConfig struct {
+	// etcd 服务端点列表，多个值之间以半角分号分隔。
+	Endpoints string `json:"endpoints"`
+	// etcd 用户名。
+	// 零值时表示不启用身份认证。
+	Username string `json:"username,omitempty"`
+	// etcd 密码。
+	Password string `json:"password,omitempty"`
+	// 是否使用 TLS 连接。
+	UseTLS bool `json:"useTLS,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 用于存放证书的键。
+	KeyForCertificate string `json:"keyForCertificate"`
+	// 用于存放私钥的键。
+	KeyForPrivateKey string `json:"keyForPrivateKey"`
+	// 键的存活时长（单位：秒）。
+	// 零值时表示不绑定租约，键将永久保留。
+	TTL int64 `json:"ttl,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.KeyForCertificate == "" {
+		return nil, errors.New("config `keyForCertificate` is required")
+	}
+	if d.config.KeyForPrivateKey == "" {
+		return nil, errors.New("config `keyForPrivateKey` is required")
+	}
+	if d.config.TTL < 0 {
+		return nil, errors.New("config `ttl` must be non-negative")
+	}
+
+	// 连接
+	client, err := createClient(d.config)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create etcd client")
+	}
+	defer client.Close()
+
+	// 仅校验模式下只检查连通性及读取权限，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		if _, err := client.Get(ctx, d.config.KeyForCertificate, clientv3.WithCountOnly()); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'etcd.Get'")
+		}
+
+		d.logger.Logt("dry run: etcd is reachable, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 按需申请租约
+	putOpts := make([]clientv3.OpOption, 0)
+	if d.config.TTL > 0 {
+		grantResp, err := client.Grant(ctx, d.config.TTL)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'etcd.Grant'")
+		}
+
+		d.logger.Logt("etcd lease granted", grantResp.ID)
+		putOpts = append(putOpts, clientv3.WithLease(grantResp.ID))
+	}
+
+	// 在同一事务中写入证书和私钥，以免监听方读取到不匹配的证书和私钥
+	txnResp, err := client.Txn(ctx).Then(
+		clientv3.OpPut(d.config.KeyForCertificate, certPem, putOpts...),
+		clientv3.OpPut(d.config.KeyForPrivateKey, privkeyPem, putOpts...),
+	).Commit()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'etcd.Txn'")
+	}
+
+	d.logger.Logt("certificate and private key written to etcd", txnResp.Header)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createClient(config *DeployerConfig) (*clientv3.Client, error) {
+	endpoints := make([]string, 0)
+	for _, endpoint := range strings.Split(config.Endpoints, ";") {
+		endpoint = strings.TrimSpace(endpoint)
+		if endpoint == "" {
+			continue
+		}
+
+		endpoints = append(endpoints, endpoint)
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("config `endpoints` is required")
+	}
+
+	clientConfig := clientv3.Config{
+		Endpoints:   endpoints,
+		Username:    config.Username,
+		Password:    config.Password,
+		DialTimeout: 30 * time.Second,
+	}
+	if config.UseTLS {
+		clientConfig.TLS = &tls.Config{InsecureSkipVerify: config.AllowInsecureConnections}
+	}
+
+	client, err := clientv3.New(clientConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,85 @@
+package etcd_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/etcd"
+)
+
+var (
+	fInputCertPath     string
+	fInputKeyPath      string
+	fEndpoints         string
+	fUsername          string
+	fPassword          string
+	fKeyForCertificate string
+	fKeyForPrivateKey  string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_ETCD_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fEndpoints, argsPrefix+"ENDPOINTS", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fKeyForCertificate, argsPrefix+"KEYFORCERTIFICATE", "", "")
+	flag.StringVar(&fKeyForPrivateKey, argsPrefix+"KEYFORPRIVATEKEY", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./etcd_test.go -args \
+	--CERTIMATE_DEPLOYER_ETCD_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_ETCD_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_ETCD_ENDPOINTS="127.0.0.1:2379" \
+	--CERTIMATE_DEPLOYER_ETCD_USERNAME="root" \
+	--CERTIMATE_DEPLOYER_ETCD_PASSWORD="password" \
+	--CERTIMATE_DEPLOYER_ETCD_KEYFORCERTIFICATE="/certimate/example.com/tls.crt" \
+	--CERTIMATE_DEPLOYER_ETCD_KEYFORPRIVATEKEY="/certimate/example.com/tls.key"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("ENDPOINTS: %v", fEndpoints),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("KEYFORCERTIFICATE: %v", fKeyForCertificate),
+			fmt.Sprintf("KEYFORPRIVATEKEY: %v", fKeyForPrivateKey),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Endpoints:         fEndpoints,
+			Username:          fUsername,
+			Password:          fPassword,
+			KeyForCertificate: fKeyForCertificate,
+			KeyForPrivateKey:  fKeyForPrivateKey,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
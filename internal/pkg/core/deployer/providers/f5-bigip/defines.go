@@ -0,0 +1,10 @@
+package f5bigip
+
+type ResourceType string
+
+const (
+	// 资源类型：更新已有的 Client SSL 配置文件。
+	RESOURCE_TYPE_PROFILE = ResourceType("profile")
+	// 资源类型：创建新版本的 Client SSL 配置文件，并替换虚拟服务器上的旧版本。
+	RESOURCE_TYPE_PROFILE_VERSION = ResourceType("profile-version")
+)
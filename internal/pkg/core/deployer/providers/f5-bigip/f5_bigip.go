@@ -0,0 +1,397 @@
+package f5bigip
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	f5sdk "github.com/usual2970/certimate/internal/pkg/vendors/f5bigip-sdk"
+)
+
+type DeployerConfig struct {
+	// BIG-IP 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// BIG-IP 管理员用户名。
+	Username string `json:"username"`
+	// BIG-IP 管理员密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 分区。
+	// 零值时默认为 "Common"。
+	Partition string `json:"partition,omitempty"`
+	// 部署资源类型。
+	ResourceType ResourceType `json:"resourceType"`
+	// Client SSL 配置文件名称。
+	ProfileName string `json:"profileName"`
+	// 父配置文件名称。
+	// 部署资源类型为 [RESOURCE_TYPE_PROFILE_VERSION] 时有效。零值时沿用 ProfileName 对应的配置文件，若其不存在则默认为 "/Common/clientssl"。
+	ParentProfileName string `json:"parentProfileName,omitempty"`
+	// 虚拟服务器名称。
+	// 部署资源类型为 [RESOURCE_TYPE_PROFILE_VERSION] 时必填；部署资源类型为 [RESOURCE_TYPE_PROFILE] 时选填，填写后将确保配置文件已绑定到该虚拟服务器。
+	VirtualServerName string `json:"virtualServerName,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *f5sdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ProfileName == "" {
+		return nil, errors.New("config `profileName` is required")
+	}
+
+	// 登录并获取令牌
+	// REF: https://clouddocs.f5.com/products/extensions/f5-declarative-onboarding/latest/authentication.html
+	if _, err := d.sdkClient.AuthnLogin(); err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'bigip.AuthnLogin'")
+	}
+
+	// 仅校验模式下只检查凭据及配置文件查询权限，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		listProfilesResp, err := d.sdkClient.ClientSSLProfileList()
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'bigip.ClientSSLProfileList'")
+		}
+
+		d.logger.Logt(fmt.Sprintf("dry run: found %d client-ssl profiles, nothing changed", len(listProfilesResp.Items)))
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传并安装证书、证书链和私钥
+	certKeyChain, err := d.installCertificate(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 部署到 Client SSL 配置文件及虚拟服务器
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_PROFILE:
+		if err := d.deployToProfile(ctx, certKeyChain); err != nil {
+			return nil, err
+		}
+
+	case RESOURCE_TYPE_PROFILE_VERSION:
+		if err := d.deployToProfileVersion(ctx, certKeyChain); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) installCertificate(ctx context.Context, certPem string, privkeyPem string) (*f5sdk.CertKeyChain, error) {
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 以时间戳命名，避免与已有对象冲突
+	objectName := fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+	certKeyChain := &f5sdk.CertKeyChain{
+		Name: "default",
+		Cert: d.fullPath(objectName + ".crt"),
+		Key:  d.fullPath(objectName + ".key"),
+	}
+
+	files := []struct {
+		name string
+		data string
+		key  bool
+	}{
+		{name: objectName + ".key", data: privkeyPem, key: true},
+		{name: objectName + ".crt", data: serverCertPem},
+	}
+	if interCertPem != "" {
+		files = append(files, struct {
+			name string
+			data string
+			key  bool
+		}{name: objectName + "_chain.crt", data: interCertPem})
+		certKeyChain.Chain = d.fullPath(objectName + "_chain.crt")
+	}
+
+	for _, file := range files {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		// 上传文件
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_shared_file-transfer_uploads.html
+		if err := d.sdkClient.FileTransferUpload(file.name, []byte(file.data)); err != nil {
+			return nil, xerrors.Wrapf(err, "failed to execute sdk request 'bigip.FileTransferUpload' (file: %s)", file.name)
+		}
+
+		// 安装证书或私钥
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_sys_crypto_cert.html
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_sys_crypto_key.html
+		installReq := &f5sdk.CryptoInstallRequest{
+			Command:       "install",
+			Name:          d.fullPath(file.name),
+			FromLocalFile: "/var/config/rest/downloads/" + file.name,
+		}
+		if file.key {
+			if err := d.sdkClient.CryptoKeyInstall(installReq); err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'bigip.CryptoKeyInstall'")
+			}
+		} else {
+			if err := d.sdkClient.CryptoCertInstall(installReq); err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'bigip.CryptoCertInstall'")
+			}
+		}
+
+		d.logger.Logt(fmt.Sprintf("已安装 %s", file.name))
+	}
+
+	return certKeyChain, nil
+}
+
+func (d *DeployerProvider) deployToProfile(ctx context.Context, certKeyChain *f5sdk.CertKeyChain) error {
+	profileFullPath := d.fullPath(d.config.ProfileName)
+
+	// 检查虚拟服务器是否已绑定此配置文件
+	virtualServerProfiles := make([]*f5sdk.VirtualServerProfile, 0)
+	if d.config.VirtualServerName != "" {
+		listResp, err := d.sdkClient.VirtualServerProfileList(d.fullPath(d.config.VirtualServerName))
+		d.logger.Logt("已查询到虚拟服务器配置文件", listResp)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'bigip.VirtualServerProfileList'")
+		}
+
+		virtualServerProfiles = listResp.Items
+	}
+
+	return d.runTransaction(func(txClient *f5sdk.Client) error {
+		// 更新 Client SSL 配置文件
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_ltm_profile_client-ssl.html
+		modifyReq := &f5sdk.ClientSSLProfileModifyRequest{
+			CertKeyChains: []*f5sdk.CertKeyChain{certKeyChain},
+		}
+		if err := txClient.ClientSSLProfileModify(profileFullPath, modifyReq); err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'bigip.ClientSSLProfileModify'")
+		}
+
+		if d.config.VirtualServerName != "" && !containsProfile(virtualServerProfiles, profileFullPath) {
+			// 绑定配置文件到虚拟服务器
+			// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_ltm_virtual_profiles.html
+			addReq := &f5sdk.VirtualServerProfileAddRequest{
+				Name:    profileFullPath,
+				Context: "clientside",
+			}
+			if err := txClient.VirtualServerProfileAdd(d.fullPath(d.config.VirtualServerName), addReq); err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'bigip.VirtualServerProfileAdd'")
+			}
+		}
+
+		return nil
+	})
+}
+
+func (d *DeployerProvider) deployToProfileVersion(ctx context.Context, certKeyChain *f5sdk.CertKeyChain) error {
+	if d.config.VirtualServerName == "" {
+		return errors.New("config `virtualServerName` is required")
+	}
+
+	baseProfileFullPath := d.fullPath(d.config.ProfileName)
+	virtualServerFullPath := d.fullPath(d.config.VirtualServerName)
+
+	// 查询已有的 Client SSL 配置文件
+	listProfilesResp, err := d.sdkClient.ClientSSLProfileList()
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'bigip.ClientSSLProfileList'")
+	}
+
+	// 确定父配置文件
+	parentProfileFullPath := "/Common/clientssl"
+	if d.config.ParentProfileName != "" {
+		parentProfileFullPath = d.fullPath(d.config.ParentProfileName)
+	} else {
+		for _, profile := range listProfilesResp.Items {
+			if profile.FullPath == baseProfileFullPath {
+				parentProfileFullPath = baseProfileFullPath
+				break
+			}
+		}
+	}
+
+	// 查询虚拟服务器已绑定的配置文件，找出需要替换的旧版本
+	listVsProfilesResp, err := d.sdkClient.VirtualServerProfileList(virtualServerFullPath)
+	d.logger.Logt("已查询到虚拟服务器配置文件", listVsProfilesResp)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'bigip.VirtualServerProfileList'")
+	}
+
+	var oldProfile *f5sdk.ClientSSLProfile
+	staleProfileFullPaths := make([]string, 0)
+	for _, vsProfile := range listVsProfilesResp.Items {
+		if vsProfile.FullPath != baseProfileFullPath && !strings.HasPrefix(vsProfile.FullPath, baseProfileFullPath+"_") {
+			continue
+		}
+
+		for _, profile := range listProfilesResp.Items {
+			if profile.FullPath == vsProfile.FullPath {
+				oldProfile = profile
+				staleProfileFullPaths = append(staleProfileFullPaths, vsProfile.FullPath)
+				break
+			}
+		}
+	}
+
+	// 新版本配置文件以时间戳命名
+	newProfile := &f5sdk.ClientSSLProfileCreateRequest{
+		Name:          fmt.Sprintf("%s_%d", d.config.ProfileName, time.Now().Unix()),
+		Partition:     d.partition(),
+		DefaultsFrom:  parentProfileFullPath,
+		CertKeyChains: []*f5sdk.CertKeyChain{certKeyChain},
+	}
+	if oldProfile != nil {
+		newProfile.SniDefault = oldProfile.SniDefault
+		newProfile.ServerName = oldProfile.ServerName
+	}
+
+	err = d.runTransaction(func(txClient *f5sdk.Client) error {
+		// 创建新版本的 Client SSL 配置文件
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_ltm_profile_client-ssl.html
+		if err := txClient.ClientSSLProfileCreate(newProfile); err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'bigip.ClientSSLProfileCreate'")
+		}
+
+		// 解绑旧版本的配置文件
+		// REF: https://clouddocs.f5.com/api/icontrol-rest/APIRef_tm_ltm_virtual_profiles.html
+		for _, staleProfileFullPath := range staleProfileFullPaths {
+			if err := txClient.VirtualServerProfileDelete(virtualServerFullPath, staleProfileFullPath); err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'bigip.VirtualServerProfileDelete'")
+			}
+		}
+
+		// 绑定新版本的配置文件
+		addReq := &f5sdk.VirtualServerProfileAddRequest{
+			Name:    d.fullPath(newProfile.Name),
+			Context: "clientside",
+		}
+		if err := txClient.VirtualServerProfileAdd(virtualServerFullPath, addReq); err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'bigip.VirtualServerProfileAdd'")
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	d.logger.Logt(fmt.Sprintf("已绑定新版本配置文件 %s", d.fullPath(newProfile.Name)), staleProfileFullPaths)
+	return nil
+}
+
+// 在事务中执行一组变更，全部成功后提交事务，否则丢弃事务。
+func (d *DeployerProvider) runTransaction(fn func(txClient *f5sdk.Client) error) error {
+	// REF: https://clouddocs.f5.com/products/big-iq/mgmt-api/latest/ApiReferences/bigiq_public_api_ref/r_transactions.html
+	createTxResp, err := d.sdkClient.TransactionCreate()
+	d.logger.Logt("已创建事务", createTxResp)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'bigip.TransactionCreate'")
+	}
+
+	if err := fn(d.sdkClient.WithTransaction(createTxResp.TransId)); err != nil {
+		if err := d.sdkClient.TransactionDelete(createTxResp.TransId); err != nil {
+			d.logger.Logt("丢弃事务失败", err.Error())
+		}
+		return err
+	}
+
+	commitTxResp, err := d.sdkClient.TransactionCommit(createTxResp.TransId)
+	d.logger.Logt("已提交事务", commitTxResp)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'bigip.TransactionCommit'")
+	} else if commitTxResp.State != "COMPLETED" {
+		return fmt.Errorf("failed to commit transaction #%d: state: %s, reason: %s", commitTxResp.TransId, commitTxResp.State, commitTxResp.FailureReason)
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) partition() string {
+	if d.config.Partition == "" {
+		return "Common"
+	}
+	return d.config.Partition
+}
+
+func (d *DeployerProvider) fullPath(name string) string {
+	if strings.HasPrefix(name, "/") {
+		return name
+	}
+	return fmt.Sprintf("/%s/%s", d.partition(), name)
+}
+
+func containsProfile(profiles []*f5sdk.VirtualServerProfile, fullPath string) bool {
+	for _, profile := range profiles {
+		if profile.FullPath == fullPath {
+			return true
+		}
+	}
+	return false
+}
+
+func createSdkClient(serverUrl, username, password string, skipTlsVerify bool) (*f5sdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid bigip server url")
+	}
+	if username == "" {
+		return nil, errors.New("invalid bigip username")
+	}
+
+	client := f5sdk.NewClient(serverUrl, username, password).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,87 @@
+package f5bigip_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/f5-bigip"
+)
+
+var (
+	fInputCertPath     string
+	fInputKeyPath      string
+	fServerUrl         string
+	fUsername          string
+	fPassword          string
+	fProfileName       string
+	fVirtualServerName string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_F5BIGIP_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fProfileName, argsPrefix+"PROFILENAME", "", "")
+	flag.StringVar(&fVirtualServerName, argsPrefix+"VIRTUALSERVERNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./f5_bigip_test.go -args \
+	--CERTIMATE_DEPLOYER_F5BIGIP_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_SERVERURL="https://192.168.1.245" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_USERNAME="admin" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_PASSWORD="your-password" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_PROFILENAME="your-clientssl-profile" \
+	--CERTIMATE_DEPLOYER_F5BIGIP_VIRTUALSERVERNAME="your-virtual-server"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("PROFILENAME: %v", fProfileName),
+			fmt.Sprintf("VIRTUALSERVERNAME: %v", fVirtualServerName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:                fServerUrl,
+			Username:                 fUsername,
+			Password:                 fPassword,
+			AllowInsecureConnections: true,
+			ResourceType:             provider.RESOURCE_TYPE_PROFILE_VERSION,
+			ProfileName:              fProfileName,
+			VirtualServerName:        fVirtualServerName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,12 @@
+package fastly
+
+type ResourceType string
+
+const (
+	// 资源类型：替换指定的 Custom TLS 证书。
+	RESOURCE_TYPE_CERTIFICATE = ResourceType("certificate")
+	// 资源类型：上传 Custom TLS 证书并启用到指定的 TLS 域名。
+	RESOURCE_TYPE_DOMAIN = ResourceType("domain")
+	// 资源类型：上传 Platform TLS 证书并关联到指定的 TLS 配置。
+	RESOURCE_TYPE_PLATFORM = ResourceType("platform")
+)
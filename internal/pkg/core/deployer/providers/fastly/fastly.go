@@ -0,0 +1,389 @@
+package fastly
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	fastlysdk "github.com/usual2970/certimate/internal/pkg/vendors/fastly-sdk"
+)
+
+type DeployerConfig struct {
+	// Fastly API Token。
+	ApiToken string `json:"apiToken"`
+	// 部署资源类型。
+	ResourceType ResourceType `json:"resourceType"`
+	// 证书 ID。
+	// 部署资源类型为 [RESOURCE_TYPE_CERTIFICATE] 时必填。
+	// 部署资源类型为 [RESOURCE_TYPE_PLATFORM] 时选填，值不为空时替换该证书，否则上传新证书。
+	CertificateId string `json:"certificateId,omitempty"`
+	// TLS 域名。
+	// 部署资源类型为 [RESOURCE_TYPE_DOMAIN] 时必填。
+	Domain string `json:"domain,omitempty"`
+	// TLS 配置 ID。
+	// 部署资源类型为 [RESOURCE_TYPE_DOMAIN] 时选填，零值时使用默认 TLS 配置。
+	// 部署资源类型为 [RESOURCE_TYPE_PLATFORM] 且未指定证书 ID 时必填。
+	TlsConfigurationId string `json:"tlsConfigurationId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *fastlysdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 根据部署资源类型决定部署方式
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_CERTIFICATE:
+		if err := d.deployToCertificate(ctx, certPem, privkeyPem, opts...); err != nil {
+			return nil, err
+		}
+
+	case RESOURCE_TYPE_DOMAIN:
+		if err := d.deployToDomain(ctx, certPem, privkeyPem, opts...); err != nil {
+			return nil, err
+		}
+
+	case RESOURCE_TYPE_PLATFORM:
+		if err := d.deployToPlatform(ctx, certPem, privkeyPem, opts...); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) deployToCertificate(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) error {
+	if d.config.CertificateId == "" {
+		return errors.New("config `certificateId` is required")
+	}
+
+	// 查询证书的启用记录
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/activations/
+	listActivationsReq := &fastlysdk.ListActivationsRequest{
+		FilterTlsCertificateId: d.config.CertificateId,
+	}
+	listActivationsResp, err := d.sdkClient.ListActivations(listActivationsReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'fastly.ListActivations'")
+	} else {
+		d.logger.Logt("已查询到证书的启用记录", listActivationsResp)
+	}
+
+	// 仅校验模式下只查询证书的启用记录，不实际上传和替换证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return nil
+	}
+
+	// 上传私钥
+	if err := d.ensurePrivateKey(ctx, certPem, privkeyPem); err != nil {
+		return err
+	}
+
+	// 替换证书，已启用的 TLS 域名会自动使用新证书
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/certificates/
+	updateCertificateReq := &fastlysdk.UpdateCertificateRequest{
+		Data: &fastlysdk.CertificateData{
+			Type: "tls_certificate",
+			Attributes: &fastlysdk.CertificateAttributes{
+				Name:     fmt.Sprintf("certimate-%d", time.Now().UnixMilli()),
+				CertBlob: certPem,
+			},
+		},
+	}
+	updateCertificateResp, err := d.sdkClient.UpdateCertificate(d.config.CertificateId, updateCertificateReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'fastly.UpdateCertificate'")
+	} else {
+		d.logger.Logt("已替换证书", updateCertificateResp)
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) deployToDomain(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) error {
+	if d.config.Domain == "" {
+		return errors.New("config `domain` is required")
+	}
+
+	// 查询 TLS 域名的启用记录
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/activations/
+	listActivationsReq := &fastlysdk.ListActivationsRequest{
+		FilterTlsDomainId:        d.config.Domain,
+		FilterTlsConfigurationId: d.config.TlsConfigurationId,
+	}
+	listActivationsResp, err := d.sdkClient.ListActivations(listActivationsReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'fastly.ListActivations'")
+	} else {
+		d.logger.Logt("已查询到 TLS 域名的启用记录", listActivationsResp)
+	}
+
+	// 仅校验模式下只查询 TLS 域名的启用记录，不实际上传和启用证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return nil
+	}
+
+	// 上传私钥
+	if err := d.ensurePrivateKey(ctx, certPem, privkeyPem); err != nil {
+		return err
+	}
+
+	// 上传证书
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/certificates/
+	createCertificateReq := &fastlysdk.CreateCertificateRequest{
+		Data: &fastlysdk.CertificateData{
+			Type: "tls_certificate",
+			Attributes: &fastlysdk.CertificateAttributes{
+				Name:     fmt.Sprintf("certimate-%d", time.Now().UnixMilli()),
+				CertBlob: certPem,
+			},
+		},
+	}
+	createCertificateResp, err := d.sdkClient.CreateCertificate(createCertificateReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'fastly.CreateCertificate'")
+	} else if createCertificateResp.Data == nil {
+		return errors.New("failed to create certificate: unexpected empty response data")
+	} else {
+		d.logger.Logt("已上传证书", createCertificateResp)
+	}
+
+	certificateRelationship := &fastlysdk.RelationshipOne{
+		Data: &fastlysdk.ResourceIdentifier{Type: "tls_certificate", Id: createCertificateResp.Data.Id},
+	}
+	if len(listActivationsResp.Data) > 0 {
+		for _, activation := range listActivationsResp.Data {
+			// 切换已有启用记录所使用的证书
+			// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/activations/
+			updateActivationReq := &fastlysdk.UpdateActivationRequest{
+				Data: &fastlysdk.ActivationData{
+					Type: "tls_activation",
+					Relationships: &fastlysdk.ActivationRelationships{
+						TlsCertificate: certificateRelationship,
+					},
+				},
+			}
+			updateActivationResp, err := d.sdkClient.UpdateActivation(activation.Id, updateActivationReq)
+			if err != nil {
+				return xerrors.Wrap(err, "failed to execute sdk request 'fastly.UpdateActivation'")
+			} else {
+				d.logger.Logt("已切换 TLS 域名证书", updateActivationResp)
+			}
+		}
+	} else {
+		// 启用证书
+		// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/activations/
+		createActivationReq := &fastlysdk.CreateActivationRequest{
+			Data: &fastlysdk.ActivationData{
+				Type: "tls_activation",
+				Relationships: &fastlysdk.ActivationRelationships{
+					TlsCertificate: certificateRelationship,
+					TlsDomain: &fastlysdk.RelationshipOne{
+						Data: &fastlysdk.ResourceIdentifier{Type: "tls_domain", Id: d.config.Domain},
+					},
+				},
+			},
+		}
+		if d.config.TlsConfigurationId != "" {
+			createActivationReq.Data.Relationships.TlsConfiguration = &fastlysdk.RelationshipOne{
+				Data: &fastlysdk.ResourceIdentifier{Type: "tls_configuration", Id: d.config.TlsConfigurationId},
+			}
+		}
+		createActivationResp, err := d.sdkClient.CreateActivation(createActivationReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'fastly.CreateActivation'")
+		} else {
+			d.logger.Logt("已启用 TLS 域名证书", createActivationResp)
+		}
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) deployToPlatform(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) error {
+	if d.config.CertificateId == "" && d.config.TlsConfigurationId == "" {
+		return errors.New("config `certificateId` or `tlsConfigurationId` is required")
+	}
+
+	// 仅校验模式下不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return nil
+	}
+
+	// Platform TLS 需要分别提供服务器证书和中间证书
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 上传私钥
+	if err := d.ensurePrivateKey(ctx, certPem, privkeyPem); err != nil {
+		return err
+	}
+
+	if d.config.CertificateId != "" {
+		// 替换证书
+		// REF: https://www.fastly.com/documentation/reference/api/tls/platform/
+		updateBulkCertificateReq := &fastlysdk.UpdateBulkCertificateRequest{
+			Data: &fastlysdk.BulkCertificateData{
+				Type: "tls_bulk_certificate",
+				Attributes: &fastlysdk.BulkCertificateAttributes{
+					CertBlob:          serverCertPem,
+					IntermediatesBlob: interCertPem,
+				},
+			},
+		}
+		updateBulkCertificateResp, err := d.sdkClient.UpdateBulkCertificate(d.config.CertificateId, updateBulkCertificateReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'fastly.UpdateBulkCertificate'")
+		} else {
+			d.logger.Logt("已替换证书", updateBulkCertificateResp)
+		}
+	} else {
+		// 上传证书
+		// REF: https://www.fastly.com/documentation/reference/api/tls/platform/
+		createBulkCertificateReq := &fastlysdk.CreateBulkCertificateRequest{
+			Data: &fastlysdk.BulkCertificateData{
+				Type: "tls_bulk_certificate",
+				Attributes: &fastlysdk.BulkCertificateAttributes{
+					CertBlob:          serverCertPem,
+					IntermediatesBlob: interCertPem,
+				},
+				Relationships: &fastlysdk.BulkCertificateRelationships{
+					TlsConfigurations: &fastlysdk.RelationshipMany{
+						Data: []*fastlysdk.ResourceIdentifier{
+							{Type: "tls_configuration", Id: d.config.TlsConfigurationId},
+						},
+					},
+				},
+			},
+		}
+		createBulkCertificateResp, err := d.sdkClient.CreateBulkCertificate(createBulkCertificateReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'fastly.CreateBulkCertificate'")
+		} else {
+			d.logger.Logt("已上传证书", createBulkCertificateResp)
+		}
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) ensurePrivateKey(ctx context.Context, certPem string, privkeyPem string) error {
+	// 以证书公钥的 SHA-1 指纹判断私钥是否已上传
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return err
+	}
+
+	publicKeySha1 := sha1.Sum(certX509.RawSubjectPublicKeyInfo)
+	publicKeySha1Hex := hex.EncodeToString(publicKeySha1[:])
+
+	// 查询私钥列表，避免重复上传
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/private-keys/
+	listPrivateKeysPage := int32(1)
+	listPrivateKeysPageSize := int32(100)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		listPrivateKeysReq := &fastlysdk.ListPrivateKeysRequest{
+			PageNumber: listPrivateKeysPage,
+			PageSize:   listPrivateKeysPageSize,
+		}
+		listPrivateKeysResp, err := d.sdkClient.ListPrivateKeys(listPrivateKeysReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'fastly.ListPrivateKeys'")
+		}
+
+		for _, privateKey := range listPrivateKeysResp.Data {
+			if privateKey.Attributes != nil && strings.EqualFold(privateKey.Attributes.PublicKeySha1, publicKeySha1Hex) {
+				d.logger.Logt("私钥已存在，跳过上传", privateKey)
+				return nil
+			}
+		}
+
+		if len(listPrivateKeysResp.Data) < int(listPrivateKeysPageSize) || listPrivateKeysResp.Meta == nil || listPrivateKeysResp.Meta.TotalPages <= listPrivateKeysPage {
+			break
+		} else {
+			listPrivateKeysPage++
+		}
+	}
+
+	// 上传私钥
+	// REF: https://www.fastly.com/documentation/reference/api/tls/custom-certs/private-keys/
+	createPrivateKeyReq := &fastlysdk.CreatePrivateKeyRequest{
+		Data: &fastlysdk.PrivateKeyData{
+			Type: "tls_private_key",
+			Attributes: &fastlysdk.PrivateKeyAttributes{
+				Name: fmt.Sprintf("certimate-%d", time.Now().UnixMilli()),
+				Key:  privkeyPem,
+			},
+		},
+	}
+	createPrivateKeyResp, err := d.sdkClient.CreatePrivateKey(createPrivateKeyReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'fastly.CreatePrivateKey'")
+	} else {
+		d.logger.Logt("已上传私钥", createPrivateKeyResp)
+	}
+
+	return nil
+}
+
+func createSdkClient(apiToken string) (*fastlysdk.Client, error) {
+	if apiToken == "" {
+		return nil, errors.New("invalid fastly api token")
+	}
+
+	client := fastlysdk.NewClient(apiToken)
+	return client, nil
+}
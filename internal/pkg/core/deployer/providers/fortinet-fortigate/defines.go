@@ -0,0 +1,12 @@
+package fortinetfortigate
+
+type ResourceType string
+
+const (
+	// 资源类型：管理界面（HTTPS 管理访问）。
+	RESOURCE_TYPE_ADMIN = ResourceType("admin")
+	// 资源类型：SSL-VPN 门户。
+	RESOURCE_TYPE_SSLVPN = ResourceType("sslvpn")
+	// 资源类型：虚拟服务器（SSL 卸载的负载均衡 VIP）。
+	RESOURCE_TYPE_VIRTUAL_SERVER = ResourceType("virtual-server")
+)
@@ -0,0 +1,289 @@
+package fortinetfortigate
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	fortiossdk "github.com/usual2970/certimate/internal/pkg/vendors/fortios-sdk"
+)
+
+type DeployerConfig struct {
+	// FortiGate 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// FortiGate REST API 令牌。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 虚拟域。
+	// 选填。零值时使用 API 管理员的默认虚拟域。
+	Vdom string `json:"vdom,omitempty"`
+	// 部署资源类型。
+	ResourceType ResourceType `json:"resourceType"`
+	// 虚拟服务器名称。
+	// 部署资源类型为 [RESOURCE_TYPE_VIRTUAL_SERVER] 时必填。
+	VirtualServerName string `json:"virtualServerName,omitempty"`
+	// 是否删除被替换下来的旧证书。
+	DeletePrevious bool `json:"deletePrevious,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *fortiossdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiToken, config.Vdom, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 仅校验模式下只检查令牌是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		// REF: https://fndn.fortinet.net/index.php?/fortiapi/1-fortios/
+		statusResp, err := d.sdkClient.SystemStatus()
+		d.logger.Logt("已查询到 FortiGate 系统状态", statusResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.SystemStatus'")
+		}
+
+		d.logger.Logt("dry run: fortigate api token is valid, nothing changed")
+		return &deployer.DeployResult{}, nil
+	}
+
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_ADMIN, RESOURCE_TYPE_SSLVPN:
+	case RESOURCE_TYPE_VIRTUAL_SERVER:
+		if d.config.VirtualServerName == "" {
+			return nil, errors.New("config `virtualServerName` is required")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
+	}
+
+	// 导入证书
+	certName, err := d.importCertificate(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 替换绑定的证书
+	var previousCertNames []string
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_ADMIN:
+		previousCertNames, err = d.deployToAdmin(ctx, certName)
+	case RESOURCE_TYPE_SSLVPN:
+		previousCertNames, err = d.deployToSslVpn(ctx, certName)
+	case RESOURCE_TYPE_VIRTUAL_SERVER:
+		previousCertNames, err = d.deployToVirtualServer(ctx, certName)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 删除旧证书
+	if d.config.DeletePrevious {
+		for _, previousCertName := range previousCertNames {
+			if previousCertName == "" || previousCertName == certName || isBuiltinCertificate(previousCertName) {
+				continue
+			}
+
+			// 旧证书可能仍被其他配置引用，删除失败时仅记录日志
+			// REF: https://fndn.fortinet.net/index.php?/fortiapi/1-fortios/
+			deleteResp, err := d.sdkClient.CertificateLocalDelete(previousCertName)
+			d.logger.Logt(fmt.Sprintf("已删除旧证书 %s", previousCertName), deleteResp)
+			if err != nil {
+				d.logger.Logt(fmt.Sprintf("删除旧证书 %s 失败", previousCertName), err.Error())
+			}
+		}
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certName": certName,
+		},
+	}, nil
+}
+
+func (d *DeployerProvider) importCertificate(ctx context.Context, certPem string, privkeyPem string) (string, error) {
+	// 生成新证书名（需符合 FortiOS 对象名长度限制，最多 35 个字符）
+	certName := fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+
+	// 管理界面证书需位于全局作用域
+	scope := "global"
+	if d.config.Vdom != "" && d.config.ResourceType != RESOURCE_TYPE_ADMIN {
+		scope = "vdom"
+	}
+
+	// 导入证书
+	// REF: https://fndn.fortinet.net/index.php?/fortiapi/1-fortios/
+	importReq := &fortiossdk.CertificateLocalImportRequest{
+		Type:           "regular",
+		CertName:       certName,
+		FileContent:    base64.StdEncoding.EncodeToString([]byte(certPem)),
+		KeyFileContent: base64.StdEncoding.EncodeToString([]byte(privkeyPem)),
+		Scope:          scope,
+	}
+	importResp, err := d.sdkClient.CertificateLocalImport(importReq)
+	d.logger.Logt("已导入证书", importResp)
+	if err != nil {
+		return "", xerrors.Wrap(err, "failed to execute sdk request 'fortios.CertificateLocalImport'")
+	}
+
+	if importResp.Results != nil && importResp.Results.MkeyName != "" {
+		certName = importResp.Results.MkeyName
+	}
+
+	return certName, nil
+}
+
+func (d *DeployerProvider) deployToAdmin(ctx context.Context, certName string) ([]string, error) {
+	// 查询当前的管理界面证书
+	getGlobalResp, err := d.sdkClient.SystemGlobalGet()
+	d.logger.Logt("已查询到系统全局设置", getGlobalResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.SystemGlobalGet'")
+	}
+
+	previousCertNames := make([]string, 0)
+	if getGlobalResp.Results != nil {
+		previousCertNames = append(previousCertNames, getGlobalResp.Results.AdminServerCert)
+	}
+
+	// 更新管理界面证书
+	updateGlobalReq := &fortiossdk.SystemGlobalUpdateRequest{
+		AdminServerCert: certName,
+	}
+	updateGlobalResp, err := d.sdkClient.SystemGlobalUpdate(updateGlobalReq)
+	d.logger.Logt("已更新管理界面证书", updateGlobalResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.SystemGlobalUpdate'")
+	}
+
+	return previousCertNames, nil
+}
+
+func (d *DeployerProvider) deployToSslVpn(ctx context.Context, certName string) ([]string, error) {
+	// 查询当前的 SSL-VPN 证书
+	getSettingsResp, err := d.sdkClient.VpnSslSettingsGet()
+	d.logger.Logt("已查询到 SSL-VPN 设置", getSettingsResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.VpnSslSettingsGet'")
+	}
+
+	previousCertNames := make([]string, 0)
+	if getSettingsResp.Results != nil {
+		previousCertNames = append(previousCertNames, getSettingsResp.Results.ServerCert)
+	}
+
+	// 更新 SSL-VPN 证书
+	updateSettingsReq := &fortiossdk.VpnSslSettingsUpdateRequest{
+		ServerCert: certName,
+	}
+	updateSettingsResp, err := d.sdkClient.VpnSslSettingsUpdate(updateSettingsReq)
+	d.logger.Logt("已更新 SSL-VPN 证书", updateSettingsResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.VpnSslSettingsUpdate'")
+	}
+
+	return previousCertNames, nil
+}
+
+func (d *DeployerProvider) deployToVirtualServer(ctx context.Context, certName string) ([]string, error) {
+	// 查询虚拟服务器
+	getVipResp, err := d.sdkClient.FirewallVipGet(d.config.VirtualServerName)
+	d.logger.Logt("已查询到虚拟服务器", getVipResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.FirewallVipGet'")
+	} else if len(getVipResp.Results) == 0 {
+		return nil, fmt.Errorf("could not find virtual server '%s'", d.config.VirtualServerName)
+	}
+
+	// FortiOS 7.2 起 "ssl-certificate" 字段为对象数组，此前版本为字符串，需按原有格式回写
+	previousCertNames := make([]string, 0)
+	var sslCertificate any = []map[string]string{{"name": certName}}
+	if raw := getVipResp.Results[0].SslCertificate; len(raw) > 0 {
+		var certNameStr string
+		var certNameObjs []struct {
+			Name string `json:"name"`
+		}
+		if err := json.Unmarshal(raw, &certNameStr); err == nil {
+			previousCertNames = append(previousCertNames, certNameStr)
+			sslCertificate = certName
+		} else if err := json.Unmarshal(raw, &certNameObjs); err == nil {
+			for _, obj := range certNameObjs {
+				previousCertNames = append(previousCertNames, obj.Name)
+			}
+		}
+	}
+
+	// 更新虚拟服务器证书
+	updateVipReq := &fortiossdk.FirewallVipUpdateRequest{
+		SslCertificate: sslCertificate,
+	}
+	updateVipResp, err := d.sdkClient.FirewallVipUpdate(d.config.VirtualServerName, updateVipReq)
+	d.logger.Logt("已更新虚拟服务器证书", updateVipResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'fortios.FirewallVipUpdate'")
+	}
+
+	return previousCertNames, nil
+}
+
+func isBuiltinCertificate(certName string) bool {
+	return strings.HasPrefix(certName, "Fortinet_") || certName == "self-sign"
+}
+
+func createSdkClient(serverUrl, apiToken, vdom string, skipTlsVerify bool) (*fortiossdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid fortigate server url")
+	}
+	if apiToken == "" {
+		return nil, errors.New("invalid fortigate api token")
+	}
+
+	client := fortiossdk.NewClient(serverUrl, apiToken).
+		WithVdom(vdom).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
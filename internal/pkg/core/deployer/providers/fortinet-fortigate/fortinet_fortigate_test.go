@@ -0,0 +1,77 @@
+package fortinetfortigate_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/fortinet-fortigate"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fApiToken      string
+	fVdom          string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_FORTINETFORTIGATE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+	flag.StringVar(&fVdom, argsPrefix+"VDOM", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./fortinet_fortigate_test.go -args \
+	--CERTIMATE_DEPLOYER_FORTINETFORTIGATE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_FORTINETFORTIGATE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_FORTINETFORTIGATE_SERVERURL="https://192.168.1.99" \
+	--CERTIMATE_DEPLOYER_FORTINETFORTIGATE_APITOKEN="your-api-token" \
+	--CERTIMATE_DEPLOYER_FORTINETFORTIGATE_VDOM="root"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+			fmt.Sprintf("VDOM: %v", fVdom),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:                fServerUrl,
+			ApiToken:                 fApiToken,
+			AllowInsecureConnections: true,
+			Vdom:                     fVdom,
+			ResourceType:             provider.RESOURCE_TYPE_SSLVPN,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,16 @@
+package ftp
+
+type OutputFormatType string
+
+const (
+	OUTPUT_FORMAT_PEM = OutputFormatType("PEM")
+	OUTPUT_FORMAT_PFX = OutputFormatType("PFX")
+	OUTPUT_FORMAT_JKS = OutputFormatType("JKS")
+)
+
+type TransferModeType string
+
+const (
+	TRANSFER_MODE_BINARY = TransferModeType("binary")
+	TRANSFER_MODE_ASCII  = TransferModeType("ascii")
+)
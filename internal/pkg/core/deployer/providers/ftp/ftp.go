@@ -0,0 +1,236 @@
+package ftp
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jlaffaye/ftp"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// FTP 主机。
+	FtpHost string `json:"ftpHost"`
+	// FTP 端口。
+	// 零值时默认为 21。
+	FtpPort int32 `json:"ftpPort,omitempty"`
+	// FTP 登录用户名。
+	// 零值时默认为 "anonymous"。
+	FtpUsername string `json:"ftpUsername,omitempty"`
+	// FTP 登录密码。
+	FtpPassword string `json:"ftpPassword,omitempty"`
+	// 是否使用显式 FTPS（即 AUTH TLS）。
+	UseExplicitTLS bool `json:"useExplicitTLS,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 传输模式。
+	// 零值时默认为 [TRANSFER_MODE_BINARY]。证书格式为 PFX 或 JKS 时总是使用二进制模式传输。
+	TransferMode TransferModeType `json:"transferMode,omitempty"`
+	// 输出证书格式。
+	OutputFormat OutputFormatType `json:"outputFormat,omitempty"`
+	// 输出证书文件路径。
+	OutputCertPath string `json:"outputCertPath,omitempty"`
+	// 输出私钥文件路径。
+	OutputKeyPath string `json:"outputKeyPath,omitempty"`
+	// PFX 导出密码。
+	// 证书格式为 PFX 时必填。
+	PfxPassword string `json:"pfxPassword,omitempty"`
+	// JKS 别名。
+	// 证书格式为 JKS 时必填。
+	JksAlias string `json:"jksAlias,omitempty"`
+	// JKS 密钥密码。
+	// 证书格式为 JKS 时必填。
+	JksKeypass string `json:"jksKeypass,omitempty"`
+	// JKS 存储密码。
+	// 证书格式为 JKS 时必填。
+	JksStorepass string `json:"jksStorepass,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.OutputCertPath == "" {
+		return nil, errors.New("config `outputCertPath` is required")
+	}
+	if d.config.OutputFormat == OUTPUT_FORMAT_PEM && d.config.OutputKeyPath == "" {
+		return nil, errors.New("config `outputKeyPath` is required")
+	}
+
+	// 转换证书格式
+	var certData, keyData []byte
+	transferType := ftp.TransferTypeBinary
+	switch d.config.OutputFormat {
+	case OUTPUT_FORMAT_PEM:
+		certData = []byte(certPem)
+		keyData = []byte(privkeyPem)
+
+		switch d.config.TransferMode {
+		case "", TRANSFER_MODE_BINARY:
+		case TRANSFER_MODE_ASCII:
+			transferType = ftp.TransferTypeASCII
+		default:
+			return nil, fmt.Errorf("unsupported transfer mode: %s", d.config.TransferMode)
+		}
+
+	case OUTPUT_FORMAT_PFX:
+		pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, d.config.PfxPassword)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+		}
+
+		certData = pfxData
+		d.logger.Logt("certificate transformed to PFX")
+
+	case OUTPUT_FORMAT_JKS:
+		jksData, err := certs.TransformCertificateFromPEMToJKS(certPem, privkeyPem, d.config.JksAlias, d.config.JksKeypass, d.config.JksStorepass)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to transform certificate to JKS")
+		}
+
+		certData = jksData
+		d.logger.Logt("certificate transformed to JKS")
+
+	default:
+		return nil, fmt.Errorf("unsupported output format: %s", d.config.OutputFormat)
+	}
+
+	// 连接
+	conn, err := createFtpConn(ctx, d.config)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ftp connection")
+	}
+	defer conn.Quit()
+
+	d.logger.Logt("FTP connected")
+
+	// 仅校验模式下只检查连通性及登录凭据，不上传任何文件
+	if deployer.MergeOptions(opts...).DryRun {
+		if err := conn.NoOp(); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute ftp command 'NOOP'")
+		}
+
+		d.logger.Logt("dry run: ftp server is reachable, nothing uploaded")
+		return &deployer.DeployResult{}, nil
+	}
+
+	if err := conn.Type(transferType); err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute ftp command 'TYPE'")
+	}
+
+	// 上传证书和私钥文件
+	if err := writeFile(conn, d.config.OutputCertPath, certData); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", d.config.OutputCertPath)
+
+	if keyData != nil {
+		if err := writeFile(conn, d.config.OutputKeyPath, keyData); err != nil {
+			return nil, xerrors.Wrap(err, "failed to upload private key file")
+		}
+
+		d.logger.Logt("private key file uploaded", d.config.OutputKeyPath)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createFtpConn(ctx context.Context, config *DeployerConfig) (*ftp.ServerConn, error) {
+	host := config.FtpHost
+	if host == "" {
+		return nil, errors.New("config `ftpHost` is required")
+	}
+
+	port := config.FtpPort
+	if port == 0 {
+		port = 21
+	}
+
+	username := config.FtpUsername
+	password := config.FtpPassword
+	if username == "" {
+		username = "anonymous"
+		if password == "" {
+			password = "anonymous"
+		}
+	}
+
+	options := []ftp.DialOption{
+		ftp.DialWithContext(ctx),
+		ftp.DialWithTimeout(30 * time.Second),
+	}
+	if config.UseExplicitTLS {
+		options = append(options, ftp.DialWithExplicitTLS(&tls.Config{
+			ServerName:         host,
+			InsecureSkipVerify: config.AllowInsecureConnections,
+		}))
+	}
+
+	conn, err := ftp.Dial(net.JoinHostPort(host, strconv.Itoa(int(port))), options...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.Login(username, password); err != nil {
+		conn.Quit()
+		return nil, err
+	}
+
+	return conn, nil
+}
+
+func writeFile(conn *ftp.ServerConn, filePath string, data []byte) error {
+	// 逐级创建父目录，目录已存在时服务端会返回错误，此处忽略即可
+	if dir := path.Dir(filePath); dir != "." && dir != "/" {
+		segments := strings.Split(strings.TrimPrefix(dir, "/"), "/")
+		for i := range segments {
+			p := strings.Join(segments[:i+1], "/")
+			if strings.HasPrefix(dir, "/") {
+				p = "/" + p
+			}
+			_ = conn.MakeDir(p)
+		}
+	}
+
+	return conn.Stor(filePath, bytes.NewReader(data))
+}
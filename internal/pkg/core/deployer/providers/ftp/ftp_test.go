@@ -0,0 +1,96 @@
+package ftp_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ftp"
+)
+
+var (
+	fInputCertPath  string
+	fInputKeyPath   string
+	fFtpHost        string
+	fFtpPort        int64
+	fFtpUsername    string
+	fFtpPassword    string
+	fUseExplicitTLS bool
+	fOutputCertPath string
+	fOutputKeyPath  string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_FTP_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fFtpHost, argsPrefix+"FTPHOST", "", "")
+	flag.Int64Var(&fFtpPort, argsPrefix+"FTPPORT", 21, "")
+	flag.StringVar(&fFtpUsername, argsPrefix+"FTPUSERNAME", "", "")
+	flag.StringVar(&fFtpPassword, argsPrefix+"FTPPASSWORD", "", "")
+	flag.BoolVar(&fUseExplicitTLS, argsPrefix+"USEEXPLICITTLS", false, "")
+	flag.StringVar(&fOutputCertPath, argsPrefix+"OUTPUTCERTPATH", "", "")
+	flag.StringVar(&fOutputKeyPath, argsPrefix+"OUTPUTKEYPATH", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./ftp_test.go -args \
+	--CERTIMATE_DEPLOYER_FTP_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_FTP_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_FTP_FTPHOST="127.0.0.1" \
+	--CERTIMATE_DEPLOYER_FTP_FTPPORT=21 \
+	--CERTIMATE_DEPLOYER_FTP_FTPUSERNAME="user" \
+	--CERTIMATE_DEPLOYER_FTP_FTPPASSWORD="password" \
+	--CERTIMATE_DEPLOYER_FTP_USEEXPLICITTLS=true \
+	--CERTIMATE_DEPLOYER_FTP_OUTPUTCERTPATH="/ssl/cert.crt" \
+	--CERTIMATE_DEPLOYER_FTP_OUTPUTKEYPATH="/ssl/cert.key"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("FTPHOST: %v", fFtpHost),
+			fmt.Sprintf("FTPPORT: %v", fFtpPort),
+			fmt.Sprintf("FTPUSERNAME: %v", fFtpUsername),
+			fmt.Sprintf("FTPPASSWORD: %v", fFtpPassword),
+			fmt.Sprintf("USEEXPLICITTLS: %v", fUseExplicitTLS),
+			fmt.Sprintf("OUTPUTCERTPATH: %v", fOutputCertPath),
+			fmt.Sprintf("OUTPUTKEYPATH: %v", fOutputKeyPath),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			FtpHost:        fFtpHost,
+			FtpPort:        int32(fFtpPort),
+			FtpUsername:    fFtpUsername,
+			FtpPassword:    fFtpPassword,
+			UseExplicitTLS: fUseExplicitTLS,
+			OutputFormat:   provider.OUTPUT_FORMAT_PEM,
+			OutputCertPath: fOutputCertPath,
+			OutputKeyPath:  fOutputKeyPath,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,233 @@
+﻿package gcpcertificatemanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+	gcpCertMgr "google.golang.org/api/certificatemanager/v1"
+	"google.golang.org/api/option"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// GCP 服务账号密钥（JSON 格式）。
+	// 零值时使用应用默认凭据（ADC），如 GOOGLE_APPLICATION_CREDENTIALS 环境变量、GKE 工作负载身份联合或 GCE 元数据服务等。
+	ServiceAccountKey string `json:"serviceAccountKey,omitempty"`
+	// GCP 项目 ID。
+	// 选填。零值时默认使用服务账号密钥或应用默认凭据中的项目 ID。
+	ProjectId string `json:"projectId,omitempty"`
+	// 证书管理器位置。
+	// 选填。零值时默认为 "global"。
+	Location string `json:"location,omitempty"`
+	// 证书映射 ID。
+	CertificateMapId string `json:"certificateMapId"`
+	// 证书映射条目 ID。
+	CertificateMapEntryId string `json:"certificateMapEntryId"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *gcpCertMgr.Service
+	projectId string
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServiceAccountKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	projectId := config.ProjectId
+	if projectId == "" {
+		projectId, err = resolveProjectId(config.ServiceAccountKey)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to resolve project id")
+		}
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+		projectId: projectId,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateMapId == "" {
+		return nil, errors.New("config `certificateMapId` is required")
+	}
+	if d.config.CertificateMapEntryId == "" {
+		return nil, errors.New("config `certificateMapEntryId` is required")
+	}
+
+	location := d.config.Location
+	if location == "" {
+		location = "global"
+	}
+
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	parent := fmt.Sprintf("projects/%s/locations/%s", d.projectId, location)
+	entryName := fmt.Sprintf("%s/certificateMaps/%s/certificateMapEntries/%s", parent, d.config.CertificateMapId, d.config.CertificateMapEntryId)
+
+	// 获取证书映射条目
+	// REF: https://cloud.google.com/certificate-manager/docs/reference/certificate-manager/rest/v1/projects.locations.certificateMaps.certificateMapEntries/get
+	getCertificateMapEntryResp, err := d.sdkClient.Projects.Locations.CertificateMaps.CertificateMapEntries.Get(entryName).Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatemanager.projects.locations.certificateMaps.certificateMapEntries.get'")
+	}
+
+	d.logger.Logt("已获取证书映射条目", getCertificateMapEntryResp)
+
+	// 如果证书映射条目已绑定相同证书，则跳过
+	for _, certName := range getCertificateMapEntryResp.Certificates {
+		// REF: https://cloud.google.com/certificate-manager/docs/reference/certificate-manager/rest/v1/projects.locations.certificates/get
+		getCertificateResp, err := d.sdkClient.Projects.Locations.Certificates.Get(certName).Context(ctx).Do()
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatemanager.projects.locations.certificates.get'")
+		}
+
+		oldCertX509, err := certs.ParseCertificateFromPEM(getCertificateResp.PemCertificate)
+		if err != nil {
+			continue
+		}
+
+		if certs.EqualCertificate(certX509, oldCertX509) {
+			d.logger.Logt("证书映射条目已绑定该证书，跳过更新")
+			return &deployer.DeployResult{}, nil
+		}
+	}
+
+	// 创建证书
+	// REF: https://cloud.google.com/certificate-manager/docs/reference/certificate-manager/rest/v1/projects.locations.certificates/create
+	certId := fmt.Sprintf("certimate-%d", time.Now().UnixMilli())
+	createCertificateReq := &gcpCertMgr.Certificate{
+		Description: "Uploaded by Certimate",
+		SelfManaged: &gcpCertMgr.SelfManagedCertificate{
+			PemCertificate: certPem,
+			PemPrivateKey:  privkeyPem,
+		},
+	}
+	createCertificateResp, err := d.sdkClient.Projects.Locations.Certificates.Create(parent, createCertificateReq).CertificateId(certId).Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatemanager.projects.locations.certificates.create'")
+	}
+
+	if err := d.waitForOperation(ctx, createCertificateResp); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已创建证书", certId)
+
+	// 更新证书映射条目
+	// REF: https://cloud.google.com/certificate-manager/docs/reference/certificate-manager/rest/v1/projects.locations.certificateMaps.certificateMapEntries/patch
+	patchCertificateMapEntryReq := &gcpCertMgr.CertificateMapEntry{
+		Certificates: []string{fmt.Sprintf("%s/certificates/%s", parent, certId)},
+	}
+	patchCertificateMapEntryResp, err := d.sdkClient.Projects.Locations.CertificateMaps.CertificateMapEntries.Patch(entryName, patchCertificateMapEntryReq).UpdateMask("certificates").Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatemanager.projects.locations.certificateMaps.certificateMapEntries.patch'")
+	}
+
+	if err := d.waitForOperation(ctx, patchCertificateMapEntryResp); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已更新证书映射条目", entryName)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) waitForOperation(ctx context.Context, op *gcpCertMgr.Operation) error {
+	// 轮询长时间运行的操作，直至其完成
+	// REF: https://cloud.google.com/certificate-manager/docs/reference/certificate-manager/rest/v1/projects.locations.operations/get
+	for !op.Done {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(3 * time.Second):
+		}
+
+		resp, err := d.sdkClient.Projects.Locations.Operations.Get(op.Name).Context(ctx).Do()
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'certificatemanager.projects.locations.operations.get'")
+		}
+
+		op = resp
+		d.logger.Logt(fmt.Sprintf("waiting for operation '%s' to be done ...", op.Name))
+	}
+
+	if op.Error != nil {
+		return fmt.Errorf("operation '%s' failed: %d %s", op.Name, op.Error.Code, op.Error.Message)
+	}
+
+	return nil
+}
+
+func createSdkClient(serviceAccountKey string) (*gcpCertMgr.Service, error) {
+	// 留空以使用应用默认凭据（ADC）
+	opts := make([]option.ClientOption, 0)
+	if serviceAccountKey != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(serviceAccountKey)))
+	}
+
+	client, err := gcpCertMgr.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func resolveProjectId(serviceAccountKey string) (string, error) {
+	if serviceAccountKey == "" {
+		credentials, err := google.FindDefaultCredentials(context.Background(), gcpCertMgr.CloudPlatformScope)
+		if err != nil {
+			return "", err
+		}
+
+		if credentials.ProjectID == "" {
+			return "", errors.New("project_id is missing in the application default credentials")
+		}
+
+		return credentials.ProjectID, nil
+	}
+
+	var key struct {
+		ProjectId string `json:"project_id"`
+	}
+	if err := json.Unmarshal([]byte(serviceAccountKey), &key); err != nil {
+		return "", err
+	}
+
+	if key.ProjectId == "" {
+		return "", errors.New("project_id is missing in the service account key")
+	}
+
+	return key.ProjectId, nil
+}
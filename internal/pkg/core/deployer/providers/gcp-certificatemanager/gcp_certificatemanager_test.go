@@ -0,0 +1,85 @@
+package gcpcertificatemanager_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-certificatemanager"
+)
+
+var (
+	fInputCertPath         string
+	fInputKeyPath          string
+	fServiceAccountKey     string
+	fProjectId             string
+	fLocation              string
+	fCertificateMapId      string
+	fCertificateMapEntryId string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServiceAccountKey, argsPrefix+"SERVICEACCOUNTKEY", "", "")
+	flag.StringVar(&fProjectId, argsPrefix+"PROJECTID", "", "")
+	flag.StringVar(&fLocation, argsPrefix+"LOCATION", "global", "")
+	flag.StringVar(&fCertificateMapId, argsPrefix+"CERTIFICATEMAPID", "", "")
+	flag.StringVar(&fCertificateMapEntryId, argsPrefix+"CERTIFICATEMAPENTRYID", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./gcp_certificatemanager_test.go -args \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_SERVICEACCOUNTKEY="{\"type\":\"service_account\", ...}" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_PROJECTID="your-project-id" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_LOCATION="global" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_CERTIFICATEMAPID="your-certificate-map-id" \
+	--CERTIMATE_DEPLOYER_GCPCERTIFICATEMANAGER_CERTIFICATEMAPENTRYID="your-certificate-map-entry-id"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVICEACCOUNTKEY: %v", fServiceAccountKey),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+			fmt.Sprintf("LOCATION: %v", fLocation),
+			fmt.Sprintf("CERTIFICATEMAPID: %v", fCertificateMapId),
+			fmt.Sprintf("CERTIFICATEMAPENTRYID: %v", fCertificateMapEntryId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServiceAccountKey:     fServiceAccountKey,
+			ProjectId:             fProjectId,
+			Location:              fLocation,
+			CertificateMapId:      fCertificateMapId,
+			CertificateMapEntryId: fCertificateMapEntryId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,301 @@
+﻿package gcploadbalancer
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/oauth2/google"
+	gcpCompute "google.golang.org/api/compute/v1"
+	"google.golang.org/api/option"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// GCP 服务账号密钥（JSON 格式）。
+	// 零值时使用应用默认凭据（ADC），如 GOOGLE_APPLICATION_CREDENTIALS 环境变量、GKE 工作负载身份联合或 GCE 元数据服务等。
+	ServiceAccountKey string `json:"serviceAccountKey,omitempty"`
+	// GCP 项目 ID。
+	// 选填。零值时默认使用服务账号密钥或应用默认凭据中的项目 ID。
+	ProjectId string `json:"projectId,omitempty"`
+	// 目标 HTTPS 代理名称。
+	TargetHttpsProxyName string `json:"targetHttpsProxyName"`
+	// 证书名称前缀。
+	// 选填。零值时默认为 "certimate"。
+	CertificateNamePrefix string `json:"certificateNamePrefix,omitempty"`
+	// 保留的历史证书数量。
+	// 选填。超出数量的、以证书名称前缀命名且未被使用的历史证书将被删除；零值时不删除。
+	KeepRotations int32 `json:"keepRotations,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *gcpCompute.Service
+	projectId string
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServiceAccountKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	projectId := config.ProjectId
+	if projectId == "" {
+		projectId, err = resolveProjectId(config.ServiceAccountKey)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to resolve project id")
+		}
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+		projectId: projectId,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.TargetHttpsProxyName == "" {
+		return nil, errors.New("config `targetHttpsProxyName` is required")
+	}
+
+	namePrefix := d.config.CertificateNamePrefix
+	if namePrefix == "" {
+		namePrefix = "certimate"
+	}
+	namePrefix = namePrefix + "-"
+
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取目标 HTTPS 代理
+	// REF: https://cloud.google.com/compute/docs/reference/rest/v1/targetHttpsProxies/get
+	getTargetHttpsProxyResp, err := d.sdkClient.TargetHttpsProxies.Get(d.projectId, d.config.TargetHttpsProxyName).Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'compute.targetHttpsProxies.get'")
+	}
+
+	d.logger.Logt("已获取目标 HTTPS 代理", getTargetHttpsProxyResp)
+
+	// 如果主证书与待部署证书相同，则跳过
+	if len(getTargetHttpsProxyResp.SslCertificates) > 0 {
+		// REF: https://cloud.google.com/compute/docs/reference/rest/v1/sslCertificates/get
+		getSslCertificateResp, err := d.sdkClient.SslCertificates.Get(d.projectId, resolveResourceName(getTargetHttpsProxyResp.SslCertificates[0])).Context(ctx).Do()
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'compute.sslCertificates.get'")
+		}
+
+		oldCertX509, err := certs.ParseCertificateFromPEM(getSslCertificateResp.Certificate)
+		if err == nil && certs.EqualCertificate(certX509, oldCertX509) {
+			d.logger.Logt("目标 HTTPS 代理已绑定该证书，跳过更新")
+			return &deployer.DeployResult{}, nil
+		}
+	}
+
+	// 创建 SSL 证书
+	// REF: https://cloud.google.com/compute/docs/reference/rest/v1/sslCertificates/insert
+	certName := fmt.Sprintf("%s%d", namePrefix, time.Now().UnixMilli())
+	insertSslCertificateReq := &gcpCompute.SslCertificate{
+		Name:        certName,
+		Description: "Uploaded by Certimate",
+		Certificate: certPem,
+		PrivateKey:  privkeyPem,
+	}
+	insertSslCertificateResp, err := d.sdkClient.SslCertificates.Insert(d.projectId, insertSslCertificateReq).Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'compute.sslCertificates.insert'")
+	}
+
+	if err := d.waitForGlobalOperation(ctx, insertSslCertificateResp); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已创建 SSL 证书", certName)
+
+	// 替换目标 HTTPS 代理的证书列表
+	// 新证书作为主证书置于首位，并移除此前以相同前缀命名的证书，其余证书保持不变
+	sslCertificates := []string{insertSslCertificateResp.TargetLink}
+	for _, certLink := range getTargetHttpsProxyResp.SslCertificates {
+		if strings.HasPrefix(resolveResourceName(certLink), namePrefix) {
+			continue
+		}
+
+		sslCertificates = append(sslCertificates, certLink)
+	}
+
+	// REF: https://cloud.google.com/compute/docs/reference/rest/v1/targetHttpsProxies/setSslCertificates
+	setSslCertificatesReq := &gcpCompute.TargetHttpsProxiesSetSslCertificatesRequest{
+		SslCertificates: sslCertificates,
+	}
+	setSslCertificatesResp, err := d.sdkClient.TargetHttpsProxies.SetSslCertificates(d.projectId, d.config.TargetHttpsProxyName, setSslCertificatesReq).Context(ctx).Do()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'compute.targetHttpsProxies.setSslCertificates'")
+	}
+
+	if err := d.waitForGlobalOperation(ctx, setSslCertificatesResp); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已更新目标 HTTPS 代理的证书列表", sslCertificates)
+
+	// 清理历史证书
+	if d.config.KeepRotations > 0 {
+		if err := d.removeStaleSslCertificates(ctx, namePrefix, sslCertificates); err != nil {
+			d.logger.Logt("failed to remove stale ssl certificates", err)
+		}
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) removeStaleSslCertificates(ctx context.Context, namePrefix string, inUseCertLinks []string) error {
+	inUseCertNames := make(map[string]struct{}, len(inUseCertLinks))
+	for _, certLink := range inUseCertLinks {
+		inUseCertNames[resolveResourceName(certLink)] = struct{}{}
+	}
+
+	// 获取以相同前缀命名的历史证书
+	// REF: https://cloud.google.com/compute/docs/reference/rest/v1/sslCertificates/list
+	staleCerts := make([]*gcpCompute.SslCertificate, 0)
+	err := d.sdkClient.SslCertificates.List(d.projectId).Pages(ctx, func(page *gcpCompute.SslCertificateList) error {
+		for _, cert := range page.Items {
+			if !strings.HasPrefix(cert.Name, namePrefix) {
+				continue
+			}
+			if _, ok := inUseCertNames[cert.Name]; ok {
+				continue
+			}
+
+			staleCerts = append(staleCerts, cert)
+		}
+		return nil
+	})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'compute.sslCertificates.list'")
+	}
+
+	if len(staleCerts) <= int(d.config.KeepRotations) {
+		return nil
+	}
+
+	// 按创建时间倒序排列，保留最近的若干个
+	sort.Slice(staleCerts, func(i, j int) bool {
+		return staleCerts[i].CreationTimestamp > staleCerts[j].CreationTimestamp
+	})
+
+	var errs []error
+	for _, cert := range staleCerts[d.config.KeepRotations:] {
+		// 删除 SSL 证书
+		// 如果证书仍被其他代理引用，删除会失败，此时忽略并继续
+		// REF: https://cloud.google.com/compute/docs/reference/rest/v1/sslCertificates/delete
+		deleteSslCertificateResp, err := d.sdkClient.SslCertificates.Delete(d.projectId, cert.Name).Context(ctx).Do()
+		if err != nil {
+			errs = append(errs, xerrors.Wrap(err, "failed to execute sdk request 'compute.sslCertificates.delete'"))
+			continue
+		}
+
+		if err := d.waitForGlobalOperation(ctx, deleteSslCertificateResp); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		d.logger.Logt("已删除历史 SSL 证书", cert.Name)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (d *DeployerProvider) waitForGlobalOperation(ctx context.Context, op *gcpCompute.Operation) error {
+	// 等待全局操作完成
+	// REF: https://cloud.google.com/compute/docs/reference/rest/v1/globalOperations/wait
+	for op.Status != "DONE" {
+		resp, err := d.sdkClient.GlobalOperations.Wait(d.projectId, op.Name).Context(ctx).Do()
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'compute.globalOperations.wait'")
+		}
+
+		op = resp
+	}
+
+	if op.Error != nil && len(op.Error.Errors) > 0 {
+		messages := make([]string, 0, len(op.Error.Errors))
+		for _, e := range op.Error.Errors {
+			messages = append(messages, fmt.Sprintf("%s: %s", e.Code, e.Message))
+		}
+		return fmt.Errorf("operation '%s' failed: %s", op.Name, strings.Join(messages, "; "))
+	}
+
+	return nil
+}
+
+func createSdkClient(serviceAccountKey string) (*gcpCompute.Service, error) {
+	// 留空以使用应用默认凭据（ADC）
+	opts := make([]option.ClientOption, 0)
+	if serviceAccountKey != "" {
+		opts = append(opts, option.WithCredentialsJSON([]byte(serviceAccountKey)))
+	}
+
+	client, err := gcpCompute.NewService(context.Background(), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
+
+func resolveProjectId(serviceAccountKey string) (string, error) {
+	if serviceAccountKey == "" {
+		credentials, err := google.FindDefaultCredentials(context.Background(), gcpCompute.CloudPlatformScope)
+		if err != nil {
+			return "", err
+		}
+
+		if credentials.ProjectID == "" {
+			return "", errors.New("project_id is missing in the application default credentials")
+		}
+
+		return credentials.ProjectID, nil
+	}
+
+	var key struct {
+		ProjectId string `json:"project_id"`
+	}
+	if err := json.Unmarshal([]byte(serviceAccountKey), &key); err != nil {
+		return "", err
+	}
+
+	if key.ProjectId == "" {
+		return "", errors.New("project_id is missing in the service account key")
+	}
+
+	return key.ProjectId, nil
+}
+
+func resolveResourceName(link string) string {
+	return link[strings.LastIndex(link, "/")+1:]
+}
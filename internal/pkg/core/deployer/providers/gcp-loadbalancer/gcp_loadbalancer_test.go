@@ -0,0 +1,85 @@
+package gcploadbalancer_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gcp-loadbalancer"
+)
+
+var (
+	fInputCertPath         string
+	fInputKeyPath          string
+	fServiceAccountKey     string
+	fProjectId             string
+	fTargetHttpsProxyName  string
+	fCertificateNamePrefix string
+	fKeepRotations         int64
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_GCPLOADBALANCER_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServiceAccountKey, argsPrefix+"SERVICEACCOUNTKEY", "", "")
+	flag.StringVar(&fProjectId, argsPrefix+"PROJECTID", "", "")
+	flag.StringVar(&fTargetHttpsProxyName, argsPrefix+"TARGETHTTPSPROXYNAME", "", "")
+	flag.StringVar(&fCertificateNamePrefix, argsPrefix+"CERTIFICATENAMEPREFIX", "certimate", "")
+	flag.Int64Var(&fKeepRotations, argsPrefix+"KEEPROTATIONS", 0, "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./gcp_loadbalancer_test.go -args \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_SERVICEACCOUNTKEY="{\"type\":\"service_account\", ...}" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_PROJECTID="your-project-id" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_TARGETHTTPSPROXYNAME="your-target-https-proxy-name" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_CERTIFICATENAMEPREFIX="certimate" \
+	--CERTIMATE_DEPLOYER_GCPLOADBALANCER_KEEPROTATIONS=2
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVICEACCOUNTKEY: %v", fServiceAccountKey),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+			fmt.Sprintf("TARGETHTTPSPROXYNAME: %v", fTargetHttpsProxyName),
+			fmt.Sprintf("CERTIFICATENAMEPREFIX: %v", fCertificateNamePrefix),
+			fmt.Sprintf("KEEPROTATIONS: %v", fKeepRotations),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServiceAccountKey:     fServiceAccountKey,
+			ProjectId:             fProjectId,
+			TargetHttpsProxyName:  fTargetHttpsProxyName,
+			CertificateNamePrefix: fCertificateNamePrefix,
+			KeepRotations:         int32(fKeepRotations),
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,21 @@
+package gitlab
+
+type ResourceType string
+
+const (
+	// 资源类型：写入项目 CI/CD 变量。
+	RESOURCE_TYPE_PROJECT_VARIABLE = ResourceType("project-variable")
+	// 资源类型：写入群组 CI/CD 变量。
+	RESOURCE_TYPE_GROUP_VARIABLE = ResourceType("group-variable")
+	// 资源类型：以提交的方式写入仓库文件。
+	RESOURCE_TYPE_REPOSITORY_FILE = ResourceType("repository-file")
+)
+
+type VariableType string
+
+const (
+	// 变量类型：环境变量。
+	VARIABLE_TYPE_ENV_VAR = VariableType("env_var")
+	// 变量类型：文件。流水线中的环境变量值为临时文件路径。
+	VARIABLE_TYPE_FILE = VariableType("file")
+)
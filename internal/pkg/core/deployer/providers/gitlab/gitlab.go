@@ -0,0 +1,333 @@
+package gitlab
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	gitlabsdk "github.com/usual2970/certimate/internal/pkg/vendors/gitlab-sdk"
+)
+
+type DeployerConfig struct {
+	// GitLab 服务地址。
+	// 零值时默认为 "https://gitlab.com"。
+	ServerUrl string `json:"serverUrl,omitempty"`
+	// GitLab 访问令牌。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 部署资源类型。
+	ResourceType ResourceType `json:"resourceType"`
+	// 项目 ID 或完整路径，如 "123" 或 "group/project"。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE]、[RESOURCE_TYPE_REPOSITORY_FILE] 时必填。
+	ProjectId string `json:"projectId,omitempty"`
+	// 群组 ID 或完整路径，如 "123" 或 "group/subgroup"。
+	// 部署资源类型为 [RESOURCE_TYPE_GROUP_VARIABLE] 时必填。
+	GroupId string `json:"groupId,omitempty"`
+	// 存放证书（含证书链）的变量名。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE]、[RESOURCE_TYPE_GROUP_VARIABLE] 时选填。
+	// 零值时默认为 "CERTIMATE_CERTIFICATE"。
+	VariableKeyForCertificate string `json:"variableKeyForCertificate,omitempty"`
+	// 存放私钥的变量名。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE]、[RESOURCE_TYPE_GROUP_VARIABLE] 时选填。
+	// 零值时默认为 "CERTIMATE_PRIVATE_KEY"。
+	VariableKeyForPrivateKey string `json:"variableKeyForPrivateKey,omitempty"`
+	// 变量类型。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE]、[RESOURCE_TYPE_GROUP_VARIABLE] 时选填。
+	// 零值时默认值 [VARIABLE_TYPE_FILE]。
+	VariableType VariableType `json:"variableType,omitempty"`
+	// 变量的环境作用域。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE] 时选填。
+	// 零值时默认为 "*"。
+	VariableEnvironmentScope string `json:"variableEnvironmentScope,omitempty"`
+	// 是否仅在受保护的分支或标签上暴露变量。
+	// 部署资源类型为 [RESOURCE_TYPE_PROJECT_VARIABLE]、[RESOURCE_TYPE_GROUP_VARIABLE] 时选填。
+	VariableProtected bool `json:"variableProtected,omitempty"`
+	// 提交的目标分支。
+	// 部署资源类型为 [RESOURCE_TYPE_REPOSITORY_FILE] 时必填。
+	Branch string `json:"branch,omitempty"`
+	// 证书（含证书链）在仓库中的文件路径。
+	// 部署资源类型为 [RESOURCE_TYPE_REPOSITORY_FILE] 时必填。
+	FilePathForCertificate string `json:"filePathForCertificate,omitempty"`
+	// 私钥在仓库中的文件路径。
+	// 部署资源类型为 [RESOURCE_TYPE_REPOSITORY_FILE] 时选填。零值时不写入私钥。
+	FilePathForPrivateKey string `json:"filePathForPrivateKey,omitempty"`
+	// 提交信息。
+	// 部署资源类型为 [RESOURCE_TYPE_REPOSITORY_FILE] 时选填。
+	CommitMessage string `json:"commitMessage,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *gitlabsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiToken, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 根据部署资源类型决定部署方式
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_PROJECT_VARIABLE, RESOURCE_TYPE_GROUP_VARIABLE:
+		if err := d.deployToVariables(ctx, certPem, privkeyPem, opts...); err != nil {
+			return nil, err
+		}
+
+	case RESOURCE_TYPE_REPOSITORY_FILE:
+		commit, err := d.deployToRepositoryFiles(ctx, certPem, privkeyPem, opts...)
+		if err != nil {
+			return nil, err
+		}
+		if commit != nil {
+			return &deployer.DeployResult{
+				ExtendedData: map[string]any{
+					"commitId":  commit.Id,
+					"commitUrl": commit.WebUrl,
+				},
+			}, nil
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) deployToVariables(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) error {
+	var (
+		getVariable    func(key string, scope string) (*gitlabsdk.Variable, error)
+		createVariable func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error)
+		updateVariable func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error)
+	)
+	environmentScope := ""
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_PROJECT_VARIABLE:
+		if d.config.ProjectId == "" {
+			return errors.New("config `projectId` is required")
+		}
+
+		projectId := d.config.ProjectId
+		getVariable = func(key string, scope string) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.GetProjectVariable(projectId, key, scope)
+		}
+		createVariable = func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.CreateProjectVariable(projectId, variable)
+		}
+		updateVariable = func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.UpdateProjectVariable(projectId, variable)
+		}
+
+		environmentScope = d.config.VariableEnvironmentScope
+		if environmentScope == "" {
+			environmentScope = "*"
+		}
+
+	case RESOURCE_TYPE_GROUP_VARIABLE:
+		if d.config.GroupId == "" {
+			return errors.New("config `groupId` is required")
+		}
+
+		// 群组变量的环境作用域仅在 GitLab Premium 及以上版本可用，此处不做设置
+		groupId := d.config.GroupId
+		getVariable = func(key string, _ string) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.GetGroupVariable(groupId, key, "")
+		}
+		createVariable = func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.CreateGroupVariable(groupId, variable)
+		}
+		updateVariable = func(variable *gitlabsdk.Variable) (*gitlabsdk.Variable, error) {
+			return d.sdkClient.UpdateGroupVariable(groupId, variable)
+		}
+	}
+
+	variableType := d.config.VariableType
+	if variableType == "" {
+		variableType = VARIABLE_TYPE_FILE
+	}
+
+	keyForCertificate := d.config.VariableKeyForCertificate
+	if keyForCertificate == "" {
+		keyForCertificate = "CERTIMATE_CERTIFICATE"
+	}
+	keyForPrivateKey := d.config.VariableKeyForPrivateKey
+	if keyForPrivateKey == "" {
+		keyForPrivateKey = "CERTIMATE_PRIVATE_KEY"
+	}
+	if keyForCertificate == keyForPrivateKey {
+		return errors.New("config `variableKeyForCertificate` and `variableKeyForPrivateKey` must be different")
+	}
+
+	// 查询已有变量，以决定新建还是更新
+	// REF: https://docs.gitlab.com/api/project_level_variables/
+	// REF: https://docs.gitlab.com/api/group_level_variables/
+	// 注意不要将变量值输出到日志中
+	variables := []*gitlabsdk.Variable{
+		{Key: keyForCertificate, Value: certPem},
+		{Key: keyForPrivateKey, Value: privkeyPem},
+	}
+	existing := make(map[string]bool, len(variables))
+	for _, variable := range variables {
+		getVariableResp, err := getVariable(variable.Key, environmentScope)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'gitlab.GetVariable' (key: %s)", variable.Key)
+		}
+
+		existing[variable.Key] = getVariableResp != nil
+	}
+
+	// 仅校验模式下只检查变量是否可读，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: gitlab variables are readable, nothing written", existing)
+		return nil
+	}
+
+	for _, variable := range variables {
+		// PEM 内容包含换行，无法满足掩码变量的格式要求，因此不启用掩码；
+		// 同时启用原始值模式，避免其中的 "$" 被流水线展开
+		variable.VariableType = string(variableType)
+		variable.Protected = d.config.VariableProtected
+		variable.Masked = false
+		variable.Raw = true
+		variable.EnvironmentScope = environmentScope
+
+		if existing[variable.Key] {
+			if _, err := updateVariable(variable); err != nil {
+				return xerrors.Wrapf(err, "failed to execute sdk request 'gitlab.UpdateVariable' (key: %s)", variable.Key)
+			}
+
+			d.logger.Logt("已更新 GitLab CI/CD 变量", variable.Key)
+		} else {
+			if _, err := createVariable(variable); err != nil {
+				return xerrors.Wrapf(err, "failed to execute sdk request 'gitlab.CreateVariable' (key: %s)", variable.Key)
+			}
+
+			d.logger.Logt("已创建 GitLab CI/CD 变量", variable.Key)
+		}
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) deployToRepositoryFiles(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*gitlabsdk.Commit, error) {
+	if d.config.ProjectId == "" {
+		return nil, errors.New("config `projectId` is required")
+	}
+	if d.config.Branch == "" {
+		return nil, errors.New("config `branch` is required")
+	}
+	if d.config.FilePathForCertificate == "" {
+		return nil, errors.New("config `filePathForCertificate` is required")
+	}
+	if d.config.FilePathForCertificate == d.config.FilePathForPrivateKey {
+		return nil, errors.New("config `filePathForCertificate` and `filePathForPrivateKey` must be different")
+	}
+
+	files := []*gitlabsdk.CommitAction{
+		{FilePath: d.config.FilePathForCertificate, Content: certPem},
+	}
+	if d.config.FilePathForPrivateKey != "" {
+		files = append(files, &gitlabsdk.CommitAction{FilePath: d.config.FilePathForPrivateKey, Content: privkeyPem})
+	}
+
+	// 查询文件是否已存在，以决定提交动作是新建还是更新
+	// REF: https://docs.gitlab.com/api/repository_files/
+	for _, file := range files {
+		getFileResp, err := d.sdkClient.GetRepositoryFile(d.config.ProjectId, file.FilePath, d.config.Branch)
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to execute sdk request 'gitlab.GetRepositoryFile' (path: %s)", file.FilePath)
+		}
+
+		if getFileResp == nil {
+			file.Action = "create"
+		} else {
+			file.Action = "update"
+		}
+	}
+
+	// 仅校验模式下只检查文件是否可读，不实际提交
+	// 注意不要将文件内容输出到日志中
+	if deployer.MergeOptions(opts...).DryRun {
+		actions := make(map[string]string, len(files))
+		for _, file := range files {
+			actions[file.FilePath] = file.Action
+		}
+		d.logger.Logt("dry run: gitlab repository files are readable, nothing committed", actions)
+		return nil, nil
+	}
+
+	commitMessage := d.config.CommitMessage
+	if commitMessage == "" {
+		commitMessage = "chore: update certificate by certimate"
+	}
+
+	// 在同一提交中写入所有文件，避免流水线读取到不一致的证书与私钥
+	// REF: https://docs.gitlab.com/api/commits/#create-a-commit-with-multiple-files-and-actions
+	createCommitReq := &gitlabsdk.CreateCommitRequest{
+		Branch:        d.config.Branch,
+		CommitMessage: commitMessage,
+		Actions:       files,
+	}
+	createCommitResp, err := d.sdkClient.CreateCommit(d.config.ProjectId, createCommitReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'gitlab.CreateCommit'")
+	}
+
+	d.logger.Logt("已提交 GitLab 仓库文件", map[string]any{"commitId": createCommitResp.Id, "webUrl": createCommitResp.WebUrl})
+
+	return createCommitResp, nil
+}
+
+func createSdkClient(serverUrl, apiToken string, skipTlsVerify bool) (*gitlabsdk.Client, error) {
+	if serverUrl == "" {
+		serverUrl = "https://gitlab.com"
+	}
+
+	if apiToken == "" {
+		return nil, errors.New("invalid gitlab api token")
+	}
+
+	client := gitlabsdk.NewClient(serverUrl).
+		WithToken(apiToken).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
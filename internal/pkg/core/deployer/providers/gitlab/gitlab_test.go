@@ -0,0 +1,76 @@
+package gitlab_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/gitlab"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fApiToken      string
+	fProjectId     string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_GITLAB_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+	flag.StringVar(&fProjectId, argsPrefix+"PROJECTID", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./gitlab_test.go -args \
+	--CERTIMATE_DEPLOYER_GITLAB_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_GITLAB_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_GITLAB_SERVERURL="https://gitlab.com" \
+	--CERTIMATE_DEPLOYER_GITLAB_APITOKEN="your-gitlab-token" \
+	--CERTIMATE_DEPLOYER_GITLAB_PROJECTID="group/project"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:    fServerUrl,
+			ApiToken:     fApiToken,
+			ResourceType: provider.RESOURCE_TYPE_PROJECT_VARIABLE,
+			ProjectId:    fProjectId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,133 @@
+package grpcagent
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// gRPC 服务地址，格式为 "host:port"。
+	Endpoint string `json:"endpoint"`
+	// 是否使用 TLS 连接。
+	UseTLS bool `json:"useTLS,omitempty"`
+	// 认证令牌。
+	// 选填。非空时将以 "authorization: Bearer <token>" 的形式附加到请求元数据中。
+	AuthToken string `json:"authToken,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 部署目标，由 Agent 自行解释。
+	// 选填。
+	Target string `json:"target,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	rpcClient *grpc.ClientConn
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createRpcClient(config.Endpoint, config.UseTLS, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create grpc client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		rpcClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to parse x509")
+	}
+
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	if d.config.AuthToken != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, "authorization", "Bearer "+d.config.AuthToken)
+	}
+
+	// 仅校验模式下由 Agent 负责校验，不实际部署证书
+	req := &deployCertificateRequest{
+		Certificate:             certPem,
+		PrivateKey:              privkeyPem,
+		ServerCertificate:       serverCertPem,
+		IntermediateCertificate: intermediaCertPem,
+		Domains:                 certX509.DNSNames,
+		NotAfter:                certX509.NotAfter.Unix(),
+		Target:                  d.config.Target,
+		DryRun:                  deployer.MergeOptions(opts...).DryRun,
+	}
+	resp := &deployCertificateResponse{}
+	if err := d.rpcClient.Invoke(ctx, deployCertificateMethod, req, resp); err != nil {
+		return nil, xerrors.Wrapf(err, "failed to execute grpc request '%s'", deployCertificateMethod)
+	} else if !resp.Success {
+		return nil, xerrors.Errorf("grpc agent rejected the certificate: %s", resp.Message)
+	}
+
+	d.logger.Logt("certificate pushed to grpc agent", resp.Message)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createRpcClient(endpoint string, useTLS bool, allowInsecureConnections bool) (*grpc.ClientConn, error) {
+	if endpoint == "" {
+		return nil, errors.New("invalid grpc endpoint")
+	}
+
+	var creds credentials.TransportCredentials
+	if useTLS {
+		tlsConfig := &tls.Config{}
+		if allowInsecureConnections {
+			tlsConfig.InsecureSkipVerify = true
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	} else {
+		creds = insecure.NewCredentials()
+	}
+
+	// 连接在首次请求时才会真正建立
+	return grpc.NewClient(
+		endpoint,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(protoCodec{})),
+		grpc.WithIdleTimeout(30*time.Second),
+	)
+}
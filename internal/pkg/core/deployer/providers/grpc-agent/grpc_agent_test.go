@@ -0,0 +1,75 @@
+package grpcagent_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/grpc-agent"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fEndpoint      string
+	fAuthToken     string
+	fTarget        string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_GRPCAGENT_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fEndpoint, argsPrefix+"ENDPOINT", "", "")
+	flag.StringVar(&fAuthToken, argsPrefix+"AUTHTOKEN", "", "")
+	flag.StringVar(&fTarget, argsPrefix+"TARGET", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./grpc_agent_test.go -args \
+	--CERTIMATE_DEPLOYER_GRPCAGENT_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_GRPCAGENT_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_GRPCAGENT_ENDPOINT="127.0.0.1:50051" \
+	--CERTIMATE_DEPLOYER_GRPCAGENT_AUTHTOKEN="your-auth-token" \
+	--CERTIMATE_DEPLOYER_GRPCAGENT_TARGET="nginx"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("ENDPOINT: %v", fEndpoint),
+			fmt.Sprintf("AUTHTOKEN: %v", fAuthToken),
+			fmt.Sprintf("TARGET: %v", fTarget),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Endpoint:  fEndpoint,
+			AuthToken: fAuthToken,
+			Target:    fTarget,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
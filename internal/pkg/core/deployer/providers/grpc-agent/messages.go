@@ -0,0 +1,122 @@
+package grpcagent
+
+import (
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// 以下消息类型与 agent.proto 中的定义一一对应。
+// 由于消息结构简单，这里直接手动编解码，避免引入 protoc 代码生成。
+
+const deployCertificateMethod = "/certimate.agent.v1.CertificateAgent/DeployCertificate"
+
+type deployCertificateRequest struct {
+	Certificate             string
+	PrivateKey              string
+	ServerCertificate       string
+	IntermediateCertificate string
+	Domains                 []string
+	NotAfter                int64
+	Target                  string
+	DryRun                  bool
+}
+
+func (m *deployCertificateRequest) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.Certificate)
+	b = appendString(b, 2, m.PrivateKey)
+	b = appendString(b, 3, m.ServerCertificate)
+	b = appendString(b, 4, m.IntermediateCertificate)
+	for _, domain := range m.Domains {
+		b = protowire.AppendTag(b, 5, protowire.BytesType)
+		b = protowire.AppendString(b, domain)
+	}
+	if m.NotAfter != 0 {
+		b = protowire.AppendTag(b, 6, protowire.VarintType)
+		b = protowire.AppendVarint(b, uint64(m.NotAfter))
+	}
+	b = appendString(b, 7, m.Target)
+	if m.DryRun {
+		b = protowire.AppendTag(b, 8, protowire.VarintType)
+		b = protowire.AppendVarint(b, protowire.EncodeBool(true))
+	}
+	return b
+}
+
+type deployCertificateResponse struct {
+	Success bool
+	Message string
+}
+
+func (m *deployCertificateResponse) unmarshal(b []byte) error {
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		b = b[n:]
+
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			m.Success = protowire.DecodeBool(v)
+			b = b[n:]
+
+		case num == 2 && typ == protowire.BytesType:
+			v, n := protowire.ConsumeString(b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			m.Message = v
+			b = b[n:]
+
+		default:
+			// 忽略未知字段，以兼容协议的后续扩展
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+
+	return nil
+}
+
+func appendString(b []byte, num protowire.Number, s string) []byte {
+	if s == "" {
+		return b
+	}
+
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendString(b, s)
+}
+
+// 实现 gRPC 的 encoding.Codec 接口，名称为 "proto" 以保证与标准 Protobuf 服务端兼容。
+type protoCodec struct{}
+
+func (protoCodec) Marshal(v any) ([]byte, error) {
+	switch m := v.(type) {
+	case *deployCertificateRequest:
+		return m.marshal(), nil
+	default:
+		return nil, fmt.Errorf("unsupported message type: %T", v)
+	}
+}
+
+func (protoCodec) Unmarshal(data []byte, v any) error {
+	switch m := v.(type) {
+	case *deployCertificateResponse:
+		return m.unmarshal(data)
+	default:
+		return fmt.Errorf("unsupported message type: %T", v)
+	}
+}
+
+func (protoCodec) Name() string {
+	return "proto"
+}
@@ -0,0 +1,148 @@
+package haproxy
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	haproxysdk "github.com/usual2970/certimate/internal/pkg/vendors/haproxy-sdk"
+)
+
+type DeployerConfig struct {
+	// HAProxy Data Plane API 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// HAProxy Data Plane API 版本。
+	// 选填。可取值 "v2"、"v3"。零值时默认为 "v3"。
+	ApiVersion string `json:"apiVersion,omitempty"`
+	// HAProxy Data Plane API 用户名。
+	Username string `json:"username"`
+	// HAProxy Data Plane API 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书文件名。
+	// 存在同名证书文件时将原地替换，使已引用该证书的 bind 配置无需修改。
+	CertificateName string `json:"certificateName"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *haproxysdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiVersion, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateName == "" {
+		return nil, errors.New("config `certificateName` is required")
+	} else if strings.ContainsAny(d.config.CertificateName, "/\\") {
+		return nil, fmt.Errorf("config `certificateName` must be a file name, got '%s'", d.config.CertificateName)
+	}
+
+	// HAProxy 要求证书与私钥合并在同一个 PEM 文件中
+	combinedPem := strings.TrimRight(certPem, "\n") + "\n" + strings.TrimRight(privkeyPem, "\n") + "\n"
+
+	// 查找同名证书文件
+	// REF: https://www.haproxy.com/documentation/haproxy-data-plane-api/reference/
+	listCertsResp, err := d.sdkClient.ListStorageSslCertificates()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'haproxy.ListStorageSslCertificates'")
+	}
+
+	var existingCert *haproxysdk.SslCertificate
+	for _, cert := range listCertsResp {
+		if cert != nil && cert.StorageName == d.config.CertificateName {
+			existingCert = cert
+			break
+		}
+	}
+
+	d.logger.Logt("已查询到证书文件列表", listCertsResp)
+
+	// 仅校验模式下只检查 API 凭据是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 替换或上传证书文件，并重新加载 HAProxy
+	if existingCert != nil {
+		replaceCertResp, err := d.sdkClient.ReplaceStorageSslCertificate(existingCert.StorageName, combinedPem, true)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'haproxy.ReplaceStorageSslCertificate'")
+		}
+
+		d.logger.Logt("已替换证书文件并重新加载 HAProxy", replaceCertResp)
+	} else {
+		createCertResp, err := d.sdkClient.CreateStorageSslCertificate(d.config.CertificateName, combinedPem, true)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'haproxy.CreateStorageSslCertificate'")
+		}
+
+		d.logger.Logt("已上传证书文件并重新加载 HAProxy", createCertResp)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(serverUrl, apiVersion, username, password string, skipTlsVerify bool) (*haproxysdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid haproxy data plane api server url")
+	}
+
+	if username == "" || password == "" {
+		return nil, errors.New("invalid haproxy data plane api credentials")
+	}
+
+	switch apiVersion {
+	case "":
+		apiVersion = "v3"
+	case "v2", "v3":
+	default:
+		return nil, fmt.Errorf("invalid haproxy data plane api version '%s'", apiVersion)
+	}
+
+	client := haproxysdk.NewClient(serverUrl, apiVersion, username, password).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
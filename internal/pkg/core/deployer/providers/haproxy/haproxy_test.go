@@ -0,0 +1,86 @@
+package haproxy_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/haproxy"
+)
+
+var (
+	fInputCertPath   string
+	fInputKeyPath    string
+	fServerUrl       string
+	fApiVersion      string
+	fUsername        string
+	fPassword        string
+	fCertificateName string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_HAPROXY_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fApiVersion, argsPrefix+"APIVERSION", "v3", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fCertificateName, argsPrefix+"CERTIFICATENAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./haproxy_test.go -args \
+	--CERTIMATE_DEPLOYER_HAPROXY_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_HAPROXY_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_HAPROXY_SERVERURL="http://127.0.0.1:5555" \
+	--CERTIMATE_DEPLOYER_HAPROXY_APIVERSION="v3" \
+	--CERTIMATE_DEPLOYER_HAPROXY_USERNAME="admin" \
+	--CERTIMATE_DEPLOYER_HAPROXY_PASSWORD="password" \
+	--CERTIMATE_DEPLOYER_HAPROXY_CERTIFICATENAME="certimate.pem"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("APIVERSION: %v", fApiVersion),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("CERTIFICATENAME: %v", fCertificateName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:                fServerUrl,
+			ApiVersion:               fApiVersion,
+			Username:                 fUsername,
+			Password:                 fPassword,
+			AllowInsecureConnections: true,
+			CertificateName:          fCertificateName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
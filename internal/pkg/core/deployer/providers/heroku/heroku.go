@@ -0,0 +1,142 @@
+package heroku
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	herokusdk "github.com/usual2970/certimate/internal/pkg/vendors/heroku-sdk"
+)
+
+type DeployerConfig struct {
+	// Heroku API Key。
+	ApiKey string `json:"apiKey"`
+	// 应用 ID 或名称。
+	AppName string `json:"appName"`
+	// SNI 端点 ID 或名称。
+	// 选填。不填写时，若应用下仅有一个 SNI 端点则更新该端点，若没有则新建端点。
+	SniEndpointId string `json:"sniEndpointId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *herokusdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.AppName == "" {
+		return nil, errors.New("config `appName` is required")
+	}
+
+	// 查询应用的 SNI 端点列表
+	// REF: https://devcenter.heroku.com/articles/platform-api-reference#sni-endpoint-list
+	listSniEndpointsResp, err := d.sdkClient.ListSniEndpoints(d.config.AppName)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'heroku.ListSniEndpoints'")
+	}
+
+	d.logger.Logt("已查询到 SNI 端点列表", listSniEndpointsResp)
+
+	// 确定待更新的 SNI 端点
+	sniEndpointId := d.config.SniEndpointId
+	if sniEndpointId != "" {
+		found := false
+		for _, sniEndpoint := range *listSniEndpointsResp {
+			if sniEndpoint.Id == sniEndpointId || sniEndpoint.Name == sniEndpointId {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("could not find sni endpoint '%s'", sniEndpointId)
+		}
+	} else {
+		switch len(*listSniEndpointsResp) {
+		case 0:
+		case 1:
+			sniEndpointId = (*listSniEndpointsResp)[0].Id
+		default:
+			return nil, errors.New("multiple sni endpoints found, config `sniEndpointId` is required")
+		}
+	}
+
+	// 仅校验模式下只查询 SNI 端点，不实际修改证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	if sniEndpointId == "" {
+		// 新建 SNI 端点
+		// REF: https://devcenter.heroku.com/articles/platform-api-reference#sni-endpoint-create
+		createSniEndpointReq := &herokusdk.CreateSniEndpointRequest{
+			CertificateChain: certPem,
+			PrivateKey:       privkeyPem,
+		}
+		createSniEndpointResp, err := d.sdkClient.CreateSniEndpoint(d.config.AppName, createSniEndpointReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'heroku.CreateSniEndpoint'")
+		}
+
+		d.logger.Logt("已新建 SNI 端点", createSniEndpointResp)
+	} else {
+		// 更新 SNI 端点
+		// REF: https://devcenter.heroku.com/articles/platform-api-reference#sni-endpoint-update
+		updateSniEndpointReq := &herokusdk.UpdateSniEndpointRequest{
+			CertificateChain: certPem,
+			PrivateKey:       privkeyPem,
+		}
+		updateSniEndpointResp, err := d.sdkClient.UpdateSniEndpoint(d.config.AppName, sniEndpointId, updateSniEndpointReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'heroku.UpdateSniEndpoint'")
+		}
+
+		d.logger.Logt("已更新 SNI 端点", updateSniEndpointResp)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiKey string) (*herokusdk.Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("invalid heroku api key")
+	}
+
+	client := herokusdk.NewClient(apiKey)
+	return client, nil
+}
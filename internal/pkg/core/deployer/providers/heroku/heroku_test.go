@@ -0,0 +1,75 @@
+package heroku_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/heroku"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fApiKey        string
+	fAppName       string
+	fSniEndpointId string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_HEROKU_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fApiKey, argsPrefix+"APIKEY", "", "")
+	flag.StringVar(&fAppName, argsPrefix+"APPNAME", "", "")
+	flag.StringVar(&fSniEndpointId, argsPrefix+"SNIENDPOINTID", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./heroku_test.go -args \
+	--CERTIMATE_DEPLOYER_HEROKU_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_HEROKU_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_HEROKU_APIKEY="" \
+	--CERTIMATE_DEPLOYER_HEROKU_APPNAME="" \
+	--CERTIMATE_DEPLOYER_HEROKU_SNIENDPOINTID=""
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("APIKEY: %v", fApiKey),
+			fmt.Sprintf("APPNAME: %v", fAppName),
+			fmt.Sprintf("SNIENDPOINTID: %v", fSniEndpointId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ApiKey:        fApiKey,
+			AppName:       fAppName,
+			SniEndpointId: fSniEndpointId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
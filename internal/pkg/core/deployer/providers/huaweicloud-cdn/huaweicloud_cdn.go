@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/auth/global"
 	hcCdn "github.com/huaweicloud/huaweicloud-sdk-go-v3/services/cdn/v2"
@@ -22,6 +23,7 @@ type DeployerConfig struct {
 	// 华为云 SecretAccessKey。
 	SecretAccessKey string `json:"secretAccessKey"`
 	// 华为云区域。
+	// 选填。零值时默认为 "cn-north-1"。
 	Region string `json:"region"`
 	// 加速域名（不支持泛域名）。
 	Domain string `json:"domain"`
@@ -34,7 +36,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -71,14 +76,14 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到 SCM
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	}
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
 
-	d.logger.Logt("certificate file uploaded", upres)
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
 
 	// 查询加速域名配置
 	// REF: https://support.huaweicloud.com/api-cdn/ShowDomainFullConfig.html
@@ -92,6 +97,19 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	d.logger.Logt("已查询到加速域名配置", showDomainFullConfigResp)
 
+	// 仅校验模式下只查询加速域名配置，不实际上传和更新证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到 SCM
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
 	// 更新加速域名配置
 	// REF: https://support.huaweicloud.com/api-cdn/UpdateDomainMultiCertificates.html
 	// REF: https://support.huaweicloud.com/usermanual-cdn/cdn_01_0306.html
@@ -109,12 +127,12 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	}
 	updateDomainMultiCertificatesResp, err := d.sdkClient.UpdateDomainMultiCertificates(updateDomainMultiCertificatesReq)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.UploadDomainMultiCertificates'")
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.UpdateDomainMultiCertificates'")
 	}
 
 	d.logger.Logt("已更新加速域名配置", updateDomainMultiCertificatesResp)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func createSdkClient(accessKeyId, secretAccessKey, region string) (*hcCdn.CdnClient, error) {
@@ -155,12 +173,15 @@ func assign(reqContent *hcCdnModel.UpdateDomainMultiCertificatesRequestBodyConte
 	// 华为云 API 中不传的字段表示使用默认值、而非保留原值，因此这里需要把原配置中的参数重新赋值回去。
 	// 而且蛋疼的是查询接口返回的数据结构和更新接口传入的参数结构不一致，需要做很多转化。
 
-	if *target.OriginProtocol == "follow" {
-		reqContent.AccessOriginWay = hwsdk.Int32Ptr(1)
-	} else if *target.OriginProtocol == "http" {
-		reqContent.AccessOriginWay = hwsdk.Int32Ptr(2)
-	} else if *target.OriginProtocol == "https" {
-		reqContent.AccessOriginWay = hwsdk.Int32Ptr(3)
+	if target.OriginProtocol != nil {
+		switch *target.OriginProtocol {
+		case "follow":
+			reqContent.AccessOriginWay = hwsdk.Int32Ptr(1)
+		case "http":
+			reqContent.AccessOriginWay = hwsdk.Int32Ptr(2)
+		case "https":
+			reqContent.AccessOriginWay = hwsdk.Int32Ptr(3)
+		}
 	}
 
 	if target.ForceRedirect != nil {
@@ -175,7 +196,7 @@ func assign(reqContent *hcCdnModel.UpdateDomainMultiCertificatesRequestBodyConte
 	}
 
 	if target.Https != nil {
-		if *target.Https.Http2Status == "on" {
+		if target.Https.Http2Status != nil && *target.Https.Http2Status == "on" {
 			reqContent.Http2 = hwsdk.Int32Ptr(1)
 		}
 	}
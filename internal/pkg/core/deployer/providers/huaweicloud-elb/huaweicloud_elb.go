@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/auth/basic"
 	"github.com/huaweicloud/huaweicloud-sdk-go-v3/core/auth/global"
@@ -20,6 +21,8 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/huaweicloud-elb"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 	hwsdk "github.com/usual2970/certimate/internal/pkg/vendors/huaweicloud-sdk"
 )
 
@@ -84,15 +87,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到 SCM
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	}
-
-	d.logger.Logt("certificate file uploaded", upres)
-
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 根据部署资源类型决定部署方式
 	switch d.config.ResourceType {
 	case RESOURCE_TYPE_CERTIFICATE:
@@ -204,16 +199,11 @@ func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, certPem str
 	if len(listenerIds) == 0 {
 		return errors.New("listener not found")
 	} else {
-		var errs []error
-
-		for _, listenerId := range listenerIds {
-			if err := d.modifyListenerCertificate(ctx, listenerId, upres.CertId); err != nil {
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			return errors.Join(errs...)
+		err := concurrent.ForEach(ctx, listenerIds, 0, func(ctx context.Context, listenerId string) error {
+			return d.modifyListenerCertificate(ctx, listenerId, upres.CertId)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
@@ -225,6 +215,28 @@ func (d *DeployerProvider) deployToListener(ctx context.Context, certPem string,
 		return errors.New("config `listenerId` is required")
 	}
 
+	// 查询监听器详情
+	// REF: https://support.huaweicloud.com/api-elb/ShowListener.html
+	showListenerReq := &hcElbModel.ShowListenerRequest{
+		ListenerId: d.config.ListenerId,
+	}
+	showListenerResp, err := d.sdkClient.ShowListener(showListenerReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'elb.ShowListener'")
+	}
+
+	d.logger.Logt("已查询到 ELB 监听器", showListenerResp)
+
+	// 如果监听器当前绑定的是由本系统创建的同域名证书，则直接原地更新该证书，避免每次部署都新增一张证书
+	if showListenerResp.Listener != nil && showListenerResp.Listener.DefaultTlsContainerRef != "" {
+		updated, err := d.tryUpdateBoundCertificate(ctx, showListenerResp.Listener.DefaultTlsContainerRef, certPem, privkeyPem)
+		if err != nil {
+			return err
+		} else if updated {
+			return nil
+		}
+	}
+
 	// 上传证书到 SCM
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -320,6 +332,64 @@ func (d *DeployerProvider) modifyListenerCertificate(ctx context.Context, cloudL
 	return nil
 }
 
+func (d *DeployerProvider) tryUpdateBoundCertificate(ctx context.Context, cloudCertId string, certPem string, privkeyPem string) (bool, error) {
+	// 查询证书详情
+	// REF: https://support.huaweicloud.com/api-elb/ShowCertificate.html
+	showCertificateReq := &hcElbModel.ShowCertificateRequest{
+		CertificateId: cloudCertId,
+	}
+	showCertificateResp, err := d.sdkClient.ShowCertificate(showCertificateReq)
+	if err != nil {
+		return false, xerrors.Wrap(err, "failed to execute sdk request 'elb.ShowCertificate'")
+	}
+
+	// 仅处理由本系统创建的、非 SCM 托管的证书
+	oldCertificate := showCertificateResp.Certificate
+	if oldCertificate == nil || !strings.HasPrefix(oldCertificate.Name, "certimate-") {
+		return false, nil
+	}
+	if oldCertificate.ScmCertificateId != nil && *oldCertificate.ScmCertificateId != "" {
+		return false, nil
+	}
+
+	// 比较新旧证书的域名是否一致
+	newCertX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return false, err
+	}
+	oldCertX509, err := certs.ParseCertificateFromPEM(oldCertificate.Certificate)
+	if err != nil {
+		return false, nil
+	}
+	if certs.EqualCertificate(newCertX509, oldCertX509) {
+		d.logger.Logt("ELB 监听器已绑定相同证书，跳过更新", oldCertificate.Id)
+		return true, nil
+	}
+	if !equalDNSNames(newCertX509.DNSNames, oldCertX509.DNSNames) {
+		return false, nil
+	}
+
+	// 更新证书
+	// REF: https://support.huaweicloud.com/api-elb/UpdateCertificate.html
+	updateCertificateReq := &hcElbModel.UpdateCertificateRequest{
+		CertificateId: oldCertificate.Id,
+		Body: &hcElbModel.UpdateCertificateRequestBody{
+			Certificate: &hcElbModel.UpdateCertificateOption{
+				Certificate: hwsdk.StringPtr(certPem),
+				PrivateKey:  hwsdk.StringPtr(privkeyPem),
+			},
+		},
+	}
+	updateCertificateResp, err := d.sdkClient.UpdateCertificate(updateCertificateReq)
+	if err != nil {
+		return false, xerrors.Wrap(err, "failed to execute sdk request 'elb.UpdateCertificate'")
+	}
+
+	d.logger.Logt("已原地更新 ELB 监听器绑定的证书", updateCertificateResp)
+
+	return true, nil
+}
+
 func createSdkClient(accessKeyId, secretAccessKey, region string) (*hcElb.ElbClient, error) {
 	projectId, err := getSdkProjectId(accessKeyId, secretAccessKey, region)
 	if err != nil {
@@ -392,3 +462,15 @@ func getSdkProjectId(accessKeyId, secretAccessKey, region string) (string, error
 
 	return (*response.Projects)[0].Id, nil
 }
+
+func equalDNSNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	a = slices.Clone(a)
+	b = slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
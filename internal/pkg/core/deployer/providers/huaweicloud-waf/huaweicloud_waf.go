@@ -81,15 +81,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到 WAF
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	} else {
-		d.logger.Logt("certificate file uploaded", upres)
-	}
-
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 根据部署资源类型决定部署方式
 	switch d.config.ResourceType {
 	case RESOURCE_TYPE_CERTIFICATE:
@@ -182,14 +174,14 @@ func (d *DeployerProvider) deployToCloudServer(ctx context.Context, certPem stri
 
 		if listHostResp.Items != nil {
 			for _, hostItem := range *listHostResp.Items {
-				if strings.TrimPrefix(d.config.Domain, "*") == *hostItem.Hostname {
+				if hostItem.Hostname != nil && *hostItem.Hostname == d.config.Domain {
 					hostId = *hostItem.Id
 					break
 				}
 			}
 		}
 
-		if listHostResp.Items == nil || len(*listHostResp.Items) < int(listHostPageSize) {
+		if hostId != "" || listHostResp.Items == nil || len(*listHostResp.Items) < int(listHostPageSize) {
 			break
 		} else {
 			listHostPage++
@@ -249,14 +241,14 @@ func (d *DeployerProvider) deployToPremiumHost(ctx context.Context, certPem stri
 
 		if listPremiumHostResp.Items != nil {
 			for _, hostItem := range *listPremiumHostResp.Items {
-				if strings.TrimPrefix(d.config.Domain, "*") == *hostItem.Hostname {
+				if hostItem.Hostname != nil && *hostItem.Hostname == d.config.Domain {
 					hostId = *hostItem.Id
 					break
 				}
 			}
 		}
 
-		if listPremiumHostResp.Items == nil || len(*listPremiumHostResp.Items) < int(listPremiumHostPageSize) {
+		if hostId != "" || listPremiumHostResp.Items == nil || len(*listPremiumHostResp.Items) < int(listPremiumHostPageSize) {
 			break
 		} else {
 			listPremiumHostPage++
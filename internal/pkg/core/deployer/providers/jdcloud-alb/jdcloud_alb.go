@@ -17,6 +17,7 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/jdcloud-ssl"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 	"github.com/usual2970/certimate/internal/pkg/utils/slices"
 )
 
@@ -80,7 +81,7 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 上传证书到 SSL
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -105,7 +106,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId string) error {
@@ -157,16 +158,11 @@ func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudCertId
 	} else {
 		d.logger.Logt("已查询到负载均衡器下的全部 HTTPS/TLS 监听器", listenerIds)
 
-		var errs []error
-
-		for _, listenerId := range listenerIds {
-			if err := d.updateListenerCertificate(ctx, listenerId, cloudCertId); err != nil {
-				errs = append(errs, err)
-			}
-		}
-
-		if len(errs) > 0 {
-			return errors.Join(errs...)
+		err := concurrent.ForEach(ctx, listenerIds, 0, func(ctx context.Context, listenerId string) error {
+			return d.updateListenerCertificate(ctx, listenerId, cloudCertId)
+		})
+		if err != nil {
+			return err
 		}
 	}
 
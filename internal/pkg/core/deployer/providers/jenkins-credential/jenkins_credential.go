@@ -0,0 +1,198 @@
+package jenkinscredential
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	jenkinssdk "github.com/usual2970/certimate/internal/pkg/vendors/jenkins-sdk"
+)
+
+type DeployerConfig struct {
+	// Jenkins 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Jenkins 用户名。
+	Username string `json:"username"`
+	// Jenkins 用户 API 令牌。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 文件夹路径，如 "team/project"。
+	// 选填。零值时写入系统全局凭据存储。
+	FolderPath string `json:"folderPath,omitempty"`
+	// 凭据域。
+	// 选填。零值时默认为全局域 "_"。
+	CredentialDomain string `json:"credentialDomain,omitempty"`
+	// 凭据 ID。
+	CredentialId string `json:"credentialId"`
+	// 凭据作用域，可取值 "GLOBAL"、"SYSTEM"。
+	// 选填。零值时默认为 "GLOBAL"。仅在系统全局凭据存储中有效。
+	CredentialScope string `json:"credentialScope,omitempty"`
+	// 凭据描述。
+	// 选填。
+	CredentialDescription string `json:"credentialDescription,omitempty"`
+	// PKCS#12 证书库密码。
+	KeystorePassword string `json:"keystorePassword"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *jenkinssdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.ApiToken, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CredentialId == "" {
+		return nil, errors.New("config `credentialId` is required")
+	}
+	if d.config.KeystorePassword == "" {
+		return nil, errors.New("config `keystorePassword` is required")
+	}
+
+	scope := d.config.CredentialScope
+	if scope == "" {
+		scope = "GLOBAL"
+	} else if scope != "GLOBAL" && scope != "SYSTEM" {
+		return nil, fmt.Errorf("unsupported credential scope: %s", scope)
+	}
+
+	// 转换证书格式
+	pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, d.config.KeystorePassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to transform certificate from PEM to PFX")
+	}
+
+	// 查询凭据是否已存在，以决定新建还是更新
+	// REF: https://github.com/jenkinsci/credentials-plugin/blob/master/docs/user.adoc
+	getCredentialResp, err := d.sdkClient.GetCredential(d.config.FolderPath, d.config.CredentialDomain, d.config.CredentialId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'jenkins.GetCredential'")
+	}
+
+	// 仅校验模式下只检查凭据存储是否可读，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: jenkins credential store is readable, nothing written", map[string]any{"credentialId": d.config.CredentialId, "exists": getCredentialResp != nil})
+		return &deployer.DeployResult{}, nil
+	}
+
+	configXml, err := buildCertificateCredentialXml(&certificateCredential{
+		Scope:       scope,
+		Id:          d.config.CredentialId,
+		Description: d.config.CredentialDescription,
+		KeyStoreSource: certificateCredentialKeyStoreSource{
+			Class:                 uploadedKeyStoreSourceClass,
+			UploadedKeystoreBytes: base64.StdEncoding.EncodeToString(pfxData),
+		},
+		Password: d.config.KeystorePassword,
+	})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to build credential config xml")
+	}
+
+	if getCredentialResp != nil {
+		// 更新凭据
+		// 注意不要将配置 XML 输出到日志中，其中包含证书库及其密码
+		if err := d.sdkClient.UpdateCredential(d.config.FolderPath, d.config.CredentialDomain, d.config.CredentialId, configXml); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'jenkins.UpdateCredential'")
+		}
+
+		d.logger.Logt("已更新 Jenkins 凭据", d.config.CredentialId)
+	} else {
+		// 新建凭据
+		if err := d.sdkClient.CreateCredential(d.config.FolderPath, d.config.CredentialDomain, configXml); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'jenkins.CreateCredential'")
+		}
+
+		d.logger.Logt("已创建 Jenkins 凭据", d.config.CredentialId)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+const uploadedKeyStoreSourceClass = "com.cloudbees.plugins.credentials.impl.CertificateCredentialsImpl$UploadedKeyStoreSource"
+
+type certificateCredential struct {
+	XMLName        xml.Name                            `xml:"com.cloudbees.plugins.credentials.impl.CertificateCredentialsImpl"`
+	Scope          string                              `xml:"scope"`
+	Id             string                              `xml:"id"`
+	Description    string                              `xml:"description"`
+	KeyStoreSource certificateCredentialKeyStoreSource `xml:"keyStoreSource"`
+	Password       string                              `xml:"password"`
+}
+
+type certificateCredentialKeyStoreSource struct {
+	Class string `xml:"class,attr"`
+	// 未加密的 Base64 内容会由 Jenkins 在保存时自动加密。
+	UploadedKeystoreBytes string `xml:"uploadedKeystoreBytes"`
+}
+
+func buildCertificateCredentialXml(credential *certificateCredential) (string, error) {
+	data, err := xml.Marshal(credential)
+	if err != nil {
+		return "", err
+	}
+
+	return xml.Header + string(data), nil
+}
+
+func createSdkClient(serverUrl, username, apiToken string, skipTlsVerify bool) (*jenkinssdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid jenkins server url")
+	}
+
+	if username == "" {
+		return nil, errors.New("invalid jenkins username")
+	}
+
+	if apiToken == "" {
+		return nil, errors.New("invalid jenkins api token")
+	}
+
+	client := jenkinssdk.NewClient(serverUrl, username, apiToken).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,85 @@
+package jenkinscredential_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/jenkins-credential"
+)
+
+var (
+	fInputCertPath    string
+	fInputKeyPath     string
+	fServerUrl        string
+	fUsername         string
+	fApiToken         string
+	fCredentialId     string
+	fKeystorePassword string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+	flag.StringVar(&fCredentialId, argsPrefix+"CREDENTIALID", "", "")
+	flag.StringVar(&fKeystorePassword, argsPrefix+"KEYSTOREPASSWORD", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./jenkins_credential_test.go -args \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_SERVERURL="https://jenkins.example.com" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_USERNAME="your-jenkins-username" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_APITOKEN="your-jenkins-api-token" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_CREDENTIALID="your-credential-id" \
+	--CERTIMATE_DEPLOYER_JENKINSCREDENTIAL_KEYSTOREPASSWORD="your-keystore-password"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+			fmt.Sprintf("CREDENTIALID: %v", fCredentialId),
+			fmt.Sprintf("KEYSTOREPASSWORD: %v", fKeystorePassword),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:        fServerUrl,
+			Username:         fUsername,
+			ApiToken:         fApiToken,
+			CredentialId:     fCredentialId,
+			KeystorePassword: fKeystorePassword,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
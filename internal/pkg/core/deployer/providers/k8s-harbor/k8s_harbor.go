@@ -0,0 +1,207 @@
+package k8sharbor
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+	k8sCore "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// kubeconfig 文件内容。
+	KubeConfig string `json:"kubeConfig,omitempty"`
+	// Kubernetes 命名空间。
+	Namespace string `json:"namespace,omitempty"`
+	// Harbor Helm Chart 中 "expose.tls.secret.secretName" 所指定的 Secret 名称。
+	SecretName string `json:"secretName"`
+	// 需要滚动重启的 Deployment 名称。
+	// 选填。当 Harbor 以 "expose.type=clusterIP/nodePort/loadBalancer" 方式暴露时，需重启 nginx 组件以使证书生效，如 "harbor-nginx"。
+	RestartDeploymentName string `json:"restartDeploymentName,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		logger: logger.NewNilLogger(),
+		config: config,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Namespace == "" {
+		return nil, errors.New("config `namespace` is required")
+	}
+	if d.config.SecretName == "" {
+		return nil, errors.New("config `secretName` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 连接
+	client, err := createK8sClient(d.config.KubeConfig)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create k8s client")
+	}
+
+	// 获取 Secret 实例
+	secretExists := true
+	secretPayload, err := client.CoreV1().Secrets(d.config.Namespace).Get(ctx, d.config.SecretName, k8sMeta.GetOptions{})
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return nil, xerrors.Wrap(err, "failed to get k8s secret")
+		}
+
+		secretExists = false
+	}
+
+	// 获取 Deployment 实例
+	if d.config.RestartDeploymentName != "" {
+		if _, err := client.AppsV1().Deployments(d.config.Namespace).Get(ctx, d.config.RestartDeploymentName, k8sMeta.GetOptions{}); err != nil {
+			return nil, xerrors.Wrap(err, "failed to get k8s deployment")
+		}
+	}
+
+	// 仅校验模式下只检查资源，不实际更新 Secret
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// Harbor Helm Chart 要求 Secret 中包含 "tls.crt" 和 "tls.key"
+	// REF: https://github.com/goharbor/harbor-helm
+	secretAnnotations := map[string]string{
+		"certimate/common-name":       certX509.Subject.CommonName,
+		"certimate/subject-sn":        certX509.Subject.SerialNumber,
+		"certimate/subject-alt-names": strings.Join(certX509.DNSNames, ","),
+		"certimate/issuer-sn":         certX509.Issuer.SerialNumber,
+		"certimate/issuer-org":        strings.Join(certX509.Issuer.Organization, ","),
+	}
+	if !secretExists {
+		secretPayload = &k8sCore.Secret{
+			TypeMeta: k8sMeta.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: k8sMeta.ObjectMeta{
+				Name:        d.config.SecretName,
+				Annotations: secretAnnotations,
+			},
+			Type: k8sCore.SecretTypeTLS,
+			Data: map[string][]byte{
+				k8sCore.TLSCertKey:       []byte(certPem),
+				k8sCore.TLSPrivateKeyKey: []byte(privkeyPem),
+			},
+		}
+
+		secretPayload, err = client.CoreV1().Secrets(d.config.Namespace).Create(ctx, secretPayload, k8sMeta.CreateOptions{})
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to create k8s secret")
+		}
+
+		d.logger.Logt("k8s secret created", secretPayload.Name)
+	} else {
+		if secretPayload.ObjectMeta.Annotations == nil {
+			secretPayload.ObjectMeta.Annotations = secretAnnotations
+		} else {
+			for k, v := range secretAnnotations {
+				secretPayload.ObjectMeta.Annotations[k] = v
+			}
+		}
+		if secretPayload.Data == nil {
+			secretPayload.Data = make(map[string][]byte)
+		}
+		secretPayload.Data[k8sCore.TLSCertKey] = []byte(certPem)
+		secretPayload.Data[k8sCore.TLSPrivateKeyKey] = []byte(privkeyPem)
+
+		secretPayload, err = client.CoreV1().Secrets(d.config.Namespace).Update(ctx, secretPayload, k8sMeta.UpdateOptions{})
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to update k8s secret")
+		}
+
+		d.logger.Logt("k8s secret updated", secretPayload.Name)
+	}
+
+	// 滚动重启 Deployment，等同于 "kubectl rollout restart"
+	if d.config.RestartDeploymentName != "" {
+		patchData, _ := json.Marshal(map[string]any{
+			"spec": map[string]any{
+				"template": map[string]any{
+					"metadata": map[string]any{
+						"annotations": map[string]string{
+							"kubectl.kubernetes.io/restartedAt": time.Now().Format(time.RFC3339),
+						},
+					},
+				},
+			},
+		})
+		if _, err := client.AppsV1().Deployments(d.config.Namespace).Patch(ctx, d.config.RestartDeploymentName, k8sTypes.StrategicMergePatchType, patchData, k8sMeta.PatchOptions{}); err != nil {
+			return nil, xerrors.Wrap(err, "failed to restart k8s deployment")
+		}
+
+		d.logger.Logt("k8s deployment restarted", d.config.RestartDeploymentName)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createK8sClient(kubeConfig string) (*kubernetes.Clientset, error) {
+	var config *rest.Config
+	var err error
+	if kubeConfig == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		kubeConfig, err := clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
+		if err != nil {
+			return nil, err
+		}
+		config, err = kubeConfig.ClientConfig()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,75 @@
+package k8sharbor_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-harbor"
+)
+
+var (
+	fInputCertPath         string
+	fInputKeyPath          string
+	fNamespace             string
+	fSecretName            string
+	fRestartDeploymentName string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_K8SHARBOR_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fNamespace, argsPrefix+"NAMESPACE", "default", "")
+	flag.StringVar(&fSecretName, argsPrefix+"SECRETNAME", "", "")
+	flag.StringVar(&fRestartDeploymentName, argsPrefix+"RESTARTDEPLOYMENTNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./k8s_harbor_test.go -args \
+	--CERTIMATE_DEPLOYER_K8SHARBOR_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_K8SHARBOR_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_K8SHARBOR_NAMESPACE="harbor" \
+	--CERTIMATE_DEPLOYER_K8SHARBOR_SECRETNAME="harbor-tls" \
+	--CERTIMATE_DEPLOYER_K8SHARBOR_RESTARTDEPLOYMENTNAME="harbor-nginx"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("NAMESPACE: %v", fNamespace),
+			fmt.Sprintf("SECRETNAME: %v", fSecretName),
+			fmt.Sprintf("RESTARTDEPLOYMENTNAME: %v", fRestartDeploymentName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Namespace:             fNamespace,
+			SecretName:            fSecretName,
+			RestartDeploymentName: fRestartDeploymentName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,245 @@
+package k8singress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/exp/slices"
+	k8sCore "k8s.io/api/core/v1"
+	k8sNetworking "k8s.io/api/networking/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// kubeconfig 文件内容。
+	KubeConfig string `json:"kubeConfig,omitempty"`
+	// Kubernetes 命名空间。
+	Namespace string `json:"namespace,omitempty"`
+	// Kubernetes Ingress 名称。
+	IngressName string `json:"ingressName"`
+	// Kubernetes Secret 名称。
+	// 选填。零值时将沿用 Ingress 中与证书域名匹配的 TLS 配置项所引用的 Secret；如果不存在，则默认为 "<IngressName>-tls"。
+	SecretName string `json:"secretName,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		logger: logger.NewNilLogger(),
+		config: config,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Namespace == "" {
+		return nil, errors.New("config `namespace` is required")
+	}
+	if d.config.IngressName == "" {
+		return nil, errors.New("config `ingressName` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 连接
+	client, err := createK8sClient(d.config.KubeConfig)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create k8s client")
+	}
+
+	// 获取 Ingress 实例
+	ingress, err := client.NetworkingV1().Ingresses(d.config.Namespace).Get(ctx, d.config.IngressName, k8sMeta.GetOptions{})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to get k8s ingress")
+	}
+
+	// 找出 Ingress 规则中可被证书覆盖的主机名
+	// 如果规则中未声明主机名，则使用证书中的全部域名
+	tlsHosts := make([]string, 0)
+	for _, rule := range ingress.Spec.Rules {
+		if rule.Host == "" || slices.Contains(tlsHosts, rule.Host) {
+			continue
+		}
+
+		if slices.ContainsFunc(certX509.DNSNames, func(san string) bool { return certs.MatchHostname(san, rule.Host) }) {
+			tlsHosts = append(tlsHosts, rule.Host)
+		}
+	}
+	if len(tlsHosts) == 0 {
+		if slices.ContainsFunc(ingress.Spec.Rules, func(rule k8sNetworking.IngressRule) bool { return rule.Host != "" }) {
+			return nil, errors.New("no ingress rule host matches the certificate")
+		}
+
+		tlsHosts = append(tlsHosts, certX509.DNSNames...)
+	}
+
+	// 确定 Secret 名称
+	secretName := d.config.SecretName
+	if secretName == "" {
+		for _, tls := range ingress.Spec.TLS {
+			if tls.SecretName != "" && slices.ContainsFunc(tls.Hosts, func(host string) bool { return slices.Contains(tlsHosts, host) }) {
+				secretName = tls.SecretName
+				break
+			}
+		}
+	}
+	if secretName == "" {
+		secretName = fmt.Sprintf("%s-tls", d.config.IngressName)
+	}
+
+	// 创建或更新 Secret 实例
+	secretAnnotations := map[string]string{
+		"certimate/common-name":       certX509.Subject.CommonName,
+		"certimate/subject-sn":        certX509.Subject.SerialNumber,
+		"certimate/subject-alt-names": strings.Join(certX509.DNSNames, ","),
+		"certimate/issuer-sn":         certX509.Issuer.SerialNumber,
+		"certimate/issuer-org":        strings.Join(certX509.Issuer.Organization, ","),
+	}
+	if err := d.upsertSecret(ctx, client, secretName, secretAnnotations, certPem, privkeyPem); err != nil {
+		return nil, err
+	}
+
+	// 更新 Ingress 的 TLS 配置项
+	// 将上述主机名从其他 TLS 配置项中移除，再合并到引用该 Secret 的 TLS 配置项中
+	ingressTLS := make([]k8sNetworking.IngressTLS, 0, len(ingress.Spec.TLS)+1)
+	matched := false
+	for _, tls := range ingress.Spec.TLS {
+		if tls.SecretName == secretName {
+			matched = true
+			tls.Hosts = append(slices.DeleteFunc(tls.Hosts, func(host string) bool { return slices.Contains(tlsHosts, host) }), tlsHosts...)
+		} else {
+			tls.Hosts = slices.DeleteFunc(tls.Hosts, func(host string) bool { return slices.Contains(tlsHosts, host) })
+			if len(tls.Hosts) == 0 {
+				continue
+			}
+		}
+
+		ingressTLS = append(ingressTLS, tls)
+	}
+	if !matched {
+		ingressTLS = append(ingressTLS, k8sNetworking.IngressTLS{
+			Hosts:      tlsHosts,
+			SecretName: secretName,
+		})
+	}
+
+	ingress.Spec.TLS = ingressTLS
+	ingress, err = client.NetworkingV1().Ingresses(d.config.Namespace).Update(ctx, ingress, k8sMeta.UpdateOptions{})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to update k8s ingress")
+	}
+
+	d.logger.Logt("k8s ingress updated", ingress.Spec.TLS)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) upsertSecret(ctx context.Context, client *kubernetes.Clientset, secretName string, secretAnnotations map[string]string, certPem string, privkeyPem string) error {
+	// 获取 Secret 实例，如果不存在则创建
+	secretPayload, err := client.CoreV1().Secrets(d.config.Namespace).Get(ctx, secretName, k8sMeta.GetOptions{})
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return xerrors.Wrap(err, "failed to get k8s secret")
+		}
+
+		secretPayload = &k8sCore.Secret{
+			TypeMeta: k8sMeta.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: k8sMeta.ObjectMeta{
+				Name:        secretName,
+				Annotations: secretAnnotations,
+			},
+			Type: k8sCore.SecretTypeTLS,
+			Data: map[string][]byte{
+				k8sCore.TLSCertKey:       []byte(certPem),
+				k8sCore.TLSPrivateKeyKey: []byte(privkeyPem),
+			},
+		}
+
+		secretPayload, err = client.CoreV1().Secrets(d.config.Namespace).Create(ctx, secretPayload, k8sMeta.CreateOptions{})
+		if err != nil {
+			return xerrors.Wrap(err, "failed to create k8s secret")
+		}
+
+		d.logger.Logt("k8s secret created", secretPayload.Name)
+		return nil
+	}
+
+	// 更新 Secret 实例
+	if secretPayload.Type != k8sCore.SecretTypeTLS {
+		return fmt.Errorf("k8s secret '%s' is not of type '%s'", secretName, k8sCore.SecretTypeTLS)
+	}
+	if secretPayload.ObjectMeta.Annotations == nil {
+		secretPayload.ObjectMeta.Annotations = secretAnnotations
+	} else {
+		for k, v := range secretAnnotations {
+			secretPayload.ObjectMeta.Annotations[k] = v
+		}
+	}
+	if secretPayload.Data == nil {
+		secretPayload.Data = make(map[string][]byte)
+	}
+	secretPayload.Data[k8sCore.TLSCertKey] = []byte(certPem)
+	secretPayload.Data[k8sCore.TLSPrivateKeyKey] = []byte(privkeyPem)
+	secretPayload, err = client.CoreV1().Secrets(d.config.Namespace).Update(ctx, secretPayload, k8sMeta.UpdateOptions{})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to update k8s secret")
+	}
+
+	d.logger.Logt("k8s secret updated", secretPayload.Name)
+	return nil
+}
+
+func createK8sClient(kubeConfig string) (*kubernetes.Clientset, error) {
+	var config *rest.Config
+	var err error
+	if kubeConfig == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		kubeConfig, err := clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
+		if err != nil {
+			return nil, err
+		}
+		config, err = kubeConfig.ClientConfig()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
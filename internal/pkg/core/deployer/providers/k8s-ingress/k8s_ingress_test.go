@@ -0,0 +1,75 @@
+package k8singress_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-ingress"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fNamespace     string
+	fIngressName   string
+	fSecretName    string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_K8SINGRESS_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fNamespace, argsPrefix+"NAMESPACE", "default", "")
+	flag.StringVar(&fIngressName, argsPrefix+"INGRESSNAME", "", "")
+	flag.StringVar(&fSecretName, argsPrefix+"SECRETNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./k8s_ingress_test.go -args \
+	--CERTIMATE_DEPLOYER_K8SINGRESS_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_K8SINGRESS_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_K8SINGRESS_NAMESPACE="default" \
+	--CERTIMATE_DEPLOYER_K8SINGRESS_INGRESSNAME="ingress" \
+	--CERTIMATE_DEPLOYER_K8SINGRESS_SECRETNAME="ingress-tls"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("NAMESPACE: %v", fNamespace),
+			fmt.Sprintf("INGRESSNAME: %v", fIngressName),
+			fmt.Sprintf("SECRETNAME: %v", fSecretName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Namespace:   fNamespace,
+			IngressName: fIngressName,
+			SecretName:  fSecretName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
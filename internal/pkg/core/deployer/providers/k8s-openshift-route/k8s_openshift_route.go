@@ -0,0 +1,193 @@
+package k8sopenshiftroute
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	xerrors "github.com/pkg/errors"
+	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sUnstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	k8sSchema "k8s.io/apimachinery/pkg/runtime/schema"
+	k8sTypes "k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type DeployerConfig struct {
+	// kubeconfig 文件内容。
+	KubeConfig string `json:"kubeConfig,omitempty"`
+	// Kubernetes 命名空间。
+	Namespace string `json:"namespace,omitempty"`
+	// OpenShift Route 名称。
+	// 选填。与 [LabelSelector] 二选一。
+	RouteName string `json:"routeName,omitempty"`
+	// OpenShift Route 标签选择器。
+	// 选填。与 [RouteName] 二选一。
+	LabelSelector string `json:"labelSelector,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+var routeGVR = k8sSchema.GroupVersionResource{Group: "route.openshift.io", Version: "v1", Resource: "routes"}
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		logger: logger.NewNilLogger(),
+		config: config,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Namespace == "" {
+		return nil, errors.New("config `namespace` is required")
+	}
+	if d.config.RouteName == "" && d.config.LabelSelector == "" {
+		return nil, errors.New("config `routeName` or `labelSelector` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 连接
+	client, err := createK8sClient(d.config.KubeConfig)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create k8s client")
+	}
+
+	// 获取待更新的 Route 实例
+	// 指定名称时只获取该 Route；否则按标签选择器列出 Route，并跳过主机名不被证书覆盖的 Route
+	routes := make([]k8sUnstructured.Unstructured, 0)
+	if d.config.RouteName != "" {
+		route, err := client.Resource(routeGVR).Namespace(d.config.Namespace).Get(ctx, d.config.RouteName, k8sMeta.GetOptions{})
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to get openshift route")
+		}
+
+		if host, _, _ := k8sUnstructured.NestedString(route.Object, "spec", "host"); host != "" && !certs.IsCertificateCoversHostname(certX509, host) {
+			return nil, fmt.Errorf("certificate does not cover openshift route host '%s'", host)
+		}
+
+		routes = append(routes, *route)
+	} else {
+		routeList, err := client.Resource(routeGVR).Namespace(d.config.Namespace).List(ctx, k8sMeta.ListOptions{LabelSelector: d.config.LabelSelector})
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to list openshift routes")
+		}
+
+		for _, route := range routeList.Items {
+			if host, _, _ := k8sUnstructured.NestedString(route.Object, "spec", "host"); host != "" && !certs.IsCertificateCoversHostname(certX509, host) {
+				d.logger.Logt(fmt.Sprintf("openshift route '%s' skipped, certificate does not cover host '%s'", route.GetName(), host))
+				continue
+			}
+
+			routes = append(routes, route)
+		}
+	}
+	if len(routes) == 0 {
+		return nil, errors.New("no openshift routes matched")
+	}
+
+	for _, route := range routes {
+		termination, _, _ := k8sUnstructured.NestedString(route.Object, "spec", "tls", "termination")
+		if termination == "passthrough" {
+			return nil, fmt.Errorf("openshift route '%s' uses passthrough termination, which does not carry certificates", route.GetName())
+		}
+	}
+
+	// 仅校验模式下只获取 Route，不实际更新证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 逐个更新 Route 的 TLS 配置项
+	// 未启用 TLS 的 Route 默认使用边缘终止；其余字段（如 reencrypt 的目标 CA 证书）保持不变
+	for _, route := range routes {
+		termination, _, _ := k8sUnstructured.NestedString(route.Object, "spec", "tls", "termination")
+		if termination == "" {
+			termination = "edge"
+		}
+
+		patchData, err := json.Marshal(map[string]any{
+			"spec": map[string]any{
+				"tls": map[string]any{
+					"termination":   termination,
+					"certificate":   serverCertPem,
+					"key":           privkeyPem,
+					"caCertificate": intermediaCertPem,
+				},
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = client.Resource(routeGVR).Namespace(d.config.Namespace).Patch(ctx, route.GetName(), k8sTypes.MergePatchType, patchData, k8sMeta.PatchOptions{})
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to patch openshift route '%s'", route.GetName())
+		}
+
+		d.logger.Logt("openshift route updated", route.GetName())
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createK8sClient(kubeConfig string) (*dynamic.DynamicClient, error) {
+	var config *rest.Config
+	var err error
+	if kubeConfig == "" {
+		config, err = rest.InClusterConfig()
+	} else {
+		kubeConfig, err := clientcmd.NewClientConfigFromBytes([]byte(kubeConfig))
+		if err != nil {
+			return nil, err
+		}
+		config, err = kubeConfig.ClientConfig()
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,75 @@
+package k8sopenshiftroute_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/k8s-openshift-route"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fNamespace     string
+	fRouteName     string
+	fLabelSelector string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fNamespace, argsPrefix+"NAMESPACE", "default", "")
+	flag.StringVar(&fRouteName, argsPrefix+"ROUTENAME", "", "")
+	flag.StringVar(&fLabelSelector, argsPrefix+"LABELSELECTOR", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./k8s_openshift_route_test.go -args \
+	--CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_NAMESPACE="default" \
+	--CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_ROUTENAME="route" \
+	--CERTIMATE_DEPLOYER_K8SOPENSHIFTROUTE_LABELSELECTOR="app=example"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("NAMESPACE: %v", fNamespace),
+			fmt.Sprintf("ROUTENAME: %v", fRouteName),
+			fmt.Sprintf("LABELSELECTOR: %v", fLabelSelector),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Namespace:     fNamespace,
+			RouteName:     fRouteName,
+			LabelSelector: fLabelSelector,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
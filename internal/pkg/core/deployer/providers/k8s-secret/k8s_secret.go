@@ -2,11 +2,13 @@ package k8ssecret
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"strings"
 
 	xerrors "github.com/pkg/errors"
 	k8sCore "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
@@ -37,7 +39,10 @@ type DeployerProvider struct {
 	logger logger.Logger
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer                  = (*DeployerProvider)(nil)
+	_ deployer.DeployedCertificateGetter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -55,7 +60,42 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetDeployedCertificate(ctx context.Context) (*x509.Certificate, error) {
+	if d.config.Namespace == "" {
+		return nil, errors.New("config `namespace` is required")
+	}
+	if d.config.SecretName == "" {
+		return nil, errors.New("config `secretName` is required")
+	}
+	if d.config.SecretDataKeyForCrt == "" {
+		return nil, errors.New("config `secretDataKeyForCrt` is required")
+	}
+
+	// 连接
+	client, err := createK8sClient(d.config.KubeConfig)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create k8s client")
+	}
+
+	// 获取 Secret 实例，不存在时视为尚未部署过证书
+	secretPayload, err := client.CoreV1().Secrets(d.config.Namespace).Get(ctx, d.config.SecretName, k8sMeta.GetOptions{})
+	if err != nil {
+		if k8sErrors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, xerrors.Wrap(err, "failed to get k8s secret")
+	}
+
+	certData := secretPayload.Data[d.config.SecretDataKeyForCrt]
+	if len(certData) == 0 {
+		return nil, nil
+	}
+
+	return certs.ParseCertificateFromPEM(string(certData))
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.Namespace == "" {
 		return nil, errors.New("config `namespace` is required")
 	}
@@ -0,0 +1,168 @@
+package kemploadmaster
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	kempsdk "github.com/usual2970/certimate/internal/pkg/vendors/kemp-sdk"
+)
+
+type DeployerConfig struct {
+	// Kemp LoadMaster 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// Kemp LoadMaster 用户名。
+	Username string `json:"username"`
+	// Kemp LoadMaster 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书名称。
+	// 同名证书已存在时将被替换，所有引用该证书的虚拟服务将自动使用新证书。
+	CertificateName string `json:"certificateName"`
+	// 中间证书名称。
+	// 选填。非零值时将同时替换同名中间证书。
+	IntermediateName string `json:"intermediateName,omitempty"`
+	// 虚拟服务 IP 地址。
+	// 选填。非零值时将证书绑定到该虚拟服务。
+	VirtualServiceAddress string `json:"virtualServiceAddress,omitempty"`
+	// 虚拟服务端口。
+	// 选填。
+	VirtualServicePort int32 `json:"virtualServicePort,omitempty"`
+	// 虚拟服务协议。
+	// 选填。零值时默认为 "tcp"。
+	VirtualServiceProtocol string `json:"virtualServiceProtocol,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *kempsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateName == "" {
+		return nil, errors.New("config `certificateName` is required")
+	}
+	if d.config.VirtualServiceAddress != "" && d.config.VirtualServicePort == 0 {
+		return nil, errors.New("config `virtualServicePort` is required")
+	}
+
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取证书列表，以校验登录凭据
+	// REF: https://support.kemptechnologies.com/hc/en-us/articles/203863435-RESTful-API
+	listCertResp, err := d.sdkClient.ListCertificates()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'kemp.listcert'")
+	}
+
+	d.logger.Logt("已获取证书列表", listCertResp)
+
+	// 仅校验模式下只检查登录凭据，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书，同名证书将被替换
+	certData := strings.TrimSpace(serverCertPem) + "\n" + strings.TrimSpace(privkeyPem) + "\n"
+	if err := d.sdkClient.AddCertificate(d.config.CertificateName, []byte(certData), "", true); err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'kemp.addcert'")
+	}
+
+	d.logger.Logt("已上传证书", d.config.CertificateName)
+
+	// 替换中间证书
+	if d.config.IntermediateName != "" && intermediaCertPem != "" {
+		// 中间证书不支持直接替换，需先删除
+		if err := d.sdkClient.DeleteIntermediateCertificate(d.config.IntermediateName); err != nil {
+			d.logger.Logt("删除中间证书失败，将尝试直接上传", err.Error())
+		}
+
+		if err := d.sdkClient.AddIntermediateCertificate(d.config.IntermediateName, []byte(intermediaCertPem)); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'kemp.addintermediate'")
+		}
+
+		d.logger.Logt("已上传中间证书", d.config.IntermediateName)
+	}
+
+	// 绑定证书到虚拟服务
+	if d.config.VirtualServiceAddress != "" {
+		protocol := d.config.VirtualServiceProtocol
+		if protocol == "" {
+			protocol = "tcp"
+		}
+
+		params := map[string]string{
+			"SSLAcceleration": "1",
+			"CertFile":        d.config.CertificateName,
+		}
+		if err := d.sdkClient.ModifyVirtualService(d.config.VirtualServiceAddress, strconv.Itoa(int(d.config.VirtualServicePort)), protocol, params); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'kemp.modvs'")
+		}
+
+		d.logger.Logt("已绑定证书到虚拟服务", d.config.VirtualServiceAddress)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(serverUrl, username, password string, skipTlsVerify bool) (*kempsdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid kemp server url")
+	}
+
+	if username == "" || password == "" {
+		return nil, errors.New("invalid kemp credentials")
+	}
+
+	client := kempsdk.NewClient(serverUrl, username, password).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
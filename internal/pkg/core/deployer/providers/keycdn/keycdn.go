@@ -0,0 +1,133 @@
+package keycdn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	keysdk "github.com/usual2970/certimate/internal/pkg/vendors/keycdn-sdk"
+)
+
+type DeployerConfig struct {
+	// KeyCDN API Key。
+	ApiKey string `json:"apiKey"`
+	// 区域别名（不支持泛域名）。
+	ZoneAlias string `json:"zoneAlias"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *keysdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ZoneAlias == "" {
+		return nil, errors.New("config `zoneAlias` is required")
+	}
+
+	// 检查证书是否覆盖区域别名
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	} else if !certs.IsCertificateCoversHostname(certX509, d.config.ZoneAlias) {
+		return nil, fmt.Errorf("certificate does not cover zone alias '%s'", d.config.ZoneAlias)
+	}
+
+	// 查询区域别名列表，获取区域 ID
+	// REF: https://www.keycdn.com/api#list-zonealiases
+	listZoneAliasesResp, err := d.sdkClient.ListZoneAliases()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'keycdn.ListZoneAliases'")
+	}
+
+	var zoneId string
+	if listZoneAliasesResp.Data != nil {
+		for _, zoneAlias := range listZoneAliasesResp.Data.ZoneAliases {
+			if zoneAlias != nil && strings.EqualFold(zoneAlias.Name, d.config.ZoneAlias) {
+				zoneId = zoneAlias.ZoneId
+				break
+			}
+		}
+	}
+	if zoneId == "" {
+		return nil, fmt.Errorf("could not find zone alias '%s'", d.config.ZoneAlias)
+	}
+
+	// 查询区域详情
+	// REF: https://www.keycdn.com/api#view-zone
+	getZoneResp, err := d.sdkClient.GetZone(zoneId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'keycdn.GetZone'")
+	}
+
+	d.logger.Logt("已查询到区域详情", getZoneResp)
+
+	// 仅校验模式下只查询区域，不实际修改证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 修改区域的自定义证书，区域别名共用区域的证书配置
+	// REF: https://www.keycdn.com/api#edit-zone
+	updateZoneReq := &keysdk.UpdateZoneRequest{
+		SslCert:       types.ToPtr("custom"),
+		CustomSslCert: types.ToPtr(certPem),
+		CustomSslKey:  types.ToPtr(privkeyPem),
+	}
+	updateZoneResp, err := d.sdkClient.UpdateZone(zoneId, updateZoneReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'keycdn.UpdateZone'")
+	}
+
+	d.logger.Logt("已修改区域证书", updateZoneResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiKey string) (*keysdk.Client, error) {
+	if apiKey == "" {
+		return nil, errors.New("invalid keycdn api key")
+	}
+
+	client := keysdk.NewClient(apiKey)
+	return client, nil
+}
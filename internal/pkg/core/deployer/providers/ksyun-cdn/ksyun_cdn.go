@@ -0,0 +1,155 @@
+package ksyuncdn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	ksyunCdn "github.com/usual2970/certimate/internal/pkg/vendors/ksyun-sdk/cdn"
+)
+
+type DeployerConfig struct {
+	// 金山云 AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// 金山云 SecretAccessKey。
+	SecretAccessKey string `json:"secretAccessKey"`
+	// 加速域名（支持泛域名）。
+	Domain string `json:"domain"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *ksyunCdn.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.AccessKeyId, config.SecretAccessKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 查询加速域名 ID
+	domainId, err := d.findDomainId(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// 仅校验模式下只查询加速域名，不实际上传和配置证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 为加速域名配置证书
+	// REF: https://docs.ksyun.com
+	configCertificateReq := &ksyunCdn.ConfigCertificateRequest{
+		Enable:            types.ToPtr("on"),
+		DomainIds:         types.ToPtr(domainId),
+		CertificateName:   types.ToPtr(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
+		ServerCertificate: types.ToPtr(certPem),
+		PrivateKey:        types.ToPtr(privkeyPem),
+	}
+	configCertificateResp, err := d.sdkClient.ConfigCertificate(configCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.ConfigCertificate'")
+	}
+
+	d.logger.Logt("已为加速域名配置证书", configCertificateResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) findDomainId(ctx context.Context) (string, error) {
+	// 金山云 CDN 的泛域名以 ".example.com" 形式表示
+	domainName := d.config.Domain
+	if strings.HasPrefix(domainName, "*.") {
+		domainName = strings.TrimPrefix(domainName, "*")
+	}
+
+	getCdnDomainsPageNumber := int32(1)
+	getCdnDomainsPageSize := int32(100)
+	for {
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		default:
+		}
+
+		// 查询加速域名列表
+		// REF: https://docs.ksyun.com
+		getCdnDomainsReq := &ksyunCdn.GetCdnDomainsRequest{
+			DomainName: types.ToPtr(domainName),
+			PageNumber: types.ToPtr(getCdnDomainsPageNumber),
+			PageSize:   types.ToPtr(getCdnDomainsPageSize),
+		}
+		getCdnDomainsResp, err := d.sdkClient.GetCdnDomains(getCdnDomainsReq)
+		if err != nil {
+			return "", xerrors.Wrap(err, "failed to execute sdk request 'cdn.GetCdnDomains'")
+		}
+
+		for _, domainItem := range getCdnDomainsResp.Domains {
+			if domainItem != nil && domainItem.DomainName == domainName {
+				d.logger.Logt("已查询到加速域名", domainItem)
+				return domainItem.DomainId, nil
+			}
+		}
+
+		if len(getCdnDomainsResp.Domains) < int(getCdnDomainsPageSize) {
+			break
+		} else {
+			getCdnDomainsPageNumber++
+		}
+	}
+
+	return "", fmt.Errorf("could not find domain '%s'", d.config.Domain)
+}
+
+func createSdkClient(accessKeyId, secretAccessKey string) (*ksyunCdn.Client, error) {
+	if accessKeyId == "" {
+		return nil, errors.New("invalid ksyun access key id")
+	}
+
+	if secretAccessKey == "" {
+		return nil, errors.New("invalid ksyun secret access key")
+	}
+
+	client := ksyunCdn.NewClient(accessKeyId, secretAccessKey)
+	return client, nil
+}
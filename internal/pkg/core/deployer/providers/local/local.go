@@ -3,8 +3,11 @@ package local
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"runtime"
 
 	xerrors "github.com/pkg/errors"
@@ -20,8 +23,10 @@ type DeployerConfig struct {
 	// 零值时默认根据操作系统决定。
 	ShellEnv ShellEnvType `json:"shellEnv,omitempty"`
 	// 前置命令。
+	// 执行时可通过环境变量 CERTIMATE_OUTPUT_* 获取输出格式及路径。
 	PreCommand string `json:"preCommand,omitempty"`
 	// 后置命令。
+	// 执行时可通过环境变量 CERTIMATE_OUTPUT_* 获取输出格式及路径。
 	PostCommand string `json:"postCommand,omitempty"`
 	// 输出证书格式。
 	OutputFormat OutputFormatType `json:"outputFormat,omitempty"`
@@ -48,7 +53,11 @@ type DeployerProvider struct {
 	logger logger.Logger
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer                  = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter           = (*DeployerProvider)(nil)
+	_ deployer.DeployedCertificateGetter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -66,10 +75,36 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) GetDeployedCertificate(ctx context.Context) (*x509.Certificate, error) {
+	// 仅 PEM 格式的证书文件可直接解析
+	if d.config.OutputFormat != OUTPUT_FORMAT_PEM {
+		return nil, fmt.Errorf("unsupported output format: %s", d.config.OutputFormat)
+	}
+
+	data, err := os.ReadFile(d.config.OutputCertPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, xerrors.Wrap(err, "failed to read certificate file")
+	}
+
+	return certs.ParseCertificateFromPEM(string(data))
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if deployer.MergeOptions(opts...).DryRun {
+		return d.validate(certPem, privkeyPem)
+	}
+
 	// 执行前置命令
 	if d.config.PreCommand != "" {
-		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PreCommand)
+		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PreCommand, d.commandEnvs())
 		if err != nil {
 			return nil, xerrors.Wrapf(err, "failed to execute pre-command, stdout: %s, stderr: %s", stdout, stderr)
 		}
@@ -118,7 +153,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			return nil, xerrors.Wrap(err, "failed to save certificate file")
 		}
 
-		d.logger.Logt("certificate file uploaded")
+		d.logger.Logt("certificate file saved")
 
 	default:
 		return nil, fmt.Errorf("unsupported output format: %s", d.config.OutputFormat)
@@ -126,7 +161,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	// 执行后置命令
 	if d.config.PostCommand != "" {
-		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PostCommand)
+		stdout, stderr, err := execCommand(d.config.ShellEnv, d.config.PostCommand, d.commandEnvs())
 		if err != nil {
 			return nil, xerrors.Wrapf(err, "failed to execute post-command, stdout: %s, stderr: %s", stdout, stderr)
 		}
@@ -137,7 +172,59 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
-func execCommand(shellEnv ShellEnvType, command string) (string, string, error) {
+func (d *DeployerProvider) validate(certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+	// 仅校验模式下不执行前后置命令，只检查证书格式转换和输出目录
+	outputPaths := []string{d.config.OutputCertPath}
+	switch d.config.OutputFormat {
+	case OUTPUT_FORMAT_PEM:
+		outputPaths = append(outputPaths, d.config.OutputKeyPath)
+
+	case OUTPUT_FORMAT_PFX:
+		if _, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, d.config.PfxPassword); err != nil {
+			return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+		}
+
+	case OUTPUT_FORMAT_JKS:
+		if _, err := certs.TransformCertificateFromPEMToJKS(certPem, privkeyPem, d.config.JksAlias, d.config.JksKeypass, d.config.JksStorepass); err != nil {
+			return nil, xerrors.Wrap(err, "failed to transform certificate to JKS")
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported output format: %s", d.config.OutputFormat)
+	}
+
+	for _, outputPath := range outputPaths {
+		dir := filepath.Dir(outputPath)
+		if info, err := os.Stat(dir); err == nil && !info.IsDir() {
+			return nil, fmt.Errorf("output path '%s' is not a directory", dir)
+		} else if err != nil && !os.IsNotExist(err) {
+			return nil, xerrors.Wrapf(err, "failed to stat output directory '%s'", dir)
+		}
+	}
+
+	d.logger.Logt("dry-run: certificate output validated")
+
+	return &deployer.DeployResult{}, nil
+}
+
+// 前后置命令可用的环境变量：
+//   - CERTIMATE_OUTPUT_FORMAT：输出证书格式，即 "PEM"、"PFX" 或 "JKS"；
+//   - CERTIMATE_OUTPUT_CERT_PATH：输出证书文件路径；
+//   - CERTIMATE_OUTPUT_KEY_PATH：输出私钥文件路径，仅 PEM 格式时有值。
+func (d *DeployerProvider) commandEnvs() []string {
+	outputKeyPath := ""
+	if d.config.OutputFormat == OUTPUT_FORMAT_PEM {
+		outputKeyPath = d.config.OutputKeyPath
+	}
+
+	return []string{
+		"CERTIMATE_OUTPUT_FORMAT=" + string(d.config.OutputFormat),
+		"CERTIMATE_OUTPUT_CERT_PATH=" + d.config.OutputCertPath,
+		"CERTIMATE_OUTPUT_KEY_PATH=" + outputKeyPath,
+	}
+}
+
+func execCommand(shellEnv ShellEnvType, command string, envs []string) (string, string, error) {
 	var cmd *exec.Cmd
 
 	switch shellEnv {
@@ -161,6 +248,8 @@ func execCommand(shellEnv ShellEnvType, command string) (string, string, error)
 		return "", "", fmt.Errorf("unsupported shell env: %s", shellEnv)
 	}
 
+	cmd.Env = append(os.Environ(), envs...)
+
 	stdoutBuf := bytes.NewBuffer(nil)
 	cmd.Stdout = stdoutBuf
 	stderrBuf := bytes.NewBuffer(nil)
@@ -0,0 +1,258 @@
+package mikrotik
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	mikrotiksdk "github.com/usual2970/certimate/internal/pkg/vendors/mikrotik-sdk"
+)
+
+type DeployerConfig struct {
+	// RouterOS REST API 地址。
+	ServerUrl string `json:"serverUrl"`
+	// RouterOS 用户名。
+	Username string `json:"username"`
+	// RouterOS 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 需要绑定证书的服务名称列表。
+	// 零值时默认为 ["www-ssl"]。
+	ServiceNames []string `json:"serviceNames,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *mikrotiksdk.Client
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	serviceNames := d.config.ServiceNames
+	if len(serviceNames) == 0 {
+		serviceNames = []string{"www-ssl"}
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// RouterOS 以 SHA-256 指纹标识证书，据此判断证书是否已导入
+	fingerprint := sha256.Sum256(certX509.Raw)
+	fingerprintHex := hex.EncodeToString(fingerprint[:])
+
+	certName, err := d.findCertificateName(fingerprintHex)
+	if err != nil {
+		return nil, err
+	}
+
+	if certName == "" {
+		certName, err = d.importCertificate(certPem, privkeyPem, fingerprintHex)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		d.logger.Logt("certificate already exists, skipped importing", certName)
+	}
+
+	// 绑定证书到服务
+	for _, serviceName := range serviceNames {
+		if err := d.bindServiceCertificate(serviceName, certName); err != nil {
+			return nil, err
+		}
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) findCertificateName(fingerprint string) (string, error) {
+	// 查询证书列表
+	// REF: https://help.mikrotik.com/docs/spaces/ROS/pages/47579162/REST+API
+	listCertificatesReq := &mikrotiksdk.ListCertificatesRequest{
+		Fingerprint: &fingerprint,
+	}
+	listCertificatesResp, err := d.sdkClient.ListCertificates(listCertificatesReq)
+	if err != nil {
+		return "", xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.ListCertificates'")
+	}
+
+	for _, certificate := range *listCertificatesResp {
+		if strings.EqualFold(certificate.Fingerprint, fingerprint) {
+			return certificate.Name, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (d *DeployerProvider) importCertificate(certPem string, privkeyPem string, fingerprint string) (string, error) {
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return "", err
+	}
+
+	// RouterOS 只能通过文件导入证书，先上传证书和私钥文件，导入后再删除
+	// 分别上传服务器证书、中间证书和私钥，以免单个文件内容超出长度限制
+	certName := fmt.Sprintf("certimate-%d", time.Now().UnixMilli())
+	files := []struct {
+		Name     string
+		Contents string
+	}{
+		{Name: certName + ".crt", Contents: serverCertPem},
+		{Name: certName + ".key", Contents: privkeyPem},
+		{Name: certName + "-chain.crt", Contents: interCertPem},
+	}
+	for _, file := range files {
+		if file.Contents == "" {
+			continue
+		}
+
+		// 上传文件
+		addFileReq := &mikrotiksdk.AddFileRequest{
+			Name:     file.Name,
+			Contents: file.Contents,
+		}
+		addFileResp, err := d.sdkClient.AddFile(addFileReq)
+		if err != nil {
+			return "", xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.AddFile'")
+		}
+
+		d.logger.Logt("file uploaded", file.Name)
+
+		// 导入证书
+		importCertificateReq := &mikrotiksdk.ImportCertificateRequest{
+			FileName:   file.Name,
+			Passphrase: "",
+		}
+		importCertificateResp, err := d.sdkClient.ImportCertificate(importCertificateReq)
+		if err != nil {
+			return "", xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.ImportCertificate'")
+		}
+
+		d.logger.Logt("已导入证书", importCertificateResp)
+
+		// 删除已导入的文件
+		if addFileResp.Id != "" {
+			if err := d.sdkClient.RemoveFile(addFileResp.Id); err != nil {
+				d.logger.Logt("failed to remove uploaded file", err)
+			}
+		}
+	}
+
+	// 查询导入后的证书，并重命名为便于识别的名称
+	listCertificatesReq := &mikrotiksdk.ListCertificatesRequest{
+		Fingerprint: &fingerprint,
+	}
+	listCertificatesResp, err := d.sdkClient.ListCertificates(listCertificatesReq)
+	if err != nil {
+		return "", xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.ListCertificates'")
+	}
+
+	var certificate *mikrotiksdk.CertificateRecord
+	for _, item := range *listCertificatesResp {
+		if strings.EqualFold(item.Fingerprint, fingerprint) {
+			certificate = item
+			break
+		}
+	}
+	if certificate == nil {
+		return "", errors.New("could not find the imported certificate")
+	}
+
+	updateCertificateReq := &mikrotiksdk.UpdateCertificateRequest{
+		Name: certName,
+	}
+	if _, err := d.sdkClient.UpdateCertificate(certificate.Id, updateCertificateReq); err != nil {
+		return "", xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.UpdateCertificate'")
+	}
+
+	d.logger.Logt("certificate imported", certName)
+
+	return certName, nil
+}
+
+func (d *DeployerProvider) bindServiceCertificate(serviceName string, certName string) error {
+	// 查询服务
+	listServicesReq := &mikrotiksdk.ListServicesRequest{
+		Name: &serviceName,
+	}
+	listServicesResp, err := d.sdkClient.ListServices(listServicesReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.ListServices'")
+	} else if len(*listServicesResp) == 0 {
+		return fmt.Errorf("could not find service '%s'", serviceName)
+	}
+
+	service := (*listServicesResp)[0]
+	if service.Certificate == certName {
+		d.logger.Logt("service certificate is already up to date, skipped", serviceName)
+		return nil
+	}
+
+	// 修改服务证书
+	updateServiceReq := &mikrotiksdk.UpdateServiceRequest{
+		Certificate: certName,
+	}
+	updateServiceResp, err := d.sdkClient.UpdateService(service.Id, updateServiceReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'mikrotik.UpdateService'")
+	}
+
+	d.logger.Logt("已绑定服务证书", updateServiceResp)
+
+	return nil
+}
+
+func createSdkClient(serverUrl, username, password string, allowInsecure bool) (*mikrotiksdk.Client, error) {
+	if _, err := url.Parse(serverUrl); err != nil {
+		return nil, errors.New("invalid mikrotik server url")
+	}
+
+	if username == "" {
+		return nil, errors.New("invalid mikrotik username")
+	}
+
+	client := mikrotiksdk.NewClient(serverUrl, username, password)
+	if allowInsecure {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,108 @@
+package netlifysite
+
+import (
+	"context"
+	"errors"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	netlifysdk "github.com/usual2970/certimate/internal/pkg/vendors/netlify-sdk"
+)
+
+type DeployerConfig struct {
+	// Netlify API Token。
+	ApiToken string `json:"apiToken"`
+	// 站点 ID。
+	SiteId string `json:"siteId"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *netlifysdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiToken)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.SiteId == "" {
+		return nil, errors.New("config `siteId` is required")
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取站点信息
+	// REF: https://open-api.netlify.com/#tag/site/operation/getSite
+	getSiteResp, err := d.sdkClient.GetSite(d.config.SiteId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'netlify.GetSite'")
+	}
+
+	d.logger.Logt("已获取站点信息", getSiteResp)
+
+	// 仅校验模式下只获取站点信息，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 为站点配置自定义证书
+	// REF: https://open-api.netlify.com/#tag/sniCertificate/operation/provisionSiteTLSCertificate
+	provisionSiteTLSCertificateReq := &netlifysdk.ProvisionSiteTLSCertificateRequest{
+		Certificate:    serverCertPem,
+		Key:            privkeyPem,
+		CACertificates: intermediaCertPem,
+	}
+	provisionSiteTLSCertificateResp, err := d.sdkClient.ProvisionSiteTLSCertificate(d.config.SiteId, provisionSiteTLSCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'netlify.ProvisionSiteTLSCertificate'")
+	}
+
+	d.logger.Logt("已配置站点证书", provisionSiteTLSCertificateResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiToken string) (*netlifysdk.Client, error) {
+	if apiToken == "" {
+		return nil, errors.New("invalid netlify api token")
+	}
+
+	client := netlifysdk.NewClient(apiToken)
+	return client, nil
+}
@@ -0,0 +1,167 @@
+package nomadvariable
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	nomadsdk "github.com/usual2970/certimate/internal/pkg/vendors/nomad-sdk"
+)
+
+type DeployerConfig struct {
+	// Nomad 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Nomad ACL 令牌。
+	// 选填。仅在 Nomad 启用了 ACL 时需要。
+	Token string `json:"token,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// Nomad 命名空间。
+	// 零值时默认为 "default"。
+	Namespace string `json:"namespace,omitempty"`
+	// 变量路径，如 "nomad/jobs/example"。
+	VariablePath string `json:"variablePath"`
+	// 存放证书（含证书链）的字段名。
+	// 零值时默认为 "certificate"。
+	KeyForCertificate string `json:"keyForCertificate,omitempty"`
+	// 存放私钥的字段名。
+	// 零值时默认为 "private_key"。
+	KeyForPrivateKey string `json:"keyForPrivateKey,omitempty"`
+	// 存放中间证书链的字段名。
+	// 零值时默认为 "ca_chain"。
+	KeyForCertificateChain string `json:"keyForCertificateChain,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *nomadsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Token, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.VariablePath == "" {
+		return nil, errors.New("config `variablePath` is required")
+	}
+
+	namespace := d.config.Namespace
+	if namespace == "" {
+		namespace = "default"
+	}
+
+	keyForCertificate := d.config.KeyForCertificate
+	if keyForCertificate == "" {
+		keyForCertificate = "certificate"
+	}
+	keyForPrivateKey := d.config.KeyForPrivateKey
+	if keyForPrivateKey == "" {
+		keyForPrivateKey = "private_key"
+	}
+	keyForCertificateChain := d.config.KeyForCertificateChain
+	if keyForCertificateChain == "" {
+		keyForCertificateChain = "ca_chain"
+	}
+
+	// 提取中间证书链
+	_, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 读取变量，以保留其中的其他字段
+	// REF: https://developer.hashicorp.com/nomad/api-docs/variables#read-variable
+	// 注意不要将变量内容输出到日志中
+	variable, err := d.sdkClient.ReadVariable(namespace, d.config.VariablePath)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'nomad.ReadVariable'")
+	}
+
+	// 仅校验模式下只检查变量是否可读，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: nomad variable is readable, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	casIndex := uint64(0)
+	items := make(map[string]string)
+	if variable != nil {
+		d.logger.Logt("已读取 Nomad 变量", map[string]any{"namespace": variable.Namespace, "path": variable.Path, "modifyIndex": variable.ModifyIndex})
+		casIndex = variable.ModifyIndex
+		for k, v := range variable.Items {
+			items[k] = v
+		}
+	}
+	items[keyForCertificate] = certPem
+	items[keyForPrivateKey] = privkeyPem
+	items[keyForCertificateChain] = interCertPem
+
+	// 写入变量，通过 CAS 避免覆盖并发修改
+	// REF: https://developer.hashicorp.com/nomad/api-docs/variables#create-variable
+	writeResp, err := d.sdkClient.WriteVariable(&nomadsdk.Variable{
+		Namespace: namespace,
+		Path:      d.config.VariablePath,
+		Items:     items,
+	}, casIndex)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'nomad.WriteVariable'")
+	}
+
+	d.logger.Logt("已写入 Nomad 变量", map[string]any{"namespace": writeResp.Namespace, "path": writeResp.Path, "modifyIndex": writeResp.ModifyIndex})
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"modifyIndex": writeResp.ModifyIndex,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, token string, skipTlsVerify bool) (*nomadsdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid nomad server url")
+	}
+
+	client := nomadsdk.NewClient(serverUrl).
+		WithToken(token).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
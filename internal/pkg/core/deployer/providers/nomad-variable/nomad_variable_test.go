@@ -0,0 +1,80 @@
+package nomadvariable_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/nomad-variable"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fToken         string
+	fNamespace     string
+	fVariablePath  string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_NOMADVARIABLE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fToken, argsPrefix+"TOKEN", "", "")
+	flag.StringVar(&fNamespace, argsPrefix+"NAMESPACE", "", "")
+	flag.StringVar(&fVariablePath, argsPrefix+"VARIABLEPATH", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./nomad_variable_test.go -args \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_SERVERURL="http://127.0.0.1:4646" \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_TOKEN="your-nomad-token" \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_NAMESPACE="default" \
+	--CERTIMATE_DEPLOYER_NOMADVARIABLE_VARIABLEPATH="nomad/jobs/example"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("TOKEN: %v", fToken),
+			fmt.Sprintf("NAMESPACE: %v", fNamespace),
+			fmt.Sprintf("VARIABLEPATH: %v", fVariablePath),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:    fServerUrl,
+			Token:        fToken,
+			Namespace:    fNamespace,
+			VariablePath: fVariablePath,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,235 @@
+package openstackoctavia
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/listeners"
+	"github.com/gophercloud/gophercloud/v2/openstack/loadbalancer/v2/loadbalancers"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/openstack-barbican"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
+)
+
+type DeployerConfig struct {
+	// OpenStack Keystone 认证地址。
+	AuthUrl string `json:"authUrl"`
+	// OpenStack 用户所属域名称。
+	DomainName string `json:"domainName,omitempty"`
+	// OpenStack 用户名。
+	Username string `json:"username"`
+	// OpenStack 密码。
+	Password string `json:"password"`
+	// OpenStack 项目 ID。
+	ProjectId string `json:"projectId"`
+	// OpenStack 区域。
+	Region string `json:"region,omitempty"`
+	// 部署资源类型。
+	ResourceType ResourceType `json:"resourceType"`
+	// 负载均衡器 ID。
+	// 部署资源类型为 [RESOURCE_TYPE_LOADBALANCER] 时必填。
+	LoadbalancerId string `json:"loadbalancerId,omitempty"`
+	// 负载均衡监听 ID。
+	// 部署资源类型为 [RESOURCE_TYPE_LISTENER] 时必填。
+	ListenerId string `json:"listenerId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *gophercloud.ServiceClient
+	sslUploader uploader.Uploader
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		AuthUrl:    config.AuthUrl,
+		DomainName: config.DomainName,
+		Username:   config.Username,
+		Password:   config.Password,
+		ProjectId:  config.ProjectId,
+		Region:     config.Region,
+	})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 上传证书到 Barbican
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	// 根据部署资源类型决定部署方式
+	switch d.config.ResourceType {
+	case RESOURCE_TYPE_LOADBALANCER:
+		if err := d.deployToLoadbalancer(ctx, upres.CertId); err != nil {
+			return nil, err
+		}
+
+	case RESOURCE_TYPE_LISTENER:
+		if err := d.deployToListener(ctx, upres.CertId); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported resource type: %s", d.config.ResourceType)
+	}
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) deployToLoadbalancer(ctx context.Context, cloudContainerRef string) error {
+	if d.config.LoadbalancerId == "" {
+		return errors.New("config `loadbalancerId` is required")
+	}
+
+	// 查询负载均衡器详情
+	// REF: https://docs.openstack.org/api-ref/load-balancer/v2/#show-load-balancer-details
+	getLoadBalancerResp, err := loadbalancers.Get(ctx, d.sdkClient, d.config.LoadbalancerId).Extract()
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'octavia.GetLoadBalancer'")
+	}
+
+	d.logger.Logt("已查询到 Octavia 负载均衡器", getLoadBalancerResp)
+
+	// 查询 TERMINATED_HTTPS 监听器列表
+	// REF: https://docs.openstack.org/api-ref/load-balancer/v2/#list-listeners
+	listListenersPages, err := listeners.List(d.sdkClient, listeners.ListOpts{
+		LoadbalancerID: getLoadBalancerResp.ID,
+		Protocol:       string(listeners.ProtocolTerminatedHTTPS),
+	}).AllPages(ctx)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'octavia.ListListeners'")
+	}
+	listListenersResp, err := listeners.ExtractListeners(listListenersPages)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'octavia.ListListeners'")
+	}
+
+	listenerIds := make([]string, 0, len(listListenersResp))
+	for _, listener := range listListenersResp {
+		listenerIds = append(listenerIds, listener.ID)
+	}
+
+	d.logger.Logt("已查询到 Octavia 负载均衡器下的监听器", listenerIds)
+
+	// 遍历更新监听器证书
+	if len(listenerIds) == 0 {
+		return errors.New("listener not found")
+	} else {
+		err := concurrent.ForEach(ctx, listenerIds, 0, func(ctx context.Context, listenerId string) error {
+			return d.modifyListenerCertificate(ctx, listenerId, cloudContainerRef)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) deployToListener(ctx context.Context, cloudContainerRef string) error {
+	if d.config.ListenerId == "" {
+		return errors.New("config `listenerId` is required")
+	}
+
+	// 更新监听器证书
+	if err := d.modifyListenerCertificate(ctx, d.config.ListenerId, cloudContainerRef); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) modifyListenerCertificate(ctx context.Context, cloudListenerId string, cloudContainerRef string) error {
+	// 查询监听器详情
+	// REF: https://docs.openstack.org/api-ref/load-balancer/v2/#show-listener-details
+	getListenerResp, err := listeners.Get(ctx, d.sdkClient, cloudListenerId).Extract()
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'octavia.GetListener'")
+	} else if getListenerResp.Protocol != string(listeners.ProtocolTerminatedHTTPS) {
+		return fmt.Errorf("listener '%s' is not a TERMINATED_HTTPS listener", cloudListenerId)
+	}
+
+	d.logger.Logt("已查询到 Octavia 监听器", getListenerResp)
+
+	// 如果默认证书已是同一证书容器，则跳过
+	if getListenerResp.DefaultTlsContainerRef == cloudContainerRef {
+		d.logger.Logt("Octavia 监听器已使用该证书，跳过更新", cloudListenerId)
+		return nil
+	}
+
+	// 更新监听器默认证书
+	// REF: https://docs.openstack.org/api-ref/load-balancer/v2/#update-a-listener
+	updateListenerResp, err := listeners.Update(ctx, d.sdkClient, cloudListenerId, listeners.UpdateOpts{
+		DefaultTlsContainerRef: &cloudContainerRef,
+	}).Extract()
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'octavia.UpdateListener'")
+	}
+
+	d.logger.Logt("已更新 Octavia 监听器", updateListenerResp)
+
+	return nil
+}
+
+func createSdkClient(config *DeployerConfig) (*gophercloud.ServiceClient, error) {
+	if config.AuthUrl == "" {
+		return nil, errors.New("openstack: auth url is required")
+	}
+
+	provider, err := openstack.AuthenticatedClient(context.Background(), gophercloud.AuthOptions{
+		IdentityEndpoint: config.AuthUrl,
+		DomainName:       config.DomainName,
+		Username:         config.Username,
+		Password:         config.Password,
+		TenantID:         config.ProjectId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewLoadBalancerV2(provider, gophercloud.EndpointOpts{
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,139 @@
+package openstackoctavia_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/openstack-octavia"
+)
+
+var (
+	fInputCertPath  string
+	fInputKeyPath   string
+	fAuthUrl        string
+	fDomainName     string
+	fUsername       string
+	fPassword       string
+	fProjectId      string
+	fRegion         string
+	fLoadbalancerId string
+	fListenerId     string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fAuthUrl, argsPrefix+"AUTHURL", "", "")
+	flag.StringVar(&fDomainName, argsPrefix+"DOMAINNAME", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fProjectId, argsPrefix+"PROJECTID", "", "")
+	flag.StringVar(&fRegion, argsPrefix+"REGION", "", "")
+	flag.StringVar(&fLoadbalancerId, argsPrefix+"LOADBALANCERID", "", "")
+	flag.StringVar(&fListenerId, argsPrefix+"LISTENERID", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./openstack_octavia_test.go -args \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_AUTHURL="https://keystone.example.com:5000/v3" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_DOMAINNAME="Default" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_USERNAME="your-username" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_PASSWORD="your-password" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_PROJECTID="your-project-id" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_REGION="RegionOne" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_LOADBALANCERID="your-octavia-loadbalancer-id" \
+	--CERTIMATE_DEPLOYER_OPENSTACKOCTAVIA_LISTENERID="your-octavia-listener-id"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy_ToLoadbalancer", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("AUTHURL: %v", fAuthUrl),
+			fmt.Sprintf("DOMAINNAME: %v", fDomainName),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+			fmt.Sprintf("REGION: %v", fRegion),
+			fmt.Sprintf("LOADBALANCERID: %v", fLoadbalancerId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			AuthUrl:        fAuthUrl,
+			DomainName:     fDomainName,
+			Username:       fUsername,
+			Password:       fPassword,
+			ProjectId:      fProjectId,
+			Region:         fRegion,
+			ResourceType:   provider.RESOURCE_TYPE_LOADBALANCER,
+			LoadbalancerId: fLoadbalancerId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+
+	t.Run("Deploy_ToListener", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("AUTHURL: %v", fAuthUrl),
+			fmt.Sprintf("DOMAINNAME: %v", fDomainName),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+			fmt.Sprintf("REGION: %v", fRegion),
+			fmt.Sprintf("LISTENERID: %v", fListenerId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			AuthUrl:      fAuthUrl,
+			DomainName:   fDomainName,
+			Username:     fUsername,
+			Password:     fPassword,
+			ProjectId:    fProjectId,
+			Region:       fRegion,
+			ResourceType: provider.RESOURCE_TYPE_LISTENER,
+			ListenerId:   fListenerId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
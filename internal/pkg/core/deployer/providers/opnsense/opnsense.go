@@ -0,0 +1,180 @@
+package opnsense
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	opnsensesdk "github.com/usual2970/certimate/internal/pkg/vendors/opnsense-sdk"
+)
+
+type DeployerConfig struct {
+	// OPNsense 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// OPNsense API Key。
+	ApiKey string `json:"apiKey"`
+	// OPNsense API Secret。
+	ApiSecret string `json:"apiSecret"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书描述名称。
+	// 存在同名证书时将原地更新，使 Web 管理界面、HAProxy 等已引用该证书的服务无需重新绑定。
+	CertificateName string `json:"certificateName"`
+	// 是否重启 Web 管理界面。
+	RestartWebGUI bool `json:"restartWebGUI,omitempty"`
+	// 是否重新加载 HAProxy 服务。
+	// 需已安装 os-haproxy 插件。
+	ReloadHAProxy bool `json:"reloadHAProxy,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *opnsensesdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiKey, config.ApiSecret, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateName == "" {
+		return nil, errors.New("config `certificateName` is required")
+	}
+
+	// 查找同名证书
+	// REF: https://docs.opnsense.org/development/api/core/trust.html
+	searchCertResp, err := d.sdkClient.TrustCertSearch(d.config.CertificateName)
+	d.logger.Logt("已查询到证书列表", searchCertResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'opnsense.TrustCertSearch'")
+	}
+
+	var existingCert *opnsensesdk.TrustCert
+	for _, cert := range searchCertResp.Rows {
+		if cert.Descr == d.config.CertificateName {
+			existingCert = cert
+			break
+		}
+	}
+
+	// 仅校验模式下只检查 API 密钥是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		if existingCert != nil {
+			d.logger.Logt("dry run: certificate would be updated in place", existingCert)
+		} else {
+			d.logger.Logt("dry run: certificate would be imported as new")
+		}
+		return &deployer.DeployResult{}, nil
+	}
+
+	saveCertReq := &opnsensesdk.TrustCertSaveRequest{
+		Cert: &opnsensesdk.TrustCertSavePayload{
+			Action:     "import",
+			Descr:      d.config.CertificateName,
+			CrtPayload: certPem,
+			PrvPayload: privkeyPem,
+		},
+	}
+	certUuid := ""
+	if existingCert != nil {
+		// 原地更新证书，保持引用不变
+		// REF: https://docs.opnsense.org/development/api/core/trust.html
+		setCertResp, err := d.sdkClient.TrustCertSet(existingCert.Uuid, saveCertReq)
+		d.logger.Logt("已更新证书", setCertResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'opnsense.TrustCertSet'")
+		}
+
+		certUuid = existingCert.Uuid
+	} else {
+		// 导入新证书
+		// REF: https://docs.opnsense.org/development/api/core/trust.html
+		addCertResp, err := d.sdkClient.TrustCertAdd(saveCertReq)
+		d.logger.Logt("已导入证书", addCertResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'opnsense.TrustCertAdd'")
+		}
+
+		certUuid = addCertResp.Uuid
+	}
+
+	// 重新加载 HAProxy 服务
+	if d.config.ReloadHAProxy {
+		// REF: https://docs.opnsense.org/development/api/plugins/haproxy.html
+		reconfigureResp, err := d.sdkClient.HAProxyServiceReconfigure()
+		d.logger.Logt("已重新加载 HAProxy 服务", reconfigureResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'opnsense.HAProxyServiceReconfigure'")
+		}
+	}
+
+	// 重启 Web 管理界面，重启过程中连接可能会被中断，因此忽略错误
+	if d.config.RestartWebGUI {
+		// REF: https://docs.opnsense.org/development/api/core/core.html
+		restartResp, err := d.sdkClient.CoreServiceRestart("webgui")
+		if err != nil {
+			d.logger.Logt("重启 Web 管理界面时连接中断", err.Error())
+		} else {
+			d.logger.Logt("已重启 Web 管理界面", restartResp)
+		}
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certificateUuid": certUuid,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, apiKey, apiSecret string, skipTlsVerify bool) (*opnsensesdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid opnsense server url")
+	}
+	if apiKey == "" {
+		return nil, errors.New("invalid opnsense api key")
+	}
+	if apiSecret == "" {
+		return nil, errors.New("invalid opnsense api secret")
+	}
+
+	client := opnsensesdk.NewClient(serverUrl, apiKey, apiSecret).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,82 @@
+package opnsense_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/opnsense"
+)
+
+var (
+	fInputCertPath   string
+	fInputKeyPath    string
+	fServerUrl       string
+	fApiKey          string
+	fApiSecret       string
+	fCertificateName string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_OPNSENSE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fApiKey, argsPrefix+"APIKEY", "", "")
+	flag.StringVar(&fApiSecret, argsPrefix+"APISECRET", "", "")
+	flag.StringVar(&fCertificateName, argsPrefix+"CERTIFICATENAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./opnsense_test.go -args \
+	--CERTIMATE_DEPLOYER_OPNSENSE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_OPNSENSE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_OPNSENSE_SERVERURL="https://192.168.1.1" \
+	--CERTIMATE_DEPLOYER_OPNSENSE_APIKEY="your-api-key" \
+	--CERTIMATE_DEPLOYER_OPNSENSE_APISECRET="your-api-secret" \
+	--CERTIMATE_DEPLOYER_OPNSENSE_CERTIFICATENAME="certimate"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("APIKEY: %v", fApiKey),
+			fmt.Sprintf("APISECRET: %v", fApiSecret),
+			fmt.Sprintf("CERTIFICATENAME: %v", fCertificateName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:                fServerUrl,
+			ApiKey:                   fApiKey,
+			ApiSecret:                fApiSecret,
+			CertificateName:          fCertificateName,
+			AllowInsecureConnections: true,
+			RestartWebGUI:            true,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
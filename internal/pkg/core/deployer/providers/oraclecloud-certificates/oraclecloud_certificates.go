@@ -0,0 +1,160 @@
+package oraclecloudcertificates
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	ocisdk "github.com/usual2970/certimate/internal/pkg/vendors/oci-sdk"
+)
+
+type DeployerConfig struct {
+	// Oracle Cloud 租户 OCID。
+	TenancyId string `json:"tenancyId"`
+	// Oracle Cloud 用户 OCID。
+	UserId string `json:"userId"`
+	// Oracle Cloud API 签名密钥指纹。
+	Fingerprint string `json:"fingerprint"`
+	// Oracle Cloud API 签名私钥。
+	PrivateKey string `json:"privateKey"`
+	// Oracle Cloud 地域。
+	Region string `json:"region"`
+	// 区间 OCID。
+	// 新建证书时必填。
+	CompartmentId string `json:"compartmentId,omitempty"`
+	// 证书 OCID。
+	// 选填。填写时将为该证书导入新版本；不填写时将新建证书。
+	CertificateId string `json:"certificateId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *ocisdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.TenancyId, config.UserId, config.Fingerprint, config.PrivateKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateId == "" && d.config.CompartmentId == "" {
+		return nil, errors.New("config `compartmentId` is required when `certificateId` is not set")
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	certificateConfig := &ocisdk.CertificatesManagementCertificateConfig{
+		ConfigType:     "IMPORTED",
+		CertificatePem: serverCertPem,
+		CertChainPem:   intermediaCertPem,
+		PrivateKeyPem:  privkeyPem,
+	}
+
+	if d.config.CertificateId == "" {
+		// 仅校验模式下不实际新建证书
+		if deployer.MergeOptions(opts...).DryRun {
+			return &deployer.DeployResult{}, nil
+		}
+
+		// 新建证书
+		// REF: https://docs.oracle.com/en-us/iaas/api/#/en/certificatesmgmt/20210224/Certificate/CreateCertificate
+		createCertificateReq := &ocisdk.CreateCertificatesManagementCertificateRequest{
+			Name:              fmt.Sprintf("certimate-%d", time.Now().UnixMilli()),
+			CompartmentId:     d.config.CompartmentId,
+			Description:       types.ToPtr("upload from certimate"),
+			CertificateConfig: certificateConfig,
+		}
+		createCertificateResp, err := d.sdkClient.CreateCertificatesManagementCertificate(createCertificateReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatesmanagement.CreateCertificate'")
+		}
+
+		d.logger.Logt("已新建证书", createCertificateResp)
+	} else {
+		// 获取证书详情
+		// REF: https://docs.oracle.com/en-us/iaas/api/#/en/certificatesmgmt/20210224/Certificate/GetCertificate
+		getCertificateResp, err := d.sdkClient.GetCertificatesManagementCertificate(d.config.CertificateId)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatesmanagement.GetCertificate'")
+		} else if getCertificateResp.ConfigType != "IMPORTED" {
+			return nil, fmt.Errorf("certificate '%s' is not an imported certificate", d.config.CertificateId)
+		}
+
+		d.logger.Logt("已获取证书详情", getCertificateResp)
+
+		// 仅校验模式下只获取证书详情，不实际导入新版本
+		if deployer.MergeOptions(opts...).DryRun {
+			return &deployer.DeployResult{}, nil
+		}
+
+		// 导入证书新版本，并设为当前版本
+		// REF: https://docs.oracle.com/en-us/iaas/api/#/en/certificatesmgmt/20210224/Certificate/UpdateCertificate
+		certificateConfig.Stage = types.ToPtr("CURRENT")
+		updateCertificateReq := &ocisdk.UpdateCertificatesManagementCertificateRequest{
+			CertificateConfig: certificateConfig,
+		}
+		updateCertificateResp, err := d.sdkClient.UpdateCertificatesManagementCertificate(d.config.CertificateId, updateCertificateReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'certificatesmanagement.UpdateCertificate'")
+		}
+
+		d.logger.Logt("已导入证书新版本", updateCertificateResp)
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(tenancyId, userId, fingerprint, privateKey, region string) (*ocisdk.Client, error) {
+	if tenancyId == "" || userId == "" {
+		return nil, errors.New("invalid oracle cloud tenancy or user")
+	}
+
+	if fingerprint == "" || privateKey == "" {
+		return nil, errors.New("invalid oracle cloud api signing key")
+	}
+
+	if region == "" {
+		return nil, errors.New("invalid oracle cloud region")
+	}
+
+	return ocisdk.NewClient(tenancyId, userId, fingerprint, privateKey, region)
+}
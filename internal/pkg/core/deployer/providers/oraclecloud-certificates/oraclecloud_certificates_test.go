@@ -0,0 +1,95 @@
+package oraclecloudcertificates_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-certificates"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fTenancyId     string
+	fUserId        string
+	fFingerprint   string
+	fPrivateKey    string
+	fRegion        string
+	fCompartmentId string
+	fCertificateId string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fTenancyId, argsPrefix+"TENANCYID", "", "")
+	flag.StringVar(&fUserId, argsPrefix+"USERID", "", "")
+	flag.StringVar(&fFingerprint, argsPrefix+"FINGERPRINT", "", "")
+	flag.StringVar(&fPrivateKey, argsPrefix+"PRIVATEKEY", "", "")
+	flag.StringVar(&fRegion, argsPrefix+"REGION", "", "")
+	flag.StringVar(&fCompartmentId, argsPrefix+"COMPARTMENTID", "", "")
+	flag.StringVar(&fCertificateId, argsPrefix+"CERTIFICATEID", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./oraclecloud_certificates_test.go -args \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_TENANCYID="ocid1.tenancy.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_USERID="ocid1.user.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_FINGERPRINT="your-fingerprint" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_PRIVATEKEY="your-private-key" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_REGION="us-ashburn-1" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_COMPARTMENTID="ocid1.compartment.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDCERTIFICATES_CERTIFICATEID="ocid1.certificate.oc1..xxx"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("TENANCYID: %v", fTenancyId),
+			fmt.Sprintf("USERID: %v", fUserId),
+			fmt.Sprintf("FINGERPRINT: %v", fFingerprint),
+			fmt.Sprintf("PRIVATEKEY: %v", fPrivateKey),
+			fmt.Sprintf("REGION: %v", fRegion),
+			fmt.Sprintf("COMPARTMENTID: %v", fCompartmentId),
+			fmt.Sprintf("CERTIFICATEID: %v", fCertificateId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			TenancyId:     fTenancyId,
+			UserId:        fUserId,
+			Fingerprint:   fFingerprint,
+			PrivateKey:    fPrivateKey,
+			Region:        fRegion,
+			CompartmentId: fCompartmentId,
+			CertificateId: fCertificateId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,202 @@
+package oraclecloudlb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	ocisdk "github.com/usual2970/certimate/internal/pkg/vendors/oci-sdk"
+)
+
+type DeployerConfig struct {
+	// Oracle Cloud 租户 OCID。
+	TenancyId string `json:"tenancyId"`
+	// Oracle Cloud 用户 OCID。
+	UserId string `json:"userId"`
+	// Oracle Cloud API 签名密钥指纹。
+	Fingerprint string `json:"fingerprint"`
+	// Oracle Cloud API 签名私钥。
+	PrivateKey string `json:"privateKey"`
+	// Oracle Cloud 地域。
+	Region string `json:"region"`
+	// 负载均衡器 OCID。
+	LoadbalancerId string `json:"loadbalancerId"`
+	// 监听器名称。
+	ListenerName string `json:"listenerName"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *ocisdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.TenancyId, config.UserId, config.Fingerprint, config.PrivateKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.LoadbalancerId == "" {
+		return nil, errors.New("config `loadbalancerId` is required")
+	}
+	if d.config.ListenerName == "" {
+		return nil, errors.New("config `listenerName` is required")
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取负载均衡器详情
+	// REF: https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/LoadBalancer/GetLoadBalancer
+	getLoadBalancerResp, err := d.sdkClient.GetLoadBalancer(d.config.LoadbalancerId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'loadbalancer.GetLoadBalancer'")
+	}
+
+	d.logger.Logt("已获取负载均衡器详情", getLoadBalancerResp)
+
+	listener, ok := getLoadBalancerResp.Listeners[d.config.ListenerName]
+	if !ok || listener == nil {
+		return nil, fmt.Errorf("could not find listener '%s'", d.config.ListenerName)
+	} else if listener.SslConfiguration == nil {
+		return nil, fmt.Errorf("listener '%s' is not ssl enabled", d.config.ListenerName)
+	}
+
+	// 仅校验模式下只获取负载均衡器详情，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到负载均衡器
+	// REF: https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/Certificate/CreateCertificate
+	certificateName := fmt.Sprintf("certimate-%d", time.Now().UnixMilli())
+	createCertificateReq := &ocisdk.CreateLoadBalancerCertificateRequest{
+		CertificateName:   certificateName,
+		PublicCertificate: serverCertPem,
+		PrivateKey:        privkeyPem,
+	}
+	if intermediaCertPem != "" {
+		createCertificateReq.CaCertificate = types.ToPtr(intermediaCertPem)
+	}
+	createCertificateWorkRequestId, err := d.sdkClient.CreateLoadBalancerCertificate(d.config.LoadbalancerId, createCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'loadbalancer.CreateCertificate'")
+	} else if err := d.waitForWorkRequest(ctx, createCertificateWorkRequestId); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt(fmt.Sprintf("已上传证书到负载均衡器，证书名称：%s", certificateName))
+
+	// 修改监听器证书，其余配置保持不变
+	// REF: https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/Listener/UpdateListener
+	sslConfiguration := *listener.SslConfiguration
+	sslConfiguration.CertificateName = types.ToPtr(certificateName)
+	sslConfiguration.CertificateIds = nil
+	updateListenerReq := &ocisdk.UpdateLoadBalancerListenerRequest{
+		DefaultBackendSetName:   listener.DefaultBackendSetName,
+		Port:                    listener.Port,
+		Protocol:                listener.Protocol,
+		HostnameNames:           listener.HostnameNames,
+		PathRouteSetName:        listener.PathRouteSetName,
+		SslConfiguration:        &sslConfiguration,
+		ConnectionConfiguration: listener.ConnectionConfiguration,
+		RuleSetNames:            listener.RuleSetNames,
+		RoutingPolicyName:       listener.RoutingPolicyName,
+	}
+	updateListenerWorkRequestId, err := d.sdkClient.UpdateLoadBalancerListener(d.config.LoadbalancerId, d.config.ListenerName, updateListenerReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'loadbalancer.UpdateListener'")
+	} else if err := d.waitForWorkRequest(ctx, updateListenerWorkRequestId); err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已修改监听器证书", updateListenerReq)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) waitForWorkRequest(ctx context.Context, workRequestId string) error {
+	if workRequestId == "" {
+		return nil
+	}
+
+	// 循环查询工作请求状态，等待其完成
+	// REF: https://docs.oracle.com/en-us/iaas/api/#/en/loadbalancer/20170115/WorkRequest/GetWorkRequest
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		getWorkRequestResp, err := d.sdkClient.GetLoadBalancerWorkRequest(workRequestId)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'loadbalancer.GetWorkRequest'")
+		}
+
+		switch strings.ToUpper(getWorkRequestResp.LifecycleState) {
+		case "SUCCEEDED":
+			return nil
+
+		case "FAILED":
+			return fmt.Errorf("work request '%s' failed: %s", workRequestId, getWorkRequestResp.Message)
+		}
+
+		d.logger.Logt(fmt.Sprintf("工作请求 %s (%s) 执行中，等待完成……", workRequestId, getWorkRequestResp.Type))
+		time.Sleep(time.Second * 5)
+	}
+}
+
+func createSdkClient(tenancyId, userId, fingerprint, privateKey, region string) (*ocisdk.Client, error) {
+	if tenancyId == "" || userId == "" {
+		return nil, errors.New("invalid oracle cloud tenancy or user")
+	}
+
+	if fingerprint == "" || privateKey == "" {
+		return nil, errors.New("invalid oracle cloud api signing key")
+	}
+
+	if region == "" {
+		return nil, errors.New("invalid oracle cloud region")
+	}
+
+	return ocisdk.NewClient(tenancyId, userId, fingerprint, privateKey, region)
+}
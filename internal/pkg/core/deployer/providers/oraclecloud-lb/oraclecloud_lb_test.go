@@ -0,0 +1,95 @@
+package oraclecloudlb_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/oraclecloud-lb"
+)
+
+var (
+	fInputCertPath  string
+	fInputKeyPath   string
+	fTenancyId      string
+	fUserId         string
+	fFingerprint    string
+	fPrivateKey     string
+	fRegion         string
+	fLoadbalancerId string
+	fListenerName   string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_ORACLECLOUDLB_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fTenancyId, argsPrefix+"TENANCYID", "", "")
+	flag.StringVar(&fUserId, argsPrefix+"USERID", "", "")
+	flag.StringVar(&fFingerprint, argsPrefix+"FINGERPRINT", "", "")
+	flag.StringVar(&fPrivateKey, argsPrefix+"PRIVATEKEY", "", "")
+	flag.StringVar(&fRegion, argsPrefix+"REGION", "", "")
+	flag.StringVar(&fLoadbalancerId, argsPrefix+"LOADBALANCERID", "", "")
+	flag.StringVar(&fListenerName, argsPrefix+"LISTENERNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./oraclecloud_lb_test.go -args \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_TENANCYID="ocid1.tenancy.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_USERID="ocid1.user.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_FINGERPRINT="your-fingerprint" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_PRIVATEKEY="your-private-key" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_REGION="us-ashburn-1" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_LOADBALANCERID="ocid1.loadbalancer.oc1..xxx" \
+	--CERTIMATE_DEPLOYER_ORACLECLOUDLB_LISTENERNAME="your-listener-name"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("TENANCYID: %v", fTenancyId),
+			fmt.Sprintf("USERID: %v", fUserId),
+			fmt.Sprintf("FINGERPRINT: %v", fFingerprint),
+			fmt.Sprintf("PRIVATEKEY: %v", fPrivateKey),
+			fmt.Sprintf("REGION: %v", fRegion),
+			fmt.Sprintf("LOADBALANCERID: %v", fLoadbalancerId),
+			fmt.Sprintf("LISTENERNAME: %v", fListenerName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			TenancyId:      fTenancyId,
+			UserId:         fUserId,
+			Fingerprint:    fFingerprint,
+			PrivateKey:     fPrivateKey,
+			Region:         fRegion,
+			LoadbalancerId: fLoadbalancerId,
+			ListenerName:   fListenerName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
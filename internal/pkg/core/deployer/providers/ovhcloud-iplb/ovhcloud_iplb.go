@@ -0,0 +1,166 @@
+package ovhcloudiplb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	ovhsdk "github.com/usual2970/certimate/internal/pkg/vendors/ovh-sdk"
+)
+
+type DeployerConfig struct {
+	// OVHcloud API 端点。
+	// 可取值 "ovh-eu"、"ovh-ca"、"ovh-us"，或自定义 URL。
+	Endpoint string `json:"endpoint"`
+	// OVHcloud Application Key。
+	ApplicationKey string `json:"applicationKey"`
+	// OVHcloud Application Secret。
+	ApplicationSecret string `json:"applicationSecret"`
+	// OVHcloud Consumer Key。
+	ConsumerKey string `json:"consumerKey"`
+	// 负载均衡服务名称。
+	ServiceName string `json:"serviceName"`
+	// HTTP 前端 ID。
+	// 选填。填写时将新证书设置为该前端的默认证书；不填写时由负载均衡按 SNI 自动匹配证书。
+	FrontendId int64 `json:"frontendId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *ovhsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Endpoint, config.ApplicationKey, config.ApplicationSecret, config.ConsumerKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ServiceName == "" {
+		return nil, errors.New("config `serviceName` is required")
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取负载均衡服务信息
+	// REF: https://eu.api.ovh.com/console/?section=%2FipLoadbalancing&branch=v1#get-/ipLoadbalancing/-serviceName-
+	getIpLoadbalancingResp, err := d.sdkClient.GetIpLoadbalancing(d.config.ServiceName)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.GetIpLoadbalancing'")
+	}
+
+	d.logger.Logt("已获取负载均衡服务信息", getIpLoadbalancingResp)
+
+	if d.config.FrontendId != 0 {
+		// 获取 HTTP 前端信息
+		// REF: https://eu.api.ovh.com/console/?section=%2FipLoadbalancing&branch=v1#get-/ipLoadbalancing/-serviceName-/http/frontend/-frontendId-
+		getHttpFrontendResp, err := d.sdkClient.GetIpLoadbalancingHttpFrontend(d.config.ServiceName, d.config.FrontendId)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.GetIpLoadbalancingHttpFrontend'")
+		} else if !getHttpFrontendResp.Ssl {
+			return nil, fmt.Errorf("frontend #%d is not ssl enabled", d.config.FrontendId)
+		}
+
+		d.logger.Logt("已获取 HTTP 前端信息", getHttpFrontendResp)
+	}
+
+	// 仅校验模式下只查询资源，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书
+	// REF: https://eu.api.ovh.com/console/?section=%2FipLoadbalancing&branch=v1#post-/ipLoadbalancing/-serviceName-/ssl
+	createSslReq := &ovhsdk.CreateIpLoadbalancingSslRequest{
+		Certificate: serverCertPem,
+		Key:         privkeyPem,
+		DisplayName: types.ToPtr(fmt.Sprintf("certimate-%d", time.Now().UnixMilli())),
+	}
+	if intermediaCertPem != "" {
+		createSslReq.Chain = types.ToPtr(intermediaCertPem)
+	}
+	createSslResp, err := d.sdkClient.CreateIpLoadbalancingSsl(d.config.ServiceName, createSslReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.CreateIpLoadbalancingSsl'")
+	}
+
+	d.logger.Logt("已上传证书", createSslResp)
+
+	if d.config.FrontendId != 0 {
+		// 修改 HTTP 前端的默认证书
+		// REF: https://eu.api.ovh.com/console/?section=%2FipLoadbalancing&branch=v1#put-/ipLoadbalancing/-serviceName-/http/frontend/-frontendId-
+		updateHttpFrontendReq := &ovhsdk.UpdateIpLoadbalancingHttpFrontendRequest{
+			DefaultSslId: types.ToPtr(createSslResp.Id),
+		}
+		if err := d.sdkClient.UpdateIpLoadbalancingHttpFrontend(d.config.ServiceName, d.config.FrontendId, updateHttpFrontendReq); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.UpdateIpLoadbalancingHttpFrontend'")
+		}
+
+		d.logger.Logt("已修改 HTTP 前端默认证书", updateHttpFrontendReq)
+	}
+
+	// 应用负载均衡配置，使证书生效
+	// REF: https://eu.api.ovh.com/console/?section=%2FipLoadbalancing&branch=v1#post-/ipLoadbalancing/-serviceName-/refresh
+	refreshResp, err := d.sdkClient.RefreshIpLoadbalancing(d.config.ServiceName, &ovhsdk.RefreshIpLoadbalancingRequest{})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.RefreshIpLoadbalancing'")
+	}
+
+	d.logger.Logt("已应用负载均衡配置", refreshResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(endpoint, applicationKey, applicationSecret, consumerKey string) (*ovhsdk.Client, error) {
+	if applicationKey == "" {
+		return nil, errors.New("invalid ovhcloud application key")
+	}
+
+	if applicationSecret == "" {
+		return nil, errors.New("invalid ovhcloud application secret")
+	}
+
+	if consumerKey == "" {
+		return nil, errors.New("invalid ovhcloud consumer key")
+	}
+
+	return ovhsdk.NewClient(endpoint, applicationKey, applicationSecret, consumerKey)
+}
@@ -0,0 +1,90 @@
+package ovhcloudiplb_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-iplb"
+)
+
+var (
+	fInputCertPath     string
+	fInputKeyPath      string
+	fEndpoint          string
+	fApplicationKey    string
+	fApplicationSecret string
+	fConsumerKey       string
+	fServiceName       string
+	fFrontendId        int64
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_OVHCLOUDIPLB_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fEndpoint, argsPrefix+"ENDPOINT", "", "")
+	flag.StringVar(&fApplicationKey, argsPrefix+"APPLICATIONKEY", "", "")
+	flag.StringVar(&fApplicationSecret, argsPrefix+"APPLICATIONSECRET", "", "")
+	flag.StringVar(&fConsumerKey, argsPrefix+"CONSUMERKEY", "", "")
+	flag.StringVar(&fServiceName, argsPrefix+"SERVICENAME", "", "")
+	flag.Int64Var(&fFrontendId, argsPrefix+"FRONTENDID", 0, "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./ovhcloud_iplb_test.go -args \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_ENDPOINT="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_APPLICATIONKEY="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_APPLICATIONSECRET="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_CONSUMERKEY="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_SERVICENAME="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDIPLB_FRONTENDID=0
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("ENDPOINT: %v", fEndpoint),
+			fmt.Sprintf("APPLICATIONKEY: %v", fApplicationKey),
+			fmt.Sprintf("APPLICATIONSECRET: %v", fApplicationSecret),
+			fmt.Sprintf("CONSUMERKEY: %v", fConsumerKey),
+			fmt.Sprintf("SERVICENAME: %v", fServiceName),
+			fmt.Sprintf("FRONTENDID: %v", fFrontendId),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Endpoint:          fEndpoint,
+			ApplicationKey:    fApplicationKey,
+			ApplicationSecret: fApplicationSecret,
+			ConsumerKey:       fConsumerKey,
+			ServiceName:       fServiceName,
+			FrontendId:        fFrontendId,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,164 @@
+package ovhcloudwebhosting
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/types"
+	ovhsdk "github.com/usual2970/certimate/internal/pkg/vendors/ovh-sdk"
+)
+
+type DeployerConfig struct {
+	// OVHcloud API 端点。
+	// 可取值 "ovh-eu"、"ovh-ca"、"ovh-us"，或自定义 URL。
+	Endpoint string `json:"endpoint"`
+	// OVHcloud Application Key。
+	ApplicationKey string `json:"applicationKey"`
+	// OVHcloud Application Secret。
+	ApplicationSecret string `json:"applicationSecret"`
+	// OVHcloud Consumer Key。
+	ConsumerKey string `json:"consumerKey"`
+	// 虚拟主机服务名称。
+	ServiceName string `json:"serviceName"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *ovhsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Endpoint, config.ApplicationKey, config.ApplicationSecret, config.ConsumerKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ServiceName == "" {
+		return nil, errors.New("config `serviceName` is required")
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取虚拟主机当前的证书信息
+	// REF: https://eu.api.ovh.com/console/?section=%2Fhosting%2Fweb&branch=v1#get-/hosting/web/-serviceName-/ssl
+	getSslResp, err := d.sdkClient.GetHostingWebSsl(d.config.ServiceName)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.GetHostingWebSsl'")
+	}
+
+	d.logger.Logt("已获取虚拟主机证书信息", getSslResp)
+
+	// 仅校验模式下只查询证书信息，不实际替换证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 虚拟主机只能绑定一张证书，需先删除已有证书
+	if getSslResp != nil {
+		// 删除证书
+		// REF: https://eu.api.ovh.com/console/?section=%2Fhosting%2Fweb&branch=v1#delete-/hosting/web/-serviceName-/ssl
+		deleteSslResp, err := d.sdkClient.DeleteHostingWebSsl(d.config.ServiceName)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.DeleteHostingWebSsl'")
+		}
+
+		d.logger.Logt("已删除原证书", deleteSslResp)
+
+		// 删除操作为异步任务，循环查询直到证书被移除
+		if err := d.waitForSslDeleted(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	// 导入自定义证书
+	// REF: https://eu.api.ovh.com/console/?section=%2Fhosting%2Fweb&branch=v1#post-/hosting/web/-serviceName-/ssl
+	createSslReq := &ovhsdk.CreateHostingWebSslRequest{
+		Certificate: serverCertPem,
+		Key:         privkeyPem,
+	}
+	if intermediaCertPem != "" {
+		createSslReq.Chain = types.ToPtr(intermediaCertPem)
+	}
+	createSslResp, err := d.sdkClient.CreateHostingWebSsl(d.config.ServiceName, createSslReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ovh.CreateHostingWebSsl'")
+	}
+
+	d.logger.Logt("已导入自定义证书", createSslResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) waitForSslDeleted(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		default:
+		}
+
+		getSslResp, err := d.sdkClient.GetHostingWebSsl(d.config.ServiceName)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'ovh.GetHostingWebSsl'")
+		} else if getSslResp == nil {
+			return nil
+		}
+
+		d.logger.Logt(fmt.Sprintf("原证书删除中（状态：%s），等待完成……", getSslResp.Status))
+		time.Sleep(time.Second * 5)
+	}
+}
+
+func createSdkClient(endpoint, applicationKey, applicationSecret, consumerKey string) (*ovhsdk.Client, error) {
+	if applicationKey == "" {
+		return nil, errors.New("invalid ovhcloud application key")
+	}
+
+	if applicationSecret == "" {
+		return nil, errors.New("invalid ovhcloud application secret")
+	}
+
+	if consumerKey == "" {
+		return nil, errors.New("invalid ovhcloud consumer key")
+	}
+
+	return ovhsdk.NewClient(endpoint, applicationKey, applicationSecret, consumerKey)
+}
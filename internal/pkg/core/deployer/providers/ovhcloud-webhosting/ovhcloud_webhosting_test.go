@@ -0,0 +1,85 @@
+package ovhcloudwebhosting_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ovhcloud-webhosting"
+)
+
+var (
+	fInputCertPath     string
+	fInputKeyPath      string
+	fEndpoint          string
+	fApplicationKey    string
+	fApplicationSecret string
+	fConsumerKey       string
+	fServiceName       string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fEndpoint, argsPrefix+"ENDPOINT", "", "")
+	flag.StringVar(&fApplicationKey, argsPrefix+"APPLICATIONKEY", "", "")
+	flag.StringVar(&fApplicationSecret, argsPrefix+"APPLICATIONSECRET", "", "")
+	flag.StringVar(&fConsumerKey, argsPrefix+"CONSUMERKEY", "", "")
+	flag.StringVar(&fServiceName, argsPrefix+"SERVICENAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./ovhcloud_webhosting_test.go -args \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_ENDPOINT="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_APPLICATIONKEY="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_APPLICATIONSECRET="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_CONSUMERKEY="" \
+	--CERTIMATE_DEPLOYER_OVHCLOUDWEBHOSTING_SERVICENAME=""
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("ENDPOINT: %v", fEndpoint),
+			fmt.Sprintf("APPLICATIONKEY: %v", fApplicationKey),
+			fmt.Sprintf("APPLICATIONSECRET: %v", fApplicationSecret),
+			fmt.Sprintf("CONSUMERKEY: %v", fConsumerKey),
+			fmt.Sprintf("SERVICENAME: %v", fServiceName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Endpoint:          fEndpoint,
+			ApplicationKey:    fApplicationKey,
+			ApplicationSecret: fApplicationSecret,
+			ConsumerKey:       fConsumerKey,
+			ServiceName:       fServiceName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
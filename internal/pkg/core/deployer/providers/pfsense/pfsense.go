@@ -0,0 +1,182 @@
+package pfsense
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	pfsensesdk "github.com/usual2970/certimate/internal/pkg/vendors/pfsense-sdk"
+)
+
+type DeployerConfig struct {
+	// pfSense 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// pfSense REST API 密钥。
+	ApiKey string `json:"apiKey"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书描述名称。
+	// 存在同名证书时将原地更新，使 HAProxy 等已引用该证书的服务无需重新绑定。
+	CertificateName string `json:"certificateName"`
+	// 是否设置为 Web 管理界面证书。
+	UpdateWebGUI bool `json:"updateWebGUI,omitempty"`
+	// 是否重新加载 HAProxy 服务。
+	// 需已安装 HAProxy 扩展包。
+	ReloadHAProxy bool `json:"reloadHAProxy,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *pfsensesdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.CertificateName == "" {
+		return nil, errors.New("config `certificateName` is required")
+	}
+
+	// 查找同名证书
+	// REF: https://pfrest.org/api-docs/#/SYSTEM/getSystemCertificatesEndpoint
+	listCertResp, err := d.sdkClient.CertificateList(d.config.CertificateName)
+	d.logger.Logt("已查询到证书列表", listCertResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'pfsense.CertificateList'")
+	}
+
+	var existingCert *pfsensesdk.Certificate
+	for _, cert := range listCertResp.Data {
+		if cert.Descr == d.config.CertificateName {
+			existingCert = cert
+			break
+		}
+	}
+
+	// 仅校验模式下只检查 API 密钥是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		if existingCert != nil {
+			d.logger.Logt("dry run: certificate would be updated in place", existingCert)
+		} else {
+			d.logger.Logt("dry run: certificate would be imported as new")
+		}
+		return &deployer.DeployResult{}, nil
+	}
+
+	var certRefId string
+	if existingCert != nil {
+		// 原地更新证书，保持引用不变
+		// REF: https://pfrest.org/api-docs/#/SYSTEM/patchSystemCertificateEndpoint
+		updateCertReq := &pfsensesdk.CertificateUpdateRequest{
+			Id:  existingCert.Id,
+			Crt: certPem,
+			Prv: privkeyPem,
+		}
+		updateCertResp, err := d.sdkClient.CertificateUpdate(updateCertReq)
+		d.logger.Logt("已更新证书", updateCertResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'pfsense.CertificateUpdate'")
+		}
+
+		certRefId = existingCert.RefId
+	} else {
+		// 导入新证书
+		// REF: https://pfrest.org/api-docs/#/SYSTEM/postSystemCertificateEndpoint
+		createCertReq := &pfsensesdk.CertificateCreateRequest{
+			Descr: d.config.CertificateName,
+			Crt:   certPem,
+			Prv:   privkeyPem,
+		}
+		createCertResp, err := d.sdkClient.CertificateCreate(createCertReq)
+		d.logger.Logt("已导入证书", createCertResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'pfsense.CertificateCreate'")
+		} else if createCertResp.Data == nil {
+			return nil, errors.New("failed to import certificate: no data returned")
+		}
+
+		certRefId = createCertResp.Data.RefId
+	}
+
+	// 重新加载 HAProxy 服务
+	if d.config.ReloadHAProxy {
+		// REF: https://pfrest.org/api-docs/#/SERVICES/postServicesHAProxyApplyEndpoint
+		applyResp, err := d.sdkClient.HAProxyApply()
+		d.logger.Logt("已重新加载 HAProxy 服务", applyResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'pfsense.HAProxyApply'")
+		}
+	}
+
+	// 设置为 Web 管理界面证书，pfSense 会自动重启 Web 管理界面，重启过程中连接可能会被中断，因此忽略错误
+	if d.config.UpdateWebGUI {
+		// REF: https://pfrest.org/api-docs/#/SYSTEM/patchSystemWebGUISettingsEndpoint
+		updateWebGUIReq := &pfsensesdk.WebGUISettingsUpdateRequest{
+			SslCertRef: certRefId,
+		}
+		updateWebGUIResp, err := d.sdkClient.WebGUISettingsUpdate(updateWebGUIReq)
+		if err != nil {
+			d.logger.Logt("更新 Web 管理界面证书时连接中断", err.Error())
+		} else {
+			d.logger.Logt("已更新 Web 管理界面证书", updateWebGUIResp)
+		}
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certificateRefId": certRefId,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, apiKey string, skipTlsVerify bool) (*pfsensesdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid pfsense server url")
+	}
+	if apiKey == "" {
+		return nil, errors.New("invalid pfsense api key")
+	}
+
+	client := pfsensesdk.NewClient(serverUrl, apiKey).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
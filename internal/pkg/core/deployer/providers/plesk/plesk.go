@@ -0,0 +1,173 @@
+package plesk
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	plesksdk "github.com/usual2970/certimate/internal/pkg/vendors/plesk-sdk"
+)
+
+type DeployerConfig struct {
+	// Plesk 地址。
+	ServerUrl string `json:"serverUrl"`
+	// Plesk API 密钥。
+	ApiKey string `json:"apiKey"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 订阅的主域名。
+	DomainName string `json:"domainName"`
+	// 是否将证书用于网站托管。
+	AssignToHosting bool `json:"assignToHosting,omitempty"`
+	// 是否将证书用于邮件服务。
+	AssignToMail bool `json:"assignToMail,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *plesksdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.DomainName == "" {
+		return nil, errors.New("config `domainName` is required")
+	}
+
+	// 查询域名，确认订阅存在
+	// REF: https://docs.plesk.com/en-US/obsidian/api-rpc/about-rest-api.79359/
+	listDomainsResp, err := d.sdkClient.DomainList(d.config.DomainName)
+	d.logger.Logt("已查询到域名列表", listDomainsResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'plesk.DomainList'")
+	}
+
+	var domain *plesksdk.Domain
+	for _, item := range listDomainsResp {
+		if strings.EqualFold(item.Name, d.config.DomainName) || strings.EqualFold(item.AsciiName, d.config.DomainName) {
+			domain = item
+			break
+		}
+	}
+	if domain == nil {
+		return nil, xerrors.Errorf("could not find domain '%s' in plesk", d.config.DomainName)
+	} else if domain.BaseDomain != nil && domain.BaseDomain.Id != 0 && domain.BaseDomain.Id != domain.Id {
+		return nil, xerrors.Errorf("domain '%s' is not the main domain of a subscription", d.config.DomainName)
+	}
+
+	// 仅校验模式下只检查 API 密钥及域名是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: certificate would be installed for subscription", d.config.DomainName)
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 安装证书到订阅的证书池，Plesk 不允许同名证书，因此以时间戳命名
+	// REF: https://docs.plesk.com/en-US/obsidian/api-rpc/about-xml-api/reference/managing-ssl-certificates/installing-certificates.34744/
+	certName := fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+	installCertReq := &plesksdk.CertificateInstallRequest{
+		Name:     certName,
+		Webspace: d.config.DomainName,
+		Cert:     serverCertPem,
+		PrivKey:  privkeyPem,
+		CACert:   intermediaCertPem,
+	}
+	installCertResp, err := d.sdkClient.CertificateInstall(installCertReq)
+	d.logger.Logt("已安装证书", installCertResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'plesk.CertificateInstall'")
+	}
+
+	// 将证书用于网站托管
+	if d.config.AssignToHosting {
+		// REF: https://docs.plesk.com/en-US/obsidian/cli-linux/using-command-line-utilities/subscription-subscriptions.39071/
+		cliCallReq := &plesksdk.CliCallRequest{
+			Params: []string{"--update", d.config.DomainName, "-certificate-name", certName},
+		}
+		cliCallResp, err := d.sdkClient.CliCall("subscription", cliCallReq)
+		d.logger.Logt("已将证书用于网站托管", cliCallResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'plesk.CliCall'")
+		}
+	}
+
+	// 将证书用于邮件服务
+	if d.config.AssignToMail {
+		// REF: https://docs.plesk.com/en-US/obsidian/cli-linux/using-command-line-utilities/subscription_settings-subscription-settings.39074/
+		cliCallReq := &plesksdk.CliCallRequest{
+			Params: []string{"--update", d.config.DomainName, "-mail_certificate", certName},
+		}
+		cliCallResp, err := d.sdkClient.CliCall("subscription_settings", cliCallReq)
+		d.logger.Logt("已将证书用于邮件服务", cliCallResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'plesk.CliCall'")
+		}
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certificateName": certName,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, apiKey string, skipTlsVerify bool) (*plesksdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid plesk server url")
+	}
+	if apiKey == "" {
+		return nil, errors.New("invalid plesk api key")
+	}
+
+	client := plesksdk.NewClient(serverUrl, apiKey).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,126 @@
+package rancherharvester
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/rest"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+)
+
+type DeployerConfig struct {
+	// Rancher 服务地址。
+	// 如果是独立部署的 Harvester，则填写 Harvester 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Rancher API Token。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// Rancher 集群 ID。
+	// 零值时默认为 "local"。
+	ClusterId string `json:"clusterId,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+var settingResource = schema.GroupVersionResource{
+	Group:    "harvesterhci.io",
+	Version:  "v1beta1",
+	Resource: "settings",
+}
+
+const settingName = "ssl-certificates"
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	clusterId := d.config.ClusterId
+	if clusterId == "" {
+		clusterId = "local"
+	}
+
+	// 连接
+	client, err := createDynamicClient(d.config.ServerUrl, d.config.ApiToken, clusterId, d.config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create k8s client")
+	}
+
+	// 获取 Harvester 的 "ssl-certificates" 设置项
+	// REF: https://docs.harvesterhci.io/v1.4/advanced/index#ssl-certificates
+	setting, err := client.Resource(settingResource).Get(ctx, settingName, k8sMeta.GetOptions{})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to get harvester setting")
+	}
+
+	// 更新设置项，其值为 JSON 字符串
+	settingValue, err := json.Marshal(map[string]string{
+		"ca":                "",
+		"publicCertificate": certPem,
+		"privateKey":        privkeyPem,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	setting.Object["value"] = string(settingValue)
+	setting, err = client.Resource(settingResource).Update(ctx, setting, k8sMeta.UpdateOptions{})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to update harvester setting")
+	}
+
+	d.logger.Logt("harvester setting updated", setting.GetName())
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createDynamicClient(serverUrl, apiToken, clusterId string, skipTlsVerify bool) (*dynamic.DynamicClient, error) {
+	if serverUrl == "" {
+		return nil, errors.New("rancher: server url is required")
+	}
+	if apiToken == "" {
+		return nil, errors.New("rancher: api token is required")
+	}
+
+	// Rancher 通过 "/k8s/clusters/{clusterId}" 代理下游集群的 Kubernetes API
+	config := &rest.Config{
+		Host:        strings.TrimRight(serverUrl, "/") + "/k8s/clusters/" + clusterId,
+		BearerToken: apiToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: skipTlsVerify,
+		},
+	}
+
+	client, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
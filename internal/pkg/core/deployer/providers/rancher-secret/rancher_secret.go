@@ -0,0 +1,297 @@
+package ranchersecret
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	k8sCore "k8s.io/api/core/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	k8sMeta "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
+)
+
+type DeployerConfig struct {
+	// Rancher 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Rancher API Token。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// Rancher 集群 ID。
+	// 零值时默认为 "local"，即 Rancher 自身所在的集群。
+	ClusterId string `json:"clusterId,omitempty"`
+	// Rancher 集群 ID 列表。
+	// 选填。非空时部署到列表中每个集群的同名 Secret，此时忽略 ClusterId。
+	ClusterIds []string `json:"clusterIds,omitempty"`
+	// Rancher 项目 ID 列表，格式为 "{集群 ID}:{项目 ID}"。
+	// 选填。非空时部署到项目下每个命名空间的同名 Secret，此时忽略 ClusterId、ClusterIds 和 Namespace。
+	ProjectIds []string `json:"projectIds,omitempty"`
+	// Kubernetes 命名空间。
+	// 零值时默认为 "cattle-system"。
+	Namespace string `json:"namespace,omitempty"`
+	// Kubernetes Secret 名称。
+	// 零值时默认为 "tls-rancher-ingress"，即 Rancher 自身 Ingress 所使用的证书。
+	SecretName string `json:"secretName,omitempty"`
+	// 是否允许部分目标部署失败。
+	// 为 true 时，只要有任一目标部署成功即视为部署成功；否则任一目标部署失败均视为部署失败。
+	AllowPartialFailure bool `json:"allowPartialFailure,omitempty"`
+}
+
+type DeployerTargetResult struct {
+	// Rancher 集群 ID。
+	ClusterId string `json:"clusterId"`
+	// Kubernetes 命名空间。
+	Namespace string `json:"namespace"`
+	// 是否部署成功。
+	Succeeded bool `json:"succeeded"`
+	// 错误信息。
+	Error string `json:"error,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	clusterId := d.config.ClusterId
+	if clusterId == "" {
+		clusterId = "local"
+	}
+	namespace := d.config.Namespace
+	if namespace == "" {
+		namespace = "cattle-system"
+	}
+
+	// 未指定集群列表和项目列表时，仅部署到单个集群
+	if len(d.config.ClusterIds) == 0 && len(d.config.ProjectIds) == 0 {
+		client, err := createK8sClient(d.config.ServerUrl, d.config.ApiToken, clusterId, d.config.AllowInsecureConnections)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to create k8s client")
+		}
+
+		if err := d.deployToNamespace(ctx, client, namespace, certPem, privkeyPem); err != nil {
+			return nil, err
+		}
+
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 解析部署目标，每个集群只创建一个客户端
+	clients := make(map[string]*kubernetes.Clientset)
+	getClient := func(clusterId string) (*kubernetes.Clientset, error) {
+		if client, ok := clients[clusterId]; ok {
+			return client, nil
+		}
+
+		client, err := createK8sClient(d.config.ServerUrl, d.config.ApiToken, clusterId, d.config.AllowInsecureConnections)
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to create k8s client for cluster '%s'", clusterId)
+		}
+
+		clients[clusterId] = client
+		return client, nil
+	}
+
+	targetResults := make([]*DeployerTargetResult, 0)
+	if len(d.config.ProjectIds) > 0 {
+		for _, projectId := range d.config.ProjectIds {
+			projectClusterId, projectShortId, ok := strings.Cut(projectId, ":")
+			if !ok || projectClusterId == "" || projectShortId == "" {
+				return nil, fmt.Errorf("invalid rancher project id '%s'", projectId)
+			}
+
+			client, err := getClient(projectClusterId)
+			if err != nil {
+				return nil, err
+			}
+
+			// Rancher 通过 "field.cattle.io/projectId" 标签标识命名空间所属的项目
+			namespaces, err := client.CoreV1().Namespaces().List(ctx, k8sMeta.ListOptions{LabelSelector: "field.cattle.io/projectId=" + projectShortId})
+			if err != nil {
+				return nil, xerrors.Wrapf(err, "failed to list namespaces of rancher project '%s'", projectId)
+			}
+
+			for _, ns := range namespaces.Items {
+				targetResults = append(targetResults, &DeployerTargetResult{ClusterId: projectClusterId, Namespace: ns.Name})
+			}
+		}
+
+		if len(targetResults) == 0 {
+			return nil, errors.New("no namespaces found in the specified rancher projects")
+		}
+	} else {
+		for _, clusterId := range d.config.ClusterIds {
+			if _, err := getClient(clusterId); err != nil {
+				return nil, err
+			}
+
+			targetResults = append(targetResults, &DeployerTargetResult{ClusterId: clusterId, Namespace: namespace})
+		}
+	}
+
+	// 部署到每个目标，单个目标部署失败不会中断其他目标
+	concurrent.ForEach(ctx, targetResults, 0, func(ctx context.Context, targetResult *DeployerTargetResult) error {
+		target := fmt.Sprintf("%s/%s", targetResult.ClusterId, targetResult.Namespace)
+		if err := d.deployToNamespace(ctx, clients[targetResult.ClusterId], targetResult.Namespace, certPem, privkeyPem); err != nil {
+			targetResult.Error = err.Error()
+			d.logger.Logt(fmt.Sprintf("failed to deploy to '%s'", target), err.Error())
+			return err
+		}
+
+		targetResult.Succeeded = true
+		d.logger.Logt(fmt.Sprintf("deployed to '%s'", target))
+		return nil
+	})
+
+	result := &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"targets": targetResults,
+		},
+	}
+
+	failedTargets := make([]string, 0)
+	for _, targetResult := range targetResults {
+		if !targetResult.Succeeded {
+			failedTargets = append(failedTargets, fmt.Sprintf("%s/%s", targetResult.ClusterId, targetResult.Namespace))
+		}
+	}
+	if len(failedTargets) > 0 {
+		if d.config.AllowPartialFailure && len(failedTargets) < len(targetResults) {
+			return result, nil
+		}
+
+		return result, fmt.Errorf("failed to deploy to %d of %d target(s): %s", len(failedTargets), len(targetResults), strings.Join(failedTargets, ", "))
+	}
+
+	return result, nil
+}
+
+func (d *DeployerProvider) deployToNamespace(ctx context.Context, client *kubernetes.Clientset, namespace string, certPem string, privkeyPem string) error {
+	secretName := d.config.SecretName
+	if secretName == "" {
+		secretName = "tls-rancher-ingress"
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return err
+	}
+
+	secretAnnotations := map[string]string{
+		"certimate/common-name":       certX509.Subject.CommonName,
+		"certimate/subject-sn":        certX509.Subject.SerialNumber,
+		"certimate/subject-alt-names": strings.Join(certX509.DNSNames, ","),
+		"certimate/issuer-sn":         certX509.Issuer.SerialNumber,
+		"certimate/issuer-org":        strings.Join(certX509.Issuer.Organization, ","),
+	}
+
+	// 获取 Secret 实例，如果不存在则创建
+	secretPayload, err := client.CoreV1().Secrets(namespace).Get(ctx, secretName, k8sMeta.GetOptions{})
+	if err != nil {
+		if !k8sErrors.IsNotFound(err) {
+			return xerrors.Wrap(err, "failed to get k8s secret")
+		}
+
+		secretPayload = &k8sCore.Secret{
+			TypeMeta: k8sMeta.TypeMeta{
+				Kind:       "Secret",
+				APIVersion: "v1",
+			},
+			ObjectMeta: k8sMeta.ObjectMeta{
+				Name:        secretName,
+				Annotations: secretAnnotations,
+			},
+			Type: k8sCore.SecretTypeTLS,
+			Data: map[string][]byte{
+				k8sCore.TLSCertKey:       []byte(certPem),
+				k8sCore.TLSPrivateKeyKey: []byte(privkeyPem),
+			},
+		}
+
+		secretPayload, err = client.CoreV1().Secrets(namespace).Create(ctx, secretPayload, k8sMeta.CreateOptions{})
+		if err != nil {
+			return xerrors.Wrap(err, "failed to create k8s secret")
+		}
+
+		d.logger.Logt("k8s secret created", secretPayload.ObjectMeta)
+		return nil
+	}
+
+	// 更新 Secret 实例
+	if secretPayload.Type != k8sCore.SecretTypeTLS && secretPayload.Type != k8sCore.SecretTypeOpaque {
+		return errors.New("unsupported k8s secret type: " + string(secretPayload.Type))
+	}
+	if secretPayload.ObjectMeta.Annotations == nil {
+		secretPayload.ObjectMeta.Annotations = secretAnnotations
+	} else {
+		for k, v := range secretAnnotations {
+			secretPayload.ObjectMeta.Annotations[k] = v
+		}
+	}
+	if secretPayload.Data == nil {
+		secretPayload.Data = make(map[string][]byte)
+	}
+	secretPayload.Data[k8sCore.TLSCertKey] = []byte(certPem)
+	secretPayload.Data[k8sCore.TLSPrivateKeyKey] = []byte(privkeyPem)
+	secretPayload, err = client.CoreV1().Secrets(namespace).Update(ctx, secretPayload, k8sMeta.UpdateOptions{})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to update k8s secret")
+	}
+
+	d.logger.Logt("k8s secret updated", secretPayload.ObjectMeta)
+
+	return nil
+}
+
+func createK8sClient(serverUrl, apiToken, clusterId string, skipTlsVerify bool) (*kubernetes.Clientset, error) {
+	if serverUrl == "" {
+		return nil, errors.New("rancher: server url is required")
+	}
+	if apiToken == "" {
+		return nil, errors.New("rancher: api token is required")
+	}
+
+	// Rancher 通过 "/k8s/clusters/{clusterId}" 代理下游集群的 Kubernetes API
+	config := &rest.Config{
+		Host:        strings.TrimRight(serverUrl, "/") + "/k8s/clusters/" + clusterId,
+		BearerToken: apiToken,
+		TLSClientConfig: rest.TLSClientConfig{
+			Insecure: skipTlsVerify,
+		},
+	}
+
+	client, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
@@ -0,0 +1,85 @@
+package ranchersecret_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/rancher-secret"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fApiToken      string
+	fClusterId     string
+	fNamespace     string
+	fSecretName    string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_RANCHERSECRET_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+	flag.StringVar(&fClusterId, argsPrefix+"CLUSTERID", "local", "")
+	flag.StringVar(&fNamespace, argsPrefix+"NAMESPACE", "cattle-system", "")
+	flag.StringVar(&fSecretName, argsPrefix+"SECRETNAME", "tls-rancher-ingress", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./rancher_secret_test.go -args \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_SERVERURL="https://rancher.example.com" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_APITOKEN="token-xxxxx:your-secret" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_CLUSTERID="local" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_NAMESPACE="cattle-system" \
+	--CERTIMATE_DEPLOYER_RANCHERSECRET_SECRETNAME="tls-rancher-ingress"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+			fmt.Sprintf("CLUSTERID: %v", fClusterId),
+			fmt.Sprintf("NAMESPACE: %v", fNamespace),
+			fmt.Sprintf("SECRETNAME: %v", fSecretName),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:  fServerUrl,
+			ApiToken:   fApiToken,
+			ClusterId:  fClusterId,
+			Namespace:  fNamespace,
+			SecretName: fSecretName,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,141 @@
+package softether
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"net/url"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	softethersdk "github.com/usual2970/certimate/internal/pkg/vendors/softether-sdk"
+)
+
+type DeployerConfig struct {
+	// SoftEther VPN Server 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// SoftEther VPN Server 管理密码。
+	AdminPassword string `json:"adminPassword"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *softethersdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.AdminPassword, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 获取当前服务器证书
+	// REF: https://github.com/SoftEtherVPN/SoftEtherVPN/tree/master/developer_tools/vpnserver-jsonrpc-clients#getservercert
+	getServerCertReq := &softethersdk.GetServerCertRequest{}
+	getServerCertResp, err := d.sdkClient.GetServerCert(getServerCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'softether.GetServerCert'")
+	}
+
+	// 如果证书未发生变化，则跳过
+	if getServerCertResp.Result != nil {
+		if currentCertX509, err := parseCertificateFromBase64(getServerCertResp.Result.Cert); err == nil && certs.EqualCertificate(currentCertX509, certX509) {
+			d.logger.Logt("server certificate is already up to date, skipped")
+			return &deployer.DeployResult{}, nil
+		}
+	}
+
+	// 仅校验模式下只检查连通性及管理权限，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("dry run: softether is reachable, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 设置服务器证书
+	// SoftEther 同时接受 DER 及 PEM 格式的证书和私钥，此处直接传递 PEM 内容
+	// REF: https://github.com/SoftEtherVPN/SoftEtherVPN/tree/master/developer_tools/vpnserver-jsonrpc-clients#setservercert
+	setServerCertReq := &softethersdk.SetServerCertRequest{
+		KeyPair: softethersdk.KeyPair{
+			Cert: base64.StdEncoding.EncodeToString([]byte(certPem)),
+			Key:  base64.StdEncoding.EncodeToString([]byte(privkeyPem)),
+		},
+	}
+	setServerCertResp, err := d.sdkClient.SetServerCert(setServerCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'softether.SetServerCert'")
+	}
+
+	d.logger.Logt("已设置服务器证书", setServerCertResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(serverUrl, adminPassword string, allowInsecure bool) (*softethersdk.Client, error) {
+	if _, err := url.Parse(serverUrl); err != nil {
+		return nil, errors.New("invalid softether server url")
+	}
+
+	if adminPassword == "" {
+		return nil, errors.New("invalid softether admin password")
+	}
+
+	client := softethersdk.NewClient(serverUrl, adminPassword)
+	if allowInsecure {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
+
+func parseCertificateFromBase64(data string) (*x509.Certificate, error) {
+	raw, err := base64.StdEncoding.DecodeString(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if block, _ := pem.Decode(raw); block != nil {
+		raw = block.Bytes
+	}
+
+	return x509.ParseCertificate(raw)
+}
@@ -0,0 +1,202 @@
+package sophosfirewall
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	sophossdk "github.com/usual2970/certimate/internal/pkg/vendors/sophos-sdk"
+)
+
+type DeployerConfig struct {
+	// Sophos Firewall Web 管理控制台地址。
+	ServerUrl string `json:"serverUrl"`
+	// Sophos Firewall 用户名。
+	Username string `json:"username"`
+	// Sophos Firewall 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书名称前缀。
+	// 零值时默认为 "certimate"。
+	CertificateNamePrefix string `json:"certificateNamePrefix,omitempty"`
+	// 是否将证书设置为 Web 管理控制台证书。
+	// 为 false 时仅在管理控制台正在使用由本部署器上传的旧证书时才会替换。
+	BindWebAdmin bool `json:"bindWebAdmin,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *sophossdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	certNamePrefix := d.config.CertificateNamePrefix
+	if certNamePrefix == "" {
+		certNamePrefix = "certimate"
+	}
+
+	// 获取已有证书列表，找出此前由本部署器上传的证书
+	// REF: https://docs.sophos.com/nsg/sophos-firewall/latest/API/index.html
+	certificates, err := d.sdkClient.Get("Certificate")
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'Get Certificate'")
+	}
+
+	oldCertNames := make(map[string]bool)
+	for _, certificate := range certificates {
+		name := certificate.Text("Name")
+		if strings.HasPrefix(name, certNamePrefix+"-") {
+			oldCertNames[name] = true
+		}
+	}
+
+	d.logger.Logt("已获取证书列表", len(certificates))
+
+	// 仅校验模式下只检查登录及证书列表，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 每次上传均使用新的证书名称，以免与正在使用的证书冲突
+	certName := fmt.Sprintf("%s-%d", certNamePrefix, time.Now().Unix())
+	pfxPassword := fmt.Sprintf("certimate%d", time.Now().UnixNano())
+	pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, pfxPassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+	}
+
+	if err := d.sdkClient.UploadCertificate(certName, pfxData, pfxPassword); err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'Set Certificate'")
+	}
+
+	d.logger.Logt("已上传证书", certName)
+
+	// 替换 Web 管理控制台证书
+	adminSettingsList, err := d.sdkClient.Get("AdminSettings")
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'Get AdminSettings'")
+	}
+
+	webAdminUpdated := false
+	for _, adminSettings := range adminSettingsList {
+		webAdminSettings := adminSettings.Find("WebAdminSettings")
+		if webAdminSettings == nil {
+			continue
+		}
+
+		certificate := webAdminSettings.Find("Certificate")
+		if certificate == nil {
+			continue
+		}
+
+		if d.config.BindWebAdmin || oldCertNames[strings.TrimSpace(certificate.Content)] {
+			certificate.Content = certName
+
+			// 仅写回 Web 管理控制台设置，以免影响其他设置
+			updateNode := sophossdk.NewNode("AdminSettings", "")
+			updateNode.Nodes = []*sophossdk.Node{webAdminSettings}
+			if err := d.sdkClient.Set("update", []*sophossdk.Node{updateNode}); err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'Set AdminSettings'")
+			}
+
+			webAdminUpdated = true
+			d.logger.Logt("已替换 Web 管理控制台证书", certName)
+		}
+	}
+
+	// 替换 WAF 规则中引用的旧证书
+	updatedRuleNames := make([]string, 0)
+	if len(oldCertNames) > 0 {
+		firewallRules, err := d.sdkClient.Get("FirewallRule")
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'Get FirewallRule'")
+		}
+
+		for _, firewallRule := range firewallRules {
+			changed := false
+			firewallRule.Walk(func(node *sophossdk.Node) {
+				if node.XMLName.Local == "Certificate" && len(node.Nodes) == 0 && oldCertNames[strings.TrimSpace(node.Content)] {
+					node.Content = certName
+					changed = true
+				}
+			})
+			if !changed {
+				continue
+			}
+
+			if err := d.sdkClient.Set("update", []*sophossdk.Node{firewallRule}); err != nil {
+				return nil, xerrors.Wrapf(err, "failed to execute sdk request 'Set FirewallRule' (name: %s)", firewallRule.Text("Name"))
+			}
+
+			updatedRuleNames = append(updatedRuleNames, firewallRule.Text("Name"))
+			d.logger.Logt(fmt.Sprintf("已替换 WAF 规则 '%s' 的证书", firewallRule.Text("Name")), certName)
+		}
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certificateName":  certName,
+			"webAdminUpdated":  webAdminUpdated,
+			"updatedRuleNames": updatedRuleNames,
+		},
+	}, nil
+}
+
+func createSdkClient(serverUrl, username, password string, skipTlsVerify bool) (*sophossdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid sophos server url")
+	}
+
+	if username == "" || password == "" {
+		return nil, errors.New("invalid sophos credentials")
+	}
+
+	client := sophossdk.NewClient(serverUrl, username, password).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
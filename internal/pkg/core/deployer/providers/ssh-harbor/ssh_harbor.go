@@ -0,0 +1,144 @@
+package sshharbor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	ussh "github.com/usual2970/certimate/internal/pkg/utils/ssh"
+)
+
+type DeployerConfig struct {
+	// SSH 主机。
+	// 零值时默认为 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认为 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机列表。
+	// 选填。按顺序逐级连接，最后经由最末一台跳板机连接到目标主机。
+	JumpServers []ussh.JumpServerConfig `json:"jumpServers,omitempty"`
+	// 是否回退使用 SCP。
+	UseSCP bool `json:"useSCP,omitempty"`
+	// Harbor 安装目录，即 docker-compose.yml 所在目录。
+	HarborDir string `json:"harborDir"`
+	// 证书文件路径。
+	// 零值时默认为 "/data/secret/cert/server.crt"。
+	CertPath string `json:"certPath,omitempty"`
+	// 私钥文件路径。
+	// 零值时默认为 "/data/secret/cert/server.key"。
+	KeyPath string `json:"keyPath,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.HarborDir == "" {
+		return nil, errors.New("config `harborDir` is required")
+	}
+
+	// Harbor 的 prepare 脚本会将 harbor.yml 中配置的证书复制到数据卷的 "secret/cert" 目录下，由 proxy 组件读取
+	// 因此直接替换该目录下的文件，再重启 proxy 组件即可，无需重新执行 prepare 脚本
+	// REF: https://goharbor.io/docs/main/install-config/configure-https/
+	certPath := d.config.CertPath
+	if certPath == "" {
+		certPath = "/data/secret/cert/server.crt"
+	}
+	keyPath := d.config.KeyPath
+	if keyPath == "" {
+		keyPath = "/data/secret/cert/server.key"
+	}
+
+	// 连接
+	client, closeClient, err := ussh.NewClient(
+		d.config.JumpServers,
+		d.config.SshHost,
+		d.config.SshPort,
+		d.config.SshUsername,
+		d.config.SshPassword,
+		d.config.SshKey,
+		d.config.SshKeyPassphrase,
+	)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer closeClient()
+
+	d.logger.Logt("SSH connected", d.config.SshHost)
+
+	// 检查 Harbor 安装目录
+	checkCommand := fmt.Sprintf("test -f %s || test -f %s", ussh.QuoteShellString(path.Join(d.config.HarborDir, "docker-compose.yml")), ussh.QuoteShellString(path.Join(d.config.HarborDir, "compose.yml")))
+	if stdout, stderr, err := ussh.ExecCommand(client, checkCommand, nil); err != nil {
+		return nil, xerrors.Wrapf(err, "failed to find docker compose file in harbor directory '%s', stdout: %s, stderr: %s", d.config.HarborDir, stdout, stderr)
+	}
+
+	// 仅校验模式下只检查 Harbor 安装目录，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书和私钥文件
+	if err := ussh.WriteFile(client, d.config.UseSCP, certPath, []byte(certPem), 0); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", certPath)
+
+	if err := ussh.WriteFile(client, d.config.UseSCP, keyPath, []byte(privkeyPem), 0); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload private key file")
+	}
+
+	d.logger.Logt("private key file uploaded", keyPath)
+
+	// 重启 proxy 组件，兼容 Docker Compose V1 和 V2
+	restartCommand := fmt.Sprintf("cd %s && (docker compose restart proxy || docker-compose restart proxy)", ussh.QuoteShellString(d.config.HarborDir))
+	stdout, stderr, err := ussh.ExecCommand(client, restartCommand, nil)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to restart harbor proxy, stdout: %s, stderr: %s", stdout, stderr)
+	}
+
+	d.logger.Logt("harbor proxy restarted", stdout)
+
+	return &deployer.DeployResult{}, nil
+}
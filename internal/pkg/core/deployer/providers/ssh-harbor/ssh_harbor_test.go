@@ -0,0 +1,85 @@
+package sshharbor_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-harbor"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fSshHost       string
+	fSshPort       int64
+	fSshUsername   string
+	fSshPassword   string
+	fHarborDir     string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_SSHHARBOR_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fSshHost, argsPrefix+"SSHHOST", "", "")
+	flag.Int64Var(&fSshPort, argsPrefix+"SSHPORT", 0, "")
+	flag.StringVar(&fSshUsername, argsPrefix+"SSHUSERNAME", "", "")
+	flag.StringVar(&fSshPassword, argsPrefix+"SSHPASSWORD", "", "")
+	flag.StringVar(&fHarborDir, argsPrefix+"HARBORDIR", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./ssh_harbor_test.go -args \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_SSHHOST="localhost" \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_SSHPORT=22 \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_SSHUSERNAME="root" \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_SSHPASSWORD="password" \
+	--CERTIMATE_DEPLOYER_SSHHARBOR_HARBORDIR="/opt/harbor"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SSHHOST: %v", fSshHost),
+			fmt.Sprintf("SSHPORT: %v", fSshPort),
+			fmt.Sprintf("SSHUSERNAME: %v", fSshUsername),
+			fmt.Sprintf("SSHPASSWORD: %v", fSshPassword),
+			fmt.Sprintf("HARBORDIR: %v", fHarborDir),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			SshHost:     fSshHost,
+			SshPort:     int32(fSshPort),
+			SshUsername: fSshUsername,
+			SshPassword: fSshPassword,
+			HarborDir:   fHarborDir,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -0,0 +1,250 @@
+package sshmailserver
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	"golang.org/x/crypto/ssh"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	ussh "github.com/usual2970/certimate/internal/pkg/utils/ssh"
+)
+
+type DeployerConfig struct {
+	// SSH 主机。
+	// 零值时默认为 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认为 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机列表。
+	// 选填。按顺序逐级连接，最后经由最末一台跳板机连接到目标主机。
+	JumpServers []ussh.JumpServerConfig `json:"jumpServers,omitempty"`
+	// 是否回退使用 SCP。
+	UseSCP bool `json:"useSCP,omitempty"`
+	// Postfix 证书文件路径。
+	// 选填。零值时通过 `postconf` 读取 smtpd_tls_cert_file 配置项。
+	PostfixCertPath string `json:"postfixCertPath,omitempty"`
+	// Postfix 私钥文件路径。
+	// 选填。零值时通过 `postconf` 读取 smtpd_tls_key_file 配置项。
+	PostfixKeyPath string `json:"postfixKeyPath,omitempty"`
+	// Dovecot 证书文件路径。
+	// 选填。零值时通过 `doveconf` 读取 ssl_cert 配置项。
+	DovecotCertPath string `json:"dovecotCertPath,omitempty"`
+	// Dovecot 私钥文件路径。
+	// 选填。零值时通过 `doveconf` 读取 ssl_key 配置项。
+	DovecotKeyPath string `json:"dovecotKeyPath,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 连接
+	client, closeClient, err := ussh.NewClient(
+		d.config.JumpServers,
+		d.config.SshHost,
+		d.config.SshPort,
+		d.config.SshUsername,
+		d.config.SshPassword,
+		d.config.SshKey,
+		d.config.SshKeyPassphrase,
+	)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer closeClient()
+
+	d.logger.Logt("SSH connected", d.config.SshHost)
+
+	// 确定 Postfix 与 Dovecot 的证书路径，未指定时从现有配置中读取
+	// REF: https://www.postfix.org/postconf.5.html#smtpd_tls_cert_file
+	// REF: https://doc.dovecot.org/configuration_manual/dovecot_ssl_configuration/
+	postfixCertPath := d.config.PostfixCertPath
+	if postfixCertPath == "" {
+		if postfixCertPath, err = readPostfixConfig(client, "smtpd_tls_cert_file"); err != nil {
+			return nil, err
+		}
+	}
+	postfixKeyPath := d.config.PostfixKeyPath
+	if postfixKeyPath == "" {
+		if postfixKeyPath, err = readPostfixConfig(client, "smtpd_tls_key_file"); err != nil {
+			return nil, err
+		}
+	}
+	dovecotCertPath := d.config.DovecotCertPath
+	if dovecotCertPath == "" {
+		if dovecotCertPath, err = readDovecotConfig(client, "ssl_cert"); err != nil {
+			return nil, err
+		}
+	}
+	dovecotKeyPath := d.config.DovecotKeyPath
+	if dovecotKeyPath == "" {
+		if dovecotKeyPath, err = readDovecotConfig(client, "ssl_key"); err != nil {
+			return nil, err
+		}
+	}
+
+	d.logger.Logt("postfix certificate paths resolved", []string{postfixCertPath, postfixKeyPath})
+	d.logger.Logt("dovecot certificate paths resolved", []string{dovecotCertPath, dovecotKeyPath})
+
+	// 仅校验模式下只解析证书路径，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 两个服务可能共用同一组文件，按路径去重
+	files := make([]*mailFile, 0, 4)
+	for _, f := range []*mailFile{
+		{Path: postfixCertPath, Data: []byte(certPem)},
+		{Path: postfixKeyPath, Data: []byte(privkeyPem), Private: true},
+		{Path: dovecotCertPath, Data: []byte(certPem)},
+		{Path: dovecotKeyPath, Data: []byte(privkeyPem), Private: true},
+	} {
+		duplicated := false
+		for _, e := range files {
+			if e.Path == f.Path {
+				if !bytes.Equal(e.Data, f.Data) {
+					return nil, fmt.Errorf("conflicting certificate and private key path '%s'", f.Path)
+				}
+				duplicated = true
+				break
+			}
+		}
+		if !duplicated {
+			files = append(files, f)
+		}
+	}
+
+	// 先上传到临时文件，避免覆盖过程中服务读取到不完整的文件
+	for _, f := range files {
+		if err := ussh.WriteFile(client, d.config.UseSCP, f.Path+stagingSuffix, f.Data, 0); err != nil {
+			return nil, xerrors.Wrapf(err, "failed to upload file '%s'", f.Path)
+		}
+
+		d.logger.Logt("file uploaded", f.Path+stagingSuffix)
+	}
+
+	// 备份、替换、校验配置并重载服务，任一步骤失败都会回滚到原有文件，确保两个服务始终使用同一份证书
+	stdout, stderr, err := ussh.ExecCommand(client, buildSwapCommand(files), nil)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to replace certificate files and reload mail services, stdout: %s, stderr: %s", stdout, stderr)
+	}
+
+	d.logger.Logt("postfix and dovecot reloaded", stdout)
+
+	return &deployer.DeployResult{}, nil
+}
+
+const (
+	stagingSuffix = ".certimate.new"
+	backupSuffix  = ".certimate.bak"
+)
+
+type mailFile struct {
+	Path    string
+	Data    []byte
+	Private bool
+}
+
+func readPostfixConfig(sshCli *ssh.Client, key string) (string, error) {
+	stdout, stderr, err := ussh.ExecCommand(sshCli, fmt.Sprintf("postconf -h %s", key), nil)
+	if err != nil {
+		return "", xerrors.Wrapf(err, "failed to read postfix config '%s', stdout: %s, stderr: %s", key, stdout, stderr)
+	}
+
+	value := strings.TrimSpace(stdout)
+	if value == "" {
+		return "", fmt.Errorf("postfix config '%s' is empty", key)
+	}
+
+	return value, nil
+}
+
+func readDovecotConfig(sshCli *ssh.Client, key string) (string, error) {
+	stdout, stderr, err := ussh.ExecCommand(sshCli, fmt.Sprintf("doveconf -h %s", key), nil)
+	if err != nil {
+		return "", xerrors.Wrapf(err, "failed to read dovecot config '%s', stdout: %s, stderr: %s", key, stdout, stderr)
+	}
+
+	// Dovecot 以 "<" 前缀表示从文件读取内容
+	value := strings.TrimPrefix(strings.TrimSpace(stdout), "<")
+	if value == "" {
+		return "", fmt.Errorf("dovecot config '%s' is empty", key)
+	}
+
+	return value, nil
+}
+
+func buildSwapCommand(files []*mailFile) string {
+	var restore, cleanup strings.Builder
+	for _, f := range files {
+		p := ussh.QuoteShellString(f.Path)
+		b := ussh.QuoteShellString(f.Path + backupSuffix)
+		s := ussh.QuoteShellString(f.Path + stagingSuffix)
+		restore.WriteString(fmt.Sprintf("rm -f %s; if [ -f %s ]; then mv -f %s %s; else rm -f %s; fi; ", s, b, b, p, p))
+		cleanup.WriteString(fmt.Sprintf("rm -f %s; ", b))
+	}
+
+	var sb strings.Builder
+	sb.WriteString("rollback() { " + restore.String() + "}; ")
+	for _, f := range files {
+		p := ussh.QuoteShellString(f.Path)
+		b := ussh.QuoteShellString(f.Path + backupSuffix)
+		s := ussh.QuoteShellString(f.Path + stagingSuffix)
+		if f.Private {
+			sb.WriteString(fmt.Sprintf("chmod 600 %s || exit 1; ", s))
+		} else {
+			sb.WriteString(fmt.Sprintf("chmod 644 %s || exit 1; ", s))
+		}
+		sb.WriteString(fmt.Sprintf("if [ -f %s ]; then cp -p %s %s || exit 1; fi; ", p, p, b))
+	}
+	for _, f := range files {
+		p := ussh.QuoteShellString(f.Path)
+		s := ussh.QuoteShellString(f.Path + stagingSuffix)
+		sb.WriteString(fmt.Sprintf("mv -f %s %s || { rollback; exit 1; }; ", s, p))
+	}
+	sb.WriteString("postfix check && postconf -n > /dev/null && doveconf -n > /dev/null || { echo 'mail config validation failed' >&2; rollback; exit 1; }; ")
+	sb.WriteString("postfix reload && doveadm reload || { echo 'mail services reload failed' >&2; rollback; postfix reload; doveadm reload; exit 1; }; ")
+	sb.WriteString(cleanup.String())
+	return strings.TrimSpace(sb.String())
+}
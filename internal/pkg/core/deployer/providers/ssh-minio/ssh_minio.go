@@ -0,0 +1,155 @@
+package sshminio
+
+import (
+	"context"
+	"fmt"
+	"path"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	ussh "github.com/usual2970/certimate/internal/pkg/utils/ssh"
+)
+
+type DeployerConfig struct {
+	// SSH 主机。
+	// 零值时默认为 "localhost"。
+	SshHost string `json:"sshHost,omitempty"`
+	// SSH 端口。
+	// 零值时默认为 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机列表。
+	// 选填。按顺序逐级连接，最后经由最末一台跳板机连接到目标主机。
+	JumpServers []ussh.JumpServerConfig `json:"jumpServers,omitempty"`
+	// 是否回退使用 SCP。
+	UseSCP bool `json:"useSCP,omitempty"`
+	// MinIO 证书目录。
+	// 零值时默认为 SSH 登录用户主目录下的 ".minio/certs"。
+	CertsDir string `json:"certsDir,omitempty"`
+	// 证书所对应的域名。
+	// 选填。非零值时将证书存放在以该域名命名的子目录中，以便 MinIO 通过 SNI 选择证书。
+	Domain string `json:"domain,omitempty"`
+	// 上传证书后执行的命令，用于重启 MinIO 服务。
+	// 选填。零值时不执行命令，由 MinIO 自动重新加载证书。
+	PostCommand string `json:"postCommand,omitempty"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain != "" {
+		if strings.ContainsAny(d.config.Domain, "/\\") {
+			return nil, fmt.Errorf("invalid domain '%s'", d.config.Domain)
+		}
+
+		certX509, err := certs.ParseCertificateFromPEM(certPem)
+		if err != nil {
+			return nil, err
+		} else if !certs.IsCertificateCoversHostname(certX509, d.config.Domain) {
+			return nil, fmt.Errorf("certificate does not cover domain '%s'", d.config.Domain)
+		}
+	}
+
+	certsDir := d.config.CertsDir
+	if certsDir == "" {
+		certsDir = ".minio/certs"
+	}
+	if d.config.Domain != "" {
+		certsDir = path.Join(certsDir, d.config.Domain)
+	}
+
+	// 连接
+	client, closeClient, err := ussh.NewClient(
+		d.config.JumpServers,
+		d.config.SshHost,
+		d.config.SshPort,
+		d.config.SshUsername,
+		d.config.SshPassword,
+		d.config.SshKey,
+		d.config.SshKeyPassphrase,
+	)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssh client")
+	}
+	defer closeClient()
+
+	d.logger.Logt("SSH connected", d.config.SshHost)
+
+	// 仅校验模式下只检查连接，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书和私钥文件
+	// MinIO 要求证书和私钥文件分别命名为 "public.crt" 和 "private.key"
+	// REF: https://min.io/docs/minio/linux/operations/network-encryption.html
+	certPath := path.Join(certsDir, "public.crt")
+	if err := ussh.WriteFile(client, d.config.UseSCP, certPath, []byte(certPem), 0); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", certPath)
+
+	keyPath := path.Join(certsDir, "private.key")
+	if err := ussh.WriteFile(client, d.config.UseSCP, keyPath, []byte(privkeyPem), 0); err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload private key file")
+	}
+
+	d.logger.Logt("private key file uploaded", keyPath)
+
+	// 执行后置命令
+	if d.config.PostCommand != "" {
+		stdout, stderr, err := ussh.ExecCommand(client, d.config.PostCommand, nil)
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to execute post-command, stdout: %s, stderr: %s", stdout, stderr)
+		}
+
+		d.logger.Logt("SSH post-command executed", stdout)
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certPath": certPath,
+			"keyPath":  keyPath,
+		},
+	}, nil
+}
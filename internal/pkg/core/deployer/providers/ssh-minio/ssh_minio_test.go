@@ -0,0 +1,90 @@
+package sshminio_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/ssh-minio"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fSshHost       string
+	fSshPort       int64
+	fSshUsername   string
+	fSshPassword   string
+	fCertsDir      string
+	fPostCommand   string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_SSHMINIO_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fSshHost, argsPrefix+"SSHHOST", "", "")
+	flag.Int64Var(&fSshPort, argsPrefix+"SSHPORT", 0, "")
+	flag.StringVar(&fSshUsername, argsPrefix+"SSHUSERNAME", "", "")
+	flag.StringVar(&fSshPassword, argsPrefix+"SSHPASSWORD", "", "")
+	flag.StringVar(&fCertsDir, argsPrefix+"CERTSDIR", "", "")
+	flag.StringVar(&fPostCommand, argsPrefix+"POSTCOMMAND", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./ssh_minio_test.go -args \
+	--CERTIMATE_DEPLOYER_SSHMINIO_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_SSHHOST="localhost" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_SSHPORT=22 \
+	--CERTIMATE_DEPLOYER_SSHMINIO_SSHUSERNAME="root" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_SSHPASSWORD="password" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_CERTSDIR="/root/.minio/certs" \
+	--CERTIMATE_DEPLOYER_SSHMINIO_POSTCOMMAND="systemctl restart minio"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SSHHOST: %v", fSshHost),
+			fmt.Sprintf("SSHPORT: %v", fSshPort),
+			fmt.Sprintf("SSHUSERNAME: %v", fSshUsername),
+			fmt.Sprintf("SSHPASSWORD: %v", fSshPassword),
+			fmt.Sprintf("CERTSDIR: %v", fCertsDir),
+			fmt.Sprintf("POSTCOMMAND: %v", fPostCommand),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			SshHost:     fSshHost,
+			SshPort:     int32(fSshPort),
+			SshUsername: fSshUsername,
+			SshPassword: fSshPassword,
+			CertsDir:    fCertsDir,
+			PostCommand: fPostCommand,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
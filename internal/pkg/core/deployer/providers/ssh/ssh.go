@@ -1,20 +1,20 @@
 package ssh
 
 import (
-	"bytes"
 	"context"
 	"fmt"
-	"os"
-	"path/filepath"
+	"path"
+	"strings"
+	"time"
 
 	xerrors "github.com/pkg/errors"
-	"github.com/pkg/sftp"
-	"github.com/povsister/scp"
 	"golang.org/x/crypto/ssh"
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
+	ussh "github.com/usual2970/certimate/internal/pkg/utils/ssh"
 )
 
 type DeployerConfig struct {
@@ -32,8 +32,16 @@ type DeployerConfig struct {
 	SshKey string `json:"sshKey,omitempty"`
 	// SSH 登录私钥口令。
 	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 跳板机列表。
+	// 选填。按顺序逐级连接，最后经由最末一台跳板机连接到目标主机。
+	JumpServers []ussh.JumpServerConfig `json:"jumpServers,omitempty"`
 	// 是否回退使用 SCP。
 	UseSCP bool `json:"useSCP,omitempty"`
+	// 是否使用 sudo 提权执行命令及写入文件。
+	UseSudo bool `json:"useSudo,omitempty"`
+	// sudo 密码。
+	// 选填。零值时沿用各主机的 SshPassword；若二者均为零值，则要求目标主机已配置免密 sudo。
+	SudoPassword string `json:"sudoPassword,omitempty"`
 	// 前置命令。
 	PreCommand string `json:"preCommand,omitempty"`
 	// 后置命令。
@@ -56,6 +64,46 @@ type DeployerConfig struct {
 	// JKS 存储密码。
 	// 证书格式为 JKS 时必填。
 	JksStorepass string `json:"jksStorepass,omitempty"`
+	// 主机列表。
+	// 选填。零值时仅部署到 SshHost 指定的主机；否则部署到列表中的每个主机，各主机的零值配置项将沿用上述全局配置。
+	Hosts []DeployerHostConfig `json:"hosts,omitempty"`
+	// 是否允许部分主机部署失败。
+	// 为 true 时，只要有任一主机部署成功即视为部署成功；否则任一主机部署失败均视为部署失败。
+	AllowPartialFailure bool `json:"allowPartialFailure,omitempty"`
+}
+
+type DeployerHostConfig struct {
+	// SSH 主机。
+	SshHost string `json:"sshHost"`
+	// SSH 端口。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	// 选填。与 SshKey 均为零值时沿用全局配置的登录凭据。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	// 选填。与 SshPassword 均为零值时沿用全局配置的登录凭据。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+	// 前置命令。
+	PreCommand string `json:"preCommand,omitempty"`
+	// 后置命令。
+	PostCommand string `json:"postCommand,omitempty"`
+	// 输出证书文件路径。
+	OutputCertPath string `json:"outputCertPath,omitempty"`
+	// 输出私钥文件路径。
+	OutputKeyPath string `json:"outputKeyPath,omitempty"`
+}
+
+type DeployerHostResult struct {
+	// SSH 主机。
+	Host string `json:"host"`
+	// 是否部署成功。
+	Succeeded bool `json:"succeeded"`
+	// 错误信息。
+	Error string `json:"error,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -81,47 +129,13 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 连接
-	client, err := createSshClient(
-		d.config.SshHost,
-		d.config.SshPort,
-		d.config.SshUsername,
-		d.config.SshPassword,
-		d.config.SshKey,
-		d.config.SshKeyPassphrase,
-	)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to create ssh client")
-	}
-	defer client.Close()
-
-	d.logger.Logt("SSH connected")
-
-	// 执行前置命令
-	if d.config.PreCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PreCommand)
-		if err != nil {
-			return nil, xerrors.Wrapf(err, "failed to execute pre-command: stdout: %s, stderr: %s", stdout, stderr)
-		}
-
-		d.logger.Logt("SSH pre-command executed", stdout)
-	}
-
-	// 上传证书和私钥文件
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 转换证书格式
+	var certData, keyData []byte
 	switch d.config.OutputFormat {
 	case OUTPUT_FORMAT_PEM:
-		if err := writeFileString(client, d.config.UseSCP, d.config.OutputCertPath, certPem); err != nil {
-			return nil, xerrors.Wrap(err, "failed to upload certificate file")
-		}
-
-		d.logger.Logt("certificate file uploaded")
-
-		if err := writeFileString(client, d.config.UseSCP, d.config.OutputKeyPath, privkeyPem); err != nil {
-			return nil, xerrors.Wrap(err, "failed to upload private key file")
-		}
-
-		d.logger.Logt("private key file uploaded")
+		certData = []byte(certPem)
+		keyData = []byte(privkeyPem)
 
 	case OUTPUT_FORMAT_PFX:
 		pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, d.config.PfxPassword)
@@ -129,159 +143,212 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
 		}
 
+		certData = pfxData
 		d.logger.Logt("certificate transformed to PFX")
 
-		if err := writeFile(client, d.config.UseSCP, d.config.OutputCertPath, pfxData); err != nil {
-			return nil, xerrors.Wrap(err, "failed to upload certificate file")
-		}
-
-		d.logger.Logt("certificate file uploaded")
-
 	case OUTPUT_FORMAT_JKS:
 		jksData, err := certs.TransformCertificateFromPEMToJKS(certPem, privkeyPem, d.config.JksAlias, d.config.JksKeypass, d.config.JksStorepass)
 		if err != nil {
 			return nil, xerrors.Wrap(err, "failed to transform certificate to JKS")
 		}
 
+		certData = jksData
 		d.logger.Logt("certificate transformed to JKS")
 
-		if err := writeFile(client, d.config.UseSCP, d.config.OutputCertPath, jksData); err != nil {
-			return nil, xerrors.Wrap(err, "failed to upload certificate file")
-		}
-
-		d.logger.Logt("certificate file uploaded")
-
 	default:
 		return nil, fmt.Errorf("unsupported output format: %s", d.config.OutputFormat)
 	}
 
-	// 执行后置命令
-	if d.config.PostCommand != "" {
-		stdout, stderr, err := execSshCommand(client, d.config.PostCommand)
-		if err != nil {
-			return nil, xerrors.Wrapf(err, "failed to execute post-command, stdout: %s, stderr: %s", stdout, stderr)
+	// 未指定主机列表时，仅部署到单个主机
+	if len(d.config.Hosts) == 0 {
+		if err := d.deployToHost(d.resolveHostConfig(DeployerHostConfig{}), certData, keyData); err != nil {
+			return nil, err
 		}
 
-		d.logger.Logt("SSH post-command executed", stdout)
+		return &deployer.DeployResult{}, nil
 	}
 
-	return &deployer.DeployResult{}, nil
-}
-
-func createSshClient(host string, port int32, username string, password string, key string, keyPassphrase string) (*ssh.Client, error) {
-	if host == "" {
-		host = "localhost"
+	// 部署到主机列表中的每个主机，单个主机部署失败不会中断其他主机
+	hostIndexes := make([]int, len(d.config.Hosts))
+	hostResults := make([]*DeployerHostResult, len(d.config.Hosts))
+	for i, hostConfig := range d.config.Hosts {
+		hostIndexes[i] = i
+		hostResults[i] = &DeployerHostResult{Host: d.resolveHostConfig(hostConfig).SshHost}
 	}
+	concurrent.ForEach(ctx, hostIndexes, 0, func(ctx context.Context, i int) error {
+		if err := d.deployToHost(d.resolveHostConfig(d.config.Hosts[i]), certData, keyData); err != nil {
+			hostResults[i].Error = err.Error()
+			d.logger.Logt(fmt.Sprintf("failed to deploy to host '%s'", hostResults[i].Host), err.Error())
+			return err
+		}
 
-	if port == 0 {
-		port = 22
-	}
+		hostResults[i].Succeeded = true
+		d.logger.Logt(fmt.Sprintf("deployed to host '%s'", hostResults[i].Host))
+		return nil
+	})
 
-	var authMethod ssh.AuthMethod
-	if key != "" {
-		var signer ssh.Signer
-		var err error
+	result := &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"hosts": hostResults,
+		},
+	}
 
-		if keyPassphrase != "" {
-			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(keyPassphrase))
-		} else {
-			signer, err = ssh.ParsePrivateKey([]byte(key))
+	failedHosts := make([]string, 0)
+	for _, hostResult := range hostResults {
+		if !hostResult.Succeeded {
+			failedHosts = append(failedHosts, hostResult.Host)
 		}
-
-		if err != nil {
-			return nil, err
+	}
+	if len(failedHosts) > 0 {
+		if d.config.AllowPartialFailure && len(failedHosts) < len(hostResults) {
+			return result, nil
 		}
-		authMethod = ssh.PublicKeys(signer)
-	} else {
-		authMethod = ssh.Password(password)
+
+		return result, fmt.Errorf("failed to deploy to %d of %d host(s): %s", len(failedHosts), len(hostResults), strings.Join(failedHosts, ", "))
 	}
 
-	return ssh.Dial("tcp", fmt.Sprintf("%s:%d", host, port), &ssh.ClientConfig{
-		User:            username,
-		Auth:            []ssh.AuthMethod{authMethod},
-		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
-	})
+	return result, nil
 }
 
-func execSshCommand(sshCli *ssh.Client, command string) (string, string, error) {
-	session, err := sshCli.NewSession()
-	if err != nil {
-		return "", "", err
+// 将主机配置与全局配置合并，零值字段沿用全局配置。
+func (d *DeployerProvider) resolveHostConfig(hostConfig DeployerHostConfig) DeployerHostConfig {
+	if hostConfig.SshHost == "" {
+		hostConfig.SshHost = d.config.SshHost
+	}
+	if hostConfig.SshHost == "" {
+		hostConfig.SshHost = "localhost"
+	}
+	if hostConfig.SshPort == 0 {
+		hostConfig.SshPort = d.config.SshPort
 	}
-	defer session.Close()
+	if hostConfig.SshPort == 0 {
+		hostConfig.SshPort = 22
+	}
+	if hostConfig.SshUsername == "" {
+		hostConfig.SshUsername = d.config.SshUsername
+	}
+	if hostConfig.SshPassword == "" && hostConfig.SshKey == "" {
+		// 登录凭据整体沿用，避免将主机的密码与全局的私钥混用
+		hostConfig.SshPassword = d.config.SshPassword
+		hostConfig.SshKey = d.config.SshKey
+		hostConfig.SshKeyPassphrase = d.config.SshKeyPassphrase
+	}
+	if hostConfig.OutputCertPath == "" {
+		hostConfig.OutputCertPath = d.config.OutputCertPath
+	}
+	if hostConfig.OutputKeyPath == "" {
+		hostConfig.OutputKeyPath = d.config.OutputKeyPath
+	}
+	if hostConfig.PreCommand == "" {
+		hostConfig.PreCommand = d.config.PreCommand
+	}
+	if hostConfig.PostCommand == "" {
+		hostConfig.PostCommand = d.config.PostCommand
+	}
+	return hostConfig
+}
 
-	stdoutBuf := bytes.NewBuffer(nil)
-	session.Stdout = stdoutBuf
-	stderrBuf := bytes.NewBuffer(nil)
-	session.Stderr = stderrBuf
-	err = session.Run(command)
+func (d *DeployerProvider) deployToHost(hostConfig DeployerHostConfig, certData []byte, keyData []byte) error {
+	// 连接
+	client, closeClient, err := ussh.NewClient(
+		d.config.JumpServers,
+		hostConfig.SshHost,
+		hostConfig.SshPort,
+		hostConfig.SshUsername,
+		hostConfig.SshPassword,
+		hostConfig.SshKey,
+		hostConfig.SshKeyPassphrase,
+	)
 	if err != nil {
-		return stdoutBuf.String(), stderrBuf.String(), xerrors.Wrap(err, "failed to execute ssh command")
+		return xerrors.Wrap(err, "failed to create ssh client")
 	}
+	defer closeClient()
 
-	return stdoutBuf.String(), stderrBuf.String(), nil
-}
+	d.logger.Logt("SSH connected", hostConfig.SshHost)
 
-func writeFileString(sshCli *ssh.Client, useSCP bool, path string, content string) error {
-	if useSCP {
-		return writeFileStringWithSCP(sshCli, path, content)
-	}
+	// 执行前置命令
+	if hostConfig.PreCommand != "" {
+		stdout, stderr, err := d.execCommand(client, hostConfig, hostConfig.PreCommand)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute pre-command: stdout: %s, stderr: %s", stdout, stderr)
+		}
 
-	return writeFileStringWithSFTP(sshCli, path, content)
-}
+		d.logger.Logt("SSH pre-command executed", stdout)
+	}
 
-func writeFile(sshCli *ssh.Client, useSCP bool, path string, data []byte) error {
-	if useSCP {
-		return writeFileWithSCP(sshCli, path, data)
+	// 上传证书和私钥文件
+	if err := d.writeFile(client, hostConfig, hostConfig.OutputCertPath, certData); err != nil {
+		return xerrors.Wrap(err, "failed to upload certificate file")
 	}
 
-	return writeFileWithSFTP(sshCli, path, data)
-}
+	d.logger.Logt("certificate file uploaded")
 
-func writeFileStringWithSCP(sshCli *ssh.Client, path string, content string) error {
-	return writeFileWithSCP(sshCli, path, []byte(content))
-}
+	if keyData != nil {
+		if err := d.writeFile(client, hostConfig, hostConfig.OutputKeyPath, keyData); err != nil {
+			return xerrors.Wrap(err, "failed to upload private key file")
+		}
 
-func writeFileWithSCP(sshCli *ssh.Client, path string, data []byte) error {
-	scpCli, err := scp.NewClientFromExistingSSH(sshCli, &scp.ClientOption{})
-	if err != nil {
-		return xerrors.Wrap(err, "failed to create scp client")
+		d.logger.Logt("private key file uploaded")
 	}
-	defer scpCli.Close()
 
-	reader := bytes.NewReader(data)
-	err = scpCli.CopyToRemote(reader, path, &scp.FileTransferOption{})
-	if err != nil {
-		return xerrors.Wrap(err, "failed to write to remote file")
+	// 执行后置命令
+	if hostConfig.PostCommand != "" {
+		stdout, stderr, err := d.execCommand(client, hostConfig, hostConfig.PostCommand)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute post-command, stdout: %s, stderr: %s", stdout, stderr)
+		}
+
+		d.logger.Logt("SSH post-command executed", stdout)
 	}
 
 	return nil
 }
 
-func writeFileStringWithSFTP(sshCli *ssh.Client, path string, content string) error {
-	return writeFileWithSFTP(sshCli, path, []byte(content))
-}
+func (d *DeployerProvider) execCommand(sshCli *ssh.Client, hostConfig DeployerHostConfig, command string) (string, string, error) {
+	if !d.config.UseSudo {
+		return ussh.ExecCommand(sshCli, command, nil)
+	}
 
-func writeFileWithSFTP(sshCli *ssh.Client, path string, data []byte) error {
-	sftpCli, err := sftp.NewClient(sshCli)
-	if err != nil {
-		return xerrors.Wrap(err, "failed to create sftp client")
+	sudoPassword := d.config.SudoPassword
+	if sudoPassword == "" {
+		sudoPassword = hostConfig.SshPassword
 	}
-	defer sftpCli.Close()
 
-	if err := sftpCli.MkdirAll(filepath.Dir(path)); err != nil {
-		return xerrors.Wrap(err, "failed to create remote directory")
+	// 未提供密码时使用非交互模式，避免远端等待输入而挂起
+	if sudoPassword == "" {
+		return ussh.ExecCommand(sshCli, "sudo -n sh -c "+ussh.QuoteShellString(command), nil)
 	}
 
-	file, err := sftpCli.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
-	if err != nil {
-		return xerrors.Wrap(err, "failed to open remote file")
+	return ussh.ExecCommand(sshCli, "sudo -S -p '' sh -c "+ussh.QuoteShellString(command), strings.NewReader(sudoPassword+"\n"))
+}
+
+func (d *DeployerProvider) writeFile(sshCli *ssh.Client, hostConfig DeployerHostConfig, filePath string, data []byte) error {
+	if !d.config.UseSudo {
+		return ussh.WriteFile(sshCli, d.config.UseSCP, filePath, data, 0)
+	}
+
+	// 使用 sudo 时，先以当前用户身份上传到临时文件，再提权写入目标路径
+	// 通过重定向而非移动文件写入，以保留目标文件原有的属主和权限
+	tempPath := fmt.Sprintf("/tmp/.certimate-%d-%s", time.Now().UnixNano(), path.Base(filePath))
+	if err := ussh.WriteFile(sshCli, d.config.UseSCP, tempPath, data, 0o600); err != nil {
+		return xerrors.Wrap(err, "failed to upload temporary file")
 	}
-	defer file.Close()
+	defer func() {
+		// 临时文件属于当前用户，无需 sudo 即可删除；无论提权写入是否成功都应删除，避免私钥残留
+		if stdout, stderr, err := ussh.ExecCommand(sshCli, "rm -f "+ussh.QuoteShellString(tempPath), nil); err != nil {
+			d.logger.Logt(fmt.Sprintf("failed to remove temporary file '%s'", tempPath), err.Error(), stdout, stderr)
+		}
+	}()
 
-	_, err = file.Write(data)
+	command := fmt.Sprintf(
+		"mkdir -p %s && cat %s > %s",
+		ussh.QuoteShellString(path.Dir(filePath)),
+		ussh.QuoteShellString(tempPath),
+		ussh.QuoteShellString(filePath),
+	)
+	stdout, stderr, err := d.execCommand(sshCli, hostConfig, command)
 	if err != nil {
-		return xerrors.Wrap(err, "failed to write to remote file")
+		return xerrors.Wrapf(err, "failed to move temporary file with sudo, stdout: %s, stderr: %s", stdout, stderr)
 	}
 
 	return nil
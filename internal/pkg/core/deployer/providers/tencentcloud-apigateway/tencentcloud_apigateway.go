@@ -0,0 +1,190 @@
+package tencentcloudapigateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/tencentcloud-ssl"
+	tcApiGateway "github.com/usual2970/certimate/internal/pkg/vendors/tencentcloud-sdk/apigateway"
+)
+
+type DeployerConfig struct {
+	// 腾讯云 SecretId。
+	SecretId string `json:"secretId"`
+	// 腾讯云 SecretKey。
+	SecretKey string `json:"secretKey"`
+	// 腾讯云地域。
+	Region string `json:"region"`
+	// API 网关服务 ID。
+	ServiceId string `json:"serviceId"`
+	// 自定义域名（不支持泛域名）。
+	SubDomain string `json:"subDomain"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *tcApiGateway.Client
+	sslUploader uploader.Uploader
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder  = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.SecretId, config.SecretKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		SecretId:  config.SecretId,
+		SecretKey: config.SecretKey,
+	})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	if u, ok := d.sslUploader.(*uploadersp.UploaderProvider); ok {
+		u.WithLogger(logger)
+	}
+	return d
+}
+
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ServiceId == "" {
+		return nil, errors.New("config `serviceId` is required")
+	}
+	if d.config.SubDomain == "" {
+		return nil, errors.New("config `subDomain` is required")
+	}
+
+	// 查询自定义域名的现有配置
+	subDomain, err := d.findSubDomain(d.config.ServiceId, d.config.SubDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	d.logger.Logt("已查询到自定义域名", subDomain)
+
+	// 仅校验模式下只查询自定义域名，不实际更新证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到 SSL
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	// 仅开启 HTTP 协议的自定义域名需同时开启 HTTPS 协议，否则证书不会生效
+	protocol := subDomain.Protocol
+	if !strings.Contains(protocol, "https") {
+		protocol = "http&https"
+	}
+
+	// 修改自定义域名
+	// REF: https://cloud.tencent.com/document/api/628/45175
+	modifySubDomainReq := &tcApiGateway.ModifySubDomainRequest{
+		ServiceId:        common.StringPtr(d.config.ServiceId),
+		SubDomain:        common.StringPtr(d.config.SubDomain),
+		IsDefaultMapping: common.BoolPtr(subDomain.IsDefaultMapping),
+		CertificateId:    common.StringPtr(upres.CertId),
+		Protocol:         common.StringPtr(protocol),
+		IsForcedHttps:    common.BoolPtr(subDomain.IsForcedHttps),
+	}
+	if subDomain.NetType != "" {
+		modifySubDomainReq.NetType = common.StringPtr(subDomain.NetType)
+	}
+	modifySubDomainResp, err := d.sdkClient.ModifySubDomain(modifySubDomainReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'apigateway.ModifySubDomain'")
+	}
+
+	d.logger.Logt("已修改自定义域名", modifySubDomainResp.Response)
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) findSubDomain(serviceId, subDomain string) (*tcApiGateway.DomainSetItem, error) {
+	describeServiceSubDomainsOffset := int64(0)
+	describeServiceSubDomainsLimit := int64(100)
+	for {
+		// 查询自定义域名列表
+		// REF: https://cloud.tencent.com/document/api/628/45184
+		describeServiceSubDomainsReq := &tcApiGateway.DescribeServiceSubDomainsRequest{
+			ServiceId: common.StringPtr(serviceId),
+			Offset:    common.Int64Ptr(describeServiceSubDomainsOffset),
+			Limit:     common.Int64Ptr(describeServiceSubDomainsLimit),
+		}
+		describeServiceSubDomainsResp, err := d.sdkClient.DescribeServiceSubDomains(describeServiceSubDomainsReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'apigateway.DescribeServiceSubDomains'")
+		}
+
+		if describeServiceSubDomainsResp.Response == nil || describeServiceSubDomainsResp.Response.Result == nil {
+			break
+		}
+
+		for _, domainItem := range describeServiceSubDomainsResp.Response.Result.DomainSet {
+			if strings.EqualFold(domainItem.DomainName, subDomain) {
+				return domainItem, nil
+			}
+		}
+
+		if len(describeServiceSubDomainsResp.Response.Result.DomainSet) < int(describeServiceSubDomainsLimit) {
+			break
+		} else {
+			describeServiceSubDomainsOffset += describeServiceSubDomainsLimit
+		}
+	}
+
+	return nil, fmt.Errorf("could not find sub domain '%s' in service '%s'", subDomain, serviceId)
+}
+
+func createSdkClient(secretId, secretKey, region string) (*tcApiGateway.Client, error) {
+	credential := common.NewCredential(secretId, secretKey)
+	client, err := tcApiGateway.NewClient(credential, region, profile.NewClientProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
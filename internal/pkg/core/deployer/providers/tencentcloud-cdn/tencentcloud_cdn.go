@@ -2,6 +2,8 @@
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"strings"
 
 	xerrors "github.com/pkg/errors"
@@ -15,6 +17,7 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/tencentcloud-ssl"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
 )
 
 type DeployerConfig struct {
@@ -24,6 +27,9 @@ type DeployerConfig struct {
 	SecretKey string `json:"secretKey"`
 	// 加速域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 是否部署到证书可覆盖的全部加速域名。
+	// 启用后将额外查询证书可关联的全部加速域名，并与证书的 SAN 进行匹配。
+	MatchCoveredDomains bool `json:"matchCoveredDomains,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -33,7 +39,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 type wSdkClients struct {
 	ssl *tcSsl.Client
@@ -68,10 +77,26 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 
 func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	d.logger = logger
+	if u, ok := d.sslUploader.(*uploadersp.UploaderProvider); ok {
+		u.WithLogger(logger)
+	}
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" && !d.config.MatchCoveredDomains {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
 	// 上传证书到 SSL
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -81,17 +106,22 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	d.logger.Logt("certificate file uploaded", upres)
 
 	// 获取待部署的 CDN 实例
-	// 如果是泛域名，根据证书匹配 CDN 实例
+	// 如果是泛域名或需部署到证书可覆盖的全部加速域名，根据证书匹配 CDN 实例
 	instanceIds := make([]string, 0)
-	if strings.HasPrefix(d.config.Domain, "*.") {
+	if d.config.Domain != "" && !strings.HasPrefix(d.config.Domain, "*.") {
+		instanceIds = append(instanceIds, d.config.Domain)
+	}
+	if strings.HasPrefix(d.config.Domain, "*.") || d.config.MatchCoveredDomains {
 		domains, err := d.getDomainsByCertificateId(upres.CertId)
 		if err != nil {
 			return nil, err
 		}
 
-		instanceIds = domains
-	} else {
-		instanceIds = append(instanceIds, d.config.Domain)
+		for _, domain := range domains {
+			if d.matchDomain(certX509, domain) && !slices.Contains(instanceIds, domain) {
+				instanceIds = append(instanceIds, domain)
+			}
+		}
 	}
 
 	// 跳过已部署的 CDN 实例
@@ -110,6 +140,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		instanceIds = temp
 	}
 
+	result := &deployer.DeployResult{CertId: upres.CertId}
 	if len(instanceIds) == 0 {
 		d.logger.Logt("已部署过或没有要部署的 CDN 实例")
 	} else {
@@ -126,9 +157,21 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		}
 
 		d.logger.Logt("已部署证书到云资源实例", deployCertificateInstanceResp.Response)
+
+		result.ResourceIds = instanceIds
+		result.RequestIds = append(result.RequestIds, *deployCertificateInstanceResp.Response.RequestId)
+	}
+
+	return result, nil
+}
+
+func (d *DeployerProvider) matchDomain(certX509 *x509.Certificate, domain string) bool {
+	// 泛域名仅匹配其下一级子域名
+	if strings.HasPrefix(d.config.Domain, "*.") && certs.MatchHostname(d.config.Domain, domain) {
+		return true
 	}
 
-	return &deployer.DeployResult{}, nil
+	return d.config.MatchCoveredDomains && certs.IsCertificateCoversHostname(certX509, domain)
 }
 
 func (d *DeployerProvider) getDomainsByCertificateId(cloudCertId string) ([]string, error) {
@@ -0,0 +1,10 @@
+package tencentcloudcos
+
+type DomainType string
+
+const (
+	// 域名类型：自定义源站域名。
+	DOMAIN_TYPE_ORIGIN = DomainType("origin")
+	// 域名类型：自定义 CDN 加速域名。
+	DOMAIN_TYPE_CDN = DomainType("cdn")
+)
@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	xerrors "github.com/pkg/errors"
+	tcCdn "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/cdn/v20180606"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	tcSsl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
@@ -27,25 +29,36 @@ type DeployerConfig struct {
 	Bucket string `json:"bucket"`
 	// 自定义域名（不支持泛域名）。
 	Domain string `json:"domain"`
+	// 自定义域名类型。
+	// 零值时默认为 [DOMAIN_TYPE_ORIGIN]。
+	DomainType DomainType `json:"domainType,omitempty"`
 }
 
 type DeployerProvider struct {
 	config      *DeployerConfig
 	logger      logger.Logger
-	sdkClient   *tcSsl.Client
+	sdkClients  *wSdkClients
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+type wSdkClients struct {
+	ssl *tcSsl.Client
+	cdn *tcCdn.Client
+}
+
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.SecretId, config.SecretKey, config.Region)
+	clients, err := createSdkClients(config.SecretId, config.SecretKey, config.Region)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to create sdk client")
+		return nil, xerrors.Wrap(err, "failed to create sdk clients")
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
@@ -59,17 +72,24 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	return &DeployerProvider{
 		config:      config,
 		logger:      logger.NewNilLogger(),
-		sdkClient:   client,
+		sdkClients:  clients,
 		sslUploader: uploader,
 	}, nil
 }
 
 func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	d.logger = logger
+	if u, ok := d.sslUploader.(*uploadersp.UploaderProvider); ok {
+		u.WithLogger(logger)
+	}
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.Bucket == "" {
 		return nil, errors.New("config `bucket` is required")
 	}
@@ -77,6 +97,25 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, errors.New("config `domain` is required")
 	}
 
+	var resourceType, instanceId string
+	switch d.config.DomainType {
+	case "", DOMAIN_TYPE_ORIGIN:
+		resourceType = "cos"
+		instanceId = fmt.Sprintf("%s#%s#%s", d.config.Region, d.config.Bucket, d.config.Domain)
+
+	case DOMAIN_TYPE_CDN:
+		// CDN 加速域名需确认其源站为当前存储桶，避免误部署到其他 CDN 域名
+		if err := d.checkCdnDomainOrigin(); err != nil {
+			return nil, err
+		}
+
+		resourceType = "cdn"
+		instanceId = d.config.Domain
+
+	default:
+		return nil, fmt.Errorf("unsupported domain type: %s", d.config.DomainType)
+	}
+
 	// 上传证书到 SSL
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -85,29 +124,72 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	d.logger.Logt("certificate file uploaded", upres)
 
-	// 证书部署到 COS 实例
+	// 证书部署到 COS 实例或 CDN 实例
 	// REF: https://cloud.tencent.com/document/product/400/91667
 	deployCertificateInstanceReq := tcSsl.NewDeployCertificateInstanceRequest()
 	deployCertificateInstanceReq.CertificateId = common.StringPtr(upres.CertId)
-	deployCertificateInstanceReq.ResourceType = common.StringPtr("cos")
+	deployCertificateInstanceReq.ResourceType = common.StringPtr(resourceType)
 	deployCertificateInstanceReq.Status = common.Int64Ptr(1)
-	deployCertificateInstanceReq.InstanceIdList = common.StringPtrs([]string{fmt.Sprintf("%s#%s#%s", d.config.Region, d.config.Bucket, d.config.Domain)})
-	deployCertificateInstanceResp, err := d.sdkClient.DeployCertificateInstance(deployCertificateInstanceReq)
+	deployCertificateInstanceReq.InstanceIdList = common.StringPtrs([]string{instanceId})
+	deployCertificateInstanceResp, err := d.sdkClients.ssl.DeployCertificateInstance(deployCertificateInstanceReq)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ssl.DeployCertificateInstance'")
 	}
 
 	d.logger.Logt("已部署证书到云资源实例", deployCertificateInstanceResp.Response)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
-func createSdkClient(secretId, secretKey, region string) (*tcSsl.Client, error) {
+func (d *DeployerProvider) checkCdnDomainOrigin() error {
+	// 查询 CDN 加速域名详细配置
+	// REF: https://cloud.tencent.com/document/product/228/41117
+	describeDomainsConfigReq := tcCdn.NewDescribeDomainsConfigRequest()
+	describeDomainsConfigReq.Filters = []*tcCdn.DomainFilter{
+		{
+			Name:  common.StringPtr("domain"),
+			Value: common.StringPtrs([]string{d.config.Domain}),
+		},
+	}
+	describeDomainsConfigReq.Offset = common.Int64Ptr(0)
+	describeDomainsConfigReq.Limit = common.Int64Ptr(1)
+	describeDomainsConfigResp, err := d.sdkClients.cdn.DescribeDomainsConfig(describeDomainsConfigReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'cdn.DescribeDomainsConfig'")
+	}
+
+	if len(describeDomainsConfigResp.Response.Domains) == 0 {
+		return fmt.Errorf("could not find cdn domain '%s'", d.config.Domain)
+	}
+
+	domainConfig := describeDomainsConfigResp.Response.Domains[0]
+	if domainConfig.Origin != nil {
+		for _, origin := range domainConfig.Origin.Origins {
+			// 存储桶源站形如 "<bucket>.cos.<region>.myqcloud.com" 或 "<bucket>.cos-website.<region>.myqcloud.com"
+			if origin != nil && strings.HasPrefix(*origin, d.config.Bucket+".cos") {
+				return nil
+			}
+		}
+	}
+
+	return fmt.Errorf("the origin of cdn domain '%s' is not bucket '%s'", d.config.Domain, d.config.Bucket)
+}
+
+func createSdkClients(secretId, secretKey, region string) (*wSdkClients, error) {
 	credential := common.NewCredential(secretId, secretKey)
-	client, err := tcSsl.NewClient(credential, region, profile.NewClientProfile())
+
+	sslClient, err := tcSsl.NewClient(credential, region, profile.NewClientProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	cdnClient, err := tcCdn.NewClient(credential, "", profile.NewClientProfile())
 	if err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	return &wSdkClients{
+		ssl: sslClient,
+		cdn: cdnClient,
+	}, nil
 }
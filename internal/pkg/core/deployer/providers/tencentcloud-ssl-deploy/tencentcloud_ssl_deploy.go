@@ -37,7 +37,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -67,10 +70,17 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 
 func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	d.logger = logger
+	if u, ok := d.sslUploader.(*uploadersp.UploaderProvider); ok {
+		u.WithLogger(logger)
+	}
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.ResourceType == "" {
 		return nil, errors.New("config `resourceType` is required")
 	}
@@ -138,7 +148,11 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		time.Sleep(time.Second * 5)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{
+		CertId:      upres.CertId,
+		ResourceIds: d.config.ResourceIds,
+		RequestIds:  []string{*deployCertificateInstanceResp.Response.RequestId},
+	}, nil
 }
 
 func createSdkClient(secretId, secretKey, region string) (*tcSsl.Client, error) {
@@ -0,0 +1,10 @@
+package tencentcloudwaf
+
+type DomainType string
+
+const (
+	// 防护域名类型：SaaS 型 WAF 域名。
+	DOMAIN_TYPE_SAAS = DomainType("saas")
+	// 防护域名类型：负载均衡型 WAF 域名。
+	DOMAIN_TYPE_CLB = DomainType("clb")
+)
@@ -3,8 +3,12 @@
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"strings"
 
 	xerrors "github.com/pkg/errors"
+	tcClb "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/clb/v20180317"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	tcWaf "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/waf/v20180125"
@@ -22,6 +26,9 @@ type DeployerConfig struct {
 	SecretKey string `json:"secretKey"`
 	// 腾讯云地域。
 	Region string `json:"region"`
+	// 防护域名类型。
+	// 零值时默认为 [DOMAIN_TYPE_SAAS]。
+	DomainType DomainType `json:"domainType,omitempty"`
 	// 防护域名（不支持泛域名）。
 	Domain string `json:"domain"`
 	// 防护域名 ID。
@@ -33,20 +40,28 @@ type DeployerConfig struct {
 type DeployerProvider struct {
 	config      *DeployerConfig
 	logger      logger.Logger
-	sdkClient   *tcWaf.Client
+	sdkClients  *wSdkClients
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+type wSdkClients struct {
+	waf *tcWaf.Client
+	clb *tcClb.Client
+}
+
+var (
+	_ deployer.Deployer       = (*DeployerProvider)(nil)
+	_ deployer.UploaderHolder = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
 		panic("config is nil")
 	}
 
-	client, err := createSdkClient(config.SecretId, config.SecretKey, config.Region)
+	clients, err := createSdkClients(config.SecretId, config.SecretKey, config.Region)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to create sdk client")
+		return nil, xerrors.Wrap(err, "failed to create sdk clients")
 	}
 
 	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
@@ -60,17 +75,24 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	return &DeployerProvider{
 		config:      config,
 		logger:      logger.NewNilLogger(),
-		sdkClient:   client,
+		sdkClients:  clients,
 		sslUploader: uploader,
 	}, nil
 }
 
 func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	d.logger = logger
+	if u, ok := d.sslUploader.(*uploadersp.UploaderProvider); ok {
+		u.WithLogger(logger)
+	}
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) GetUploader() uploader.Uploader {
+	return d.sslUploader
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	if d.config.Domain == "" {
 		return nil, errors.New("config `domain` is required")
 	}
@@ -81,51 +103,201 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		return nil, errors.New("config `instanceId` is required")
 	}
 
-	// 上传证书到 SSL
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	} else {
-		d.logger.Logt("certificate file uploaded", upres)
+	// 根据防护域名类型决定部署方式
+	switch d.config.DomainType {
+	case "", DOMAIN_TYPE_SAAS:
+		if err := d.deployToSaasDomain(ctx, certPem, privkeyPem); err != nil {
+			return nil, err
+		}
+
+	case DOMAIN_TYPE_CLB:
+		if err := d.deployToClbDomain(ctx, certPem, privkeyPem); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unsupported domain type: %s", d.config.DomainType)
 	}
 
+	return &deployer.DeployResult{}, nil
+}
+
+func (d *DeployerProvider) deployToSaasDomain(ctx context.Context, certPem string, privkeyPem string) error {
 	// 查询单个 SaaS 型 WAF 域名详情
 	// REF: https://cloud.tencent.com/document/api/627/82938
 	describeDomainDetailsSaasReq := tcWaf.NewDescribeDomainDetailsSaasRequest()
 	describeDomainDetailsSaasReq.Domain = common.StringPtr(d.config.Domain)
 	describeDomainDetailsSaasReq.DomainId = common.StringPtr(d.config.DomainId)
 	describeDomainDetailsSaasReq.InstanceId = common.StringPtr(d.config.InstanceId)
-	describeDomainDetailsSaasResp, err := d.sdkClient.DescribeDomainDetailsSaas(describeDomainDetailsSaasReq)
+	describeDomainDetailsSaasResp, err := d.sdkClients.waf.DescribeDomainDetailsSaas(describeDomainDetailsSaasReq)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'waf.DescribeDomainDetailsSaas'")
-	} else {
-		d.logger.Logt("已查询到 SaaS 型 WAF 域名详情", describeDomainDetailsSaasResp.Response)
+		return xerrors.Wrap(err, "failed to execute sdk request 'waf.DescribeDomainDetailsSaas'")
+	} else if describeDomainDetailsSaasResp.Response.DomainsPartInfo == nil {
+		return fmt.Errorf("could not find saas domain '%s'", d.config.Domain)
 	}
 
+	d.logger.Logt("已查询到 SaaS 型 WAF 域名详情", describeDomainDetailsSaasResp.Response)
+
+	// 上传证书到 SSL
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
 	// 编辑 SaaS 型 WAF 域名
+	// 未传入的配置项会被重置，因此需沿用已有的域名配置，仅替换证书
 	// REF: https://cloud.tencent.com/document/api/627/94309
+	domainInfo := describeDomainDetailsSaasResp.Response.DomainsPartInfo
 	modifySpartaProtectionReq := tcWaf.NewModifySpartaProtectionRequest()
 	modifySpartaProtectionReq.Domain = common.StringPtr(d.config.Domain)
 	modifySpartaProtectionReq.DomainId = common.StringPtr(d.config.DomainId)
 	modifySpartaProtectionReq.InstanceID = common.StringPtr(d.config.InstanceId)
 	modifySpartaProtectionReq.CertType = common.Int64Ptr(2)
 	modifySpartaProtectionReq.SSLId = common.StringPtr(upres.CertId)
-	modifySpartaProtectionResp, err := d.sdkClient.ModifySpartaProtection(modifySpartaProtectionReq)
+	modifySpartaProtectionReq.Edition = domainInfo.Edition
+	modifySpartaProtectionReq.IsCdn = uint64PtrToInt64Ptr(domainInfo.IsCdn)
+	modifySpartaProtectionReq.UpstreamScheme = domainInfo.UpstreamScheme
+	modifySpartaProtectionReq.HttpsUpstreamPort = domainInfo.HttpsUpstreamPort
+	modifySpartaProtectionReq.HttpsRewrite = domainInfo.HttpsRewrite
+	modifySpartaProtectionReq.UpstreamType = uint64PtrToInt64Ptr(domainInfo.UpstreamType)
+	modifySpartaProtectionReq.UpstreamDomain = domainInfo.UpstreamDomain
+	modifySpartaProtectionReq.SrcList = domainInfo.SrcList
+	modifySpartaProtectionReq.IsHttp2 = uint64PtrToInt64Ptr(domainInfo.IsHttp2)
+	modifySpartaProtectionReq.IsWebsocket = uint64PtrToInt64Ptr(domainInfo.IsWebsocket)
+	modifySpartaProtectionReq.LoadBalance = uint64PtrToInt64Ptr(domainInfo.LoadBalance)
+	modifySpartaProtectionReq.IsGray = uint64PtrToInt64Ptr(domainInfo.IsGray)
+	modifySpartaProtectionReq.ActiveCheck = uint64PtrToInt64Ptr(domainInfo.ActiveCheck)
+	modifySpartaProtectionReq.TLSVersion = domainInfo.TLSVersion
+	modifySpartaProtectionReq.Ciphers = domainInfo.Ciphers
+	modifySpartaProtectionReq.CipherTemplate = domainInfo.CipherTemplate
+	modifySpartaProtectionReq.ProxyReadTimeout = domainInfo.ProxyReadTimeout
+	modifySpartaProtectionReq.ProxySendTimeout = domainInfo.ProxySendTimeout
+	modifySpartaProtectionReq.SniType = domainInfo.SniType
+	modifySpartaProtectionReq.SniHost = domainInfo.SniHost
+	modifySpartaProtectionReq.IpHeaders = domainInfo.IpHeaders
+	modifySpartaProtectionReq.XFFReset = domainInfo.XFFReset
+	modifySpartaProtectionReq.Note = domainInfo.Note
+	modifySpartaProtectionReq.UpstreamHost = domainInfo.UpstreamHost
+	modifySpartaProtectionReq.ProxyBuffer = domainInfo.ProxyBuffer
+	modifySpartaProtectionReq.ProbeStatus = domainInfo.ProbeStatus
+	if domainInfo.IsKeepAlive != nil {
+		modifySpartaProtectionReq.IsKeepAlive = common.StringPtr(strconv.FormatUint(*domainInfo.IsKeepAlive, 10))
+	}
+	if domainInfo.Ports != nil {
+		modifySpartaProtectionReq.Ports = make([]*tcWaf.SpartaProtectionPort, 0, len(domainInfo.Ports))
+		for _, port := range domainInfo.Ports {
+			modifySpartaProtectionReq.Ports = append(modifySpartaProtectionReq.Ports, &tcWaf.SpartaProtectionPort{
+				NginxServerId:    port.NginxServerId,
+				Port:             port.Port,
+				Protocol:         port.Protocol,
+				UpstreamPort:     port.UpstreamPort,
+				UpstreamProtocol: port.UpstreamProtocol,
+			})
+		}
+	}
+	if domainInfo.Weights != nil {
+		modifySpartaProtectionReq.Weights = make([]*int64, 0, len(domainInfo.Weights))
+		for _, weight := range domainInfo.Weights {
+			if weight == nil {
+				continue
+			}
+
+			w, _ := strconv.ParseInt(*weight, 10, 64)
+			modifySpartaProtectionReq.Weights = append(modifySpartaProtectionReq.Weights, common.Int64Ptr(w))
+		}
+	}
+	modifySpartaProtectionResp, err := d.sdkClients.waf.ModifySpartaProtection(modifySpartaProtectionReq)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'waf.ModifySpartaProtection'")
-	} else {
-		d.logger.Logt("已编辑 SaaS 型 WAF 域名", modifySpartaProtectionResp.Response)
+		return xerrors.Wrap(err, "failed to execute sdk request 'waf.ModifySpartaProtection'")
 	}
 
-	return &deployer.DeployResult{}, nil
+	d.logger.Logt("已编辑 SaaS 型 WAF 域名", modifySpartaProtectionResp.Response)
+
+	return nil
 }
 
-func createSdkClient(secretId, secretKey, region string) (*tcWaf.Client, error) {
+func (d *DeployerProvider) deployToClbDomain(ctx context.Context, certPem string, privkeyPem string) error {
+	// 查询单个负载均衡型 WAF 域名详情
+	// REF: https://cloud.tencent.com/document/api/627/82939
+	describeDomainDetailsClbReq := tcWaf.NewDescribeDomainDetailsClbRequest()
+	describeDomainDetailsClbReq.Domain = common.StringPtr(d.config.Domain)
+	describeDomainDetailsClbReq.DomainId = common.StringPtr(d.config.DomainId)
+	describeDomainDetailsClbReq.InstanceId = common.StringPtr(d.config.InstanceId)
+	describeDomainDetailsClbResp, err := d.sdkClients.waf.DescribeDomainDetailsClb(describeDomainDetailsClbReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'waf.DescribeDomainDetailsClb'")
+	} else if describeDomainDetailsClbResp.Response.DomainsClbPartInfo == nil {
+		return fmt.Errorf("could not find clb domain '%s'", d.config.Domain)
+	}
+
+	d.logger.Logt("已查询到负载均衡型 WAF 域名详情", describeDomainDetailsClbResp.Response)
+
+	// 负载均衡型 WAF 不持有证书，HTTPS 由所绑定的负载均衡七层监听器卸载
+	listeners := make([]*tcWaf.LoadBalancerPackageNew, 0)
+	for _, listener := range describeDomainDetailsClbResp.Response.DomainsClbPartInfo.LoadBalancerSet {
+		if listener.Protocol != nil && strings.EqualFold(*listener.Protocol, "HTTPS") {
+			listeners = append(listeners, listener)
+		}
+	}
+	if len(listeners) == 0 {
+		return fmt.Errorf("could not find any https listener bound to clb domain '%s'", d.config.Domain)
+	}
+
+	// 上传证书到 SSL
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	for _, listener := range listeners {
+		// 修改负载均衡七层监听器转发规则的域名级别属性
+		// REF: https://cloud.tencent.com/document/api/214/38092
+		modifyDomainAttributesReq := tcClb.NewModifyDomainAttributesRequest()
+		modifyDomainAttributesReq.LoadBalancerId = listener.LoadBalancerId
+		modifyDomainAttributesReq.ListenerId = listener.ListenerId
+		modifyDomainAttributesReq.Domain = common.StringPtr(d.config.Domain)
+		modifyDomainAttributesReq.Certificate = &tcClb.CertificateInput{
+			SSLMode: common.StringPtr("UNIDIRECTIONAL"),
+			CertId:  common.StringPtr(upres.CertId),
+		}
+		modifyDomainAttributesResp, err := d.sdkClients.clb.ModifyDomainAttributes(modifyDomainAttributesReq)
+		if err != nil {
+			return xerrors.Wrapf(err, "failed to execute sdk request 'clb.ModifyDomainAttributes' (listenerId: %s)", *listener.ListenerId)
+		}
+
+		d.logger.Logt(fmt.Sprintf("已修改监听器 %s 转发规则的域名级别属性", *listener.ListenerId), modifyDomainAttributesResp.Response)
+	}
+
+	return nil
+}
+
+func createSdkClients(secretId, secretKey, region string) (*wSdkClients, error) {
 	credential := common.NewCredential(secretId, secretKey)
-	client, err := tcWaf.NewClient(credential, region, profile.NewClientProfile())
+
+	wafClient, err := tcWaf.NewClient(credential, region, profile.NewClientProfile())
 	if err != nil {
 		return nil, err
 	}
 
-	return client, nil
+	clbClient, err := tcClb.NewClient(credential, region, profile.NewClientProfile())
+	if err != nil {
+		return nil, err
+	}
+
+	return &wSdkClients{
+		waf: wafClient,
+		clb: clbClient,
+	}, nil
+}
+
+func uint64PtrToInt64Ptr(v *uint64) *int64 {
+	if v == nil {
+		return nil
+	}
+
+	return common.Int64Ptr(int64(*v))
 }
@@ -0,0 +1,314 @@
+package truenas
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	truenassdk "github.com/usual2970/certimate/internal/pkg/vendors/truenas-sdk"
+)
+
+type DeployerConfig struct {
+	// TrueNAS 管理地址。
+	ServerUrl string `json:"serverUrl"`
+	// TrueNAS API 密钥。
+	ApiKey string `json:"apiKey"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 是否设置为 Web 管理界面证书。
+	UpdateUICertificate bool `json:"updateUICertificate,omitempty"`
+	// 是否更新 S3 服务证书。
+	// 仅适用于 TrueNAS CORE 及 TrueNAS SCALE 24.04 及以下版本。
+	UpdateS3Certificate bool `json:"updateS3Certificate,omitempty"`
+	// 是否更新 FTP 服务证书。
+	UpdateFTPCertificate bool `json:"updateFTPCertificate,omitempty"`
+	// 需要更新证书的应用名称数组。
+	// 选填。仅适用于 TrueNAS SCALE 24.10 及以上版本。
+	AppNames []string `json:"appNames,omitempty"`
+	// 是否清理由本工具导入的已过期证书。
+	PruneExpired bool `json:"pruneExpired,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *truenassdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+// 由本工具导入的证书名称前缀，清理过期证书时只会处理带有此前缀的证书。
+const certificateNamePrefix = "certimate_"
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.ApiKey, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 仅校验模式下只检查 API 密钥是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		// REF: https://www.truenas.com/docs/api/scale_rest_api.html
+		systemInfoResp, err := d.sdkClient.SystemInfo()
+		d.logger.Logt("已查询到 TrueNAS 系统信息", systemInfoResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.SystemInfo'")
+		}
+
+		d.logger.Logt("dry run: truenas api key is valid, nothing changed")
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 导入证书
+	certificate, err := d.importCertificate(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 设置为 Web 管理界面证书
+	if d.config.UpdateUICertificate {
+		updateGeneralReq := &truenassdk.SystemGeneralUpdateRequest{
+			UICertificate: certificate.Id,
+		}
+		updateGeneralResp, err := d.sdkClient.SystemGeneralUpdate(updateGeneralReq)
+		d.logger.Logt("已设置 Web 管理界面证书", updateGeneralResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.SystemGeneralUpdate'")
+		}
+
+		// 重启 Web 管理界面使证书生效，重启过程中连接可能会被中断，因此忽略错误
+		if err := d.sdkClient.SystemGeneralUIRestart(); err != nil {
+			d.logger.Logt("重启 Web 管理界面时连接中断", err.Error())
+		} else {
+			d.logger.Logt("已重启 Web 管理界面")
+		}
+	}
+
+	// 更新 S3 服务证书
+	if d.config.UpdateS3Certificate {
+		updateS3Req := &truenassdk.S3UpdateRequest{
+			Certificate: certificate.Id,
+		}
+		updateS3Resp, err := d.sdkClient.S3Update(updateS3Req)
+		d.logger.Logt("已更新 S3 服务证书", updateS3Resp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.S3Update'")
+		}
+	}
+
+	// 更新 FTP 服务证书
+	if d.config.UpdateFTPCertificate {
+		updateFtpReq := &truenassdk.FTPUpdateRequest{
+			TLS:               true,
+			SSLTLSCertificate: certificate.Id,
+		}
+		updateFtpResp, err := d.sdkClient.FTPUpdate(updateFtpReq)
+		d.logger.Logt("已更新 FTP 服务证书", updateFtpResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.FTPUpdate'")
+		}
+	}
+
+	// 更新应用证书
+	for _, appName := range d.config.AppNames {
+		if err := d.updateAppCertificate(ctx, appName, certificate.Id); err != nil {
+			return nil, err
+		}
+	}
+
+	// 清理已过期的证书
+	if d.config.PruneExpired {
+		d.pruneExpiredCertificates(ctx, certificate.Id)
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"certificateId":   certificate.Id,
+			"certificateName": certificate.Name,
+		},
+	}, nil
+}
+
+func (d *DeployerProvider) importCertificate(ctx context.Context, certPem string, privkeyPem string) (*truenassdk.Certificate, error) {
+	// 导入证书
+	// REF: https://www.truenas.com/docs/api/scale_rest_api.html
+	createCertReq := &truenassdk.CertificateCreateRequest{
+		CreateType:  "CERTIFICATE_CREATE_IMPORTED",
+		Name:        fmt.Sprintf("%s%d", certificateNamePrefix, time.Now().UnixMilli()),
+		Certificate: certPem,
+		Privatekey:  privkeyPem,
+	}
+	createCertJobId, err := d.sdkClient.CertificateCreate(createCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.CertificateCreate'")
+	}
+
+	createCertJob, err := d.waitJob(ctx, createCertJobId)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate := &truenassdk.Certificate{}
+	if err := json.Unmarshal(createCertJob.Result, certificate); err != nil || certificate.Id == 0 {
+		return nil, fmt.Errorf("failed to parse imported certificate from job #%d", createCertJobId)
+	}
+
+	d.logger.Logt("已导入证书", certificate)
+	return certificate, nil
+}
+
+func (d *DeployerProvider) updateAppCertificate(ctx context.Context, appName string, certId int64) error {
+	// 获取应用的用户配置
+	appValues, err := d.sdkClient.AppConfig(appName)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to execute sdk request 'truenas.AppConfig' (app: %s)", appName)
+	}
+
+	// 替换配置中全部已设置的证书
+	if replaced := replaceCertificateIds(appValues, certId); replaced == 0 {
+		d.logger.Logt(fmt.Sprintf("应用 %s 未配置证书，跳过", appName))
+		return nil
+	}
+
+	// 更新应用的用户配置
+	updateAppReq := &truenassdk.AppUpdateRequest{
+		Values: appValues,
+	}
+	updateAppJobId, err := d.sdkClient.AppUpdate(appName, updateAppReq)
+	if err != nil {
+		return xerrors.Wrapf(err, "failed to execute sdk request 'truenas.AppUpdate' (app: %s)", appName)
+	}
+
+	if _, err := d.waitJob(ctx, updateAppJobId); err != nil {
+		return xerrors.Wrapf(err, "failed to update app '%s'", appName)
+	}
+
+	d.logger.Logt(fmt.Sprintf("已更新应用 %s 的证书", appName))
+	return nil
+}
+
+func (d *DeployerProvider) pruneExpiredCertificates(ctx context.Context, excludeCertId int64) {
+	queryCertResp, err := d.sdkClient.CertificateQuery()
+	if err != nil {
+		d.logger.Logt("查询证书列表失败，跳过清理", err.Error())
+		return
+	}
+
+	for _, certificate := range queryCertResp {
+		if certificate.Id == excludeCertId || !certificate.Expired || !strings.HasPrefix(certificate.Name, certificateNamePrefix) {
+			continue
+		}
+
+		// 证书仍被服务或应用使用时删除会失败，此时仅记录日志
+		deleteCertJobId, err := d.sdkClient.CertificateDelete(certificate.Id)
+		if err == nil {
+			_, err = d.waitJob(ctx, deleteCertJobId)
+		}
+		if err != nil {
+			d.logger.Logt(fmt.Sprintf("删除过期证书 %s 失败", certificate.Name), err.Error())
+		} else {
+			d.logger.Logt(fmt.Sprintf("已删除过期证书 %s", certificate.Name))
+		}
+	}
+}
+
+func (d *DeployerProvider) waitJob(ctx context.Context, jobId int64) (*truenassdk.Job, error) {
+	// 循环查询任务状态，等待任务完成
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		job, err := d.sdkClient.JobGet(jobId)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'truenas.JobGet'")
+		}
+
+		switch job.State {
+		case "SUCCESS":
+			return job, nil
+
+		case "FAILED", "ABORTED":
+			return job, fmt.Errorf("job #%d %s: %s", jobId, strings.ToLower(job.State), job.Error)
+		}
+
+		d.logger.Logt(fmt.Sprintf("任务 #%d (%s) 执行中，等待完成……", jobId, job.Method))
+		time.Sleep(time.Second * 2)
+	}
+}
+
+// 递归替换应用配置中全部名为 "certificate_id" 且已设置的字段，返回替换的数量。
+func replaceCertificateIds(values map[string]any, certId int64) int {
+	replaced := 0
+	for k, v := range values {
+		switch tv := v.(type) {
+		case map[string]any:
+			replaced += replaceCertificateIds(tv, certId)
+
+		case []any:
+			for _, item := range tv {
+				if m, ok := item.(map[string]any); ok {
+					replaced += replaceCertificateIds(m, certId)
+				}
+			}
+
+		default:
+			if k == "certificate_id" && v != nil {
+				values[k] = certId
+				replaced++
+			}
+		}
+	}
+	return replaced
+}
+
+func createSdkClient(serverUrl, apiKey string, skipTlsVerify bool) (*truenassdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid truenas server url")
+	}
+	if apiKey == "" {
+		return nil, errors.New("invalid truenas api key")
+	}
+
+	client := truenassdk.NewClient(serverUrl, apiKey).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
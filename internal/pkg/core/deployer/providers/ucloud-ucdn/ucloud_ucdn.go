@@ -34,7 +34,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -68,14 +71,14 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到 USSL
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	}
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
 
-	d.logger.Logt("certificate file uploaded", upres)
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.DomainId == "" {
+		return nil, errors.New("config `domainId` is required")
+	}
 
 	// 获取加速域名配置
 	// REF: https://docs.ucloud.cn/api/ucdn-api/get_ucdn_domain_config
@@ -93,14 +96,30 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	d.logger.Logt("已查询到加速域名配置", getUcdnDomainConfigResp)
 
+	// 仅校验模式下只查询加速域名配置，不实际上传和更新证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传证书到 USSL
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
 	// 更新 HTTPS 加速配置
 	// REF: https://docs.ucloud.cn/api/ucdn-api/update_ucdn_domain_https_config_v2
-	certId, _ := strconv.Atoi(upres.CertId)
+	certId, err := strconv.Atoi(upres.CertId)
+	if err != nil {
+		return nil, xerrors.Wrapf(err, "failed to parse certificate id '%s'", upres.CertId)
+	}
+
 	updateUcdnDomainHttpsConfigV2Req := d.sdkClient.NewUpdateUcdnDomainHttpsConfigV2Request()
 	updateUcdnDomainHttpsConfigV2Req.DomainId = usdk.String(d.config.DomainId)
 	updateUcdnDomainHttpsConfigV2Req.HttpsStatusCn = usdk.String(getUcdnDomainConfigResp.DomainList[0].HttpsStatusCn)
 	updateUcdnDomainHttpsConfigV2Req.HttpsStatusAbroad = usdk.String(getUcdnDomainConfigResp.DomainList[0].HttpsStatusAbroad)
-	updateUcdnDomainHttpsConfigV2Req.HttpsStatusAbroad = usdk.String(getUcdnDomainConfigResp.DomainList[0].HttpsStatusAbroad)
 	updateUcdnDomainHttpsConfigV2Req.CertId = usdk.Int(certId)
 	updateUcdnDomainHttpsConfigV2Req.CertName = usdk.String(upres.CertName)
 	updateUcdnDomainHttpsConfigV2Req.CertType = usdk.String("ussl")
@@ -114,7 +133,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	d.logger.Logt("已更新 HTTPS 加速配置", updateUcdnDomainHttpsConfigV2Resp)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func createSdkClient(privateKey, publicKey string) (*uCdn.UCDNClient, error) {
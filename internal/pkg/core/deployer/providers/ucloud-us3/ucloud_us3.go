@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 
 	xerrors "github.com/pkg/errors"
 	usdk "github.com/ucloud/ucloud-sdk-go/ucloud"
@@ -70,7 +71,14 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Bucket == "" {
+		return nil, errors.New("config `bucket` is required")
+	}
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
 	// 上传证书到 USSL
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -91,12 +99,12 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	}
 	addUFileSSLCertResp, err := d.sdkClient.AddUFileSSLCert(addUFileSSLCertReq)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'ucdn.AddUFileSSLCert'")
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'us3.AddUFileSSLCert'")
 	}
 
-	d.logger.Logt("添加 SSL 证书", addUFileSSLCertResp)
+	d.logger.Logt("已添加 SSL 证书", addUFileSSLCertResp)
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func createSdkClient(privateKey, publicKey, region string) (*usdkFile.UFileClient, error) {
@@ -0,0 +1,144 @@
+package upyuncdn
+
+import (
+	"context"
+	"errors"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/upyun-ssl"
+	upyunsdk "github.com/usual2970/certimate/internal/pkg/vendors/upyun-sdk/console"
+)
+
+type DeployerConfig struct {
+	// 又拍云账号用户名。
+	Username string `json:"username"`
+	// 又拍云账号密码。
+	Password string `json:"password"`
+	// 加速域名（支持泛域名）。
+	Domain string `json:"domain"`
+}
+
+type DeployerProvider struct {
+	config      *DeployerConfig
+	logger      logger.Logger
+	sdkClient   *upyunsdk.Client
+	sslUploader uploader.Uploader
+}
+
+var _ deployer.Deployer = (*DeployerProvider)(nil)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client := upyunsdk.NewClient(config.Username, config.Password)
+
+	uploader, err := uploadersp.NewUploader(&uploadersp.UploaderConfig{
+		Username: config.Username,
+		Password: config.Password,
+	})
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create ssl uploader")
+	}
+
+	return &DeployerProvider{
+		config:      config,
+		logger:      logger.NewNilLogger(),
+		sdkClient:   client,
+		sslUploader: uploader,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 上传证书
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	// 获取域名的 HTTPS 证书配置
+	getHttpsServiceManagerResp, err := d.sdkClient.GetHttpsServiceManager(d.config.Domain)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'console.GetHttpsServiceManager'")
+	}
+
+	d.logger.Logt("已查询到域名的 HTTPS 证书配置", getHttpsServiceManagerResp)
+
+	// 查找域名当前正在使用的证书
+	var oldCertId string
+	if getHttpsServiceManagerResp.Data != nil {
+		for _, domainInfo := range getHttpsServiceManagerResp.Data.Domains {
+			if domainInfo.Https {
+				oldCertId = domainInfo.CertificateId
+				break
+			}
+		}
+	}
+
+	// 判断域名是否已启用 HTTPS。如果已启用，将绑定在旧证书上的域名迁移到新证书；否则，为域名设置新证书
+	if oldCertId == "" {
+		updateHttpsCertificateManagerReq := &upyunsdk.UpdateHttpsCertificateManagerRequest{
+			CertificateId: upres.CertId,
+			Domain:        d.config.Domain,
+			Https:         true,
+			ForceHttps:    true,
+		}
+		updateHttpsCertificateManagerResp, err := d.sdkClient.UpdateHttpsCertificateManager(updateHttpsCertificateManagerReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'console.UpdateHttpsCertificateManager'")
+		}
+
+		d.logger.Logt("已为域名设置 HTTPS 证书", updateHttpsCertificateManagerResp)
+	} else if oldCertId != upres.CertId {
+		// 查询旧证书绑定的所有域名，包括 CDN 加速域名和云存储自定义域名
+		getHttpsCertificateManagerResp, err := d.sdkClient.GetHttpsCertificateManager(oldCertId)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'console.GetHttpsCertificateManager'")
+		}
+
+		d.logger.Logt("已查询到旧证书绑定的域名", getHttpsCertificateManagerResp)
+
+		domains := []string{d.config.Domain}
+		if getHttpsCertificateManagerResp.Data != nil {
+			for _, domainInfo := range getHttpsCertificateManagerResp.Data.Domains {
+				if domainInfo.Name != d.config.Domain && domainInfo.Https {
+					domains = append(domains, domainInfo.Name)
+				}
+			}
+		}
+
+		// 逐个迁移域名证书
+		for _, domain := range domains {
+			migrateHttpsDomainReq := &upyunsdk.MigrateHttpsDomainRequest{
+				CertificateId: upres.CertId,
+				Domain:        domain,
+			}
+			migrateHttpsDomainResp, err := d.sdkClient.MigrateHttpsDomain(migrateHttpsDomainReq)
+			if err != nil {
+				return nil, xerrors.Wrapf(err, "failed to execute sdk request 'console.MigrateHttpsDomain' (domain: %s)", domain)
+			}
+
+			d.logger.Logt("已迁移域名证书", domain, migrateHttpsDomainResp)
+		}
+	} else {
+		d.logger.Logt("域名已绑定相同证书，跳过更新")
+	}
+
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
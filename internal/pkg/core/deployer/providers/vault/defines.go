@@ -0,0 +1,15 @@
+package vault
+
+type AuthMethodType string
+
+const (
+	AUTH_METHOD_TOKEN   = AuthMethodType("token")
+	AUTH_METHOD_APPROLE = AuthMethodType("approle")
+)
+
+type SecretsEngineType string
+
+const (
+	SECRETS_ENGINE_KV2 = SecretsEngineType("kv-v2")
+	SECRETS_ENGINE_PKI = SecretsEngineType("pki")
+)
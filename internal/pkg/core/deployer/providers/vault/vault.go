@@ -0,0 +1,280 @@
+package vault
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	vaultsdk "github.com/usual2970/certimate/internal/pkg/vendors/vault-sdk"
+)
+
+type DeployerConfig struct {
+	// Vault 服务地址。
+	ServerUrl string `json:"serverUrl"`
+	// Vault 命名空间。
+	// 选填。仅适用于 Vault Enterprise 或 HCP Vault。
+	Namespace string `json:"namespace,omitempty"`
+	// 认证方式。
+	AuthMethod AuthMethodType `json:"authMethod"`
+	// 访问令牌。
+	// 认证方式为 [AUTH_METHOD_TOKEN] 时必填。
+	Token string `json:"token,omitempty"`
+	// AppRole 认证挂载路径。
+	// 认证方式为 [AUTH_METHOD_APPROLE] 时有效。零值时默认为 "approle"。
+	AppRoleMountPath string `json:"appRoleMountPath,omitempty"`
+	// AppRole RoleID。
+	// 认证方式为 [AUTH_METHOD_APPROLE] 时必填。
+	AppRoleRoleId string `json:"appRoleRoleId,omitempty"`
+	// AppRole SecretID。
+	// 认证方式为 [AUTH_METHOD_APPROLE] 时选填，取决于角色是否启用了 bind_secret_id。
+	AppRoleSecretId string `json:"appRoleSecretId,omitempty"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 机密引擎类型。
+	SecretsEngine SecretsEngineType `json:"secretsEngine"`
+	// 机密引擎挂载路径。
+	// 零值时，KV v2 引擎默认为 "secret"，PKI 引擎默认为 "pki"。
+	MountPath string `json:"mountPath,omitempty"`
+	// 机密路径。
+	// 机密引擎为 [SECRETS_ENGINE_KV2] 时必填。
+	SecretPath string `json:"secretPath,omitempty"`
+	// 存放证书（含证书链）的字段名。
+	// 机密引擎为 [SECRETS_ENGINE_KV2] 时有效。零值时默认为 "certificate"。
+	KeyForCertificate string `json:"keyForCertificate,omitempty"`
+	// 存放私钥的字段名。
+	// 机密引擎为 [SECRETS_ENGINE_KV2] 时有效。零值时默认为 "private_key"。
+	KeyForPrivateKey string `json:"keyForPrivateKey,omitempty"`
+	// 存放中间证书链的字段名。
+	// 机密引擎为 [SECRETS_ENGINE_KV2] 时有效。零值时默认为 "ca_chain"。
+	KeyForCertificateChain string `json:"keyForCertificateChain,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *vaultsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Namespace, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 登录认证
+	if err := d.login(); err != nil {
+		return nil, err
+	}
+
+	// 仅校验模式下只检查令牌是否有效，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		// REF: https://developer.hashicorp.com/vault/api-docs/auth/token#lookup-a-token-self
+		lookupSelfResp, err := d.sdkClient.TokenLookupSelf()
+		d.logger.Logt("已查询当前令牌", lookupSelfResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'vault.TokenLookupSelf'")
+		}
+
+		d.logger.Logt("dry run: vault token is valid, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	switch d.config.SecretsEngine {
+	case SECRETS_ENGINE_KV2:
+		return d.deployToKV2(ctx, certPem, privkeyPem)
+
+	case SECRETS_ENGINE_PKI:
+		return d.deployToPKI(ctx, certPem, privkeyPem)
+
+	default:
+		return nil, fmt.Errorf("unsupported secrets engine: %s", d.config.SecretsEngine)
+	}
+}
+
+func (d *DeployerProvider) login() error {
+	switch d.config.AuthMethod {
+	case AUTH_METHOD_TOKEN:
+		if d.config.Token == "" {
+			return errors.New("config `token` is required")
+		}
+
+		d.sdkClient.WithToken(d.config.Token)
+
+	case AUTH_METHOD_APPROLE:
+		if d.config.AppRoleRoleId == "" {
+			return errors.New("config `appRoleRoleId` is required")
+		}
+
+		mountPath := d.config.AppRoleMountPath
+		if mountPath == "" {
+			mountPath = "approle"
+		}
+
+		// 使用 AppRole 登录获取令牌
+		// REF: https://developer.hashicorp.com/vault/api-docs/auth/approle#login-with-approle
+		loginReq := &vaultsdk.AppRoleLoginRequest{
+			RoleId:   d.config.AppRoleRoleId,
+			SecretId: d.config.AppRoleSecretId,
+		}
+		loginResp, err := d.sdkClient.AppRoleLogin(mountPath, loginReq)
+		if err != nil {
+			return xerrors.Wrap(err, "failed to execute sdk request 'vault.AppRoleLogin'")
+		} else if loginResp.Auth == nil || loginResp.Auth.ClientToken == "" {
+			return errors.New("failed to login with approle: no client token returned")
+		}
+
+		d.logger.Logt("AppRole 登录成功", loginResp.Auth.Policies)
+		d.sdkClient.WithToken(loginResp.Auth.ClientToken)
+
+	default:
+		return fmt.Errorf("unsupported auth method: %s", d.config.AuthMethod)
+	}
+
+	return nil
+}
+
+func (d *DeployerProvider) deployToKV2(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+	if d.config.SecretPath == "" {
+		return nil, errors.New("config `secretPath` is required")
+	}
+
+	mountPath := d.config.MountPath
+	if mountPath == "" {
+		mountPath = "secret"
+	}
+
+	keyForCertificate := d.config.KeyForCertificate
+	if keyForCertificate == "" {
+		keyForCertificate = "certificate"
+	}
+	keyForPrivateKey := d.config.KeyForPrivateKey
+	if keyForPrivateKey == "" {
+		keyForPrivateKey = "private_key"
+	}
+	keyForCertificateChain := d.config.KeyForCertificateChain
+	if keyForCertificateChain == "" {
+		keyForCertificateChain = "ca_chain"
+	}
+
+	// 提取中间证书链
+	_, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 读取机密的最新版本，以保留其中的其他字段
+	// REF: https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2#read-secret-version
+	// 注意不要将机密内容输出到日志中
+	readResp, err := d.sdkClient.KVv2Read(mountPath, d.config.SecretPath)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vault.KVv2Read'")
+	}
+
+	data := make(map[string]any)
+	if readResp.Data != nil {
+		d.logger.Logt("已读取 KV v2 机密", readResp.Data.Metadata)
+		for k, v := range readResp.Data.Data {
+			data[k] = v
+		}
+	}
+	data[keyForCertificate] = certPem
+	data[keyForPrivateKey] = privkeyPem
+	data[keyForCertificateChain] = interCertPem
+
+	// 写入机密，生成新版本
+	// REF: https://developer.hashicorp.com/vault/api-docs/secret/kv/kv-v2#create-update-secret
+	writeReq := &vaultsdk.KVv2WriteRequest{
+		Data: data,
+	}
+	writeResp, err := d.sdkClient.KVv2Write(mountPath, d.config.SecretPath, writeReq)
+	d.logger.Logt("已写入 KV v2 机密", writeResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vault.KVv2Write'")
+	}
+
+	result := &deployer.DeployResult{}
+	if writeResp.Data != nil {
+		result.ExtendedData = map[string]any{
+			"version": writeResp.Data.Version,
+		}
+	}
+
+	return result, nil
+}
+
+func (d *DeployerProvider) deployToPKI(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+	mountPath := d.config.MountPath
+	if mountPath == "" {
+		mountPath = "pki"
+	}
+
+	// 导入证书及私钥，已存在的颁发者或密钥会被 Vault 自动跳过
+	// REF: https://developer.hashicorp.com/vault/api-docs/secret/pki#import-ca-certificates-and-keys
+	importReq := &vaultsdk.PKIImportBundleRequest{
+		PemBundle: strings.TrimSpace(certPem) + "\n" + strings.TrimSpace(privkeyPem) + "\n",
+	}
+	importResp, err := d.sdkClient.PKIImportBundle(mountPath, importReq)
+	d.logger.Logt("已导入 PKI 证书包", importResp)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vault.PKIImportBundle'")
+	}
+
+	result := &deployer.DeployResult{}
+	if importResp.Data != nil {
+		result.ExtendedData = map[string]any{
+			"importedIssuers": importResp.Data.ImportedIssuers,
+			"importedKeys":    importResp.Data.ImportedKeys,
+		}
+	}
+
+	return result, nil
+}
+
+func createSdkClient(serverUrl, namespace string, skipTlsVerify bool) (*vaultsdk.Client, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid vault server url")
+	}
+
+	client := vaultsdk.NewClient(serverUrl).
+		WithNamespace(namespace).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,82 @@
+package vault_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vault"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fToken         string
+	fMountPath     string
+	fSecretPath    string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_VAULT_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fToken, argsPrefix+"TOKEN", "", "")
+	flag.StringVar(&fMountPath, argsPrefix+"MOUNTPATH", "", "")
+	flag.StringVar(&fSecretPath, argsPrefix+"SECRETPATH", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./vault_test.go -args \
+	--CERTIMATE_DEPLOYER_VAULT_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_VAULT_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_VAULT_SERVERURL="http://127.0.0.1:8200" \
+	--CERTIMATE_DEPLOYER_VAULT_TOKEN="your-vault-token" \
+	--CERTIMATE_DEPLOYER_VAULT_MOUNTPATH="secret" \
+	--CERTIMATE_DEPLOYER_VAULT_SECRETPATH="certimate/example.com"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("TOKEN: %v", fToken),
+			fmt.Sprintf("MOUNTPATH: %v", fMountPath),
+			fmt.Sprintf("SECRETPATH: %v", fSecretPath),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:     fServerUrl,
+			AuthMethod:    provider.AUTH_METHOD_TOKEN,
+			Token:         fToken,
+			SecretsEngine: provider.SECRETS_ENGINE_KV2,
+			MountPath:     fMountPath,
+			SecretPath:    fSecretPath,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
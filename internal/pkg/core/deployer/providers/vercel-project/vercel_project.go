@@ -0,0 +1,126 @@
+package vercelproject
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	vercelsdk "github.com/usual2970/certimate/internal/pkg/vendors/vercel-sdk"
+)
+
+type DeployerConfig struct {
+	// Vercel API Access Token。
+	ApiAccessToken string `json:"apiAccessToken"`
+	// Vercel 团队 ID。
+	// 选填。不填写时表示个人账户。
+	TeamId string `json:"teamId,omitempty"`
+	// 项目 ID 或名称。
+	ProjectId string `json:"projectId"`
+	// 项目自定义域名（不支持泛域名）。
+	Domain string `json:"domain"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *vercelsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ApiAccessToken, config.TeamId)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.ProjectId == "" {
+		return nil, errors.New("config `projectId` is required")
+	}
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// 检查证书是否覆盖项目域名
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	} else if !certs.IsCertificateCoversHostname(certX509, d.config.Domain) {
+		return nil, fmt.Errorf("certificate does not cover domain '%s'", d.config.Domain)
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 获取项目域名，确认域名已绑定到项目
+	// REF: https://vercel.com/docs/rest-api/endpoints/projects#get-a-project-domain
+	getProjectDomainResp, err := d.sdkClient.GetProjectDomain(d.config.ProjectId, d.config.Domain)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vercel.GetProjectDomain'")
+	}
+
+	d.logger.Logt("已获取项目域名", getProjectDomainResp)
+
+	// 仅校验模式下只获取项目域名，不实际上传证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 上传自定义证书
+	// Vercel 会自动为匹配的域名使用自定义证书，而非平台自动签发的证书
+	// REF: https://vercel.com/docs/rest-api/endpoints/certs#upload-a-cert
+	uploadCertReq := &vercelsdk.UploadCertRequest{
+		Ca:   intermediaCertPem,
+		Cert: serverCertPem,
+		Key:  privkeyPem,
+	}
+	uploadCertResp, err := d.sdkClient.UploadCert(uploadCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'vercel.UploadCert'")
+	}
+
+	d.logger.Logt("已上传自定义证书", uploadCertResp)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createSdkClient(apiAccessToken, teamId string) (*vercelsdk.Client, error) {
+	if apiAccessToken == "" {
+		return nil, errors.New("invalid vercel api access token")
+	}
+
+	client := vercelsdk.NewClient(apiAccessToken, teamId)
+	return client, nil
+}
@@ -0,0 +1,80 @@
+package vercelproject_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/vercel-project"
+)
+
+var (
+	fInputCertPath  string
+	fInputKeyPath   string
+	fApiAccessToken string
+	fTeamId         string
+	fProjectId      string
+	fDomain         string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_VERCELPROJECT_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fApiAccessToken, argsPrefix+"APIACCESSTOKEN", "", "")
+	flag.StringVar(&fTeamId, argsPrefix+"TEAMID", "", "")
+	flag.StringVar(&fProjectId, argsPrefix+"PROJECTID", "", "")
+	flag.StringVar(&fDomain, argsPrefix+"DOMAIN", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./vercel_project_test.go -args \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_APIACCESSTOKEN="" \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_TEAMID="" \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_PROJECTID="" \
+	--CERTIMATE_DEPLOYER_VERCELPROJECT_DOMAIN=""
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("APIACCESSTOKEN: %v", fApiAccessToken),
+			fmt.Sprintf("TEAMID: %v", fTeamId),
+			fmt.Sprintf("PROJECTID: %v", fProjectId),
+			fmt.Sprintf("DOMAIN: %v", fDomain),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ApiAccessToken: fApiAccessToken,
+			TeamId:         fTeamId,
+			ProjectId:      fProjectId,
+			Domain:         fDomain,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
@@ -2,17 +2,22 @@
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"strings"
 
 	xerrors "github.com/pkg/errors"
 	veCdn "github.com/volcengine/volc-sdk-golang/service/cdn"
+	ve "github.com/volcengine/volcengine-go-sdk/volcengine"
+	"golang.org/x/exp/slices"
 
 	"github.com/usual2970/certimate/internal/pkg/core/deployer"
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/volcengine-cdn"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -22,6 +27,9 @@ type DeployerConfig struct {
 	AccessKeySecret string `json:"accessKeySecret"`
 	// 加速域名（支持泛域名）。
 	Domain string `json:"domain"`
+	// 是否部署到证书可覆盖的全部加速域名。
+	// 启用后将额外查询证书可关联的全部加速域名，并与证书的 SAN 进行匹配。
+	MatchCoveredDomains bool `json:"matchCoveredDomains,omitempty"`
 }
 
 type DeployerProvider struct {
@@ -31,7 +39,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -63,7 +74,55 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" && !d.config.MatchCoveredDomains {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 仅校验模式下只查询加速域名，不实际上传和关联证书
+	if deployer.MergeOptions(opts...).DryRun {
+		if d.config.Domain == "" || strings.HasPrefix(d.config.Domain, "*.") {
+			// 查询加速域名列表
+			// REF: https://www.volcengine.com/docs/6454
+			listCdnDomainsReq := &veCdn.ListCdnDomainsRequest{
+				PageNum:  ve.Int64(1),
+				PageSize: ve.Int64(100),
+			}
+			if d.config.Domain != "" {
+				listCdnDomainsReq.Domain = ve.String(strings.TrimPrefix(d.config.Domain, "*."))
+			}
+			listCdnDomainsResp, err := d.sdkClient.ListCdnDomains(listCdnDomainsReq)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.ListCdnDomains'")
+			}
+
+			d.logger.Logt("已查询到加速域名列表", listCdnDomainsResp)
+		} else {
+			// 查询加速域名配置
+			// REF: https://www.volcengine.com/docs/6454
+			describeCdnConfigReq := &veCdn.DescribeCdnConfigRequest{
+				Domain: d.config.Domain,
+			}
+			describeCdnConfigResp, err := d.sdkClient.DescribeCdnConfig(describeCdnConfigReq)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'cdn.DescribeCdnConfig'")
+			}
+
+			d.logger.Logt("已查询到加速域名配置", describeCdnConfigResp)
+		}
+
+		return &deployer.DeployResult{}, nil
+	}
+
 	// 上传证书到 CDN
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -73,7 +132,10 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	d.logger.Logt("certificate file uploaded", upres)
 
 	domains := make([]string, 0)
-	if strings.HasPrefix(d.config.Domain, "*.") {
+	if d.config.Domain != "" && !strings.HasPrefix(d.config.Domain, "*.") {
+		domains = append(domains, d.config.Domain)
+	}
+	if strings.HasPrefix(d.config.Domain, "*.") || d.config.MatchCoveredDomains {
 		// 获取指定证书可关联的域名
 		// REF: https://www.volcengine.com/docs/6454/125711
 		describeCertConfigReq := &veCdn.DescribeCertConfigRequest{
@@ -86,31 +148,33 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 		if describeCertConfigResp.Result.CertNotConfig != nil {
 			for i := range describeCertConfigResp.Result.CertNotConfig {
-				domains = append(domains, describeCertConfigResp.Result.CertNotConfig[i].Domain)
+				domain := describeCertConfigResp.Result.CertNotConfig[i].Domain
+				if d.matchDomain(certX509, domain) && !slices.Contains(domains, domain) {
+					domains = append(domains, domain)
+				}
 			}
 		}
 
 		if describeCertConfigResp.Result.OtherCertConfig != nil {
 			for i := range describeCertConfigResp.Result.OtherCertConfig {
-				domains = append(domains, describeCertConfigResp.Result.OtherCertConfig[i].Domain)
+				domain := describeCertConfigResp.Result.OtherCertConfig[i].Domain
+				if d.matchDomain(certX509, domain) && !slices.Contains(domains, domain) {
+					domains = append(domains, domain)
+				}
 			}
 		}
 
 		if len(domains) == 0 {
-			if len(describeCertConfigResp.Result.SpecifiedCertConfig) > 0 {
+			if slices.ContainsFunc(describeCertConfigResp.Result.SpecifiedCertConfig, func(item veCdn.DomainCertStatus) bool { return d.matchDomain(certX509, item.Domain) }) {
 				// 所有可关联的域名都配置了该证书，跳过部署
 			} else {
 				return nil, errors.New("domain not found")
 			}
 		}
-	} else {
-		domains = append(domains, d.config.Domain)
 	}
 
 	if len(domains) > 0 {
-		var errs []error
-
-		for _, domain := range domains {
+		err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
 			// 关联证书与加速域名
 			// REF: https://www.volcengine.com/docs/6454/125712
 			batchDeployCertReq := &veCdn.BatchDeployCertRequest{
@@ -119,16 +183,25 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			}
 			batchDeployCertResp, err := d.sdkClient.BatchDeployCert(batchDeployCertReq)
 			if err != nil {
-				errs = append(errs, err)
-			} else {
-				d.logger.Logt(fmt.Sprintf("已关联证书到域名 %s", domain), batchDeployCertResp)
+				return err
 			}
-		}
 
-		if len(errs) > 0 {
-			return nil, errors.Join(errs...)
+			d.logger.Logt(fmt.Sprintf("已关联证书到域名 %s", domain), batchDeployCertResp)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
+}
+
+func (d *DeployerProvider) matchDomain(certX509 *x509.Certificate, domain string) bool {
+	// 泛域名仅匹配其下一级子域名
+	if strings.HasPrefix(d.config.Domain, "*.") && certs.MatchHostname(d.config.Domain, domain) {
+		return true
+	}
+
+	return d.config.MatchCoveredDomains && certs.IsCertificateCoversHostname(certX509, domain)
 }
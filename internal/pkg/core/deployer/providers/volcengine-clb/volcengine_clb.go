@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	xerrors "github.com/pkg/errors"
 	veClb "github.com/volcengine/volcengine-go-sdk/service/clb"
@@ -37,7 +38,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -71,19 +75,15 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到证书中心
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	}
-
-	d.logger.Logt("certificate file uploaded", upres)
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
 
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	// 根据部署资源类型决定部署方式
 	switch d.config.ResourceType {
 	case RESOURCE_TYPE_LISTENER:
-		if err := d.deployToListener(ctx, upres.CertId); err != nil {
+		if err := d.deployToListener(ctx, certPem, privkeyPem, opts...); err != nil {
 			return nil, err
 		}
 
@@ -94,17 +94,53 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
-func (d *DeployerProvider) deployToListener(ctx context.Context, cloudCertId string) error {
+func (d *DeployerProvider) deployToListener(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) error {
 	if d.config.ListenerId == "" {
 		return errors.New("config `listenerId` is required")
 	}
 
+	// 查询监听器详情
+	// REF: https://www.volcengine.com/docs/6406
+	describeListenerAttributesReq := &veClb.DescribeListenerAttributesInput{
+		ListenerId: ve.String(d.config.ListenerId),
+	}
+	describeListenerAttributesResp, err := d.sdkClient.DescribeListenerAttributes(describeListenerAttributesReq)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to execute sdk request 'clb.DescribeListenerAttributes'")
+	} else {
+		d.logger.Logt("已查询到监听器详情", describeListenerAttributesResp)
+	}
+
+	if !strings.EqualFold(ve.StringValue(describeListenerAttributesResp.Protocol), "HTTPS") {
+		return fmt.Errorf("listener '%s' is not an HTTPS listener", d.config.ListenerId)
+	}
+
+	// 仅校验模式下只查询监听器，不实际上传和替换证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return nil
+	}
+
+	// 上传证书到证书中心
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	// 如果监听器已绑定相同证书，跳过部署
+	if ve.StringValue(describeListenerAttributesResp.CertificateSource) == "cert_center" &&
+		ve.StringValue(describeListenerAttributesResp.CertCenterCertificateId) == upres.CertId {
+		d.logger.Logt("监听器已绑定相同证书，跳过部署")
+		return nil
+	}
+
 	// 修改监听器
 	// REF: https://www.volcengine.com/docs/6406/71775
 	modifyListenerAttributesReq := &veClb.ModifyListenerAttributesInput{
 		ListenerId:              ve.String(d.config.ListenerId),
 		CertificateSource:       ve.String("cert_center"),
-		CertCenterCertificateId: ve.String(cloudCertId),
+		CertCenterCertificateId: ve.String(upres.CertId),
 	}
 	modifyListenerAttributesResp, err := d.sdkClient.ModifyListenerAttributes(modifyListenerAttributesReq)
 	if err != nil {
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"strings"
 
 	xerrors "github.com/pkg/errors"
@@ -33,7 +34,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -67,7 +71,35 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
+
+	// "*.example.com" → ".example.com"，适配火山引擎 DCDN 要求的泛域名格式
+	domain := strings.TrimPrefix(d.config.Domain, "*")
+
+	// 仅校验模式下只查询加速域名详情，不实际上传和绑定证书
+	if deployer.MergeOptions(opts...).DryRun {
+		// 查询加速域名详情
+		// REF: https://www.volcengine.com/docs/6559
+		describeDomainDetailReq := &veDcdn.DescribeDomainDetailInput{
+			Domain: ve.String(domain),
+		}
+		describeDomainDetailResp, err := d.sdkClient.DescribeDomainDetail(describeDomainDetailReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'dcdn.DescribeDomainDetail'")
+		}
+
+		d.logger.Logt("已查询到加速域名详情", describeDomainDetailResp)
+
+		return &deployer.DeployResult{}, nil
+	}
+
 	// 上传证书到证书中心
 	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
 	if err != nil {
@@ -76,9 +108,6 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 
 	d.logger.Logt("certificate file uploaded", upres)
 
-	// "*.example.com" → ".example.com"，适配火山引擎 DCDN 要求的泛域名格式
-	domain := strings.TrimPrefix(d.config.Domain, "*")
-
 	// 绑定证书
 	// REF: https://www.volcengine.com/docs/6559/1250189
 	createCertBindReq := &veDcdn.CreateCertBindInput{
@@ -93,7 +122,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		d.logger.Logt("已绑定证书", createCertBindResp)
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
 
 func createSdkClient(accessKeyId, accessKeySecret, region string) (*veDcdn.DCDN, error) {
@@ -14,6 +14,8 @@ import (
 	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
 	uploadersp "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/volcengine-live"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 )
 
 type DeployerConfig struct {
@@ -32,7 +34,10 @@ type DeployerProvider struct {
 	sslUploader uploader.Uploader
 }
 
-var _ deployer.Deployer = (*DeployerProvider)(nil)
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
 
 func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 	if config == nil {
@@ -64,14 +69,14 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
-	// 上传证书到 Live
-	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to upload certificate file")
-	}
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
 
-	d.logger.Logt("certificate file uploaded", upres)
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.Domain == "" {
+		return nil, errors.New("config `domain` is required")
+	}
 
 	domains := make([]string, 0)
 	if strings.HasPrefix(d.config.Domain, "*.") {
@@ -93,8 +98,7 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			if listDomainDetailResp.Result.DomainList != nil {
 				for _, item := range listDomainDetailResp.Result.DomainList {
 					// 仅匹配泛域名的下一级子域名
-					wildcardDomain := strings.TrimPrefix(d.config.Domain, "*")
-					if strings.HasSuffix(item.Domain, wildcardDomain) && !strings.Contains(strings.TrimSuffix(item.Domain, wildcardDomain), ".") {
+					if certs.MatchHostname(d.config.Domain, item.Domain) {
 						domains = append(domains, item.Domain)
 					}
 				}
@@ -116,10 +120,22 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 		domains = append(domains, d.config.Domain)
 	}
 
-	if len(domains) > 0 {
-		var errs []error
+	// 仅校验模式下只查询待部署的域名，不实际上传和绑定证书
+	if deployer.MergeOptions(opts...).DryRun {
+		d.logger.Logt("已查询到待部署的域名", domains)
+		return &deployer.DeployResult{}, nil
+	}
 
-		for _, domain := range domains {
+	// 上传证书到 Live
+	upres, err := d.sslUploader.Upload(ctx, certPem, privkeyPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to upload certificate file")
+	}
+
+	d.logger.Logt("certificate file uploaded", upres)
+
+	if len(domains) > 0 {
+		err := concurrent.ForEach(ctx, domains, 0, func(ctx context.Context, domain string) error {
 			// 绑定证书
 			// REF: https://www.volcengine.com/docs/6469/1186278#%E7%BB%91%E5%AE%9A%E8%AF%81%E4%B9%A6
 			bindCertReq := &veLive.BindCertBody{
@@ -129,16 +145,16 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 			}
 			bindCertResp, err := d.sdkClient.BindCert(ctx, bindCertReq)
 			if err != nil {
-				errs = append(errs, err)
-			} else {
-				d.logger.Logt(fmt.Sprintf("已绑定证书到域名 %s", domain), bindCertResp)
+				return err
 			}
-		}
 
-		if len(errs) > 0 {
-			return nil, errors.Join(errs...)
+			d.logger.Logt(fmt.Sprintf("已绑定证书到域名 %s", domain), bindCertResp)
+			return nil
+		})
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return &deployer.DeployResult{}, nil
+	return &deployer.DeployResult{CertId: upres.CertId}, nil
 }
@@ -1,10 +1,17 @@
 package webhook
 
 import (
+	"bytes"
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"strings"
+	"text/template"
 	"time"
 
 	"github.com/go-resty/resty/v2"
@@ -19,11 +26,56 @@ type DeployerConfig struct {
 	// Webhook URL。
 	WebhookUrl string `json:"webhookUrl"`
 	// Webhook 回调数据（JSON 格式）。
+	// 值中的 "${DOMAIN}" 等占位符将被替换为实际值。
 	WebhookData string `json:"webhookData,omitempty"`
+	// Webhook 回调数据模板（Go template 格式）。
+	// 选填。非空时优先于 WebhookData，可用变量详见 [TemplateData]。
+	WebhookTemplate string `json:"webhookTemplate,omitempty"`
+	// 请求内容类型。
+	// 零值时默认为 "application/json"。
+	ContentType string `json:"contentType,omitempty"`
+	// 自定义请求头。
+	// 选填。
+	Headers map[string]string `json:"headers,omitempty"`
+	// HMAC 签名密钥。
+	// 选填。非空时将以 HMAC-SHA256 对请求体签名，并通过 "X-Certimate-Signature" 请求头发送。
+	HmacSecret string `json:"hmacSecret,omitempty"`
+	// 客户端证书（PEM 格式）。
+	// 选填。用于双向 TLS 认证，需与 TlsClientKey 同时提供。
+	TlsClientCert string `json:"tlsClientCert,omitempty"`
+	// 客户端私钥（PEM 格式）。
+	// 选填。用于双向 TLS 认证，需与 TlsClientCert 同时提供。
+	TlsClientKey string `json:"tlsClientKey,omitempty"`
 	// 是否允许不安全的连接。
 	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
 }
 
+// 回调数据模板中可用的变量。
+type TemplateData struct {
+	// 证书主域名，即 CommonName。
+	Domain string
+	// 证书域名列表，即 SubjectAltNames。
+	Domains []string
+	// 完整证书链（PEM 格式）。
+	Certificate string
+	// 服务器证书（PEM 格式）。
+	ServerCertificate string
+	// 中间证书（PEM 格式）。
+	IntermediateCertificate string
+	// 私钥（PEM 格式）。
+	PrivateKey string
+	// 证书序列号（十六进制）。
+	SerialNumber string
+	// 证书颁发者。
+	Issuer string
+	// 证书生效时间。
+	NotBefore time.Time
+	// 证书过期时间。
+	NotAfter time.Time
+}
+
+const signatureHeader = "X-Certimate-Signature"
+
 type DeployerProvider struct {
 	config     *DeployerConfig
 	logger     logger.Logger
@@ -37,13 +89,24 @@ func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
 		panic("config is nil")
 	}
 
+	tlsConfig := &tls.Config{}
+	if config.AllowInsecureConnections {
+		tlsConfig.InsecureSkipVerify = true
+	}
+	if config.TlsClientCert != "" || config.TlsClientKey != "" {
+		clientCert, err := tls.X509KeyPair([]byte(config.TlsClientCert), []byte(config.TlsClientKey))
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to load tls client certificate")
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{clientCert}
+	}
+
 	client := resty.New().
 		SetTimeout(30 * time.Second).
 		SetRetryCount(3).
-		SetRetryWaitTime(5 * time.Second)
-	if config.AllowInsecureConnections {
-		client.SetTLSClientConfig(&tls.Config{InsecureSkipVerify: true})
-	}
+		SetRetryWaitTime(5 * time.Second).
+		SetTLSClientConfig(tlsConfig)
 
 	return &DeployerProvider{
 		config:     config,
@@ -57,29 +120,70 @@ func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
 	return d
 }
 
-func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string) (*deployer.DeployResult, error) {
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
 	certX509, err := certs.ParseCertificateFromPEM(certPem)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to parse x509")
 	}
 
-	var webhookData interface{}
-	err = json.Unmarshal([]byte(d.config.WebhookData), &webhookData)
-	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to unmarshall webhook data")
+	var body []byte
+	if d.config.WebhookTemplate != "" {
+		serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to extract certs")
+		}
+
+		body, err = renderTemplate(d.config.WebhookTemplate, &TemplateData{
+			Domain:                  certX509.Subject.CommonName,
+			Domains:                 certX509.DNSNames,
+			Certificate:             certPem,
+			ServerCertificate:       serverCertPem,
+			IntermediateCertificate: intermediaCertPem,
+			PrivateKey:              privkeyPem,
+			SerialNumber:            strings.ToUpper(certX509.SerialNumber.Text(16)),
+			Issuer:                  certX509.Issuer.String(),
+			NotBefore:               certX509.NotBefore,
+			NotAfter:                certX509.NotAfter,
+		})
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to render webhook template")
+		}
+	} else {
+		var webhookData interface{}
+		err = json.Unmarshal([]byte(d.config.WebhookData), &webhookData)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to unmarshall webhook data")
+		}
+
+		replaceJsonValueRecursively(webhookData, "${DOMAIN}", certX509.Subject.CommonName)
+		replaceJsonValueRecursively(webhookData, "${DOMAINS}", strings.Join(certX509.DNSNames, ";"))
+		replaceJsonValueRecursively(webhookData, "${SUBJECT_ALT_NAMES}", strings.Join(certX509.DNSNames, ";"))
+		replaceJsonValueRecursively(webhookData, "${CERTIFICATE}", certPem)
+		replaceJsonValueRecursively(webhookData, "${PRIVATE_KEY}", privkeyPem)
+
+		body, err = json.Marshal(webhookData)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to marshall webhook data")
+		}
 	}
 
-	replaceJsonValueRecursively(webhookData, "${DOMAIN}", certX509.Subject.CommonName)
-	replaceJsonValueRecursively(webhookData, "${DOMAINS}", strings.Join(certX509.DNSNames, ";"))
-	replaceJsonValueRecursively(webhookData, "${SUBJECT_ALT_NAMES}", strings.Join(certX509.DNSNames, ";"))
-	replaceJsonValueRecursively(webhookData, "${CERTIFICATE}", certPem)
-	replaceJsonValueRecursively(webhookData, "${PRIVATE_KEY}", privkeyPem)
+	contentType := d.config.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
 
-	resp, err := d.httpClient.R().
+	req := d.httpClient.R().
 		SetContext(ctx).
-		SetHeader("Content-Type", "application/json").
-		SetBody(webhookData).
-		Post(d.config.WebhookUrl)
+		SetHeader("Content-Type", contentType).
+		SetHeaders(d.config.Headers).
+		SetBody(body)
+	if d.config.HmacSecret != "" {
+		mac := hmac.New(sha256.New, []byte(d.config.HmacSecret))
+		mac.Write(body)
+		req.SetHeader(signatureHeader, "sha256="+hex.EncodeToString(mac.Sum(nil)))
+	}
+
+	resp, err := req.Post(d.config.WebhookUrl)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to send webhook request")
 	} else if resp.StatusCode() != 200 {
@@ -91,6 +195,38 @@ func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPe
 	return &deployer.DeployResult{}, nil
 }
 
+func renderTemplate(text string, data *TemplateData) ([]byte, error) {
+	tmpl, err := template.New("webhook").
+		Option("missingkey=error").
+		Funcs(template.FuncMap{
+			"json": func(v any) (string, error) {
+				b, err := json.Marshal(v)
+				return string(b), err
+			},
+			"join": func(sep string, elems []string) string {
+				return strings.Join(elems, sep)
+			},
+			"base64": func(s string) string {
+				return base64.StdEncoding.EncodeToString([]byte(s))
+			},
+		}).
+		Parse(text)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+
+	if buf.Len() == 0 {
+		return nil, errors.New("rendered webhook body is empty")
+	}
+
+	return buf.Bytes(), nil
+}
+
 func replaceJsonValueRecursively(data interface{}, oldStr, newStr string) interface{} {
 	switch v := data.(type) {
 	case map[string]interface{}:
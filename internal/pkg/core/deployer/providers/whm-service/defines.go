@@ -0,0 +1,14 @@
+package whmservice
+
+type ServiceType string
+
+const (
+	// 服务类型：cPanel、WHM 及 Webmail 管理界面。
+	SERVICE_TYPE_CPANEL = ServiceType("cpanel")
+	// 服务类型：Exim 邮件服务。
+	SERVICE_TYPE_EXIM = ServiceType("exim")
+	// 服务类型：Dovecot 邮件服务。
+	SERVICE_TYPE_DOVECOT = ServiceType("dovecot")
+	// 服务类型：FTP 服务。
+	SERVICE_TYPE_FTP = ServiceType("ftp")
+)
@@ -0,0 +1,162 @@
+package whmservice
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	cpanelsdk "github.com/usual2970/certimate/internal/pkg/vendors/cpanel-sdk"
+)
+
+type DeployerConfig struct {
+	// WHM 地址。
+	ServerUrl string `json:"serverUrl"`
+	// WHM 用户名。
+	Username string `json:"username"`
+	// WHM API 令牌。
+	ApiToken string `json:"apiToken"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 服务类型列表。
+	// 选填。零值时默认为 [SERVICE_TYPE_CPANEL]。
+	ServiceTypes []ServiceType `json:"serviceTypes,omitempty"`
+	// 是否在安装证书后重启对应服务。
+	RestartServices bool `json:"restartServices,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *cpanelsdk.WhmClient
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.ServerUrl, config.Username, config.ApiToken, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	serviceTypes := d.config.ServiceTypes
+	if len(serviceTypes) == 0 {
+		serviceTypes = []ServiceType{SERVICE_TYPE_CPANEL}
+	}
+	for _, serviceType := range serviceTypes {
+		if _, ok := restartServiceNames[serviceType]; !ok {
+			return nil, xerrors.Errorf("unsupported service type: %s", serviceType)
+		}
+	}
+
+	// 仅校验模式下只检查 API 令牌是否有效，不做任何变更
+	if deployer.MergeOptions(opts...).DryRun {
+		// REF: https://api.docs.cpanel.net/openapi/whm/operation/version/
+		versionResp, err := d.sdkClient.Version()
+		d.logger.Logt("已查询到 WHM 版本", versionResp)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'whm.Version'")
+		}
+
+		d.logger.Logt("dry run: certificate would be installed for services", serviceTypes)
+		return &deployer.DeployResult{}, nil
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, intermediaCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	for _, serviceType := range serviceTypes {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		default:
+		}
+
+		// 为服务安装证书
+		// REF: https://api.docs.cpanel.net/openapi/whm/operation/install_service_ssl_certificate/
+		installReq := &cpanelsdk.ServiceSSLInstallRequest{
+			Service:  string(serviceType),
+			Crt:      serverCertPem,
+			Key:      privkeyPem,
+			CABundle: intermediaCertPem,
+		}
+		installResp, err := d.sdkClient.InstallServiceSSLCertificate(installReq)
+		d.logger.Logt("已为服务安装证书", string(serviceType), installResp)
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to execute sdk request 'whm.InstallServiceSSLCertificate' (service: %s)", serviceType)
+		}
+
+		// 重启服务，使新证书生效；重启 cpsrvd 时连接可能会被中断，因此仅记录错误
+		if d.config.RestartServices {
+			// REF: https://api.docs.cpanel.net/openapi/whm/operation/restartservice/
+			restartResp, err := d.sdkClient.RestartService(restartServiceNames[serviceType])
+			if err != nil {
+				d.logger.Logt("重启服务失败", string(serviceType), err.Error())
+			} else {
+				d.logger.Logt("已重启服务", string(serviceType), restartResp)
+			}
+		}
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+// 服务类型与 WHM restartservice 接口中服务名称的映射。
+var restartServiceNames = map[ServiceType]string{
+	SERVICE_TYPE_CPANEL:  "cpsrvd",
+	SERVICE_TYPE_EXIM:    "exim",
+	SERVICE_TYPE_DOVECOT: "dovecot",
+	SERVICE_TYPE_FTP:     "ftpd",
+}
+
+func createSdkClient(serverUrl, username, apiToken string, skipTlsVerify bool) (*cpanelsdk.WhmClient, error) {
+	if serverUrl == "" {
+		return nil, errors.New("invalid whm server url")
+	}
+	if username == "" {
+		return nil, errors.New("invalid whm username")
+	}
+	if apiToken == "" {
+		return nil, errors.New("invalid whm api token")
+	}
+
+	client := cpanelsdk.NewWhmClient(serverUrl, username, apiToken).
+		WithTimeout(30 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,78 @@
+package whmservice_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/whm-service"
+)
+
+var (
+	fInputCertPath string
+	fInputKeyPath  string
+	fServerUrl     string
+	fUsername      string
+	fApiToken      string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_WHMSERVICE_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServerUrl, argsPrefix+"SERVERURL", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fApiToken, argsPrefix+"APITOKEN", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./whm_service_test.go -args \
+	--CERTIMATE_DEPLOYER_WHMSERVICE_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_WHMSERVICE_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_WHMSERVICE_SERVERURL="https://example.com:2087" \
+	--CERTIMATE_DEPLOYER_WHMSERVICE_USERNAME="root" \
+	--CERTIMATE_DEPLOYER_WHMSERVICE_APITOKEN="your-api-token"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERURL: %v", fServerUrl),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("APITOKEN: %v", fApiToken),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			ServerUrl:                fServerUrl,
+			Username:                 fUsername,
+			ApiToken:                 fApiToken,
+			AllowInsecureConnections: true,
+			ServiceTypes:             []provider.ServiceType{provider.SERVICE_TYPE_CPANEL},
+			RestartServices:          true,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
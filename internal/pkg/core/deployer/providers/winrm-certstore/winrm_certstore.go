@@ -0,0 +1,186 @@
+package winrmcertstore
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	winrmsdk "github.com/usual2970/certimate/internal/pkg/vendors/winrm-sdk"
+)
+
+type DeployerConfig struct {
+	// WinRM 主机地址。
+	Host string `json:"host"`
+	// WinRM 端口。
+	// 零值时 HTTP 默认为 5985、HTTPS 默认为 5986。
+	Port int32 `json:"port,omitempty"`
+	// 是否使用 HTTPS。
+	UseHttps bool `json:"useHttps,omitempty"`
+	// WinRM 认证方式。
+	// 零值时默认为 "ntlm"。NTLM 认证仅支持 HTTPS。
+	AuthMethod string `json:"authMethod,omitempty"`
+	// WinRM 用户名。
+	Username string `json:"username"`
+	// WinRM 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// 证书友好名称。
+	// 选填。
+	FriendlyName string `json:"friendlyName,omitempty"`
+	// 导入证书后执行的 PowerShell 脚本。
+	// 选填。脚本中可通过变量 $thumbprint 获取已导入证书的指纹。
+	PostCommand string `json:"postCommand,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *winrmsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Host, config.Port, config.UseHttps, config.AuthMethod, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	// 检查远程主机是否可连接
+	if _, err := d.runPowerShell("Write-Output $env:COMPUTERNAME"); err != nil {
+		return nil, xerrors.Wrap(err, "failed to connect to remote host")
+	}
+
+	d.logger.Logt("已连接到远程主机", d.config.Host)
+
+	// 仅校验模式下只检查连接，不实际导入证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	pfxPassword := fmt.Sprintf("certimate%d", time.Now().UnixNano())
+	pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, pfxPassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+	}
+
+	// 导入 PFX 到本地计算机的个人证书存储
+	// REF: https://learn.microsoft.com/en-us/dotnet/api/system.security.cryptography.x509certificates.x509store
+	importScript := strings.Join([]string{
+		fmt.Sprintf("$pfxBytes = [Convert]::FromBase64String(%s)", quotePowerShellString(base64.StdEncoding.EncodeToString(pfxData))),
+		fmt.Sprintf("$cert = New-Object System.Security.Cryptography.X509Certificates.X509Certificate2($pfxBytes, %s, 'MachineKeySet,PersistKeySet,Exportable')", quotePowerShellString(pfxPassword)),
+		fmt.Sprintf("$friendlyName = %s", quotePowerShellString(d.config.FriendlyName)),
+		"if ($friendlyName) { $cert.FriendlyName = $friendlyName }",
+		"$store = New-Object System.Security.Cryptography.X509Certificates.X509Store('My', 'LocalMachine')",
+		"$store.Open('ReadWrite')",
+		"try { $store.Add($cert) } finally { $store.Close() }",
+		"Write-Output $cert.Thumbprint",
+	}, "\n")
+	stdout, err := d.runPowerShell(importScript)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to import certificate to certificate store")
+	}
+
+	thumbprint := strings.TrimSpace(stdout)
+	d.logger.Logt("已导入证书到本地计算机证书存储", thumbprint)
+
+	// 执行后置脚本
+	if d.config.PostCommand != "" {
+		postScript := strings.Join([]string{
+			"$ErrorActionPreference = 'Stop'",
+			fmt.Sprintf("$thumbprint = %s", quotePowerShellString(thumbprint)),
+			d.config.PostCommand,
+		}, "\n")
+		stdout, err := d.runPowerShell(postScript)
+		if err != nil {
+			return nil, xerrors.Wrapf(err, "failed to execute post-command, stdout: %s", stdout)
+		}
+
+		d.logger.Logt("已执行后置脚本", stdout)
+	}
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"thumbprint": thumbprint,
+		},
+	}, nil
+}
+
+func (d *DeployerProvider) runPowerShell(script string) (string, error) {
+	result, err := d.sdkClient.RunPowerShell(script)
+	if err != nil {
+		return "", err
+	} else if result.ExitCode != 0 {
+		return result.Stdout, fmt.Errorf("powershell exited with code %d: %s", result.ExitCode, strings.TrimSpace(result.Stderr))
+	}
+
+	return result.Stdout, nil
+}
+
+func quotePowerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func createSdkClient(host string, port int32, useHttps bool, authMethod, username, password string, skipTlsVerify bool) (*winrmsdk.Client, error) {
+	if host == "" {
+		return nil, errors.New("invalid winrm host")
+	}
+
+	if username == "" || password == "" {
+		return nil, errors.New("invalid winrm credentials")
+	}
+
+	switch authMethod {
+	case "":
+		authMethod = winrmsdk.AuthMethodNTLM
+	case winrmsdk.AuthMethodBasic, winrmsdk.AuthMethodNTLM:
+	default:
+		return nil, fmt.Errorf("invalid winrm auth method '%s'", authMethod)
+	}
+
+	if authMethod == winrmsdk.AuthMethodNTLM && !useHttps {
+		return nil, errors.New("winrm ntlm authentication requires https")
+	}
+
+	client := winrmsdk.NewClient(host, port, useHttps, authMethod, username, password).
+		WithTimeout(90 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
@@ -0,0 +1,205 @@
+package winrmiis
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	winrmsdk "github.com/usual2970/certimate/internal/pkg/vendors/winrm-sdk"
+)
+
+type DeployerConfig struct {
+	// WinRM 主机地址。
+	Host string `json:"host"`
+	// WinRM 端口。
+	// 零值时 HTTP 默认为 5985、HTTPS 默认为 5986。
+	Port int32 `json:"port,omitempty"`
+	// 是否使用 HTTPS。
+	UseHttps bool `json:"useHttps,omitempty"`
+	// WinRM 认证方式。
+	// 零值时默认为 "ntlm"。NTLM 认证仅支持 HTTPS。
+	AuthMethod string `json:"authMethod,omitempty"`
+	// WinRM 用户名。
+	Username string `json:"username"`
+	// WinRM 密码。
+	Password string `json:"password"`
+	// 是否允许不安全的连接。
+	AllowInsecureConnections bool `json:"allowInsecureConnections,omitempty"`
+	// IIS 网站名称。
+	SiteName string `json:"siteName"`
+	// IIS 网站 HTTPS 绑定的主机名（SNI）。
+	// 选填。零值时匹配未指定主机名的绑定。
+	BindingHostname string `json:"bindingHostname,omitempty"`
+	// IIS 网站 HTTPS 绑定的端口。
+	// 零值时默认为 443。
+	BindingPort int32 `json:"bindingPort,omitempty"`
+}
+
+type DeployerProvider struct {
+	config    *DeployerConfig
+	logger    logger.Logger
+	sdkClient *winrmsdk.Client
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.Host, config.Port, config.UseHttps, config.AuthMethod, config.Username, config.Password, config.AllowInsecureConnections)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &DeployerProvider{
+		config:    config,
+		logger:    logger.NewNilLogger(),
+		sdkClient: client,
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if d.config.SiteName == "" {
+		return nil, errors.New("config `siteName` is required")
+	}
+
+	bindingPort := d.config.BindingPort
+	if bindingPort == 0 {
+		bindingPort = 443
+	}
+
+	if d.config.BindingHostname != "" {
+		certX509, err := certs.ParseCertificateFromPEM(certPem)
+		if err != nil {
+			return nil, err
+		} else if !certs.IsCertificateCoversHostname(certX509, d.config.BindingHostname) {
+			return nil, fmt.Errorf("certificate does not cover binding hostname '%s'", d.config.BindingHostname)
+		}
+	}
+
+	// 检查 IIS 网站是否存在
+	checkScript := strings.Join([]string{
+		"Import-Module WebAdministration",
+		fmt.Sprintf("if (-not (Get-Website -Name %s)) { throw 'IIS site not found' }", quotePowerShellString(d.config.SiteName)),
+	}, "\n")
+	if _, err := d.runPowerShell(checkScript); err != nil {
+		return nil, xerrors.Wrapf(err, "failed to check iis site '%s'", d.config.SiteName)
+	}
+
+	d.logger.Logt("已检查 IIS 网站", d.config.SiteName)
+
+	// 仅校验模式下只检查 IIS 网站，不实际导入证书
+	if deployer.MergeOptions(opts...).DryRun {
+		return &deployer.DeployResult{}, nil
+	}
+
+	pfxPassword := fmt.Sprintf("certimate%d", time.Now().UnixNano())
+	pfxData, err := certs.TransformCertificateFromPEMToPFX(certPem, privkeyPem, pfxPassword)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to transform certificate to PFX")
+	}
+
+	// 导入 PFX 到本地计算机的个人证书存储，再将证书绑定到 IIS 网站的 HTTPS 绑定
+	// 绑定不存在时将新建；指定主机名时新建的绑定将启用 SNI
+	// REF: https://learn.microsoft.com/en-us/iis/configuration/system.applicationhost/sites/site/bindings/binding
+	deployScript := strings.Join([]string{
+		"Import-Module WebAdministration",
+		fmt.Sprintf("$pfxBytes = [Convert]::FromBase64String(%s)", quotePowerShellString(base64.StdEncoding.EncodeToString(pfxData))),
+		fmt.Sprintf("$cert = New-Object System.Security.Cryptography.X509Certificates.X509Certificate2($pfxBytes, %s, 'MachineKeySet,PersistKeySet,Exportable')", quotePowerShellString(pfxPassword)),
+		"$store = New-Object System.Security.Cryptography.X509Certificates.X509Store('My', 'LocalMachine')",
+		"$store.Open('ReadWrite')",
+		"try { $store.Add($cert) } finally { $store.Close() }",
+		fmt.Sprintf("$siteName = %s", quotePowerShellString(d.config.SiteName)),
+		fmt.Sprintf("$bindingPort = %d", bindingPort),
+		fmt.Sprintf("$bindingHostname = %s", quotePowerShellString(d.config.BindingHostname)),
+		"$binding = Get-WebBinding -Name $siteName -Protocol 'https' | Where-Object { $parts = $_.bindingInformation.Split(':'); $parts[1] -eq \"$bindingPort\" -and $parts[2] -eq $bindingHostname } | Select-Object -First 1",
+		"if (-not $binding) {",
+		"  if ($bindingHostname) { New-WebBinding -Name $siteName -Protocol 'https' -Port $bindingPort -HostHeader $bindingHostname -SslFlags 1 }",
+		"  else { New-WebBinding -Name $siteName -Protocol 'https' -Port $bindingPort }",
+		"  $binding = Get-WebBinding -Name $siteName -Protocol 'https' | Where-Object { $parts = $_.bindingInformation.Split(':'); $parts[1] -eq \"$bindingPort\" -and $parts[2] -eq $bindingHostname } | Select-Object -First 1",
+		"}",
+		"if ($binding.certificateHash -ne $cert.Thumbprint) { $binding.AddSslCertificate($cert.Thumbprint, 'My') }",
+		"Write-Output $cert.Thumbprint",
+	}, "\n")
+	stdout, err := d.runPowerShell(deployScript)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to import certificate and bind to iis site")
+	}
+
+	thumbprint := strings.TrimSpace(stdout)
+	d.logger.Logt("已导入证书并绑定到 IIS 网站", thumbprint)
+
+	return &deployer.DeployResult{
+		ExtendedData: map[string]any{
+			"thumbprint": thumbprint,
+		},
+	}, nil
+}
+
+func (d *DeployerProvider) runPowerShell(script string) (string, error) {
+	result, err := d.sdkClient.RunPowerShell(script)
+	if err != nil {
+		return "", err
+	} else if result.ExitCode != 0 {
+		return result.Stdout, fmt.Errorf("powershell exited with code %d: %s", result.ExitCode, strings.TrimSpace(result.Stderr))
+	}
+
+	return result.Stdout, nil
+}
+
+func quotePowerShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
+
+func createSdkClient(host string, port int32, useHttps bool, authMethod, username, password string, skipTlsVerify bool) (*winrmsdk.Client, error) {
+	if host == "" {
+		return nil, errors.New("invalid winrm host")
+	}
+
+	if username == "" || password == "" {
+		return nil, errors.New("invalid winrm credentials")
+	}
+
+	switch authMethod {
+	case "":
+		authMethod = winrmsdk.AuthMethodNTLM
+	case winrmsdk.AuthMethodBasic, winrmsdk.AuthMethodNTLM:
+	default:
+		return nil, fmt.Errorf("invalid winrm auth method '%s'", authMethod)
+	}
+
+	if authMethod == winrmsdk.AuthMethodNTLM && !useHttps {
+		return nil, errors.New("winrm ntlm authentication requires https")
+	}
+
+	client := winrmsdk.NewClient(host, port, useHttps, authMethod, username, password).
+		WithTimeout(90 * time.Second)
+	if skipTlsVerify {
+		client.WithTLSConfig(&tls.Config{InsecureSkipVerify: true})
+	}
+
+	return client, nil
+}
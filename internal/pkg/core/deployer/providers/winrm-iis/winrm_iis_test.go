@@ -0,0 +1,92 @@
+package winrmiis_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/winrm-iis"
+)
+
+var (
+	fInputCertPath   string
+	fInputKeyPath    string
+	fHost            string
+	fPort            int64
+	fUsername        string
+	fPassword        string
+	fSiteName        string
+	fBindingHostname string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_WINRMIIS_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fHost, argsPrefix+"HOST", "", "")
+	flag.Int64Var(&fPort, argsPrefix+"PORT", 0, "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fSiteName, argsPrefix+"SITENAME", "", "")
+	flag.StringVar(&fBindingHostname, argsPrefix+"BINDINGHOSTNAME", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./winrm_iis_test.go -args \
+	--CERTIMATE_DEPLOYER_WINRMIIS_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_HOST="192.168.1.1" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_PORT=5986 \
+	--CERTIMATE_DEPLOYER_WINRMIIS_USERNAME="Administrator" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_PASSWORD="password" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_SITENAME="Default Web Site" \
+	--CERTIMATE_DEPLOYER_WINRMIIS_BINDINGHOSTNAME="example.com"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("HOST: %v", fHost),
+			fmt.Sprintf("PORT: %v", fPort),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("SITENAME: %v", fSiteName),
+			fmt.Sprintf("BINDINGHOSTNAME: %v", fBindingHostname),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Host:                     fHost,
+			Port:                     int32(fPort),
+			UseHttps:                 true,
+			Username:                 fUsername,
+			Password:                 fPassword,
+			AllowInsecureConnections: true,
+			SiteName:                 fSiteName,
+			BindingHostname:          fBindingHostname,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
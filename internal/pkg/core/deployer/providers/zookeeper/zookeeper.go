@@ -0,0 +1,166 @@
+package zookeeper
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-zookeeper/zk"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
+)
+
+type DeployerConfig struct {
+	// ZooKeeper 服务器地址列表，多个值之间以半角分号分隔。
+	Servers string `json:"servers"`
+	// ZooKeeper digest 认证用户名。
+	// 零值时表示不启用身份认证。
+	Username string `json:"username,omitempty"`
+	// ZooKeeper digest 认证密码。
+	Password string `json:"password,omitempty"`
+	// 用于存放证书的节点路径。
+	PathForCertificate string `json:"pathForCertificate"`
+	// 用于存放私钥的节点路径。
+	PathForPrivateKey string `json:"pathForPrivateKey"`
+}
+
+type DeployerProvider struct {
+	config *DeployerConfig
+	logger logger.Logger
+}
+
+var (
+	_ deployer.Deployer        = (*DeployerProvider)(nil)
+	_ deployer.DryRunSupporter = (*DeployerProvider)(nil)
+)
+
+func NewDeployer(config *DeployerConfig) (*DeployerProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	return &DeployerProvider{
+		config: config,
+		logger: logger.NewNilLogger(),
+	}, nil
+}
+
+func (d *DeployerProvider) WithLogger(logger logger.Logger) *DeployerProvider {
+	d.logger = logger
+	return d
+}
+
+func (d *DeployerProvider) SupportsDryRun() bool {
+	return true
+}
+
+func (d *DeployerProvider) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	if !strings.HasPrefix(d.config.PathForCertificate, "/") {
+		return nil, errors.New("config `pathForCertificate` is required and must be an absolute path")
+	}
+	if !strings.HasPrefix(d.config.PathForPrivateKey, "/") {
+		return nil, errors.New("config `pathForPrivateKey` is required and must be an absolute path")
+	}
+
+	// 连接
+	conn, err := createConn(d.config)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create zookeeper connection")
+	}
+	defer conn.Close()
+
+	// 仅校验模式下只检查连通性及读取权限，不写入任何数据
+	if deployer.MergeOptions(opts...).DryRun {
+		if _, _, err := conn.Exists(d.config.PathForCertificate); err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'zk.Exists'")
+		}
+
+		d.logger.Logt("dry run: zookeeper is reachable, nothing written")
+		return &deployer.DeployResult{}, nil
+	}
+
+	acl := zk.WorldACL(zk.PermAll)
+	if d.config.Username != "" {
+		acl = zk.DigestACL(zk.PermAll, d.config.Username, d.config.Password)
+	}
+
+	// 写入证书和私钥节点，均为持久节点
+	if err := writeNode(conn, d.config.PathForCertificate, []byte(certPem), acl); err != nil {
+		return nil, xerrors.Wrap(err, "failed to write certificate node")
+	}
+
+	d.logger.Logt("certificate node written", d.config.PathForCertificate)
+
+	if err := writeNode(conn, d.config.PathForPrivateKey, []byte(privkeyPem), acl); err != nil {
+		return nil, xerrors.Wrap(err, "failed to write private key node")
+	}
+
+	d.logger.Logt("private key node written", d.config.PathForPrivateKey)
+
+	return &deployer.DeployResult{}, nil
+}
+
+func createConn(config *DeployerConfig) (*zk.Conn, error) {
+	servers := make([]string, 0)
+	for _, server := range strings.Split(config.Servers, ";") {
+		server = strings.TrimSpace(server)
+		if server == "" {
+			continue
+		}
+
+		servers = append(servers, server)
+	}
+	if len(servers) == 0 {
+		return nil, errors.New("config `servers` is required")
+	}
+
+	conn, _, err := zk.Connect(servers, 30*time.Second, zk.WithLogger(nilLogger{}))
+	if err != nil {
+		return nil, err
+	}
+
+	if config.Username != "" {
+		if err := conn.AddAuth("digest", []byte(config.Username+":"+config.Password)); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+
+	return conn, nil
+}
+
+func writeNode(conn *zk.Conn, nodePath string, data []byte, acl []zk.ACL) error {
+	// 如果节点已存在，则直接更新数据
+	exists, _, err := conn.Exists(nodePath)
+	if err != nil {
+		return err
+	} else if exists {
+		_, err := conn.Set(nodePath, data, -1)
+		return err
+	}
+
+	// 逐级创建父节点
+	if parent := path.Dir(nodePath); parent != "/" {
+		segments := strings.Split(strings.TrimPrefix(parent, "/"), "/")
+		for i := range segments {
+			p := "/" + strings.Join(segments[:i+1], "/")
+			if _, err := conn.Create(p, nil, zk.FlagPersistent, acl); err != nil && !errors.Is(err, zk.ErrNodeExists) {
+				return err
+			}
+		}
+	}
+
+	_, err = conn.Create(nodePath, data, zk.FlagPersistent, acl)
+	if errors.Is(err, zk.ErrNodeExists) {
+		_, err = conn.Set(nodePath, data, -1)
+	}
+	return err
+}
+
+type nilLogger struct{}
+
+func (nilLogger) Printf(string, ...interface{}) {}
@@ -0,0 +1,85 @@
+package zookeeper_test
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	provider "github.com/usual2970/certimate/internal/pkg/core/deployer/providers/zookeeper"
+)
+
+var (
+	fInputCertPath      string
+	fInputKeyPath       string
+	fServers            string
+	fUsername           string
+	fPassword           string
+	fPathForCertificate string
+	fPathForPrivateKey  string
+)
+
+func init() {
+	argsPrefix := "CERTIMATE_DEPLOYER_ZOOKEEPER_"
+
+	flag.StringVar(&fInputCertPath, argsPrefix+"INPUTCERTPATH", "", "")
+	flag.StringVar(&fInputKeyPath, argsPrefix+"INPUTKEYPATH", "", "")
+	flag.StringVar(&fServers, argsPrefix+"SERVERS", "", "")
+	flag.StringVar(&fUsername, argsPrefix+"USERNAME", "", "")
+	flag.StringVar(&fPassword, argsPrefix+"PASSWORD", "", "")
+	flag.StringVar(&fPathForCertificate, argsPrefix+"PATHFORCERTIFICATE", "", "")
+	flag.StringVar(&fPathForPrivateKey, argsPrefix+"PATHFORPRIVATEKEY", "", "")
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./zookeeper_test.go -args \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_INPUTCERTPATH="/path/to/your-input-cert.pem" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_INPUTKEYPATH="/path/to/your-input-key.pem" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_SERVERS="127.0.0.1:2181" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_USERNAME="user" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_PASSWORD="password" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_PATHFORCERTIFICATE="/certimate/example.com/tls.crt" \
+	--CERTIMATE_DEPLOYER_ZOOKEEPER_PATHFORPRIVATEKEY="/certimate/example.com/tls.key"
+*/
+func TestDeploy(t *testing.T) {
+	flag.Parse()
+
+	t.Run("Deploy", func(t *testing.T) {
+		t.Log(strings.Join([]string{
+			"args:",
+			fmt.Sprintf("INPUTCERTPATH: %v", fInputCertPath),
+			fmt.Sprintf("INPUTKEYPATH: %v", fInputKeyPath),
+			fmt.Sprintf("SERVERS: %v", fServers),
+			fmt.Sprintf("USERNAME: %v", fUsername),
+			fmt.Sprintf("PASSWORD: %v", fPassword),
+			fmt.Sprintf("PATHFORCERTIFICATE: %v", fPathForCertificate),
+			fmt.Sprintf("PATHFORPRIVATEKEY: %v", fPathForPrivateKey),
+		}, "\n"))
+
+		deployer, err := provider.NewDeployer(&provider.DeployerConfig{
+			Servers:            fServers,
+			Username:           fUsername,
+			Password:           fPassword,
+			PathForCertificate: fPathForCertificate,
+			PathForPrivateKey:  fPathForPrivateKey,
+		})
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		fInputCertData, _ := os.ReadFile(fInputCertPath)
+		fInputKeyData, _ := os.ReadFile(fInputKeyPath)
+		res, err := deployer.Deploy(context.Background(), string(fInputCertData), string(fInputKeyData))
+		if err != nil {
+			t.Errorf("err: %+v", err)
+			return
+		}
+
+		t.Logf("ok: %v", res)
+	})
+}
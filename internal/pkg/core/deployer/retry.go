@@ -0,0 +1,239 @@
+﻿package deployer
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	hwsdkerr "github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	xerrors "github.com/pkg/errors"
+)
+
+// 表示部署重试策略的数据结构。
+// 重试时将重新调用 [Deployer.Deploy]，因此部署器应保证重复部署是安全的。
+type RetryPolicy struct {
+	// 最大重试次数。
+	// 零值表示不重试。
+	MaxRetries int `json:"maxRetries,omitempty"`
+	// 首次重试前的等待时间。
+	// 零值时默认为 1 秒。
+	InitialInterval time.Duration `json:"initialInterval,omitempty"`
+	// 重试前的最大等待时间。
+	// 零值时默认为 30 秒；小于首次重试前的等待时间时以后者为准。
+	MaxInterval time.Duration `json:"maxInterval,omitempty"`
+	// 每次重试后等待时间的增长倍数。
+	// 零值时默认为 2。
+	Multiplier float64 `json:"multiplier,omitempty"`
+	// 判断错误是否可重试的函数。
+	// 为 nil 时使用 [IsRetryableError]。
+	Retryable func(err error) bool `json:"-"`
+}
+
+// 按重试策略部署证书。
+// 仅当部署失败且错误可重试时才会重试，每次重试前按指数退避并附加随机抖动进行等待。
+//
+// 入参：
+//   - ctx：上下文。上下文取消后将不再重试。
+//   - d：部署器。
+//   - certPem：证书 PEM 内容。
+//   - privkeyPem：私钥 PEM 内容。
+//   - policy：重试策略。
+//   - opts：部署选项，将原样传递给 [Deployer.Deploy]。
+//
+// 出参：
+//   - res：最后一次部署的结果。
+//   - err: 最后一次部署的错误。
+func DeployWithRetry(ctx context.Context, d Deployer, certPem string, privkeyPem string, policy RetryPolicy, opts ...Options) (res *DeployResult, err error) {
+	retryable := policy.Retryable
+	if retryable == nil {
+		retryable = IsRetryableError
+	}
+
+	for attempt := 0; ; attempt++ {
+		res, err = d.Deploy(ctx, certPem, privkeyPem, opts...)
+		if err == nil || ctx.Err() != nil || !retryable(err) {
+			return res, err
+		}
+
+		if attempt >= policy.MaxRetries {
+			if attempt > 0 {
+				return res, xerrors.Wrapf(err, "failed after %d retries", attempt)
+			}
+			return res, err
+		}
+
+		timer := time.NewTimer(policy.backoff(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return res, err
+		case <-timer.C:
+		}
+	}
+}
+
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	initialInterval := p.InitialInterval
+	if initialInterval <= 0 {
+		initialInterval = time.Second
+	}
+
+	maxInterval := p.MaxInterval
+	if maxInterval <= 0 {
+		maxInterval = 30 * time.Second
+	}
+	if maxInterval < initialInterval {
+		maxInterval = initialInterval
+	}
+
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	interval := float64(initialInterval) * math.Pow(multiplier, float64(attempt))
+	if interval > float64(maxInterval) {
+		interval = float64(maxInterval)
+	}
+
+	// 在 [interval/2, interval] 范围内随机抖动，避免多个部署器同时重试
+	return time.Duration(interval/2 + rand.Float64()*interval/2)
+}
+
+// 可重试的服务商错误码前缀（小写）。
+// 错误码由服务商返回，语义明确，因此可按前缀匹配，如阿里云的 "Throttling.User"、腾讯云的 "InternalError.DbError"。
+var retryableErrorCodePrefixes = []string{
+	"throttl",
+	"requestlimitexceeded",
+	"toomanyrequests",
+	"serviceunavailable",
+	"internalerror",
+	"internalfailure",
+	"internalservererror",
+	"requesttimeout",
+	"slowdown",
+}
+
+// 可重试错误信息中的关键字（小写）。
+// 仅在无法从错误中解析出服务商错误码或 HTTP 状态码时才通过错误信息进行兜底判断，
+// 因此只保留足够具体的短语，避免将如 "invalid timeout value" 等参数错误误判为临时性错误。
+var retryableErrorKeywords = []string{
+	"too many requests",
+	"rate limit exceeded",
+	"service unavailable",
+	"bad gateway",
+	"gateway timeout",
+	"i/o timeout",
+	"tls handshake timeout",
+	"connection reset by peer",
+	"connection refused",
+	"broken pipe",
+	"unexpected eof",
+	"context deadline exceeded",
+}
+
+// 判断错误是否为可重试的临时性错误，如限流、服务端 5xx 错误、网络超时等。
+// 依次根据网络错误、服务商错误码、HTTP 状态码进行判断，均无法判断时才匹配错误信息中的关键字。
+//
+// 入参：
+//   - err：错误。
+//
+// 出参：
+//   - 是否可重试。
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, syscall.ECONNRESET) || errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.EPIPE) {
+		return true
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+
+	code, statusCode := resolveErrorCodeAndStatus(err)
+	if code != "" {
+		code = strings.ToLower(code)
+		for _, prefix := range retryableErrorCodePrefixes {
+			if strings.HasPrefix(code, prefix) {
+				return true
+			}
+		}
+	}
+	if statusCode != 0 {
+		return isRetryableHTTPStatusCode(statusCode)
+	}
+	if code != "" {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	for _, keyword := range retryableErrorKeywords {
+		if strings.Contains(message, keyword) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func resolveErrorCodeAndStatus(err error) (code string, statusCode int) {
+	// 阿里云 SDK
+	var teaErr *tea.SDKError
+	if errors.As(err, &teaErr) {
+		return tea.StringValue(teaErr.Code), tea.IntValue(teaErr.StatusCode)
+	}
+
+	// 华为云 SDK
+	var hwErr *hwsdkerr.ServiceResponseError
+	if errors.As(err, &hwErr) {
+		return hwErr.ErrorCode, hwErr.StatusCode
+	}
+
+	// 腾讯云 SDK 等
+	var codeGetter interface{ GetCode() string }
+	if errors.As(err, &codeGetter) {
+		code = codeGetter.GetCode()
+	}
+
+	// AWS SDK 等
+	var codeErr interface{ ErrorCode() string }
+	if code == "" && errors.As(err, &codeErr) {
+		code = codeErr.ErrorCode()
+	}
+	var statusCodeErr interface{ HTTPStatusCode() int }
+	if errors.As(err, &statusCodeErr) {
+		statusCode = statusCodeErr.HTTPStatusCode()
+	}
+
+	return code, statusCode
+}
+
+func isRetryableHTTPStatusCode(statusCode int) bool {
+	switch statusCode {
+	case http.StatusRequestTimeout,
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,143 @@
+package deployer_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/alibabacloud-go/tea/tea"
+	hwsdkerr "github.com/huaweicloud/huaweicloud-sdk-go-v3/core/sdkerr"
+	tcerrors "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/deployer"
+)
+
+type awsLikeError struct {
+	code       string
+	statusCode int
+}
+
+func (e *awsLikeError) Error() string {
+	return fmt.Sprintf("api error %s: status %d", e.code, e.statusCode)
+}
+
+func (e *awsLikeError) ErrorCode() string {
+	return e.code
+}
+
+func (e *awsLikeError) HTTPStatusCode() int {
+	return e.statusCode
+}
+
+/*
+Shell command to run this test:
+
+	go test -v ./retry_test.go
+*/
+func TestIsRetryableError(t *testing.T) {
+	testCases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil, want: false},
+		{name: "context canceled", err: context.Canceled, want: false},
+		{name: "context deadline exceeded", err: fmt.Errorf("wrapped: %w", context.DeadlineExceeded), want: true},
+		{name: "unexpected eof", err: io.ErrUnexpectedEOF, want: true},
+		{name: "connection reset", err: &net.OpError{Op: "read", Err: syscall.ECONNRESET}, want: true},
+		{name: "net timeout", err: &net.DNSError{Err: "i/o timeout", IsTimeout: true}, want: true},
+
+		{name: "aliyun throttling", err: &tea.SDKError{Code: tea.String("Throttling.User"), StatusCode: tea.Int(400)}, want: true},
+		{name: "aliyun service unavailable", err: &tea.SDKError{Code: tea.String("ServiceUnavailable"), StatusCode: tea.Int(503)}, want: true},
+		{name: "aliyun invalid parameter", err: &tea.SDKError{Code: tea.String("InvalidParameter"), StatusCode: tea.Int(400), Message: tea.String("invalid timeout value")}, want: false},
+		{name: "aliyun unknown code with 5xx", err: &tea.SDKError{Code: tea.String("UnknownError"), StatusCode: tea.Int(502)}, want: true},
+		{name: "tencentcloud request limit", err: tcerrors.NewTencentCloudSDKError("RequestLimitExceeded", "", ""), want: true},
+		{name: "tencentcloud internal error", err: tcerrors.NewTencentCloudSDKError("InternalError.DbError", "", ""), want: true},
+		{name: "tencentcloud auth failure mentioning timeout", err: tcerrors.NewTencentCloudSDKError("AuthFailure.SignatureExpire", "signature timeout", ""), want: false},
+		{name: "tencentcloud invalid parameter mentioning internalerror", err: tcerrors.NewTencentCloudSDKError("InvalidParameterValue", "field `InternalErrorPage` is invalid", ""), want: false},
+		{name: "huaweicloud 429", err: &hwsdkerr.ServiceResponseError{StatusCode: 429, ErrorCode: "APIGW.0308"}, want: true},
+		{name: "huaweicloud 400", err: &hwsdkerr.ServiceResponseError{StatusCode: 400, ErrorCode: "SCM.0001", ErrorMessage: "request timeout is invalid"}, want: false},
+		{name: "aws throttling", err: &awsLikeError{code: "ThrottlingException", statusCode: 400}, want: true},
+		{name: "aws validation", err: &awsLikeError{code: "ValidationException", statusCode: 400}, want: false},
+		{name: "aws 503 without code", err: &awsLikeError{statusCode: 503}, want: true},
+		{name: "aws 501 without code", err: &awsLikeError{statusCode: 501}, want: false},
+		{name: "wrapped provider error", err: fmt.Errorf("failed to execute sdk request: %w", tcerrors.NewTencentCloudSDKError("RequestLimitExceeded", "", "")), want: true},
+
+		{name: "message too many requests", err: errors.New("429 Too Many Requests"), want: true},
+		{name: "message gateway timeout", err: errors.New("unexpected status: 504 Gateway Timeout"), want: true},
+		{name: "message i/o timeout", err: errors.New("dial tcp 10.0.0.1:443: i/o timeout"), want: true},
+		{name: "message invalid timeout", err: errors.New("config `timeout` is invalid"), want: false},
+		{name: "message internalerror in field name", err: errors.New("unknown field 'InternalErrorPage'"), want: false},
+		{name: "message required", err: errors.New("config `domain` is required"), want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := deployer.IsRetryableError(tc.err); got != tc.want {
+				t.Errorf("IsRetryableError(%v) = %v, want %v", tc.err, got, tc.want)
+			}
+		})
+	}
+}
+
+type flakyDeployer struct {
+	errs    []error
+	calls   int
+	dryRuns []bool
+}
+
+func (d *flakyDeployer) Deploy(ctx context.Context, certPem string, privkeyPem string, opts ...deployer.Options) (*deployer.DeployResult, error) {
+	d.calls++
+	d.dryRuns = append(d.dryRuns, deployer.MergeOptions(opts...).DryRun)
+	if d.calls <= len(d.errs) {
+		return nil, d.errs[d.calls-1]
+	}
+
+	return &deployer.DeployResult{}, nil
+}
+
+func TestDeployWithRetry(t *testing.T) {
+	transientErr := tcerrors.NewTencentCloudSDKError("RequestLimitExceeded", "", "")
+	permanentErr := tcerrors.NewTencentCloudSDKError("InvalidParameter", "", "")
+	policy := deployer.RetryPolicy{MaxRetries: 2, InitialInterval: time.Millisecond, MaxInterval: time.Millisecond}
+
+	testCases := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   bool
+	}{
+		{name: "success", errs: nil, wantCalls: 1, wantErr: false},
+		{name: "recovered after retries", errs: []error{transientErr, transientErr}, wantCalls: 3, wantErr: false},
+		{name: "retries exhausted", errs: []error{transientErr, transientErr, transientErr}, wantCalls: 3, wantErr: true},
+		{name: "permanent error not retried", errs: []error{permanentErr}, wantCalls: 1, wantErr: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := &flakyDeployer{errs: tc.errs}
+			_, err := deployer.DeployWithRetry(context.Background(), d, "", "", policy)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if d.calls != tc.wantCalls {
+				t.Errorf("expected %d calls, got %d", tc.wantCalls, d.calls)
+			}
+		})
+	}
+
+	t.Run("options forwarded to every attempt", func(t *testing.T) {
+		d := &flakyDeployer{errs: []error{transientErr}}
+		if _, err := deployer.DeployWithRetry(context.Background(), d, "", "", policy, deployer.Options{DryRun: true}); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		if len(d.dryRuns) != 2 || !d.dryRuns[0] || !d.dryRuns[1] {
+			t.Errorf("expected dry-run on both attempts, got %v", d.dryRuns)
+		}
+	})
+}
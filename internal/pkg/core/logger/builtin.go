@@ -5,20 +5,21 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 
 	"github.com/usual2970/certimate/internal/pkg/utils/types"
 )
 
 // 表示默认的日志记录器类型。
+// 该日志记录器是并发安全的。
 type DefaultLogger struct {
 	records []string
+	mutex   sync.Mutex
 }
 
 var _ Logger = (*DefaultLogger)(nil)
 
 func (l *DefaultLogger) Logt(tag string, data ...any) {
-	l.ensureInitialized()
-
 	temp := make([]string, len(data)+1)
 	temp[0] = tag
 	for i, v := range data {
@@ -43,16 +44,26 @@ func (l *DefaultLogger) Logt(tag string, data ...any) {
 		temp[i+1] = s
 	}
 
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.ensureInitialized()
 	l.records = append(l.records, strings.Join(temp, ": "))
 }
 
 func (l *DefaultLogger) Logf(format string, args ...any) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
 	l.ensureInitialized()
 
 	l.records = append(l.records, fmt.Sprintf(format, args...))
 }
 
 func (l *DefaultLogger) GetRecords() []string {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
 	l.ensureInitialized()
 
 	temp := make([]string, len(l.records))
@@ -61,6 +72,9 @@ func (l *DefaultLogger) GetRecords() []string {
 }
 
 func (l *DefaultLogger) FlushRecords() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
 	l.records = make([]string, 0)
 }
 
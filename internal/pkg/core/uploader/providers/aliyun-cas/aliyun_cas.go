@@ -2,7 +2,11 @@
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,8 +24,17 @@ type UploaderConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
+	// 阿里云资源组 ID（可选）。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 支持的变量：${DOMAIN}（证书的首个域名）、${DATE}（上传日期，格式为 yyyyMMdd）、${TIMESTAMP}（上传时间戳，单位为毫秒）。
+	// 零值时默认为 "certimate_${TIMESTAMP}"。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type UploaderProvider struct {
@@ -29,7 +42,11 @@ type UploaderProvider struct {
 	sdkClient *aliyunCas.Client
 }
 
-var _ uploader.Uploader = (*UploaderProvider)(nil)
+var (
+	_ uploader.Uploader           = (*UploaderProvider)(nil)
+	_ uploader.CertificateFinder  = (*UploaderProvider)(nil)
+	_ uploader.CertificateCleaner = (*UploaderProvider)(nil)
+)
 
 func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
 	if config == nil {
@@ -39,6 +56,7 @@ func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
 	client, err := createSdkClient(
 		config.AccessKeyId,
 		config.AccessKeySecret,
+		config.SecurityToken,
 		config.Region,
 	)
 	if err != nil {
@@ -58,7 +76,44 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 		return nil, err
 	}
 
-	// 查询证书列表，避免重复上传
+	// 查找已上传的相同证书，避免重复上传
+	existingCert, err := u.FindCertificate(ctx, certX509)
+	if err != nil {
+		return nil, err
+	} else if existingCert != nil {
+		return existingCert, nil
+	}
+
+	// 生成新证书名（需符合阿里云命名规则）
+	var certId, certName string
+	certName = RenderCertName(u.config.CertNameTemplate, certX509, time.Now())
+
+	// 上传新证书
+	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-uploadusercertificate
+	uploadUserCertificateReq := &aliyunCas.UploadUserCertificateRequest{
+		Name: tea.String(certName),
+		Cert: tea.String(certPem),
+		Key:  tea.String(privkeyPem),
+	}
+	if u.config.ResourceGroupId != "" {
+		uploadUserCertificateReq.ResourceGroupId = tea.String(u.config.ResourceGroupId)
+	}
+	uploadUserCertificateResp, err := u.sdkClient.UploadUserCertificate(uploadUserCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cas.UploadUserCertificate'")
+	}
+
+	certId = fmt.Sprintf("%d", tea.Int64Value(uploadUserCertificateResp.Body.CertId))
+	return &uploader.UploadResult{
+		CertId:   certId,
+		CertName: certName,
+	}, nil
+}
+
+func (u *UploaderProvider) FindCertificate(ctx context.Context, certX509 *x509.Certificate) (res *uploader.UploadResult, err error) {
+	fingerprint := certs.GetCertificateFingerprintSHA256(certX509)
+
+	// 查询证书列表，按 SHA-256 指纹匹配
 	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-listusercertificateorder
 	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-getusercertificatedetail
 	listUserCertificateOrderPage := int64(1)
@@ -76,7 +131,13 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 
 		if listUserCertificateOrderResp.Body.CertificateOrderList != nil {
 			for _, certDetail := range listUserCertificateOrderResp.Body.CertificateOrderList {
-				if strings.EqualFold(certX509.SerialNumber.Text(16), *certDetail.SerialNo) {
+				oldFingerprint := tea.StringValue(certDetail.Sha2)
+				if oldFingerprint == "" {
+					// 列表未返回指纹时，仅对序列号相同的证书查询详情并计算指纹
+					if !strings.EqualFold(certX509.SerialNumber.Text(16), tea.StringValue(certDetail.SerialNo)) {
+						continue
+					}
+
 					getUserCertificateDetailReq := &aliyunCas.GetUserCertificateDetailRequest{
 						CertId: certDetail.CertificateId,
 					}
@@ -85,25 +146,20 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 						return nil, xerrors.Wrap(err, "failed to execute sdk request 'cas.GetUserCertificateDetail'")
 					}
 
-					var isSameCert bool
-					if *getUserCertificateDetailResp.Body.Cert == certPem {
-						isSameCert = true
-					} else {
-						oldCertX509, err := certs.ParseCertificateFromPEM(*getUserCertificateDetailResp.Body.Cert)
-						if err != nil {
-							continue
-						}
-
-						isSameCert = certs.EqualCertificate(certX509, oldCertX509)
+					oldCertX509, err := certs.ParseCertificateFromPEM(tea.StringValue(getUserCertificateDetailResp.Body.Cert))
+					if err != nil {
+						continue
 					}
 
-					// 如果已存在相同证书，直接返回已有的证书信息
-					if isSameCert {
-						return &uploader.UploadResult{
-							CertId:   fmt.Sprintf("%d", tea.Int64Value(certDetail.CertificateId)),
-							CertName: *certDetail.Name,
-						}, nil
-					}
+					oldFingerprint = certs.GetCertificateFingerprintSHA256(oldCertX509)
+				}
+
+				// 如果已存在相同证书，直接返回已有的证书信息
+				if certs.EqualFingerprint(fingerprint, oldFingerprint) {
+					return &uploader.UploadResult{
+						CertId:   fmt.Sprintf("%d", tea.Int64Value(certDetail.CertificateId)),
+						CertName: tea.StringValue(certDetail.Name),
+					}, nil
 				}
 			}
 		}
@@ -115,30 +171,168 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 		}
 	}
 
-	// 生成新证书名（需符合阿里云命名规则）
-	var certId, certName string
-	certName = fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+	return nil, nil
+}
 
-	// 上传新证书
-	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-uploadusercertificate
-	uploadUserCertificateReq := &aliyunCas.UploadUserCertificateRequest{
-		Name: tea.String(certName),
-		Cert: tea.String(certPem),
-		Key:  tea.String(privkeyPem),
-	}
-	uploadUserCertificateResp, err := u.sdkClient.UploadUserCertificate(uploadUserCertificateReq)
+func (u *UploaderProvider) CleanupCertificates(ctx context.Context, certX509 *x509.Certificate) (certIds []string, err error) {
+	fingerprint := certs.GetCertificateFingerprintSHA256(certX509)
+	certNameRegexp, err := compileCertNameRegexp(u.config.CertNameTemplate)
 	if err != nil {
-		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cas.UploadUserCertificate'")
+		return nil, err
 	}
 
-	certId = fmt.Sprintf("%d", tea.Int64Value(uploadUserCertificateResp.Body.CertId))
-	return &uploader.UploadResult{
-		CertId:   certId,
-		CertName: certName,
-	}, nil
+	// 查询证书列表，找出由 Certimate 上传且已被取代的旧证书
+	// 阿里云证书列表不返回标签，因此以名称是否符合证书名称模板来判断是否由 Certimate 上传
+	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-listusercertificateorder
+	supersededCerts := make([]*aliyunCas.ListUserCertificateOrderResponseBodyCertificateOrderList, 0)
+	listUserCertificateOrderPage := int64(1)
+	listUserCertificateOrderLimit := int64(50)
+	for {
+		listUserCertificateOrderReq := &aliyunCas.ListUserCertificateOrderRequest{
+			CurrentPage: tea.Int64(listUserCertificateOrderPage),
+			ShowSize:    tea.Int64(listUserCertificateOrderLimit),
+			OrderType:   tea.String("CERT"),
+		}
+		listUserCertificateOrderResp, err := u.sdkClient.ListUserCertificateOrder(listUserCertificateOrderReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'cas.ListUserCertificateOrder'")
+		}
+
+		if listUserCertificateOrderResp.Body.CertificateOrderList != nil {
+			for _, certDetail := range listUserCertificateOrderResp.Body.CertificateOrderList {
+				if !certNameRegexp.MatchString(tea.StringValue(certDetail.Name)) {
+					continue
+				}
+
+				if certs.EqualFingerprint(fingerprint, tea.StringValue(certDetail.Sha2)) ||
+					strings.EqualFold(certX509.SerialNumber.Text(16), tea.StringValue(certDetail.SerialNo)) {
+					continue
+				}
+
+				sans := strings.Split(tea.StringValue(certDetail.Sans), ",")
+				if tea.StringValue(certDetail.Sans) == "" {
+					sans = []string{tea.StringValue(certDetail.CommonName)}
+				}
+				if !certs.IsCertificateHostnamesEqual(certX509, sans) {
+					continue
+				}
+
+				if tea.Int64Value(certDetail.CertEndTime) >= certX509.NotAfter.UnixMilli() {
+					continue
+				}
+
+				supersededCerts = append(supersededCerts, certDetail)
+			}
+		}
+
+		if listUserCertificateOrderResp.Body.CertificateOrderList == nil || len(listUserCertificateOrderResp.Body.CertificateOrderList) < int(listUserCertificateOrderLimit) {
+			break
+		} else {
+			listUserCertificateOrderPage++
+		}
+	}
+
+	// 删除未关联任何云资源的旧证书
+	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-listcloudresources
+	// REF: https://help.aliyun.com/zh/ssl-certificate/developer-reference/api-cas-2020-04-07-deleteusercertificate
+	certIds = make([]string, 0)
+	for _, certDetail := range supersededCerts {
+		listCloudResourcesReq := &aliyunCas.ListCloudResourcesRequest{
+			CertIds: []*int64{certDetail.CertificateId},
+		}
+		listCloudResourcesResp, err := u.sdkClient.ListCloudResources(listCloudResourcesReq)
+		if err != nil {
+			return certIds, xerrors.Wrap(err, "failed to execute sdk request 'cas.ListCloudResources'")
+		} else if tea.Int64Value(listCloudResourcesResp.Body.Total) > 0 {
+			continue
+		}
+
+		deleteUserCertificateReq := &aliyunCas.DeleteUserCertificateRequest{
+			CertId: certDetail.CertificateId,
+		}
+		if _, err := u.sdkClient.DeleteUserCertificate(deleteUserCertificateReq); err != nil {
+			return certIds, xerrors.Wrap(err, "failed to execute sdk request 'cas.DeleteUserCertificate'")
+		}
+
+		certIds = append(certIds, fmt.Sprintf("%d", tea.Int64Value(certDetail.CertificateId)))
+	}
+
+	return certIds, nil
+}
+
+var certNameInvalidCharsRegexp = regexp.MustCompile(`[^a-zA-Z0-9_.\-]`)
+
+// 根据证书名称模板生成证书名称。
+// 生成的名称仅包含字母、数字、下划线、中划线和点，且长度不超过 64 个字符，可同时满足阿里云 CAS 及 SLB 的命名规则。
+//
+// 入参：
+//   - template：证书名称模板，支持的变量参见 [UploaderConfig]。零值时默认为 "certimate_${TIMESTAMP}"。
+//   - certX509：证书。
+//   - now：当前时间。
+//
+// 出参：
+//   - 证书名称。
+func RenderCertName(template string, certX509 *x509.Certificate, now time.Time) string {
+	if template == "" {
+		template = "certimate_${TIMESTAMP}"
+	}
+
+	domain := certX509.Subject.CommonName
+	if len(certX509.DNSNames) > 0 {
+		domain = certX509.DNSNames[0]
+	}
+	domain = strings.ReplaceAll(domain, "*", "_")
+
+	name := template
+	name = strings.ReplaceAll(name, "${DOMAIN}", domain)
+	name = strings.ReplaceAll(name, "${DATE}", now.Format("20060102"))
+	name = strings.ReplaceAll(name, "${TIMESTAMP}", strconv.FormatInt(now.UnixMilli(), 10))
+
+	// 阿里云证书名称仅支持字母、数字、下划线、中划线和点，且长度不超过 64 个字符
+	name = certNameInvalidCharsRegexp.ReplaceAllString(name, "_")
+	if len(name) > 64 {
+		name = name[:64]
+	}
+
+	return name
+}
+
+var certNameTemplateVarsRegexp = regexp.MustCompile(`\$\{(DOMAIN|DATE|TIMESTAMP)\}`)
+
+// 将证书名称模板转换为正则表达式，用于判断证书名称是否由该模板生成。
+// 为避免误删其他证书，要求模板以固定的前缀开头，否则返回错误。
+func compileCertNameRegexp(template string) (*regexp.Regexp, error) {
+	if template == "" {
+		template = "certimate_${TIMESTAMP}"
+	}
+
+	locs := certNameTemplateVarsRegexp.FindAllStringSubmatchIndex(template, -1)
+	if len(locs) > 0 && locs[0][0] == 0 {
+		return nil, errors.New("certificate name template must start with a fixed prefix to cleanup certificates")
+	}
+
+	var sb strings.Builder
+	sb.WriteString("^")
+	lastIndex := 0
+	for _, loc := range locs {
+		sb.WriteString(regexp.QuoteMeta(certNameInvalidCharsRegexp.ReplaceAllString(template[lastIndex:loc[0]], "_")))
+		switch template[loc[2]:loc[3]] {
+		case "DOMAIN":
+			sb.WriteString(`[a-zA-Z0-9_.\-]+`)
+		case "DATE":
+			sb.WriteString(`\d{8}`)
+		case "TIMESTAMP":
+			sb.WriteString(`\d+`)
+		}
+		lastIndex = loc[1]
+	}
+	sb.WriteString(regexp.QuoteMeta(certNameInvalidCharsRegexp.ReplaceAllString(template[lastIndex:], "_")))
+	sb.WriteString("$")
+
+	return regexp.Compile(sb.String())
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunCas.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunCas.Client, error) {
 	if region == "" {
 		region = "cn-hangzhou" // CAS 服务默认区域：华东一杭州
 	}
@@ -156,6 +350,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunCas.Cl
 		Endpoint:        tea.String(endpoint),
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 	}
 
 	client, err := aliyunCas.NewClient(config)
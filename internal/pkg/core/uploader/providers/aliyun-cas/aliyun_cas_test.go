@@ -0,0 +1,89 @@
+package aliyuncas
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+	"time"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v ./aliyun_cas_test.go ./aliyun_cas.go
+*/
+func TestCompileCertNameRegexp(t *testing.T) {
+	certX509 := &x509.Certificate{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: []string{"*.example.com", "example.com"},
+	}
+	now := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name     string
+		template string
+		wantErr  bool
+		matches  []string
+		excludes []string
+	}{
+		{
+			name:     "default template",
+			template: "",
+			matches:  []string{RenderCertName("", certX509, now), "certimate_1"},
+			excludes: []string{"certimate_", "certimate_abc", "my_certimate_1", "cert-1"},
+		},
+		{
+			name:     "prefixed domain and date",
+			template: "team-a_${DOMAIN}_${DATE}",
+			matches:  []string{RenderCertName("team-a_${DOMAIN}_${DATE}", certX509, now), "team-a_foo.bar_20240101"},
+			excludes: []string{"team-b_example.com_20250301", "team-a_example.com_2025", "team-a__20250301"},
+		},
+		{
+			name:     "invalid characters in literal",
+			template: "team a/${TIMESTAMP}",
+			matches:  []string{RenderCertName("team a/${TIMESTAMP}", certX509, now)},
+			excludes: []string{"team a/1", "team_a_"},
+		},
+		{
+			name:     "template without variables",
+			template: "fixed-name",
+			matches:  []string{"fixed-name"},
+			excludes: []string{"fixed-name-1"},
+		},
+		{
+			name:     "domain only",
+			template: "${DOMAIN}",
+			wantErr:  true,
+		},
+		{
+			name:     "leading variable",
+			template: "${DATE}_certimate",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			re, err := compileCertNameRegexp(tc.template)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got regexp %q", re.String())
+				}
+				return
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			for _, name := range tc.matches {
+				if !re.MatchString(name) {
+					t.Errorf("expected %q to match %q", name, re.String())
+				}
+			}
+			for _, name := range tc.excludes {
+				if re.MatchString(name) {
+					t.Errorf("expected %q not to match %q", name, re.String())
+				}
+			}
+		})
+	}
+}
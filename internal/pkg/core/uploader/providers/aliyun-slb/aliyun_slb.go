@@ -15,6 +15,7 @@ import (
 	xerrors "github.com/pkg/errors"
 
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	aliyuncas "github.com/usual2970/certimate/internal/pkg/core/uploader/providers/aliyun-cas"
 	"github.com/usual2970/certimate/internal/pkg/utils/certs"
 )
 
@@ -23,8 +24,16 @@ type UploaderConfig struct {
 	AccessKeyId string `json:"accessKeyId"`
 	// 阿里云 AccessKeySecret。
 	AccessKeySecret string `json:"accessKeySecret"`
+	// 阿里云 SecurityToken。
+	// 使用 STS 临时访问凭证时必填。
+	SecurityToken string `json:"securityToken,omitempty"`
 	// 阿里云地域。
 	Region string `json:"region"`
+	// 阿里云资源组 ID（可选）。
+	ResourceGroupId string `json:"resourceGroupId,omitempty"`
+	// 证书名称模板（可选）。
+	// 支持的变量及零值时的默认值同 [aliyuncas.UploaderConfig]。
+	CertNameTemplate string `json:"certNameTemplate,omitempty"`
 }
 
 type UploaderProvider struct {
@@ -42,6 +51,7 @@ func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
 	client, err := createSdkClient(
 		config.AccessKeyId,
 		config.AccessKeySecret,
+		config.SecurityToken,
 		config.Region,
 	)
 	if err != nil {
@@ -90,7 +100,7 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 
 	// 生成新证书名（需符合阿里云命名规则）
 	var certId, certName string
-	certName = fmt.Sprintf("certimate_%d", time.Now().UnixMilli())
+	certName = aliyuncas.RenderCertName(u.config.CertNameTemplate, certX509, time.Now())
 
 	// 去除证书和私钥内容中的空白行，以符合阿里云 API 要求
 	// REF: https://github.com/usual2970/certimate/issues/326
@@ -106,6 +116,9 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 		ServerCertificate:     tea.String(certPem),
 		PrivateKey:            tea.String(privkeyPem),
 	}
+	if u.config.ResourceGroupId != "" {
+		uploadServerCertificateReq.ResourceGroupId = tea.String(u.config.ResourceGroupId)
+	}
 	uploadServerCertificateResp, err := u.sdkClient.UploadServerCertificate(uploadServerCertificateReq)
 	if err != nil {
 		return nil, xerrors.Wrap(err, "failed to execute sdk request 'slb.UploadServerCertificate'")
@@ -118,7 +131,7 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 	}, nil
 }
 
-func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunSlb.Client, error) {
+func createSdkClient(accessKeyId, accessKeySecret, securityToken, region string) (*aliyunSlb.Client, error) {
 	// 接入点一览 https://api.aliyun.com/product/Slb
 	var endpoint string
 	switch region {
@@ -136,6 +149,7 @@ func createSdkClient(accessKeyId, accessKeySecret, region string) (*aliyunSlb.Cl
 		Endpoint:        tea.String(endpoint),
 		AccessKeyId:     tea.String(accessKeyId),
 		AccessKeySecret: tea.String(accessKeySecret),
+		SecurityToken:   tea.String(securityToken),
 	}
 
 	client, err := aliyunSlb.NewClient(config)
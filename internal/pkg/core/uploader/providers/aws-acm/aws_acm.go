@@ -147,7 +147,9 @@ func createSdkClient(accessKeyId, secretAccessKey, region string) (*awsAcm.Clien
 
 	client := awsAcm.NewFromConfig(cfg, func(o *awsAcm.Options) {
 		o.Region = region
-		o.Credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+		if accessKeyId != "" || secretAccessKey != "" {
+			o.Credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+		}
 	})
 	return client, nil
 }
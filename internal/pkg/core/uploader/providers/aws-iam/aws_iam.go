@@ -0,0 +1,167 @@
+﻿package awsiam
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	aws "github.com/aws/aws-sdk-go-v2/aws"
+	awsCfg "github.com/aws/aws-sdk-go-v2/config"
+	awsCred "github.com/aws/aws-sdk-go-v2/credentials"
+	awsIam "github.com/aws/aws-sdk-go-v2/service/iam"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type UploaderConfig struct {
+	// AWS AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// AWS SecretAccessKey。
+	SecretAccessKey string `json:"secretAccessKey"`
+	// AWS 区域。
+	Region string `json:"region"`
+	// IAM 证书路径。
+	// 选填。零值时默认为 "/"。
+	CertificatePath string `json:"certificatePath,omitempty"`
+}
+
+type UploaderProvider struct {
+	config    *UploaderConfig
+	sdkClient *awsIam.Client
+}
+
+var _ uploader.Uploader = (*UploaderProvider)(nil)
+
+func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.AccessKeyId, config.SecretAccessKey, config.Region)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &UploaderProvider{
+		config:    config,
+		sdkClient: client,
+	}, nil
+}
+
+func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPem string) (res *uploader.UploadResult, err error) {
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 生成 AWS 业务参数
+	// IAM 要求证书链中仅包含中间证书
+	scertPem, icertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	certPath := u.config.CertificatePath
+	if certPath == "" {
+		certPath = "/"
+	}
+
+	// 获取证书列表，避免重复上传
+	// REF: https://docs.aws.amazon.com/en_us/IAM/latest/APIReference/API_ListServerCertificates.html
+	var listServerCertificatesMarker *string = nil
+	listServerCertificatesMaxItems := int32(1000)
+	for {
+		listServerCertificatesReq := &awsIam.ListServerCertificatesInput{
+			PathPrefix: aws.String(certPath),
+			Marker:     listServerCertificatesMarker,
+			MaxItems:   aws.Int32(listServerCertificatesMaxItems),
+		}
+		listServerCertificatesResp, err := u.sdkClient.ListServerCertificates(ctx, listServerCertificatesReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'iam.ListServerCertificates'")
+		}
+
+		for _, certMeta := range listServerCertificatesResp.ServerCertificateMetadataList {
+			// 先对比证书路径及有效期
+			if aws.ToString(certMeta.Path) != certPath {
+				continue
+			}
+			if certMeta.Expiration == nil || !certMeta.Expiration.Equal(certX509.NotAfter) {
+				continue
+			}
+
+			// 最后对比证书内容
+			// REF: https://docs.aws.amazon.com/en_us/IAM/latest/APIReference/API_GetServerCertificate.html
+			getServerCertificateReq := &awsIam.GetServerCertificateInput{
+				ServerCertificateName: certMeta.ServerCertificateName,
+			}
+			getServerCertificateResp, err := u.sdkClient.GetServerCertificate(ctx, getServerCertificateReq)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'iam.GetServerCertificate'")
+			} else {
+				oldCertX509, err := certs.ParseCertificateFromPEM(aws.ToString(getServerCertificateResp.ServerCertificate.CertificateBody))
+				if err != nil {
+					continue
+				}
+
+				if !certs.EqualCertificate(certX509, oldCertX509) {
+					continue
+				}
+			}
+
+			// 如果以上信息都一致，则视为已存在相同证书，直接返回
+			return &uploader.UploadResult{
+				CertId:   aws.ToString(certMeta.Arn),
+				CertName: aws.ToString(certMeta.ServerCertificateName),
+			}, nil
+		}
+
+		if !listServerCertificatesResp.IsTruncated || listServerCertificatesResp.Marker == nil {
+			break
+		} else {
+			listServerCertificatesMarker = listServerCertificatesResp.Marker
+		}
+	}
+
+	// 生成新证书名（需符合 AWS 命名规则）
+	certName := fmt.Sprintf("certimate-%d", time.Now().UnixMilli())
+
+	// 上传服务器证书
+	// REF: https://docs.aws.amazon.com/en_us/IAM/latest/APIReference/API_UploadServerCertificate.html
+	uploadServerCertificateReq := &awsIam.UploadServerCertificateInput{
+		ServerCertificateName: aws.String(certName),
+		Path:                  aws.String(certPath),
+		CertificateBody:       aws.String(scertPem),
+		PrivateKey:            aws.String(privkeyPem),
+	}
+	if icertPem != "" {
+		uploadServerCertificateReq.CertificateChain = aws.String(icertPem)
+	}
+	uploadServerCertificateResp, err := u.sdkClient.UploadServerCertificate(ctx, uploadServerCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'iam.UploadServerCertificate'")
+	}
+
+	return &uploader.UploadResult{
+		CertId:   aws.ToString(uploadServerCertificateResp.ServerCertificateMetadata.Arn),
+		CertName: certName,
+	}, nil
+}
+
+func createSdkClient(accessKeyId, secretAccessKey, region string) (*awsIam.Client, error) {
+	cfg, err := awsCfg.LoadDefaultConfig(context.TODO())
+	if err != nil {
+		return nil, err
+	}
+
+	client := awsIam.NewFromConfig(cfg, func(o *awsIam.Options) {
+		o.Region = region
+		if accessKeyId != "" || secretAccessKey != "" {
+			o.Credentials = aws.NewCredentialsCache(awsCred.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, ""))
+		}
+	})
+	return client, nil
+}
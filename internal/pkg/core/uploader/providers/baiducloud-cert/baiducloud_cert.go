@@ -0,0 +1,119 @@
+package baiducloudcert
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	bceCert "github.com/baidubce/bce-sdk-go/services/cert"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type UploaderConfig struct {
+	// 百度智能云 AccessKeyId。
+	AccessKeyId string `json:"accessKeyId"`
+	// 百度智能云 SecretAccessKey。
+	SecretAccessKey string `json:"secretAccessKey"`
+}
+
+type UploaderProvider struct {
+	config    *UploaderConfig
+	sdkClient *bceCert.Client
+}
+
+var _ uploader.Uploader = (*UploaderProvider)(nil)
+
+func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config.AccessKeyId, config.SecretAccessKey)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &UploaderProvider{
+		config:    config,
+		sdkClient: client,
+	}, nil
+}
+
+func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPem string) (res *uploader.UploadResult, err error) {
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// 查询证书列表，避免重复上传
+	// REF: https://cloud.baidu.com/doc/Reference/s/8jwvz26si
+	listCertDetailResp, err := u.sdkClient.ListCertDetail()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cert.ListCertDetail'")
+	} else {
+		for _, certDetail := range listCertDetailResp.Certs {
+			// 百度智能云未提供可唯一标识证书的字段，只能通过多个字段尝试对比来判断是否为同一证书
+			// 先对比证书的通用名称，再对比有效期
+
+			if certDetail.CertCommonName != certX509.Subject.CommonName {
+				continue
+			}
+
+			startTime, err := time.Parse(time.RFC3339, certDetail.CertStartTime)
+			if err != nil || !startTime.Equal(certX509.NotBefore) {
+				continue
+			}
+
+			stopTime, err := time.Parse(time.RFC3339, certDetail.CertStopTime)
+			if err != nil || !stopTime.Equal(certX509.NotAfter) {
+				continue
+			}
+
+			// 如果已存在相同证书，直接返回已有的证书信息
+			return &uploader.UploadResult{
+				CertId:   certDetail.CertId,
+				CertName: certDetail.CertName,
+			}, nil
+		}
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 生成新证书名（需符合百度智能云命名规则）
+	certName := fmt.Sprintf("certimate-%d", time.Now().UnixMilli())
+
+	// 上传证书
+	// REF: https://cloud.baidu.com/doc/Reference/s/8jwvz26si
+	createCertReq := &bceCert.CreateCertArgs{
+		CertName:        certName,
+		CertServerData:  serverCertPem,
+		CertPrivateData: privkeyPem,
+		CertLinkData:    interCertPem,
+	}
+	createCertResp, err := u.sdkClient.CreateCert(createCertReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'cert.CreateCert'")
+	}
+
+	return &uploader.UploadResult{
+		CertId:   createCertResp.CertId,
+		CertName: createCertResp.CertName,
+	}, nil
+}
+
+func createSdkClient(accessKeyId, secretAccessKey string) (*bceCert.Client, error) {
+	client, err := bceCert.NewClient(accessKeyId, secretAccessKey, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
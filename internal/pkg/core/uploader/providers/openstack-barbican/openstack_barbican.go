@@ -0,0 +1,170 @@
+package openstackbarbican
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/containers"
+	"github.com/gophercloud/gophercloud/v2/openstack/keymanager/v1/secrets"
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+type UploaderConfig struct {
+	// OpenStack Keystone 认证地址。
+	AuthUrl string `json:"authUrl"`
+	// OpenStack 用户所属域名称。
+	DomainName string `json:"domainName,omitempty"`
+	// OpenStack 用户名。
+	Username string `json:"username"`
+	// OpenStack 密码。
+	Password string `json:"password"`
+	// OpenStack 项目 ID。
+	ProjectId string `json:"projectId"`
+	// OpenStack 区域。
+	Region string `json:"region,omitempty"`
+}
+
+type UploaderProvider struct {
+	config    *UploaderConfig
+	sdkClient *gophercloud.ServiceClient
+}
+
+var _ uploader.Uploader = (*UploaderProvider)(nil)
+
+func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client, err := createSdkClient(config)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to create sdk client")
+	}
+
+	return &UploaderProvider{
+		config:    config,
+		sdkClient: client,
+	}, nil
+}
+
+func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPem string) (res *uploader.UploadResult, err error) {
+	// 解析证书内容
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	// Barbican 不支持按内容查询证书，此处以证书指纹生成容器名，以便查询已有容器，避免重复上传
+	fingerprint := sha256.Sum256(certX509.Raw)
+	containerName := fmt.Sprintf("certimate-%s", hex.EncodeToString(fingerprint[:])[:32])
+
+	// 查询已有证书容器
+	// REF: https://docs.openstack.org/barbican/latest/api/reference/containers.html#get-v1-containers
+	listContainersPages, err := containers.List(u.sdkClient, containers.ListOpts{Name: containerName}).AllPages(ctx)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'barbican.ListContainers'")
+	}
+	listContainersResp, err := containers.ExtractContainers(listContainersPages)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'barbican.ListContainers'")
+	}
+
+	for _, container := range listContainersResp {
+		// 如果已存在相同证书，直接返回已有的证书信息
+		if container.Name == containerName {
+			return &uploader.UploadResult{
+				CertId:   container.ContainerRef,
+				CertName: container.Name,
+			}, nil
+		}
+	}
+
+	// 提取服务器证书和中间证书
+	serverCertPem, interCertPem, err := certs.ExtractCertificatesFromPEM(certPem)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to extract certs")
+	}
+
+	// 创建证书、私钥、中间证书秘密
+	// REF: https://docs.openstack.org/barbican/latest/api/reference/secrets.html#post-v1-secrets
+	secretRefs := make([]containers.SecretRef, 0, 3)
+	secretPayloads := []struct {
+		name       string
+		secretType secrets.SecretType
+		payload    string
+	}{
+		{name: "certificate", secretType: secrets.CertificateSecret, payload: serverCertPem},
+		{name: "private_key", secretType: secrets.PrivateSecret, payload: privkeyPem},
+		{name: "intermediates", secretType: secrets.CertificateSecret, payload: interCertPem},
+	}
+	for _, secretPayload := range secretPayloads {
+		if secretPayload.payload == "" {
+			continue
+		}
+
+		createSecretResp, err := secrets.Create(ctx, u.sdkClient, secrets.CreateOpts{
+			Name:               fmt.Sprintf("%s-%s", containerName, secretPayload.name),
+			SecretType:         secretPayload.secretType,
+			Payload:            secretPayload.payload,
+			PayloadContentType: "text/plain",
+		}).Extract()
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'barbican.CreateSecret'")
+		}
+
+		secretRefs = append(secretRefs, containers.SecretRef{
+			Name:      secretPayload.name,
+			SecretRef: createSecretResp.SecretRef,
+		})
+	}
+
+	// 创建证书容器
+	// REF: https://docs.openstack.org/barbican/latest/api/reference/containers.html#post-v1-containers
+	createContainerResp, err := containers.Create(ctx, u.sdkClient, containers.CreateOpts{
+		Type:       containers.CertificateContainer,
+		Name:       containerName,
+		SecretRefs: secretRefs,
+	}).Extract()
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'barbican.CreateContainer'")
+	}
+
+	return &uploader.UploadResult{
+		CertId:   createContainerResp.ContainerRef,
+		CertName: containerName,
+	}, nil
+}
+
+func createSdkClient(config *UploaderConfig) (*gophercloud.ServiceClient, error) {
+	if config.AuthUrl == "" {
+		return nil, errors.New("openstack: auth url is required")
+	}
+
+	provider, err := openstack.AuthenticatedClient(context.Background(), gophercloud.AuthOptions{
+		IdentityEndpoint: config.AuthUrl,
+		DomainName:       config.DomainName,
+		Username:         config.Username,
+		Password:         config.Password,
+		TenantID:         config.ProjectId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := openstack.NewKeyManagerV1(provider, gophercloud.EndpointOpts{
+		Region: config.Region,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return client, nil
+}
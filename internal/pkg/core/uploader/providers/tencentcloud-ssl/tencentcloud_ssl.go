@@ -2,13 +2,19 @@
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
 
 	xerrors "github.com/pkg/errors"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
 	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
 	tcSsl "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/ssl/v20191205"
 
+	"github.com/usual2970/certimate/internal/pkg/core/logger"
 	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
 )
 
 type UploaderConfig struct {
@@ -20,10 +26,18 @@ type UploaderConfig struct {
 
 type UploaderProvider struct {
 	config    *UploaderConfig
+	logger    logger.Logger
 	sdkClient *tcSsl.Client
 }
 
-var _ uploader.Uploader = (*UploaderProvider)(nil)
+var (
+	_ uploader.Uploader           = (*UploaderProvider)(nil)
+	_ uploader.CertificateFinder  = (*UploaderProvider)(nil)
+	_ uploader.CertificateCleaner = (*UploaderProvider)(nil)
+)
+
+// 由 Certimate 上传的证书的备注名前缀，用于在清理旧证书时识别。
+const certAliasPrefix = "certimate_"
 
 func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
 	if config == nil {
@@ -40,16 +54,35 @@ func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
 
 	return &UploaderProvider{
 		config:    config,
+		logger:    logger.NewNilLogger(),
 		sdkClient: client,
 	}, nil
 }
 
+func (u *UploaderProvider) WithLogger(logger logger.Logger) *UploaderProvider {
+	u.logger = logger
+	return u
+}
+
 func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPem string) (res *uploader.UploadResult, err error) {
+	// 查找已上传的相同证书，避免重复上传
+	// 查询失败时（如密钥仅具有上传权限）视为未找到，继续上传
+	existingCert, err := uploader.FindCertificate(ctx, u, certPem)
+	if err != nil {
+		u.logger.Logt("failed to find existing certificate, uploading anyway", err.Error())
+	} else if existingCert != nil {
+		return existingCert, nil
+	}
+
+	// 生成新证书名
+	certName := fmt.Sprintf("%s%d", certAliasPrefix, time.Now().UnixMilli())
+
 	// 上传新证书
 	// REF: https://cloud.tencent.com/document/product/400/41665
 	uploadCertificateReq := tcSsl.NewUploadCertificateRequest()
 	uploadCertificateReq.CertificatePublicKey = common.StringPtr(certPem)
 	uploadCertificateReq.CertificatePrivateKey = common.StringPtr(privkeyPem)
+	uploadCertificateReq.Alias = common.StringPtr(certName)
 	uploadCertificateReq.Repeatable = common.BoolPtr(false)
 	uploadCertificateResp, err := u.sdkClient.UploadCertificate(uploadCertificateReq)
 	if err != nil {
@@ -59,10 +92,170 @@ func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPe
 	certId := *uploadCertificateResp.Response.CertificateId
 	return &uploader.UploadResult{
 		CertId:   certId,
-		CertName: "",
+		CertName: certName,
 	}, nil
 }
 
+func (u *UploaderProvider) FindCertificate(ctx context.Context, certX509 *x509.Certificate) (res *uploader.UploadResult, err error) {
+	fingerprint := certs.GetCertificateFingerprintSHA256(certX509)
+
+	searchKey := certX509.Subject.CommonName
+	if len(certX509.DNSNames) > 0 {
+		searchKey = certX509.DNSNames[0]
+	}
+
+	// 按域名查询证书列表，再查询证书详情并按 SHA-256 指纹匹配
+	// REF: https://cloud.tencent.com/document/product/400/41671
+	// REF: https://cloud.tencent.com/document/product/400/41673
+	describeCertificatesOffset := uint64(0)
+	describeCertificatesLimit := uint64(100)
+	for {
+		describeCertificatesReq := tcSsl.NewDescribeCertificatesRequest()
+		describeCertificatesReq.Offset = common.Uint64Ptr(describeCertificatesOffset)
+		describeCertificatesReq.Limit = common.Uint64Ptr(describeCertificatesLimit)
+		describeCertificatesReq.SearchKey = common.StringPtr(searchKey)
+		describeCertificatesReq.CertificateType = common.StringPtr("SVR")
+		describeCertificatesResp, err := u.sdkClient.DescribeCertificates(describeCertificatesReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'ssl.DescribeCertificates'")
+		}
+
+		for _, certInfo := range describeCertificatesResp.Response.Certificates {
+			if certInfo.CertificateId == nil {
+				continue
+			}
+
+			// 到期时间不一致的必然不是同一张证书，跳过以减少详情查询次数
+			if certInfo.CertEndTime != nil {
+				certEndTime, err := time.ParseInLocation(time.DateTime, *certInfo.CertEndTime, time.FixedZone("CST", 8*60*60))
+				if err == nil && !certEndTime.Equal(certX509.NotAfter.Truncate(time.Second)) {
+					continue
+				}
+			}
+
+			describeCertificateDetailReq := tcSsl.NewDescribeCertificateDetailRequest()
+			describeCertificateDetailReq.CertificateId = certInfo.CertificateId
+			describeCertificateDetailResp, err := u.sdkClient.DescribeCertificateDetail(describeCertificateDetailReq)
+			if err != nil {
+				return nil, xerrors.Wrap(err, "failed to execute sdk request 'ssl.DescribeCertificateDetail'")
+			} else if describeCertificateDetailResp.Response.CertificatePublicKey == nil {
+				continue
+			}
+
+			oldCertX509, err := certs.ParseCertificateFromPEM(*describeCertificateDetailResp.Response.CertificatePublicKey)
+			if err != nil {
+				continue
+			}
+
+			// 如果已存在相同证书，直接返回已有的证书信息
+			if certs.EqualFingerprint(fingerprint, certs.GetCertificateFingerprintSHA256(oldCertX509)) {
+				certName := ""
+				if certInfo.Alias != nil {
+					certName = *certInfo.Alias
+				}
+
+				return &uploader.UploadResult{
+					CertId:   *certInfo.CertificateId,
+					CertName: certName,
+				}, nil
+			}
+		}
+
+		if len(describeCertificatesResp.Response.Certificates) < int(describeCertificatesLimit) {
+			break
+		} else {
+			describeCertificatesOffset += describeCertificatesLimit
+		}
+	}
+
+	return nil, nil
+}
+
+func (u *UploaderProvider) CleanupCertificates(ctx context.Context, certX509 *x509.Certificate) (certIds []string, err error) {
+	searchKey := certX509.Subject.CommonName
+	if len(certX509.DNSNames) > 0 {
+		searchKey = certX509.DNSNames[0]
+	}
+
+	// 按域名查询证书列表，找出由 Certimate 上传且已被取代的旧证书
+	// REF: https://cloud.tencent.com/document/product/400/41671
+	supersededCertIds := make([]string, 0)
+	describeCertificatesOffset := uint64(0)
+	describeCertificatesLimit := uint64(100)
+	for {
+		describeCertificatesReq := tcSsl.NewDescribeCertificatesRequest()
+		describeCertificatesReq.Offset = common.Uint64Ptr(describeCertificatesOffset)
+		describeCertificatesReq.Limit = common.Uint64Ptr(describeCertificatesLimit)
+		describeCertificatesReq.SearchKey = common.StringPtr(searchKey)
+		describeCertificatesReq.CertificateType = common.StringPtr("SVR")
+		describeCertificatesResp, err := u.sdkClient.DescribeCertificates(describeCertificatesReq)
+		if err != nil {
+			return nil, xerrors.Wrap(err, "failed to execute sdk request 'ssl.DescribeCertificates'")
+		}
+
+		for _, certInfo := range describeCertificatesResp.Response.Certificates {
+			if certInfo.CertificateId == nil || certInfo.Alias == nil || !strings.HasPrefix(*certInfo.Alias, certAliasPrefix) {
+				continue
+			}
+
+			// 仍关联云资源的证书不可删除
+			if len(certInfo.BoundResource) > 0 {
+				continue
+			}
+
+			sans := make([]string, 0, len(certInfo.SubjectAltName))
+			for _, san := range certInfo.SubjectAltName {
+				if san != nil {
+					sans = append(sans, *san)
+				}
+			}
+			if len(sans) == 0 && certInfo.Domain != nil {
+				sans = append(sans, *certInfo.Domain)
+			}
+			if !certs.IsCertificateHostnamesEqual(certX509, sans) {
+				continue
+			}
+
+			// 到期时间无法解析或不早于当前证书的，均视为未被取代
+			if certInfo.CertEndTime == nil {
+				continue
+			}
+			certEndTime, err := time.ParseInLocation(time.DateTime, *certInfo.CertEndTime, time.FixedZone("CST", 8*60*60))
+			if err != nil || !certEndTime.Before(certX509.NotAfter.Truncate(time.Second)) {
+				continue
+			}
+
+			supersededCertIds = append(supersededCertIds, *certInfo.CertificateId)
+		}
+
+		if len(describeCertificatesResp.Response.Certificates) < int(describeCertificatesLimit) {
+			break
+		} else {
+			describeCertificatesOffset += describeCertificatesLimit
+		}
+	}
+
+	// 删除旧证书，并由服务端再次检查是否关联云资源
+	// REF: https://cloud.tencent.com/document/product/400/41675
+	certIds = make([]string, 0)
+	for _, certId := range supersededCertIds {
+		deleteCertificateReq := tcSsl.NewDeleteCertificateRequest()
+		deleteCertificateReq.CertificateId = common.StringPtr(certId)
+		deleteCertificateReq.IsCheckResource = common.BoolPtr(true)
+		deleteCertificateResp, err := u.sdkClient.DeleteCertificate(deleteCertificateReq)
+		if err != nil {
+			return certIds, xerrors.Wrap(err, "failed to execute sdk request 'ssl.DeleteCertificate'")
+		} else if deleteCertificateResp.Response.DeleteResult != nil && !*deleteCertificateResp.Response.DeleteResult {
+			// 未返回删除结果时为异步删除任务，视为已删除
+			continue
+		}
+
+		certIds = append(certIds, certId)
+	}
+
+	return certIds, nil
+}
+
 func createSdkClient(secretId, secretKey string) (*tcSsl.Client, error) {
 	credential := common.NewCredential(secretId, secretKey)
 	client, err := tcSsl.NewClient(credential, "", profile.NewClientProfile())
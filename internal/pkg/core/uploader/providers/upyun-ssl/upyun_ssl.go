@@ -0,0 +1,55 @@
+package upyunssl
+
+import (
+	"context"
+
+	xerrors "github.com/pkg/errors"
+
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	upyunsdk "github.com/usual2970/certimate/internal/pkg/vendors/upyun-sdk/console"
+)
+
+type UploaderConfig struct {
+	// 又拍云账号用户名。
+	Username string `json:"username"`
+	// 又拍云账号密码。
+	Password string `json:"password"`
+}
+
+type UploaderProvider struct {
+	config    *UploaderConfig
+	sdkClient *upyunsdk.Client
+}
+
+var _ uploader.Uploader = (*UploaderProvider)(nil)
+
+func NewUploader(config *UploaderConfig) (*UploaderProvider, error) {
+	if config == nil {
+		panic("config is nil")
+	}
+
+	client := upyunsdk.NewClient(config.Username, config.Password)
+
+	return &UploaderProvider{
+		config:    config,
+		sdkClient: client,
+	}, nil
+}
+
+func (u *UploaderProvider) Upload(ctx context.Context, certPem string, privkeyPem string) (res *uploader.UploadResult, err error) {
+	// 上传证书
+	// 又拍云会对相同的证书去重，重复上传时返回已有的证书 ID
+	uploadHttpsCertificateReq := &upyunsdk.UploadHttpsCertificateRequest{
+		Certificate: certPem,
+		PrivateKey:  privkeyPem,
+	}
+	uploadHttpsCertificateResp, err := u.sdkClient.UploadHttpsCertificate(uploadHttpsCertificateReq)
+	if err != nil {
+		return nil, xerrors.Wrap(err, "failed to execute sdk request 'console.UploadHttpsCertificate'")
+	}
+
+	return &uploader.UploadResult{
+		CertId:   uploadHttpsCertificateResp.Data.Result.CertificateId,
+		CertName: uploadHttpsCertificateResp.Data.Result.CommonName,
+	}, nil
+}
@@ -1,6 +1,15 @@
 ﻿package uploader
 
-import "context"
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+// 上传器不支持清理旧证书时返回的错误
+var ErrCleanupNotSupported = errors.New("uploader does not support certificate cleanup")
 
 // 表示定义证书上传器的抽象类型接口。
 // 云服务商通常会提供 SSL 证书管理服务，可供用户集中管理证书。
@@ -25,3 +34,82 @@ type UploadResult struct {
 	CertName     string         `json:"certName"`
 	ExtendedData map[string]any `json:"extendedData,omitempty"`
 }
+
+// 表示支持查找已上传证书的上传器。
+// 实现此接口的上传器可复用已上传的相同证书，避免每次部署同一证书时重复上传。
+type CertificateFinder interface {
+	// 查找与给定证书 SHA-256 指纹相同的已上传证书。
+	//
+	// 入参：
+	//   - ctx：上下文。
+	//   - cert：待查找的证书。
+	//
+	// 出参：
+	//   - res：已上传证书的信息。未找到时返回 nil。
+	//   - err: 错误。
+	FindCertificate(ctx context.Context, cert *x509.Certificate) (res *UploadResult, err error)
+}
+
+// 查找与给定证书相同的已上传证书。
+//
+// 入参：
+//   - ctx：上下文。
+//   - u：上传器。
+//   - certPem：证书 PEM 内容。
+//
+// 出参：
+//   - res：已上传证书的信息。未找到或上传器未实现 [CertificateFinder] 时返回 nil。
+//   - err: 错误。
+func FindCertificate(ctx context.Context, u Uploader, certPem string) (res *UploadResult, err error) {
+	finder, ok := u.(CertificateFinder)
+	if !ok {
+		return nil, nil
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	return finder.FindCertificate(ctx, certX509)
+}
+
+// 表示支持清理已被取代的旧证书的上传器。
+// 可在部署成功后调用，避免云服务商证书管理服务中堆积大量过期或无用的证书。
+type CertificateCleaner interface {
+	// 清理已被给定证书取代的旧证书。
+	// 仅清理由 Certimate 上传、域名与给定证书完全一致、到期时间早于给定证书，且未关联任何云资源的证书。给定证书本身不会被清理。
+	//
+	// 入参：
+	//   - ctx：上下文。
+	//   - cert：当前使用的证书。
+	//
+	// 出参：
+	//   - certIds：已清理的证书 ID 列表。
+	//   - err: 错误。
+	CleanupCertificates(ctx context.Context, cert *x509.Certificate) (certIds []string, err error)
+}
+
+// 清理已被给定证书取代的旧证书。
+//
+// 入参：
+//   - ctx：上下文。
+//   - u：上传器。
+//   - certPem：当前使用的证书 PEM 内容。
+//
+// 出参：
+//   - certIds：已清理的证书 ID 列表。
+//   - err: 错误。上传器未实现 [CertificateCleaner] 时返回 [ErrCleanupNotSupported]。
+func CleanupCertificates(ctx context.Context, u Uploader, certPem string) (certIds []string, err error) {
+	cleaner, ok := u.(CertificateCleaner)
+	if !ok {
+		return nil, ErrCleanupNotSupported
+	}
+
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return nil, err
+	}
+
+	return cleaner.CleanupCertificates(ctx, certX509)
+}
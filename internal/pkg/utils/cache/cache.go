@@ -0,0 +1,203 @@
+package cache
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+var errFactoryPanicked = errors.New("cache factory panicked")
+
+type cacheEntry[V any] struct {
+	value     V
+	expiredAt time.Time
+}
+
+type cacheCall[V any] struct {
+	wg    sync.WaitGroup
+	value V
+	err   error
+}
+
+// 表示带有过期时间的内存缓存。
+// 该缓存是并发安全的。
+// 每次写入时，若距上次清理已超过一个存活时长，将顺带清理全部已过期的缓存项，避免长期不再访问的缓存项堆积。
+type TTLCache[K comparable, V any] struct {
+	ttl         time.Duration
+	entries     map[K]*cacheEntry[V]
+	calls       map[K]*cacheCall[V]
+	lastSweptAt time.Time
+	mutex       sync.Mutex
+}
+
+// 创建一个新的内存缓存。
+//
+// 入参：
+//   - ttl: 缓存项的存活时长。零值或负值表示永不过期。
+//
+// 出参：
+//   - 内存缓存。
+func NewTTLCache[K comparable, V any](ttl time.Duration) *TTLCache[K, V] {
+	return &TTLCache[K, V]{
+		ttl:         ttl,
+		entries:     make(map[K]*cacheEntry[V]),
+		calls:       make(map[K]*cacheCall[V]),
+		lastSweptAt: time.Now(),
+	}
+}
+
+// 获取指定键的缓存值。
+//
+// 入参：
+//   - key: 键。
+//
+// 出参：
+//   - value: 缓存值。
+//   - ok: 缓存项是否存在且未过期。
+func (c *TTLCache[K, V]) Get(key K) (value V, ok bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	entry, exists := c.entries[key]
+	if !exists {
+		return value, false
+	}
+
+	if c.isExpired(entry) {
+		delete(c.entries, key)
+		return value, false
+	}
+
+	return entry.value, true
+}
+
+// 设置指定键的缓存值。
+//
+// 入参：
+//   - key: 键。
+//   - value: 缓存值。
+func (c *TTLCache[K, V]) Set(key K, value V) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.store(key, value)
+}
+
+// 获取指定键的缓存值；如果不存在或已过期，则调用工厂函数创建并写入缓存。
+// 工厂函数返回错误时不会写入缓存。
+// 工厂函数执行期间不会阻塞其他键的读写；同一键的并发调用只会执行一次工厂函数，并共享其结果。
+//
+// 入参：
+//   - key: 键。
+//   - factory: 创建缓存值的工厂函数。
+//
+// 出参：
+//   - value: 缓存值。
+//   - err: 工厂函数返回的错误。
+func (c *TTLCache[K, V]) GetOrCreate(key K, factory func() (V, error)) (value V, err error) {
+	c.mutex.Lock()
+	if entry, exists := c.entries[key]; exists && !c.isExpired(entry) {
+		c.mutex.Unlock()
+		return entry.value, nil
+	}
+
+	if call, exists := c.calls[key]; exists {
+		c.mutex.Unlock()
+		call.wg.Wait()
+		return call.value, call.err
+	}
+
+	call := &cacheCall[V]{}
+	call.wg.Add(1)
+	c.calls[key] = call
+	c.mutex.Unlock()
+
+	defer func() {
+		c.mutex.Lock()
+		delete(c.calls, key)
+		if call.err == nil {
+			c.store(key, call.value)
+		}
+		c.mutex.Unlock()
+		call.wg.Done()
+	}()
+
+	// 工厂函数发生 panic 时，也应唤醒等待中的调用方
+	call.err = errFactoryPanicked
+	call.value, call.err = factory()
+	return call.value, call.err
+}
+
+// 移除指定键的缓存项。
+//
+// 入参：
+//   - key: 键。
+func (c *TTLCache[K, V]) Remove(key K) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	delete(c.entries, key)
+}
+
+// 移除所有满足条件的缓存项。
+//
+// 入参：
+//   - predicate: 为每个缓存项执行的函数。它应该返回一个布尔值以指示是否移除该缓存项。
+func (c *TTLCache[K, V]) RemoveFunc(predicate func(key K, value V) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, entry := range c.entries {
+		if predicate(key, entry.value) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// 清空所有缓存项。
+func (c *TTLCache[K, V]) Clear() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.entries = make(map[K]*cacheEntry[V])
+}
+
+// 清理全部已过期的缓存项。
+func (c *TTLCache[K, V]) Sweep() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.sweep()
+}
+
+// 调用方需持有锁。
+func (c *TTLCache[K, V]) store(key K, value V) {
+	c.entries[key] = c.newEntry(value)
+
+	if c.ttl > 0 && time.Since(c.lastSweptAt) >= c.ttl {
+		c.sweep()
+	}
+}
+
+// 调用方需持有锁。
+func (c *TTLCache[K, V]) sweep() {
+	for key, entry := range c.entries {
+		if c.isExpired(entry) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.lastSweptAt = time.Now()
+}
+
+func (c *TTLCache[K, V]) newEntry(value V) *cacheEntry[V] {
+	entry := &cacheEntry[V]{value: value}
+	if c.ttl > 0 {
+		entry.expiredAt = time.Now().Add(c.ttl)
+	}
+	return entry
+}
+
+func (c *TTLCache[K, V]) isExpired(entry *cacheEntry[V]) bool {
+	return !entry.expiredAt.IsZero() && time.Now().After(entry.expiredAt)
+}
@@ -0,0 +1,137 @@
+package cache_test
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/cache"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v ./cache_test.go
+*/
+func TestTTLCache(t *testing.T) {
+	t.Run("Get_Set", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](0)
+
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("expected key 'a' to be absent")
+		}
+
+		c.Set("a", 1)
+		if v, ok := c.Get("a"); !ok || v != 1 {
+			t.Errorf("expected (1, true), got (%d, %v)", v, ok)
+		}
+
+		c.Remove("a")
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("expected key 'a' to be removed")
+		}
+	})
+
+	t.Run("Expiration", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](20 * time.Millisecond)
+
+		c.Set("a", 1)
+		time.Sleep(40 * time.Millisecond)
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("expected key 'a' to be expired")
+		}
+	})
+
+	t.Run("GetOrCreate_FactoryError", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](0)
+
+		if _, err := c.GetOrCreate("a", func() (int, error) { return 0, errors.New("oops") }); err == nil {
+			t.Errorf("expected factory error")
+		}
+		if _, ok := c.Get("a"); ok {
+			t.Errorf("expected failed result not to be cached")
+		}
+
+		v, err := c.GetOrCreate("a", func() (int, error) { return 2, nil })
+		if err != nil || v != 2 {
+			t.Errorf("expected (2, nil), got (%d, %v)", v, err)
+		}
+	})
+
+	t.Run("GetOrCreate_SingleFlight", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](0)
+
+		var calls int32
+		release := make(chan struct{})
+		var wg sync.WaitGroup
+		for i := 0; i < 10; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				v, err := c.GetOrCreate("a", func() (int, error) {
+					atomic.AddInt32(&calls, 1)
+					<-release
+					return 3, nil
+				})
+				if err != nil || v != 3 {
+					t.Errorf("expected (3, nil), got (%d, %v)", v, err)
+				}
+			}()
+		}
+
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		if calls != 1 {
+			t.Errorf("expected factory to be called once, got %d", calls)
+		}
+	})
+
+	t.Run("GetOrCreate_NotBlockingOtherKeys", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](0)
+
+		release := make(chan struct{})
+		defer close(release)
+		go c.GetOrCreate("slow", func() (int, error) {
+			<-release
+			return 0, nil
+		})
+		time.Sleep(10 * time.Millisecond)
+
+		done := make(chan struct{})
+		go func() {
+			c.Set("a", 1)
+			c.GetOrCreate("b", func() (int, error) { return 2, nil })
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Errorf("expected other keys not to be blocked by a running factory")
+		}
+	})
+
+	t.Run("Sweep", func(t *testing.T) {
+		c := cache.NewTTLCache[string, int](20 * time.Millisecond)
+
+		c.Set("a", 1)
+		c.Set("b", 2)
+		time.Sleep(40 * time.Millisecond)
+
+		// 写入时顺带清理已过期的缓存项
+		c.Set("c", 3)
+
+		removed := make([]string, 0)
+		c.RemoveFunc(func(key string, _ int) bool {
+			removed = append(removed, key)
+			return true
+		})
+		if len(removed) != 1 || removed[0] != "c" {
+			t.Errorf("expected only key 'c' to remain, got %v", removed)
+		}
+	})
+}
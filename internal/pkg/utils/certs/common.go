@@ -1,7 +1,10 @@
 ﻿package certs
 
 import (
+	"crypto/sha256"
 	"crypto/x509"
+	"encoding/hex"
+	"strings"
 )
 
 // 比较两个 x509.Certificate 对象，判断它们是否是同一张证书。
@@ -24,3 +27,39 @@ func EqualCertificate(a, b *x509.Certificate) bool {
 		a.Issuer.SerialNumber == b.Issuer.SerialNumber &&
 		a.Subject.SerialNumber == b.Subject.SerialNumber
 }
+
+// 计算 x509.Certificate 对象的 SHA-256 指纹。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//
+// 出参:
+//   - 指纹的十六进制字符串（小写，无分隔符）。
+func GetCertificateFingerprintSHA256(cert *x509.Certificate) string {
+	if cert == nil {
+		return ""
+	}
+
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// 比较两个证书指纹字符串是否相同。
+// 忽略大小写及常见的分隔符（如冒号、空格），以兼容各云服务商返回的不同格式。
+//
+// 入参:
+//   - a: 待比较的第一个指纹字符串。
+//   - b: 待比较的第二个指纹字符串。
+//
+// 出参:
+//   - 是否相同。
+func EqualFingerprint(a, b string) bool {
+	normalize := func(s string) string {
+		s = strings.ReplaceAll(s, ":", "")
+		s = strings.ReplaceAll(s, " ", "")
+		return strings.ToLower(s)
+	}
+
+	a, b = normalize(a), normalize(b)
+	return a != "" && a == b
+}
@@ -0,0 +1,50 @@
+﻿package certs
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+)
+
+// 通过 TLS 握手获取服务器当前所用的证书。
+// 仅用于读取证书，不会校验证书链及主机名。
+//
+// 入参:
+//   - ctx: 上下文。
+//   - address: 服务器地址，形如 "example.com:443"。未指定端口时默认为 443。
+//   - serverName: TLS 握手时发送的 SNI。为空时取 address 中的主机名。
+//
+// 出参:
+//   - cert: 服务器当前所用的证书。
+//   - err: 错误。
+func FetchCertificateFromServer(ctx context.Context, address string, serverName string) (cert *x509.Certificate, err error) {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		host = address
+		address = net.JoinHostPort(address, "443")
+	}
+	if serverName == "" {
+		serverName = host
+	}
+
+	dialer := &tls.Dialer{
+		Config: &tls.Config{
+			ServerName:         serverName,
+			InsecureSkipVerify: true,
+		},
+	}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	peerCerts := conn.(*tls.Conn).ConnectionState().PeerCertificates
+	if len(peerCerts) == 0 {
+		return nil, errors.New("no certificate returned by server")
+	}
+
+	return peerCerts[0], nil
+}
@@ -0,0 +1,146 @@
+﻿package certs
+
+import (
+	"crypto/x509"
+	"strings"
+
+	"golang.org/x/net/idna"
+)
+
+// 判断主机名是否与证书的某个 SAN 条目匹配。
+// 通配符仅匹配一级子域名，即 "*.example.com" 可匹配 "www.example.com"，但不匹配 "example.com" 或 "a.b.example.com"。
+// 若主机名本身是泛域名（如某些云服务商允许添加泛域名加速域名），则仅在 SAN 中存在相同的泛域名时才视为匹配。
+//
+// 入参:
+//   - san: 证书的 SAN 条目，如 "example.com"、"*.example.com"。
+//   - hostname: 待匹配的主机名。支持 "*.example.com" 或 ".example.com" 形式的泛域名。
+//
+// 出参:
+//   - 是否匹配。
+func MatchHostname(san, hostname string) bool {
+	san = normalizeHostname(san)
+	hostname = normalizeHostname(hostname)
+	if san == "" || hostname == "" {
+		return false
+	}
+
+	if san == hostname {
+		return true
+	}
+
+	if strings.HasPrefix(hostname, "*.") || !strings.HasPrefix(san, "*.") {
+		return false
+	}
+
+	// 仅匹配泛域名的下一级子域名
+	suffix := strings.TrimPrefix(san, "*")
+	if !strings.HasSuffix(hostname, suffix) {
+		return false
+	}
+
+	label := strings.TrimSuffix(hostname, suffix)
+	return label != "" && !strings.Contains(label, ".")
+}
+
+// 判断证书是否覆盖指定主机名。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//   - hostname: 待匹配的主机名。
+//
+// 出参:
+//   - 是否覆盖。
+func IsCertificateCoversHostname(cert *x509.Certificate, hostname string) bool {
+	if cert == nil {
+		return false
+	}
+
+	for _, san := range cert.DNSNames {
+		if MatchHostname(san, hostname) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// 从主机名列表中筛选出被证书覆盖的主机名，可用于查找云服务商中需要部署该证书的全部域名。
+// 返回结果保持入参中的顺序，并去除重复项。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//   - hostnames: 待筛选的主机名列表，通常为云服务商接口返回的域名列表。
+//
+// 出参:
+//   - 被证书覆盖的主机名列表。
+func FilterHostnamesCoveredByCertificate(cert *x509.Certificate, hostnames []string) []string {
+	matched := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, hostname := range hostnames {
+		if seen[hostname] {
+			continue
+		}
+
+		if IsCertificateCoversHostname(cert, hostname) {
+			seen[hostname] = true
+			matched = append(matched, hostname)
+		}
+	}
+
+	return matched
+}
+
+// 判断证书的 SAN 列表是否与给定主机名列表完全一致，可用于查找云服务商中与该证书域名相同的其他证书。
+// 忽略顺序、大小写及重复项。
+//
+// 入参:
+//   - cert: x509.Certificate 对象。
+//   - hostnames: 待比较的主机名列表，通常为云服务商接口返回的证书域名列表。
+//
+// 出参:
+//   - 是否一致。
+func IsCertificateHostnamesEqual(cert *x509.Certificate, hostnames []string) bool {
+	if cert == nil {
+		return false
+	}
+
+	certHostnames := make(map[string]bool)
+	for _, san := range cert.DNSNames {
+		if san = normalizeHostname(san); san != "" {
+			certHostnames[san] = true
+		}
+	}
+
+	otherHostnames := make(map[string]bool)
+	for _, hostname := range hostnames {
+		if hostname = normalizeHostname(hostname); hostname != "" {
+			otherHostnames[hostname] = true
+		}
+	}
+
+	if len(certHostnames) == 0 || len(certHostnames) != len(otherHostnames) {
+		return false
+	}
+
+	for hostname := range otherHostnames {
+		if !certHostnames[hostname] {
+			return false
+		}
+	}
+
+	return true
+}
+
+func normalizeHostname(hostname string) string {
+	hostname = strings.ToLower(strings.TrimSpace(hostname))
+	hostname = strings.TrimSuffix(hostname, ".")
+	if strings.HasPrefix(hostname, ".") {
+		// ".example.com" → "*.example.com"，部分云服务商以此形式表示泛域名
+		hostname = "*" + hostname
+	}
+	if ascii, err := idna.Punycode.ToASCII(hostname); err == nil {
+		// "例子.com" → "xn--fsqu00a.com"，证书 SAN 中的国际化域名均为 Punycode 形式
+		hostname = ascii
+	}
+	return hostname
+}
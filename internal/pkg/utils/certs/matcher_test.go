@@ -0,0 +1,135 @@
+package certs_test
+
+import (
+	"crypto/x509"
+	"slices"
+	"testing"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v ./matcher_test.go
+*/
+func TestMatchHostname(t *testing.T) {
+	testCases := []struct {
+		name     string
+		san      string
+		hostname string
+		want     bool
+	}{
+		{name: "exact", san: "example.com", hostname: "example.com", want: true},
+		{name: "exact mismatch", san: "example.com", hostname: "example.org", want: false},
+		{name: "case insensitive", san: "WWW.Example.com", hostname: "www.example.COM", want: true},
+		{name: "empty san", san: "", hostname: "example.com", want: false},
+		{name: "empty hostname", san: "example.com", hostname: "", want: false},
+
+		{name: "wildcard one level", san: "*.example.com", hostname: "www.example.com", want: true},
+		{name: "wildcard apex", san: "*.example.com", hostname: "example.com", want: false},
+		{name: "wildcard two levels", san: "*.example.com", hostname: "a.b.example.com", want: false},
+		{name: "wildcard suffix only", san: "*.example.com", hostname: "badexample.com", want: false},
+		{name: "wildcard nested", san: "*.b.example.com", hostname: "a.b.example.com", want: true},
+		{name: "wildcard hostname equal", san: "*.example.com", hostname: "*.example.com", want: true},
+		{name: "wildcard hostname deeper", san: "*.example.com", hostname: "*.b.example.com", want: false},
+		{name: "wildcard hostname vs plain san", san: "www.example.com", hostname: "*.example.com", want: false},
+		{name: "dot-prefixed hostname", san: "*.example.com", hostname: ".example.com", want: true},
+		{name: "dot-prefixed san", san: ".example.com", hostname: "www.example.com", want: true},
+
+		{name: "trailing dot hostname", san: "example.com", hostname: "example.com.", want: true},
+		{name: "trailing dot san", san: "*.example.com.", hostname: "www.example.com", want: true},
+		{name: "trailing dot both", san: "*.example.com.", hostname: "www.example.com.", want: true},
+		{name: "surrounding spaces", san: " example.com ", hostname: "example.com", want: true},
+
+		{name: "idn unicode to punycode", san: "xn--fsqu00a.com", hostname: "例子.com", want: true},
+		{name: "idn punycode to unicode", san: "例子.com", hostname: "xn--fsqu00a.com", want: true},
+		{name: "idn wildcard", san: "*.xn--fsqu00a.com", hostname: "www.例子.com", want: true},
+		{name: "idn wildcard label", san: "*.example.com", hostname: "例子.example.com", want: true},
+		{name: "idn wildcard two levels", san: "*.xn--fsqu00a.com", hostname: "a.b.例子.com", want: false},
+		{name: "idn mismatch", san: "xn--fsqu00a.com", hostname: "例子.cn", want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := certs.MatchHostname(tc.san, tc.hostname); got != tc.want {
+				t.Errorf("MatchHostname(%q, %q) = %v, want %v", tc.san, tc.hostname, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterHostnamesCoveredByCertificate(t *testing.T) {
+	cert := &x509.Certificate{
+		DNSNames: []string{"example.com", "*.example.com", "xn--fsqu00a.com"},
+	}
+
+	testCases := []struct {
+		name      string
+		hostnames []string
+		want      []string
+	}{
+		{
+			name:      "keeps order and removes duplicates",
+			hostnames: []string{"www.example.com", "example.com", "www.example.com", "api.example.com"},
+			want:      []string{"www.example.com", "example.com", "api.example.com"},
+		},
+		{
+			name:      "skips deeper subdomains",
+			hostnames: []string{"a.b.example.com", "cdn.example.com"},
+			want:      []string{"cdn.example.com"},
+		},
+		{
+			name:      "keeps original form of matched hostnames",
+			hostnames: []string{"example.com.", ".example.com", "例子.com"},
+			want:      []string{"example.com.", ".example.com", "例子.com"},
+		},
+		{
+			name:      "nothing covered",
+			hostnames: []string{"example.org", "例子.cn"},
+			want:      []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := certs.FilterHostnamesCoveredByCertificate(cert, tc.hostnames); !slices.Equal(got, tc.want) {
+				t.Errorf("FilterHostnamesCoveredByCertificate(%v) = %v, want %v", tc.hostnames, got, tc.want)
+			}
+		})
+	}
+
+	t.Run("nil certificate", func(t *testing.T) {
+		if got := certs.FilterHostnamesCoveredByCertificate(nil, []string{"example.com"}); len(got) != 0 {
+			t.Errorf("expected no hostnames, got %v", got)
+		}
+	})
+}
+
+func TestIsCertificateHostnamesEqual(t *testing.T) {
+	cert := &x509.Certificate{
+		DNSNames: []string{"example.com", "*.example.com"},
+	}
+
+	testCases := []struct {
+		name      string
+		hostnames []string
+		want      bool
+	}{
+		{name: "same", hostnames: []string{"example.com", "*.example.com"}, want: true},
+		{name: "different order and case", hostnames: []string{"*.EXAMPLE.com", "Example.com"}, want: true},
+		{name: "trailing dots and duplicates", hostnames: []string{"example.com.", "*.example.com", "example.com"}, want: true},
+		{name: "dot-prefixed wildcard", hostnames: []string{"example.com", ".example.com"}, want: true},
+		{name: "missing one", hostnames: []string{"example.com"}, want: false},
+		{name: "extra one", hostnames: []string{"example.com", "*.example.com", "www.example.com"}, want: false},
+		{name: "empty", hostnames: []string{}, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := certs.IsCertificateHostnamesEqual(cert, tc.hostnames); got != tc.want {
+				t.Errorf("IsCertificateHostnamesEqual(%v) = %v, want %v", tc.hostnames, got, tc.want)
+			}
+		})
+	}
+}
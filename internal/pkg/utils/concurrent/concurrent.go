@@ -0,0 +1,55 @@
+package concurrent
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// 默认的最大并发数。
+var DefaultLimit = 4
+
+// 以有限的并发数对切片中的每个元素执行指定的函数，并等待全部执行完成。
+// 单个元素执行失败不会中断其他元素的执行，所有错误将按元素顺序合并后返回。
+//
+// 入参：
+//   - ctx: 上下文。上下文取消后，尚未开始执行的元素将被跳过。
+//   - items: 元素切片。
+//   - limit: 最大并发数。零值或负值表示使用 [DefaultLimit]。
+//   - fn: 为每个元素执行的函数。
+//
+// 出参：
+//   - 错误。
+func ForEach[T any](ctx context.Context, items []T, limit int, fn func(ctx context.Context, item T) error) error {
+	if limit <= 0 {
+		limit = DefaultLimit
+	}
+	if limit <= 0 {
+		limit = 1
+	}
+
+	errs := make([]error, len(items))
+	sem := make(chan struct{}, limit)
+	wg := sync.WaitGroup{}
+	for i, item := range items {
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func(i int, item T) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			errs[i] = fn(ctx, item)
+		}(i, item)
+	}
+
+	wg.Wait()
+	return errors.Join(errs...)
+}
@@ -0,0 +1,430 @@
+package jsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// 表示 JSON Schema 文档。
+// 仅支持以下关键字的子集：
+//   - type, enum, const
+//   - properties, required
+//   - items, minItems, maxItems
+//   - minLength, maxLength, pattern
+//   - minimum, maximum
+//   - allOf, anyOf, if/then/else
+//
+// 为兼容表单提交的配置，校验时存在以下宽松处理：
+//   - "required" 关键字将 null、空字符串及空数组均视为缺失；
+//   - "type" 关键字允许以字符串形式表示的数字及布尔值。
+type Schema struct {
+	Type       any                `json:"type,omitempty"`
+	Enum       []any              `json:"enum,omitempty"`
+	Const      any                `json:"const,omitempty"`
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	Items      *Schema            `json:"items,omitempty"`
+	MinItems   *int               `json:"minItems,omitempty"`
+	MaxItems   *int               `json:"maxItems,omitempty"`
+	MinLength  *int               `json:"minLength,omitempty"`
+	MaxLength  *int               `json:"maxLength,omitempty"`
+	Pattern    string             `json:"pattern,omitempty"`
+	Minimum    *float64           `json:"minimum,omitempty"`
+	Maximum    *float64           `json:"maximum,omitempty"`
+	AllOf      []*Schema          `json:"allOf,omitempty"`
+	AnyOf      []*Schema          `json:"anyOf,omitempty"`
+	If         *Schema            `json:"if,omitempty"`
+	Then       *Schema            `json:"then,omitempty"`
+	Else       *Schema            `json:"else,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+// 表示单个字段的校验错误。
+type FieldError struct {
+	// 字段路径，如 "domain"、"targets[0].host"。
+	// 为空时表示根节点。
+	Field string `json:"field"`
+	// 错误信息。
+	Message string `json:"message"`
+}
+
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+
+	return fmt.Sprintf("`%s` %s", e.Field, e.Message)
+}
+
+// 表示校验失败时返回的错误，包含全部字段的校验错误。
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, fe := range e.Errors {
+		messages = append(messages, fe.Error())
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+// 为校验错误中的全部字段路径添加前缀。
+//
+// 入参：
+//   - err: 错误。
+//   - prefix: 字段路径前缀，如 "providerConfig"。
+//
+// 出参：
+//   - 错误。当 err 不是 [*ValidationError] 时原样返回。
+func WithFieldPrefix(err error, prefix string) error {
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		return err
+	}
+
+	fieldErrs := make([]FieldError, 0, len(verr.Errors))
+	for _, fe := range verr.Errors {
+		fe.Field = joinPath(prefix, fe.Field)
+		fieldErrs = append(fieldErrs, fe)
+	}
+
+	return &ValidationError{Errors: fieldErrs}
+}
+
+// 解析 JSON Schema 文档。
+//
+// 入参：
+//   - data: JSON 格式的文档内容。
+//
+// 出参：
+//   - schema: JSON Schema 文档。
+//   - err: 错误。
+func Parse(data []byte) (*Schema, error) {
+	schema := &Schema{}
+	if err := json.Unmarshal(data, schema); err != nil {
+		return nil, err
+	}
+
+	if err := schema.compile(); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// 从文件系统中读取并解析 JSON Schema 文档。
+//
+// 入参：
+//   - fsys: 文件系统，通常为嵌入的文件系统。
+//   - name: 文件路径。
+//
+// 出参：
+//   - schema: JSON Schema 文档。
+//   - err: 错误。文件不存在时返回的错误满足 errors.Is(err, fs.ErrNotExist)。
+func ParseFS(fsys fs.FS, name string) (*Schema, error) {
+	data, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, err
+	}
+
+	schema, err := Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse json schema '%s': %w", name, err)
+	}
+
+	return schema, nil
+}
+
+// 校验指定值是否符合 JSON Schema 文档。
+//
+// 入参：
+//   - value: 待校验的值，通常为 JSON 反序列化后的结果。
+//
+// 出参：
+//   - 错误。校验失败时返回 [*ValidationError]。
+func (s *Schema) Validate(value any) error {
+	errs := s.validate("", value)
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return &ValidationError{Errors: errs}
+}
+
+func (s *Schema) compile() error {
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", s.Pattern, err)
+		}
+		s.pattern = re
+	}
+
+	children := make([]*Schema, 0)
+	for _, child := range s.Properties {
+		children = append(children, child)
+	}
+	children = append(children, s.Items, s.If, s.Then, s.Else)
+	children = append(children, s.AllOf...)
+	children = append(children, s.AnyOf...)
+	for _, child := range children {
+		if child == nil {
+			continue
+		}
+
+		if err := child.compile(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Schema) validate(path string, value any) []FieldError {
+	errs := make([]FieldError, 0)
+	fail := func(format string, args ...any) {
+		errs = append(errs, FieldError{Field: path, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if s.Type != nil && !matchType(s.Type, value) {
+		fail("must be of type %s", formatType(s.Type))
+		return errs
+	}
+
+	if s.Const != nil && !equalValue(s.Const, value) {
+		fail("must be equal to %v", s.Const)
+	}
+
+	if len(s.Enum) > 0 {
+		matched := false
+		for _, e := range s.Enum {
+			if equalValue(e, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			fail("must be one of %v", s.Enum)
+		}
+	}
+
+	switch v := value.(type) {
+	case string:
+		length := len([]rune(v))
+		if s.MinLength != nil && length < *s.MinLength {
+			fail("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && length > *s.MaxLength {
+			fail("must be at most %d characters", *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			fail("must match pattern %q", s.Pattern)
+		}
+	}
+
+	if n, ok := toNumber(value); ok {
+		if s.Minimum != nil && n < *s.Minimum {
+			fail("must be greater than or equal to %v", *s.Minimum)
+		}
+		if s.Maximum != nil && n > *s.Maximum {
+			fail("must be less than or equal to %v", *s.Maximum)
+		}
+	}
+
+	if arr, ok := value.([]any); ok {
+		if s.MinItems != nil && len(arr) < *s.MinItems {
+			fail("must contain at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(arr) > *s.MaxItems {
+			fail("must contain at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range arr {
+				errs = append(errs, s.Items.validate(fmt.Sprintf("%s[%d]", path, i), item)...)
+			}
+		}
+	}
+
+	if obj, ok := value.(map[string]any); ok {
+		for _, key := range s.Required {
+			if isEmptyValue(obj[key]) {
+				errs = append(errs, FieldError{Field: joinPath(path, key), Message: "is required"})
+			}
+		}
+
+		keys := make([]string, 0, len(s.Properties))
+		for key := range s.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			// 缺失或为空的字段由 "required" 关键字负责校验
+			if v, ok := obj[key]; ok && !isEmptyValue(v) {
+				errs = append(errs, s.Properties[key].validate(joinPath(path, key), v)...)
+			}
+		}
+	}
+
+	for _, sub := range s.AllOf {
+		errs = append(errs, sub.validate(path, value)...)
+	}
+
+	if len(s.AnyOf) > 0 {
+		var firstErrs []FieldError
+		matched := false
+		for _, sub := range s.AnyOf {
+			subErrs := sub.validate(path, value)
+			if len(subErrs) == 0 {
+				matched = true
+				break
+			}
+			if firstErrs == nil {
+				firstErrs = subErrs
+			}
+		}
+		if !matched {
+			errs = append(errs, firstErrs...)
+		}
+	}
+
+	if s.If != nil {
+		if len(s.If.validate(path, value)) == 0 {
+			if s.Then != nil {
+				errs = append(errs, s.Then.validate(path, value)...)
+			}
+		} else {
+			if s.Else != nil {
+				errs = append(errs, s.Else.validate(path, value)...)
+			}
+		}
+	}
+
+	return errs
+}
+
+func joinPath(path string, key string) string {
+	if path == "" {
+		return key
+	} else if key == "" {
+		return path
+	}
+
+	return path + "." + key
+}
+
+func isEmptyValue(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	}
+
+	return false
+}
+
+func matchType(t any, value any) bool {
+	switch tv := t.(type) {
+	case string:
+		return matchSingleType(tv, value)
+	case []any:
+		for _, item := range tv {
+			if s, ok := item.(string); ok && matchSingleType(s, value) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func matchSingleType(t string, value any) bool {
+	switch t {
+	case "null":
+		return value == nil
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		switch v := value.(type) {
+		case bool:
+			return true
+		case string:
+			_, err := strconv.ParseBool(v)
+			return err == nil
+		}
+	case "number":
+		_, ok := toNumber(value)
+		return ok
+	case "integer":
+		n, ok := toNumber(value)
+		return ok && n == math.Trunc(n)
+	case "array":
+		_, ok := value.([]any)
+		return ok
+	case "object":
+		_, ok := value.(map[string]any)
+		return ok
+	}
+
+	return false
+}
+
+func formatType(t any) string {
+	if arr, ok := t.([]any); ok {
+		parts := make([]string, 0, len(arr))
+		for _, item := range arr {
+			parts = append(parts, fmt.Sprint(item))
+		}
+		return strings.Join(parts, " or ")
+	}
+
+	return fmt.Sprint(t)
+}
+
+func toNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case json.Number:
+		n, err := v.Float64()
+		return n, err == nil
+	case string:
+		n, err := strconv.ParseFloat(strings.TrimSpace(v), 64)
+		return n, err == nil
+	}
+
+	return 0, false
+}
+
+func equalValue(expected any, actual any) bool {
+	if en, ok := expected.(float64); ok {
+		if an, ok := toNumber(actual); ok {
+			return en == an
+		}
+		return false
+	}
+
+	return reflect.DeepEqual(expected, actual)
+}
@@ -0,0 +1,247 @@
+package ssh
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net"
+	"os"
+	"path"
+	"strconv"
+	"strings"
+
+	xerrors "github.com/pkg/errors"
+	"github.com/pkg/sftp"
+	"github.com/povsister/scp"
+	"golang.org/x/crypto/ssh"
+)
+
+// 表示跳板机配置的数据结构。
+type JumpServerConfig struct {
+	// SSH 主机。
+	SshHost string `json:"sshHost"`
+	// SSH 端口。
+	// 零值时默认为 22。
+	SshPort int32 `json:"sshPort,omitempty"`
+	// SSH 登录用户名。
+	SshUsername string `json:"sshUsername,omitempty"`
+	// SSH 登录密码。
+	SshPassword string `json:"sshPassword,omitempty"`
+	// SSH 登录私钥。
+	SshKey string `json:"sshKey,omitempty"`
+	// SSH 登录私钥口令。
+	SshKeyPassphrase string `json:"sshKeyPassphrase,omitempty"`
+}
+
+// 创建 SSH 客户端。
+// 指定跳板机时，将按顺序经由跳板机逐级建立连接，最后经由最末一台跳板机连接到目标主机。
+//
+// 入参:
+//   - jumpServers: 跳板机列表，可为空。
+//   - host: SSH 主机。零值时默认为 "localhost"。
+//   - port: SSH 端口。零值时默认为 22。
+//   - username: SSH 登录用户名。
+//   - password: SSH 登录密码。
+//   - key: SSH 登录私钥。非零值时优先于密码使用。
+//   - keyPassphrase: SSH 登录私钥口令。
+//
+// 出参:
+//   - client: 到目标主机的 SSH 客户端。
+//   - closeClient: 用于关闭整条连接链的函数。
+//   - err: 错误。
+func NewClient(jumpServers []JumpServerConfig, host string, port int32, username string, password string, key string, keyPassphrase string) (client *ssh.Client, closeClient func(), err error) {
+	clients := make([]*ssh.Client, 0, len(jumpServers)+1)
+	closeClients := func() {
+		for i := len(clients) - 1; i >= 0; i-- {
+			clients[i].Close()
+		}
+	}
+
+	var viaClient *ssh.Client
+	for i, jumpServer := range jumpServers {
+		jumpClient, err := dial(viaClient, jumpServer.SshHost, jumpServer.SshPort, jumpServer.SshUsername, jumpServer.SshPassword, jumpServer.SshKey, jumpServer.SshKeyPassphrase)
+		if err != nil {
+			closeClients()
+			return nil, nil, xerrors.Wrapf(err, "failed to connect to jump server #%d '%s'", i+1, jumpServer.SshHost)
+		}
+
+		clients = append(clients, jumpClient)
+		viaClient = jumpClient
+	}
+
+	client, err = dial(viaClient, host, port, username, password, key, keyPassphrase)
+	if err != nil {
+		closeClients()
+		return nil, nil, err
+	}
+
+	clients = append(clients, client)
+	return client, closeClients, nil
+}
+
+func dial(viaClient *ssh.Client, host string, port int32, username string, password string, key string, keyPassphrase string) (*ssh.Client, error) {
+	if host == "" {
+		host = "localhost"
+	}
+
+	if port == 0 {
+		port = 22
+	}
+
+	if username == "" {
+		return nil, errors.New("invalid ssh username")
+	}
+
+	var authMethod ssh.AuthMethod
+	if key != "" {
+		var signer ssh.Signer
+		var err error
+
+		if keyPassphrase != "" {
+			signer, err = ssh.ParsePrivateKeyWithPassphrase([]byte(key), []byte(keyPassphrase))
+		} else {
+			signer, err = ssh.ParsePrivateKey([]byte(key))
+		}
+
+		if err != nil {
+			return nil, err
+		}
+		authMethod = ssh.PublicKeys(signer)
+	} else {
+		authMethod = ssh.Password(password)
+	}
+
+	addr := net.JoinHostPort(host, strconv.Itoa(int(port)))
+	clientConfig := &ssh.ClientConfig{
+		User:            username,
+		Auth:            []ssh.AuthMethod{authMethod},
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+	}
+
+	if viaClient == nil {
+		return ssh.Dial("tcp", addr, clientConfig)
+	}
+
+	conn, err := viaClient.Dial("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+
+	clientConn, chans, reqs, err := ssh.NewClientConn(conn, addr, clientConfig)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+
+	return ssh.NewClient(clientConn, chans, reqs), nil
+}
+
+// 在远程主机上执行命令。
+//
+// 入参:
+//   - client: SSH 客户端。
+//   - command: 待执行的命令。
+//   - stdin: 标准输入，可为 nil。
+//
+// 出参:
+//   - stdout: 标准输出。
+//   - stderr: 标准错误输出。
+//   - err: 错误。
+func ExecCommand(client *ssh.Client, command string, stdin io.Reader) (stdout string, stderr string, err error) {
+	session, err := client.NewSession()
+	if err != nil {
+		return "", "", err
+	}
+	defer session.Close()
+
+	stdoutBuf := bytes.NewBuffer(nil)
+	session.Stdout = stdoutBuf
+	stderrBuf := bytes.NewBuffer(nil)
+	session.Stderr = stderrBuf
+	session.Stdin = stdin
+	err = session.Run(command)
+	if err != nil {
+		return stdoutBuf.String(), stderrBuf.String(), xerrors.Wrap(err, "failed to execute ssh command")
+	}
+
+	return stdoutBuf.String(), stderrBuf.String(), nil
+}
+
+// 将数据写入远程主机上指定路径的文件。
+// 如果目录不存在，将会递归创建目录（仅 SFTP 模式）。
+// 如果文件已存在，将会覆盖原有内容。
+//
+// 入参:
+//   - client: SSH 客户端。
+//   - useSCP: 是否使用 SCP 而非 SFTP。
+//   - filePath: 远程文件路径。
+//   - data: 文件数据字节数组。
+//   - perm: 文件权限。零值时不修改文件权限。
+//
+// 出参:
+//   - 错误。
+func WriteFile(client *ssh.Client, useSCP bool, filePath string, data []byte, perm os.FileMode) error {
+	if useSCP {
+		return writeFileWithSCP(client, filePath, data, perm)
+	}
+
+	return writeFileWithSFTP(client, filePath, data, perm)
+}
+
+func writeFileWithSCP(client *ssh.Client, filePath string, data []byte, perm os.FileMode) error {
+	scpCli, err := scp.NewClientFromExistingSSH(client, &scp.ClientOption{})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create scp client")
+	}
+	defer scpCli.Close()
+
+	reader := bytes.NewReader(data)
+	err = scpCli.CopyToRemote(reader, filePath, &scp.FileTransferOption{Perm: perm})
+	if err != nil {
+		return xerrors.Wrap(err, "failed to write to remote file")
+	}
+
+	return nil
+}
+
+func writeFileWithSFTP(client *ssh.Client, filePath string, data []byte, perm os.FileMode) error {
+	sftpCli, err := sftp.NewClient(client)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to create sftp client")
+	}
+	defer sftpCli.Close()
+
+	if err := sftpCli.MkdirAll(path.Dir(filePath)); err != nil {
+		return xerrors.Wrap(err, "failed to create remote directory")
+	}
+
+	file, err := sftpCli.OpenFile(filePath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to open remote file")
+	}
+	defer file.Close()
+
+	if perm != 0 {
+		if err := file.Chmod(perm); err != nil {
+			return xerrors.Wrap(err, "failed to change remote file mode")
+		}
+	}
+
+	_, err = file.Write(data)
+	if err != nil {
+		return xerrors.Wrap(err, "failed to write to remote file")
+	}
+
+	return nil
+}
+
+// 将字符串转义为可安全拼接到 POSIX Shell 命令中的单引号字符串。
+//
+// 入参:
+//   - s: 原始字符串。
+//
+// 出参:
+//   - 转义后的字符串。
+func QuoteShellString(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
@@ -14,6 +14,15 @@ func (c *Client) UpdateSystemSSL(req *UpdateSystemSSLRequest) (*UpdateSystemSSLR
 	return resp, nil
 }
 
+func (c *Client) SearchWebsite(req *SearchWebsiteRequest) (*SearchWebsiteResponse, error) {
+	resp := &SearchWebsiteResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/websites/search", req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) SearchWebsiteSSL(req *SearchWebsiteSSLRequest) (*SearchWebsiteSSLResponse, error) {
 	resp := &SearchWebsiteSSLResponse{}
 	err := c.sendRequestWithResult(http.MethodPost, "/websites/ssl/search", req, resp)
@@ -31,6 +31,31 @@ type UpdateSystemSSLResponse struct {
 	baseResponse
 }
 
+type SearchWebsiteRequest struct {
+	Page           int32  `json:"page"`
+	PageSize       int32  `json:"pageSize"`
+	Name           string `json:"name,omitempty"`
+	WebsiteGroupId int64  `json:"websiteGroupId"`
+	OrderBy        string `json:"orderBy"`
+	Order          string `json:"order"`
+}
+
+type SearchWebsiteResponse struct {
+	baseResponse
+	Data struct {
+		Items []*struct {
+			ID            int64  `json:"id"`
+			PrimaryDomain string `json:"primaryDomain"`
+			Alias         string `json:"alias"`
+			Type          string `json:"type"`
+			Status        string `json:"status"`
+			SSLStatus     string `json:"sslStatus"`
+			SSLExpireDate string `json:"sslExpireDate"`
+		} `json:"items"`
+		Total int32 `json:"total"`
+	} `json:"data"`
+}
+
 type SearchWebsiteSSLRequest struct {
 	Page     int32 `json:"page"`
 	PageSize int32 `json:"pageSize"`
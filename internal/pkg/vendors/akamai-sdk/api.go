@@ -0,0 +1,97 @@
+package akamaisdk
+
+import (
+	"fmt"
+	"net/http"
+)
+
+// CPS 接口的请求及响应均使用带版本号的媒体类型。
+// REF: https://techdocs.akamai.com/cps/reference/api
+const (
+	mediaTypeEnrollment               = "application/vnd.akamai.cps.enrollment.v11+json"
+	mediaTypeChange                   = "application/vnd.akamai.cps.change.v2+json"
+	mediaTypeChangeId                 = "application/vnd.akamai.cps.change-id.v1+json"
+	mediaTypeCSR                      = "application/vnd.akamai.cps.csr.v2+json"
+	mediaTypeCertificateAndTrustChain = "application/vnd.akamai.cps.certificate-and-trust-chain.v2+json"
+	mediaTypePostVerificationWarnings = "application/vnd.akamai.cps.warnings.v1+json"
+	mediaTypeAcknowledgement          = "application/vnd.akamai.cps.acknowledgement.v1+json"
+)
+
+// 获取证书注册信息。
+func (c *Client) GetEnrollment(enrollmentId int64) (*Enrollment, error) {
+	resp := Enrollment{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/cps/v2/enrollments/%d", enrollmentId), mediaTypeEnrollment, "", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 获取变更状态。变更已结束而不存在时返回 nil。
+//
+// 入参：
+//   - changeLocation：变更地址，即证书注册信息中的 pendingChanges[].location。
+func (c *Client) GetChangeStatus(changeLocation string) (*ChangeStatus, error) {
+	resp := ChangeStatus{}
+	err := c.sendRequestWithResult(http.MethodGet, changeLocation, mediaTypeChange, "", nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 获取第三方证书的 CSR。
+//
+// 入参：
+//   - infoLocation：输入信息地址，即变更状态中类型为 "third-party-certificate" 的 allowedInput[].info。
+func (c *Client) GetThirdPartyCSR(infoLocation string) (*ThirdPartyCSR, error) {
+	resp := ThirdPartyCSR{}
+	err := c.sendRequestWithResult(http.MethodGet, infoLocation, mediaTypeCSR, "", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 上传第三方证书及其信任链。
+//
+// 入参：
+//   - updateLocation：输入更新地址，即变更状态中类型为 "third-party-certificate" 的 allowedInput[].update。
+func (c *Client) UploadThirdPartyCertAndTrustChain(updateLocation string, req *UploadThirdPartyCertAndTrustChainRequest) (*ChangeIdResponse, error) {
+	resp := ChangeIdResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, updateLocation, mediaTypeChangeId, mediaTypeCertificateAndTrustChain, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 获取部署后校验警告。
+//
+// 入参：
+//   - infoLocation：输入信息地址，即变更状态中类型为 "post-verification-warnings-acknowledgement" 的 allowedInput[].info。
+func (c *Client) GetPostVerificationWarnings(infoLocation string) (*PostVerificationWarnings, error) {
+	resp := PostVerificationWarnings{}
+	err := c.sendRequestWithResult(http.MethodGet, infoLocation, mediaTypePostVerificationWarnings, "", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 确认变更输入，如部署后校验警告、变更管理等。
+//
+// 入参：
+//   - updateLocation：输入更新地址，即变更状态中相应类型的 allowedInput[].update。
+func (c *Client) AcknowledgeChangeInput(updateLocation string) (*ChangeIdResponse, error) {
+	req := &AcknowledgementRequest{Acknowledgement: "acknowledge"}
+	resp := ChangeIdResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, updateLocation, mediaTypeChangeId, mediaTypeAcknowledgement, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
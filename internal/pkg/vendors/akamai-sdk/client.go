@@ -0,0 +1,160 @@
+package akamaisdk
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/google/uuid"
+)
+
+type Client struct {
+	host         string
+	clientToken  string
+	clientSecret string
+	accessToken  string
+
+	client *resty.Client
+}
+
+// 创建使用 EdgeGrid 认证的 Akamai API 客户端。
+//
+// 入参：
+//   - host：API 主机名，如 "akab-xxxx.luna.akamaiapis.net"，即 .edgerc 文件中的 host。
+//   - clientToken：API 客户端令牌，即 .edgerc 文件中的 client_token。
+//   - clientSecret：API 客户端密钥，即 .edgerc 文件中的 client_secret。
+//   - accessToken：API 访问令牌，即 .edgerc 文件中的 access_token。
+//
+// 出参：
+//   - 客户端。
+func NewClient(host, clientToken, clientSecret, accessToken string) *Client {
+	host = strings.TrimPrefix(strings.TrimPrefix(host, "https://"), "http://")
+	host = strings.TrimRight(host, "/")
+
+	client := resty.New().
+		SetBaseURL("https://" + host)
+
+	return &Client{
+		host:         host,
+		clientToken:  clientToken,
+		clientSecret: clientSecret,
+		accessToken:  accessToken,
+		client:       client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, accept string, contentType string, body interface{}) (*resty.Response, error) {
+	var bodyBytes []byte
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("akamai api error: failed to marshal request body: %w", err)
+		}
+		bodyBytes = data
+	}
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	req = req.
+		SetHeader("Accept", accept).
+		SetHeader("Authorization", c.signRequest(method, path, bodyBytes, time.Now()))
+	if bodyBytes != nil {
+		req = req.
+			SetHeader("Content-Type", contentType).
+			SetBody(bodyBytes)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("akamai api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: strings.TrimSpace(string(resp.Body()))}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, accept string, contentType string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, accept, contentType, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("akamai api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+// 生成 EdgeGrid 认证请求头。
+// REF: https://techdocs.akamai.com/developer/docs/authenticate-with-edgegrid
+func (c *Client) signRequest(method string, path string, body []byte, now time.Time) string {
+	timestamp := now.UTC().Format("20060102T15:04:05+0000")
+	authHeader := fmt.Sprintf("EG1-HMAC-SHA256 client_token=%s;access_token=%s;timestamp=%s;nonce=%s;", c.clientToken, c.accessToken, timestamp, uuid.NewString())
+
+	relativeUrl := path
+	if u, err := url.Parse(path); err == nil {
+		relativeUrl = u.EscapedPath()
+		if u.RawQuery != "" {
+			relativeUrl += "?" + u.RawQuery
+		}
+	}
+
+	// 仅 POST 请求需要计算请求体摘要，且最多计算前 128KB
+	contentHash := ""
+	if method == http.MethodPost && len(body) > 0 {
+		const maxBody = 131072
+		if len(body) > maxBody {
+			body = body[:maxBody]
+		}
+		sum := sha256.Sum256(body)
+		contentHash = base64.StdEncoding.EncodeToString(sum[:])
+	}
+
+	dataToSign := strings.Join([]string{
+		method,
+		"https",
+		c.host,
+		relativeUrl,
+		"", // 无需参与签名的请求头
+		contentHash,
+		authHeader,
+	}, "\t")
+
+	signingKey := computeHmacSha256Base64([]byte(c.clientSecret), timestamp)
+	signature := computeHmacSha256Base64([]byte(signingKey), dataToSign)
+	return authHeader + "signature=" + signature
+}
+
+func computeHmacSha256Base64(key []byte, data string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("akamai api error: unexpected status code: %d, %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,68 @@
+package akamaisdk
+
+type Enrollment struct {
+	Id               int64                      `json:"id"`
+	CertificateType  string                     `json:"certificateType"`
+	ValidationType   string                     `json:"validationType"`
+	ChangeManagement bool                       `json:"changeManagement"`
+	PendingChanges   []*EnrollmentPendingChange `json:"pendingChanges"`
+	CSR              *struct {
+		CN   string   `json:"cn"`
+		SANs []string `json:"sans"`
+	} `json:"csr"`
+}
+
+type EnrollmentPendingChange struct {
+	Location   string `json:"location"`
+	ChangeType string `json:"changeType"`
+}
+
+type ChangeStatus struct {
+	StatusInfo *struct {
+		Status      string `json:"status"`
+		State       string `json:"state"`
+		Description string `json:"description"`
+		Error       *struct {
+			Code        string `json:"code"`
+			Description string `json:"description"`
+			Timestamp   string `json:"timestamp"`
+		} `json:"error"`
+	} `json:"statusInfo"`
+	AllowedInput []*ChangeAllowedInput `json:"allowedInput"`
+}
+
+type ChangeAllowedInput struct {
+	Type              string `json:"type"`
+	RequiredToProceed bool   `json:"requiredToProceed"`
+	Info              string `json:"info"`
+	Update            string `json:"update"`
+}
+
+type ThirdPartyCSR struct {
+	CSRs []*struct {
+		CSR          string `json:"csr"`
+		KeyAlgorithm string `json:"keyAlgorithm"`
+	} `json:"csrs"`
+}
+
+type PostVerificationWarnings struct {
+	Warnings string `json:"warnings"`
+}
+
+type UploadThirdPartyCertAndTrustChainRequest struct {
+	CertificatesAndTrustChains []*CertificateAndTrustChain `json:"certificatesAndTrustChains"`
+}
+
+type CertificateAndTrustChain struct {
+	Certificate  string `json:"certificate"`
+	KeyAlgorithm string `json:"keyAlgorithm"`
+	TrustChain   string `json:"trustChain,omitempty"`
+}
+
+type AcknowledgementRequest struct {
+	Acknowledgement string `json:"acknowledgement"`
+}
+
+type ChangeIdResponse struct {
+	Change string `json:"change"`
+}
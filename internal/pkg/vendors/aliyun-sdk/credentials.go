@@ -0,0 +1,93 @@
+﻿package aliyunsdk
+
+import (
+	"errors"
+
+	"github.com/aliyun/credentials-go/credentials/providers"
+)
+
+type Credentials struct {
+	AccessKeyId     string
+	AccessKeySecret string
+	SecurityToken   string
+}
+
+// 从运行环境中获取访问凭证。
+// 未指定 ECS 实例 RAM 角色名称时，将依次尝试默认凭证链中的环境变量、RRSA（OIDC）、配置文件及 ECS 实例 RAM 角色等方式。
+//
+// 入参：
+//   - ecsRamRoleName: 实例 RAM 角色名称。为空时将使用默认凭证链。
+//
+// 出参：
+//   - credentials: 访问凭证。
+//   - err: 错误。
+func GetEnvironmentCredentials(ecsRamRoleName string) (*Credentials, error) {
+	var provider providers.CredentialsProvider
+	if ecsRamRoleName != "" {
+		p, err := providers.NewECSRAMRoleCredentialsProviderBuilder().
+			WithRoleName(ecsRamRoleName).
+			Build()
+		if err != nil {
+			return nil, err
+		}
+
+		provider = p
+	} else {
+		provider = providers.NewDefaultCredentialsProvider()
+	}
+
+	credentials, err := provider.GetCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{
+		AccessKeyId:     credentials.AccessKeyId,
+		AccessKeySecret: credentials.AccessKeySecret,
+		SecurityToken:   credentials.SecurityToken,
+	}, nil
+}
+
+// 扮演 RAM 角色，获取 STS 临时访问凭证。
+//
+// 入参：
+//   - accessKeyId: 阿里云 AccessKeyId。
+//   - accessKeySecret: 阿里云 AccessKeySecret。
+//   - securityToken: 阿里云 SecurityToken。前置凭证为 STS 临时访问凭证时必填。
+//   - roleArn: 待扮演的 RAM 角色 ARN。
+//   - roleSessionName: 角色会话名称。为空时将自动生成。
+//
+// 出参：
+//   - credentials: 临时访问凭证。
+//   - err: 错误。
+func AssumeRole(accessKeyId, accessKeySecret, securityToken, roleArn, roleSessionName string) (*Credentials, error) {
+	if roleArn == "" {
+		return nil, errors.New("aliyun: role arn is required")
+	}
+
+	if roleSessionName == "" {
+		roleSessionName = "certimate"
+	}
+
+	provider, err := providers.NewRAMRoleARNCredentialsProviderBuilder().
+		WithAccessKeyId(accessKeyId).
+		WithAccessKeySecret(accessKeySecret).
+		WithSecurityToken(securityToken).
+		WithRoleArn(roleArn).
+		WithRoleSessionName(roleSessionName).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	credentials, err := provider.GetCredentials()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Credentials{
+		AccessKeyId:     credentials.AccessKeyId,
+		AccessKeySecret: credentials.AccessKeySecret,
+		SecurityToken:   credentials.SecurityToken,
+	}, nil
+}
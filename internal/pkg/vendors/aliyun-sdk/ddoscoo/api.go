@@ -0,0 +1,44 @@
+package ddoscoo
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+func (c *Client) DescribeWebRules(req *DescribeWebRulesRequest) (*DescribeWebRulesResponse, error) {
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := DescribeWebRulesResponse{}
+	err = c.sendRequestWithResult("DescribeWebRules", params, &result)
+	return &result, err
+}
+
+func (c *Client) AssociateWebCert(req *AssociateWebCertRequest) (*AssociateWebCertResponse, error) {
+	params, err := toParams(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result := AssociateWebCertResponse{}
+	err = c.sendRequestWithResult("AssociateWebCert", params, &result)
+	return &result, err
+}
+
+func toParams(req any) (map[string]any, error) {
+	data, err := json.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+
+	params := make(map[string]any)
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&params); err != nil {
+		return nil, err
+	}
+
+	return params, nil
+}
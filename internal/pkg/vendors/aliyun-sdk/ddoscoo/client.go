@@ -0,0 +1,83 @@
+package ddoscoo
+
+import (
+	"encoding/json"
+	"fmt"
+
+	aliyunOpen "github.com/alibabacloud-go/darabonba-openapi/v2/client"
+	"github.com/alibabacloud-go/tea/dara"
+	"github.com/alibabacloud-go/tea/tea"
+)
+
+const apiVersion = "2020-01-01"
+
+type Client struct {
+	client *aliyunOpen.Client
+}
+
+// 创建阿里云 DDoS 高防（新 BGP & 国际）客户端。
+// 官方 SDK 尚未引入，此处基于通用 OpenAPI 客户端以 RPC 风格调用。
+//
+// 入参：
+//   - config: 阿里云 OpenAPI 客户端配置。
+//
+// 出参：
+//   - client: 客户端实例。
+//   - err: 错误。
+func NewClient(config *aliyunOpen.Config) (*Client, error) {
+	client, err := aliyunOpen.NewClient(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{client: client}, nil
+}
+
+func (c *Client) sendRequest(action string, params map[string]any) (map[string]any, error) {
+	query := make(map[string]*string)
+	for k, v := range params {
+		if v == nil {
+			continue
+		}
+
+		query[k] = tea.String(fmt.Sprintf("%v", v))
+	}
+
+	req := &aliyunOpen.OpenApiRequest{
+		Query: query,
+	}
+	resp, err := c.client.CallApi(&aliyunOpen.Params{
+		Action:      tea.String(action),
+		Version:     tea.String(apiVersion),
+		Protocol:    tea.String("HTTPS"),
+		Pathname:    tea.String("/"),
+		Method:      tea.String("POST"),
+		AuthType:    tea.String("AK"),
+		Style:       tea.String("RPC"),
+		ReqBodyType: tea.String("formData"),
+		BodyType:    tea.String("json"),
+	}, req, &dara.RuntimeOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(action string, params map[string]any, result any) error {
+	resp, err := c.sendRequest(action, params)
+	if err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(resp["body"])
+	if err != nil {
+		return fmt.Errorf("aliyun ddoscoo api error: failed to marshal response: %w", err)
+	}
+
+	if err := json.Unmarshal(body, result); err != nil {
+		return fmt.Errorf("aliyun ddoscoo api error: failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
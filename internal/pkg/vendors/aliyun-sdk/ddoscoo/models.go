@@ -0,0 +1,43 @@
+package ddoscoo
+
+type BaseResponse struct {
+	RequestId string `json:"RequestId"`
+}
+
+type DescribeWebRulesRequest struct {
+	Domain          *string `json:"Domain,omitempty"`
+	ResourceGroupId *string `json:"ResourceGroupId,omitempty"`
+	PageNumber      *int32  `json:"PageNumber,omitempty"`
+	PageSize        *int32  `json:"PageSize,omitempty"`
+}
+
+type DescribeWebRulesResponse struct {
+	BaseResponse
+	TotalCount int64      `json:"TotalCount"`
+	WebRules   []*WebRule `json:"WebRules"`
+}
+
+type WebRule struct {
+	Domain           string `json:"Domain"`
+	Cname            string `json:"Cname"`
+	CertName         string `json:"CertName"`
+	CertRegion       string `json:"CertRegion"`
+	Http2Enable      bool   `json:"Http2Enable"`
+	HttpToUserIp     bool   `json:"HttpToUserIp"`
+	Https2HttpEnable bool   `json:"Https2HttpEnable"`
+	ResourceGroupId  string `json:"ResourceGroupId"`
+}
+
+type AssociateWebCertRequest struct {
+	Domain          *string `json:"Domain,omitempty"`
+	CertId          *int64  `json:"CertId,omitempty"`
+	CertRegion      *string `json:"CertRegion,omitempty"`
+	CertName        *string `json:"CertName,omitempty"`
+	Cert            *string `json:"Cert,omitempty"`
+	Key             *string `json:"Key,omitempty"`
+	ResourceGroupId *string `json:"ResourceGroupId,omitempty"`
+}
+
+type AssociateWebCertResponse struct {
+	BaseResponse
+}
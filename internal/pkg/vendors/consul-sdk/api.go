@@ -0,0 +1,52 @@
+package consulsdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// 读取键值对。键不存在时返回 nil。
+func (c *Client) KVGet(datacenter string, key string) (*KVPair, error) {
+	resp := make([]*KVPair, 0)
+	err := c.sendRequestWithResult(http.MethodGet, "/kv/"+trimPath(key), map[string]string{"dc": datacenter}, nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if len(resp) == 0 {
+		return nil, nil
+	}
+	return resp[0], nil
+}
+
+// 在单个事务中执行多个操作，任一操作失败时整个事务回滚。
+func (c *Client) Txn(datacenter string, ops []*TxnOp) (*TxnResponse, error) {
+	resp := TxnResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/txn", map[string]string{"dc": datacenter}, ops, &resp)
+	if err != nil {
+		// 事务回滚时 Consul 返回 409 状态码，响应体中包含各操作的错误信息
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusConflict {
+			return nil, errors.New("consul api error: transaction rolled back, " + errResp.Message)
+		}
+		return nil, err
+	}
+
+	if len(resp.Errors) > 0 {
+		messages := make([]string, 0, len(resp.Errors))
+		for _, e := range resp.Errors {
+			messages = append(messages, fmt.Sprintf("op#%d: %s", e.OpIndex, e.What))
+		}
+		return &resp, errors.New("consul api error: transaction failed, " + strings.Join(messages, "; "))
+	}
+
+	return &resp, nil
+}
+
+func trimPath(path string) string {
+	return strings.Trim(path, "/")
+}
@@ -0,0 +1,34 @@
+package consulsdk
+
+type KVPair struct {
+	Key         string `json:"Key"`
+	Value       []byte `json:"Value,omitempty"`
+	Flags       uint64 `json:"Flags,omitempty"`
+	CreateIndex uint64 `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64 `json:"ModifyIndex,omitempty"`
+}
+
+type TxnOp struct {
+	KV *TxnKVOp `json:"KV,omitempty"`
+}
+
+type TxnKVOp struct {
+	Verb  string `json:"Verb"`
+	Key   string `json:"Key"`
+	Value []byte `json:"Value,omitempty"`
+	Index uint64 `json:"Index,omitempty"`
+}
+
+type TxnResponse struct {
+	Results []*TxnResult `json:"Results"`
+	Errors  []*TxnError  `json:"Errors"`
+}
+
+type TxnResult struct {
+	KV *KVPair `json:"KV,omitempty"`
+}
+
+type TxnError struct {
+	OpIndex int    `json:"OpIndex"`
+	What    string `json:"What"`
+}
@@ -0,0 +1,74 @@
+package cpanelsdk
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+func (c *Client) DomainInfoListDomains() (*DomainInfoListDomainsData, error) {
+	resp := UapiResponse{}
+	err := c.sendRequestWithResult("DomainInfo", "list_domains", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+
+	data := DomainInfoListDomainsData{}
+	if err := json.Unmarshal(resp.Data, &data); err != nil {
+		return nil, fmt.Errorf("cpanel api error: failed to parse response data: %w", err)
+	}
+	return &data, nil
+}
+
+func (c *Client) SSLInstallSSL(req *SSLInstallRequest) (*UapiResponse, error) {
+	params := map[string]string{
+		"domain":   req.Domain,
+		"cert":     req.Cert,
+		"key":      req.Key,
+		"cabundle": req.CABundle,
+	}
+
+	resp := UapiResponse{}
+	err := c.sendRequestWithResult("SSL", "install_ssl", params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *WhmClient) Version() (*WhmResponse, error) {
+	resp := WhmResponse{}
+	err := c.sendRequestWithResult("version", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *WhmClient) InstallServiceSSLCertificate(req *ServiceSSLInstallRequest) (*WhmResponse, error) {
+	params := map[string]string{
+		"service":  req.Service,
+		"crt":      req.Crt,
+		"key":      req.Key,
+		"cabundle": req.CABundle,
+	}
+
+	resp := WhmResponse{}
+	err := c.sendRequestWithResult("install_service_ssl_certificate", params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *WhmClient) RestartService(service string) (*WhmResponse, error) {
+	params := map[string]string{
+		"service": service,
+	}
+
+	resp := WhmResponse{}
+	err := c.sendRequestWithResult("restartservice", params, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,137 @@
+package cpanelsdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 cPanel UAPI 客户端。
+//
+// 入参：
+//   - serverUrl：cPanel 地址，如 "https://example.com:2083"。
+//   - username：cPanel 用户名。
+//   - apiToken：cPanel API 令牌。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, username, apiToken string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/execute").
+		SetHeader("Authorization", fmt.Sprintf("cpanel %s:%s", username, apiToken))
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(module string, function string, params map[string]string) (*resty.Response, error) {
+	req := c.client.R().SetFormData(params)
+	resp, err := req.Post(fmt.Sprintf("/%s/%s", module, function))
+	if err != nil {
+		return nil, fmt.Errorf("cpanel api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("cpanel api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(module string, function string, params map[string]string, result *UapiResponse) error {
+	resp, err := c.sendRequest(module, function, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("cpanel api error: failed to parse response: %w", err)
+	} else if result.Status != 1 {
+		return fmt.Errorf("cpanel api error: %s", strings.Join(result.Errors, "; "))
+	}
+
+	return nil
+}
+
+type WhmClient struct {
+	client *resty.Client
+}
+
+// 创建 WHM API 1 客户端。
+//
+// 入参：
+//   - serverUrl：WHM 地址，如 "https://example.com:2087"。
+//   - username：WHM 用户名，通常为 "root"。
+//   - apiToken：WHM API 令牌。
+//
+// 出参：
+//   - 客户端。
+func NewWhmClient(serverUrl, username, apiToken string) *WhmClient {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/json-api").
+		SetHeader("Authorization", fmt.Sprintf("whm %s:%s", username, apiToken))
+
+	return &WhmClient{
+		client: client,
+	}
+}
+
+func (c *WhmClient) WithTimeout(timeout time.Duration) *WhmClient {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *WhmClient) WithTLSConfig(config *tls.Config) *WhmClient {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *WhmClient) sendRequest(function string, params map[string]string) (*resty.Response, error) {
+	req := c.client.R().
+		SetQueryParam("api.version", "1").
+		SetFormData(params)
+	resp, err := req.Post("/" + function)
+	if err != nil {
+		return nil, fmt.Errorf("whm api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("whm api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *WhmClient) sendRequestWithResult(function string, params map[string]string, result *WhmResponse) error {
+	resp, err := c.sendRequest(function, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("whm api error: failed to parse response: %w", err)
+	} else if result.Metadata == nil || result.Metadata.Result != 1 {
+		reason := ""
+		if result.Metadata != nil {
+			reason = result.Metadata.Reason
+		}
+		return fmt.Errorf("whm api error: %s", reason)
+	}
+
+	return nil
+}
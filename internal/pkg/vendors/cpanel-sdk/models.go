@@ -0,0 +1,47 @@
+package cpanelsdk
+
+import (
+	"encoding/json"
+)
+
+type UapiResponse struct {
+	ApiVersion int32           `json:"apiversion"`
+	Func       string          `json:"func"`
+	Module     string          `json:"module"`
+	Status     int32           `json:"status"`
+	Errors     []string        `json:"errors"`
+	Warnings   []string        `json:"warnings"`
+	Messages   []string        `json:"messages"`
+	Data       json.RawMessage `json:"data"`
+}
+
+type DomainInfoListDomainsData struct {
+	MainDomain    string   `json:"main_domain"`
+	AddonDomains  []string `json:"addon_domains"`
+	SubDomains    []string `json:"sub_domains"`
+	ParkedDomains []string `json:"parked_domains"`
+}
+
+type SSLInstallRequest struct {
+	Domain   string
+	Cert     string
+	Key      string
+	CABundle string
+}
+
+type WhmResponse struct {
+	Metadata *struct {
+		Command string `json:"command"`
+		Reason  string `json:"reason"`
+		Result  int32  `json:"result"`
+		Version int32  `json:"version"`
+	} `json:"metadata"`
+	Data json.RawMessage `json:"data,omitempty"`
+}
+
+type ServiceSSLInstallRequest struct {
+	Service  string
+	Crt      string
+	Key      string
+	CABundle string
+}
@@ -0,0 +1,57 @@
+package dockersdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) SecretList(req *SecretListRequest) (SecretListResponse, error) {
+	queryParams := make(map[string]string)
+	if filters := req.encodeFilters(); filters != "" {
+		queryParams["filters"] = filters
+	}
+
+	resp := SecretListResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/secrets", queryParams, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) SecretCreate(req *SecretCreateRequest) (*SecretCreateResponse, error) {
+	resp := SecretCreateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/secrets/create", nil, req.SecretSpec, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SecretDelete(secretId string) error {
+	_, err := c.sendRequest(http.MethodDelete, fmt.Sprintf("/secrets/%s", url.PathEscape(secretId)), nil, nil)
+	return err
+}
+
+func (c *Client) ServiceInspect(serviceId string) (*ServiceInspectResponse, error) {
+	resp := ServiceInspectResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/services/%s", url.PathEscape(serviceId)), nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ServiceUpdate(serviceId string, req *ServiceUpdateRequest) (*ServiceUpdateResponse, error) {
+	queryParams := map[string]string{
+		"version": fmt.Sprintf("%d", req.Version),
+	}
+
+	resp := ServiceUpdateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/services/%s/update", url.PathEscape(serviceId)), queryParams, req.Spec, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
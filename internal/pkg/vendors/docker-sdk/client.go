@@ -0,0 +1,118 @@
+package dockersdk
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 Docker Engine API 客户端。
+//
+// 入参：
+//   - dockerHost：Docker 守护进程地址，如 "unix:///var/run/docker.sock"、"tcp://127.0.0.1:2376"。
+//
+// 出参：
+//   - 客户端。
+//   - 错误。
+func NewClient(dockerHost string) (*Client, error) {
+	hostUrl, err := url.Parse(dockerHost)
+	if err != nil {
+		return nil, fmt.Errorf("docker api error: invalid docker host: %w", err)
+	}
+
+	client := resty.New()
+
+	switch hostUrl.Scheme {
+	case "unix":
+		socketPath := hostUrl.Path
+		client.SetTransport(&http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		})
+		client.SetBaseURL("http://docker")
+
+	case "tcp", "http", "https":
+		if hostUrl.Host == "" {
+			return nil, fmt.Errorf("docker api error: invalid docker host: %s", dockerHost)
+		}
+		client.SetBaseURL("http://" + hostUrl.Host)
+
+	default:
+		return nil, fmt.Errorf("docker api error: unsupported docker host scheme: %s", hostUrl.Scheme)
+	}
+
+	return &Client{
+		client: client,
+	}, nil
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	if config != nil {
+		c.client.SetBaseURL(strings.Replace(c.client.BaseURL, "http://", "https://", 1))
+	}
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, queryParams map[string]string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if queryParams != nil {
+		req = req.SetQueryParams(queryParams)
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("docker api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("docker api error: unexpected status code: %d, %s", resp.StatusCode(), errResp.Message)
+		}
+
+		return resp, fmt.Errorf("docker api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, queryParams map[string]string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, queryParams, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("docker api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
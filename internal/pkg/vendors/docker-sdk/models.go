@@ -0,0 +1,80 @@
+package dockersdk
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ErrorResponse struct {
+	Message string `json:"message"`
+}
+
+type ObjectVersion struct {
+	Index uint64 `json:"Index"`
+}
+
+type SecretSpec struct {
+	Name   string            `json:"Name"`
+	Labels map[string]string `json:"Labels,omitempty"`
+	Data   string            `json:"Data,omitempty"`
+}
+
+type Secret struct {
+	ID        string        `json:"ID"`
+	Version   ObjectVersion `json:"Version"`
+	CreatedAt time.Time     `json:"CreatedAt"`
+	UpdatedAt time.Time     `json:"UpdatedAt"`
+	Spec      SecretSpec    `json:"Spec"`
+}
+
+type ServiceUpdateStatus struct {
+	State       string     `json:"State,omitempty"`
+	StartedAt   *time.Time `json:"StartedAt,omitempty"`
+	CompletedAt *time.Time `json:"CompletedAt,omitempty"`
+	Message     string     `json:"Message,omitempty"`
+}
+
+type Service struct {
+	ID      string        `json:"ID"`
+	Version ObjectVersion `json:"Version"`
+	// 服务规格。
+	// 为避免更新时丢失未声明的字段，此处保留原始的 JSON 结构。
+	Spec         map[string]any       `json:"Spec"`
+	UpdateStatus *ServiceUpdateStatus `json:"UpdateStatus,omitempty"`
+}
+
+type SecretListRequest struct {
+	Filters map[string][]string
+}
+
+type SecretListResponse []*Secret
+
+type SecretCreateRequest struct {
+	SecretSpec
+}
+
+type SecretCreateResponse struct {
+	ID string `json:"ID"`
+}
+
+type ServiceInspectResponse struct {
+	Service
+}
+
+type ServiceUpdateRequest struct {
+	Version uint64
+	Spec    map[string]any
+}
+
+type ServiceUpdateResponse struct {
+	Warnings []string `json:"Warnings,omitempty"`
+}
+
+func (r *SecretListRequest) encodeFilters() string {
+	if len(r.Filters) == 0 {
+		return ""
+	}
+
+	jsonb, _ := json.Marshal(r.Filters)
+	return string(jsonb)
+}
@@ -0,0 +1,112 @@
+package f5bigipsdk
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// 登录并获取令牌，后续请求将自动携带该令牌。
+func (c *Client) AuthnLogin() (*AuthnLoginResponse, error) {
+	req := &AuthnLoginRequest{
+		Username:          c.username,
+		Password:          c.password,
+		LoginProviderName: "tmos",
+	}
+
+	resp := AuthnLoginResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/mgmt/shared/authn/login", req, &resp)
+	if err != nil {
+		return nil, err
+	} else if resp.Token == nil || resp.Token.Token == "" {
+		return nil, errors.New("f5 bigip api error: no token returned")
+	}
+
+	c.client.SetHeader("X-F5-Auth-Token", resp.Token.Token)
+	return &resp, nil
+}
+
+// 上传文件。文件将被保存到 "/var/config/rest/downloads/" 目录下。
+func (c *Client) FileTransferUpload(fileName string, data []byte) error {
+	req := c.client.R().
+		SetHeader("Content-Type", "application/octet-stream").
+		SetHeader("Content-Range", fmt.Sprintf("0-%d/%d", len(data)-1, len(data))).
+		SetBody(data)
+	req.Method = http.MethodPost
+	req.URL = fmt.Sprintf("/mgmt/shared/file-transfer/uploads/%s", url.PathEscape(fileName))
+
+	_, err := c.send(req)
+	return err
+}
+
+func (c *Client) CryptoKeyInstall(req *CryptoInstallRequest) error {
+	return c.sendRequestWithResult(http.MethodPost, "/mgmt/tm/sys/crypto/key", req, nil)
+}
+
+func (c *Client) CryptoCertInstall(req *CryptoInstallRequest) error {
+	return c.sendRequestWithResult(http.MethodPost, "/mgmt/tm/sys/crypto/cert", req, nil)
+}
+
+func (c *Client) ClientSSLProfileList() (*ClientSSLProfileListResponse, error) {
+	resp := ClientSSLProfileListResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/mgmt/tm/ltm/profile/client-ssl", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ClientSSLProfileCreate(req *ClientSSLProfileCreateRequest) error {
+	return c.sendRequestWithResult(http.MethodPost, "/mgmt/tm/ltm/profile/client-ssl", req, nil)
+}
+
+func (c *Client) ClientSSLProfileModify(profileFullPath string, req *ClientSSLProfileModifyRequest) error {
+	return c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/mgmt/tm/ltm/profile/client-ssl/%s", EncodeFullPath(profileFullPath)), req, nil)
+}
+
+func (c *Client) VirtualServerProfileList(virtualServerFullPath string) (*VirtualServerProfileListResponse, error) {
+	resp := VirtualServerProfileListResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/mgmt/tm/ltm/virtual/%s/profiles", EncodeFullPath(virtualServerFullPath)), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) VirtualServerProfileAdd(virtualServerFullPath string, req *VirtualServerProfileAddRequest) error {
+	return c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/mgmt/tm/ltm/virtual/%s/profiles", EncodeFullPath(virtualServerFullPath)), req, nil)
+}
+
+func (c *Client) VirtualServerProfileDelete(virtualServerFullPath string, profileFullPath string) error {
+	return c.sendRequestWithResult(http.MethodDelete, fmt.Sprintf("/mgmt/tm/ltm/virtual/%s/profiles/%s", EncodeFullPath(virtualServerFullPath), EncodeFullPath(profileFullPath)), nil, nil)
+}
+
+func (c *Client) TransactionCreate() (*TransactionCreateResponse, error) {
+	resp := TransactionCreateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/mgmt/tm/transaction", map[string]any{}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TransactionCommit(transId int64) (*TransactionCommitResponse, error) {
+	req := &TransactionCommitRequest{State: "VALIDATING"}
+	resp := TransactionCommitResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/mgmt/tm/transaction/%d", transId), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TransactionDelete(transId int64) error {
+	return c.sendRequestWithResult(http.MethodDelete, fmt.Sprintf("/mgmt/tm/transaction/%d", transId), nil, nil)
+}
+
+// 将对象的完整路径（如 "/Common/clientssl"）编码为 iControl REST 的资源标识（如 "~Common~clientssl"）。
+func EncodeFullPath(fullPath string) string {
+	return strings.ReplaceAll(fullPath, "/", "~")
+}
@@ -0,0 +1,107 @@
+package f5bigipsdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client         *resty.Client
+	username       string
+	password       string
+	coordinationId string
+}
+
+// 创建 F5 BIG-IP iControl REST API 客户端。
+//
+// 入参：
+//   - serverUrl：BIG-IP 管理地址，如 "https://192.168.1.245"。
+//   - username：管理员用户名。
+//   - password：管理员密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, username, password string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/"))
+
+	return &Client{
+		client:   client,
+		username: username,
+		password: password,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 返回一个绑定到指定事务的客户端，通过它发送的请求都将加入该事务，直到事务被提交。
+func (c *Client) WithTransaction(transId int64) *Client {
+	return &Client{
+		client:         c.client,
+		username:       c.username,
+		password:       c.password,
+		coordinationId: fmt.Sprintf("%d", transId),
+	}
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if c.coordinationId != "" {
+		req = req.SetHeader("X-F5-REST-Coordination-Id", c.coordinationId)
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	return c.send(req)
+}
+
+func (c *Client) send(req *resty.Request) (*resty.Response, error) {
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("f5 bigip api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("f5 bigip api error: unexpected status code: %d, %s", resp.StatusCode(), errResp.Message)
+		}
+
+		return resp, fmt.Errorf("f5 bigip api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("f5 bigip api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package f5bigipsdk
+
+type ErrorResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+type AuthnLoginRequest struct {
+	Username          string `json:"username"`
+	Password          string `json:"password"`
+	LoginProviderName string `json:"loginProviderName"`
+}
+
+type AuthnLoginResponse struct {
+	Username string `json:"username"`
+	Token    *struct {
+		Token      string `json:"token"`
+		Timeout    int32  `json:"timeout"`
+		Expiration int64  `json:"expirationMicros"`
+	} `json:"token"`
+}
+
+type CryptoInstallRequest struct {
+	Command       string `json:"command"`
+	Name          string `json:"name"`
+	FromLocalFile string `json:"from-local-file"`
+}
+
+type CertKeyChain struct {
+	Name  string `json:"name"`
+	Cert  string `json:"cert"`
+	Key   string `json:"key"`
+	Chain string `json:"chain,omitempty"`
+}
+
+type ClientSSLProfile struct {
+	Name          string          `json:"name"`
+	Partition     string          `json:"partition,omitempty"`
+	FullPath      string          `json:"fullPath,omitempty"`
+	DefaultsFrom  string          `json:"defaultsFrom,omitempty"`
+	SniDefault    string          `json:"sniDefault,omitempty"`
+	ServerName    string          `json:"serverName,omitempty"`
+	CertKeyChains []*CertKeyChain `json:"certKeyChain,omitempty"`
+}
+
+type ClientSSLProfileListResponse struct {
+	Items []*ClientSSLProfile `json:"items"`
+}
+
+type ClientSSLProfileCreateRequest = ClientSSLProfile
+
+type ClientSSLProfileModifyRequest struct {
+	CertKeyChains []*CertKeyChain `json:"certKeyChain"`
+}
+
+type VirtualServerProfile struct {
+	Name      string `json:"name"`
+	Partition string `json:"partition,omitempty"`
+	FullPath  string `json:"fullPath,omitempty"`
+	Context   string `json:"context,omitempty"`
+}
+
+type VirtualServerProfileListResponse struct {
+	Items []*VirtualServerProfile `json:"items"`
+}
+
+type VirtualServerProfileAddRequest struct {
+	Name    string `json:"name"`
+	Context string `json:"context"`
+}
+
+type TransactionCreateResponse struct {
+	TransId int64  `json:"transId"`
+	State   string `json:"state"`
+}
+
+type TransactionCommitRequest struct {
+	State string `json:"state"`
+}
+
+type TransactionCommitResponse struct {
+	TransId       int64  `json:"transId"`
+	State         string `json:"state"`
+	FailureReason string `json:"failureReason,omitempty"`
+}
@@ -0,0 +1,86 @@
+package fastlysdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) ListPrivateKeys(req *ListPrivateKeysRequest) (*ListPrivateKeysResponse, error) {
+	queryParams := make(map[string]string)
+	if req.PageNumber > 0 {
+		queryParams["page[number]"] = fmt.Sprintf("%d", req.PageNumber)
+	}
+	if req.PageSize > 0 {
+		queryParams["page[size]"] = fmt.Sprintf("%d", req.PageSize)
+	}
+
+	resp := &ListPrivateKeysResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/tls/private_keys", queryParams, nil, resp)
+	return resp, err
+}
+
+func (c *Client) CreatePrivateKey(req *CreatePrivateKeyRequest) (*CreatePrivateKeyResponse, error) {
+	resp := &CreatePrivateKeyResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/tls/private_keys", nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) CreateCertificate(req *CreateCertificateRequest) (*CreateCertificateResponse, error) {
+	resp := &CreateCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/tls/certificates", nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateCertificate(certificateId string, req *UpdateCertificateRequest) (*UpdateCertificateResponse, error) {
+	resp := &UpdateCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/tls/certificates/%s", url.PathEscape(certificateId)), nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) ListActivations(req *ListActivationsRequest) (*ListActivationsResponse, error) {
+	queryParams := make(map[string]string)
+	if req.FilterTlsCertificateId != "" {
+		queryParams["filter[tls_certificate.id]"] = req.FilterTlsCertificateId
+	}
+	if req.FilterTlsConfigurationId != "" {
+		queryParams["filter[tls_configuration.id]"] = req.FilterTlsConfigurationId
+	}
+	if req.FilterTlsDomainId != "" {
+		queryParams["filter[tls_domain.id]"] = req.FilterTlsDomainId
+	}
+	if req.PageNumber > 0 {
+		queryParams["page[number]"] = fmt.Sprintf("%d", req.PageNumber)
+	}
+	if req.PageSize > 0 {
+		queryParams["page[size]"] = fmt.Sprintf("%d", req.PageSize)
+	}
+
+	resp := &ListActivationsResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/tls/activations", queryParams, nil, resp)
+	return resp, err
+}
+
+func (c *Client) CreateActivation(req *CreateActivationRequest) (*CreateActivationResponse, error) {
+	resp := &CreateActivationResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/tls/activations", nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateActivation(activationId string, req *UpdateActivationRequest) (*UpdateActivationResponse, error) {
+	resp := &UpdateActivationResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/tls/activations/%s", url.PathEscape(activationId)), nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) CreateBulkCertificate(req *CreateBulkCertificateRequest) (*CreateBulkCertificateResponse, error) {
+	resp := &CreateBulkCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/tls/bulk/certificates", nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateBulkCertificate(certificateId string, req *UpdateBulkCertificateRequest) (*UpdateBulkCertificateResponse, error) {
+	resp := &UpdateBulkCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/tls/bulk/certificates/%s", url.PathEscape(certificateId)), nil, req, resp)
+	return resp, err
+}
@@ -0,0 +1,69 @@
+package fastlysdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	apiToken string
+
+	client *resty.Client
+}
+
+func NewClient(apiToken string) *Client {
+	client := resty.New()
+
+	return &Client{
+		apiToken: apiToken,
+		client:   client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, queryParams map[string]string, params interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = "https://api.fastly.com" + path
+	req = req.
+		SetHeader("Accept", "application/vnd.api+json").
+		SetHeader("Fastly-Key", c.apiToken)
+	if len(queryParams) > 0 {
+		req = req.SetQueryParams(queryParams)
+	}
+	if method != http.MethodGet && params != nil {
+		req = req.
+			SetHeader("Content-Type", "application/vnd.api+json").
+			SetBody(params)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("fastly api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("fastly api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, queryParams map[string]string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, queryParams, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("fastly api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package fastlysdk
+
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	Id   string `json:"id"`
+}
+
+type RelationshipOne struct {
+	Data *ResourceIdentifier `json:"data"`
+}
+
+type RelationshipMany struct {
+	Data []*ResourceIdentifier `json:"data"`
+}
+
+type PaginationMeta struct {
+	CurrentPage int32 `json:"current_page"`
+	PerPage     int32 `json:"per_page"`
+	RecordCount int32 `json:"record_count"`
+	TotalPages  int32 `json:"total_pages"`
+}
+
+type PrivateKeyAttributes struct {
+	Name          string `json:"name,omitempty"`
+	Key           string `json:"key,omitempty"`
+	KeyLength     int32  `json:"key_length,omitempty"`
+	KeyType       string `json:"key_type,omitempty"`
+	PublicKeySha1 string `json:"public_key_sha1,omitempty"`
+	Replace       bool   `json:"replace,omitempty"`
+	CreatedAt     string `json:"created_at,omitempty"`
+}
+
+type PrivateKeyData struct {
+	Type       string                `json:"type"`
+	Id         string                `json:"id,omitempty"`
+	Attributes *PrivateKeyAttributes `json:"attributes,omitempty"`
+}
+
+type ListPrivateKeysRequest struct {
+	PageNumber int32
+	PageSize   int32
+}
+
+type ListPrivateKeysResponse struct {
+	Data []*PrivateKeyData `json:"data"`
+	Meta *PaginationMeta   `json:"meta,omitempty"`
+}
+
+type CreatePrivateKeyRequest struct {
+	Data *PrivateKeyData `json:"data"`
+}
+
+type CreatePrivateKeyResponse struct {
+	Data *PrivateKeyData `json:"data"`
+}
+
+type CertificateAttributes struct {
+	Name               string `json:"name,omitempty"`
+	CertBlob           string `json:"cert_blob,omitempty"`
+	IssuedTo           string `json:"issued_to,omitempty"`
+	Issuer             string `json:"issuer,omitempty"`
+	SerialNumber       string `json:"serial_number,omitempty"`
+	SignatureAlgorithm string `json:"signature_algorithm,omitempty"`
+	NotBefore          string `json:"not_before,omitempty"`
+	NotAfter           string `json:"not_after,omitempty"`
+	Replace            bool   `json:"replace,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+type CertificateData struct {
+	Type       string                 `json:"type"`
+	Id         string                 `json:"id,omitempty"`
+	Attributes *CertificateAttributes `json:"attributes,omitempty"`
+}
+
+type CreateCertificateRequest struct {
+	Data *CertificateData `json:"data"`
+}
+
+type CreateCertificateResponse struct {
+	Data *CertificateData `json:"data"`
+}
+
+type UpdateCertificateRequest struct {
+	Data *CertificateData `json:"data"`
+}
+
+type UpdateCertificateResponse struct {
+	Data *CertificateData `json:"data"`
+}
+
+type ActivationRelationships struct {
+	TlsCertificate   *RelationshipOne `json:"tls_certificate,omitempty"`
+	TlsConfiguration *RelationshipOne `json:"tls_configuration,omitempty"`
+	TlsDomain        *RelationshipOne `json:"tls_domain,omitempty"`
+}
+
+type ActivationData struct {
+	Type          string                   `json:"type"`
+	Id            string                   `json:"id,omitempty"`
+	Relationships *ActivationRelationships `json:"relationships,omitempty"`
+}
+
+type ListActivationsRequest struct {
+	FilterTlsCertificateId   string
+	FilterTlsConfigurationId string
+	FilterTlsDomainId        string
+	PageNumber               int32
+	PageSize                 int32
+}
+
+type ListActivationsResponse struct {
+	Data []*ActivationData `json:"data"`
+	Meta *PaginationMeta   `json:"meta,omitempty"`
+}
+
+type CreateActivationRequest struct {
+	Data *ActivationData `json:"data"`
+}
+
+type CreateActivationResponse struct {
+	Data *ActivationData `json:"data"`
+}
+
+type UpdateActivationRequest struct {
+	Data *ActivationData `json:"data"`
+}
+
+type UpdateActivationResponse struct {
+	Data *ActivationData `json:"data"`
+}
+
+type BulkCertificateAttributes struct {
+	CertBlob           string `json:"cert_blob,omitempty"`
+	IntermediatesBlob  string `json:"intermediates_blob,omitempty"`
+	AllowUntrustedRoot bool   `json:"allow_untrusted_root,omitempty"`
+	NotBefore          string `json:"not_before,omitempty"`
+	NotAfter           string `json:"not_after,omitempty"`
+	Replace            bool   `json:"replace,omitempty"`
+	CreatedAt          string `json:"created_at,omitempty"`
+	UpdatedAt          string `json:"updated_at,omitempty"`
+}
+
+type BulkCertificateRelationships struct {
+	TlsConfigurations *RelationshipMany `json:"tls_configurations,omitempty"`
+	TlsDomains        *RelationshipMany `json:"tls_domains,omitempty"`
+}
+
+type BulkCertificateData struct {
+	Type          string                        `json:"type"`
+	Id            string                        `json:"id,omitempty"`
+	Attributes    *BulkCertificateAttributes    `json:"attributes,omitempty"`
+	Relationships *BulkCertificateRelationships `json:"relationships,omitempty"`
+}
+
+type CreateBulkCertificateRequest struct {
+	Data *BulkCertificateData `json:"data"`
+}
+
+type CreateBulkCertificateResponse struct {
+	Data *BulkCertificateData `json:"data"`
+}
+
+type UpdateBulkCertificateRequest struct {
+	Data *BulkCertificateData `json:"data"`
+}
+
+type UpdateBulkCertificateResponse struct {
+	Data *BulkCertificateData `json:"data"`
+}
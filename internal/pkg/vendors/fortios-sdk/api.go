@@ -0,0 +1,88 @@
+package fortiossdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) SystemStatus() (*SystemStatusResponse, error) {
+	resp := SystemStatusResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/monitor/system/status", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateLocalImport(req *CertificateLocalImportRequest) (*CertificateLocalImportResponse, error) {
+	resp := CertificateLocalImportResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/monitor/vpn-certificate/local/import", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateLocalDelete(certName string) (*CertificateLocalDeleteResponse, error) {
+	resp := CertificateLocalDeleteResponse{}
+	err := c.sendRequestWithResult(http.MethodDelete, fmt.Sprintf("/cmdb/vpn.certificate/local/%s", url.PathEscape(certName)), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SystemGlobalGet() (*SystemGlobalGetResponse, error) {
+	resp := SystemGlobalGetResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/cmdb/system/global", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SystemGlobalUpdate(req *SystemGlobalUpdateRequest) (*SystemGlobalUpdateResponse, error) {
+	resp := SystemGlobalUpdateResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/cmdb/system/global", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) VpnSslSettingsGet() (*VpnSslSettingsGetResponse, error) {
+	resp := VpnSslSettingsGetResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/cmdb/vpn.ssl/settings", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) VpnSslSettingsUpdate(req *VpnSslSettingsUpdateRequest) (*VpnSslSettingsUpdateResponse, error) {
+	resp := VpnSslSettingsUpdateResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/cmdb/vpn.ssl/settings", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) FirewallVipGet(vipName string) (*FirewallVipGetResponse, error) {
+	resp := FirewallVipGetResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/cmdb/firewall/vip/%s", url.PathEscape(vipName)), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) FirewallVipUpdate(vipName string, req *FirewallVipUpdateRequest) (*FirewallVipUpdateResponse, error) {
+	resp := FirewallVipUpdateResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, fmt.Sprintf("/cmdb/firewall/vip/%s", url.PathEscape(vipName)), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
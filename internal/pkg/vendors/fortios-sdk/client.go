@@ -0,0 +1,93 @@
+package fortiossdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+	vdom   string
+}
+
+// 创建 FortiOS REST API 客户端。
+//
+// 入参：
+//   - serverUrl：FortiGate 管理地址，如 "https://192.168.1.99"。
+//   - apiToken：REST API 管理员的令牌。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiToken string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/api/v2").
+		SetHeader("Authorization", "Bearer "+apiToken)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 指定虚拟域。零值时由 FortiOS 使用管理员的默认虚拟域。
+func (c *Client) WithVdom(vdom string) *Client {
+	c.vdom = vdom
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if c.vdom != "" {
+		req = req.SetQueryParam("vdom", c.vdom)
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("fortios api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &BaseResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Status != "" {
+			return resp, fmt.Errorf("fortios api error: unexpected status code: %d, status: %s, error: %d, cli_error: %s", resp.StatusCode(), errResp.Status, errResp.Error, errResp.CliError)
+		}
+
+		return resp, fmt.Errorf("fortios api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result BaseResponseResultGetter) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("fortios api error: failed to parse response: %w", err)
+	} else if status := result.GetStatus(); status != "" && status != "success" {
+		return fmt.Errorf("fortios api error: status: %s, error: %d, cli_error: %s", status, result.GetError(), result.GetCliError())
+	}
+
+	return nil
+}
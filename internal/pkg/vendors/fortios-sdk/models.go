@@ -0,0 +1,115 @@
+package fortiossdk
+
+import (
+	"encoding/json"
+)
+
+type BaseResponseResultGetter interface {
+	GetStatus() string
+	GetError() int32
+	GetCliError() string
+}
+
+type BaseResponse struct {
+	HttpMethod string `json:"http_method,omitempty"`
+	HttpStatus int32  `json:"http_status,omitempty"`
+	Status     string `json:"status"`
+	Error      int32  `json:"error,omitempty"`
+	CliError   string `json:"cli_error,omitempty"`
+	Vdom       string `json:"vdom,omitempty"`
+	Serial     string `json:"serial,omitempty"`
+	Version    string `json:"version,omitempty"`
+	Build      int32  `json:"build,omitempty"`
+}
+
+func (r *BaseResponse) GetStatus() string {
+	return r.Status
+}
+
+func (r *BaseResponse) GetError() int32 {
+	return r.Error
+}
+
+func (r *BaseResponse) GetCliError() string {
+	return r.CliError
+}
+
+type SystemStatusResponse struct {
+	BaseResponse
+	Results *struct {
+		Hostname  string `json:"hostname"`
+		ModelName string `json:"model_name"`
+		ModelNo   string `json:"model_number"`
+	} `json:"results,omitempty"`
+}
+
+type CertificateLocalImportRequest struct {
+	Type           string `json:"type"`
+	CertName       string `json:"certname"`
+	FileContent    string `json:"file_content"`
+	KeyFileContent string `json:"key_file_content"`
+	Password       string `json:"password,omitempty"`
+	Scope          string `json:"scope,omitempty"`
+}
+
+type CertificateLocalImportResponse struct {
+	BaseResponse
+	Results *struct {
+		Status   string `json:"status"`
+		MkeyName string `json:"mkey"`
+	} `json:"results,omitempty"`
+}
+
+type CertificateLocalDeleteResponse struct {
+	BaseResponse
+}
+
+type SystemGlobal struct {
+	AdminServerCert string `json:"admin-server-cert,omitempty"`
+}
+
+type SystemGlobalGetResponse struct {
+	BaseResponse
+	Results *SystemGlobal `json:"results,omitempty"`
+}
+
+type SystemGlobalUpdateRequest = SystemGlobal
+
+type SystemGlobalUpdateResponse struct {
+	BaseResponse
+}
+
+type VpnSslSettings struct {
+	ServerCert string `json:"servercert,omitempty"`
+}
+
+type VpnSslSettingsGetResponse struct {
+	BaseResponse
+	Results *VpnSslSettings `json:"results,omitempty"`
+}
+
+type VpnSslSettingsUpdateRequest = VpnSslSettings
+
+type VpnSslSettingsUpdateResponse struct {
+	BaseResponse
+}
+
+type FirewallVip struct {
+	Name string `json:"name"`
+	Type string `json:"type,omitempty"`
+	// FortiOS 7.2 起为对象数组（形如 `[{"name": "xxx"}]`），此前版本为字符串。
+	SslCertificate json.RawMessage `json:"ssl-certificate,omitempty"`
+}
+
+type FirewallVipGetResponse struct {
+	BaseResponse
+	Results []*FirewallVip `json:"results,omitempty"`
+}
+
+type FirewallVipUpdateRequest struct {
+	SslCertificate any `json:"ssl-certificate"`
+}
+
+type FirewallVipUpdateResponse struct {
+	BaseResponse
+}
@@ -0,0 +1,98 @@
+package gitlabsdk
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// 获取项目 CI/CD 变量。变量不存在时返回 nil。
+func (c *Client) GetProjectVariable(projectId string, key string, environmentScope string) (*Variable, error) {
+	return c.getVariable("/projects/"+escapeId(projectId), key, environmentScope)
+}
+
+// 创建项目 CI/CD 变量。
+func (c *Client) CreateProjectVariable(projectId string, variable *Variable) (*Variable, error) {
+	return c.createVariable("/projects/"+escapeId(projectId), variable)
+}
+
+// 更新项目 CI/CD 变量。
+func (c *Client) UpdateProjectVariable(projectId string, variable *Variable) (*Variable, error) {
+	return c.updateVariable("/projects/"+escapeId(projectId), variable)
+}
+
+// 获取群组 CI/CD 变量。变量不存在时返回 nil。
+func (c *Client) GetGroupVariable(groupId string, key string, environmentScope string) (*Variable, error) {
+	return c.getVariable("/groups/"+escapeId(groupId), key, environmentScope)
+}
+
+// 创建群组 CI/CD 变量。
+func (c *Client) CreateGroupVariable(groupId string, variable *Variable) (*Variable, error) {
+	return c.createVariable("/groups/"+escapeId(groupId), variable)
+}
+
+// 更新群组 CI/CD 变量。
+func (c *Client) UpdateGroupVariable(groupId string, variable *Variable) (*Variable, error) {
+	return c.updateVariable("/groups/"+escapeId(groupId), variable)
+}
+
+// 获取仓库文件的元信息。文件不存在时返回 nil。
+func (c *Client) GetRepositoryFile(projectId string, filePath string, ref string) (*RepositoryFile, error) {
+	resp := RepositoryFile{}
+	path := "/projects/" + escapeId(projectId) + "/repository/files/" + url.PathEscape(strings.TrimLeft(filePath, "/"))
+	err := c.sendRequestWithResult(http.MethodGet, path, map[string]string{"ref": ref}, nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 创建提交，可在同一提交中创建或更新多个文件。
+func (c *Client) CreateCommit(projectId string, req *CreateCommitRequest) (*Commit, error) {
+	resp := Commit{}
+	err := c.sendRequestWithResult(http.MethodPost, "/projects/"+escapeId(projectId)+"/repository/commits", nil, req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) getVariable(basePath string, key string, environmentScope string) (*Variable, error) {
+	resp := Variable{}
+	params := map[string]string{"filter[environment_scope]": environmentScope}
+	err := c.sendRequestWithResult(http.MethodGet, basePath+"/variables/"+url.PathEscape(key), params, nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) createVariable(basePath string, variable *Variable) (*Variable, error) {
+	resp := Variable{}
+	err := c.sendRequestWithResult(http.MethodPost, basePath+"/variables", nil, variable, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) updateVariable(basePath string, variable *Variable) (*Variable, error) {
+	resp := Variable{}
+	params := map[string]string{"filter[environment_scope]": variable.EnvironmentScope}
+	err := c.sendRequestWithResult(http.MethodPut, basePath+"/variables/"+url.PathEscape(variable.Key), params, variable, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 项目或群组 ID 可以是数字 ID，也可以是形如 "group/project" 的完整路径，后者需进行 URL 编码。
+func escapeId(id string) string {
+	return url.PathEscape(strings.Trim(id, "/"))
+}
@@ -0,0 +1,98 @@
+package gitlabsdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 GitLab REST API 客户端。
+//
+// 入参：
+//   - serverUrl：GitLab 服务地址，如 "https://gitlab.com"。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/") + "/api/v4")
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 设置访问令牌，可以是个人、项目或群组访问令牌。
+func (c *Client) WithToken(token string) *Client {
+	c.client.SetHeader("PRIVATE-TOKEN", token)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, params map[string]string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	for k, v := range params {
+		if v != "" {
+			req = req.SetQueryParam(k, v)
+		}
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("gitlab api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: strings.TrimSpace(string(resp.Body()))}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params map[string]string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, params, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("gitlab api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("gitlab api error: unexpected status code: %d, %s", e.StatusCode, e.Message)
+}
@@ -0,0 +1,42 @@
+package gitlabsdk
+
+type Variable struct {
+	Key              string `json:"key"`
+	Value            string `json:"value"`
+	VariableType     string `json:"variable_type,omitempty"`
+	Protected        bool   `json:"protected"`
+	Masked           bool   `json:"masked"`
+	Raw              bool   `json:"raw"`
+	EnvironmentScope string `json:"environment_scope,omitempty"`
+	Description      string `json:"description,omitempty"`
+}
+
+type RepositoryFile struct {
+	FileName     string `json:"file_name"`
+	FilePath     string `json:"file_path"`
+	Size         int64  `json:"size"`
+	Ref          string `json:"ref"`
+	BlobId       string `json:"blob_id"`
+	CommitId     string `json:"commit_id"`
+	LastCommitId string `json:"last_commit_id"`
+}
+
+type CommitAction struct {
+	Action   string `json:"action"`
+	FilePath string `json:"file_path"`
+	Content  string `json:"content,omitempty"`
+}
+
+type CreateCommitRequest struct {
+	Branch        string          `json:"branch"`
+	CommitMessage string          `json:"commit_message"`
+	Actions       []*CommitAction `json:"actions"`
+}
+
+type Commit struct {
+	Id        string `json:"id"`
+	ShortId   string `json:"short_id"`
+	Title     string `json:"title"`
+	WebUrl    string `json:"web_url"`
+	CreatedAt string `json:"created_at"`
+}
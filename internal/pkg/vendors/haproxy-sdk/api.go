@@ -0,0 +1,45 @@
+package haproxysdk
+
+import (
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+func (c *Client) ListStorageSslCertificates() ([]*SslCertificate, error) {
+	req := c.client.R()
+	req.Method = http.MethodGet
+	req.URL = "/services/haproxy/storage/ssl_certificates"
+
+	resp := make([]*SslCertificate, 0)
+	err := c.sendRequestWithResult(req, &resp)
+	return resp, err
+}
+
+// 上传证书文件到存储目录。forceReload 为 true 时将立即重新加载 HAProxy。
+func (c *Client) CreateStorageSslCertificate(fileName string, fileContent string, forceReload bool) (*SslCertificate, error) {
+	req := c.client.R()
+	req.Method = http.MethodPost
+	req.URL = "/services/haproxy/storage/ssl_certificates"
+	req.SetQueryParam("force_reload", strconv.FormatBool(forceReload))
+	req.SetFileReader("file_upload", fileName, strings.NewReader(fileContent))
+
+	resp := &SslCertificate{}
+	err := c.sendRequestWithResult(req, resp)
+	return resp, err
+}
+
+// 替换存储目录中的证书文件。forceReload 为 true 时将立即重新加载 HAProxy。
+func (c *Client) ReplaceStorageSslCertificate(storageName string, fileContent string, forceReload bool) (*SslCertificate, error) {
+	req := c.client.R()
+	req.Method = http.MethodPut
+	req.URL = "/services/haproxy/storage/ssl_certificates/" + url.PathEscape(storageName)
+	req.SetQueryParam("force_reload", strconv.FormatBool(forceReload))
+	req.SetHeader("Content-Type", "text/plain")
+	req.SetBody(fileContent)
+
+	resp := &SslCertificate{}
+	err := c.sendRequestWithResult(req, resp)
+	return resp, err
+}
@@ -0,0 +1,74 @@
+package haproxysdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 HAProxy Data Plane API 客户端。
+//
+// 入参：
+//   - serverUrl：Data Plane API 服务地址，如 "http://192.168.1.1:5555"。
+//   - apiVersion：Data Plane API 版本，可取值 "v2"、"v3"。
+//   - username：用户名。
+//   - password：密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiVersion, username, password string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/"+apiVersion).
+		SetBasicAuth(username, password)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(req *resty.Request) (*resty.Response, error) {
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("haproxy api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("haproxy api error: unexpected status code: %d, %s", resp.StatusCode(), errResp.Message)
+		}
+
+		return resp, fmt.Errorf("haproxy api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(req *resty.Request, result interface{}) error {
+	resp, err := c.sendRequest(req)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("haproxy api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
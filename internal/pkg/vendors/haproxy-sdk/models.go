@@ -0,0 +1,14 @@
+package haproxysdk
+
+type ErrorResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+type SslCertificate struct {
+	StorageName string `json:"storage_name"`
+	File        string `json:"file,omitempty"`
+	Description string `json:"description,omitempty"`
+	NotAfter    string `json:"not_after,omitempty"`
+	NotBefore   string `json:"not_before,omitempty"`
+}
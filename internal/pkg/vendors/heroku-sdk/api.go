@@ -0,0 +1,25 @@
+package herokusdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) ListSniEndpoints(appIdOrName string) (*ListSniEndpointsResponse, error) {
+	resp := &ListSniEndpointsResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/apps/%s/sni-endpoints", url.PathEscape(appIdOrName)), nil, resp)
+	return resp, err
+}
+
+func (c *Client) CreateSniEndpoint(appIdOrName string, req *CreateSniEndpointRequest) (*CreateSniEndpointResponse, error) {
+	resp := &CreateSniEndpointResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/apps/%s/sni-endpoints", url.PathEscape(appIdOrName)), req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateSniEndpoint(appIdOrName string, sniEndpointIdOrName string, req *UpdateSniEndpointRequest) (*UpdateSniEndpointResponse, error) {
+	resp := &UpdateSniEndpointResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, fmt.Sprintf("/apps/%s/sni-endpoints/%s", url.PathEscape(appIdOrName), url.PathEscape(sniEndpointIdOrName)), req, resp)
+	return resp, err
+}
@@ -0,0 +1,38 @@
+package herokusdk
+
+type SniEndpointInfo struct {
+	Id               string `json:"id"`
+	Name             string `json:"name"`
+	CertificateChain string `json:"certificate_chain"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
+	App              *struct {
+		Id   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"app,omitempty"`
+	SslCert *struct {
+		Id          string   `json:"id"`
+		CertDomains []string `json:"cert_domains"`
+		ExpiresAt   string   `json:"expires_at"`
+	} `json:"ssl_cert,omitempty"`
+}
+
+type ListSniEndpointsResponse []*SniEndpointInfo
+
+type CreateSniEndpointRequest struct {
+	CertificateChain string `json:"certificate_chain"`
+	PrivateKey       string `json:"private_key"`
+}
+
+type CreateSniEndpointResponse struct {
+	SniEndpointInfo
+}
+
+type UpdateSniEndpointRequest struct {
+	CertificateChain string `json:"certificate_chain"`
+	PrivateKey       string `json:"private_key"`
+}
+
+type UpdateSniEndpointResponse struct {
+	SniEndpointInfo
+}
@@ -0,0 +1,62 @@
+package jenkinssdk
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const contentTypeXml = "application/xml; charset=utf-8"
+
+// 获取凭据。凭据不存在时返回 nil。
+//
+// 入参：
+//   - folder：文件夹路径，如 "team/project"；为空时表示系统全局凭据存储。
+//   - domain：凭据域；为空时表示全局域 "_"。
+//   - id：凭据 ID。
+func (c *Client) GetCredential(folder string, domain string, id string) (*Credential, error) {
+	resp := Credential{}
+	err := c.sendRequestWithResult(http.MethodGet, credentialPath(folder, domain, id)+"/api/json", "", nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 以 XML 配置创建凭据。
+func (c *Client) CreateCredential(folder string, domain string, configXml string) error {
+	_, err := c.sendRequest(http.MethodPost, domainPath(folder, domain)+"/createCredentials", contentTypeXml, configXml)
+	return err
+}
+
+// 以 XML 配置覆盖更新凭据。
+func (c *Client) UpdateCredential(folder string, domain string, id string, configXml string) error {
+	_, err := c.sendRequest(http.MethodPost, credentialPath(folder, domain, id)+"/config.xml", contentTypeXml, configXml)
+	return err
+}
+
+func domainPath(folder string, domain string) string {
+	if domain == "" {
+		domain = "_"
+	}
+
+	folder = strings.Trim(folder, "/")
+	if folder == "" {
+		return "/credentials/store/system/domain/" + url.PathEscape(domain)
+	}
+
+	path := ""
+	for _, segment := range strings.Split(folder, "/") {
+		if segment != "" {
+			path += "/job/" + url.PathEscape(segment)
+		}
+	}
+	return path + "/credentials/store/folder/domain/" + url.PathEscape(domain)
+}
+
+func credentialPath(folder string, domain string, id string) string {
+	return domainPath(folder, domain) + "/credential/" + url.PathEscape(id)
+}
@@ -0,0 +1,99 @@
+package jenkinssdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 Jenkins REST API 客户端。
+//
+// 入参：
+//   - serverUrl：Jenkins 服务地址，如 "https://jenkins.example.com"。
+//   - username：用户名。
+//   - apiToken：用户的 API 令牌。使用 API 令牌认证时无需携带 CSRF Crumb。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, username, apiToken string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")).
+		SetBasicAuth(username, apiToken)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, contentType string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", contentType).
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("jenkins api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: truncateMessage(strings.TrimSpace(string(resp.Body())))}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, contentType string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, contentType, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("jenkins api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("jenkins api error: unexpected status code: %d, %s", e.StatusCode, e.Message)
+}
+
+// Jenkins 的错误页面为完整的 HTML 文档，此处截断以免错误信息过长。
+func truncateMessage(message string) string {
+	const maxLen = 512
+	if len(message) > maxLen {
+		return message[:maxLen] + "..."
+	}
+	return message
+}
@@ -0,0 +1,11 @@
+package jenkinssdk
+
+type Credential struct {
+	Id          string `json:"id"`
+	DisplayName string `json:"displayName"`
+	Description string `json:"description"`
+	TypeName    string `json:"typeName"`
+	Fingerprint *struct {
+		Hash string `json:"hash"`
+	} `json:"fingerprint"`
+}
@@ -0,0 +1,87 @@
+package kempsdk
+
+import (
+	"bytes"
+	"net/http"
+)
+
+// 获取证书列表。
+func (c *Client) ListCertificates() ([]*CertificateInfo, error) {
+	resp, err := c.sendRequest(c.client.R(), http.MethodGet, "listcert")
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.Success == nil || resp.Success.Data == nil {
+		return []*CertificateInfo{}, nil
+	}
+
+	return resp.Success.Data.Certificates, nil
+}
+
+// 上传证书。
+//
+// 入参：
+//   - name：证书名称。
+//   - data：证书文件内容，可以是包含私钥的 PEM，也可以是 PFX。
+//   - password：PFX 文件密码，PEM 格式时为空。
+//   - replace：是否替换同名证书。
+func (c *Client) AddCertificate(name string, data []byte, password string, replace bool) error {
+	req := c.client.R().
+		SetQueryParam("cert", name).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(bytes.NewReader(data))
+	if password != "" {
+		req.SetQueryParam("password", password)
+	}
+	if replace {
+		req.SetQueryParam("replace", "1")
+	} else {
+		req.SetQueryParam("replace", "0")
+	}
+
+	_, err := c.sendRequest(req, http.MethodPost, "addcert")
+	return err
+}
+
+// 上传中间证书。
+func (c *Client) AddIntermediateCertificate(name string, data []byte) error {
+	req := c.client.R().
+		SetQueryParam("cert", name).
+		SetHeader("Content-Type", "application/octet-stream").
+		SetBody(bytes.NewReader(data))
+
+	_, err := c.sendRequest(req, http.MethodPost, "addintermediate")
+	return err
+}
+
+// 删除中间证书。
+func (c *Client) DeleteIntermediateCertificate(name string) error {
+	req := c.client.R().
+		SetQueryParam("cert", name)
+
+	_, err := c.sendRequest(req, http.MethodGet, "delintermediate")
+	return err
+}
+
+// 修改虚拟服务。
+//
+// 入参：
+//   - vs：虚拟服务 IP 地址或索引号。
+//   - port：虚拟服务端口，使用索引号时为空。
+//   - prot：虚拟服务协议，可取值 "tcp"、"udp"，使用索引号时为空。
+//   - params：需要修改的参数。
+func (c *Client) ModifyVirtualService(vs, port, prot string, params map[string]string) error {
+	req := c.client.R().
+		SetQueryParam("vs", vs).
+		SetQueryParams(params)
+	if port != "" {
+		req.SetQueryParam("port", port)
+	}
+	if prot != "" {
+		req.SetQueryParam("prot", prot)
+	}
+
+	_, err := c.sendRequest(req, http.MethodGet, "modvs")
+	return err
+}
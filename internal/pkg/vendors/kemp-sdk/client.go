@@ -0,0 +1,66 @@
+package kempsdk
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 Kemp LoadMaster RESTful API 客户端。
+//
+// 入参：
+//   - serverUrl：LoadMaster 管理地址，如 "https://192.168.1.1"。
+//   - username：用户名。
+//   - password：密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, username, password string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/access").
+		SetBasicAuth(username, password)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(req *resty.Request, method string, command string) (*Response, error) {
+	resp, err := req.Execute(method, "/"+command)
+	if err != nil {
+		return nil, fmt.Errorf("kemp api error: failed to send request: %w", err)
+	}
+
+	result := &Response{}
+	if err := xml.Unmarshal(resp.Body(), result); err != nil {
+		if resp.IsError() {
+			return nil, fmt.Errorf("kemp api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+		}
+
+		return nil, fmt.Errorf("kemp api error: failed to parse response: %w", err)
+	}
+
+	if resp.IsError() || result.Code != "ok" {
+		return result, fmt.Errorf("kemp api error: unexpected status code: %d, %s", resp.StatusCode(), strings.TrimSpace(result.Error))
+	}
+
+	return result, nil
+}
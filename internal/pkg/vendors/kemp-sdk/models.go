@@ -0,0 +1,22 @@
+package kempsdk
+
+import "encoding/xml"
+
+type Response struct {
+	XMLName xml.Name `xml:"Response"`
+	Stat    string   `xml:"stat,attr"`
+	Code    string   `xml:"code,attr"`
+	Error   string   `xml:"Error"`
+	Success *struct {
+		Data *ResponseData `xml:"Data"`
+	} `xml:"Success"`
+}
+
+type ResponseData struct {
+	Certificates []*CertificateInfo `xml:"cert"`
+}
+
+type CertificateInfo struct {
+	Name string `xml:"name"`
+	Type string `xml:"type"`
+}
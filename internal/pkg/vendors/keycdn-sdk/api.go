@@ -0,0 +1,45 @@
+package keycdnsdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetZone(zoneId string) (*GetZoneResponse, error) {
+	resp := &GetZoneResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/zones/%s.json", url.PathEscape(zoneId)), nil, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) UpdateZone(zoneId string, req *UpdateZoneRequest) (*UpdateZoneResponse, error) {
+	params := make(map[string]string)
+	if req.SslCert != nil {
+		params["sslcert"] = *req.SslCert
+	}
+	if req.CustomSslCert != nil {
+		params["customsslcert"] = *req.CustomSslCert
+	}
+	if req.CustomSslKey != nil {
+		params["customsslkey"] = *req.CustomSslKey
+	}
+
+	resp := &UpdateZoneResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, fmt.Sprintf("/zones/%s.json", url.PathEscape(zoneId)), params, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) ListZoneAliases() (*ListZoneAliasesResponse, error) {
+	resp := &ListZoneAliasesResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/zonealiases.json", nil, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
@@ -0,0 +1,66 @@
+package keycdnsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	apiKey string
+
+	client *resty.Client
+}
+
+func NewClient(apiKey string) *Client {
+	client := resty.New()
+
+	return &Client{
+		apiKey: apiKey,
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, params map[string]string) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = "https://api.keycdn.com" + path
+	req = req.SetBasicAuth(c.apiKey, "")
+	if method == http.MethodGet {
+		req = req.SetQueryParams(params)
+	} else if params != nil {
+		req = req.SetFormData(params)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("keycdn api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("keycdn api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params map[string]string, result BaseResponse) error {
+	resp, err := c.sendRequest(method, path, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return fmt.Errorf("keycdn api error: failed to parse response: %w", err)
+	} else if status := result.GetStatus(); status != "success" {
+		return fmt.Errorf("keycdn api error: %s, %s", status, result.GetDescription())
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package keycdnsdk
+
+type BaseResponse interface {
+	GetStatus() string
+	GetDescription() string
+}
+
+type baseResponse struct {
+	Status      string `json:"status"`
+	Description string `json:"description"`
+}
+
+func (r *baseResponse) GetStatus() string {
+	return r.Status
+}
+
+func (r *baseResponse) GetDescription() string {
+	return r.Description
+}
+
+type ZoneInfo struct {
+	Id            string `json:"id"`
+	Name          string `json:"name"`
+	Status        string `json:"status"`
+	Type          string `json:"type"`
+	OriginUrl     string `json:"originurl"`
+	SslCert       string `json:"sslcert"`
+	CustomSslCert string `json:"customsslcert,omitempty"`
+	ForceSsl      string `json:"forcessl"`
+}
+
+type ZoneAliasInfo struct {
+	Id     string `json:"id"`
+	ZoneId string `json:"zone_id"`
+	Name   string `json:"name"`
+}
+
+type GetZoneResponse struct {
+	baseResponse
+	Data *struct {
+		Zone *ZoneInfo `json:"zone,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+type UpdateZoneRequest struct {
+	SslCert       *string
+	CustomSslCert *string
+	CustomSslKey  *string
+}
+
+type UpdateZoneResponse struct {
+	baseResponse
+	Data *struct {
+		Zone *ZoneInfo `json:"zone,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+type ListZoneAliasesResponse struct {
+	baseResponse
+	Data *struct {
+		ZoneAliases []*ZoneAliasInfo `json:"zonealiases,omitempty"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,17 @@
+package cdn
+
+import (
+	"net/http"
+)
+
+func (c *Client) GetCdnDomains(req *GetCdnDomainsRequest) (*GetCdnDomainsResponse, error) {
+	resp := &GetCdnDomainsResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "2019-06-01", "domain", "GetCdnDomains", req, resp)
+	return resp, err
+}
+
+func (c *Client) ConfigCertificate(req *ConfigCertificateRequest) (*ConfigCertificateResponse, error) {
+	resp := &ConfigCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "2016-09-01", "cert", "ConfigCertificate", req, resp)
+	return resp, err
+}
@@ -0,0 +1,143 @@
+package cdn
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsSigner "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	apiHost    = "https://cdn.api.ksyun.com"
+	apiService = "cdn"
+	apiRegion  = "cn-shanghai-2"
+)
+
+type Client struct {
+	accessKeyId     string
+	secretAccessKey string
+
+	client *resty.Client
+}
+
+// 创建金山云 CDN API 客户端。
+// 金山云 OpenAPI 使用与 AWS Signature V4 相同的签名算法。
+//
+// 入参：
+//   - accessKeyId：金山云 AccessKeyId。
+//   - secretAccessKey：金山云 SecretAccessKey。
+//
+// 出参：
+//   - 客户端。
+func NewClient(accessKeyId, secretAccessKey string) *Client {
+	signer := awsSigner.NewSigner()
+	credentials := aws.Credentials{
+		AccessKeyID:     accessKeyId,
+		SecretAccessKey: secretAccessKey,
+	}
+
+	client := resty.New().
+		SetBaseURL(apiHost).
+		SetHeader("Accept", "application/json").
+		SetHeader("User-Agent", "certimate").
+		SetPreRequestHook(func(c *resty.Client, req *http.Request) error {
+			payload := []byte{}
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return err
+				}
+
+				payload, err = io.ReadAll(body)
+				if err != nil {
+					return err
+				}
+			}
+
+			payloadHash := sha256.Sum256(payload)
+			return signer.SignHTTP(context.Background(), credentials, req, hex.EncodeToString(payloadHash[:]), apiService, apiRegion, time.Now().UTC())
+		})
+
+	return &Client{
+		accessKeyId:     accessKeyId,
+		secretAccessKey: secretAccessKey,
+		client:          client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, version string, path string, action string, params interface{}) (*resty.Response, error) {
+	req := c.client.R().
+		SetHeader("X-Action", action).
+		SetHeader("X-Version", version)
+	req.Method = method
+	req.URL = fmt.Sprintf("/%s/%s/%s", version, path, action)
+	if method == http.MethodGet {
+		if params != nil {
+			data, err := json.Marshal(params)
+			if err != nil {
+				return nil, fmt.Errorf("ksyun api error: failed to marshal params: %w", err)
+			}
+
+			queryParams := make(map[string]interface{})
+			if err := json.Unmarshal(data, &queryParams); err != nil {
+				return nil, fmt.Errorf("ksyun api error: failed to unmarshal params: %w", err)
+			}
+
+			for k, v := range queryParams {
+				req = req.SetQueryParam(k, fmt.Sprintf("%v", v))
+			}
+		}
+	} else {
+		data, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("ksyun api error: failed to marshal params: %w", err)
+		}
+
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(data)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("ksyun api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("ksyun api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, version string, path string, action string, params interface{}, result baseResponseIface) error {
+	resp, err := c.sendRequest(method, version, path, action, params)
+	if err != nil {
+		if resp != nil {
+			// 金山云 API 在出错时会返回非 2xx 状态码，尝试解析其中的错误信息
+			if jsonErr := json.Unmarshal(resp.Body(), result); jsonErr == nil && result.GetErrorCode() != "" {
+				return fmt.Errorf("ksyun api error: %s, %s (RequestId: %s)", result.GetErrorCode(), result.GetErrorMessage(), result.GetRequestId())
+			}
+		}
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("ksyun api error: failed to parse response: %w", err)
+	} else if errCode := result.GetErrorCode(); errCode != "" {
+		return fmt.Errorf("ksyun api error: %s, %s (RequestId: %s)", errCode, result.GetErrorMessage(), result.GetRequestId())
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+package cdn
+
+type baseResponseIface interface {
+	GetRequestId() string
+	GetErrorCode() string
+	GetErrorMessage() string
+}
+
+type baseResponse struct {
+	RequestId *string `json:"RequestId,omitempty"`
+	Error     *struct {
+		Code    string `json:"Code"`
+		Message string `json:"Message"`
+	} `json:"Error,omitempty"`
+}
+
+func (r *baseResponse) GetRequestId() string {
+	if r.RequestId != nil {
+		return *r.RequestId
+	}
+	return ""
+}
+
+func (r *baseResponse) GetErrorCode() string {
+	if r.Error != nil {
+		return r.Error.Code
+	}
+	return ""
+}
+
+func (r *baseResponse) GetErrorMessage() string {
+	if r.Error != nil {
+		return r.Error.Message
+	}
+	return ""
+}
+
+type DomainInfo struct {
+	DomainId     string `json:"DomainId"`
+	DomainName   string `json:"DomainName"`
+	Cname        string `json:"Cname"`
+	CdnType      string `json:"CdnType"`
+	CdnProtocol  string `json:"CdnProtocol"`
+	DomainStatus string `json:"DomainStatus"`
+	CreatedTime  string `json:"CreatedTime"`
+	ModifiedTime string `json:"ModifiedTime"`
+}
+
+type GetCdnDomainsRequest struct {
+	DomainName *string `json:"DomainName,omitempty"`
+	PageNumber *int32  `json:"PageNumber,omitempty"`
+	PageSize   *int32  `json:"PageSize,omitempty"`
+}
+
+type GetCdnDomainsResponse struct {
+	baseResponse
+	Domains    []*DomainInfo `json:"Domains"`
+	PageNumber int32         `json:"PageNumber"`
+	PageSize   int32         `json:"PageSize"`
+	TotalCount int32         `json:"TotalCount"`
+}
+
+type ConfigCertificateRequest struct {
+	Enable            *string `json:"Enable,omitempty"`
+	DomainIds         *string `json:"DomainIds,omitempty"`
+	CertificateId     *string `json:"CertificateId,omitempty"`
+	CertificateName   *string `json:"CertificateName,omitempty"`
+	ServerCertificate *string `json:"ServerCertificate,omitempty"`
+	PrivateKey        *string `json:"PrivateKey,omitempty"`
+}
+
+type ConfigCertificateResponse struct {
+	baseResponse
+	CertificateId string `json:"CertificateId"`
+}
@@ -0,0 +1,64 @@
+package mikrotiksdk
+
+import (
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) AddFile(req *AddFileRequest) (*AddFileResponse, error) {
+	resp := AddFileResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/file", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) RemoveFile(id string) error {
+	return c.sendRequestWithResult(http.MethodDelete, "/file/"+url.PathEscape(id), nil, nil)
+}
+
+func (c *Client) ListCertificates(req *ListCertificatesRequest) (*ListCertificatesResponse, error) {
+	resp := ListCertificatesResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/certificate", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ImportCertificate(req *ImportCertificateRequest) (*ImportCertificateResponse, error) {
+	resp := ImportCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/certificate/import", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) UpdateCertificate(id string, req *UpdateCertificateRequest) (*UpdateCertificateResponse, error) {
+	resp := UpdateCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, "/certificate/"+url.PathEscape(id), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) ListServices(req *ListServicesRequest) (*ListServicesResponse, error) {
+	resp := ListServicesResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/ip/service", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) UpdateService(id string, req *UpdateServiceRequest) (*UpdateServiceResponse, error) {
+	resp := UpdateServiceResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, "/ip/service/"+url.PathEscape(id), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,93 @@
+package mikrotiksdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	serverUrl string
+	username  string
+	password  string
+
+	client *resty.Client
+}
+
+func NewClient(serverUrl, username, password string) *Client {
+	client := resty.New()
+
+	return &Client{
+		serverUrl: strings.TrimRight(serverUrl, "/"),
+		username:  username,
+		password:  password,
+		client:    client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, params interface{}) (*resty.Response, error) {
+	url := c.serverUrl + "/rest" + path
+	req := c.client.R().
+		SetBasicAuth(c.username, c.password).
+		SetHeader("Content-Type", "application/json")
+	if method == http.MethodGet {
+		if params != nil {
+			temp := make(map[string]interface{})
+			jsonb, _ := json.Marshal(params)
+			json.Unmarshal(jsonb, &temp)
+			for k, v := range temp {
+				if v != nil {
+					req = req.SetQueryParam(k, fmt.Sprintf("%v", v))
+				}
+			}
+		}
+	} else if params != nil {
+		req = req.SetBody(params)
+	}
+
+	resp, err := req.Execute(method, url)
+	if err != nil {
+		return nil, fmt.Errorf("mikrotik api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if err := json.Unmarshal(resp.Body(), errResp); err == nil && errResp.Message != "" {
+			return nil, fmt.Errorf("mikrotik api error: %d - %s, %s", errResp.Error, errResp.Message, errResp.Detail)
+		}
+
+		return nil, fmt.Errorf("mikrotik api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, params)
+	if err != nil {
+		return err
+	}
+
+	if len(resp.Body()) == 0 || result == nil {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("mikrotik api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package mikrotiksdk
+
+type ErrorResponse struct {
+	Error   int32  `json:"error"`
+	Message string `json:"message"`
+	Detail  string `json:"detail"`
+}
+
+type FileRecord struct {
+	Id   string `json:".id"`
+	Name string `json:"name"`
+	Size string `json:"size"`
+	Type string `json:"type"`
+}
+
+type CertificateRecord struct {
+	Id            string `json:".id"`
+	Name          string `json:"name"`
+	CommonName    string `json:"common-name"`
+	Fingerprint   string `json:"fingerprint"`
+	PrivateKey    string `json:"private-key"`
+	InvalidBefore string `json:"invalid-before"`
+	InvalidAfter  string `json:"invalid-after"`
+}
+
+type ServiceRecord struct {
+	Id          string `json:".id"`
+	Name        string `json:"name"`
+	Port        string `json:"port"`
+	Certificate string `json:"certificate"`
+	Disabled    string `json:"disabled"`
+}
+
+type AddFileRequest struct {
+	Name     string `json:"name"`
+	Contents string `json:"contents"`
+}
+
+type AddFileResponse = FileRecord
+
+type ListCertificatesRequest struct {
+	Fingerprint *string `json:"fingerprint,omitempty"`
+	Name        *string `json:"name,omitempty"`
+}
+
+type ListCertificatesResponse = []*CertificateRecord
+
+type ImportCertificateRequest struct {
+	FileName   string `json:"file-name"`
+	Passphrase string `json:"passphrase"`
+}
+
+type ImportCertificateResponse = []map[string]interface{}
+
+type UpdateCertificateRequest struct {
+	Name string `json:"name"`
+}
+
+type UpdateCertificateResponse = CertificateRecord
+
+type ListServicesRequest struct {
+	Name *string `json:"name,omitempty"`
+}
+
+type ListServicesResponse = []*ServiceRecord
+
+type UpdateServiceRequest struct {
+	Certificate string `json:"certificate"`
+}
+
+type UpdateServiceResponse = ServiceRecord
@@ -0,0 +1,26 @@
+package netlifysdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetSite(siteId string) (*GetSiteResponse, error) {
+	resp := &GetSiteResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/sites/%s", url.PathEscape(siteId)), nil, resp)
+	return resp, err
+}
+
+func (c *Client) ProvisionSiteTLSCertificate(siteId string, req *ProvisionSiteTLSCertificateRequest) (*ProvisionSiteTLSCertificateResponse, error) {
+	queryParams := make(map[string]string)
+	queryParams["certificate"] = req.Certificate
+	queryParams["key"] = req.Key
+	if req.CACertificates != "" {
+		queryParams["ca_certificates"] = req.CACertificates
+	}
+
+	resp := &ProvisionSiteTLSCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/sites/%s/ssl", url.PathEscape(siteId)), queryParams, resp)
+	return resp, err
+}
@@ -0,0 +1,63 @@
+package netlifysdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	apiToken string
+
+	client *resty.Client
+}
+
+func NewClient(apiToken string) *Client {
+	client := resty.New()
+
+	return &Client{
+		apiToken: apiToken,
+		client:   client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, queryParams map[string]string) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = "https://api.netlify.com/api/v1" + path
+	req = req.
+		SetHeader("Accept", "application/json").
+		SetHeader("Authorization", "Bearer "+c.apiToken)
+	if len(queryParams) > 0 {
+		req = req.SetQueryParams(queryParams)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("netlify api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("netlify api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, queryParams map[string]string, result interface{}) error {
+	resp, err := c.sendRequest(method, path, queryParams)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("netlify api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package netlifysdk
+
+type SiteInfo struct {
+	Id            string   `json:"id"`
+	Name          string   `json:"name"`
+	Url           string   `json:"url"`
+	SslUrl        string   `json:"ssl_url"`
+	CustomDomain  string   `json:"custom_domain"`
+	DomainAliases []string `json:"domain_aliases"`
+	Ssl           bool     `json:"ssl"`
+	ForceSsl      bool     `json:"force_ssl"`
+	ManagedDns    bool     `json:"managed_dns"`
+}
+
+type SniCertificateInfo struct {
+	State     string   `json:"state"`
+	Domains   []string `json:"domains"`
+	CreatedAt string   `json:"created_at"`
+	UpdatedAt string   `json:"updated_at"`
+	ExpiresAt string   `json:"expires_at"`
+}
+
+type GetSiteResponse struct {
+	SiteInfo
+}
+
+type ProvisionSiteTLSCertificateRequest struct {
+	Certificate    string
+	Key            string
+	CACertificates string
+}
+
+type ProvisionSiteTLSCertificateResponse struct {
+	SniCertificateInfo
+}
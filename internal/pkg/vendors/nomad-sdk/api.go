@@ -0,0 +1,39 @@
+package nomadsdk
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// 读取变量。变量不存在时返回 nil。
+func (c *Client) ReadVariable(namespace string, path string) (*Variable, error) {
+	resp := Variable{}
+	err := c.sendRequestWithResult(http.MethodGet, "/var/"+trimPath(path), map[string]string{"namespace": namespace}, nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 写入变量。
+// 入参 casIndex 用于乐观锁校验，为 0 时表示仅在变量不存在时写入。
+func (c *Client) WriteVariable(variable *Variable, casIndex uint64) (*Variable, error) {
+	resp := Variable{}
+	params := map[string]string{
+		"namespace": variable.Namespace,
+		"cas":       strconv.FormatUint(casIndex, 10),
+	}
+	err := c.sendRequestWithResult(http.MethodPut, "/var/"+trimPath(variable.Path), params, variable, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func trimPath(path string) string {
+	return strings.Trim(path, "/")
+}
@@ -0,0 +1,100 @@
+package nomadsdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 HashiCorp Nomad HTTP API 客户端。
+//
+// 入参：
+//   - serverUrl：Nomad 服务地址，如 "http://127.0.0.1:4646"。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/") + "/v1")
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 设置 ACL 令牌，仅在 Nomad 启用了 ACL 时需要。
+func (c *Client) WithToken(token string) *Client {
+	if token != "" {
+		c.client.SetHeader("X-Nomad-Token", token)
+	}
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, params map[string]string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	for k, v := range params {
+		if v != "" {
+			req = req.SetQueryParam(k, v)
+		}
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("nomad api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: strings.TrimSpace(string(resp.Body()))}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params map[string]string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, params, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("nomad api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("nomad api error: unexpected status code: %d, %s", e.StatusCode, e.Message)
+}
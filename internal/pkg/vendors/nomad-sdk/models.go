@@ -0,0 +1,11 @@
+package nomadsdk
+
+type Variable struct {
+	Namespace   string            `json:"Namespace"`
+	Path        string            `json:"Path"`
+	Items       map[string]string `json:"Items"`
+	CreateIndex uint64            `json:"CreateIndex,omitempty"`
+	ModifyIndex uint64            `json:"ModifyIndex,omitempty"`
+	CreateTime  int64             `json:"CreateTime,omitempty"`
+	ModifyTime  int64             `json:"ModifyTime,omitempty"`
+}
@@ -0,0 +1,65 @@
+package ocisdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) certificatesManagementHost() string {
+	return fmt.Sprintf("certificatesmanagement.%s.oci.oraclecloud.com", c.region)
+}
+
+func (c *Client) loadBalancerHost() string {
+	return fmt.Sprintf("iaas.%s.oraclecloud.com", c.region)
+}
+
+func (c *Client) GetCertificatesManagementCertificate(certificateId string) (*GetCertificatesManagementCertificateResponse, error) {
+	resp := &GetCertificatesManagementCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, c.certificatesManagementHost(), fmt.Sprintf("/20210224/certificates/%s", url.PathEscape(certificateId)), nil, resp)
+	return resp, err
+}
+
+func (c *Client) CreateCertificatesManagementCertificate(req *CreateCertificatesManagementCertificateRequest) (*CreateCertificatesManagementCertificateResponse, error) {
+	resp := &CreateCertificatesManagementCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, c.certificatesManagementHost(), "/20210224/certificates", req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateCertificatesManagementCertificate(certificateId string, req *UpdateCertificatesManagementCertificateRequest) (*UpdateCertificatesManagementCertificateResponse, error) {
+	resp := &UpdateCertificatesManagementCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, c.certificatesManagementHost(), fmt.Sprintf("/20210224/certificates/%s", url.PathEscape(certificateId)), req, resp)
+	return resp, err
+}
+
+func (c *Client) GetLoadBalancer(loadBalancerId string) (*GetLoadBalancerResponse, error) {
+	resp := &GetLoadBalancerResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, c.loadBalancerHost(), fmt.Sprintf("/20170115/loadBalancers/%s", url.PathEscape(loadBalancerId)), nil, resp)
+	return resp, err
+}
+
+// 创建负载均衡证书，返回异步工作请求 ID。
+func (c *Client) CreateLoadBalancerCertificate(loadBalancerId string, req *CreateLoadBalancerCertificateRequest) (string, error) {
+	resp, err := c.sendRequest(http.MethodPost, c.loadBalancerHost(), fmt.Sprintf("/20170115/loadBalancers/%s/certificates", url.PathEscape(loadBalancerId)), req)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Header().Get("opc-work-request-id"), nil
+}
+
+// 修改负载均衡监听器，返回异步工作请求 ID。
+func (c *Client) UpdateLoadBalancerListener(loadBalancerId string, listenerName string, req *UpdateLoadBalancerListenerRequest) (string, error) {
+	resp, err := c.sendRequest(http.MethodPut, c.loadBalancerHost(), fmt.Sprintf("/20170115/loadBalancers/%s/listeners/%s", url.PathEscape(loadBalancerId), url.PathEscape(listenerName)), req)
+	if err != nil {
+		return "", err
+	}
+
+	return resp.Header().Get("opc-work-request-id"), nil
+}
+
+func (c *Client) GetLoadBalancerWorkRequest(workRequestId string) (*GetLoadBalancerWorkRequestResponse, error) {
+	resp := &GetLoadBalancerWorkRequestResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, c.loadBalancerHost(), fmt.Sprintf("/20170115/loadBalancerWorkRequests/%s", url.PathEscape(workRequestId)), nil, resp)
+	return resp, err
+}
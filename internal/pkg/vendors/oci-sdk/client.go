@@ -0,0 +1,163 @@
+package ocisdk
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	tenancyId   string
+	userId      string
+	fingerprint string
+	privateKey  *rsa.PrivateKey
+	region      string
+
+	client *resty.Client
+}
+
+func NewClient(tenancyId, userId, fingerprint, privateKeyPem, region string) (*Client, error) {
+	privateKey, err := parseRSAPrivateKey(privateKeyPem)
+	if err != nil {
+		return nil, err
+	}
+
+	client := resty.New()
+
+	return &Client{
+		tenancyId:   tenancyId,
+		userId:      userId,
+		fingerprint: fingerprint,
+		privateKey:  privateKey,
+		region:      region,
+		client:      client,
+	}, nil
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, host string, path string, params interface{}) (*resty.Response, error) {
+	reqBody := []byte{}
+	if (method == http.MethodPost || method == http.MethodPut) && params != nil {
+		jsonb, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("oci api error: failed to marshal request: %w", err)
+		}
+		reqBody = jsonb
+	}
+
+	reqUrl, err := url.Parse("https://" + host + path)
+	if err != nil {
+		return nil, fmt.Errorf("oci api error: failed to parse url: %w", err)
+	}
+
+	// 签名算法
+	// REF: https://docs.oracle.com/en-us/iaas/Content/API/Concepts/signingrequests.htm
+	headers := map[string]string{
+		"date": time.Now().UTC().Format(http.TimeFormat),
+		"host": reqUrl.Host,
+	}
+	signedHeaders := []string{"(request-target)", "date", "host"}
+	if method == http.MethodPost || method == http.MethodPut {
+		bodyHash := sha256.Sum256(reqBody)
+		headers["content-type"] = "application/json"
+		headers["content-length"] = strconv.Itoa(len(reqBody))
+		headers["x-content-sha256"] = base64.StdEncoding.EncodeToString(bodyHash[:])
+		signedHeaders = append(signedHeaders, "content-length", "content-type", "x-content-sha256")
+	}
+
+	signingLines := make([]string, 0, len(signedHeaders))
+	for _, name := range signedHeaders {
+		if name == "(request-target)" {
+			signingLines = append(signingLines, fmt.Sprintf("(request-target): %s %s", strings.ToLower(method), reqUrl.RequestURI()))
+		} else {
+			signingLines = append(signingLines, fmt.Sprintf("%s: %s", name, headers[name]))
+		}
+	}
+
+	signingHash := sha256.Sum256([]byte(strings.Join(signingLines, "\n")))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, c.privateKey, crypto.SHA256, signingHash[:])
+	if err != nil {
+		return nil, fmt.Errorf("oci api error: failed to sign request: %w", err)
+	}
+
+	authorization := fmt.Sprintf(`Signature version="1",keyId="%s/%s/%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		c.tenancyId, c.userId, c.fingerprint, strings.Join(signedHeaders, " "), base64.StdEncoding.EncodeToString(signature))
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = reqUrl.String()
+	req = req.
+		SetHeader("Accept", "application/json").
+		SetHeader("Authorization", authorization)
+	for name, value := range headers {
+		if name != "host" {
+			req = req.SetHeader(name, value)
+		}
+	}
+	if method == http.MethodPost || method == http.MethodPut {
+		req = req.SetBody(reqBody)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("oci api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("oci api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, host string, path string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, host, path, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("oci api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+func parseRSAPrivateKey(privateKeyPem string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(privateKeyPem))
+	if block == nil {
+		return nil, errors.New("oci api error: failed to decode private key")
+	}
+
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("oci api error: failed to parse private key: %w", err)
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("oci api error: private key is not a rsa key")
+	}
+
+	return rsaKey, nil
+}
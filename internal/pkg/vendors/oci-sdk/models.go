@@ -0,0 +1,127 @@
+package ocisdk
+
+type CertificatesManagementCertificateConfig struct {
+	ConfigType     string  `json:"configType"`
+	CertificatePem string  `json:"certificatePem,omitempty"`
+	CertChainPem   string  `json:"certChainPem,omitempty"`
+	PrivateKeyPem  string  `json:"privateKeyPem,omitempty"`
+	VersionName    *string `json:"versionName,omitempty"`
+	Stage          *string `json:"stage,omitempty"`
+}
+
+type CertificatesManagementCertificateInfo struct {
+	Id             string `json:"id"`
+	Name           string `json:"name"`
+	CompartmentId  string `json:"compartmentId"`
+	ConfigType     string `json:"configType"`
+	LifecycleState string `json:"lifecycleState"`
+	CurrentVersion *struct {
+		VersionNumber int64    `json:"versionNumber"`
+		SerialNumber  string   `json:"serialNumber"`
+		Stages        []string `json:"stages"`
+	} `json:"currentVersion,omitempty"`
+	TimeCreated string `json:"timeCreated"`
+}
+
+type GetCertificatesManagementCertificateResponse struct {
+	CertificatesManagementCertificateInfo
+}
+
+type CreateCertificatesManagementCertificateRequest struct {
+	Name              string                                   `json:"name"`
+	CompartmentId     string                                   `json:"compartmentId"`
+	Description       *string                                  `json:"description,omitempty"`
+	CertificateConfig *CertificatesManagementCertificateConfig `json:"certificateConfig"`
+}
+
+type CreateCertificatesManagementCertificateResponse struct {
+	CertificatesManagementCertificateInfo
+}
+
+type UpdateCertificatesManagementCertificateRequest struct {
+	Description       *string                                  `json:"description,omitempty"`
+	CertificateConfig *CertificatesManagementCertificateConfig `json:"certificateConfig,omitempty"`
+}
+
+type UpdateCertificatesManagementCertificateResponse struct {
+	CertificatesManagementCertificateInfo
+}
+
+type LoadBalancerSslConfiguration struct {
+	CertificateName                *string  `json:"certificateName,omitempty"`
+	CertificateIds                 []string `json:"certificateIds,omitempty"`
+	TrustedCertificateAuthorityIds []string `json:"trustedCertificateAuthorityIds,omitempty"`
+	VerifyPeerCertificate          *bool    `json:"verifyPeerCertificate,omitempty"`
+	VerifyDepth                    *int32   `json:"verifyDepth,omitempty"`
+	Protocols                      []string `json:"protocols,omitempty"`
+	CipherSuiteName                *string  `json:"cipherSuiteName,omitempty"`
+	ServerOrderPreference          *string  `json:"serverOrderPreference,omitempty"`
+}
+
+type LoadBalancerConnectionConfiguration struct {
+	IdleTimeout                    *int64 `json:"idleTimeout,omitempty"`
+	BackendTcpProxyProtocolVersion *int32 `json:"backendTcpProxyProtocolVersion,omitempty"`
+}
+
+type LoadBalancerListenerInfo struct {
+	Name                    string                               `json:"name"`
+	DefaultBackendSetName   string                               `json:"defaultBackendSetName"`
+	Port                    int32                                `json:"port"`
+	Protocol                string                               `json:"protocol"`
+	HostnameNames           []string                             `json:"hostnameNames,omitempty"`
+	PathRouteSetName        *string                              `json:"pathRouteSetName,omitempty"`
+	SslConfiguration        *LoadBalancerSslConfiguration        `json:"sslConfiguration,omitempty"`
+	ConnectionConfiguration *LoadBalancerConnectionConfiguration `json:"connectionConfiguration,omitempty"`
+	RuleSetNames            []string                             `json:"ruleSetNames,omitempty"`
+	RoutingPolicyName       *string                              `json:"routingPolicyName,omitempty"`
+}
+
+type LoadBalancerCertificateInfo struct {
+	CertificateName   string `json:"certificateName"`
+	PublicCertificate string `json:"publicCertificate"`
+	CaCertificate     string `json:"caCertificate"`
+}
+
+type LoadBalancerInfo struct {
+	Id             string                                  `json:"id"`
+	CompartmentId  string                                  `json:"compartmentId"`
+	DisplayName    string                                  `json:"displayName"`
+	LifecycleState string                                  `json:"lifecycleState"`
+	Listeners      map[string]*LoadBalancerListenerInfo    `json:"listeners"`
+	Certificates   map[string]*LoadBalancerCertificateInfo `json:"certificates"`
+}
+
+type GetLoadBalancerResponse struct {
+	LoadBalancerInfo
+}
+
+type CreateLoadBalancerCertificateRequest struct {
+	CertificateName   string  `json:"certificateName"`
+	PublicCertificate string  `json:"publicCertificate"`
+	PrivateKey        string  `json:"privateKey"`
+	CaCertificate     *string `json:"caCertificate,omitempty"`
+}
+
+type UpdateLoadBalancerListenerRequest struct {
+	DefaultBackendSetName   string                               `json:"defaultBackendSetName"`
+	Port                    int32                                `json:"port"`
+	Protocol                string                               `json:"protocol"`
+	HostnameNames           []string                             `json:"hostnameNames,omitempty"`
+	PathRouteSetName        *string                              `json:"pathRouteSetName,omitempty"`
+	SslConfiguration        *LoadBalancerSslConfiguration        `json:"sslConfiguration,omitempty"`
+	ConnectionConfiguration *LoadBalancerConnectionConfiguration `json:"connectionConfiguration,omitempty"`
+	RuleSetNames            []string                             `json:"ruleSetNames,omitempty"`
+	RoutingPolicyName       *string                              `json:"routingPolicyName,omitempty"`
+}
+
+type LoadBalancerWorkRequestInfo struct {
+	Id             string `json:"id"`
+	LoadBalancerId string `json:"loadBalancerId"`
+	Type           string `json:"type"`
+	LifecycleState string `json:"lifecycleState"`
+	Message        string `json:"message"`
+}
+
+type GetLoadBalancerWorkRequestResponse struct {
+	LoadBalancerWorkRequestInfo
+}
@@ -0,0 +1,52 @@
+package opnsensesdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) TrustCertSearch(searchPhrase string) (*TrustCertSearchResponse, error) {
+	resp := TrustCertSearchResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/trust/cert/search?rowCount=-1&searchPhrase="+url.QueryEscape(searchPhrase), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TrustCertAdd(req *TrustCertSaveRequest) (*TrustCertSaveResponse, error) {
+	resp := TrustCertSaveResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/trust/cert/add", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TrustCertSet(uuid string, req *TrustCertSaveRequest) (*TrustCertSaveResponse, error) {
+	resp := TrustCertSaveResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/trust/cert/set/%s", url.PathEscape(uuid)), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CoreServiceRestart(serviceName string) (*ServiceActionResponse, error) {
+	resp := ServiceActionResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/core/service/restart/%s", url.PathEscape(serviceName)), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) HAProxyServiceReconfigure() (*ServiceActionResponse, error) {
+	resp := ServiceActionResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/haproxy/service/reconfigure", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
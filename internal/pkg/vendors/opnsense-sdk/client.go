@@ -0,0 +1,89 @@
+package opnsensesdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 OPNsense REST API 客户端。
+//
+// 入参：
+//   - serverUrl：OPNsense 管理地址，如 "https://192.168.1.1"。
+//   - apiKey：API 密钥。
+//   - apiSecret：API 密钥对应的 Secret。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiKey, apiSecret string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/api").
+		SetBasicAuth(apiKey, apiSecret)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	} else if method == http.MethodPost {
+		// OPNsense 要求 POST 请求必须携带请求体
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(map[string]any{})
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("opnsense api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("opnsense api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("opnsense api error: failed to parse response: %w", err)
+	}
+
+	if r, ok := result.(interface{ GetResult() string }); ok {
+		if status := r.GetResult(); status != "" && status != "saved" && status != "ok" {
+			return fmt.Errorf("opnsense api error: result: %s, %s", status, resp.Body())
+		}
+	}
+
+	return nil
+}
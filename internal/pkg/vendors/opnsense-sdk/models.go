@@ -0,0 +1,44 @@
+package opnsensesdk
+
+type BaseResponse struct {
+	Result      string            `json:"result,omitempty"`
+	Validations map[string]string `json:"validations,omitempty"`
+}
+
+func (r *BaseResponse) GetResult() string {
+	return r.Result
+}
+
+type TrustCert struct {
+	Uuid    string `json:"uuid"`
+	RefId   string `json:"refid"`
+	Descr   string `json:"descr"`
+	ValidTo string `json:"valid_to,omitempty"`
+}
+
+type TrustCertSearchResponse struct {
+	Rows     []*TrustCert `json:"rows"`
+	RowCount int32        `json:"rowCount"`
+	Total    int32        `json:"total"`
+}
+
+type TrustCertSaveRequest struct {
+	Cert *TrustCertSavePayload `json:"cert"`
+}
+
+type TrustCertSavePayload struct {
+	Action     string `json:"action"`
+	Descr      string `json:"descr"`
+	CrtPayload string `json:"crt_payload"`
+	PrvPayload string `json:"prv_payload"`
+}
+
+type TrustCertSaveResponse struct {
+	BaseResponse
+	Uuid string `json:"uuid,omitempty"`
+}
+
+type ServiceActionResponse struct {
+	BaseResponse
+	Status string `json:"status,omitempty"`
+}
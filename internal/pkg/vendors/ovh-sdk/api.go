@@ -0,0 +1,68 @@
+package ovhsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetIpLoadbalancing(serviceName string) (*GetIpLoadbalancingResponse, error) {
+	resp := &GetIpLoadbalancingResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/ipLoadbalancing/%s", url.PathEscape(serviceName)), nil, nil, resp)
+	return resp, err
+}
+
+func (c *Client) CreateIpLoadbalancingSsl(serviceName string, req *CreateIpLoadbalancingSslRequest) (*CreateIpLoadbalancingSslResponse, error) {
+	resp := &CreateIpLoadbalancingSslResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/ipLoadbalancing/%s/ssl", url.PathEscape(serviceName)), nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) GetIpLoadbalancingHttpFrontend(serviceName string, frontendId int64) (*GetIpLoadbalancingHttpFrontendResponse, error) {
+	resp := &GetIpLoadbalancingHttpFrontendResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/ipLoadbalancing/%s/http/frontend/%d", url.PathEscape(serviceName), frontendId), nil, nil, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateIpLoadbalancingHttpFrontend(serviceName string, frontendId int64, req *UpdateIpLoadbalancingHttpFrontendRequest) error {
+	_, err := c.sendRequest(http.MethodPut, fmt.Sprintf("/ipLoadbalancing/%s/http/frontend/%d", url.PathEscape(serviceName), frontendId), nil, req)
+	return err
+}
+
+func (c *Client) RefreshIpLoadbalancing(serviceName string, req *RefreshIpLoadbalancingRequest) (*RefreshIpLoadbalancingResponse, error) {
+	resp := &RefreshIpLoadbalancingResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/ipLoadbalancing/%s/refresh", url.PathEscape(serviceName)), nil, req, resp)
+	return resp, err
+}
+
+// 获取虚拟主机 SSL 证书信息。
+// 若未配置证书，则返回 nil。
+func (c *Client) GetHostingWebSsl(serviceName string) (*GetHostingWebSslResponse, error) {
+	httpResp, err := c.sendRequest(http.MethodGet, fmt.Sprintf("/hosting/web/%s/ssl", url.PathEscape(serviceName)), nil, nil)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode() == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resp := &GetHostingWebSslResponse{}
+	if err := json.Unmarshal(httpResp.Body(), resp); err != nil {
+		return nil, fmt.Errorf("ovh api error: failed to parse response: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) CreateHostingWebSsl(serviceName string, req *CreateHostingWebSslRequest) (*CreateHostingWebSslResponse, error) {
+	resp := &CreateHostingWebSslResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/hosting/web/%s/ssl", url.PathEscape(serviceName)), nil, req, resp)
+	return resp, err
+}
+
+func (c *Client) DeleteHostingWebSsl(serviceName string) (*DeleteHostingWebSslResponse, error) {
+	resp := &DeleteHostingWebSslResponse{}
+	err := c.sendRequestWithResult(http.MethodDelete, fmt.Sprintf("/hosting/web/%s/ssl", url.PathEscape(serviceName)), nil, nil, resp)
+	return resp, err
+}
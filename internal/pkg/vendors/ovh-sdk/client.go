@@ -0,0 +1,128 @@
+package ovhsdk
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	endpoint          string
+	applicationKey    string
+	applicationSecret string
+	consumerKey       string
+
+	client *resty.Client
+}
+
+func NewClient(endpoint, applicationKey, applicationSecret, consumerKey string) (*Client, error) {
+	apiUrl, err := resolveEndpoint(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	client := resty.New()
+
+	return &Client{
+		endpoint:          apiUrl,
+		applicationKey:    applicationKey,
+		applicationSecret: applicationSecret,
+		consumerKey:       consumerKey,
+		client:            client,
+	}, nil
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, queryParams map[string]string, params interface{}) (*resty.Response, error) {
+	reqUrl := c.endpoint + path
+	if len(queryParams) > 0 {
+		values := url.Values{}
+		for k, v := range queryParams {
+			values.Set(k, v)
+		}
+		reqUrl = reqUrl + "?" + values.Encode()
+	}
+
+	reqBody := ""
+	if method != http.MethodGet && params != nil {
+		jsonb, err := json.Marshal(params)
+		if err != nil {
+			return nil, fmt.Errorf("ovh api error: failed to marshal request: %w", err)
+		}
+		reqBody = string(jsonb)
+	}
+
+	// 签名算法
+	// REF: https://help.ovhcloud.com/csm/en-gb-api-getting-started-ovhcloud-api?id=kb_article_view&sysparm_article=KB0042784
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	signHash := sha1.Sum([]byte(strings.Join([]string{c.applicationSecret, c.consumerKey, method, reqUrl, reqBody, timestamp}, "+")))
+	signature := "$1$" + hex.EncodeToString(signHash[:])
+
+	req := c.client.R()
+	req.Method = method
+	req.URL = reqUrl
+	req = req.
+		SetHeader("Accept", "application/json").
+		SetHeader("X-Ovh-Application", c.applicationKey).
+		SetHeader("X-Ovh-Consumer", c.consumerKey).
+		SetHeader("X-Ovh-Timestamp", timestamp).
+		SetHeader("X-Ovh-Signature", signature)
+	if reqBody != "" {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(reqBody)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return resp, fmt.Errorf("ovh api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("ovh api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, queryParams map[string]string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, queryParams, params)
+	if err != nil {
+		return err
+	}
+
+	if result != nil {
+		if err := json.Unmarshal(resp.Body(), result); err != nil {
+			return fmt.Errorf("ovh api error: failed to parse response: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func resolveEndpoint(endpoint string) (string, error) {
+	switch endpoint {
+	case "", "ovh-eu":
+		return "https://eu.api.ovh.com/1.0", nil
+	case "ovh-ca":
+		return "https://ca.api.ovh.com/1.0", nil
+	case "ovh-us":
+		return "https://api.us.ovhcloud.com/1.0", nil
+	}
+
+	if strings.HasPrefix(endpoint, "https://") || strings.HasPrefix(endpoint, "http://") {
+		return strings.TrimRight(endpoint, "/"), nil
+	}
+
+	return "", fmt.Errorf("ovh api error: unknown endpoint '%s'", endpoint)
+}
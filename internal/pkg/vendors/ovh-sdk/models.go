@@ -0,0 +1,95 @@
+package ovhsdk
+
+type IpLoadbalancingInfo struct {
+	ServiceName     string   `json:"serviceName"`
+	DisplayName     string   `json:"displayName"`
+	IpLoadbalancing string   `json:"ipLoadbalancing"`
+	State           string   `json:"state"`
+	Zone            []string `json:"zone"`
+}
+
+type IpLoadbalancingSslInfo struct {
+	Id          int64    `json:"id"`
+	DisplayName string   `json:"displayName"`
+	Type        string   `json:"type"`
+	Fingerprint string   `json:"fingerprint"`
+	Serial      string   `json:"serial"`
+	San         []string `json:"san"`
+	Subject     string   `json:"subject"`
+	ExpireDate  string   `json:"expireDate"`
+}
+
+type IpLoadbalancingHttpFrontendInfo struct {
+	FrontendId    int64    `json:"frontendId"`
+	DisplayName   string   `json:"displayName"`
+	Port          string   `json:"port"`
+	Zone          string   `json:"zone"`
+	Ssl           bool     `json:"ssl"`
+	DefaultSslId  *int64   `json:"defaultSslId,omitempty"`
+	AllowedSource []string `json:"allowedSource,omitempty"`
+}
+
+type GetIpLoadbalancingResponse struct {
+	IpLoadbalancingInfo
+}
+
+type CreateIpLoadbalancingSslRequest struct {
+	Certificate string  `json:"certificate"`
+	Key         string  `json:"key"`
+	Chain       *string `json:"chain,omitempty"`
+	DisplayName *string `json:"displayName,omitempty"`
+}
+
+type CreateIpLoadbalancingSslResponse struct {
+	IpLoadbalancingSslInfo
+}
+
+type GetIpLoadbalancingHttpFrontendResponse struct {
+	IpLoadbalancingHttpFrontendInfo
+}
+
+type UpdateIpLoadbalancingHttpFrontendRequest struct {
+	DefaultSslId *int64 `json:"defaultSslId,omitempty"`
+	Ssl          *bool  `json:"ssl,omitempty"`
+}
+
+type RefreshIpLoadbalancingRequest struct {
+	Zone *string `json:"zone,omitempty"`
+}
+
+type IpLoadbalancingTaskInfo struct {
+	Id       int64  `json:"id"`
+	Action   string `json:"action"`
+	Status   string `json:"status"`
+	Progress int32  `json:"progress"`
+}
+
+type RefreshIpLoadbalancingResponse struct {
+	IpLoadbalancingTaskInfo
+}
+
+type HostingWebSslInfo struct {
+	Status       string `json:"status"`
+	Provider     string `json:"provider"`
+	Type         string `json:"type"`
+	IsReportable bool   `json:"isReportable"`
+	TaskId       int64  `json:"taskId,omitempty"`
+}
+
+type GetHostingWebSslResponse struct {
+	HostingWebSslInfo
+}
+
+type CreateHostingWebSslRequest struct {
+	Certificate string  `json:"certificate"`
+	Key         string  `json:"key"`
+	Chain       *string `json:"chain,omitempty"`
+}
+
+type CreateHostingWebSslResponse struct {
+	HostingWebSslInfo
+}
+
+type DeleteHostingWebSslResponse struct {
+	HostingWebSslInfo
+}
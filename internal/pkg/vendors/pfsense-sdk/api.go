@@ -0,0 +1,52 @@
+package pfsensesdk
+
+import (
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) CertificateList(descr string) (*CertificateListResponse, error) {
+	resp := CertificateListResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/system/certificates?limit=0&descr="+url.QueryEscape(descr), nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateCreate(req *CertificateCreateRequest) (*CertificateResponse, error) {
+	resp := CertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/system/certificate", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateUpdate(req *CertificateUpdateRequest) (*CertificateResponse, error) {
+	resp := CertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, "/system/certificate", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 更新 Web 管理界面设置。pfSense 会在设置变更后自动重启 Web 管理界面。
+func (c *Client) WebGUISettingsUpdate(req *WebGUISettingsUpdateRequest) (*WebGUISettingsResponse, error) {
+	resp := WebGUISettingsResponse{}
+	err := c.sendRequestWithResult(http.MethodPatch, "/system/webgui/settings", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) HAProxyApply() (*HAProxyApplyResponse, error) {
+	resp := HAProxyApplyResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/services/haproxy/apply", map[string]any{}, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
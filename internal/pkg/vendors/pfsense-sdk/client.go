@@ -0,0 +1,81 @@
+package pfsensesdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 pfSense REST API 客户端。需在 pfSense 上安装 pfSense-pkg-RESTAPI v2 扩展包。
+//
+// 入参：
+//   - serverUrl：pfSense 管理地址，如 "https://192.168.1.1"。
+//   - apiKey：API 密钥。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiKey string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/api/v2").
+		SetHeader("X-API-Key", apiKey)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("pfsense api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &BaseResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("pfsense api error: unexpected status code: %d, %s: %s", resp.StatusCode(), errResp.ResponseId, errResp.Message)
+		}
+
+		return resp, fmt.Errorf("pfsense api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("pfsense api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
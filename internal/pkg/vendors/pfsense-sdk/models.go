@@ -0,0 +1,58 @@
+package pfsensesdk
+
+type BaseResponse struct {
+	Code       int32  `json:"code"`
+	Status     string `json:"status"`
+	ResponseId string `json:"response_id"`
+	Message    string `json:"message"`
+}
+
+type Certificate struct {
+	Id    int32  `json:"id"`
+	RefId string `json:"refid"`
+	Descr string `json:"descr"`
+	Type  string `json:"type,omitempty"`
+}
+
+type CertificateListResponse struct {
+	BaseResponse
+	Data []*Certificate `json:"data"`
+}
+
+type CertificateCreateRequest struct {
+	Descr string `json:"descr"`
+	Crt   string `json:"crt"`
+	Prv   string `json:"prv"`
+}
+
+type CertificateUpdateRequest struct {
+	Id    int32  `json:"id"`
+	Descr string `json:"descr,omitempty"`
+	Crt   string `json:"crt"`
+	Prv   string `json:"prv"`
+}
+
+type CertificateResponse struct {
+	BaseResponse
+	Data *Certificate `json:"data"`
+}
+
+type WebGUISettingsUpdateRequest struct {
+	SslCertRef string `json:"sslcertref"`
+}
+
+type WebGUISettingsResponse struct {
+	BaseResponse
+	Data *struct {
+		Protocol   string `json:"protocol"`
+		Port       string `json:"port"`
+		SslCertRef string `json:"sslcertref"`
+	} `json:"data"`
+}
+
+type HAProxyApplyResponse struct {
+	BaseResponse
+	Data *struct {
+		Applied bool `json:"applied"`
+	} `json:"data"`
+}
@@ -0,0 +1,60 @@
+package plesksdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+func (c *Client) ServerInfo() (*ServerInfo, error) {
+	resp := ServerInfo{}
+	err := c.sendRequestWithResult(http.MethodGet, "/server", nil, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) DomainList(name string) ([]*Domain, error) {
+	queryParams := make(map[string]string)
+	if name != "" {
+		queryParams["name"] = name
+	}
+
+	resp := make([]*Domain, 0)
+	err := c.sendRequestWithResult(http.MethodGet, "/domains", queryParams, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (c *Client) CliCall(command string, req *CliCallRequest) (*CliCallResponse, error) {
+	resp := CliCallResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/cli/%s/call", url.PathEscape(command)), nil, req, &resp)
+	if err != nil {
+		return nil, err
+	} else if resp.Code != 0 {
+		return &resp, fmt.Errorf("plesk api error: cli command '%s' exited with code %d: %s", command, resp.Code, strings.TrimSpace(resp.Stderr))
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateInstall(req *CertificateInstallRequest) (*CertificateInstallResponse, error) {
+	packet := certificateInstallPacket{}
+	packet.Certificate.Install.Name = req.Name
+	packet.Certificate.Install.Webspace = req.Webspace
+	packet.Certificate.Install.Content.Pvt = req.PrivKey
+	packet.Certificate.Install.Content.Cert = req.Cert
+	packet.Certificate.Install.Content.CA = req.CACert
+
+	resp := CertificateInstallResponse{}
+	err := c.sendXmlRequest(&packet, &resp)
+	if err != nil {
+		return nil, err
+	} else if resp.Certificate.Install.Result.Status != "ok" {
+		return &resp, fmt.Errorf("plesk xml api error: %d: %s", resp.Certificate.Install.Result.ErrCode, resp.Certificate.Install.Result.ErrText)
+	}
+	return &resp, nil
+}
@@ -0,0 +1,112 @@
+package plesksdk
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 Plesk API 客户端。同时支持 REST API 与 XML API，二者共用同一个 API 密钥。
+//
+// 入参：
+//   - serverUrl：Plesk 地址，如 "https://example.com:8443"。
+//   - apiKey：API 密钥。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiKey string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")).
+		SetHeader("X-API-Key", apiKey).
+		SetHeader("KEY", apiKey)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, queryParams map[string]string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = "/api/v2" + path
+	if queryParams != nil {
+		req = req.SetQueryParams(queryParams)
+	}
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("plesk api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && errResp.Message != "" {
+			return resp, fmt.Errorf("plesk api error: unexpected status code: %d, %d: %s", resp.StatusCode(), errResp.Code, errResp.Message)
+		}
+
+		return resp, fmt.Errorf("plesk api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, queryParams map[string]string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, queryParams, body)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("plesk api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) sendXmlRequest(packet interface{}, result interface{}) error {
+	buf := bytes.Buffer{}
+	buf.WriteString(xml.Header)
+	if err := xml.NewEncoder(&buf).Encode(packet); err != nil {
+		return fmt.Errorf("plesk xml api error: failed to encode request: %w", err)
+	}
+
+	req := c.client.R().
+		SetHeader("Content-Type", "text/xml").
+		SetHeader("HTTP_PRETTY_PRINT", "TRUE").
+		SetBody(buf.Bytes())
+	resp, err := req.Post("/enterprise/control/agent.php")
+	if err != nil {
+		return fmt.Errorf("plesk xml api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return fmt.Errorf("plesk xml api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	if err := xml.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("plesk xml api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package plesksdk
+
+import (
+	"encoding/xml"
+)
+
+type ErrorResponse struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+type ServerInfo struct {
+	Platform string `json:"platform"`
+	Hostname string `json:"hostname"`
+	Guid     string `json:"guid"`
+	Panel    struct {
+		Version  string `json:"version"`
+		Revision string `json:"revision"`
+	} `json:"panel_version"`
+}
+
+type Domain struct {
+	Id          int64  `json:"id"`
+	Name        string `json:"name"`
+	AsciiName   string `json:"ascii_name"`
+	HostingType string `json:"hosting_type"`
+	BaseDomain  *struct {
+		Id   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"base_domain,omitempty"`
+}
+
+type CliCallRequest struct {
+	Params []string          `json:"params"`
+	Env    map[string]string `json:"env,omitempty"`
+}
+
+type CliCallResponse struct {
+	Code   int32  `json:"code"`
+	Stdout string `json:"stdout"`
+	Stderr string `json:"stderr"`
+}
+
+type XmlResult struct {
+	Status  string `xml:"status"`
+	ErrCode int32  `xml:"errcode,omitempty"`
+	ErrText string `xml:"errtext,omitempty"`
+}
+
+type CertificateInstallRequest struct {
+	Name     string
+	Webspace string
+	Cert     string
+	PrivKey  string
+	CACert   string
+}
+
+type certificateInstallPacket struct {
+	XMLName     xml.Name `xml:"packet"`
+	Certificate struct {
+		Install struct {
+			Name     string `xml:"name"`
+			Webspace string `xml:"webspace"`
+			Content  struct {
+				Csr  string `xml:"csr"`
+				Pvt  string `xml:"pvt"`
+				Cert string `xml:"cert"`
+				CA   string `xml:"ca,omitempty"`
+			} `xml:"content"`
+		} `xml:"install"`
+	} `xml:"certificate"`
+}
+
+type CertificateInstallResponse struct {
+	XMLName     xml.Name `xml:"packet"`
+	Certificate struct {
+		Install struct {
+			Result XmlResult `xml:"result"`
+		} `xml:"install"`
+	} `xml:"certificate"`
+}
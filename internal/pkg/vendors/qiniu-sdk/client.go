@@ -10,7 +10,10 @@ import (
 	"github.com/qiniu/go-sdk/v7/client"
 )
 
-const qiniuHost = "https://api.qiniu.com"
+const (
+	qiniuHost   = "https://api.qiniu.com"
+	qiniuUcHost = "https://uc.qiniuapi.com"
+)
 
 type Client struct {
 	client *client.Client
@@ -78,8 +81,26 @@ func (c *Client) UploadSslCert(ctx context.Context, name string, commonName stri
 	return resp, nil
 }
 
+func (c *Client) BindBucketCert(ctx context.Context, domain string, certId string) (*BindBucketCertResponse, error) {
+	req := &BindBucketCertRequest{
+		CertID: certId,
+		Domain: domain,
+	}
+	resp := new(BindBucketCertResponse)
+	if err := c.client.CallWithJson(ctx, resp, http.MethodPost, c.ucUrlf("cert/bind"), nil, req); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 func (c *Client) urlf(pathf string, pathargs ...any) string {
 	path := fmt.Sprintf(pathf, pathargs...)
 	path = strings.TrimPrefix(path, "/")
 	return qiniuHost + "/" + path
 }
+
+func (c *Client) ucUrlf(pathf string, pathargs ...any) string {
+	path := fmt.Sprintf(pathf, pathargs...)
+	path = strings.TrimPrefix(path, "/")
+	return qiniuUcHost + "/" + path
+}
@@ -52,3 +52,12 @@ type EnableDomainHttpsRequest struct {
 type EnableDomainHttpsResponse struct {
 	BaseResponse
 }
+
+type BindBucketCertRequest struct {
+	CertID string `json:"certid"`
+	Domain string `json:"domain"`
+}
+
+type BindBucketCertResponse struct {
+	BaseResponse
+}
@@ -0,0 +1,19 @@
+package softethersdk
+
+func (c *Client) GetServerCert(req *GetServerCertRequest) (*GetServerCertResponse, error) {
+	resp := GetServerCertResponse{}
+	err := c.sendRequestWithResult("GetServerCert", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) SetServerCert(req *SetServerCertRequest) (*SetServerCertResponse, error) {
+	resp := SetServerCertResponse{}
+	err := c.sendRequestWithResult("SetServerCert", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,75 @@
+package softethersdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	serverUrl     string
+	adminPassword string
+
+	client *resty.Client
+}
+
+func NewClient(serverUrl, adminPassword string) *Client {
+	client := resty.New()
+
+	return &Client{
+		serverUrl:     strings.TrimRight(serverUrl, "/"),
+		adminPassword: adminPassword,
+		client:        client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, params interface{}) (*resty.Response, error) {
+	url := c.serverUrl + "/api/"
+	req := c.client.R().
+		SetHeader("Content-Type", "application/json").
+		SetHeader("X-VPNADMIN-HUBNAME", "").
+		SetHeader("X-VPNADMIN-PASSWORD", c.adminPassword).
+		SetBody(map[string]interface{}{
+			"jsonrpc": "2.0",
+			"id":      "rpc_call_id",
+			"method":  method,
+			"params":  params,
+		})
+	resp, err := req.Post(url)
+	if err != nil {
+		return nil, fmt.Errorf("softether api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("softether api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, params interface{}, result BaseResponse) error {
+	resp, err := c.sendRequest(method, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), &result); err != nil {
+		return fmt.Errorf("softether api error: failed to parse response: %w", err)
+	} else if rpcErr := result.GetError(); rpcErr != nil {
+		return fmt.Errorf("softether api error: %d - %s", rpcErr.Code, rpcErr.Message)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package softethersdk
+
+type BaseResponse interface {
+	GetError() *ErrorInfo
+}
+
+type ErrorInfo struct {
+	Code    int32  `json:"code"`
+	Message string `json:"message"`
+}
+
+type baseResponse struct {
+	JsonRpc string     `json:"jsonrpc"`
+	Id      string     `json:"id"`
+	Error   *ErrorInfo `json:"error,omitempty"`
+}
+
+func (r *baseResponse) GetError() *ErrorInfo {
+	return r.Error
+}
+
+type KeyPair struct {
+	Cert string `json:"Cert_bin"`
+	Key  string `json:"Key_bin"`
+}
+
+type GetServerCertRequest struct{}
+
+type GetServerCertResponse struct {
+	baseResponse
+	Result *KeyPair `json:"result,omitempty"`
+}
+
+type SetServerCertRequest struct {
+	Flag1 bool `json:"Flag1_bool"`
+	KeyPair
+}
+
+type SetServerCertResponse struct {
+	baseResponse
+	Result *KeyPair `json:"result,omitempty"`
+}
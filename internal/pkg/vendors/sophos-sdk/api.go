@@ -0,0 +1,82 @@
+package sophossdk
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+)
+
+// 获取指定类型的全部实体。
+func (c *Client) Get(entity string) ([]*Node, error) {
+	reqXml, err := c.buildRequestXml("Get", nil, []*Node{NewNode(entity, "")})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.sendRequest(c.client.R().SetFormData(map[string]string{"reqxml": reqXml}))
+	if err != nil {
+		return nil, err
+	}
+
+	nodes := make([]*Node, 0)
+	for _, node := range resp.Nodes {
+		if node.XMLName.Local == entity {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes, nil
+}
+
+// 新增或更新实体。
+//
+// 入参：
+//   - operation：操作类型，可取值 "add"、"update"。
+//   - nodes：实体节点。
+func (c *Client) Set(operation string, nodes []*Node) error {
+	if len(nodes) == 0 {
+		return errors.New("sophos api error: empty entities")
+	}
+
+	// 写回时去掉服务端返回的 transactionid 等属性
+	for _, node := range nodes {
+		node.Attrs = nil
+	}
+
+	reqXml, err := c.buildRequestXml("Set", []xml.Attr{{Name: xml.Name{Local: "operation"}, Value: operation}}, nodes)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.sendRequest(c.client.R().SetFormData(map[string]string{"reqxml": reqXml}))
+	return err
+}
+
+// 上传 PKCS#12 格式的证书。
+//
+// 入参：
+//   - name：证书名称。
+//   - pfxData：PFX 文件内容。
+//   - pfxPassword：PFX 文件密码。
+func (c *Client) UploadCertificate(name string, pfxData []byte, pfxPassword string) error {
+	fileName := name + ".p12"
+	certificate := NewNode("Certificate", "")
+	certificate.Nodes = []*Node{
+		NewNode("Action", "UploadCertificate"),
+		NewNode("Name", name),
+		NewNode("CertificateFormat", "pkcs12"),
+		NewNode("Password", pfxPassword),
+		NewNode("CertificateFile", fileName),
+	}
+
+	reqXml, err := c.buildRequestXml("Set", []xml.Attr{{Name: xml.Name{Local: "operation"}, Value: "add"}}, []*Node{certificate})
+	if err != nil {
+		return err
+	}
+
+	req := c.client.R().
+		SetMultipartFormData(map[string]string{"reqxml": reqXml}).
+		SetFileReader("file", fileName, bytes.NewReader(pfxData))
+	_, err = c.sendRequest(req)
+	return err
+}
@@ -0,0 +1,119 @@
+package sophossdk
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	username string
+	password string
+
+	client *resty.Client
+}
+
+// 创建 Sophos Firewall XML API 客户端。
+//
+// 入参：
+//   - serverUrl：Web 管理控制台地址，如 "https://192.168.1.1:4444"。
+//   - username：用户名。
+//   - password：密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, username, password string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/") + "/webconsole/APIController")
+
+	return &Client{
+		username: username,
+		password: password,
+		client:   client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) buildRequestXml(operation string, attrs []xml.Attr, nodes []*Node) (string, error) {
+	type login struct {
+		Username string `xml:"Username"`
+		Password string `xml:"Password"`
+	}
+	type operationNode struct {
+		XMLName xml.Name
+		Attrs   []xml.Attr `xml:",any,attr"`
+		Nodes   []*Node
+	}
+	type request struct {
+		XMLName   xml.Name      `xml:"Request"`
+		Login     login         `xml:"Login"`
+		Operation operationNode `xml:",any"`
+	}
+
+	req := &request{
+		Login: login{
+			Username: c.username,
+			Password: c.password,
+		},
+		Operation: operationNode{
+			XMLName: xml.Name{Local: operation},
+			Attrs:   attrs,
+			Nodes:   nodes,
+		},
+	}
+
+	buf := bytes.NewBuffer(nil)
+	if err := xml.NewEncoder(buf).Encode(req); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func (c *Client) sendRequest(req *resty.Request) (*Response, error) {
+	resp, err := req.Post("")
+	if err != nil {
+		return nil, fmt.Errorf("sophos api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("sophos api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	result := &Response{}
+	if err := xml.Unmarshal(resp.Body(), result); err != nil {
+		return nil, fmt.Errorf("sophos api error: failed to parse response: %w", err)
+	}
+	result.normalize()
+
+	if result.Status != nil {
+		return result, fmt.Errorf("sophos api error: code='%s', message='%s'", result.Status.Code, strings.TrimSpace(result.Status.Message))
+	}
+	if result.Login != nil && !strings.EqualFold(strings.TrimSpace(result.Login.Status), "Authentication Successful") {
+		return result, fmt.Errorf("sophos api error: login failed, %s", strings.TrimSpace(result.Login.Status))
+	}
+
+	// 每个实体节点均会返回各自的处理结果，只要有一个失败即视为请求失败
+	for _, node := range result.Nodes {
+		if status := node.Find("Status"); status != nil {
+			code := status.Attr("code")
+			if code != "" && code != "200" && code != "202" {
+				return result, fmt.Errorf("sophos api error: entity='%s', code='%s', message='%s'", node.XMLName.Local, code, strings.TrimSpace(status.Content))
+			}
+		}
+	}
+
+	return result, nil
+}
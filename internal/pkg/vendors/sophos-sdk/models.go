@@ -0,0 +1,87 @@
+package sophossdk
+
+import (
+	"encoding/xml"
+	"strings"
+)
+
+// 表示一个通用的 XML 节点。
+// Sophos Firewall XML API 的实体结构因版本而异，使用通用节点以便在读取后原样写回未知字段。
+type Node struct {
+	XMLName xml.Name
+	Attrs   []xml.Attr `xml:",any,attr"`
+	Content string     `xml:",chardata"`
+	Nodes   []*Node    `xml:",any"`
+}
+
+func NewNode(name string, content string) *Node {
+	return &Node{XMLName: xml.Name{Local: name}, Content: content}
+}
+
+// 查找第一个名称匹配的直接子节点。
+func (n *Node) Find(name string) *Node {
+	for _, child := range n.Nodes {
+		if child.XMLName.Local == name {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// 获取子节点的文本内容。
+func (n *Node) Text(name string) string {
+	if child := n.Find(name); child != nil {
+		return strings.TrimSpace(child.Content)
+	}
+
+	return ""
+}
+
+// 获取节点属性值。
+func (n *Node) Attr(name string) string {
+	for _, attr := range n.Attrs {
+		if attr.Name.Local == name {
+			return attr.Value
+		}
+	}
+
+	return ""
+}
+
+// 递归遍历节点及其所有子节点。
+func (n *Node) Walk(fn func(node *Node)) {
+	fn(n)
+	for _, child := range n.Nodes {
+		child.Walk(fn)
+	}
+}
+
+func (n *Node) normalize() {
+	// 含有子节点时，文本内容仅为缩进空白
+	if len(n.Nodes) > 0 {
+		n.Content = ""
+	}
+
+	for _, child := range n.Nodes {
+		child.normalize()
+	}
+}
+
+type Response struct {
+	XMLName xml.Name `xml:"Response"`
+	Login   *struct {
+		Status string `xml:"status"`
+	} `xml:"Login"`
+	Status *struct {
+		Code    string `xml:"code,attr"`
+		Message string `xml:",chardata"`
+	} `xml:"Status"`
+	Nodes []*Node `xml:",any"`
+}
+
+func (r *Response) normalize() {
+	for _, node := range r.Nodes {
+		node.normalize()
+	}
+}
@@ -0,0 +1,13 @@
+package apigateway
+
+func (c *Client) DescribeServiceSubDomains(req *DescribeServiceSubDomainsRequest) (*DescribeServiceSubDomainsResponse, error) {
+	result := DescribeServiceSubDomainsResponse{}
+	err := c.sendRequestWithResult("DescribeServiceSubDomains", req, &result)
+	return &result, err
+}
+
+func (c *Client) ModifySubDomain(req *ModifySubDomainRequest) (*ModifySubDomainResponse, error) {
+	result := ModifySubDomainResponse{}
+	err := c.sendRequestWithResult("ModifySubDomain", req, &result)
+	return &result, err
+}
@@ -0,0 +1,58 @@
+package apigateway
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common"
+	tchttp "github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/http"
+	"github.com/tencentcloud/tencentcloud-sdk-go/tencentcloud/common/profile"
+)
+
+const (
+	apiService = "apigateway"
+	apiVersion = "2018-08-08"
+)
+
+type Client struct {
+	client *common.Client
+}
+
+// 创建腾讯云 API 网关客户端。
+// 官方 SDK 尚未引入，此处基于通用客户端调用。
+//
+// 入参：
+//   - credential: 腾讯云访问凭证。
+//   - region: 腾讯云地域。
+//   - clientProfile: 客户端配置。
+//
+// 出参：
+//   - client: 客户端实例。
+//   - err: 错误。
+func NewClient(credential common.CredentialIface, region string, clientProfile *profile.ClientProfile) (*Client, error) {
+	client := common.NewCommonClient(credential, region, clientProfile)
+	return &Client{client: client}, nil
+}
+
+func (c *Client) sendRequestWithResult(action string, params any, result any) error {
+	data, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("tencentcloud apigateway api error: failed to marshal request: %w", err)
+	}
+
+	req := tchttp.NewCommonRequest(apiService, apiVersion, action)
+	if err := req.SetActionParameters(data); err != nil {
+		return err
+	}
+
+	resp := tchttp.NewCommonResponse()
+	if err := c.client.Send(req, resp); err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.GetBody(), result); err != nil {
+		return fmt.Errorf("tencentcloud apigateway api error: failed to unmarshal response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package apigateway
+
+type DescribeServiceSubDomainsRequest struct {
+	ServiceId *string `json:"ServiceId,omitempty"`
+	Limit     *int64  `json:"Limit,omitempty"`
+	Offset    *int64  `json:"Offset,omitempty"`
+}
+
+type DescribeServiceSubDomainsResponse struct {
+	Response *struct {
+		Result *struct {
+			TotalCount int64            `json:"TotalCount"`
+			DomainSet  []*DomainSetItem `json:"DomainSet"`
+		} `json:"Result"`
+		RequestId string `json:"RequestId"`
+	} `json:"Response"`
+}
+
+type DomainSetItem struct {
+	DomainName         string `json:"DomainName"`
+	Status             int64  `json:"Status"`
+	CertificateId      string `json:"CertificateId"`
+	IsDefaultMapping   bool   `json:"IsDefaultMapping"`
+	Protocol           string `json:"Protocol"`
+	NetType            string `json:"NetType"`
+	IsForcedHttps      bool   `json:"IsForcedHttps"`
+	RegistrationStatus bool   `json:"RegistrationStatus"`
+}
+
+type ModifySubDomainRequest struct {
+	ServiceId        *string `json:"ServiceId,omitempty"`
+	SubDomain        *string `json:"SubDomain,omitempty"`
+	IsDefaultMapping *bool   `json:"IsDefaultMapping,omitempty"`
+	CertificateId    *string `json:"CertificateId,omitempty"`
+	Protocol         *string `json:"Protocol,omitempty"`
+	NetType          *string `json:"NetType,omitempty"`
+	IsForcedHttps    *bool   `json:"IsForcedHttps,omitempty"`
+}
+
+type ModifySubDomainResponse struct {
+	Response *struct {
+		Result    bool   `json:"Result"`
+		RequestId string `json:"RequestId"`
+	} `json:"Response"`
+}
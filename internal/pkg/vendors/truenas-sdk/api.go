@@ -0,0 +1,108 @@
+package truenassdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) SystemInfo() (*SystemInfoResponse, error) {
+	resp := SystemInfoResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/system/info", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) CertificateQuery() (CertificateQueryResponse, error) {
+	resp := CertificateQueryResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/certificate", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// 导入证书。返回值为异步任务 ID，需通过 [Client.JobGet] 查询任务状态。
+func (c *Client) CertificateCreate(req *CertificateCreateRequest) (int64, error) {
+	var jobId int64
+	err := c.sendRequestWithResult(http.MethodPost, "/certificate", req, &jobId)
+	if err != nil {
+		return 0, err
+	}
+	return jobId, nil
+}
+
+// 删除证书。返回值为异步任务 ID，需通过 [Client.JobGet] 查询任务状态。
+func (c *Client) CertificateDelete(certId int64) (int64, error) {
+	var jobId int64
+	err := c.sendRequestWithResult(http.MethodDelete, fmt.Sprintf("/certificate/id/%d", certId), nil, &jobId)
+	if err != nil {
+		return 0, err
+	}
+	return jobId, nil
+}
+
+func (c *Client) SystemGeneralUpdate(req *SystemGeneralUpdateRequest) (*SystemGeneralResponse, error) {
+	resp := SystemGeneralResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/system/general", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 重启 Web 管理界面，以使新的证书生效。
+func (c *Client) SystemGeneralUIRestart() error {
+	return c.sendRequestWithResult(http.MethodGet, "/system/general/ui_restart", nil, nil)
+}
+
+func (c *Client) S3Update(req *S3UpdateRequest) (*S3Response, error) {
+	resp := S3Response{}
+	err := c.sendRequestWithResult(http.MethodPut, "/s3", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) FTPUpdate(req *FTPUpdateRequest) (*FTPResponse, error) {
+	resp := FTPResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/ftp", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 获取应用的用户配置。仅适用于 TrueNAS SCALE 24.10 及以上版本。
+func (c *Client) AppConfig(appName string) (map[string]any, error) {
+	resp := make(map[string]any)
+	err := c.sendRequestWithResult(http.MethodPost, "/app/config", appName, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// 更新应用的用户配置。返回值为异步任务 ID，需通过 [Client.JobGet] 查询任务状态。
+func (c *Client) AppUpdate(appName string, req *AppUpdateRequest) (int64, error) {
+	var jobId int64
+	err := c.sendRequestWithResult(http.MethodPut, fmt.Sprintf("/app/id/%s", url.PathEscape(appName)), req, &jobId)
+	if err != nil {
+		return 0, err
+	}
+	return jobId, nil
+}
+
+func (c *Client) JobGet(jobId int64) (*Job, error) {
+	resp := make([]*Job, 0)
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/core/get_jobs?id=%d", jobId), nil, &resp)
+	if err != nil {
+		return nil, err
+	} else if len(resp) == 0 {
+		return nil, fmt.Errorf("truenas api error: job #%d not found", jobId)
+	}
+	return resp[0], nil
+}
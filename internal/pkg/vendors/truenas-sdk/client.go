@@ -0,0 +1,80 @@
+package truenassdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 TrueNAS REST API 客户端。
+//
+// 入参：
+//   - serverUrl：TrueNAS 管理地址，如 "https://192.168.1.100"。
+//   - apiKey：API 密钥。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl, apiKey string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/")+"/api/v2.0").
+		SetHeader("Authorization", "Bearer "+apiKey)
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("truenas api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("truenas api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("truenas api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package truenassdk
+
+import (
+	"encoding/json"
+)
+
+type SystemInfoResponse struct {
+	Version  string `json:"version"`
+	Hostname string `json:"hostname"`
+}
+
+type Certificate struct {
+	Id          int64    `json:"id"`
+	Name        string   `json:"name"`
+	CertType    string   `json:"cert_type,omitempty"`
+	Common      string   `json:"common,omitempty"`
+	SAN         []string `json:"san,omitempty"`
+	From        string   `json:"from,omitempty"`
+	Until       string   `json:"until,omitempty"`
+	Expired     bool     `json:"expired"`
+	Fingerprint string   `json:"fingerprint,omitempty"`
+}
+
+type CertificateQueryResponse = []*Certificate
+
+type CertificateCreateRequest struct {
+	CreateType  string `json:"create_type"`
+	Name        string `json:"name"`
+	Certificate string `json:"certificate"`
+	Privatekey  string `json:"privatekey"`
+}
+
+type Job struct {
+	Id       int64           `json:"id"`
+	Method   string          `json:"method"`
+	State    string          `json:"state"`
+	Result   json.RawMessage `json:"result,omitempty"`
+	Error    string          `json:"error,omitempty"`
+	Progress *struct {
+		Percent     float64 `json:"percent"`
+		Description string  `json:"description"`
+	} `json:"progress,omitempty"`
+}
+
+type SystemGeneralUpdateRequest struct {
+	UICertificate int64 `json:"ui_certificate"`
+}
+
+type SystemGeneralResponse struct {
+	UICertificate *Certificate `json:"ui_certificate,omitempty"`
+}
+
+type S3UpdateRequest struct {
+	Certificate int64 `json:"certificate"`
+}
+
+type S3Response struct {
+	Certificate *int64 `json:"certificate"`
+}
+
+type FTPUpdateRequest struct {
+	TLS               bool  `json:"tls"`
+	SSLTLSCertificate int64 `json:"ssltls_certificate"`
+}
+
+type FTPResponse struct {
+	TLS               bool   `json:"tls"`
+	SSLTLSCertificate *int64 `json:"ssltls_certificate"`
+}
+
+type AppUpdateRequest struct {
+	Values map[string]any `json:"values"`
+}
@@ -0,0 +1,64 @@
+package console
+
+import (
+	"errors"
+	"net/http"
+)
+
+func (c *Client) UploadHttpsCertificate(req *UploadHttpsCertificateRequest) (*UploadHttpsCertificateResponse, error) {
+	if err := c.ensureSignedIn(); err != nil {
+		return nil, err
+	}
+
+	resp := &UploadHttpsCertificateResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/api/https/certificate/", req, resp)
+	if err != nil {
+		return resp, err
+	} else if resp.Data == nil || resp.Data.Result == nil {
+		return resp, errors.New("upyun api error: unexpected empty response data")
+	}
+
+	return resp, nil
+}
+
+func (c *Client) GetHttpsCertificateManager(certificateId string) (*GetHttpsCertificateManagerResponse, error) {
+	if err := c.ensureSignedIn(); err != nil {
+		return nil, err
+	}
+
+	req := &GetHttpsCertificateManagerRequest{CertificateId: certificateId}
+	resp := &GetHttpsCertificateManagerResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/api/https/certificate/manager/", req, resp)
+	return resp, err
+}
+
+func (c *Client) UpdateHttpsCertificateManager(req *UpdateHttpsCertificateManagerRequest) (*UpdateHttpsCertificateManagerResponse, error) {
+	if err := c.ensureSignedIn(); err != nil {
+		return nil, err
+	}
+
+	resp := &UpdateHttpsCertificateManagerResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/api/https/certificate/manager", req, resp)
+	return resp, err
+}
+
+func (c *Client) GetHttpsServiceManager(domain string) (*GetHttpsServiceManagerResponse, error) {
+	if err := c.ensureSignedIn(); err != nil {
+		return nil, err
+	}
+
+	req := &GetHttpsServiceManagerRequest{Domain: domain}
+	resp := &GetHttpsServiceManagerResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/api/https/services/manager", req, resp)
+	return resp, err
+}
+
+func (c *Client) MigrateHttpsDomain(req *MigrateHttpsDomainRequest) (*MigrateHttpsDomainResponse, error) {
+	if err := c.ensureSignedIn(); err != nil {
+		return nil, err
+	}
+
+	resp := &MigrateHttpsDomainResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, "/api/https/migrate/domain", req, resp)
+	return resp, err
+}
@@ -0,0 +1,124 @@
+package console
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const apiHost = "https://console.upyun.com"
+
+type Client struct {
+	username string
+	password string
+
+	client     *resty.Client
+	signInOnce sync.Once
+	signInErr  error
+}
+
+// 创建又拍云控制台 API 客户端。
+// 又拍云未提供证书管理相关的 OpenAPI，只能模拟控制台登录后调用其内部接口。
+//
+// 入参：
+//   - username：又拍云账号用户名。
+//   - password：又拍云账号密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(username, password string) *Client {
+	// resty 默认启用 CookieJar，登录后的会话 Cookie 会在后续请求中自动携带
+	client := resty.New().
+		SetBaseURL(apiHost).
+		SetHeader("User-Agent", "certimate")
+
+	return &Client{
+		username: username,
+		password: password,
+		client:   client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) ensureSignedIn() error {
+	c.signInOnce.Do(func() {
+		req := &signInRequest{Username: c.username, Password: c.password}
+		resp := &signInResponse{}
+		if err := c.sendRequestWithResult(http.MethodPost, "/accounts/signin/", req, resp); err != nil {
+			c.signInErr = err
+			return
+		}
+
+		if resp.Data == nil {
+			c.signInErr = errors.New("upyun api error: failed to sign in: unexpected empty response data")
+		} else if !resp.Data.Result {
+			c.signInErr = fmt.Errorf("upyun api error: failed to sign in: %s", resp.Data.Message)
+		}
+	})
+
+	return c.signInErr
+}
+
+func (c *Client) sendRequest(method string, path string, params interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if method == http.MethodGet {
+		if params != nil {
+			data, err := json.Marshal(params)
+			if err != nil {
+				return nil, fmt.Errorf("upyun api error: failed to marshal params: %w", err)
+			}
+
+			queryParams := make(map[string]interface{})
+			if err := json.Unmarshal(data, &queryParams); err != nil {
+				return nil, fmt.Errorf("upyun api error: failed to unmarshal params: %w", err)
+			}
+
+			for k, v := range queryParams {
+				req = req.SetQueryParam(k, fmt.Sprintf("%v", v))
+			}
+		}
+	} else {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(params)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("upyun api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return resp, fmt.Errorf("upyun api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("upyun api error: failed to parse response: %w", err)
+	}
+
+	if baseResp, ok := result.(baseResponseIface); ok {
+		if errCode := baseResp.GetErrorCode(); errCode != 0 {
+			return fmt.Errorf("upyun api error: %d, %s", errCode, baseResp.GetErrorMessage())
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,129 @@
+package console
+
+type baseResponseIface interface {
+	GetErrorCode() int32
+	GetErrorMessage() string
+}
+
+type baseResponse struct {
+	ErrorCode    *int32  `json:"error_code,omitempty"`
+	ErrorMessage *string `json:"message,omitempty"`
+}
+
+func (r *baseResponse) GetErrorCode() int32 {
+	if r.ErrorCode == nil {
+		return 0
+	}
+	return *r.ErrorCode
+}
+
+func (r *baseResponse) GetErrorMessage() string {
+	if r.ErrorMessage == nil {
+		return ""
+	}
+	return *r.ErrorMessage
+}
+
+type signInRequest struct {
+	Username string `json:"username"`
+	Password string `json:"password"`
+}
+
+type signInResponse struct {
+	baseResponse
+	Data *struct {
+		Result  bool   `json:"result"`
+		Message string `json:"message,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+type UploadHttpsCertificateRequest struct {
+	Certificate string `json:"certificate"`
+	PrivateKey  string `json:"private_key"`
+}
+
+type UploadHttpsCertificateResponse struct {
+	baseResponse
+	Data *struct {
+		Status int32 `json:"status"`
+		Result *struct {
+			CertificateId string `json:"certificate_id"`
+			CommonName    string `json:"commonName"`
+			Serial        string `json:"serial_number"`
+		} `json:"result,omitempty"`
+	} `json:"data,omitempty"`
+}
+
+type GetHttpsCertificateManagerRequest struct {
+	CertificateId string `json:"certificate_id"`
+}
+
+type GetHttpsCertificateManagerResponse struct {
+	baseResponse
+	Data *struct {
+		Status  int32                        `json:"status"`
+		Domains []HttpsCertificateDomainInfo `json:"domains"`
+	} `json:"data,omitempty"`
+}
+
+type HttpsCertificateDomainInfo struct {
+	Name       string `json:"name"`
+	Type       string `json:"type"`
+	BucketId   int64  `json:"bucket_id"`
+	BucketName string `json:"bucket_name"`
+	Https      bool   `json:"https"`
+	ForceHttps bool   `json:"force_https"`
+}
+
+type UpdateHttpsCertificateManagerRequest struct {
+	CertificateId string `json:"certificate_id"`
+	Domain        string `json:"domain"`
+	Https         bool   `json:"https"`
+	ForceHttps    bool   `json:"force_https"`
+}
+
+type UpdateHttpsCertificateManagerResponse struct {
+	baseResponse
+	Data *struct {
+		Status int32 `json:"status"`
+		Result bool  `json:"result"`
+	} `json:"data,omitempty"`
+}
+
+type GetHttpsServiceManagerRequest struct {
+	Domain string `json:"domain"`
+}
+
+type GetHttpsServiceManagerResponse struct {
+	baseResponse
+	Data *struct {
+		Status  int32                     `json:"status"`
+		Domains []HttpsServiceManagerInfo `json:"result"`
+	} `json:"data,omitempty"`
+}
+
+type HttpsServiceManagerInfo struct {
+	CertificateId string `json:"certificate_id"`
+	CommonName    string `json:"commonName"`
+	Https         bool   `json:"https"`
+	ForceHttps    bool   `json:"force_https"`
+	PaymentType   string `json:"payment_type"`
+	DomainType    string `json:"domain_type"`
+	Validity      struct {
+		Start int64 `json:"start"`
+		End   int64 `json:"end"`
+	} `json:"validity"`
+}
+
+type MigrateHttpsDomainRequest struct {
+	CertificateId string `json:"crt_id"`
+	Domain        string `json:"domain_name"`
+}
+
+type MigrateHttpsDomainResponse struct {
+	baseResponse
+	Data *struct {
+		Status int32 `json:"status"`
+		Result bool  `json:"result"`
+	} `json:"data,omitempty"`
+}
@@ -0,0 +1,60 @@
+package vaultsdk
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+func (c *Client) AppRoleLogin(mountPath string, req *AppRoleLoginRequest) (*AppRoleLoginResponse, error) {
+	resp := AppRoleLoginResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/auth/%s/login", trimPath(mountPath)), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) TokenLookupSelf() (*TokenLookupSelfResponse, error) {
+	resp := TokenLookupSelfResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, "/auth/token/lookup-self", nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// 读取 KV v2 机密的最新版本。机密不存在时返回的 Data 为 nil。
+func (c *Client) KVv2Read(mountPath string, secretPath string) (*KVv2ReadResponse, error) {
+	resp := KVv2ReadResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/%s/data/%s", trimPath(mountPath), trimPath(secretPath)), nil, &resp)
+	if err != nil {
+		if errResp, ok := err.(*ResponseError); ok && errResp.StatusCode == http.StatusNotFound {
+			return &KVv2ReadResponse{}, nil
+		}
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) KVv2Write(mountPath string, secretPath string, req *KVv2WriteRequest) (*KVv2WriteResponse, error) {
+	resp := KVv2WriteResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/%s/data/%s", trimPath(mountPath), trimPath(secretPath)), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (c *Client) PKIImportBundle(mountPath string, req *PKIImportBundleRequest) (*PKIImportBundleResponse, error) {
+	resp := PKIImportBundleResponse{}
+	err := c.sendRequestWithResult(http.MethodPost, fmt.Sprintf("/%s/issuers/import/bundle", trimPath(mountPath)), req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func trimPath(path string) string {
+	return strings.Trim(path, "/")
+}
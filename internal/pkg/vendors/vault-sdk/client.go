@@ -0,0 +1,105 @@
+package vaultsdk
+
+import (
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	client *resty.Client
+}
+
+// 创建 HashiCorp Vault HTTP API 客户端。
+//
+// 入参：
+//   - serverUrl：Vault 服务地址，如 "https://127.0.0.1:8200"。
+//
+// 出参：
+//   - 客户端。
+func NewClient(serverUrl string) *Client {
+	client := resty.New().
+		SetBaseURL(strings.TrimRight(serverUrl, "/") + "/v1")
+
+	return &Client{
+		client: client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.client.SetTLSClientConfig(config)
+	return c
+}
+
+// 设置命名空间，仅适用于 Vault Enterprise 或 HCP Vault。
+func (c *Client) WithNamespace(namespace string) *Client {
+	if namespace != "" {
+		c.client.SetHeader("X-Vault-Namespace", namespace)
+	}
+	return c
+}
+
+func (c *Client) WithToken(token string) *Client {
+	c.client.SetHeader("X-Vault-Token", token)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, body interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = path
+	if body != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(body)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("vault api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		errResp := &ErrorResponse{}
+		if json.Unmarshal(resp.Body(), errResp) == nil && len(errResp.Errors) > 0 {
+			return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: strings.Join(errResp.Errors, "; ")}
+		}
+
+		return resp, &ResponseError{StatusCode: resp.StatusCode(), Message: string(resp.Body())}
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, body interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, body)
+	if err != nil {
+		return err
+	}
+
+	if result == nil || len(resp.Body()) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("vault api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
+
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("vault api error: unexpected status code: %d, %s", e.StatusCode, e.Message)
+}
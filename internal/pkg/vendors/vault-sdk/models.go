@@ -0,0 +1,82 @@
+package vaultsdk
+
+type ErrorResponse struct {
+	Errors []string `json:"errors"`
+}
+
+type baseResponse struct {
+	RequestId string   `json:"request_id"`
+	Warnings  []string `json:"warnings,omitempty"`
+}
+
+type AuthInfo struct {
+	ClientToken   string   `json:"client_token"`
+	Accessor      string   `json:"accessor"`
+	Policies      []string `json:"policies"`
+	LeaseDuration int64    `json:"lease_duration"`
+	Renewable     bool     `json:"renewable"`
+}
+
+type AppRoleLoginRequest struct {
+	RoleId   string `json:"role_id"`
+	SecretId string `json:"secret_id,omitempty"`
+}
+
+type AppRoleLoginResponse struct {
+	baseResponse
+	Auth *AuthInfo `json:"auth"`
+}
+
+type TokenLookupSelfResponse struct {
+	baseResponse
+	Data *struct {
+		Accessor    string   `json:"accessor"`
+		DisplayName string   `json:"display_name"`
+		Policies    []string `json:"policies"`
+		TTL         int64    `json:"ttl"`
+	} `json:"data"`
+}
+
+type KVv2Metadata struct {
+	CreatedTime  string `json:"created_time"`
+	DeletionTime string `json:"deletion_time"`
+	Destroyed    bool   `json:"destroyed"`
+	Version      int64  `json:"version"`
+}
+
+type KVv2ReadResponse struct {
+	baseResponse
+	Data *struct {
+		Data     map[string]any `json:"data"`
+		Metadata *KVv2Metadata  `json:"metadata"`
+	} `json:"data"`
+}
+
+type KVv2WriteRequest struct {
+	Data    map[string]any    `json:"data"`
+	Options *KVv2WriteOptions `json:"options,omitempty"`
+}
+
+type KVv2WriteOptions struct {
+	Cas *int64 `json:"cas,omitempty"`
+}
+
+type KVv2WriteResponse struct {
+	baseResponse
+	Data *KVv2Metadata `json:"data"`
+}
+
+type PKIImportBundleRequest struct {
+	PemBundle string `json:"pem_bundle"`
+}
+
+type PKIImportBundleResponse struct {
+	baseResponse
+	Data *struct {
+		ImportedIssuers []string          `json:"imported_issuers"`
+		ImportedKeys    []string          `json:"imported_keys"`
+		Mapping         map[string]string `json:"mapping"`
+		ExistingIssuers []string          `json:"existing_issuers"`
+		ExistingKeys    []string          `json:"existing_keys"`
+	} `json:"data"`
+}
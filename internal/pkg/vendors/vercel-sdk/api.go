@@ -0,0 +1,19 @@
+package vercelsdk
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+func (c *Client) GetProjectDomain(projectIdOrName string, domain string) (*GetProjectDomainResponse, error) {
+	resp := &GetProjectDomainResponse{}
+	err := c.sendRequestWithResult(http.MethodGet, fmt.Sprintf("/v9/projects/%s/domains/%s", url.PathEscape(projectIdOrName), url.PathEscape(domain)), nil, resp)
+	return resp, err
+}
+
+func (c *Client) UploadCert(req *UploadCertRequest) (*UploadCertResponse, error) {
+	resp := &UploadCertResponse{}
+	err := c.sendRequestWithResult(http.MethodPut, "/v7/certs", req, resp)
+	return resp, err
+}
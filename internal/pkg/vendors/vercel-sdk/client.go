@@ -0,0 +1,71 @@
+package vercelsdk
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type Client struct {
+	apiAccessToken string
+	teamId         string
+
+	client *resty.Client
+}
+
+func NewClient(apiAccessToken, teamId string) *Client {
+	client := resty.New()
+
+	return &Client{
+		apiAccessToken: apiAccessToken,
+		teamId:         teamId,
+		client:         client,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) sendRequest(method string, path string, params interface{}) (*resty.Response, error) {
+	req := c.client.R()
+	req.Method = method
+	req.URL = "https://api.vercel.com" + path
+	req = req.
+		SetHeader("Accept", "application/json").
+		SetHeader("Authorization", "Bearer "+c.apiAccessToken)
+	if c.teamId != "" {
+		req = req.SetQueryParam("teamId", c.teamId)
+	}
+	if method != http.MethodGet && params != nil {
+		req = req.
+			SetHeader("Content-Type", "application/json").
+			SetBody(params)
+	}
+
+	resp, err := req.Send()
+	if err != nil {
+		return nil, fmt.Errorf("vercel api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		return nil, fmt.Errorf("vercel api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(method string, path string, params interface{}, result interface{}) error {
+	resp, err := c.sendRequest(method, path, params)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("vercel api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package vercelsdk
+
+type ProjectDomainInfo struct {
+	Name      string `json:"name"`
+	ApexName  string `json:"apexName"`
+	ProjectId string `json:"projectId"`
+	Verified  bool   `json:"verified"`
+	CreatedAt int64  `json:"createdAt"`
+	UpdatedAt int64  `json:"updatedAt"`
+}
+
+type CertInfo struct {
+	Id        string   `json:"id"`
+	Cns       []string `json:"cns"`
+	AutoRenew bool     `json:"autoRenew"`
+	CreatedAt int64    `json:"createdAt"`
+	ExpiresAt int64    `json:"expiresAt"`
+}
+
+type GetProjectDomainResponse struct {
+	ProjectDomainInfo
+}
+
+type UploadCertRequest struct {
+	Ca             string `json:"ca"`
+	Cert           string `json:"cert"`
+	Key            string `json:"key"`
+	SkipValidation bool   `json:"skipValidation,omitempty"`
+}
+
+type UploadCertResponse struct {
+	CertInfo
+}
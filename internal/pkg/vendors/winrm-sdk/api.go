@@ -0,0 +1,221 @@
+package winrmsdk
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf16"
+)
+
+const (
+	xmlnsShell = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell"
+
+	resourceUriCmd = "http://schemas.microsoft.com/wbem/wsman/1/windows/shell/cmd"
+
+	actionCreate  = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Create"
+	actionDelete  = "http://schemas.xmlsoap.org/ws/2004/09/transfer/Delete"
+	actionCommand = xmlnsShell + "/Command"
+	actionSend    = xmlnsShell + "/Send"
+	actionReceive = xmlnsShell + "/Receive"
+	actionSignal  = xmlnsShell + "/Signal"
+
+	commandStateDone = xmlnsShell + "/CommandState/Done"
+	signalTerminate  = xmlnsShell + "/signal/terminate"
+
+	// 接收输出超时的错误码，此时需继续接收
+	faultCodeOperationTimeout = "2150858793"
+)
+
+// 在远程主机上执行 PowerShell 脚本。
+// 脚本通过标准输入传递，因此不受 Windows 命令行长度限制。
+func (c *Client) RunPowerShell(script string) (*CommandResult, error) {
+	shellId, err := c.createShell()
+	if err != nil {
+		return nil, err
+	}
+	defer c.deleteShell(shellId)
+
+	// 引导脚本：从标准输入读取 Base64 编码的脚本内容并执行
+	bootstrap := strings.Join([]string{
+		"$ErrorActionPreference = 'Stop'",
+		"$ProgressPreference = 'SilentlyContinue'",
+		"$s = $input | Out-String",
+		"& ([ScriptBlock]::Create([Text.Encoding]::UTF8.GetString([Convert]::FromBase64String($s.Trim()))))",
+	}, "; ")
+	commandId, err := c.runCommand(shellId, "powershell.exe", "-NoLogo -NoProfile -NonInteractive -ExecutionPolicy Bypass -EncodedCommand "+encodePowerShellCommand(bootstrap))
+	if err != nil {
+		return nil, err
+	}
+	defer c.signalTerminate(shellId, commandId)
+
+	if err := c.sendInput(shellId, commandId, []byte(base64.StdEncoding.EncodeToString([]byte(script))+"\r\n")); err != nil {
+		return nil, err
+	}
+
+	return c.receiveOutput(shellId, commandId)
+}
+
+func (c *Client) createShell() (string, error) {
+	body := `<rsp:Shell xmlns:rsp="` + xmlnsShell + `"><rsp:InputStreams>stdin</rsp:InputStreams><rsp:OutputStreams>stdout stderr</rsp:OutputStreams></rsp:Shell>`
+	options := map[string]string{"WINRS_NOPROFILE": "FALSE", "WINRS_CODEPAGE": "65001"}
+
+	resp := &soapEnvelope{}
+	if err := c.sendRequestWithResult(c.buildEnvelope(actionCreate, "", options, body), resp); err != nil {
+		return "", err
+	}
+
+	if resp.Body.Shell != nil && resp.Body.Shell.ShellId != "" {
+		return resp.Body.Shell.ShellId, nil
+	}
+	if resp.Body.ResourceCreated != nil {
+		for _, selector := range resp.Body.ResourceCreated.ReferenceParameters.SelectorSet.Selectors {
+			if selector.Name == "ShellId" {
+				return selector.Value, nil
+			}
+		}
+	}
+
+	return "", errors.New("winrm api error: shell id not found in response")
+}
+
+func (c *Client) deleteShell(shellId string) error {
+	_, err := c.sendRequest(c.buildEnvelope(actionDelete, shellId, nil, ""))
+	return err
+}
+
+func (c *Client) runCommand(shellId string, command string, arguments string) (string, error) {
+	body := fmt.Sprintf(`<rsp:CommandLine xmlns:rsp="%s"><rsp:Command>%s</rsp:Command><rsp:Arguments>%s</rsp:Arguments></rsp:CommandLine>`, xmlnsShell, xmlEscape(command), xmlEscape(arguments))
+	options := map[string]string{"WINRS_CONSOLEMODE_STDIN": "FALSE", "WINRS_SKIP_CMD_SHELL": "FALSE"}
+
+	resp := &soapEnvelope{}
+	if err := c.sendRequestWithResult(c.buildEnvelope(actionCommand, shellId, options, body), resp); err != nil {
+		return "", err
+	}
+
+	if resp.Body.CommandResponse == nil || resp.Body.CommandResponse.CommandId == "" {
+		return "", errors.New("winrm api error: command id not found in response")
+	}
+
+	return resp.Body.CommandResponse.CommandId, nil
+}
+
+func (c *Client) sendInput(shellId string, commandId string, input []byte) error {
+	body := fmt.Sprintf(`<rsp:Send xmlns:rsp="%s"><rsp:Stream Name="stdin" CommandId="%s" End="true">%s</rsp:Stream></rsp:Send>`, xmlnsShell, xmlEscape(commandId), base64.StdEncoding.EncodeToString(input))
+
+	_, err := c.sendRequest(c.buildEnvelope(actionSend, shellId, nil, body))
+	return err
+}
+
+func (c *Client) receiveOutput(shellId string, commandId string) (*CommandResult, error) {
+	body := fmt.Sprintf(`<rsp:Receive xmlns:rsp="%s"><rsp:DesiredStream CommandId="%s">stdout stderr</rsp:DesiredStream></rsp:Receive>`, xmlnsShell, xmlEscape(commandId))
+
+	var stdout, stderr bytes.Buffer
+	for {
+		resp := &soapEnvelope{}
+		if err := c.sendRequestWithResult(c.buildEnvelope(actionReceive, shellId, nil, body), resp); err != nil {
+			var faultErr *FaultError
+			if errors.As(err, &faultErr) && faultErr.Code == faultCodeOperationTimeout {
+				continue
+			}
+
+			return nil, err
+		}
+
+		if resp.Body.ReceiveResponse == nil {
+			return nil, errors.New("winrm api error: unexpected receive response")
+		}
+
+		for _, stream := range resp.Body.ReceiveResponse.Streams {
+			data, err := base64.StdEncoding.DecodeString(strings.TrimSpace(stream.Value))
+			if err != nil {
+				return nil, fmt.Errorf("winrm api error: failed to decode output stream: %w", err)
+			}
+
+			switch stream.Name {
+			case "stdout":
+				stdout.Write(data)
+			case "stderr":
+				stderr.Write(data)
+			}
+		}
+
+		if state := resp.Body.ReceiveResponse.CommandState; state != nil && state.State == commandStateDone {
+			result := &CommandResult{
+				Stdout: stdout.String(),
+				Stderr: stderr.String(),
+			}
+			if state.ExitCode != nil {
+				result.ExitCode = *state.ExitCode
+			}
+			return result, nil
+		}
+	}
+}
+
+func (c *Client) signalTerminate(shellId string, commandId string) error {
+	body := fmt.Sprintf(`<rsp:Signal xmlns:rsp="%s" CommandId="%s"><rsp:Code>%s</rsp:Code></rsp:Signal>`, xmlnsShell, xmlEscape(commandId), signalTerminate)
+
+	_, err := c.sendRequest(c.buildEnvelope(actionSignal, shellId, nil, body))
+	return err
+}
+
+func (c *Client) buildEnvelope(action string, shellId string, options map[string]string, body string) string {
+	var sb strings.Builder
+	sb.WriteString(`<env:Envelope xmlns:env="http://www.w3.org/2003/05/soap-envelope" xmlns:a="http://schemas.xmlsoap.org/ws/2004/08/addressing" xmlns:w="http://schemas.dmtf.org/wbem/wsman/1/wsman.xsd" xmlns:p="http://schemas.microsoft.com/wbem/wsman/1/wsman.xsd">`)
+	sb.WriteString(`<env:Header>`)
+	sb.WriteString(`<a:To>` + xmlEscape(c.endpoint) + `</a:To>`)
+	sb.WriteString(`<a:ReplyTo><a:Address mustUnderstand="true">http://schemas.xmlsoap.org/ws/2004/08/addressing/role/anonymous</a:Address></a:ReplyTo>`)
+	sb.WriteString(`<w:MaxEnvelopeSize mustUnderstand="true">153600</w:MaxEnvelopeSize>`)
+	sb.WriteString(`<a:MessageID>uuid:` + newUUID() + `</a:MessageID>`)
+	sb.WriteString(`<w:Locale xml:lang="en-US" mustUnderstand="false"/>`)
+	sb.WriteString(`<p:DataLocale xml:lang="en-US" mustUnderstand="false"/>`)
+	sb.WriteString(`<w:OperationTimeout>PT60S</w:OperationTimeout>`)
+	sb.WriteString(`<w:ResourceURI mustUnderstand="true">` + resourceUriCmd + `</w:ResourceURI>`)
+	sb.WriteString(`<a:Action mustUnderstand="true">` + action + `</a:Action>`)
+	if shellId != "" {
+		sb.WriteString(`<w:SelectorSet><w:Selector Name="ShellId">` + xmlEscape(shellId) + `</w:Selector></w:SelectorSet>`)
+	}
+	if len(options) > 0 {
+		sb.WriteString(`<w:OptionSet>`)
+		for name, value := range options {
+			sb.WriteString(`<w:Option Name="` + xmlEscape(name) + `">` + xmlEscape(value) + `</w:Option>`)
+		}
+		sb.WriteString(`</w:OptionSet>`)
+	}
+	sb.WriteString(`</env:Header>`)
+	sb.WriteString(`<env:Body>` + body + `</env:Body>`)
+	sb.WriteString(`</env:Envelope>`)
+	return sb.String()
+}
+
+func encodePowerShellCommand(script string) string {
+	return base64.StdEncoding.EncodeToString(encodeUTF16LE(script))
+}
+
+func encodeUTF16LE(s string) []byte {
+	codes := utf16.Encode([]rune(s))
+	b := make([]byte, len(codes)*2)
+	for i, c := range codes {
+		binary.LittleEndian.PutUint16(b[i*2:], c)
+	}
+	return b
+}
+
+func newUUID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+func xmlEscape(s string) string {
+	var buf bytes.Buffer
+	xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
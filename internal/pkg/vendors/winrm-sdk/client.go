@@ -0,0 +1,147 @@
+package winrmsdk
+
+import (
+	"crypto/tls"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/Azure/go-ntlmssp"
+	"github.com/go-resty/resty/v2"
+)
+
+const (
+	AuthMethodBasic = "basic"
+	AuthMethodNTLM  = "ntlm"
+)
+
+type Client struct {
+	endpoint   string
+	useHttps   bool
+	authMethod string
+	username   string
+	password   string
+
+	client    *resty.Client
+	transport *http.Transport
+}
+
+// 创建 WinRM 客户端。
+//
+// 入参：
+//   - host：Windows 主机地址。
+//   - port：WinRM 端口。零值时 HTTP 默认为 5985、HTTPS 默认为 5986。
+//   - useHttps：是否使用 HTTPS。
+//   - authMethod：认证方式，可取值 [AuthMethodBasic]、[AuthMethodNTLM]。
+//     由于未实现 NTLM 消息加密，NTLM 认证仅支持 HTTPS。
+//   - username：用户名，NTLM 认证时可以是 "DOMAIN\user" 形式。
+//   - password：密码。
+//
+// 出参：
+//   - 客户端。
+func NewClient(host string, port int32, useHttps bool, authMethod, username, password string) *Client {
+	scheme := "http"
+	if useHttps {
+		scheme = "https"
+	}
+	if port == 0 {
+		port = 5985
+		if useHttps {
+			port = 5986
+		}
+	}
+
+	// NTLM 认证是基于连接的，需保证握手过程复用同一个连接
+	transport := &http.Transport{
+		Proxy:           http.ProxyFromEnvironment,
+		MaxConnsPerHost: 1,
+		IdleConnTimeout: 90 * time.Second,
+	}
+
+	client := resty.New()
+	if authMethod == AuthMethodNTLM {
+		// 由 ntlmssp 将 Basic 认证请求头转换为 NTLM 握手
+		client.SetTransport(ntlmssp.Negotiator{RoundTripper: transport})
+	} else {
+		client.SetTransport(transport)
+	}
+
+	return &Client{
+		endpoint:   fmt.Sprintf("%s://%s/wsman", scheme, net.JoinHostPort(host, strconv.Itoa(int(port)))),
+		useHttps:   useHttps,
+		authMethod: authMethod,
+		username:   username,
+		password:   password,
+		client:     client,
+		transport:  transport,
+	}
+}
+
+func (c *Client) WithTimeout(timeout time.Duration) *Client {
+	c.client.SetTimeout(timeout)
+	return c
+}
+
+func (c *Client) WithTLSConfig(config *tls.Config) *Client {
+	c.transport.TLSClientConfig = config
+	return c
+}
+
+func (c *Client) sendRequest(envelope string) (*resty.Response, error) {
+	req := c.client.R().
+		SetHeader("Content-Type", "application/soap+xml;charset=UTF-8")
+
+	switch c.authMethod {
+	case AuthMethodBasic:
+		req.SetBasicAuth(c.username, c.password)
+
+	case AuthMethodNTLM:
+		// WinRM 服务端默认拒绝未加密的消息（AllowUnencrypted=false），而 HTTP 下需借助 NTLM 会话密钥对消息加密
+		if !c.useHttps {
+			return nil, errors.New("winrm api error: ntlm authentication requires https")
+		}
+		req.SetBasicAuth(c.username, c.password)
+
+	default:
+		return nil, fmt.Errorf("winrm api error: unsupported auth method '%s'", c.authMethod)
+	}
+
+	resp, err := req.SetBody(envelope).Post(c.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("winrm api error: failed to send request: %w", err)
+	} else if resp.IsError() {
+		if resp.StatusCode() == http.StatusUnauthorized {
+			return resp, errors.New("winrm api error: unexpected status code: 401, unauthorized")
+		}
+
+		errResp := &soapEnvelope{}
+		if xml.Unmarshal(resp.Body(), errResp) == nil && errResp.Body.Fault != nil {
+			return resp, &FaultError{
+				StatusCode: resp.StatusCode(),
+				Code:       errResp.Body.Fault.Detail.WSManFault.Code,
+				Message:    errResp.Body.Fault.Message(),
+			}
+		}
+
+		return resp, fmt.Errorf("winrm api error: unexpected status code: %d, %s", resp.StatusCode(), resp.Body())
+	}
+
+	return resp, nil
+}
+
+func (c *Client) sendRequestWithResult(envelope string, result *soapEnvelope) error {
+	resp, err := c.sendRequest(envelope)
+	if err != nil {
+		return err
+	}
+
+	if err := xml.Unmarshal(resp.Body(), result); err != nil {
+		return fmt.Errorf("winrm api error: failed to parse response: %w", err)
+	}
+
+	return nil
+}
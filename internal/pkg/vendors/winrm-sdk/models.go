@@ -0,0 +1,82 @@
+package winrmsdk
+
+import (
+	"fmt"
+	"strings"
+)
+
+type soapEnvelope struct {
+	Body struct {
+		Shell *struct {
+			ShellId string `xml:"ShellId"`
+		} `xml:"Shell"`
+		ResourceCreated *struct {
+			ReferenceParameters struct {
+				SelectorSet struct {
+					Selectors []struct {
+						Name  string `xml:"Name,attr"`
+						Value string `xml:",chardata"`
+					} `xml:"Selector"`
+				} `xml:"SelectorSet"`
+			} `xml:"ReferenceParameters"`
+		} `xml:"ResourceCreated"`
+		CommandResponse *struct {
+			CommandId string `xml:"CommandId"`
+		} `xml:"CommandResponse"`
+		ReceiveResponse *struct {
+			Streams []struct {
+				Name      string `xml:"Name,attr"`
+				CommandId string `xml:"CommandId,attr"`
+				End       bool   `xml:"End,attr"`
+				Value     string `xml:",chardata"`
+			} `xml:"Stream"`
+			CommandState *struct {
+				CommandId string `xml:"CommandId,attr"`
+				State     string `xml:"State,attr"`
+				ExitCode  *int32 `xml:"ExitCode"`
+			} `xml:"CommandState"`
+		} `xml:"ReceiveResponse"`
+		Fault *soapFault `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+type soapFault struct {
+	Code struct {
+		Value   string `xml:"Value"`
+		Subcode struct {
+			Value string `xml:"Value"`
+		} `xml:"Subcode"`
+	} `xml:"Code"`
+	Reason struct {
+		Text string `xml:"Text"`
+	} `xml:"Reason"`
+	Detail struct {
+		WSManFault struct {
+			Code    string `xml:"Code,attr"`
+			Message string `xml:",innerxml"`
+		} `xml:"WSManFault"`
+	} `xml:"Detail"`
+}
+
+func (f *soapFault) Message() string {
+	if text := strings.TrimSpace(f.Reason.Text); text != "" {
+		return text
+	}
+	return strings.TrimSpace(f.Detail.WSManFault.Message)
+}
+
+type FaultError struct {
+	StatusCode int
+	Code       string
+	Message    string
+}
+
+func (e *FaultError) Error() string {
+	return fmt.Sprintf("winrm api error: unexpected status code: %d, fault %s: %s", e.StatusCode, e.Code, e.Message)
+}
+
+type CommandResult struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int32
+}
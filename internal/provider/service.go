@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/usual2970/certimate/internal/applicant"
+	"github.com/usual2970/certimate/internal/deployer"
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/domain/dtos"
+	"github.com/usual2970/certimate/internal/notify"
+)
+
+type ProviderService struct{}
+
+func NewProviderService() *ProviderService {
+	return &ProviderService{}
+}
+
+func (s *ProviderService) List(ctx context.Context, req *dtos.ProviderListReq) (*dtos.ProviderListResp, error) {
+	items := make([]*domain.ProviderDescriptor, 0)
+	for _, descriptor := range s.getAllDescriptors() {
+		if req.Kind != "" && descriptor.Kind != req.Kind {
+			continue
+		}
+		if req.Capability != "" && !descriptor.HasCapability(req.Capability) {
+			continue
+		}
+
+		items = append(items, descriptor)
+	}
+
+	return &dtos.ProviderListResp{
+		Items: items,
+	}, nil
+}
+
+func (s *ProviderService) Get(ctx context.Context, req *dtos.ProviderGetReq) (*domain.ProviderDescriptor, error) {
+	for _, descriptor := range s.getAllDescriptors() {
+		if descriptor.Kind == req.Kind && descriptor.Type == req.Type {
+			return descriptor, nil
+		}
+	}
+
+	return nil, domain.ErrRecordNotFound
+}
+
+func (s *ProviderService) getAllDescriptors() []*domain.ProviderDescriptor {
+	descriptors := make([]*domain.ProviderDescriptor, 0)
+	descriptors = append(descriptors, applicant.GetProviderDescriptors()...)
+	descriptors = append(descriptors, deployer.GetProviderDescriptors()...)
+	descriptors = append(descriptors, notify.GetProviderDescriptors()...)
+	return descriptors
+}
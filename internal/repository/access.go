@@ -19,6 +19,19 @@ func NewAccessRepository() *AccessRepository {
 }
 
 func (r *AccessRepository) GetById(ctx context.Context, id string) (*domain.Access, error) {
+	access, err := accessCache.GetOrCreate(id, func() (*domain.Access, error) {
+		return r.getById(ctx, id)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回副本，避免调用方修改缓存中的数据
+	clone := *access
+	return &clone, nil
+}
+
+func (r *AccessRepository) getById(ctx context.Context, id string) (*domain.Access, error) {
 	record, err := app.GetApp().FindRecordById(domain.CollectionNameAccess, id)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"time"
+
+	"github.com/pocketbase/pocketbase/core"
+
+	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/pkg/utils/cache"
+)
+
+// 读多写少的记录缓存。
+// 记录变更时会通过钩子函数立即失效；过期时间仅作为兜底，以防绕过应用直接修改数据库。
+const recordCacheTTL = 5 * time.Minute
+
+var (
+	settingsCache = cache.NewTTLCache[string, *domain.Settings](recordCacheTTL) // key: Name
+	accessCache   = cache.NewTTLCache[string, *domain.Access](recordCacheTTL)   // key: Id
+)
+
+func Register() {
+	app := app.GetApp()
+
+	invalidateSettings := func(e *core.RecordEvent) error {
+		settingsCache.Remove(e.Record.GetString("name"))
+		if original := e.Record.Original(); original != nil {
+			settingsCache.Remove(original.GetString("name"))
+		}
+		return e.Next()
+	}
+	app.OnRecordAfterCreateSuccess(domain.CollectionNameSettings).BindFunc(invalidateSettings)
+	app.OnRecordAfterUpdateSuccess(domain.CollectionNameSettings).BindFunc(invalidateSettings)
+	app.OnRecordAfterDeleteSuccess(domain.CollectionNameSettings).BindFunc(invalidateSettings)
+
+	invalidateAccess := func(e *core.RecordEvent) error {
+		accessCache.Remove(e.Record.Id)
+		return e.Next()
+	}
+	app.OnRecordAfterUpdateSuccess(domain.CollectionNameAccess).BindFunc(invalidateAccess)
+	app.OnRecordAfterDeleteSuccess(domain.CollectionNameAccess).BindFunc(invalidateAccess)
+}
@@ -5,9 +5,11 @@ import (
 	"database/sql"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/types"
 	"github.com/usual2970/certimate/internal/app"
 	"github.com/usual2970/certimate/internal/domain"
 )
@@ -19,11 +21,16 @@ func NewCertificateRepository() *CertificateRepository {
 }
 
 func (r *CertificateRepository) ListExpireSoon(ctx context.Context) ([]*domain.Certificate, error) {
-	records, err := app.GetApp().FindAllRecords(
+	now := time.Now().UTC()
+	records, err := app.GetApp().FindRecordsByFilter(
 		domain.CollectionNameCertificate,
-		dbx.NewExp("expireAt>DATETIME('now')"),
-		dbx.NewExp("expireAt<DATETIME('now', '+20 days')"),
-		dbx.NewExp("deleted=null"),
+		"expireAt>{:now} && expireAt<{:expiredAt} && archived=false && deleted=null",
+		"expireAt",
+		0, 0,
+		dbx.Params{
+			"now":       now.Format(types.DefaultDateLayout),
+			"expiredAt": now.AddDate(0, 0, 20).Format(types.DefaultDateLayout),
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -42,6 +49,71 @@ func (r *CertificateRepository) ListExpireSoon(ctx context.Context) ([]*domain.C
 	return certificates, nil
 }
 
+type CertificateListOptions struct {
+	Keyword    string
+	State      string // 可取值 "expireSoon"、"expired"、"archived"
+	WorkflowId string
+	Sort       string
+	Cursor     string
+	Limit      int
+}
+
+func (r *CertificateRepository) List(ctx context.Context, options *CertificateListOptions) (*domain.CursorPage[*domain.Certificate], error) {
+	if options == nil {
+		options = &CertificateListOptions{}
+	}
+
+	sortField, sortDesc, err := parseSortExpr(options.Sort, []string{"created", "updated", "effectAt", "expireAt"}, "-created")
+	if err != nil {
+		return nil, err
+	}
+
+	filters := []string{"deleted=null"}
+	params := dbx.Params{}
+	if options.Keyword != "" {
+		filters = append(filters, "(subjectAltNames~{:keyword} || serialNumber={:keyword})")
+		params["keyword"] = options.Keyword
+	}
+	switch options.State {
+	case "":
+		filters = append(filters, "archived=false")
+	case "expireSoon":
+		filters = append(filters, "archived=false", "expireAt<{:expiredAt}")
+		params["expiredAt"] = time.Now().AddDate(0, 0, 20).UTC().Format(types.DefaultDateLayout)
+	case "expired":
+		filters = append(filters, "archived=false", "expireAt<={:expiredAt}")
+		params["expiredAt"] = time.Now().UTC().Format(types.DefaultDateLayout)
+	case "archived":
+		filters = append(filters, "archived=true")
+	default:
+		return nil, domain.ErrInvalidParams
+	}
+	if options.WorkflowId != "" {
+		filters = append(filters, "workflowId={:workflowId}")
+		params["workflowId"] = options.WorkflowId
+	}
+
+	records, nextCursor, err := findRecordsByCursor(domain.CollectionNameCertificate, filters, params, sortField, sortDesc, options.Cursor, options.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	certificates := make([]*domain.Certificate, 0, len(records))
+	for _, record := range records {
+		certificate, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		certificates = append(certificates, certificate)
+	}
+
+	return &domain.CursorPage[*domain.Certificate]{
+		Items:      certificates,
+		NextCursor: nextCursor,
+	}, nil
+}
+
 func (r *CertificateRepository) GetById(ctx context.Context, id string) (*domain.Certificate, error) {
 	record, err := app.GetApp().FindRecordById(domain.CollectionNameCertificate, id)
 	if err != nil {
@@ -59,70 +131,121 @@ func (r *CertificateRepository) GetById(ctx context.Context, id string) (*domain
 }
 
 func (r *CertificateRepository) GetByWorkflowNodeId(ctx context.Context, workflowNodeId string) (*domain.Certificate, error) {
-	records, err := app.GetApp().FindRecordsByFilter(
-		domain.CollectionNameCertificate,
-		"workflowNodeId={:workflowNodeId} && deleted=null",
-		"-created",
-		1, 0,
-		dbx.Params{"workflowNodeId": workflowNodeId},
-	)
+	// 直接构造查询以命中 (workflowNodeId, created) 复合索引
+	record := &core.Record{}
+	err := app.GetApp().RecordQuery(domain.CollectionNameCertificate).
+		AndWhere(dbx.HashExp{"workflowNodeId": workflowNodeId}).
+		AndWhere(dbx.Or(dbx.HashExp{"deleted": ""}, dbx.HashExp{"deleted": nil})).
+		OrderBy("created DESC").
+		Limit(1).
+		One(record)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrRecordNotFound
 		}
 		return nil, err
 	}
-	if len(records) == 0 {
-		return nil, domain.ErrRecordNotFound
-	}
 
-	return r.castRecordToModel(records[0])
+	return r.castRecordToModel(record)
 }
 
 func (r *CertificateRepository) Save(ctx context.Context, certificate *domain.Certificate) (*domain.Certificate, error) {
-	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameCertificate)
+	record, err := r.saveRecord(app.GetApp(), certificate)
 	if err != nil {
 		return certificate, err
 	}
 
-	var record *core.Record
-	if certificate.Id == "" {
-		record = core.NewRecord(collection)
-	} else {
-		record, err = app.GetApp().FindRecordById(collection, certificate.Id)
+	certificate.Id = record.Id
+	certificate.CreatedAt = record.GetDateTime("created").Time()
+	certificate.UpdatedAt = record.GetDateTime("updated").Time()
+	return certificate, nil
+}
+
+// 批量软删除证书。
+// 返回实际被删除的记录数，已删除或不存在的记录将被忽略。
+func (r *CertificateRepository) DeleteByIds(ctx context.Context, ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	count := 0
+	err := app.GetApp().RunInTransaction(func(txApp core.App) error {
+		records, err := txApp.FindRecordsByIds(domain.CollectionNameCertificate, ids)
 		if err != nil {
-			if errors.Is(err, sql.ErrNoRows) {
-				return certificate, domain.ErrRecordNotFound
+			return err
+		}
+
+		now := types.NowDateTime()
+		for _, record := range records {
+			if !record.GetDateTime("deleted").IsZero() {
+				continue
 			}
-			return certificate, err
+
+			record.Set("deleted", now)
+			if err := txApp.Save(record); err != nil {
+				return err
+			}
+
+			count++
 		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	record.Set("source", string(certificate.Source))
-	record.Set("subjectAltNames", certificate.SubjectAltNames)
-	record.Set("serialNumber", certificate.SerialNumber)
-	record.Set("certificate", certificate.Certificate)
-	record.Set("privateKey", certificate.PrivateKey)
-	record.Set("issuer", certificate.Issuer)
-	record.Set("issuerCertificate", certificate.IssuerCertificate)
-	record.Set("keyAlgorithm", string(certificate.KeyAlgorithm))
-	record.Set("effectAt", certificate.EffectAt)
-	record.Set("expireAt", certificate.ExpireAt)
-	record.Set("acmeAccountUrl", certificate.ACMEAccountUrl)
-	record.Set("acmeCertUrl", certificate.ACMECertUrl)
-	record.Set("acmeCertStableUrl", certificate.ACMECertStableUrl)
-	record.Set("workflowId", certificate.WorkflowId)
-	record.Set("workflowRunId", certificate.WorkflowRunId)
-	record.Set("workflowNodeId", certificate.WorkflowNodeId)
-	record.Set("workflowOutputId", certificate.WorkflowOutputId)
-	if err := app.GetApp().Save(record); err != nil {
-		return certificate, err
+	return count, nil
+}
+
+// 表示证书的可批量更新字段。
+// 证书内容均由签发或上传流程写入，仅开放由用户维护的字段，nil 表示不修改。
+type CertificatePatch struct {
+	Archived *bool
+}
+
+// 批量更新证书。
+// 返回实际被更新的记录数，已删除、不存在或无需变更的记录将被忽略。
+func (r *CertificateRepository) UpdateByIds(ctx context.Context, ids []string, patch *CertificatePatch) (int, error) {
+	if len(ids) == 0 || patch == nil {
+		return 0, nil
 	}
 
-	certificate.Id = record.Id
-	certificate.CreatedAt = record.GetDateTime("created").Time()
-	certificate.UpdatedAt = record.GetDateTime("updated").Time()
-	return certificate, nil
+	count := 0
+	err := app.GetApp().RunInTransaction(func(txApp core.App) error {
+		records, err := txApp.FindRecordsByIds(domain.CollectionNameCertificate, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if !record.GetDateTime("deleted").IsZero() {
+				continue
+			}
+
+			changed := false
+			if patch.Archived != nil && record.GetBool("archived") != *patch.Archived {
+				record.Set("archived", *patch.Archived)
+				changed = true
+			}
+			if !changed {
+				continue
+			}
+
+			if err := txApp.Save(record); err != nil {
+				return err
+			}
+
+			count++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
 }
 
 func (r *CertificateRepository) castRecordToModel(record *core.Record) (*domain.Certificate, error) {
@@ -153,6 +276,51 @@ func (r *CertificateRepository) castRecordToModel(record *core.Record) (*domain.
 		WorkflowRunId:     record.GetString("workflowRunId"),
 		WorkflowNodeId:    record.GetString("workflowNodeId"),
 		WorkflowOutputId:  record.GetString("workflowOutputId"),
+		Archived:          record.GetBool("archived"),
 	}
 	return certificate, nil
 }
+
+func (r *CertificateRepository) saveRecord(txApp core.App, certificate *domain.Certificate) (*core.Record, error) {
+	collection, err := txApp.FindCollectionByNameOrId(domain.CollectionNameCertificate)
+	if err != nil {
+		return nil, err
+	}
+
+	var record *core.Record
+	if certificate.Id == "" {
+		record = core.NewRecord(collection)
+	} else {
+		record, err = txApp.FindRecordById(collection, certificate.Id)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return record, domain.ErrRecordNotFound
+			}
+			return record, err
+		}
+	}
+
+	record.Set("source", string(certificate.Source))
+	record.Set("subjectAltNames", certificate.SubjectAltNames)
+	record.Set("serialNumber", certificate.SerialNumber)
+	record.Set("certificate", certificate.Certificate)
+	record.Set("privateKey", certificate.PrivateKey)
+	record.Set("issuer", certificate.Issuer)
+	record.Set("issuerCertificate", certificate.IssuerCertificate)
+	record.Set("keyAlgorithm", string(certificate.KeyAlgorithm))
+	record.Set("effectAt", certificate.EffectAt)
+	record.Set("expireAt", certificate.ExpireAt)
+	record.Set("acmeAccountUrl", certificate.ACMEAccountUrl)
+	record.Set("acmeCertUrl", certificate.ACMECertUrl)
+	record.Set("acmeCertStableUrl", certificate.ACMECertStableUrl)
+	record.Set("workflowId", certificate.WorkflowId)
+	record.Set("workflowRunId", certificate.WorkflowRunId)
+	record.Set("workflowNodeId", certificate.WorkflowNodeId)
+	record.Set("workflowOutputId", certificate.WorkflowOutputId)
+	record.Set("archived", certificate.Archived)
+	if err := txApp.Save(record); err != nil {
+		return record, err
+	}
+
+	return record, nil
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/pocketbase/dbx"
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/domain"
+)
+
+const (
+	defaultPageLimit = 20
+	maxPageLimit     = 500
+)
+
+type pageCursor struct {
+	Value string `json:"v"`
+	Id    string `json:"id"`
+}
+
+func encodePageCursor(record *core.Record, sortField string) string {
+	cursor := pageCursor{
+		Value: record.GetString(sortField),
+		Id:    record.Id,
+	}
+
+	data, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodePageCursor(s string) (*pageCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, domain.ErrInvalidParams
+	}
+
+	cursor := &pageCursor{}
+	if err := json.Unmarshal(data, cursor); err != nil || cursor.Id == "" {
+		return nil, domain.ErrInvalidParams
+	}
+
+	return cursor, nil
+}
+
+// 解析排序表达式（如 "-created"），仅允许按白名单内的字段排序。
+func parseSortExpr(sort string, allowedFields []string, defaultSort string) (field string, desc bool, err error) {
+	if sort == "" {
+		sort = defaultSort
+	}
+
+	field = strings.TrimSpace(sort)
+	if strings.HasPrefix(field, "-") {
+		field = strings.TrimPrefix(field, "-")
+		desc = true
+	} else {
+		field = strings.TrimPrefix(field, "+")
+	}
+
+	if !slices.Contains(allowedFields, field) {
+		return "", false, domain.ErrInvalidParams
+	}
+
+	return field, desc, nil
+}
+
+// 基于游标分页查询记录。
+// 游标由排序字段值与记录 ID 组成，确保在大数据量下无需 OFFSET 扫描即可稳定翻页。
+func findRecordsByCursor(collection string, filters []string, params dbx.Params, sortField string, sortDesc bool, cursor string, limit int) ([]*core.Record, string, error) {
+	if limit <= 0 {
+		limit = defaultPageLimit
+	} else if limit > maxPageLimit {
+		limit = maxPageLimit
+	}
+
+	if params == nil {
+		params = dbx.Params{}
+	}
+
+	if cursor != "" {
+		pc, err := decodePageCursor(cursor)
+		if err != nil {
+			return nil, "", err
+		}
+
+		op := ">"
+		if sortDesc {
+			op = "<"
+		}
+
+		filters = append(filters, fmt.Sprintf("(%[1]s%[2]s{:cursorValue} || (%[1]s={:cursorValue} && id%[2]s{:cursorId}))", sortField, op))
+		params["cursorValue"] = pc.Value
+		params["cursorId"] = pc.Id
+	}
+
+	sortExpr := fmt.Sprintf("%s,id", sortField)
+	if sortDesc {
+		sortExpr = fmt.Sprintf("-%s,-id", sortField)
+	}
+
+	records, err := app.GetApp().FindRecordsByFilter(
+		collection,
+		strings.Join(filters, " && "),
+		sortExpr,
+		limit+1, 0,
+		params,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	nextCursor := ""
+	if len(records) > limit {
+		records = records[:limit]
+		nextCursor = encodePageCursor(records[limit-1], sortField)
+	}
+
+	return records, nextCursor, nil
+}
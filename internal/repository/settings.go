@@ -17,6 +17,19 @@ func NewSettingsRepository() *SettingsRepository {
 }
 
 func (r *SettingsRepository) GetByName(ctx context.Context, name string) (*domain.Settings, error) {
+	settings, err := settingsCache.GetOrCreate(name, func() (*domain.Settings, error) {
+		return r.getByName(ctx, name)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 返回副本，避免调用方修改缓存中的数据
+	clone := *settings
+	return &clone, nil
+}
+
+func (r *SettingsRepository) getByName(ctx context.Context, name string) (*domain.Settings, error) {
 	record, err := app.GetApp().FindFirstRecordByFilter(
 		domain.CollectionNameSettings,
 		"name={:name}",
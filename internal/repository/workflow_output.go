@@ -19,28 +19,25 @@ func NewWorkflowOutputRepository() *WorkflowOutputRepository {
 }
 
 func (r *WorkflowOutputRepository) GetByNodeId(ctx context.Context, workflowNodeId string) (*domain.WorkflowOutput, error) {
-	records, err := app.GetApp().FindRecordsByFilter(
-		domain.CollectionNameWorkflowOutput,
-		"nodeId={:nodeId}",
-		"-created",
-		1, 0,
-		dbx.Params{"nodeId": workflowNodeId},
-	)
+	// 直接构造查询以命中 (nodeId, created) 复合索引，避免过滤表达式解析带来的额外开销
+	record := &core.Record{}
+	err := app.GetApp().RecordQuery(domain.CollectionNameWorkflowOutput).
+		AndWhere(dbx.HashExp{"nodeId": workflowNodeId}).
+		OrderBy("created DESC").
+		Limit(1).
+		One(record)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return nil, domain.ErrRecordNotFound
 		}
 		return nil, err
 	}
-	if len(records) == 0 {
-		return nil, domain.ErrRecordNotFound
-	}
 
-	return r.castRecordToModel(records[0])
+	return r.castRecordToModel(record)
 }
 
 func (r *WorkflowOutputRepository) Save(ctx context.Context, workflowOutput *domain.WorkflowOutput) (*domain.WorkflowOutput, error) {
-	record, err := r.saveRecord(workflowOutput)
+	record, err := r.saveRecord(app.GetApp(), workflowOutput)
 	if err != nil {
 		return workflowOutput, err
 	}
@@ -52,54 +49,72 @@ func (r *WorkflowOutputRepository) Save(ctx context.Context, workflowOutput *dom
 }
 
 func (r *WorkflowOutputRepository) SaveWithCertificate(ctx context.Context, workflowOutput *domain.WorkflowOutput, certificate *domain.Certificate) (*domain.WorkflowOutput, error) {
-	record, err := r.saveRecord(workflowOutput)
-	if err != nil {
-		return workflowOutput, err
-	} else {
-		workflowOutput.Id = record.Id
-		workflowOutput.CreatedAt = record.GetDateTime("created").Time()
-		workflowOutput.UpdatedAt = record.GetDateTime("updated").Time()
-	}
-
 	if certificate == nil {
 		panic("certificate is nil")
-	} else {
-		if certificate.WorkflowId != "" && certificate.WorkflowId != workflowOutput.WorkflowId {
-			return workflowOutput, fmt.Errorf("certificate #%s is not belong to workflow #%s", certificate.Id, workflowOutput.WorkflowId)
+	}
+
+	// 在副本上进行修改，仅在事务成功提交后才回写到入参中，避免失败时留下不一致的 ID 或引用
+	workflowOutputCopy := *workflowOutput
+	workflowOutputCopy.Outputs = append([]domain.WorkflowNodeIO(nil), workflowOutput.Outputs...)
+	certificateCopy := *certificate
+
+	// 工作流输出、证书及其相互引用需在同一事务中写入，避免产生孤立或不完整的记录
+	err := app.GetApp().RunInTransaction(func(txApp core.App) error {
+		record, err := r.saveRecord(txApp, &workflowOutputCopy)
+		if err != nil {
+			return err
 		}
-		if certificate.WorkflowRunId != "" && certificate.WorkflowRunId != workflowOutput.RunId {
-			return workflowOutput, fmt.Errorf("certificate #%s is not belong to workflow run #%s", certificate.Id, workflowOutput.RunId)
+
+		if certificateCopy.WorkflowId != "" && certificateCopy.WorkflowId != workflowOutputCopy.WorkflowId {
+			return fmt.Errorf("certificate #%s is not belong to workflow #%s", certificateCopy.Id, workflowOutputCopy.WorkflowId)
 		}
-		if certificate.WorkflowNodeId != "" && certificate.WorkflowNodeId != workflowOutput.NodeId {
-			return workflowOutput, fmt.Errorf("certificate #%s is not belong to workflow node #%s", certificate.Id, workflowOutput.NodeId)
+		if certificateCopy.WorkflowRunId != "" && certificateCopy.WorkflowRunId != workflowOutputCopy.RunId {
+			return fmt.Errorf("certificate #%s is not belong to workflow run #%s", certificateCopy.Id, workflowOutputCopy.RunId)
 		}
-		if certificate.WorkflowOutputId != "" && certificate.WorkflowOutputId != workflowOutput.Id {
-			return workflowOutput, fmt.Errorf("certificate #%s is not belong to workflow output #%s", certificate.Id, workflowOutput.Id)
+		if certificateCopy.WorkflowNodeId != "" && certificateCopy.WorkflowNodeId != workflowOutputCopy.NodeId {
+			return fmt.Errorf("certificate #%s is not belong to workflow node #%s", certificateCopy.Id, workflowOutputCopy.NodeId)
+		}
+		if certificateCopy.WorkflowOutputId != "" && certificateCopy.WorkflowOutputId != record.Id {
+			return fmt.Errorf("certificate #%s is not belong to workflow output #%s", certificateCopy.Id, record.Id)
 		}
 
-		certificate.WorkflowId = workflowOutput.WorkflowId
-		certificate.WorkflowRunId = workflowOutput.RunId
-		certificate.WorkflowNodeId = workflowOutput.NodeId
-		certificate.WorkflowOutputId = workflowOutput.Id
-		certificate, err := NewCertificateRepository().Save(ctx, certificate)
+		certificateCopy.WorkflowId = workflowOutputCopy.WorkflowId
+		certificateCopy.WorkflowRunId = workflowOutputCopy.RunId
+		certificateCopy.WorkflowNodeId = workflowOutputCopy.NodeId
+		certificateCopy.WorkflowOutputId = record.Id
+		certificateRecord, err := NewCertificateRepository().saveRecord(txApp, &certificateCopy)
 		if err != nil {
-			return workflowOutput, err
+			return err
 		}
 
+		certificateCopy.Id = certificateRecord.Id
+		certificateCopy.CreatedAt = certificateRecord.GetDateTime("created").Time()
+		certificateCopy.UpdatedAt = certificateRecord.GetDateTime("updated").Time()
+
 		// 写入证书 ID 到工作流输出结果中
-		for i, item := range workflowOutput.Outputs {
+		for i, item := range workflowOutputCopy.Outputs {
 			if item.Name == string(domain.WorkflowNodeIONameCertificate) {
-				workflowOutput.Outputs[i].Value = certificate.Id
+				workflowOutputCopy.Outputs[i].Value = certificateCopy.Id
 				break
 			}
 		}
-		record.Set("outputs", workflowOutput.Outputs)
-		if err := app.GetApp().Save(record); err != nil {
-			return workflowOutput, err
+		record.Set("outputs", workflowOutputCopy.Outputs)
+		if err := txApp.Save(record); err != nil {
+			return err
 		}
+
+		workflowOutputCopy.Id = record.Id
+		workflowOutputCopy.CreatedAt = record.GetDateTime("created").Time()
+		workflowOutputCopy.UpdatedAt = record.GetDateTime("updated").Time()
+		return nil
+	})
+	if err != nil {
+		return workflowOutput, err
 	}
 
-	return workflowOutput, err
+	*workflowOutput = workflowOutputCopy
+	*certificate = certificateCopy
+	return workflowOutput, nil
 }
 
 func (r *WorkflowOutputRepository) castRecordToModel(record *core.Record) (*domain.WorkflowOutput, error) {
@@ -133,8 +148,8 @@ func (r *WorkflowOutputRepository) castRecordToModel(record *core.Record) (*doma
 	return workflowOutput, nil
 }
 
-func (r *WorkflowOutputRepository) saveRecord(workflowOutput *domain.WorkflowOutput) (*core.Record, error) {
-	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameWorkflowOutput)
+func (r *WorkflowOutputRepository) saveRecord(txApp core.App, workflowOutput *domain.WorkflowOutput) (*core.Record, error) {
+	collection, err := txApp.FindCollectionByNameOrId(domain.CollectionNameWorkflowOutput)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +158,7 @@ func (r *WorkflowOutputRepository) saveRecord(workflowOutput *domain.WorkflowOut
 	if workflowOutput.Id == "" {
 		record = core.NewRecord(collection)
 	} else {
-		record, err = app.GetApp().FindRecordById(collection, workflowOutput.Id)
+		record, err = txApp.FindRecordById(collection, workflowOutput.Id)
 		if err != nil {
 			return record, err
 		}
@@ -154,7 +169,7 @@ func (r *WorkflowOutputRepository) saveRecord(workflowOutput *domain.WorkflowOut
 	record.Set("node", workflowOutput.Node)
 	record.Set("outputs", workflowOutput.Outputs)
 	record.Set("succeeded", workflowOutput.Succeeded)
-	if err := app.GetApp().Save(record); err != nil {
+	if err := txApp.Save(record); err != nil {
 		return record, err
 	}
 
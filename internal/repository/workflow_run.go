@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/pocketbase/dbx"
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/usual2970/certimate/internal/app"
 	"github.com/usual2970/certimate/internal/domain"
@@ -29,6 +30,77 @@ func (r *WorkflowRunRepository) GetById(ctx context.Context, id string) (*domain
 	return r.castRecordToModel(record)
 }
 
+func (r *WorkflowRunRepository) GetByIdempotencyKey(ctx context.Context, workflowId string, idempotencyKey string) (*domain.WorkflowRun, error) {
+	record, err := app.GetApp().FindFirstRecordByFilter(
+		domain.CollectionNameWorkflowRun,
+		"workflowId={:workflowId} && idempotencyKey={:idempotencyKey}",
+		dbx.Params{"workflowId": workflowId, "idempotencyKey": idempotencyKey},
+	)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, domain.ErrRecordNotFound
+		}
+		return nil, err
+	}
+
+	return r.castRecordToModel(record)
+}
+
+type WorkflowRunListOptions struct {
+	WorkflowId string
+	Status     domain.WorkflowRunStatusType
+	Trigger    domain.WorkflowTriggerType
+	Sort       string
+	Cursor     string
+	Limit      int
+}
+
+func (r *WorkflowRunRepository) List(ctx context.Context, options *WorkflowRunListOptions) (*domain.CursorPage[*domain.WorkflowRun], error) {
+	if options == nil {
+		options = &WorkflowRunListOptions{}
+	}
+
+	sortField, sortDesc, err := parseSortExpr(options.Sort, []string{"created", "startedAt", "endedAt"}, "-created")
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]string, 0)
+	params := dbx.Params{}
+	if options.WorkflowId != "" {
+		filters = append(filters, "workflowId={:workflowId}")
+		params["workflowId"] = options.WorkflowId
+	}
+	if options.Status != "" {
+		filters = append(filters, "status={:status}")
+		params["status"] = string(options.Status)
+	}
+	if options.Trigger != "" {
+		filters = append(filters, "trigger={:trigger}")
+		params["trigger"] = string(options.Trigger)
+	}
+
+	records, nextCursor, err := findRecordsByCursor(domain.CollectionNameWorkflowRun, filters, params, sortField, sortDesc, options.Cursor, options.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowRuns := make([]*domain.WorkflowRun, 0, len(records))
+	for _, record := range records {
+		workflowRun, err := r.castRecordToModel(record)
+		if err != nil {
+			return nil, err
+		}
+
+		workflowRuns = append(workflowRuns, workflowRun)
+	}
+
+	return &domain.CursorPage[*domain.WorkflowRun]{
+		Items:      workflowRuns,
+		NextCursor: nextCursor,
+	}, nil
+}
+
 func (r *WorkflowRunRepository) Save(ctx context.Context, workflowRun *domain.WorkflowRun) (*domain.WorkflowRun, error) {
 	collection, err := app.GetApp().FindCollectionByNameOrId(domain.CollectionNameWorkflowRun)
 	if err != nil {
@@ -56,6 +128,8 @@ func (r *WorkflowRunRepository) Save(ctx context.Context, workflowRun *domain.Wo
 		record.Set("endedAt", workflowRun.EndedAt)
 		record.Set("logs", workflowRun.Logs)
 		record.Set("error", workflowRun.Error)
+		record.Set("staging", workflowRun.Staging)
+		record.Set("idempotencyKey", workflowRun.IdempotencyKey)
 		err = txApp.Save(record)
 		if err != nil {
 			return err
@@ -96,6 +170,47 @@ func (r *WorkflowRunRepository) Save(ctx context.Context, workflowRun *domain.Wo
 	return workflowRun, nil
 }
 
+// 批量删除指定工作流下的运行记录。
+// 返回实际被删除的记录数，等待中或运行中的记录将被忽略。
+// 运行记录的各字段（状态、输出、日志等）均由调度器维护，不存在可由用户修改的字段，因此不提供批量更新。
+func (r *WorkflowRunRepository) DeleteByIds(ctx context.Context, workflowId string, ids []string) (int, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	count := 0
+	err := app.GetApp().RunInTransaction(func(txApp core.App) error {
+		records, err := txApp.FindRecordsByIds(domain.CollectionNameWorkflowRun, ids)
+		if err != nil {
+			return err
+		}
+
+		for _, record := range records {
+			if record.GetString("workflowId") != workflowId {
+				continue
+			}
+
+			status := domain.WorkflowRunStatusType(record.GetString("status"))
+			if status == domain.WorkflowRunStatusTypePending || status == domain.WorkflowRunStatusTypeRunning {
+				continue
+			}
+
+			if err := txApp.Delete(record); err != nil {
+				return err
+			}
+
+			count++
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
 func (r *WorkflowRunRepository) castRecordToModel(record *core.Record) (*domain.WorkflowRun, error) {
 	if record == nil {
 		return nil, fmt.Errorf("record is nil")
@@ -112,13 +227,15 @@ func (r *WorkflowRunRepository) castRecordToModel(record *core.Record) (*domain.
 			CreatedAt: record.GetDateTime("created").Time(),
 			UpdatedAt: record.GetDateTime("updated").Time(),
 		},
-		WorkflowId: record.GetString("workflowId"),
-		Status:     domain.WorkflowRunStatusType(record.GetString("status")),
-		Trigger:    domain.WorkflowTriggerType(record.GetString("trigger")),
-		StartedAt:  record.GetDateTime("startedAt").Time(),
-		EndedAt:    record.GetDateTime("endedAt").Time(),
-		Logs:       logs,
-		Error:      record.GetString("error"),
+		WorkflowId:     record.GetString("workflowId"),
+		Status:         domain.WorkflowRunStatusType(record.GetString("status")),
+		Trigger:        domain.WorkflowTriggerType(record.GetString("trigger")),
+		StartedAt:      record.GetDateTime("startedAt").Time(),
+		EndedAt:        record.GetDateTime("endedAt").Time(),
+		Logs:           logs,
+		Error:          record.GetString("error"),
+		Staging:        record.GetBool("staging"),
+		IdempotencyKey: record.GetString("idempotencyKey"),
 	}
 	return workflowRun, nil
 }
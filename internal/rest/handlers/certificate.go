@@ -2,6 +2,7 @@ package handlers
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
@@ -11,6 +12,9 @@ import (
 )
 
 type certificateService interface {
+	List(ctx context.Context, req *dtos.CertificateListReq) (*dtos.CertificateListResp, error)
+	BatchUpdate(ctx context.Context, req *dtos.CertificateBatchUpdateReq) (*dtos.CertificateBatchUpdateResp, error)
+	BatchDelete(ctx context.Context, req *dtos.CertificateBatchDeleteReq) (*dtos.CertificateBatchDeleteResp, error)
 	ArchiveFile(ctx context.Context, req *dtos.CertificateArchiveFileReq) (*dtos.CertificateArchiveFileResp, error)
 	ValidateCertificate(ctx context.Context, req *dtos.CertificateValidateCertificateReq) (*dtos.CertificateValidateCertificateResp, error)
 	ValidatePrivateKey(ctx context.Context, req *dtos.CertificateValidatePrivateKeyReq) (*dtos.CertificateValidatePrivateKeyResp, error)
@@ -26,11 +30,57 @@ func NewCertificateHandler(router *router.RouterGroup[*core.RequestEvent], servi
 	}
 
 	group := router.Group("/certificates")
+	group.GET("", handler.list)
+	group.POST("/batch-update", handler.batchUpdate)
+	group.POST("/batch-delete", handler.batchDelete)
 	group.POST("/{certificateId}/archive", handler.archiveFile)
 	group.POST("/validate/certificate", handler.validateCertificate)
 	group.POST("/validate/private-key", handler.validatePrivateKey)
 }
 
+func (handler *CertificateHandler) list(e *core.RequestEvent) error {
+	query := e.Request.URL.Query()
+	req := &dtos.CertificateListReq{}
+	req.Keyword = query.Get("keyword")
+	req.State = query.Get("state")
+	req.WorkflowId = query.Get("workflowId")
+	req.Sort = query.Get("sort")
+	req.Cursor = query.Get("cursor")
+	req.Limit, _ = strconv.Atoi(query.Get("limit"))
+
+	if res, err := handler.service.List(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *CertificateHandler) batchUpdate(e *core.RequestEvent) error {
+	req := &dtos.CertificateBatchUpdateReq{}
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	if res, err := handler.service.BatchUpdate(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *CertificateHandler) batchDelete(e *core.RequestEvent) error {
+	req := &dtos.CertificateBatchDeleteReq{}
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	if res, err := handler.service.BatchDelete(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
 func (handler *CertificateHandler) archiveFile(e *core.RequestEvent) error {
 	req := &dtos.CertificateArchiveFileReq{}
 	req.CertificateId = e.Request.PathValue("certificateId")
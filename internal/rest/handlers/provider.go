@@ -0,0 +1,56 @@
+package handlers
+
+import (
+	"context"
+
+	"github.com/pocketbase/pocketbase/core"
+	"github.com/pocketbase/pocketbase/tools/router"
+
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/domain/dtos"
+	"github.com/usual2970/certimate/internal/rest/resp"
+)
+
+type providerService interface {
+	List(ctx context.Context, req *dtos.ProviderListReq) (*dtos.ProviderListResp, error)
+	Get(ctx context.Context, req *dtos.ProviderGetReq) (*domain.ProviderDescriptor, error)
+}
+
+type ProviderHandler struct {
+	service providerService
+}
+
+func NewProviderHandler(router *router.RouterGroup[*core.RequestEvent], service providerService) {
+	handler := &ProviderHandler{
+		service: service,
+	}
+
+	group := router.Group("/providers")
+	group.GET("", handler.list)
+	group.GET("/{kind}/{type}", handler.get)
+}
+
+func (handler *ProviderHandler) list(e *core.RequestEvent) error {
+	query := e.Request.URL.Query()
+	req := &dtos.ProviderListReq{}
+	req.Kind = domain.ProviderKind(query.Get("kind"))
+	req.Capability = domain.ProviderCapability(query.Get("capability"))
+
+	if res, err := handler.service.List(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *ProviderHandler) get(e *core.RequestEvent) error {
+	req := &dtos.ProviderGetReq{}
+	req.Kind = domain.ProviderKind(e.Request.PathValue("kind"))
+	req.Type = e.Request.PathValue("type")
+
+	if res, err := handler.service.Get(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
@@ -2,17 +2,23 @@ package handlers
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/pocketbase/pocketbase/core"
 	"github.com/pocketbase/pocketbase/tools/router"
 
+	"github.com/usual2970/certimate/internal/domain"
 	"github.com/usual2970/certimate/internal/domain/dtos"
 	"github.com/usual2970/certimate/internal/rest/resp"
 )
 
 type workflowService interface {
-	StartRun(ctx context.Context, req *dtos.WorkflowStartRunReq) error
+	ListRuns(ctx context.Context, req *dtos.WorkflowListRunsReq) (*dtos.WorkflowListRunsResp, error)
+	BatchDeleteRuns(ctx context.Context, req *dtos.WorkflowBatchDeleteRunsReq) (*dtos.WorkflowBatchDeleteRunsResp, error)
+	StartRun(ctx context.Context, req *dtos.WorkflowStartRunReq) (*dtos.WorkflowStartRunResp, error)
+	PromoteRun(ctx context.Context, req *dtos.WorkflowPromoteRunReq) (*dtos.WorkflowStartRunResp, error)
 	CancelRun(ctx context.Context, req *dtos.WorkflowCancelRunReq) error
+	RollbackNode(ctx context.Context, req *dtos.WorkflowRollbackNodeReq) (*dtos.WorkflowRollbackNodeResp, error)
 	Shutdown(ctx context.Context)
 }
 
@@ -26,8 +32,12 @@ func NewWorkflowHandler(router *router.RouterGroup[*core.RequestEvent], service
 	}
 
 	group := router.Group("/workflows")
+	group.GET("/{workflowId}/runs", handler.listRuns)
 	group.POST("/{workflowId}/runs", handler.run)
+	group.POST("/{workflowId}/runs/batch-delete", handler.batchDeleteRuns)
+	group.POST("/{workflowId}/runs/{runId}/promote", handler.promote)
 	group.POST("/{workflowId}/runs/{runId}/cancel", handler.cancel)
+	group.POST("/{workflowId}/nodes/{nodeId}/rollback", handler.rollbackNode)
 }
 
 func (handler *WorkflowHandler) run(e *core.RequestEvent) error {
@@ -37,11 +47,58 @@ func (handler *WorkflowHandler) run(e *core.RequestEvent) error {
 		return resp.Err(e, err)
 	}
 
-	if err := handler.service.StartRun(e.Request.Context(), req); err != nil {
+	if key := e.Request.Header.Get("Idempotency-Key"); key != "" {
+		req.IdempotencyKey = key
+	}
+
+	if res, err := handler.service.StartRun(e.Request.Context(), req); err != nil {
 		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
 	}
+}
 
-	return resp.Ok(e, nil)
+func (handler *WorkflowHandler) listRuns(e *core.RequestEvent) error {
+	query := e.Request.URL.Query()
+	req := &dtos.WorkflowListRunsReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	req.Status = domain.WorkflowRunStatusType(query.Get("status"))
+	req.Trigger = domain.WorkflowTriggerType(query.Get("trigger"))
+	req.Sort = query.Get("sort")
+	req.Cursor = query.Get("cursor")
+	req.Limit, _ = strconv.Atoi(query.Get("limit"))
+
+	if res, err := handler.service.ListRuns(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *WorkflowHandler) batchDeleteRuns(e *core.RequestEvent) error {
+	req := &dtos.WorkflowBatchDeleteRunsReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	if err := e.BindBody(req); err != nil {
+		return resp.Err(e, err)
+	}
+
+	if res, err := handler.service.BatchDeleteRuns(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
+
+func (handler *WorkflowHandler) promote(e *core.RequestEvent) error {
+	req := &dtos.WorkflowPromoteRunReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	req.RunId = e.Request.PathValue("runId")
+
+	if res, err := handler.service.PromoteRun(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
 }
 
 func (handler *WorkflowHandler) cancel(e *core.RequestEvent) error {
@@ -55,3 +112,15 @@ func (handler *WorkflowHandler) cancel(e *core.RequestEvent) error {
 
 	return resp.Ok(e, nil)
 }
+
+func (handler *WorkflowHandler) rollbackNode(e *core.RequestEvent) error {
+	req := &dtos.WorkflowRollbackNodeReq{}
+	req.WorkflowId = e.Request.PathValue("workflowId")
+	req.NodeId = e.Request.PathValue("nodeId")
+
+	if res, err := handler.service.RollbackNode(e.Request.Context(), req); err != nil {
+		return resp.Err(e, err)
+	} else {
+		return resp.Ok(e, res)
+	}
+}
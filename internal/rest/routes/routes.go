@@ -9,6 +9,7 @@ import (
 
 	"github.com/usual2970/certimate/internal/certificate"
 	"github.com/usual2970/certimate/internal/notify"
+	"github.com/usual2970/certimate/internal/provider"
 	"github.com/usual2970/certimate/internal/repository"
 	"github.com/usual2970/certimate/internal/rest/handlers"
 	"github.com/usual2970/certimate/internal/statistics"
@@ -20,6 +21,7 @@ var (
 	workflowSvc    *workflow.WorkflowService
 	statisticsSvc  *statistics.StatisticsService
 	notifySvc      *notify.NotifyService
+	providerSvc    *provider.ProviderService
 )
 
 func Register(router *router.Router[*core.RequestEvent]) {
@@ -36,12 +38,15 @@ func Register(router *router.Router[*core.RequestEvent]) {
 	settingsRepo := repository.NewSettingsRepository()
 	notifySvc = notify.NewNotifyService(settingsRepo)
 
+	providerSvc = provider.NewProviderService()
+
 	group := router.Group("/api")
 	group.Bind(apis.RequireSuperuserAuth())
 	handlers.NewCertificateHandler(group, certificateSvc)
 	handlers.NewWorkflowHandler(group, workflowSvc)
 	handlers.NewStatisticsHandler(group, statisticsSvc)
 	handlers.NewNotifyHandler(group, notifySvc)
+	handlers.NewProviderHandler(group, providerSvc)
 }
 
 func Unregister() {
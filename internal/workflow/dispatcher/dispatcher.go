@@ -18,7 +18,7 @@ var maxWorkers = 16
 
 func init() {
 	envMaxWorkers := os.Getenv("CERTIMATE_WORKFLOW_MAX_WORKERS")
-	if n, err := strconv.Atoi(envMaxWorkers); err != nil && n > 0 {
+	if n, err := strconv.Atoi(envMaxWorkers); err == nil && n > 0 {
 		maxWorkers = n
 	}
 }
@@ -32,6 +32,7 @@ type WorkflowWorkerData struct {
 	WorkflowId      string
 	WorkflowContent *domain.WorkflowNode
 	RunId           string
+	RunStaging      bool
 }
 
 type WorkflowDispatcher struct {
@@ -132,6 +133,84 @@ func (w *WorkflowDispatcher) Cancel(runId string) {
 	}
 }
 
+// 独占指定工作流的执行权，用于在调度队列之外同步执行的操作（如手动回滚）。
+// 持有期间，相同 WorkflowId 的 WorkflowRun 将保持排队，直至释放；同时占用一个并发名额，受最大并发数限制。
+// 取得执行权后再创建对应的 WorkflowRun，并通过 [WorkflowDispatcher.Bind] 关联，此后即可通过 [WorkflowDispatcher.Cancel] 取消，此时 workerCtx 将被取消。
+//
+// 入参:
+//   - ctx: 上下文。workerCtx 派生于此，调用方应传入不会随请求结束而取消的上下文。
+//   - workflowId: 工作流 ID。
+//
+// 出参:
+//   - workerCtx: 持有期间使用的上下文，取消或停机时将被取消。
+//   - release: 用于释放执行权的函数。
+//   - err: 错误。当该工作流存在正在执行或排队中的 WorkflowRun，或已达到最大并发数时返回错误。
+func (w *WorkflowDispatcher) Acquire(ctx context.Context, workflowId string) (workerCtx context.Context, release func(), err error) {
+	select {
+	case w.semaphore <- struct{}{}:
+	default:
+		return nil, nil, errors.New("too many workflows are running")
+	}
+
+	w.workerMutex.Lock()
+	defer w.workerMutex.Unlock()
+
+	if _, exists := w.workers[workflowId]; exists {
+		<-w.semaphore
+		return nil, nil, errors.New("workflow is already running")
+	}
+
+	w.queueMutex.Lock()
+	queued := slices.Some(w.queue, func(d *WorkflowWorkerData) bool {
+		return d.WorkflowId == workflowId
+	})
+	w.queueMutex.Unlock()
+	if queued {
+		<-w.semaphore
+		return nil, nil, errors.New("workflow is already pending")
+	}
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	worker := &workflowWorker{&WorkflowWorkerData{WorkflowId: workflowId}, cancel}
+	w.workers[workflowId] = worker
+	w.wg.Add(1)
+
+	release = func() {
+		cancel()
+		<-w.semaphore
+
+		// 已被取消时，worker 已由 Cancel 移除
+		w.workerMutex.Lock()
+		if w.workers[workflowId] == worker {
+			delete(w.workers, workflowId)
+			delete(w.workerIdMap, worker.Data.RunId)
+		}
+		w.workerMutex.Unlock()
+
+		w.wg.Done()
+
+		// 尝试取出排队中的其他 WorkflowRun 继续执行
+		select {
+		case w.chCandi <- struct{}{}:
+		default:
+		}
+	}
+
+	return workerCtx, release, nil
+}
+
+// 为通过 [WorkflowDispatcher.Acquire] 持有的执行权关联 WorkflowRun。
+// 仅在执行权仍被持有且尚未关联时生效。
+func (w *WorkflowDispatcher) Bind(workflowId string, runId string) {
+	w.workerMutex.Lock()
+	defer w.workerMutex.Unlock()
+
+	if worker, ok := w.workers[workflowId]; ok && worker.Data.RunId == "" {
+		worker.Data.RunId = runId
+		w.workerIdMap[runId] = workflowId
+	}
+}
+
 func (w *WorkflowDispatcher) Shutdown() {
 	// 清空排队中的 WorkflowRun
 	w.queueMutex.Lock()
@@ -12,6 +12,7 @@ type workflowInvoker struct {
 	workflowId      string
 	workflowContent *domain.WorkflowNode
 	runId           string
+	runStaging      bool
 	runLogs         []domain.WorkflowRunLog
 
 	workflowRunRepo workflowRunRepository
@@ -27,6 +28,7 @@ func newWorkflowInvokerWithData(workflowRunRepo workflowRunRepository, data *Wor
 		workflowId:      data.WorkflowId,
 		workflowContent: data.WorkflowContent,
 		runId:           data.RunId,
+		runStaging:      data.RunStaging,
 		runLogs:         make([]domain.WorkflowRunLog, 0),
 
 		workflowRunRepo: workflowRunRepo,
@@ -36,6 +38,7 @@ func newWorkflowInvokerWithData(workflowRunRepo workflowRunRepository, data *Wor
 func (w *workflowInvoker) Invoke(ctx context.Context) error {
 	ctx = context.WithValue(ctx, "workflow_id", w.workflowId)
 	ctx = context.WithValue(ctx, "workflow_run_id", w.runId)
+	ctx = context.WithValue(ctx, "workflow_run_staging", w.runStaging)
 	return w.processNode(ctx, w.workflowContent)
 }
 
@@ -94,6 +97,8 @@ func (w *workflowInvoker) processNode(ctx context.Context, node *domain.Workflow
 		if procErr != nil && current.Next != nil && current.Next.Type != domain.WorkflowNodeTypeExecuteResultBranch {
 			return procErr
 		} else if procErr != nil && current.Next != nil && current.Next.Type == domain.WorkflowNodeTypeExecuteResultBranch {
+			// 记录执行失败的节点，以便执行失败分支进行回滚等操作
+			ctx = context.WithValue(ctx, "workflow_failed_node", current)
 			current = w.getBranchByType(current.Next.Branches, domain.WorkflowNodeTypeExecuteFailure)
 		} else if procErr == nil && current.Next != nil && current.Next.Type == domain.WorkflowNodeTypeExecuteResultBranch {
 			current = w.getBranchByType(current.Next.Branches, domain.WorkflowNodeTypeExecuteSuccess)
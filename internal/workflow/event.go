@@ -2,19 +2,29 @@ package workflow
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"reflect"
+	"strings"
 
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/pocketbase/pocketbase/core"
 
 	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/applicant"
+	"github.com/usual2970/certimate/internal/deployer"
 	"github.com/usual2970/certimate/internal/domain"
-	"github.com/usual2970/certimate/internal/domain/dtos"
+	"github.com/usual2970/certimate/internal/pkg/utils/jsonschema"
 	"github.com/usual2970/certimate/internal/repository"
 )
 
 func Register() {
 	app := app.GetApp()
 	app.OnRecordCreateRequest(domain.CollectionNameWorkflow).BindFunc(func(e *core.RecordRequestEvent) error {
+		if err := validateWorkflowRecordContent(e); err != nil {
+			return err
+		}
+
 		if err := e.Next(); err != nil {
 			return err
 		}
@@ -26,6 +36,10 @@ func Register() {
 		return nil
 	})
 	app.OnRecordUpdateRequest(domain.CollectionNameWorkflow).BindFunc(func(e *core.RecordRequestEvent) error {
+		if err := validateWorkflowRecordContent(e); err != nil {
+			return err
+		}
+
 		if err := e.Next(); err != nil {
 			return err
 		}
@@ -49,6 +63,66 @@ func Register() {
 	})
 }
 
+// 发布工作流时校验各节点的提供商配置，并以字段级错误返回校验结果。
+// 草稿允许保存不完整的配置，因此不做校验。
+func validateWorkflowRecordContent(e *core.RecordRequestEvent) error {
+	record := e.Record
+	if !record.IsNew() && reflect.DeepEqual(record.Original().GetRaw("content"), record.GetRaw("content")) {
+		return nil
+	}
+
+	content := &domain.WorkflowNode{}
+	if err := record.UnmarshalJSONField("content", content); err != nil {
+		return e.BadRequestError("Failed to parse workflow content.", err)
+	}
+
+	nodeErrs := validation.Errors{}
+	nodeErrMsgs := make([]string, 0)
+	for _, node := range flattenWorkflowNodes(content) {
+		var err error
+		switch node.Type {
+		case domain.WorkflowNodeTypeApply:
+			err = applicant.ValidateApplyNode(node)
+		case domain.WorkflowNodeTypeDeploy:
+			err = deployer.ValidateDeployNode(node)
+		}
+		if err == nil {
+			continue
+		}
+
+		var verr *jsonschema.ValidationError
+		if !errors.As(err, &verr) {
+			return err
+		}
+
+		fieldErrs := validation.Errors{}
+		for _, fe := range verr.Errors {
+			fieldErrs[fe.Field] = validation.NewError("validation_invalid_provider_config", fe.Message)
+		}
+		nodeErrs[node.Id] = fieldErrs
+		nodeErrMsgs = append(nodeErrMsgs, fmt.Sprintf("node '%s': %s", node.Name, verr.Error()))
+	}
+	if len(nodeErrs) > 0 {
+		// 错误信息中包含各节点的校验结果以便直接展示，错误数据中则按节点 ID 及字段路径提供结构化的校验结果
+		message := fmt.Sprintf("Invalid provider config of workflow %s.", strings.Join(nodeErrMsgs, "; "))
+		return e.BadRequestError(message, validation.Errors{"content": nodeErrs})
+	}
+
+	return nil
+}
+
+func flattenWorkflowNodes(node *domain.WorkflowNode) []*domain.WorkflowNode {
+	nodes := make([]*domain.WorkflowNode, 0)
+	for current := node; current != nil; current = current.Next {
+		nodes = append(nodes, current)
+		for i := range current.Branches {
+			nodes = append(nodes, flattenWorkflowNodes(&current.Branches[i])...)
+		}
+	}
+
+	return nodes
+}
+
 func onWorkflowRecordCreateOrUpdate(ctx context.Context, record *core.Record) error {
 	scheduler := app.GetScheduler()
 
@@ -66,10 +140,7 @@ func onWorkflowRecordCreateOrUpdate(ctx context.Context, record *core.Record) er
 	// 反之，重新添加定时任务
 	err := scheduler.Add(fmt.Sprintf("workflow#%s", workflowId), record.GetString("triggerCron"), func() {
 		workflowSrv := NewWorkflowService(repository.NewWorkflowRepository(), repository.NewWorkflowRunRepository())
-		workflowSrv.StartRun(ctx, &dtos.WorkflowStartRunReq{
-			WorkflowId: workflowId,
-			RunTrigger: domain.WorkflowTriggerTypeAuto,
-		})
+		startScheduledRun(context.Background(), workflowSrv, workflowId)
 	})
 	if err != nil {
 		return fmt.Errorf("add cron job failed: %w", err)
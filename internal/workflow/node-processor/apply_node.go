@@ -34,6 +34,12 @@ func NewApplyNode(node *domain.WorkflowNode) *applyNode {
 func (n *applyNode) Process(ctx context.Context) error {
 	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "进入申请证书节点")
 
+	// 校验提供商配置，避免执行到一半才因配置缺失而失败
+	if err := applicant.ValidateApplyNode(n.node); err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "申请配置校验失败", err.Error())
+		return err
+	}
+
 	// 查询上次执行结果
 	lastOutput, err := n.outputRepo.GetByNodeId(ctx, n.node.Id)
 	if err != nil && !domain.IsRecordNotFoundError(err) {
@@ -42,13 +48,16 @@ func (n *applyNode) Process(ctx context.Context) error {
 	}
 
 	// 检测是否可以跳过本次执行
-	if skippable, skipReason := n.checkCanSkip(ctx, lastOutput); skippable {
+	staging := getContextWorkflowRunStaging(ctx)
+	if staging {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "以测试环境模式执行，将向 CA 测试环境申请证书")
+	} else if skippable, skipReason := n.checkCanSkip(ctx, lastOutput); skippable {
 		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, skipReason)
 		return nil
 	}
 
 	// 初始化申请器
-	applicant, err := applicant.NewWithApplyNode(n.node)
+	applicant, err := applicant.NewWithApplyNode(n.node, staging)
 	if err != nil {
 		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "获取申请对象失败", err.Error())
 		return err
@@ -78,6 +87,15 @@ func (n *applyNode) Process(ctx context.Context) error {
 		ACMECertStableUrl: applyResult.ACMECertStableUrl,
 	}
 	certificate.PopulateFromX509(certX509)
+	if certificate.PrivateKey == "" {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "证书基于外部 CSR 签发，私钥由外部提供商保管，仅能部署到支持外部私钥的目标")
+	}
+
+	// 测试环境签发的证书不受信任，不保存执行结果，避免被后续节点部署或影响正式环境的续期判断
+	if staging {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "测试环境证书不会被保存")
+		return nil
+	}
 
 	// 保存执行结果
 	output := &domain.WorkflowOutput{
@@ -117,6 +135,11 @@ func (n *applyNode) checkCanSkip(ctx context.Context, lastOutput *domain.Workflo
 		if currentNodeConfig.KeyAlgorithm != lastNodeConfig.KeyAlgorithm {
 			return false, "配置项变化：数字签名算法"
 		}
+		if currentNodeConfig.CSRProvider != lastNodeConfig.CSRProvider ||
+			currentNodeConfig.CSRProviderAccessId != lastNodeConfig.CSRProviderAccessId ||
+			!maps.Equal(currentNodeConfig.CSRProviderConfig, lastNodeConfig.CSRProviderConfig) {
+			return false, "配置项变化：外部 CSR 提供商"
+		}
 
 		lastCertificate, _ := n.certRepo.GetByWorkflowNodeId(ctx, n.node.Id)
 		if lastCertificate != nil {
@@ -2,11 +2,20 @@ package nodeprocessor
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
+	"time"
 
 	"github.com/usual2970/certimate/internal/deployer"
 	"github.com/usual2970/certimate/internal/domain"
+	coreDeployer "github.com/usual2970/certimate/internal/pkg/core/deployer"
+	"github.com/usual2970/certimate/internal/pkg/core/uploader"
+	"github.com/usual2970/certimate/internal/pkg/utils/certs"
+	"github.com/usual2970/certimate/internal/pkg/utils/concurrent"
 	"github.com/usual2970/certimate/internal/repository"
 	"golang.org/x/exp/maps"
 )
@@ -32,6 +41,12 @@ func NewDeployNode(node *domain.WorkflowNode) *deployNode {
 func (n *deployNode) Process(ctx context.Context) error {
 	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "开始执行")
 
+	// 校验提供商配置，避免执行到一半才因配置缺失而失败
+	if err := deployer.ValidateDeployNode(n.node); err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "部署配置校验失败", err.Error())
+		return err
+	}
+
 	// 查询上次执行结果
 	lastOutput, err := n.outputRepo.GetByNodeId(ctx, n.node.Id)
 	if err != nil && !domain.IsRecordNotFoundError(err) {
@@ -39,6 +54,10 @@ func (n *deployNode) Process(ctx context.Context) error {
 		return err
 	}
 
+	// 测试环境模式下，部署节点总是以仅校验模式执行
+	staging := getContextWorkflowRunStaging(ctx)
+	dryRun := staging || deployer.IsDryRun(n.node)
+
 	// 获取前序节点输出证书
 	previousNodeOutputCertificateSource := n.node.GetConfigForDeploy().Certificate
 	previousNodeOutputCertificateSourceSlice := strings.Split(previousNodeOutputCertificateSource, "#")
@@ -48,41 +67,335 @@ func (n *deployNode) Process(ctx context.Context) error {
 	}
 	certificate, err := n.certRepo.GetByWorkflowNodeId(ctx, previousNodeOutputCertificateSourceSlice[0])
 	if err != nil {
+		if staging && domain.IsRecordNotFoundError(err) {
+			n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelWarn, "测试环境模式下尚无可用于校验的证书，跳过此次部署")
+			return nil
+		}
+
 		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "获取证书失败", err.Error())
 		return err
 	}
 
 	// 检测是否可以跳过本次执行
-	if lastOutput != nil && certificate.CreatedAt.Before(lastOutput.UpdatedAt) {
+	if !dryRun && lastOutput != nil && certificate.CreatedAt.Before(lastOutput.UpdatedAt) {
 		if skippable, skipReason := n.checkCanSkip(ctx, lastOutput); skippable {
 			n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, skipReason)
 			return nil
 		}
 	}
 
+	// 配置了批量部署目标时，并发部署到各个目标
+	if len(n.node.GetConfigForDeploy().BatchTargets) > 0 {
+		return n.processBatch(ctx, certificate, dryRun)
+	}
+
 	// 初始化部署器
-	deployer, err := deployer.NewWithDeployNode(n.node, struct {
+	d, err := deployer.NewWithDeployNode(n.node, struct {
 		Certificate string
 		PrivateKey  string
-	}{Certificate: certificate.Certificate, PrivateKey: certificate.PrivateKey})
+	}{Certificate: certificate.Certificate, PrivateKey: certificate.PrivateKey}, dryRun)
 	if err != nil {
 		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "获取部署对象失败", err.Error())
 		return err
 	}
 
 	// 部署证书
-	if err := deployer.Deploy(ctx); err != nil {
-		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "部署失败", err.Error())
+	res, deployed, err := n.deploy(ctx, d, certificate.Certificate, dryRun, n.AppendLogRecord)
+	if err != nil {
 		return err
+	} else if !deployed {
+		return nil
+	}
+
+	// 记录本次部署的证书及部署前所用的证书，以便回滚
+	// 重复部署同一证书时，沿用上次记录的部署前所用的证书
+	previousCertificateId := ""
+	if lastOutput != nil && lastOutput.Succeeded {
+		previousCertificateId = getOutputValueAsString(lastOutput, domain.WorkflowNodeIONameDeployedCertificate)
+		if previousCertificateId == certificate.Id {
+			previousCertificateId = getOutputValueAsString(lastOutput, domain.WorkflowNodeIONamePreviousCertificate)
+		}
 	}
-	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "部署成功")
 
 	// 保存执行结果
+	// 部署结果一并保存，以便后续节点及界面查看实际部署情况
 	output := &domain.WorkflowOutput{
 		WorkflowId: getContextWorkflowId(ctx),
 		RunId:      getContextWorkflowRunId(ctx),
 		NodeId:     n.node.Id,
 		Node:       n.node,
+		Outputs:    newDeployOutputs(res, certificate.Id, previousCertificateId),
+		Succeeded:  true,
+	}
+	if _, err := n.outputRepo.Save(ctx, output); err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "保存部署记录失败", err.Error())
+		return err
+	}
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "保存部署记录成功")
+
+	return nil
+}
+
+type deployTargetResult struct {
+	Name      string                     `json:"name"`
+	Succeeded bool                       `json:"succeeded"`
+	Skipped   bool                       `json:"skipped,omitempty"`
+	Error     string                     `json:"error,omitempty"`
+	Result    *coreDeployer.DeployResult `json:"result,omitempty"`
+}
+
+func (n *deployNode) processBatch(ctx context.Context, certificate *domain.Certificate, dryRun bool) error {
+	nodeConfig := n.node.GetConfigForDeploy()
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, fmt.Sprintf("批量部署到 %d 个目标", len(nodeConfig.BatchTargets)))
+
+	indexes := make([]int, len(nodeConfig.BatchTargets))
+	for i := range indexes {
+		indexes[i] = i
+	}
+
+	// 未命名的部署目标以序号作为名称
+	for i := range nodeConfig.BatchTargets {
+		if nodeConfig.BatchTargets[i].Name == "" {
+			nodeConfig.BatchTargets[i].Name = fmt.Sprintf("#%d", i+1)
+		}
+	}
+
+	results := make([]*deployTargetResult, len(nodeConfig.BatchTargets))
+	concurrent.ForEach(ctx, indexes, int(nodeConfig.BatchConcurrency), func(ctx context.Context, i int) error {
+		target := nodeConfig.BatchTargets[i]
+
+		result := &deployTargetResult{Name: target.Name}
+		results[i] = result
+
+		// 各部署目标的日志以目标名称作为前缀，便于区分
+		logf := func(ctx context.Context, level domain.WorkflowRunLogLevel, content string, err ...string) {
+			n.AppendLogRecord(ctx, level, fmt.Sprintf("[%s] %s", target.Name, content), err...)
+		}
+
+		d, err := deployer.NewWithDeployNodeTarget(n.node, target, struct {
+			Certificate string
+			PrivateKey  string
+		}{Certificate: certificate.Certificate, PrivateKey: certificate.PrivateKey}, dryRun)
+		if err != nil {
+			logf(ctx, domain.WorkflowRunLogLevelError, "获取部署对象失败", err.Error())
+			result.Error = err.Error()
+			return err
+		}
+
+		res, deployed, err := n.deploy(ctx, d, certificate.Certificate, dryRun, logf)
+		if err != nil {
+			// 部署失败时同样保留部署器给出的结果详情（如各主机的部署情况），以便界面查看
+			result.Error = err.Error()
+			result.Result = res
+			return err
+		}
+
+		result.Succeeded = true
+		result.Skipped = !deployed
+		result.Result = res
+		return nil
+	})
+
+	// 上下文取消时，尚未开始执行的部署目标不会产生结果
+	failed := 0
+	for i, result := range results {
+		if result == nil {
+			results[i] = &deployTargetResult{Name: nodeConfig.BatchTargets[i].Name, Error: context.Cause(ctx).Error()}
+			failed++
+		} else if !result.Succeeded {
+			failed++
+		}
+	}
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, fmt.Sprintf("批量部署完成：成功 %d 个，失败 %d 个", len(results)-failed, failed))
+
+	// 仅校验模式下不保存执行结果，避免影响后续的重复部署判断
+	if dryRun {
+		if failed > 0 {
+			return fmt.Errorf("%d 个部署目标校验失败", failed)
+		}
+		return nil
+	}
+
+	// 保存执行结果
+	// 部分目标部署失败时同样保存，以便界面查看各目标的部署情况；此时下次执行不会跳过
+	output := &domain.WorkflowOutput{
+		WorkflowId: getContextWorkflowId(ctx),
+		RunId:      getContextWorkflowRunId(ctx),
+		NodeId:     n.node.Id,
+		Node:       n.node,
+		Outputs: []domain.WorkflowNodeIO{
+			{
+				Label: "部署结果",
+				Name:  domain.WorkflowNodeIONameDeployResult,
+				Type:  domain.WorkflowNodeIONameDeployResult,
+				Value: results,
+			},
+		},
+		Succeeded: failed == 0,
+	}
+	if _, err := n.outputRepo.Save(ctx, output); err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "保存部署记录失败", err.Error())
+		return err
+	}
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "保存部署记录成功")
+
+	if failed > 0 {
+		return fmt.Errorf("%d 个部署目标部署失败", failed)
+	}
+
+	return nil
+}
+
+// 使用指定的部署器部署证书，返回部署结果及是否实际进行了部署。
+// 仅校验模式下或因部署目标当前所用证书未发生变化而跳过时，不会实际进行部署。
+// 部署失败时，若部署器给出了结果详情，同样一并返回。
+func (n *deployNode) deploy(ctx context.Context, d deployer.Deployer, certPem string, dryRun bool, logf func(ctx context.Context, level domain.WorkflowRunLogLevel, content string, err ...string)) (*coreDeployer.DeployResult, bool, error) {
+	if dryRun {
+		logf(ctx, domain.WorkflowRunLogLevelInfo, "以仅校验模式执行，不会实际部署证书")
+
+		if _, err := d.Deploy(ctx); err != nil {
+			// 不支持仅校验模式的部署目标无法完成校验，视为校验失败，避免误报为校验成功
+			if errors.Is(err, deployer.ErrDryRunNotSupported) {
+				logf(ctx, domain.WorkflowRunLogLevelError, "该部署目标不支持仅校验模式，无法完成校验", err.Error())
+				return nil, false, err
+			}
+
+			logf(ctx, domain.WorkflowRunLogLevelError, "校验失败", err.Error())
+			return nil, false, err
+		}
+
+		logf(ctx, domain.WorkflowRunLogLevelInfo, "校验成功")
+		return nil, false, nil
+	}
+
+	// 比对部署目标当前所用证书，未发生变化时跳过本次部署
+	// 查询失败时不影响部署
+	if n.node.GetConfigForDeploy().SkipOnUnchanged {
+		unchanged, err := n.checkDeployedCertificateUnchanged(ctx, d, certPem)
+		if err != nil {
+			if errors.Is(err, deployer.ErrDeployedCertificateNotSupported) {
+				logf(ctx, domain.WorkflowRunLogLevelWarn, "该部署目标不支持查询当前所用证书，继续部署")
+			} else {
+				logf(ctx, domain.WorkflowRunLogLevelWarn, "查询部署目标当前所用证书失败，继续部署", err.Error())
+			}
+		} else if unchanged {
+			logf(ctx, domain.WorkflowRunLogLevelInfo, "部署目标当前所用证书未发生变化，跳过此次部署")
+			return nil, false, nil
+		}
+	}
+
+	res, err := d.Deploy(ctx)
+	if res != nil && len(res.ExtendedData) > 0 {
+		if extendedData, err := json.Marshal(res.ExtendedData); err == nil {
+			logf(ctx, domain.WorkflowRunLogLevelInfo, fmt.Sprintf("部署结果详情：%s", extendedData))
+		}
+	}
+	if err != nil {
+		logf(ctx, domain.WorkflowRunLogLevelError, "部署失败", err.Error())
+		return res, false, err
+	}
+	logf(ctx, domain.WorkflowRunLogLevelInfo, "部署成功")
+
+	// 清理旧证书
+	// 清理失败不影响部署结果
+	if n.node.GetConfigForDeploy().CleanupCertificates {
+		if certIds, err := d.CleanupCertificates(ctx); err != nil {
+			if errors.Is(err, uploader.ErrCleanupNotSupported) {
+				logf(ctx, domain.WorkflowRunLogLevelWarn, "该部署目标不支持清理旧证书，跳过清理")
+			} else {
+				logf(ctx, domain.WorkflowRunLogLevelWarn, "清理旧证书失败", err.Error())
+			}
+		} else if len(certIds) > 0 {
+			logf(ctx, domain.WorkflowRunLogLevelInfo, fmt.Sprintf("已清理 %d 张旧证书", len(certIds)), strings.Join(certIds, ", "))
+		}
+	}
+
+	return res, true, nil
+}
+
+// 回滚部署节点，即使用相同的部署目标重新部署此前所用的证书。
+// 部署失败后回滚时，重新部署失败前所用的证书；否则重新部署上一次成功部署前所用的证书，并记录为新的执行结果。
+func (n *deployNode) Rollback(ctx context.Context, afterFailure bool) error {
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "开始回滚")
+
+	if getContextWorkflowRunStaging(ctx) {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelWarn, "测试环境模式下不执行回滚")
+		return nil
+	}
+
+	// 仅校验模式下不会实际变更部署目标，回滚将真实地重新部署证书，因此同样跳过
+	if deployer.IsDryRun(n.node) {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelWarn, "仅校验模式下不执行回滚")
+		return nil
+	}
+
+	if len(n.node.GetConfigForDeploy().BatchTargets) > 0 {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "回滚失败", "批量部署节点暂不支持回滚")
+		return errors.New("批量部署节点暂不支持回滚")
+	}
+
+	lastOutput, err := n.outputRepo.GetByNodeId(ctx, n.node.Id)
+	if err != nil {
+		if domain.IsRecordNotFoundError(err) {
+			n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "回滚失败", "尚无部署记录")
+			return errors.New("尚无部署记录")
+		}
+
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "查询部署记录失败", err.Error())
+		return err
+	} else if !lastOutput.Succeeded {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "回滚失败", "上次部署未成功")
+		return errors.New("上次部署未成功")
+	}
+
+	certificateId := getOutputValueAsString(lastOutput, domain.WorkflowNodeIONamePreviousCertificate)
+	if afterFailure {
+		certificateId = getOutputValueAsString(lastOutput, domain.WorkflowNodeIONameDeployedCertificate)
+	}
+	if certificateId == "" {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "回滚失败", "未记录此前所用的证书")
+		return errors.New("未记录此前所用的证书")
+	}
+
+	certificate, err := n.certRepo.GetById(ctx, certificateId)
+	if err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "获取此前所用的证书失败", err.Error())
+		return err
+	}
+
+	// 使用上次部署时的节点配置，确保部署到相同的目标
+	node := n.node
+	if lastOutput.Node != nil {
+		node = lastOutput.Node
+	}
+
+	d, err := deployer.NewWithDeployNode(node, struct {
+		Certificate string
+		PrivateKey  string
+	}{Certificate: certificate.Certificate, PrivateKey: certificate.PrivateKey}, false)
+	if err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "获取部署对象失败", err.Error())
+		return err
+	}
+
+	res, err := d.Deploy(ctx)
+	if err != nil {
+		n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelError, "回滚失败", err.Error())
+		return err
+	}
+	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, fmt.Sprintf("回滚成功，已重新部署证书 #%s", certificate.Id))
+
+	// 部署失败后回滚时，上次的执行结果仍与部署目标当前所用的证书一致，无需更新
+	if afterFailure {
+		return nil
+	}
+
+	output := &domain.WorkflowOutput{
+		WorkflowId: lastOutput.WorkflowId,
+		RunId:      getContextWorkflowRunId(ctx),
+		NodeId:     lastOutput.NodeId,
+		Node:       node,
+		Outputs:    newDeployOutputs(res, certificate.Id, getOutputValueAsString(lastOutput, domain.WorkflowNodeIONameDeployedCertificate)),
 		Succeeded:  true,
 	}
 	if _, err := n.outputRepo.Save(ctx, output); err != nil {
@@ -105,6 +418,9 @@ func (n *deployNode) checkCanSkip(ctx context.Context, lastOutput *domain.Workfl
 		if !maps.Equal(currentNodeConfig.ProviderConfig, lastNodeConfig.ProviderConfig) {
 			return false, "配置项变化：主机提供商参数"
 		}
+		if !reflect.DeepEqual(currentNodeConfig.BatchTargets, lastNodeConfig.BatchTargets) {
+			return false, "配置项变化：批量部署目标"
+		}
 
 		if currentNodeConfig.SkipOnLastSucceeded {
 			return true, "已部署过证书，跳过此次部署"
@@ -113,3 +429,63 @@ func (n *deployNode) checkCanSkip(ctx context.Context, lastOutput *domain.Workfl
 
 	return false, ""
 }
+
+func (n *deployNode) checkDeployedCertificateUnchanged(ctx context.Context, d deployer.Deployer, certPem string) (bool, error) {
+	certX509, err := certs.ParseCertificateFromPEM(certPem)
+	if err != nil {
+		return false, err
+	}
+
+	// 指定了地址时通过 TLS 握手获取，否则通过部署目标查询
+	var deployedCertX509 *x509.Certificate
+	if addr := n.node.GetConfigForDeploy().UnchangedCheckAddr; addr != "" {
+		timeoutCtx, cancel := context.WithTimeout(ctx, 10*time.Second)
+		defer cancel()
+
+		deployedCertX509, err = certs.FetchCertificateFromServer(timeoutCtx, addr, "")
+	} else {
+		deployedCertX509, err = d.GetDeployedCertificate(ctx)
+	}
+	if err != nil {
+		return false, err
+	} else if deployedCertX509 == nil {
+		return false, nil
+	}
+
+	return certs.EqualFingerprint(certs.GetCertificateFingerprintSHA256(certX509), certs.GetCertificateFingerprintSHA256(deployedCertX509)), nil
+}
+
+func newDeployOutputs(res *coreDeployer.DeployResult, certificateId string, previousCertificateId string) []domain.WorkflowNodeIO {
+	return []domain.WorkflowNodeIO{
+		{
+			Label: "部署结果",
+			Name:  domain.WorkflowNodeIONameDeployResult,
+			Type:  domain.WorkflowNodeIONameDeployResult,
+			Value: res,
+		},
+		{
+			Label: "已部署的证书",
+			Name:  domain.WorkflowNodeIONameDeployedCertificate,
+			Type:  "string",
+			Value: certificateId,
+		},
+		{
+			Label: "部署前所用的证书",
+			Name:  domain.WorkflowNodeIONamePreviousCertificate,
+			Type:  "string",
+			Value: previousCertificateId,
+		},
+	}
+}
+
+func getOutputValueAsString(output *domain.WorkflowOutput, name string) string {
+	for _, io := range output.Outputs {
+		if io.Name == name {
+			if value, ok := io.Value.(string); ok {
+				return value
+			}
+		}
+	}
+
+	return ""
+}
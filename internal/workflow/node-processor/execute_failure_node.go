@@ -19,8 +19,21 @@ func NewExecuteFailureNode(node *domain.WorkflowNode) *executeFailureNode {
 }
 
 func (n *executeFailureNode) Process(ctx context.Context) error {
-	// 此类型节点不需要执行任何操作，直接返回
 	n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelInfo, "进入执行失败分支")
 
+	// 按需回滚执行失败的部署节点
+	if n.node.GetConfigForExecuteFailure().Rollback {
+		failedNode := getContextWorkflowFailedNode(ctx)
+		if failedNode == nil || failedNode.Type != domain.WorkflowNodeTypeDeploy {
+			n.AppendLogRecord(ctx, domain.WorkflowRunLogLevelWarn, "执行失败的节点不是部署节点，跳过回滚")
+			return nil
+		}
+
+		// 回滚日志记录到当前节点下
+		deployNode := NewDeployNode(failedNode)
+		deployNode.nodeLogger = n.nodeLogger
+		return deployNode.Rollback(ctx, true)
+	}
+
 	return nil
 }
@@ -0,0 +1,106 @@
+package nodeprocessor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/usual2970/certimate/internal/pkg/utils/files"
+)
+
+const (
+	// 单条日志记录内容的最大字节数，超出部分将被拆分为多条记录
+	maxLogChunkSize = 8 * 1024
+	// 单个节点日志内容的最大字节数，超出部分将被丢弃
+	maxLogSize = 256 * 1024
+
+	logTruncatedMarker = "[日志内容过长，后续内容已被截断]"
+)
+
+// 表示超长日志内容的外部存储。
+type LogSink interface {
+	// 写入日志内容。
+	//
+	// 入参：
+	//   - ctx: 上下文。
+	//   - nodeId: 工作流节点 ID。
+	//   - content: 日志内容。
+	//
+	// 出参：
+	//   - ref: 日志内容的引用地址。
+	//   - err: 错误。
+	Write(ctx context.Context, nodeId string, content string) (ref string, err error)
+}
+
+var logSink LogSink
+
+func init() {
+	if dir := os.Getenv("CERTIMATE_WORKFLOW_LOG_SINK_DIR"); dir != "" {
+		logSink = &fileLogSink{dir: dir}
+	}
+}
+
+// 设置超长日志内容的外部存储。传入 nil 表示禁用。
+func SetLogSink(sink LogSink) {
+	logSink = sink
+}
+
+type fileLogSink struct {
+	dir string
+}
+
+func (s *fileLogSink) Write(ctx context.Context, nodeId string, content string) (string, error) {
+	runId, _ := ctx.Value("workflow_run_id").(string)
+	if runId == "" {
+		runId = "_"
+	}
+
+	path := filepath.Join(s.dir, runId, fmt.Sprintf("%s-%s.log", nodeId, strconv.FormatInt(time.Now().UnixNano(), 10)))
+	if err := files.WriteString(path, content); err != nil {
+		return "", err
+	}
+
+	return "file://" + filepath.ToSlash(path), nil
+}
+
+func splitLogContent(content string, size int) []string {
+	if len(content) <= size {
+		return []string{content}
+	}
+
+	chunks := make([]string, 0, len(content)/size+1)
+	for len(content) > size {
+		end := size
+		for end > 0 && !utf8.RuneStart(content[end]) {
+			end--
+		}
+		if end == 0 {
+			end = size
+		}
+
+		chunks = append(chunks, content[:end])
+		content = content[end:]
+	}
+	if content != "" {
+		chunks = append(chunks, content)
+	}
+
+	return chunks
+}
+
+func truncateLogContent(content string, size int) string {
+	if len(content) <= size {
+		return content
+	}
+
+	end := size
+	for end > 0 && !utf8.RuneStart(content[end]) {
+		end--
+	}
+
+	return fmt.Sprintf("%s...[已截断 %d 字节]", content[:end], len(content)-end)
+}
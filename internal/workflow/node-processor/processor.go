@@ -3,6 +3,7 @@ package nodeprocessor
 import (
 	"context"
 	"errors"
+	"sync"
 	"time"
 
 	"github.com/usual2970/certimate/internal/domain"
@@ -16,10 +17,13 @@ type NodeProcessor interface {
 }
 
 type nodeLogger struct {
-	log *domain.WorkflowRunLog
+	log  *domain.WorkflowRunLog
+	size int
+	mtx  sync.Mutex
 }
 
 type certificateRepository interface {
+	GetById(ctx context.Context, id string) (*domain.Certificate, error)
 	GetByWorkflowNodeId(ctx context.Context, workflowNodeId string) (*domain.Certificate, error)
 }
 
@@ -48,17 +52,59 @@ func (l *nodeLogger) GetLog(ctx context.Context) *domain.WorkflowRunLog {
 }
 
 func (l *nodeLogger) AppendLogRecord(ctx context.Context, level domain.WorkflowRunLogLevel, content string, err ...string) {
-	record := domain.WorkflowRunLogRecord{
-		Time:    time.Now().UTC().Format(time.RFC3339),
-		Level:   level,
-		Content: content,
-	}
+	// 批量部署等场景下可能被并发调用
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now().UTC().Format(time.RFC3339)
+
+	errmsg := ""
 	if len(err) > 0 {
-		record.Error = err[0]
-		l.log.Error = err[0]
+		errmsg = truncateLogContent(err[0], maxLogChunkSize)
+		l.log.Error = errmsg
+	}
+
+	// 超长内容优先转储到外部存储，仅在运行记录中保留摘要及引用地址
+	ref := ""
+	if len(content) > maxLogChunkSize && logSink != nil {
+		if r, err := logSink.Write(ctx, l.log.NodeId, content); err == nil {
+			ref = r
+			content = truncateLogContent(content, maxLogChunkSize/2)
+		}
+	}
+
+	// 已截断后不再追加记录，仅保留最近一次的错误信息
+	if l.log.Truncated {
+		return
 	}
 
-	l.log.Records = append(l.log.Records, record)
+	chunks := splitLogContent(content, maxLogChunkSize)
+	for i, chunk := range chunks {
+		record := domain.WorkflowRunLogRecord{
+			Time:    now,
+			Level:   level,
+			Content: chunk,
+		}
+		if i == len(chunks)-1 {
+			record.Error = errmsg
+			record.Ref = ref
+		}
+
+		// 错误信息及引用地址同样计入日志大小
+		recordSize := len(record.Content) + len(record.Error) + len(record.Ref)
+		if l.size+recordSize > maxLogSize {
+			l.log.Truncated = true
+			l.log.Records = append(l.log.Records, domain.WorkflowRunLogRecord{
+				Time:    now,
+				Level:   domain.WorkflowRunLogLevelWarn,
+				Content: logTruncatedMarker,
+			})
+			return
+		}
+
+		l.size += recordSize
+		l.log.Records = append(l.log.Records, record)
+	}
 }
 
 func GetProcessor(node *domain.WorkflowNode) (NodeProcessor, error) {
@@ -90,3 +136,13 @@ func getContextWorkflowId(ctx context.Context) string {
 func getContextWorkflowRunId(ctx context.Context) string {
 	return ctx.Value("workflow_run_id").(string)
 }
+
+func getContextWorkflowFailedNode(ctx context.Context) *domain.WorkflowNode {
+	node, _ := ctx.Value("workflow_failed_node").(*domain.WorkflowNode)
+	return node
+}
+
+func getContextWorkflowRunStaging(ctx context.Context) bool {
+	staging, _ := ctx.Value("workflow_run_staging").(bool)
+	return staging
+}
@@ -0,0 +1,71 @@
+package nodeprocessor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/usual2970/certimate/internal/domain"
+)
+
+/*
+Shell command to run this test:
+
+	go test -v -run TestNodeLoggerAppendLogRecord .
+*/
+func TestNodeLoggerAppendLogRecord(t *testing.T) {
+	logSizeOf := func(log *domain.WorkflowRunLog) int {
+		size := 0
+		for _, record := range log.Records {
+			if record.Content == logTruncatedMarker {
+				continue
+			}
+			size += len(record.Content) + len(record.Error) + len(record.Ref)
+		}
+		return size
+	}
+
+	t.Run("ErrorRecordsAreBounded", func(t *testing.T) {
+		logger := newNodeLogger(&domain.WorkflowNode{Id: "test"})
+		errmsg := strings.Repeat("e", maxLogChunkSize*2)
+
+		for i := 0; i < 1000; i++ {
+			logger.AppendLogRecord(context.Background(), domain.WorkflowRunLogLevelError, "", fmt.Sprintf("%d: %s", i, errmsg))
+		}
+
+		log := logger.GetLog(context.Background())
+		if !log.Truncated {
+			t.Errorf("expected log to be truncated")
+		}
+		if size := logSizeOf(log); size > maxLogSize {
+			t.Errorf("expected log size <= %d, got %d", maxLogSize, size)
+		}
+		if len(log.Records) > maxLogSize/maxLogChunkSize+1 {
+			t.Errorf("expected at most %d records, got %d", maxLogSize/maxLogChunkSize+1, len(log.Records))
+		}
+		if !strings.HasPrefix(log.Error, "999: ") {
+			t.Errorf("expected last error to be kept, got '%s'", log.Error[:min(len(log.Error), 16)])
+		}
+	})
+
+	t.Run("ContentRecordsAreBounded", func(t *testing.T) {
+		logger := newNodeLogger(&domain.WorkflowNode{Id: "test"})
+		content := strings.Repeat("c", maxLogChunkSize*3)
+
+		for i := 0; i < 100; i++ {
+			logger.AppendLogRecord(context.Background(), domain.WorkflowRunLogLevelInfo, content, "error")
+		}
+
+		log := logger.GetLog(context.Background())
+		if !log.Truncated {
+			t.Errorf("expected log to be truncated")
+		}
+		if size := logSizeOf(log); size > maxLogSize {
+			t.Errorf("expected log size <= %d, got %d", maxLogSize, size)
+		}
+		if log.Records[len(log.Records)-1].Content != logTruncatedMarker {
+			t.Errorf("expected the truncated marker to be the last record")
+		}
+	})
+}
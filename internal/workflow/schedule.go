@@ -0,0 +1,53 @@
+package workflow
+
+import (
+	"context"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/usual2970/certimate/internal/domain"
+	"github.com/usual2970/certimate/internal/domain/dtos"
+)
+
+// 定时触发的工作流的最大延迟时间，用于打散共用同一 Cron 表达式的工作流。
+// 可通过环境变量 CERTIMATE_WORKFLOW_SCHEDULE_JITTER 配置（单位：秒），设置为 0 表示不延迟。
+var scheduleJitter = 60 * time.Second
+
+func init() {
+	envJitter := os.Getenv("CERTIMATE_WORKFLOW_SCHEDULE_JITTER")
+	if n, err := strconv.Atoi(envJitter); err == nil && n >= 0 {
+		scheduleJitter = time.Duration(n) * time.Second
+	}
+}
+
+// 计算定时触发的工作流在开始运行前的延迟时间。
+// 延迟由工作流 ID 的哈希值决定基础偏移（使各工作流均匀分布在窗口内），并叠加少量随机抖动。
+func getScheduleDelay(workflowId string) time.Duration {
+	if scheduleJitter <= 0 {
+		return 0
+	}
+
+	h := fnv.New32a()
+	h.Write([]byte(workflowId))
+	spread := time.Duration(h.Sum32()%uint32(scheduleJitter/time.Millisecond)) * time.Millisecond
+	jitter := time.Duration(rand.Int63n(int64(scheduleJitter)/10 + 1))
+	return (spread + jitter) % scheduleJitter
+}
+
+func startScheduledRun(ctx context.Context, srv *WorkflowService, workflowId string) {
+	if delay := getScheduleDelay(workflowId); delay > 0 {
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+
+	srv.StartRun(ctx, &dtos.WorkflowStartRunReq{
+		WorkflowId: workflowId,
+		RunTrigger: domain.WorkflowTriggerTypeAuto,
+	})
+}
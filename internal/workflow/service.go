@@ -7,9 +7,12 @@ import (
 	"time"
 
 	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/applicant"
 	"github.com/usual2970/certimate/internal/domain"
 	"github.com/usual2970/certimate/internal/domain/dtos"
+	"github.com/usual2970/certimate/internal/repository"
 	"github.com/usual2970/certimate/internal/workflow/dispatcher"
+	nodes "github.com/usual2970/certimate/internal/workflow/node-processor"
 )
 
 type workflowRepository interface {
@@ -19,8 +22,11 @@ type workflowRepository interface {
 }
 
 type workflowRunRepository interface {
+	List(ctx context.Context, options *repository.WorkflowRunListOptions) (*domain.CursorPage[*domain.WorkflowRun], error)
 	GetById(ctx context.Context, id string) (*domain.WorkflowRun, error)
+	GetByIdempotencyKey(ctx context.Context, workflowId string, idempotencyKey string) (*domain.WorkflowRun, error)
 	Save(ctx context.Context, workflowRun *domain.WorkflowRun) (*domain.WorkflowRun, error)
+	DeleteByIds(ctx context.Context, workflowId string, ids []string) (int, error)
 }
 
 type WorkflowService struct {
@@ -51,10 +57,7 @@ func (s *WorkflowService) InitSchedule(ctx context.Context) error {
 		var errs []error
 
 		err := scheduler.Add(fmt.Sprintf("workflow#%s", workflow.Id), workflow.TriggerCron, func() {
-			s.StartRun(ctx, &dtos.WorkflowStartRunReq{
-				WorkflowId: workflow.Id,
-				RunTrigger: domain.WorkflowTriggerTypeAuto,
-			})
+			startScheduledRun(ctx, s, workflow.Id)
 		})
 		if err != nil {
 			errs = append(errs, err)
@@ -68,24 +71,62 @@ func (s *WorkflowService) InitSchedule(ctx context.Context) error {
 	return nil
 }
 
-func (s *WorkflowService) StartRun(ctx context.Context, req *dtos.WorkflowStartRunReq) error {
+func (s *WorkflowService) StartRun(ctx context.Context, req *dtos.WorkflowStartRunReq) (*dtos.WorkflowStartRunResp, error) {
+	// 携带幂等键的请求重复提交时，直接返回首次创建的运行记录，避免重复申请证书
+	// 幂等键随运行记录持久化，并由唯一索引保证同一工作流下不会创建两条相同幂等键的运行记录
+	if req.IdempotencyKey != "" {
+		if len(req.IdempotencyKey) > 256 {
+			return nil, errors.New("idempotency key must be at most 256 characters")
+		}
+
+		if run, err := s.workflowRunRepo.GetByIdempotencyKey(ctx, req.WorkflowId, req.IdempotencyKey); err == nil {
+			return &dtos.WorkflowStartRunResp{RunId: run.Id}, nil
+		} else if !domain.IsRecordNotFoundError(err) {
+			return nil, err
+		}
+	}
+
+	runId, err := s.startRun(ctx, req)
+	if err != nil {
+		// 并发提交相同幂等键的请求时，仅有一个请求能创建运行记录，其余请求返回该运行记录
+		if req.IdempotencyKey != "" {
+			if run, err := s.workflowRunRepo.GetByIdempotencyKey(ctx, req.WorkflowId, req.IdempotencyKey); err == nil {
+				return &dtos.WorkflowStartRunResp{RunId: run.Id}, nil
+			}
+		}
+
+		return nil, err
+	}
+
+	return &dtos.WorkflowStartRunResp{RunId: runId}, nil
+}
+
+func (s *WorkflowService) startRun(ctx context.Context, req *dtos.WorkflowStartRunReq) (string, error) {
 	workflow, err := s.workflowRepo.GetById(ctx, req.WorkflowId)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if workflow.LastRunStatus == domain.WorkflowRunStatusTypePending || workflow.LastRunStatus == domain.WorkflowRunStatusTypeRunning {
-		return errors.New("workflow is already pending or running")
+		return "", errors.New("workflow is already pending or running")
+	}
+
+	if req.Staging {
+		if err := applicant.CheckStagingSupported(ctx); err != nil {
+			return "", err
+		}
 	}
 
 	run := &domain.WorkflowRun{
-		WorkflowId: workflow.Id,
-		Status:     domain.WorkflowRunStatusTypePending,
-		Trigger:    req.RunTrigger,
-		StartedAt:  time.Now(),
+		WorkflowId:     workflow.Id,
+		Status:         domain.WorkflowRunStatusTypePending,
+		Trigger:        req.RunTrigger,
+		StartedAt:      time.Now(),
+		Staging:        req.Staging,
+		IdempotencyKey: req.IdempotencyKey,
 	}
 	if resp, err := s.workflowRunRepo.Save(ctx, run); err != nil {
-		return err
+		return "", err
 	} else {
 		run = resp
 	}
@@ -94,9 +135,37 @@ func (s *WorkflowService) StartRun(ctx context.Context, req *dtos.WorkflowStartR
 		WorkflowId:      workflow.Id,
 		WorkflowContent: workflow.Content,
 		RunId:           run.Id,
+		RunStaging:      run.Staging,
 	})
 
-	return nil
+	return run.Id, nil
+}
+
+// 将测试运行提升到正式环境。
+// 提升并非复用测试运行的产物（测试环境签发的证书不受信任），而是以工作流当前的配置发起一次全新的完整运行：
+// 重新向正式环境申请证书，并重新执行全部部署节点。
+func (s *WorkflowService) PromoteRun(ctx context.Context, req *dtos.WorkflowPromoteRunReq) (*dtos.WorkflowStartRunResp, error) {
+	workflow, err := s.workflowRepo.GetById(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	workflowRun, err := s.workflowRunRepo.GetById(ctx, req.RunId)
+	if err != nil {
+		return nil, err
+	} else if workflowRun.WorkflowId != workflow.Id {
+		return nil, errors.New("workflow run not found")
+	} else if !workflowRun.Staging {
+		return nil, errors.New("workflow run is not a staging run")
+	} else if workflowRun.Status != domain.WorkflowRunStatusTypeSucceeded {
+		return nil, errors.New("workflow run is not succeeded")
+	}
+
+	return s.StartRun(ctx, &dtos.WorkflowStartRunReq{
+		WorkflowId: workflow.Id,
+		RunTrigger: domain.WorkflowTriggerTypeManual,
+		Staging:    false,
+	})
 }
 
 func (s *WorkflowService) CancelRun(ctx context.Context, req *dtos.WorkflowCancelRunReq) error {
@@ -119,6 +188,120 @@ func (s *WorkflowService) CancelRun(ctx context.Context, req *dtos.WorkflowCance
 	return nil
 }
 
+func (s *WorkflowService) RollbackNode(ctx context.Context, req *dtos.WorkflowRollbackNodeReq) (*dtos.WorkflowRollbackNodeResp, error) {
+	workflow, err := s.workflowRepo.GetById(ctx, req.WorkflowId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 避免与正在执行的部署同时变更部署目标
+	if workflow.LastRunStatus == domain.WorkflowRunStatusTypePending || workflow.LastRunStatus == domain.WorkflowRunStatusTypeRunning {
+		return nil, errors.New("workflow is already pending or running")
+	}
+
+	var node *domain.WorkflowNode
+	if workflow.Content != nil {
+		node = workflow.Content.FindNodeById(req.NodeId)
+	}
+	if node == nil {
+		return nil, errors.New("workflow node not found")
+	} else if node.Type != domain.WorkflowNodeTypeDeploy {
+		return nil, errors.New("workflow node is not a deploy node")
+	}
+
+	// 回滚作为一次独立的 WorkflowRun 记录，其执行结果也归属于此次运行
+	// 回滚一旦开始便不应随请求中断而中止，否则部署目标可能处于中间状态，且运行记录将无法更新
+	runCtx := context.WithoutCancel(ctx)
+
+	// 先独占工作流的执行权，确保回滚期间不会有其他 WorkflowRun 同时执行
+	// 取得执行权后再创建运行记录，避免遗留无人维护的运行中记录
+	workerCtx, release, err := s.dispatcher.Acquire(runCtx, workflow.Id)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	run := &domain.WorkflowRun{
+		WorkflowId: workflow.Id,
+		Status:     domain.WorkflowRunStatusTypeRunning,
+		Trigger:    domain.WorkflowTriggerTypeManual,
+		StartedAt:  time.Now(),
+	}
+	if resp, err := s.workflowRunRepo.Save(runCtx, run); err != nil {
+		return nil, err
+	} else {
+		run = resp
+	}
+	s.dispatcher.Bind(workflow.Id, run.Id)
+
+	workerCtx = context.WithValue(workerCtx, "workflow_id", workflow.Id)
+	workerCtx = context.WithValue(workerCtx, "workflow_run_id", run.Id)
+	workerCtx = context.WithValue(workerCtx, "workflow_run_staging", false)
+
+	deployNode := nodes.NewDeployNode(node)
+	rollbackErr := deployNode.Rollback(workerCtx, false)
+
+	run.EndedAt = time.Now()
+	run.Logs = make([]domain.WorkflowRunLog, 0)
+	if log := deployNode.GetLog(workerCtx); log != nil {
+		run.Logs = append(run.Logs, *log)
+	}
+	if rollbackErr != nil && (errors.Is(rollbackErr, context.Canceled) || workerCtx.Err() != nil) {
+		run.Status = domain.WorkflowRunStatusTypeCanceled
+	} else if rollbackErr != nil {
+		run.Status = domain.WorkflowRunStatusTypeFailed
+		run.Error = rollbackErr.Error()
+	} else {
+		run.Status = domain.WorkflowRunStatusTypeSucceeded
+	}
+	if _, err := s.workflowRunRepo.Save(runCtx, run); err != nil {
+		return nil, err
+	}
+
+	if rollbackErr != nil {
+		return nil, rollbackErr
+	}
+
+	return &dtos.WorkflowRollbackNodeResp{
+		RunId: run.Id,
+		Log:   deployNode.GetLog(workerCtx),
+	}, nil
+}
+
+func (s *WorkflowService) ListRuns(ctx context.Context, req *dtos.WorkflowListRunsReq) (*dtos.WorkflowListRunsResp, error) {
+	if _, err := s.workflowRepo.GetById(ctx, req.WorkflowId); err != nil {
+		return nil, err
+	}
+
+	return s.workflowRunRepo.List(ctx, &repository.WorkflowRunListOptions{
+		WorkflowId: req.WorkflowId,
+		Status:     req.Status,
+		Trigger:    req.Trigger,
+		Sort:       req.Sort,
+		Cursor:     req.Cursor,
+		Limit:      req.Limit,
+	})
+}
+
+func (s *WorkflowService) BatchDeleteRuns(ctx context.Context, req *dtos.WorkflowBatchDeleteRunsReq) (*dtos.WorkflowBatchDeleteRunsResp, error) {
+	if len(req.RunIds) > 1000 {
+		return nil, domain.ErrInvalidParams
+	}
+
+	if _, err := s.workflowRepo.GetById(ctx, req.WorkflowId); err != nil {
+		return nil, err
+	}
+
+	count, err := s.workflowRunRepo.DeleteByIds(ctx, req.WorkflowId, req.RunIds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dtos.WorkflowBatchDeleteRunsResp{
+		DeletedCount: count,
+	}, nil
+}
+
 func (s *WorkflowService) Shutdown(ctx context.Context) {
 	s.dispatcher.Shutdown()
 }
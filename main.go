@@ -14,6 +14,8 @@ import (
 	"github.com/pocketbase/pocketbase/tools/hook"
 
 	"github.com/usual2970/certimate/internal/app"
+	"github.com/usual2970/certimate/internal/deployer"
+	"github.com/usual2970/certimate/internal/repository"
 	"github.com/usual2970/certimate/internal/rest/routes"
 	"github.com/usual2970/certimate/internal/scheduler"
 	"github.com/usual2970/certimate/internal/workflow"
@@ -43,8 +45,10 @@ func main() {
 	})
 
 	app.OnServe().BindFunc(func(e *core.ServeEvent) error {
+		repository.Register()
 		scheduler.Register()
 		workflow.Register()
+		deployer.Register()
 		routes.Register(e.Router)
 		return e.Next()
 	})
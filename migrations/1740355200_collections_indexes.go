@@ -0,0 +1,40 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		workflowOutputCollection, err := app.FindCollectionByNameOrId("bqnxb95f2cooowp")
+		if err != nil {
+			return err
+		} else {
+			// add indexes
+			// `runId` 已存在单列索引 `idx_O9zxLETuxJ`，此处无需重复创建
+			workflowOutputCollection.AddIndex("idx_workflow_output_nodeId_created", false, "`nodeId`, `created`", "")
+
+			if err := app.Save(workflowOutputCollection); err != nil {
+				return err
+			}
+		}
+
+		certificateCollection, err := app.FindCollectionByNameOrId("4szxr9x43tpj6np")
+		if err != nil {
+			return err
+		} else {
+			// add indexes
+			certificateCollection.AddIndex("idx_certificate_expireAt", false, "`expireAt`", "")
+			certificateCollection.AddIndex("idx_certificate_workflowNodeId_created", false, "`workflowNodeId`, `created`", "")
+
+			if err := app.Save(certificateCollection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(app core.App) error {
+		return nil
+	})
+}
@@ -0,0 +1,45 @@
+package migrations
+
+import (
+	"github.com/pocketbase/pocketbase/core"
+	m "github.com/pocketbase/pocketbase/migrations"
+)
+
+func init() {
+	m.Register(func(app core.App) error {
+		workflowRunCollection, err := app.FindCollectionByNameOrId("qjp8lygssgwyqyz")
+		if err != nil {
+			return err
+		} else {
+			// add field
+			if err := workflowRunCollection.Fields.AddMarshaledJSON([]byte(`{
+				"autogeneratePattern": "",
+				"hidden": false,
+				"id": "text5xq2ibkd",
+				"max": 256,
+				"min": 0,
+				"name": "idempotencyKey",
+				"pattern": "",
+				"presentable": false,
+				"primaryKey": false,
+				"required": false,
+				"system": false,
+				"type": "text"
+			}`)); err != nil {
+				return err
+			}
+
+			// add indexes
+			// 同一工作流下的幂等键唯一，未携带幂等键的运行记录不受约束
+			workflowRunCollection.AddIndex("idx_workflow_run_workflowId_idempotencyKey", true, "`workflowId`, `idempotencyKey`", "`idempotencyKey` != ''")
+
+			if err := app.Save(workflowRunCollection); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}, func(app core.App) error {
+		return nil
+	})
+}
@@ -1,6 +1,6 @@
 import { ClientResponseError } from "pocketbase";
 
-import { type CertificateFormatType } from "@/domain/certificate";
+import { type CertificateFormatType, type CertificateModel } from "@/domain/certificate";
 import { getPocketBase } from "@/repository/_pocketbase";
 
 type ArchiveRespData = {
@@ -73,3 +73,93 @@ export const validatePrivateKey = async (privateKey: string) => {
 
   return resp;
 };
+
+type ListRespData = {
+  items: CertificateModel[];
+  nextCursor?: string;
+};
+
+export type ListRequest = {
+  keyword?: string;
+  state?: "expireSoon" | "expired" | "archived";
+  workflowId?: string;
+  sort?: string;
+  cursor?: string;
+  limit?: number;
+};
+
+export const list = async (request: ListRequest) => {
+  const pb = getPocketBase();
+
+  const query: Record<string, string> = {};
+  Object.entries(request).forEach(([key, value]) => {
+    if (value != null && value !== "") {
+      query[key] = String(value);
+    }
+  });
+
+  const resp = await pb.send<BaseResponse<ListRespData>>(`/api/certificates`, {
+    method: "GET",
+    query: query,
+    requestKey: null,
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+type BatchDeleteRespData = {
+  deletedCount: number;
+};
+
+export const batchDelete = async (certificateIds: string[]) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse<BatchDeleteRespData>>(`/api/certificates/batch-delete`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: {
+      certificateIds: certificateIds,
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+type BatchUpdateRespData = {
+  updatedCount: number;
+};
+
+export type BatchUpdateRequest = {
+  archived?: boolean;
+};
+
+export const batchUpdate = async (certificateIds: string[], request: BatchUpdateRequest) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse<BatchUpdateRespData>>(`/api/certificates/batch-update`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: {
+      certificateIds: certificateIds,
+      ...request,
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
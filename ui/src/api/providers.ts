@@ -0,0 +1,60 @@
+import { ClientResponseError } from "pocketbase";
+
+import { getPocketBase } from "@/repository/_pocketbase";
+
+export type ProviderKind = "applicant" | "deployer" | "notifier";
+
+export type ProviderCapability = "wildcard" | "multiple_domains" | "dry_run" | "external_key";
+
+export type ProviderDescriptor = {
+  kind: ProviderKind;
+  type: string;
+  accessProvider?: string;
+  capabilities: ProviderCapability[];
+  configJsonSchema?: Record<string, unknown>;
+};
+
+type ListProvidersRespData = {
+  items: ProviderDescriptor[];
+};
+
+export type ListProvidersRequest = {
+  kind?: ProviderKind;
+  capability?: ProviderCapability;
+};
+
+export const list = async (request?: ListProvidersRequest) => {
+  const pb = getPocketBase();
+
+  const query: Record<string, string> = {};
+  Object.entries(request ?? {}).forEach(([key, value]) => {
+    if (value != null && value !== "") {
+      query[key] = String(value);
+    }
+  });
+
+  const resp = await pb.send<BaseResponse<ListProvidersRespData>>("/api/providers", {
+    method: "GET",
+    query: query,
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+export const get = async (kind: ProviderKind, type: string) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse<ProviderDescriptor>>(`/api/providers/${encodeURIComponent(kind)}/${encodeURIComponent(type)}`, {
+    method: "GET",
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
@@ -1,9 +1,14 @@
 import { ClientResponseError } from "pocketbase";
 
 import { WORKFLOW_TRIGGERS } from "@/domain/workflow";
+import { type WorkflowRunModel } from "@/domain/workflowRun";
 import { getPocketBase } from "@/repository/_pocketbase";
 
-export const startRun = async (workflowId: string) => {
+export type StartRunOptions = {
+  staging?: boolean;
+};
+
+export const startRun = async (workflowId: string, options?: StartRunOptions) => {
   const pb = getPocketBase();
 
   const resp = await pb.send<BaseResponse>(`/api/workflows/${encodeURIComponent(workflowId)}/runs`, {
@@ -13,6 +18,24 @@ export const startRun = async (workflowId: string) => {
     },
     body: {
       trigger: WORKFLOW_TRIGGERS.MANUAL,
+      staging: !!options?.staging,
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+export const promoteRun = async (workflowId: string, runId: string) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse>(`/api/workflows/${encodeURIComponent(workflowId)}/runs/${encodeURIComponent(runId)}/promote`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
     },
   });
 
@@ -39,3 +62,80 @@ export const cancelRun = async (workflowId: string, runId: string) => {
 
   return resp;
 };
+
+export const rollbackNode = async (workflowId: string, nodeId: string) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse>(`/api/workflows/${encodeURIComponent(workflowId)}/nodes/${encodeURIComponent(nodeId)}/rollback`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+type ListRunsRespData = {
+  items: WorkflowRunModel[];
+  nextCursor?: string;
+};
+
+export type ListRunsRequest = {
+  status?: string;
+  trigger?: string;
+  sort?: string;
+  cursor?: string;
+  limit?: number;
+};
+
+export const listRuns = async (workflowId: string, request: ListRunsRequest) => {
+  const pb = getPocketBase();
+
+  const query: Record<string, string> = {};
+  Object.entries(request).forEach(([key, value]) => {
+    if (value != null && value !== "") {
+      query[key] = String(value);
+    }
+  });
+
+  const resp = await pb.send<BaseResponse<ListRunsRespData>>(`/api/workflows/${encodeURIComponent(workflowId)}/runs`, {
+    method: "GET",
+    query: query,
+    requestKey: null,
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
+
+type BatchDeleteRunsRespData = {
+  deletedCount: number;
+};
+
+export const batchDeleteRuns = async (workflowId: string, runIds: string[]) => {
+  const pb = getPocketBase();
+
+  const resp = await pb.send<BaseResponse<BatchDeleteRunsRespData>>(`/api/workflows/${encodeURIComponent(workflowId)}/runs/batch-delete`, {
+    method: "POST",
+    headers: {
+      "Content-Type": "application/json",
+    },
+    body: {
+      runIds: runIds,
+    },
+  });
+
+  if (resp.code != 0) {
+    throw new ClientResponseError({ status: resp.code, response: resp, data: {} });
+  }
+
+  return resp;
+};
@@ -0,0 +1,47 @@
+import { useTranslation } from "react-i18next";
+import { LeftOutlined as LeftOutlinedIcon, RightOutlined as RightOutlinedIcon } from "@ant-design/icons";
+import { Button, Flex, Select } from "antd";
+
+export type CursorPaginationProps = {
+  className?: string;
+  style?: React.CSSProperties;
+  disabled?: boolean;
+  hasPrev: boolean;
+  hasNext: boolean;
+  pageSize: number;
+  pageSizeOptions?: number[];
+  onPrev?: () => void;
+  onNext?: () => void;
+  onPageSizeChange?: (pageSize: number) => void;
+};
+
+const CursorPagination = ({
+  className,
+  style,
+  disabled,
+  hasPrev,
+  hasNext,
+  pageSize,
+  pageSizeOptions = [10, 20, 50, 100],
+  onPrev,
+  onNext,
+  onPageSizeChange,
+}: CursorPaginationProps) => {
+  const { t } = useTranslation();
+
+  return (
+    <Flex className={className} style={style} align="center" justify="end" gap="small">
+      <Button disabled={disabled || !hasPrev} icon={<LeftOutlinedIcon />} size="small" onClick={onPrev} />
+      <Button disabled={disabled || !hasNext} icon={<RightOutlinedIcon />} size="small" onClick={onNext} />
+      <Select
+        disabled={disabled}
+        options={pageSizeOptions.map((size) => ({ label: t("common.pagination.page_size", { size }), value: size }))}
+        size="small"
+        value={pageSize}
+        onChange={(value) => onPageSizeChange?.(value)}
+      />
+    </Flex>
+  );
+};
+
+export default CursorPagination;
@@ -11,6 +11,7 @@ import { useAntdForm, useAntdFormName } from "@/hooks";
 
 import AccessForm1PanelConfig from "./AccessForm1PanelConfig";
 import AccessFormACMEHttpReqConfig from "./AccessFormACMEHttpReqConfig";
+import AccessFormAkamaiConfig from "./AccessFormAkamaiConfig";
 import AccessFormAliyunConfig from "./AccessFormAliyunConfig";
 import AccessFormAWSConfig from "./AccessFormAWSConfig";
 import AccessFormAzureConfig from "./AccessFormAzureConfig";
@@ -20,33 +21,70 @@ import AccessFormBaotaPanelConfig from "./AccessFormBaotaPanelConfig";
 import AccessFormBytePlusConfig from "./AccessFormBytePlusConfig";
 import AccessFormCacheFlyConfig from "./AccessFormCacheFlyConfig";
 import AccessFormCdnflyConfig from "./AccessFormCdnflyConfig";
+import AccessFormCiscoConfig from "./AccessFormCiscoConfig";
 import AccessFormCloudflareConfig from "./AccessFormCloudflareConfig";
 import AccessFormClouDNSConfig from "./AccessFormClouDNSConfig";
 import AccessFormCMCCCloudConfig from "./AccessFormCMCCCloudConfig";
+import AccessFormConsulConfig from "./AccessFormConsulConfig";
+import AccessFormCPanelConfig from "./AccessFormCPanelConfig";
 import AccessFormDNSLAConfig from "./AccessFormDNSLAConfig";
+import AccessFormDockerConfig from "./AccessFormDockerConfig";
 import AccessFormDogeCloudConfig from "./AccessFormDogeCloudConfig";
 import AccessFormEdgioConfig from "./AccessFormEdgioConfig";
+import AccessFormEtcdConfig from "./AccessFormEtcdConfig";
+import AccessFormF5Config from "./AccessFormF5Config";
+import AccessFormFastlyConfig from "./AccessFormFastlyConfig";
+import AccessFormFortinetConfig from "./AccessFormFortinetConfig";
+import AccessFormFTPConfig from "./AccessFormFTPConfig";
 import AccessFormGcoreConfig from "./AccessFormGcoreConfig";
+import AccessFormGCPConfig from "./AccessFormGCPConfig";
+import AccessFormGitLabConfig from "./AccessFormGitLabConfig";
 import AccessFormGnameConfig from "./AccessFormGnameConfig";
 import AccessFormGoDaddyConfig from "./AccessFormGoDaddyConfig";
+import AccessFormGRPCConfig from "./AccessFormGRPCConfig";
+import AccessFormHAProxyConfig from "./AccessFormHAProxyConfig";
+import AccessFormHerokuConfig from "./AccessFormHerokuConfig";
 import AccessFormHuaweiCloudConfig from "./AccessFormHuaweiCloudConfig";
 import AccessFormJDCloudConfig from "./AccessFormJDCloudConfig";
+import AccessFormJenkinsConfig from "./AccessFormJenkinsConfig";
+import AccessFormKempConfig from "./AccessFormKempConfig";
+import AccessFormKeyCDNConfig from "./AccessFormKeyCDNConfig";
+import AccessFormKSyunConfig from "./AccessFormKSyunConfig";
 import AccessFormKubernetesConfig from "./AccessFormKubernetesConfig";
 import AccessFormLocalConfig from "./AccessFormLocalConfig";
+import AccessFormMikrotikConfig from "./AccessFormMikrotikConfig";
 import AccessFormNamecheapConfig from "./AccessFormNamecheapConfig";
 import AccessFormNameDotComConfig from "./AccessFormNameDotComConfig";
 import AccessFormNameSiloConfig from "./AccessFormNameSiloConfig";
+import AccessFormNetlifyConfig from "./AccessFormNetlifyConfig";
+import AccessFormNomadConfig from "./AccessFormNomadConfig";
 import AccessFormNS1Config from "./AccessFormNS1Config";
+import AccessFormOpenStackConfig from "./AccessFormOpenStackConfig";
+import AccessFormOPNsenseConfig from "./AccessFormOPNsenseConfig";
+import AccessFormOracleCloudConfig from "./AccessFormOracleCloudConfig";
+import AccessFormOVHcloudConfig from "./AccessFormOVHcloudConfig";
+import AccessFormPfSenseConfig from "./AccessFormPfSenseConfig";
+import AccessFormPleskConfig from "./AccessFormPleskConfig";
 import AccessFormPowerDNSConfig from "./AccessFormPowerDNSConfig";
 import AccessFormQiniuConfig from "./AccessFormQiniuConfig";
 import AccessFormRainYunConfig from "./AccessFormRainYunConfig";
+import AccessFormRancherConfig from "./AccessFormRancherConfig";
 import AccessFormSafeLineConfig from "./AccessFormSafeLineConfig";
+import AccessFormSoftEtherConfig from "./AccessFormSoftEtherConfig";
+import AccessFormSophosConfig from "./AccessFormSophosConfig";
 import AccessFormSSHConfig from "./AccessFormSSHConfig";
 import AccessFormTencentCloudConfig from "./AccessFormTencentCloudConfig";
+import AccessFormTrueNASConfig from "./AccessFormTrueNASConfig";
 import AccessFormUCloudConfig from "./AccessFormUCloudConfig";
+import AccessFormUpyunConfig from "./AccessFormUpyunConfig";
+import AccessFormVaultConfig from "./AccessFormVaultConfig";
+import AccessFormVercelConfig from "./AccessFormVercelConfig";
 import AccessFormVolcEngineConfig from "./AccessFormVolcEngineConfig";
 import AccessFormWebhookConfig from "./AccessFormWebhookConfig";
 import AccessFormWestcnConfig from "./AccessFormWestcnConfig";
+import AccessFormWHMConfig from "./AccessFormWHMConfig";
+import AccessFormWinRMConfig from "./AccessFormWinRMConfig";
+import AccessFormZooKeeperConfig from "./AccessFormZooKeeperConfig";
 
 type AccessFormFieldValues = Partial<MaybeModelRecord<AccessModel>>;
 type AccessFormPresets = "add" | "edit";
@@ -104,6 +142,8 @@ const AccessForm = forwardRef<AccessFormInstance, AccessFormProps>(({ className,
         return <AccessForm1PanelConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.ACMEHTTPREQ:
         return <AccessFormACMEHttpReqConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.AKAMAI:
+        return <AccessFormAkamaiConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.ALIYUN:
         return <AccessFormAliyunConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.AWS:
@@ -122,60 +162,134 @@ const AccessForm = forwardRef<AccessFormInstance, AccessFormProps>(({ className,
         return <AccessFormCacheFlyConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.CDNFLY:
         return <AccessFormCdnflyConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.CISCO:
+        return <AccessFormCiscoConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.CLOUDFLARE:
         return <AccessFormCloudflareConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.CLOUDNS:
         return <AccessFormClouDNSConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.CMCCCLOUD:
         return <AccessFormCMCCCloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.CONSUL:
+        return <AccessFormConsulConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.CPANEL:
+        return <AccessFormCPanelConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.DNSLA:
         return <AccessFormDNSLAConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.DOCKER:
+        return <AccessFormDockerConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.DOGECLOUD:
         return <AccessFormDogeCloudConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.GCORE:
         return <AccessFormGcoreConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.GCP:
+        return <AccessFormGCPConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.GITLAB:
+        return <AccessFormGitLabConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.GNAME:
         return <AccessFormGnameConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.GODADDY:
         return <AccessFormGoDaddyConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.EDGIO:
         return <AccessFormEdgioConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.ETCD:
+        return <AccessFormEtcdConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.F5:
+        return <AccessFormF5Config {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.FASTLY:
+        return <AccessFormFastlyConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.FORTINET:
+        return <AccessFormFortinetConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.FTP:
+        return <AccessFormFTPConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.GRPC:
+        return <AccessFormGRPCConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.HAPROXY:
+        return <AccessFormHAProxyConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.HEROKU:
+        return <AccessFormHerokuConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.HUAWEICLOUD:
         return <AccessFormHuaweiCloudConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.JDCLOUD:
         return <AccessFormJDCloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.JENKINS:
+        return <AccessFormJenkinsConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.KEMP:
+        return <AccessFormKempConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.KEYCDN:
+        return <AccessFormKeyCDNConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.KSYUN:
+        return <AccessFormKSyunConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.KUBERNETES:
         return <AccessFormKubernetesConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.LOCAL:
         return <AccessFormLocalConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.MIKROTIK:
+        return <AccessFormMikrotikConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.NAMECHEAP:
         return <AccessFormNamecheapConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.NAMEDOTCOM:
         return <AccessFormNameDotComConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.NAMESILO:
         return <AccessFormNameSiloConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.NETLIFY:
+        return <AccessFormNetlifyConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.NOMAD:
+        return <AccessFormNomadConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.NS1:
         return <AccessFormNS1Config {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.OPENSTACK:
+        return <AccessFormOpenStackConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.OPNSENSE:
+        return <AccessFormOPNsenseConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.ORACLECLOUD:
+        return <AccessFormOracleCloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.OVHCLOUD:
+        return <AccessFormOVHcloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.PFSENSE:
+        return <AccessFormPfSenseConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.PLESK:
+        return <AccessFormPleskConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.POWERDNS:
         return <AccessFormPowerDNSConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.QINIU:
         return <AccessFormQiniuConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.RAINYUN:
         return <AccessFormRainYunConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.RANCHER:
+        return <AccessFormRancherConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.SAFELINE:
         return <AccessFormSafeLineConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.SOFTETHER:
+        return <AccessFormSoftEtherConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.SOPHOS:
+        return <AccessFormSophosConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.SSH:
         return <AccessFormSSHConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.TENCENTCLOUD:
         return <AccessFormTencentCloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.TRUENAS:
+        return <AccessFormTrueNASConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.UCLOUD:
         return <AccessFormUCloudConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.UPYUN:
+        return <AccessFormUpyunConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.VAULT:
+        return <AccessFormVaultConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.VERCEL:
+        return <AccessFormVercelConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.VOLCENGINE:
         return <AccessFormVolcEngineConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.WEBHOOK:
         return <AccessFormWebhookConfig {...nestedFormProps} />;
       case ACCESS_PROVIDERS.WESTCN:
         return <AccessFormWestcnConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.WHM:
+        return <AccessFormWHMConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.WINRM:
+        return <AccessFormWinRMConfig {...nestedFormProps} />;
+      case ACCESS_PROVIDERS.ZOOKEEPER:
+        return <AccessFormZooKeeperConfig {...nestedFormProps} />;
     }
   }, [disabled, initialValues?.config, fieldProvider, nestedFormInst, nestedFormName]);
 
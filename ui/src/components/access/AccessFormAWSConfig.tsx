@@ -1,8 +1,9 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import Show from "@/components/Show";
 import { type AccessConfigForAWS } from "@/domain/access";
 
 type AccessFormAWSConfigFieldValues = Nullish<AccessConfigForAWS>;
@@ -15,8 +16,12 @@ export type AccessFormAWSConfigProps = {
   onValuesChange?: (values: AccessFormAWSConfigFieldValues) => void;
 };
 
+const CREDENTIAL_MODE_STATIC = "static" as const;
+const CREDENTIAL_MODE_ENVIRONMENT = "environment" as const;
+
 const initFormModel = (): AccessFormAWSConfigFieldValues => {
   return {
+    credentialMode: CREDENTIAL_MODE_STATIC,
     accessKeyId: "",
     secretAccessKey: "",
   };
@@ -26,19 +31,24 @@ const AccessFormAWSConfig = ({ form: formInst, formName, disabled, initialValues
   const { t } = useTranslation();
 
   const formSchema = z.object({
+    credentialMode: z.enum([CREDENTIAL_MODE_STATIC, CREDENTIAL_MODE_ENVIRONMENT]).nullish(),
     accessKeyId: z
       .string()
-      .min(1, t("access.form.aws_access_key_id.placeholder"))
       .max(64, t("common.errmsg.string_max", { max: 64 }))
-      .trim(),
+      .trim()
+      .nullish()
+      .refine((v) => fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !!v, t("access.form.aws_access_key_id.placeholder")),
     secretAccessKey: z
       .string()
-      .min(1, t("access.form.aws_secret_access_key.placeholder"))
       .max(64, t("common.errmsg.string_max", { max: 64 }))
-      .trim(),
+      .trim()
+      .nullish()
+      .refine((v) => fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !!v, t("access.form.aws_secret_access_key.placeholder")),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldCredentialMode = Form.useWatch("credentialMode", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -53,22 +63,40 @@ const AccessFormAWSConfig = ({ form: formInst, formName, disabled, initialValues
       onValuesChange={handleFormChange}
     >
       <Form.Item
-        name="accessKeyId"
-        label={t("access.form.aws_access_key_id.label")}
+        name="credentialMode"
+        label={t("access.form.aws_credential_mode.label")}
         rules={[formRule]}
-        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aws_access_key_id.tooltip") }}></span>}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aws_credential_mode.tooltip") }}></span>}
       >
-        <Input autoComplete="new-password" placeholder={t("access.form.aws_access_key_id.placeholder")} />
+        <Select placeholder={t("access.form.aws_credential_mode.placeholder")}>
+          <Select.Option key={CREDENTIAL_MODE_STATIC} value={CREDENTIAL_MODE_STATIC}>
+            {t("access.form.aws_credential_mode.option.static.label")}
+          </Select.Option>
+          <Select.Option key={CREDENTIAL_MODE_ENVIRONMENT} value={CREDENTIAL_MODE_ENVIRONMENT}>
+            {t("access.form.aws_credential_mode.option.environment.label")}
+          </Select.Option>
+        </Select>
       </Form.Item>
 
-      <Form.Item
-        name="secretAccessKey"
-        label={t("access.form.aws_secret_access_key.label")}
-        rules={[formRule]}
-        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aws_secret_access_key.tooltip") }}></span>}
-      >
-        <Input.Password autoComplete="new-password" placeholder={t("access.form.aws_secret_access_key.placeholder")} />
-      </Form.Item>
+      <Show when={fieldCredentialMode !== CREDENTIAL_MODE_ENVIRONMENT}>
+        <Form.Item
+          name="accessKeyId"
+          label={t("access.form.aws_access_key_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aws_access_key_id.tooltip") }}></span>}
+        >
+          <Input autoComplete="new-password" placeholder={t("access.form.aws_access_key_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="secretAccessKey"
+          label={t("access.form.aws_secret_access_key.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aws_secret_access_key.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("access.form.aws_secret_access_key.placeholder")} />
+        </Form.Item>
+      </Show>
     </Form>
   );
 };
@@ -0,0 +1,106 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForAkamai } from "@/domain/access";
+
+type AccessFormAkamaiConfigFieldValues = Nullish<AccessConfigForAkamai>;
+
+export type AccessFormAkamaiConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormAkamaiConfigFieldValues;
+  onValuesChange?: (values: AccessFormAkamaiConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormAkamaiConfigFieldValues => {
+  return {
+    host: "",
+    clientToken: "",
+    clientSecret: "",
+    accessToken: "",
+  };
+};
+
+const AccessFormAkamaiConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormAkamaiConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    host: z
+      .string()
+      .min(1, t("access.form.akamai_host.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    clientToken: z
+      .string()
+      .min(1, t("access.form.akamai_client_token.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    clientSecret: z
+      .string()
+      .min(1, t("access.form.akamai_client_secret.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    accessToken: z
+      .string()
+      .min(1, t("access.form.akamai_access_token.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="host"
+        label={t("access.form.akamai_host.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.akamai_host.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.akamai_host.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="clientToken"
+        label={t("access.form.akamai_client_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.akamai_client_token.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.akamai_client_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="clientSecret"
+        label={t("access.form.akamai_client_secret.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.akamai_client_secret.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.akamai_client_secret.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="accessToken"
+        label={t("access.form.akamai_access_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.akamai_access_token.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.akamai_access_token.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormAkamaiConfig;
@@ -1,8 +1,9 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import Show from "@/components/Show";
 import { type AccessConfigForAliyun } from "@/domain/access";
 
 type AccessFormAliyunConfigFieldValues = Nullish<AccessConfigForAliyun>;
@@ -15,8 +16,12 @@ export type AccessFormAliyunConfigProps = {
   onValuesChange?: (values: AccessFormAliyunConfigFieldValues) => void;
 };
 
+const CREDENTIAL_MODE_STATIC = "static" as const;
+const CREDENTIAL_MODE_ENVIRONMENT = "environment" as const;
+
 const initFormModel = (): AccessFormAliyunConfigFieldValues => {
   return {
+    credentialMode: CREDENTIAL_MODE_STATIC,
     accessKeyId: "",
     accessKeySecret: "",
   };
@@ -26,19 +31,44 @@ const AccessFormAliyunConfig = ({ form: formInst, formName, disabled, initialVal
   const { t } = useTranslation();
 
   const formSchema = z.object({
+    credentialMode: z.enum([CREDENTIAL_MODE_STATIC, CREDENTIAL_MODE_ENVIRONMENT]).nullish(),
     accessKeyId: z
       .string()
-      .min(1, t("access.form.aliyun_access_key_id.placeholder"))
       .max(64, t("common.errmsg.string_max", { max: 64 }))
-      .trim(),
+      .trim()
+      .nullish()
+      .refine((v) => fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !!v, t("access.form.aliyun_access_key_id.placeholder")),
     accessKeySecret: z
       .string()
-      .min(1, t("access.form.aliyun_access_key_secret.placeholder"))
       .max(64, t("common.errmsg.string_max", { max: 64 }))
-      .trim(),
+      .trim()
+      .nullish()
+      .refine((v) => fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !!v, t("access.form.aliyun_access_key_secret.placeholder")),
+    securityToken: z
+      .string()
+      .max(4096, t("common.errmsg.string_max", { max: 4096 }))
+      .trim()
+      .nullish(),
+    ecsRamRoleName: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    roleArn: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    roleSessionName: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldCredentialMode = Form.useWatch("credentialMode", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -53,21 +83,77 @@ const AccessFormAliyunConfig = ({ form: formInst, formName, disabled, initialVal
       onValuesChange={handleFormChange}
     >
       <Form.Item
-        name="accessKeyId"
-        label={t("access.form.aliyun_access_key_id.label")}
+        name="credentialMode"
+        label={t("access.form.aliyun_credential_mode.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_credential_mode.tooltip") }}></span>}
+      >
+        <Select placeholder={t("access.form.aliyun_credential_mode.placeholder")}>
+          <Select.Option key={CREDENTIAL_MODE_STATIC} value={CREDENTIAL_MODE_STATIC}>
+            {t("access.form.aliyun_credential_mode.option.static.label")}
+          </Select.Option>
+          <Select.Option key={CREDENTIAL_MODE_ENVIRONMENT} value={CREDENTIAL_MODE_ENVIRONMENT}>
+            {t("access.form.aliyun_credential_mode.option.environment.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCredentialMode !== CREDENTIAL_MODE_ENVIRONMENT}>
+        <Form.Item
+          name="accessKeyId"
+          label={t("access.form.aliyun_access_key_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_access_key_id.tooltip") }}></span>}
+        >
+          <Input autoComplete="new-password" placeholder={t("access.form.aliyun_access_key_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="accessKeySecret"
+          label={t("access.form.aliyun_access_key_secret.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_access_key_secret.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("access.form.aliyun_access_key_secret.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="securityToken"
+          label={t("access.form.aliyun_security_token.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_security_token.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("access.form.aliyun_security_token.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT}>
+        <Form.Item
+          name="ecsRamRoleName"
+          label={t("access.form.aliyun_ecs_ram_role_name.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_ecs_ram_role_name.tooltip") }}></span>}
+        >
+          <Input placeholder={t("access.form.aliyun_ecs_ram_role_name.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        name="roleArn"
+        label={t("access.form.aliyun_role_arn.label")}
         rules={[formRule]}
-        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_access_key_id.tooltip") }}></span>}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_role_arn.tooltip") }}></span>}
       >
-        <Input autoComplete="new-password" placeholder={t("access.form.aliyun_access_key_id.placeholder")} />
+        <Input placeholder={t("access.form.aliyun_role_arn.placeholder")} />
       </Form.Item>
 
       <Form.Item
-        name="accessKeySecret"
-        label={t("access.form.aliyun_access_key_secret.label")}
+        name="roleSessionName"
+        label={t("access.form.aliyun_role_session_name.label")}
         rules={[formRule]}
-        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_access_key_secret.tooltip") }}></span>}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.aliyun_role_session_name.tooltip") }}></span>}
       >
-        <Input.Password autoComplete="new-password" placeholder={t("access.form.aliyun_access_key_secret.placeholder")} />
+        <Input placeholder={t("access.form.aliyun_role_session_name.placeholder")} />
       </Form.Item>
     </Form>
   );
@@ -0,0 +1,100 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForCPanel } from "@/domain/access";
+
+type AccessFormCPanelConfigFieldValues = Nullish<AccessConfigForCPanel>;
+
+export type AccessFormCPanelConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormCPanelConfigFieldValues;
+  onValuesChange?: (values: AccessFormCPanelConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormCPanelConfigFieldValues => {
+  return {
+    serverUrl: "https://example.com:2083/",
+    username: "",
+    apiToken: "",
+  };
+};
+
+const AccessFormCPanelConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormCPanelConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.cpanel_username.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    apiToken: z
+      .string()
+      .min(1, t("access.form.cpanel_api_token.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.cpanel_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cpanel_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.cpanel_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="username"
+        label={t("access.form.cpanel_username.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cpanel_username.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.cpanel_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiToken"
+        label={t("access.form.cpanel_api_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cpanel_api_token.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.cpanel_api_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.cpanel_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cpanel_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.cpanel_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.cpanel_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormCPanelConfig;
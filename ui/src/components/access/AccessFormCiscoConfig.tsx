@@ -0,0 +1,105 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, InputNumber } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForCisco } from "@/domain/access";
+import { validDomainName, validIPv4Address, validIPv6Address } from "@/utils/validators";
+
+type AccessFormCiscoConfigFieldValues = Nullish<AccessConfigForCisco>;
+
+export type AccessFormCiscoConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormCiscoConfigFieldValues;
+  onValuesChange?: (values: AccessFormCiscoConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormCiscoConfigFieldValues => {
+  return {
+    host: "192.168.1.1",
+    port: 22,
+    username: "admin",
+    password: "",
+  };
+};
+
+const AccessFormCiscoConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormCiscoConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    host: z
+      .string({ message: t("access.form.cisco_host.placeholder") })
+      .refine((v) => validDomainName(v) || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.host_invalid")),
+    port: z
+      .number({ message: t("access.form.cisco_port.placeholder") })
+      .int()
+      .gte(1, t("common.errmsg.port_invalid"))
+      .lte(65535, t("common.errmsg.port_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.cisco_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    password: z
+      .string()
+      .min(1, t("access.form.cisco_password.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <div className="flex space-x-2">
+        <div className="w-2/3">
+          <Form.Item
+            name="host"
+            label={t("access.form.cisco_host.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cisco_host.tooltip") }}></span>}
+          >
+            <Input placeholder={t("access.form.cisco_host.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/3">
+          <Form.Item name="port" label={t("access.form.cisco_port.label")} rules={[formRule]}>
+            <InputNumber className="w-full" placeholder={t("access.form.cisco_port.placeholder")} min={1} max={65535} />
+          </Form.Item>
+        </div>
+      </div>
+
+      <div className="flex space-x-2">
+        <div className="w-1/2">
+          <Form.Item
+            name="username"
+            label={t("access.form.cisco_username.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.cisco_username.tooltip") }}></span>}
+          >
+            <Input autoComplete="new-password" placeholder={t("access.form.cisco_username.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/2">
+          <Form.Item name="password" label={t("access.form.cisco_password.label")} rules={[formRule]}>
+            <Input.Password autoComplete="new-password" placeholder={t("access.form.cisco_password.placeholder")} />
+          </Form.Item>
+        </div>
+      </div>
+    </Form>
+  );
+};
+
+export default AccessFormCiscoConfig;
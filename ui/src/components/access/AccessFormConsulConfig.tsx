@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForConsul } from "@/domain/access";
+
+type AccessFormConsulConfigFieldValues = Nullish<AccessConfigForConsul>;
+
+export type AccessFormConsulConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormConsulConfigFieldValues;
+  onValuesChange?: (values: AccessFormConsulConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormConsulConfigFieldValues => {
+  return {
+    serverUrl: "http://127.0.0.1:8500/",
+    token: "",
+  };
+};
+
+const AccessFormConsulConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormConsulConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    token: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.consul_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.consul_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.consul_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="token"
+        label={t("access.form.consul_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.consul_token.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.consul_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.consul_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.consul_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.consul_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.consul_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormConsulConfig;
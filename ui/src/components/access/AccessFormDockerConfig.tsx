@@ -0,0 +1,144 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { UploadOutlined as UploadOutlinedIcon } from "@ant-design/icons";
+import { Button, Form, type FormInstance, Input, Switch, Upload, type UploadFile, type UploadProps } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { type AccessConfigForDocker } from "@/domain/access";
+import { readFileContent } from "@/utils/file";
+
+type AccessFormDockerConfigFieldValues = Nullish<AccessConfigForDocker>;
+
+export type AccessFormDockerConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormDockerConfigFieldValues;
+  onValuesChange?: (values: AccessFormDockerConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormDockerConfigFieldValues => {
+  return {
+    dockerHost: "unix:///var/run/docker.sock",
+  };
+};
+
+const AccessFormDockerConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormDockerConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    dockerHost: z
+      .string({ message: t("access.form.docker_host.placeholder") })
+      .trim()
+      .min(1, t("access.form.docker_host.placeholder"))
+      .refine((v) => /^(unix|tcp|http|https):\/\/.+$/.test(v), t("access.form.docker_host.errmsg.invalid")),
+    tlsCaCertificate: z
+      .string()
+      .trim()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+    tlsCertificate: z
+      .string()
+      .trim()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+    tlsPrivateKey: z
+      .string()
+      .trim()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldDockerHost = Form.useWatch<string>("dockerHost", formInst);
+
+  const [fieldFileLists, setFieldFileLists] = useState<Record<string, UploadFile[]>>({});
+  useEffect(() => {
+    setFieldFileLists({
+      tlsCaCertificate: initialValues?.tlsCaCertificate?.trim() ? [{ uid: "-1", name: "ca.pem", status: "done" }] : [],
+      tlsCertificate: initialValues?.tlsCertificate?.trim() ? [{ uid: "-1", name: "cert.pem", status: "done" }] : [],
+      tlsPrivateKey: initialValues?.tlsPrivateKey?.trim() ? [{ uid: "-1", name: "key.pem", status: "done" }] : [],
+    });
+  }, [initialValues?.tlsCaCertificate, initialValues?.tlsCertificate, initialValues?.tlsPrivateKey]);
+
+  const handleFileChange = (fieldName: string): UploadProps["onChange"] => {
+    return async ({ file }) => {
+      if (file && file.status !== "removed") {
+        formInst.setFieldValue(fieldName, await readFileContent(file.originFileObj ?? (file as unknown as File)));
+        setFieldFileLists((prev) => ({ ...prev, [fieldName]: [file] }));
+      } else {
+        formInst.setFieldValue(fieldName, "");
+        setFieldFileLists((prev) => ({ ...prev, [fieldName]: [] }));
+      }
+
+      onValuesChange?.(formInst.getFieldsValue(true));
+    };
+  };
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="dockerHost"
+        label={t("access.form.docker_host.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.docker_host.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.docker_host.placeholder")} />
+      </Form.Item>
+
+      <Show when={!String(fieldDockerHost ?? "").startsWith("unix://")}>
+        {(["tlsCaCertificate", "tlsCertificate", "tlsPrivateKey"] as const).map((fieldName) => {
+          const i18nKey = {
+            tlsCaCertificate: "docker_tls_ca_certificate",
+            tlsCertificate: "docker_tls_certificate",
+            tlsPrivateKey: "docker_tls_private_key",
+          }[fieldName];
+
+          return (
+            <div key={fieldName}>
+              <Form.Item name={fieldName} noStyle rules={[formRule]}>
+                <Input.TextArea autoComplete="new-password" hidden placeholder={t(`access.form.${i18nKey}.placeholder`)} />
+              </Form.Item>
+              <Form.Item
+                label={t(`access.form.${i18nKey}.label`)}
+                tooltip={<span dangerouslySetInnerHTML={{ __html: t(`access.form.${i18nKey}.tooltip`) }}></span>}
+              >
+                <Upload beforeUpload={() => false} fileList={fieldFileLists[fieldName]} maxCount={1} onChange={handleFileChange(fieldName)}>
+                  <Button icon={<UploadOutlinedIcon />}>{t(`access.form.${i18nKey}.upload`)}</Button>
+                </Upload>
+              </Form.Item>
+            </div>
+          );
+        })}
+
+        <Form.Item
+          name="allowInsecureConnections"
+          label={t("access.form.docker_allow_insecure_conns.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.docker_allow_insecure_conns.tooltip") }}></span>}
+        >
+          <Switch
+            checkedChildren={t("access.form.docker_allow_insecure_conns.switch.on")}
+            unCheckedChildren={t("access.form.docker_allow_insecure_conns.switch.off")}
+          />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default AccessFormDockerConfig;
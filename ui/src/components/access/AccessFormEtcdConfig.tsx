@@ -0,0 +1,104 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { type AccessConfigForEtcd } from "@/domain/access";
+
+type AccessFormEtcdConfigFieldValues = Nullish<AccessConfigForEtcd>;
+
+export type AccessFormEtcdConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormEtcdConfigFieldValues;
+  onValuesChange?: (values: AccessFormEtcdConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormEtcdConfigFieldValues => {
+  return {
+    endpoints: "127.0.0.1:2379",
+  };
+};
+
+const AccessFormEtcdConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormEtcdConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    endpoints: z
+      .string({ message: t("access.form.etcd_endpoints.placeholder") })
+      .trim()
+      .min(1, t("access.form.etcd_endpoints.placeholder")),
+    username: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    password: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    useTLS: z.boolean().nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldUseTLS = Form.useWatch<boolean>("useTLS", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="endpoints"
+        label={t("access.form.etcd_endpoints.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.etcd_endpoints.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.etcd_endpoints.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="username"
+        label={t("access.form.etcd_username.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.etcd_username.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.etcd_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.etcd_password.label")} rules={[formRule]}>
+        <Input.Password allowClear autoComplete="new-password" placeholder={t("access.form.etcd_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="useTLS" label={t("access.form.etcd_use_tls.label")} rules={[formRule]}>
+        <Switch />
+      </Form.Item>
+
+      <Show when={!!fieldUseTLS}>
+        <Form.Item
+          name="allowInsecureConnections"
+          label={t("access.form.etcd_allow_insecure_conns.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.etcd_allow_insecure_conns.tooltip") }}></span>}
+        >
+          <Switch
+            checkedChildren={t("access.form.etcd_allow_insecure_conns.switch.on")}
+            unCheckedChildren={t("access.form.etcd_allow_insecure_conns.switch.off")}
+          />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default AccessFormEtcdConfig;
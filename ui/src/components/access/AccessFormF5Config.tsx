@@ -0,0 +1,92 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForF5 } from "@/domain/access";
+
+type AccessFormF5ConfigFieldValues = Nullish<AccessConfigForF5>;
+
+export type AccessFormF5ConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormF5ConfigFieldValues;
+  onValuesChange?: (values: AccessFormF5ConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormF5ConfigFieldValues => {
+  return {
+    serverUrl: "https://192.168.1.245/",
+    username: "admin",
+    password: "",
+  };
+};
+
+const AccessFormF5Config = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormF5ConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.f5_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    password: z
+      .string()
+      .min(1, t("access.form.f5_password.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.f5_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.f5_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.f5_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="username"
+        label={t("access.form.f5_username.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.f5_username.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.f5_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.f5_password.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.f5_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.f5_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.f5_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch checkedChildren={t("access.form.f5_allow_insecure_conns.switch.on")} unCheckedChildren={t("access.form.f5_allow_insecure_conns.switch.off")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormF5Config;
@@ -0,0 +1,126 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, InputNumber, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { type AccessConfigForFTP } from "@/domain/access";
+import { validDomainName, validIPv4Address, validIPv6Address } from "@/utils/validators";
+
+type AccessFormFTPConfigFieldValues = Nullish<AccessConfigForFTP>;
+
+export type AccessFormFTPConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormFTPConfigFieldValues;
+  onValuesChange?: (values: AccessFormFTPConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormFTPConfigFieldValues => {
+  return {
+    host: "127.0.0.1",
+    port: 21,
+  };
+};
+
+const AccessFormFTPConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormFTPConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    host: z
+      .string({ message: t("access.form.ftp_host.placeholder") })
+      .refine((v) => validDomainName(v) || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.host_invalid")),
+    port: z
+      .number({ message: t("access.form.ftp_port.placeholder") })
+      .int()
+      .gte(1, t("common.errmsg.port_invalid"))
+      .lte(65535, t("common.errmsg.port_invalid")),
+    username: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
+    password: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
+    useExplicitTLS: z.boolean().nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldUseExplicitTLS = Form.useWatch<boolean>("useExplicitTLS", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <div className="flex space-x-2">
+        <div className="w-2/3">
+          <Form.Item name="host" label={t("access.form.ftp_host.label")} rules={[formRule]}>
+            <Input placeholder={t("access.form.ftp_host.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/3">
+          <Form.Item name="port" label={t("access.form.ftp_port.label")} rules={[formRule]}>
+            <InputNumber className="w-full" placeholder={t("access.form.ftp_port.placeholder")} min={1} max={65535} />
+          </Form.Item>
+        </div>
+      </div>
+
+      <div className="flex space-x-2">
+        <div className="w-1/2">
+          <Form.Item
+            name="username"
+            label={t("access.form.ftp_username.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ftp_username.tooltip") }}></span>}
+          >
+            <Input autoComplete="new-password" placeholder={t("access.form.ftp_username.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/2">
+          <Form.Item name="password" label={t("access.form.ftp_password.label")} rules={[formRule]}>
+            <Input.Password autoComplete="new-password" placeholder={t("access.form.ftp_password.placeholder")} />
+          </Form.Item>
+        </div>
+      </div>
+
+      <Form.Item
+        name="useExplicitTLS"
+        label={t("access.form.ftp_use_explicit_tls.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ftp_use_explicit_tls.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Show when={!!fieldUseExplicitTLS}>
+        <Form.Item
+          name="allowInsecureConnections"
+          label={t("access.form.ftp_allow_insecure_conns.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ftp_allow_insecure_conns.tooltip") }}></span>}
+        >
+          <Switch
+            checkedChildren={t("access.form.ftp_allow_insecure_conns.switch.on")}
+            unCheckedChildren={t("access.form.ftp_allow_insecure_conns.switch.off")}
+          />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default AccessFormFTPConfig;
@@ -0,0 +1,56 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForFastly } from "@/domain/access";
+
+type AccessFormFastlyConfigFieldValues = Nullish<AccessConfigForFastly>;
+
+export type AccessFormFastlyConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormFastlyConfigFieldValues;
+  onValuesChange?: (values: AccessFormFastlyConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormFastlyConfigFieldValues => {
+  return {
+    apiToken: "",
+  };
+};
+
+const AccessFormFastlyConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormFastlyConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    apiToken: z
+      .string()
+      .min(1, t("access.form.fastly_api_token.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="apiToken" label={t("access.form.fastly_api_token.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.fastly_api_token.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormFastlyConfig;
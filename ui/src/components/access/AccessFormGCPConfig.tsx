@@ -0,0 +1,129 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { UploadOutlined as UploadOutlinedIcon } from "@ant-design/icons";
+import { Button, Form, type FormInstance, Input, Select, Upload, type UploadFile, type UploadProps } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { type AccessConfigForGCP } from "@/domain/access";
+import { readFileContent } from "@/utils/file";
+
+type AccessFormGCPConfigFieldValues = Nullish<AccessConfigForGCP>;
+
+export type AccessFormGCPConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormGCPConfigFieldValues;
+  onValuesChange?: (values: AccessFormGCPConfigFieldValues) => void;
+};
+
+const CREDENTIAL_MODE_STATIC = "static" as const;
+const CREDENTIAL_MODE_ENVIRONMENT = "environment" as const;
+
+const initFormModel = (): AccessFormGCPConfigFieldValues => {
+  return {
+    credentialMode: CREDENTIAL_MODE_STATIC,
+    serviceAccountKey: "",
+  };
+};
+
+const AccessFormGCPConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormGCPConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    credentialMode: z.enum([CREDENTIAL_MODE_STATIC, CREDENTIAL_MODE_ENVIRONMENT]).nullish(),
+    serviceAccountKey: z
+      .string()
+      .trim()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish()
+      .refine((v) => fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !!v, t("access.form.gcp_service_account_key.placeholder"))
+      .refine((v) => {
+        if (fieldCredentialMode === CREDENTIAL_MODE_ENVIRONMENT || !v) {
+          return true;
+        }
+
+        try {
+          const json = JSON.parse(v);
+          return typeof json === "object" && !!json.private_key;
+        } catch {
+          return false;
+        }
+      }, t("access.form.gcp_service_account_key.errmsg.invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldCredentialMode = Form.useWatch("credentialMode", formInst);
+  const fieldServiceAccountKey = Form.useWatch("serviceAccountKey", formInst);
+  const [fieldServiceAccountKeyFileList, setFieldServiceAccountKeyFileList] = useState<UploadFile[]>([]);
+  useEffect(() => {
+    setFieldServiceAccountKeyFileList(initialValues?.serviceAccountKey?.trim() ? [{ uid: "-1", name: "service-account.json", status: "done" }] : []);
+  }, [initialValues?.serviceAccountKey]);
+
+  const handleServiceAccountKeyFileChange: UploadProps["onChange"] = async ({ file }) => {
+    if (file && file.status !== "removed") {
+      formInst.setFieldValue("serviceAccountKey", await readFileContent(file.originFileObj ?? (file as unknown as File)));
+      setFieldServiceAccountKeyFileList([file]);
+    } else {
+      formInst.setFieldValue("serviceAccountKey", "");
+      setFieldServiceAccountKeyFileList([]);
+    }
+
+    onValuesChange?.(formInst.getFieldsValue(true));
+  };
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="credentialMode"
+        label={t("access.form.gcp_credential_mode.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.gcp_credential_mode.tooltip") }}></span>}
+      >
+        <Select placeholder={t("access.form.gcp_credential_mode.placeholder")}>
+          <Select.Option key={CREDENTIAL_MODE_STATIC} value={CREDENTIAL_MODE_STATIC}>
+            {t("access.form.gcp_credential_mode.option.static.label")}
+          </Select.Option>
+          <Select.Option key={CREDENTIAL_MODE_ENVIRONMENT} value={CREDENTIAL_MODE_ENVIRONMENT}>
+            {t("access.form.gcp_credential_mode.option.environment.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCredentialMode !== CREDENTIAL_MODE_ENVIRONMENT}>
+        <Form.Item name="serviceAccountKey" noStyle rules={[formRule]}>
+          <Input.TextArea
+            autoComplete="new-password"
+            hidden
+            placeholder={t("access.form.gcp_service_account_key.placeholder")}
+            value={fieldServiceAccountKey}
+          />
+        </Form.Item>
+        <Form.Item
+          label={t("access.form.gcp_service_account_key.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.gcp_service_account_key.tooltip") }}></span>}
+        >
+          <Upload beforeUpload={() => false} fileList={fieldServiceAccountKeyFileList} maxCount={1} onChange={handleServiceAccountKeyFileChange}>
+            <Button icon={<UploadOutlinedIcon />}>{t("access.form.gcp_service_account_key.upload")}</Button>
+          </Upload>
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default AccessFormGCPConfig;
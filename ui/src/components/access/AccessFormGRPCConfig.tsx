@@ -0,0 +1,96 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForGRPC } from "@/domain/access";
+
+type AccessFormGRPCConfigFieldValues = Nullish<AccessConfigForGRPC>;
+
+export type AccessFormGRPCConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormGRPCConfigFieldValues;
+  onValuesChange?: (values: AccessFormGRPCConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormGRPCConfigFieldValues => {
+  return {
+    endpoint: "127.0.0.1:50051",
+    useTLS: true,
+  };
+};
+
+const AccessFormGRPCConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormGRPCConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    endpoint: z
+      .string({ message: t("access.form.grpc_endpoint.placeholder") })
+      .min(1, t("access.form.grpc_endpoint.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    useTLS: z.boolean().nullish(),
+    authToken: z
+      .string()
+      .max(1024, t("common.errmsg.string_max", { max: 1024 }))
+      .nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldUseTLS = Form.useWatch("useTLS", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="endpoint"
+        label={t("access.form.grpc_endpoint.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.grpc_endpoint.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.grpc_endpoint.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="useTLS" label={t("access.form.grpc_use_tls.label")} rules={[formRule]}>
+        <Switch checkedChildren={t("access.form.grpc_use_tls.switch.on")} unCheckedChildren={t("access.form.grpc_use_tls.switch.off")} />
+      </Form.Item>
+
+      <Form.Item
+        name="authToken"
+        label={t("access.form.grpc_auth_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.grpc_auth_token.tooltip") }}></span>}
+      >
+        <Input.Password allowClear autoComplete="new-password" placeholder={t("access.form.grpc_auth_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.grpc_allow_insecure_conns.label")}
+        rules={[formRule]}
+        hidden={!fieldUseTLS}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.grpc_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.grpc_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.grpc_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormGRPCConfig;
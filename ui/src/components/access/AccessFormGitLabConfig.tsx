@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForGitLab } from "@/domain/access";
+
+type AccessFormGitLabConfigFieldValues = Nullish<AccessConfigForGitLab>;
+
+export type AccessFormGitLabConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormGitLabConfigFieldValues;
+  onValuesChange?: (values: AccessFormGitLabConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormGitLabConfigFieldValues => {
+  return {
+    serverUrl: "https://gitlab.com/",
+    apiToken: "",
+  };
+};
+
+const AccessFormGitLabConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormGitLabConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    apiToken: z
+      .string()
+      .min(1, t("access.form.gitlab_api_token.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.gitlab_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.gitlab_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.gitlab_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiToken"
+        label={t("access.form.gitlab_api_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.gitlab_api_token.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.gitlab_api_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.gitlab_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.gitlab_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.gitlab_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.gitlab_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormGitLabConfig;
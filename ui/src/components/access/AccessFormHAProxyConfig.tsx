@@ -0,0 +1,104 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForHAProxy } from "@/domain/access";
+
+type AccessFormHAProxyConfigFieldValues = Nullish<AccessConfigForHAProxy>;
+
+export type AccessFormHAProxyConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormHAProxyConfigFieldValues;
+  onValuesChange?: (values: AccessFormHAProxyConfigFieldValues) => void;
+};
+
+const API_VERSION_V2 = "v2" as const;
+const API_VERSION_V3 = "v3" as const;
+
+const initFormModel = (): AccessFormHAProxyConfigFieldValues => {
+  return {
+    serverUrl: "http://127.0.0.1:5555/",
+    apiVersion: API_VERSION_V3,
+    username: "admin",
+    password: "",
+  };
+};
+
+const AccessFormHAProxyConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormHAProxyConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    apiVersion: z.union([z.literal(API_VERSION_V2), z.literal(API_VERSION_V3)], {
+      message: t("access.form.haproxy_api_version.placeholder"),
+    }),
+    username: z
+      .string()
+      .min(1, t("access.form.haproxy_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    password: z
+      .string()
+      .min(1, t("access.form.haproxy_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.haproxy_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.haproxy_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.haproxy_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="apiVersion" label={t("access.form.haproxy_api_version.label")} rules={[formRule]}>
+        <Select
+          options={[API_VERSION_V3, API_VERSION_V2].map((s) => ({ label: s, value: s }))}
+          placeholder={t("access.form.haproxy_api_version.placeholder")}
+        />
+      </Form.Item>
+
+      <Form.Item name="username" label={t("access.form.haproxy_username.label")} rules={[formRule]}>
+        <Input autoComplete="new-password" placeholder={t("access.form.haproxy_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.haproxy_password.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.haproxy_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.haproxy_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.haproxy_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.haproxy_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.haproxy_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormHAProxyConfig;
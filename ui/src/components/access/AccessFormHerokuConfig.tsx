@@ -0,0 +1,56 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForHeroku } from "@/domain/access";
+
+type AccessFormHerokuConfigFieldValues = Nullish<AccessConfigForHeroku>;
+
+export type AccessFormHerokuConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormHerokuConfigFieldValues;
+  onValuesChange?: (values: AccessFormHerokuConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormHerokuConfigFieldValues => {
+  return {
+    apiKey: "",
+  };
+};
+
+const AccessFormHerokuConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormHerokuConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    apiKey: z
+      .string()
+      .min(1, t("access.form.heroku_api_key.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="apiKey" label={t("access.form.heroku_api_key.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.heroku_api_key.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormHerokuConfig;
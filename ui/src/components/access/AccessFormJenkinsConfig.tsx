@@ -0,0 +1,95 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForJenkins } from "@/domain/access";
+
+type AccessFormJenkinsConfigFieldValues = Nullish<AccessConfigForJenkins>;
+
+export type AccessFormJenkinsConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormJenkinsConfigFieldValues;
+  onValuesChange?: (values: AccessFormJenkinsConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormJenkinsConfigFieldValues => {
+  return {
+    serverUrl: "http://127.0.0.1:8080/",
+    username: "admin",
+    apiToken: "",
+  };
+};
+
+const AccessFormJenkinsConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormJenkinsConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.jenkins_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    apiToken: z
+      .string()
+      .min(1, t("access.form.jenkins_api_token.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.jenkins_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.jenkins_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.jenkins_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="username" label={t("access.form.jenkins_username.label")} rules={[formRule]}>
+        <Input autoComplete="new-password" placeholder={t("access.form.jenkins_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiToken"
+        label={t("access.form.jenkins_api_token.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.jenkins_api_token.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.jenkins_api_token.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.jenkins_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.jenkins_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.jenkins_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.jenkins_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormJenkinsConfig;
@@ -0,0 +1,76 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForKSyun } from "@/domain/access";
+
+type AccessFormKSyunConfigFieldValues = Nullish<AccessConfigForKSyun>;
+
+export type AccessFormKSyunConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormKSyunConfigFieldValues;
+  onValuesChange?: (values: AccessFormKSyunConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormKSyunConfigFieldValues => {
+  return {
+    accessKeyId: "",
+    secretAccessKey: "",
+  };
+};
+
+const AccessFormKSyunConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormKSyunConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    accessKeyId: z
+      .string()
+      .trim()
+      .min(1, t("access.form.ksyun_access_key_id.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 })),
+    secretAccessKey: z
+      .string()
+      .min(1, t("access.form.ksyun_secret_access_key.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="accessKeyId"
+        label={t("access.form.ksyun_access_key_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ksyun_access_key_id.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.ksyun_access_key_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="secretAccessKey"
+        label={t("access.form.ksyun_secret_access_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ksyun_secret_access_key.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.ksyun_secret_access_key.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormKSyunConfig;
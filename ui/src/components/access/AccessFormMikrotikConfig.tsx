@@ -0,0 +1,89 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForMikrotik } from "@/domain/access";
+
+type AccessFormMikrotikConfigFieldValues = Nullish<AccessConfigForMikrotik>;
+
+export type AccessFormMikrotikConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormMikrotikConfigFieldValues;
+  onValuesChange?: (values: AccessFormMikrotikConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormMikrotikConfigFieldValues => {
+  return {
+    serverUrl: "https://192.168.88.1/",
+    username: "admin",
+    password: "",
+  };
+};
+
+const AccessFormMikrotikConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormMikrotikConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.mikrotik_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    password: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.mikrotik_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.mikrotik_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.mikrotik_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="username" label={t("access.form.mikrotik_username.label")} rules={[formRule]}>
+        <Input autoComplete="new-password" placeholder={t("access.form.mikrotik_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.mikrotik_password.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.mikrotik_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.mikrotik_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.mikrotik_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.mikrotik_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.mikrotik_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormMikrotikConfig;
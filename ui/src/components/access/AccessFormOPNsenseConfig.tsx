@@ -0,0 +1,100 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForOPNsense } from "@/domain/access";
+
+type AccessFormOPNsenseConfigFieldValues = Nullish<AccessConfigForOPNsense>;
+
+export type AccessFormOPNsenseConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormOPNsenseConfigFieldValues;
+  onValuesChange?: (values: AccessFormOPNsenseConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormOPNsenseConfigFieldValues => {
+  return {
+    serverUrl: "https://192.168.1.1/",
+    apiKey: "",
+    apiSecret: "",
+  };
+};
+
+const AccessFormOPNsenseConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormOPNsenseConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    apiKey: z
+      .string()
+      .min(1, t("access.form.opnsense_api_key.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    apiSecret: z
+      .string()
+      .min(1, t("access.form.opnsense_api_secret.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.opnsense_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.opnsense_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.opnsense_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiKey"
+        label={t("access.form.opnsense_api_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.opnsense_api_key.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.opnsense_api_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiSecret"
+        label={t("access.form.opnsense_api_secret.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.opnsense_api_secret.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.opnsense_api_secret.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.opnsense_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.opnsense_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.opnsense_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.opnsense_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormOPNsenseConfig;
@@ -0,0 +1,110 @@
+import { useTranslation } from "react-i18next";
+import { AutoComplete, Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForOVHcloud } from "@/domain/access";
+
+type AccessFormOVHcloudConfigFieldValues = Nullish<AccessConfigForOVHcloud>;
+
+export type AccessFormOVHcloudConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormOVHcloudConfigFieldValues;
+  onValuesChange?: (values: AccessFormOVHcloudConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormOVHcloudConfigFieldValues => {
+  return {
+    endpoint: "ovh-eu",
+    applicationKey: "",
+    applicationSecret: "",
+    consumerKey: "",
+  };
+};
+
+const AccessFormOVHcloudConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormOVHcloudConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    endpoint: z
+      .string()
+      .min(1, t("access.form.ovhcloud_endpoint.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    applicationKey: z
+      .string()
+      .min(1, t("access.form.ovhcloud_application_key.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    applicationSecret: z
+      .string()
+      .min(1, t("access.form.ovhcloud_application_secret.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    consumerKey: z
+      .string()
+      .min(1, t("access.form.ovhcloud_consumer_key.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="endpoint"
+        label={t("access.form.ovhcloud_endpoint.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ovhcloud_endpoint.tooltip") }}></span>}
+      >
+        <AutoComplete
+          options={["ovh-eu", "ovh-ca", "ovh-us"].map((value) => ({ value }))}
+          placeholder={t("access.form.ovhcloud_endpoint.placeholder")}
+          filterOption={(inputValue, option) => option!.value.toLowerCase().includes(inputValue.toLowerCase())}
+        />
+      </Form.Item>
+
+      <Form.Item
+        name="applicationKey"
+        label={t("access.form.ovhcloud_application_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ovhcloud_application_key.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.ovhcloud_application_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="applicationSecret"
+        label={t("access.form.ovhcloud_application_secret.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ovhcloud_application_secret.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.ovhcloud_application_secret.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="consumerKey"
+        label={t("access.form.ovhcloud_consumer_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ovhcloud_consumer_key.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.ovhcloud_consumer_key.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormOVHcloudConfig;
@@ -0,0 +1,106 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForOpenStack } from "@/domain/access";
+
+type AccessFormOpenStackConfigFieldValues = Nullish<AccessConfigForOpenStack>;
+
+export type AccessFormOpenStackConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormOpenStackConfigFieldValues;
+  onValuesChange?: (values: AccessFormOpenStackConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormOpenStackConfigFieldValues => {
+  return {
+    authUrl: "",
+    domainName: "Default",
+    username: "",
+    password: "",
+    projectId: "",
+  };
+};
+
+const AccessFormOpenStackConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormOpenStackConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    authUrl: z.string({ message: t("access.form.openstack_auth_url.placeholder") }).url(t("common.errmsg.url_invalid")),
+    domainName: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    username: z
+      .string()
+      .min(1, t("access.form.openstack_username.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    password: z
+      .string()
+      .min(1, t("access.form.openstack_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 })),
+    projectId: z
+      .string()
+      .min(1, t("access.form.openstack_project_id.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="authUrl"
+        label={t("access.form.openstack_auth_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.openstack_auth_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.openstack_auth_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="domainName"
+        label={t("access.form.openstack_domain_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.openstack_domain_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.openstack_domain_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="username" label={t("access.form.openstack_username.label")} rules={[formRule]}>
+        <Input autoComplete="new-password" placeholder={t("access.form.openstack_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.openstack_password.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.openstack_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="projectId"
+        label={t("access.form.openstack_project_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.openstack_project_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.openstack_project_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormOpenStackConfig;
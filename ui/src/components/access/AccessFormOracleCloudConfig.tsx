@@ -0,0 +1,131 @@
+import { useEffect, useState } from "react";
+import { useTranslation } from "react-i18next";
+import { UploadOutlined as UploadOutlinedIcon } from "@ant-design/icons";
+import { Button, Form, type FormInstance, Input, Upload, type UploadFile, type UploadProps } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForOracleCloud } from "@/domain/access";
+import { readFileContent } from "@/utils/file";
+
+type AccessFormOracleCloudConfigFieldValues = Nullish<AccessConfigForOracleCloud>;
+
+export type AccessFormOracleCloudConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormOracleCloudConfigFieldValues;
+  onValuesChange?: (values: AccessFormOracleCloudConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormOracleCloudConfigFieldValues => {
+  return {
+    tenancyId: "",
+    userId: "",
+    fingerprint: "",
+    privateKey: "",
+  };
+};
+
+const AccessFormOracleCloudConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormOracleCloudConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    tenancyId: z
+      .string({ message: t("access.form.oraclecloud_tenancy_id.placeholder") })
+      .nonempty(t("access.form.oraclecloud_tenancy_id.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    userId: z
+      .string({ message: t("access.form.oraclecloud_user_id.placeholder") })
+      .nonempty(t("access.form.oraclecloud_user_id.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    fingerprint: z
+      .string({ message: t("access.form.oraclecloud_fingerprint.placeholder") })
+      .nonempty(t("access.form.oraclecloud_fingerprint.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    privateKey: z
+      .string({ message: t("access.form.oraclecloud_private_key.placeholder") })
+      .trim()
+      .nonempty(t("access.form.oraclecloud_private_key.placeholder"))
+      .max(20480, t("common.errmsg.string_max", { max: 20480 })),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldPrivateKey = Form.useWatch("privateKey", formInst);
+  const [fieldPrivateKeyFileList, setFieldPrivateKeyFileList] = useState<UploadFile[]>([]);
+  useEffect(() => {
+    setFieldPrivateKeyFileList(initialValues?.privateKey?.trim() ? [{ uid: "-1", name: "oci_api_key.pem", status: "done" }] : []);
+  }, [initialValues?.privateKey]);
+
+  const handlePrivateKeyFileChange: UploadProps["onChange"] = async ({ file }) => {
+    if (file && file.status !== "removed") {
+      formInst.setFieldValue("privateKey", await readFileContent(file.originFileObj ?? (file as unknown as File)));
+      setFieldPrivateKeyFileList([file]);
+    } else {
+      formInst.setFieldValue("privateKey", "");
+      setFieldPrivateKeyFileList([]);
+    }
+
+    onValuesChange?.(formInst.getFieldsValue(true));
+  };
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="tenancyId"
+        label={t("access.form.oraclecloud_tenancy_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.oraclecloud_tenancy_id.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.oraclecloud_tenancy_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="userId"
+        label={t("access.form.oraclecloud_user_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.oraclecloud_user_id.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.oraclecloud_user_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="fingerprint"
+        label={t("access.form.oraclecloud_fingerprint.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.oraclecloud_fingerprint.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.oraclecloud_fingerprint.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="privateKey" noStyle rules={[formRule]}>
+        <Input.TextArea autoComplete="new-password" hidden placeholder={t("access.form.oraclecloud_private_key.placeholder")} value={fieldPrivateKey} />
+      </Form.Item>
+      <Form.Item
+        label={t("access.form.oraclecloud_private_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.oraclecloud_private_key.tooltip") }}></span>}
+      >
+        <Upload beforeUpload={() => false} fileList={fieldPrivateKeyFileList} maxCount={1} onChange={handlePrivateKeyFileChange}>
+          <Button icon={<UploadOutlinedIcon />}>{t("access.form.oraclecloud_private_key.upload")}</Button>
+        </Upload>
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormOracleCloudConfig;
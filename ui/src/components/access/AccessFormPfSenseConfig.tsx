@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForPfSense } from "@/domain/access";
+
+type AccessFormPfSenseConfigFieldValues = Nullish<AccessConfigForPfSense>;
+
+export type AccessFormPfSenseConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormPfSenseConfigFieldValues;
+  onValuesChange?: (values: AccessFormPfSenseConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormPfSenseConfigFieldValues => {
+  return {
+    serverUrl: "https://192.168.1.1/",
+    apiKey: "",
+  };
+};
+
+const AccessFormPfSenseConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormPfSenseConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    apiKey: z
+      .string()
+      .min(1, t("access.form.pfsense_api_key.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.pfsense_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.pfsense_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.pfsense_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="apiKey"
+        label={t("access.form.pfsense_api_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.pfsense_api_key.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.pfsense_api_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.pfsense_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.pfsense_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.pfsense_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.pfsense_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormPfSenseConfig;
@@ -1,7 +1,7 @@
 import { useEffect, useState } from "react";
 import { useTranslation } from "react-i18next";
-import { UploadOutlined as UploadOutlinedIcon } from "@ant-design/icons";
-import { Button, Form, type FormInstance, Input, InputNumber, Upload, type UploadFile, type UploadProps } from "antd";
+import { CloseOutlined as CloseOutlinedIcon, PlusOutlined as PlusOutlinedIcon, UploadOutlined as UploadOutlinedIcon } from "@ant-design/icons";
+import { Button, Card, Form, type FormInstance, Input, InputNumber, Upload, type UploadFile, type UploadProps } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
@@ -56,6 +56,37 @@ const AccessFormSSHConfig = ({ form: formInst, formName, disabled, initialValues
       .max(20480, t("common.errmsg.string_max", { max: 20480 }))
       .nullish()
       .refine((v) => !v || formInst.getFieldValue("key"), t("access.form.ssh_key.placeholder")),
+    jumpServers: z
+      .array(
+        z.object({
+          host: z
+            .string({ message: t("access.form.ssh_host.placeholder") })
+            .refine((v) => validDomainName(v) || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.host_invalid")),
+          port: z
+            .number({ message: t("access.form.ssh_port.placeholder") })
+            .int()
+            .gte(1, t("common.errmsg.port_invalid"))
+            .lte(65535, t("common.errmsg.port_invalid")),
+          username: z
+            .string()
+            .min(1, "access.form.ssh_username.placeholder")
+            .max(64, t("common.errmsg.string_max", { max: 64 })),
+          password: z
+            .string()
+            .max(64, t("common.errmsg.string_max", { max: 64 }))
+            .nullish(),
+          key: z
+            .string()
+            .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+            .nullish(),
+          keyPassphrase: z
+            .string()
+            .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+            .nullish(),
+        }),
+        { message: t("access.form.ssh_jump_servers.placeholder") }
+      )
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -146,6 +177,79 @@ const AccessFormSSHConfig = ({ form: formInst, formName, disabled, initialValues
           </Form.Item>
         </div>
       </div>
+
+      <Form.Item
+        label={t("access.form.ssh_jump_servers.label")}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ssh_jump_servers.tooltip") }}></span>}
+      >
+        <Form.List name="jumpServers">
+          {(fields, { add, remove }) => (
+            <div className="flex flex-col gap-2">
+              {fields.map((field) => (
+                <Card
+                  key={field.key}
+                  size="small"
+                  title={t("access.form.ssh_jump_servers.item.title", { index: field.name + 1 })}
+                  extra={<Button icon={<CloseOutlinedIcon />} size="small" type="text" onClick={() => remove(field.name)} />}
+                >
+                  <div className="flex space-x-2">
+                    <div className="w-2/3">
+                      <Form.Item name={[field.name, "host"]} label={t("access.form.ssh_host.label")} rules={[formRule]}>
+                        <Input placeholder={t("access.form.ssh_host.placeholder")} />
+                      </Form.Item>
+                    </div>
+                    <div className="w-1/3">
+                      <Form.Item name={[field.name, "port"]} label={t("access.form.ssh_port.label")} rules={[formRule]}>
+                        <InputNumber className="w-full" placeholder={t("access.form.ssh_port.placeholder")} min={1} max={65535} />
+                      </Form.Item>
+                    </div>
+                  </div>
+
+                  <div className="flex space-x-2">
+                    <div className="w-1/2">
+                      <Form.Item name={[field.name, "username"]} label={t("access.form.ssh_username.label")} rules={[formRule]}>
+                        <Input autoComplete="new-password" placeholder={t("access.form.ssh_username.placeholder")} />
+                      </Form.Item>
+                    </div>
+                    <div className="w-1/2">
+                      <Form.Item
+                        name={[field.name, "password"]}
+                        label={t("access.form.ssh_password.label")}
+                        rules={[formRule]}
+                        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ssh_password.tooltip") }}></span>}
+                      >
+                        <Input.Password autoComplete="new-password" placeholder={t("access.form.ssh_password.placeholder")} />
+                      </Form.Item>
+                    </div>
+                  </div>
+
+                  <Form.Item
+                    name={[field.name, "key"]}
+                    label={t("access.form.ssh_key.label")}
+                    rules={[formRule]}
+                    tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ssh_key.tooltip") }}></span>}
+                  >
+                    <Input.TextArea autoComplete="new-password" autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("access.form.ssh_key.placeholder")} />
+                  </Form.Item>
+
+                  <Form.Item
+                    className="mb-0"
+                    name={[field.name, "keyPassphrase"]}
+                    label={t("access.form.ssh_key_passphrase.label")}
+                    rules={[formRule]}
+                    tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.ssh_key_passphrase.tooltip") }}></span>}
+                  >
+                    <Input.Password autoComplete="new-password" placeholder={t("access.form.ssh_key_passphrase.placeholder")} />
+                  </Form.Item>
+                </Card>
+              ))}
+              <Button block icon={<PlusOutlinedIcon />} type="dashed" onClick={() => add({ host: "", port: 22, username: "root" })}>
+                {t("access.form.ssh_jump_servers.button")}
+              </Button>
+            </div>
+          )}
+        </Form.List>
+      </Form.Item>
     </Form>
   );
 };
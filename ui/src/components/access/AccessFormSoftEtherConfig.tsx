@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForSoftEther } from "@/domain/access";
+
+type AccessFormSoftEtherConfigFieldValues = Nullish<AccessConfigForSoftEther>;
+
+export type AccessFormSoftEtherConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormSoftEtherConfigFieldValues;
+  onValuesChange?: (values: AccessFormSoftEtherConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormSoftEtherConfigFieldValues => {
+  return {
+    serverUrl: "https://<your-host-addr>:5555/",
+    adminPassword: "",
+  };
+};
+
+const AccessFormSoftEtherConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormSoftEtherConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    adminPassword: z
+      .string()
+      .min(1, t("access.form.softether_admin_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.softether_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.softether_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.softether_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="adminPassword"
+        label={t("access.form.softether_admin_password.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.softether_admin_password.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.softether_admin_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.softether_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.softether_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.softether_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.softether_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormSoftEtherConfig;
@@ -0,0 +1,90 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForSophos } from "@/domain/access";
+
+type AccessFormSophosConfigFieldValues = Nullish<AccessConfigForSophos>;
+
+export type AccessFormSophosConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormSophosConfigFieldValues;
+  onValuesChange?: (values: AccessFormSophosConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormSophosConfigFieldValues => {
+  return {
+    serverUrl: "https://127.0.0.1:4444/",
+    username: "admin",
+    password: "",
+  };
+};
+
+const AccessFormSophosConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormSophosConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    username: z
+      .string()
+      .min(1, t("access.form.sophos_username.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    password: z
+      .string()
+      .min(1, t("access.form.sophos_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.sophos_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.sophos_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.sophos_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="username" label={t("access.form.sophos_username.label")} rules={[formRule]}>
+        <Input autoComplete="new-password" placeholder={t("access.form.sophos_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.sophos_password.label")} rules={[formRule]}>
+        <Input.Password autoComplete="new-password" placeholder={t("access.form.sophos_password.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.sophos_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.sophos_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.sophos_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.sophos_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormSophosConfig;
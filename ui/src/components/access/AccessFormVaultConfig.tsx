@@ -0,0 +1,166 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { type AccessConfigForVault } from "@/domain/access";
+
+type AccessFormVaultConfigFieldValues = Nullish<AccessConfigForVault>;
+
+export type AccessFormVaultConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormVaultConfigFieldValues;
+  onValuesChange?: (values: AccessFormVaultConfigFieldValues) => void;
+};
+
+const AUTH_METHOD_TOKEN = "token" as const;
+const AUTH_METHOD_APPROLE = "approle" as const;
+
+const initFormModel = (): AccessFormVaultConfigFieldValues => {
+  return {
+    serverUrl: "http://127.0.0.1:8200/",
+    authMethod: AUTH_METHOD_TOKEN,
+    token: "",
+  };
+};
+
+const AccessFormVaultConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormVaultConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serverUrl: z.string().url(t("common.errmsg.url_invalid")),
+    namespace: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    authMethod: z.enum([AUTH_METHOD_TOKEN, AUTH_METHOD_APPROLE], { message: t("access.form.vault_auth_method.placeholder") }),
+    token: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldAuthMethod !== AUTH_METHOD_TOKEN || !!v, t("access.form.vault_token.placeholder")),
+    appRoleMountPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    appRoleRoleId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldAuthMethod !== AUTH_METHOD_APPROLE || !!v, t("access.form.vault_approle_role_id.placeholder")),
+    appRoleSecretId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldAuthMethod = Form.useWatch("authMethod", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serverUrl"
+        label={t("access.form.vault_server_url.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_server_url.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.vault_server_url.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="namespace"
+        label={t("access.form.vault_namespace.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_namespace.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.vault_namespace.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="authMethod" label={t("access.form.vault_auth_method.label")} rules={[formRule]}>
+        <Select placeholder={t("access.form.vault_auth_method.placeholder")}>
+          <Select.Option key={AUTH_METHOD_TOKEN} value={AUTH_METHOD_TOKEN}>
+            {t("access.form.vault_auth_method.option.token.label")}
+          </Select.Option>
+          <Select.Option key={AUTH_METHOD_APPROLE} value={AUTH_METHOD_APPROLE}>
+            {t("access.form.vault_auth_method.option.approle.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldAuthMethod === AUTH_METHOD_TOKEN}>
+        <Form.Item
+          name="token"
+          label={t("access.form.vault_token.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_token.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("access.form.vault_token.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldAuthMethod === AUTH_METHOD_APPROLE}>
+        <Form.Item
+          name="appRoleMountPath"
+          label={t("access.form.vault_approle_mount_path.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_approle_mount_path.tooltip") }}></span>}
+        >
+          <Input placeholder={t("access.form.vault_approle_mount_path.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="appRoleRoleId"
+          label={t("access.form.vault_approle_role_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_approle_role_id.tooltip") }}></span>}
+        >
+          <Input autoComplete="new-password" placeholder={t("access.form.vault_approle_role_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="appRoleSecretId"
+          label={t("access.form.vault_approle_secret_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_approle_secret_id.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("access.form.vault_approle_secret_id.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.vault_allow_insecure_conns.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.vault_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.vault_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.vault_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormVaultConfig;
@@ -26,6 +26,30 @@ const AccessFormWebhookConfig = ({ form: formInst, formName, disabled, initialVa
 
   const formSchema = z.object({
     url: z.string({ message: t("access.form.webhook_url.placeholder") }).url(t("common.errmsg.url_invalid")),
+    headers: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        if (!v) return true;
+        return v
+          .split("\n")
+          .filter((line) => !!line.trim())
+          .every((line) => line.indexOf(":") > 0);
+      }, t("access.form.webhook_headers.errmsg.invalid")),
+    hmacSecret: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    tlsClientCert: z
+      .string()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish()
+      .refine((v) => !v === !formInst.getFieldValue("tlsClientKey"), t("access.form.webhook_tls_client_cert.errmsg.mismatched")),
+    tlsClientKey: z
+      .string()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish()
+      .refine((v) => !v === !formInst.getFieldValue("tlsClientCert"), t("access.form.webhook_tls_client_cert.errmsg.mismatched")),
     allowInsecureConnections: z.boolean().nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
@@ -47,6 +71,37 @@ const AccessFormWebhookConfig = ({ form: formInst, formName, disabled, initialVa
         <Input placeholder={t("access.form.webhook_url.placeholder")} />
       </Form.Item>
 
+      <Form.Item
+        name="headers"
+        label={t("access.form.webhook_headers.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.webhook_headers.tooltip") }}></span>}
+      >
+        <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("access.form.webhook_headers.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="hmacSecret"
+        label={t("access.form.webhook_hmac_secret.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.webhook_hmac_secret.tooltip") }}></span>}
+      >
+        <Input.Password allowClear autoComplete="new-password" placeholder={t("access.form.webhook_hmac_secret.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="tlsClientCert"
+        label={t("access.form.webhook_tls_client_cert.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.webhook_tls_client_cert.tooltip") }}></span>}
+      >
+        <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("access.form.webhook_tls_client_cert.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="tlsClientKey" label={t("access.form.webhook_tls_client_key.label")} rules={[formRule]}>
+        <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("access.form.webhook_tls_client_key.placeholder")} />
+      </Form.Item>
+
       <Form.Item
         name="allowInsecureConnections"
         label={t("access.form.webhook_allow_insecure_conns.label")}
@@ -0,0 +1,153 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, InputNumber, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForWinRM } from "@/domain/access";
+import { validDomainName, validIPv4Address, validIPv6Address } from "@/utils/validators";
+
+type AccessFormWinRMConfigFieldValues = Nullish<AccessConfigForWinRM>;
+
+export type AccessFormWinRMConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormWinRMConfigFieldValues;
+  onValuesChange?: (values: AccessFormWinRMConfigFieldValues) => void;
+};
+
+const AUTH_METHOD_NTLM = "ntlm" as const;
+const AUTH_METHOD_BASIC = "basic" as const;
+
+const initFormModel = (): AccessFormWinRMConfigFieldValues => {
+  return {
+    host: "127.0.0.1",
+    port: 5986,
+    useHttps: true,
+    authMethod: AUTH_METHOD_NTLM,
+    username: "Administrator",
+    password: "",
+  };
+};
+
+const AccessFormWinRMConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormWinRMConfigProps) => {
+  const { t } = useTranslation();
+
+  const fieldUseHttps = Form.useWatch("useHttps", formInst);
+
+  const formSchema = z.object({
+    host: z
+      .string({ message: t("access.form.winrm_host.placeholder") })
+      .refine((v) => validDomainName(v) || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.host_invalid")),
+    port: z
+      .number({ message: t("access.form.winrm_port.placeholder") })
+      .int()
+      .gte(1, t("common.errmsg.port_invalid"))
+      .lte(65535, t("common.errmsg.port_invalid")),
+    useHttps: z.boolean().nullish(),
+    authMethod: z
+      .union([z.literal(AUTH_METHOD_NTLM), z.literal(AUTH_METHOD_BASIC)], {
+        message: t("access.form.winrm_auth_method.placeholder"),
+      })
+      .refine((v) => v !== AUTH_METHOD_NTLM || !!fieldUseHttps, t("access.form.winrm_auth_method.errmsg.ntlm_requires_https")),
+    username: z
+      .string()
+      .min(1, t("access.form.winrm_username.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    password: z
+      .string()
+      .min(1, t("access.form.winrm_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 })),
+    allowInsecureConnections: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <div className="flex space-x-2">
+        <div className="w-2/3">
+          <Form.Item name="host" label={t("access.form.winrm_host.label")} rules={[formRule]}>
+            <Input placeholder={t("access.form.winrm_host.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/3">
+          <Form.Item name="port" label={t("access.form.winrm_port.label")} rules={[formRule]}>
+            <InputNumber className="w-full" placeholder={t("access.form.winrm_port.placeholder")} min={1} max={65535} />
+          </Form.Item>
+        </div>
+      </div>
+
+      <Form.Item
+        name="useHttps"
+        label={t("access.form.winrm_use_https.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.winrm_use_https.tooltip") }}></span>}
+      >
+        <Switch checkedChildren={t("access.form.winrm_use_https.switch.on")} unCheckedChildren={t("access.form.winrm_use_https.switch.off")} />
+      </Form.Item>
+
+      <Form.Item
+        name="authMethod"
+        label={t("access.form.winrm_auth_method.label")}
+        dependencies={["useHttps"]}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.winrm_auth_method.tooltip") }}></span>}
+      >
+        <Select
+          options={[AUTH_METHOD_NTLM, AUTH_METHOD_BASIC].map((s) => ({
+            label: t(`access.form.winrm_auth_method.option.${s}.label`),
+            value: s,
+          }))}
+          placeholder={t("access.form.winrm_auth_method.placeholder")}
+        />
+      </Form.Item>
+
+      <div className="flex space-x-2">
+        <div className="w-1/2">
+          <Form.Item
+            name="username"
+            label={t("access.form.winrm_username.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.winrm_username.tooltip") }}></span>}
+          >
+            <Input autoComplete="new-password" placeholder={t("access.form.winrm_username.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/2">
+          <Form.Item name="password" label={t("access.form.winrm_password.label")} rules={[formRule]}>
+            <Input.Password autoComplete="new-password" placeholder={t("access.form.winrm_password.placeholder")} />
+          </Form.Item>
+        </div>
+      </div>
+
+      <Form.Item
+        name="allowInsecureConnections"
+        label={t("access.form.winrm_allow_insecure_conns.label")}
+        rules={[formRule]}
+        hidden={!fieldUseHttps}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.winrm_allow_insecure_conns.tooltip") }}></span>}
+      >
+        <Switch
+          checkedChildren={t("access.form.winrm_allow_insecure_conns.switch.on")}
+          unCheckedChildren={t("access.form.winrm_allow_insecure_conns.switch.off")}
+        />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormWinRMConfig;
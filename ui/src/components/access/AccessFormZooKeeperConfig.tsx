@@ -0,0 +1,81 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { type AccessConfigForZooKeeper } from "@/domain/access";
+
+type AccessFormZooKeeperConfigFieldValues = Nullish<AccessConfigForZooKeeper>;
+
+export type AccessFormZooKeeperConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: AccessFormZooKeeperConfigFieldValues;
+  onValuesChange?: (values: AccessFormZooKeeperConfigFieldValues) => void;
+};
+
+const initFormModel = (): AccessFormZooKeeperConfigFieldValues => {
+  return {
+    servers: "127.0.0.1:2181",
+  };
+};
+
+const AccessFormZooKeeperConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: AccessFormZooKeeperConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    servers: z
+      .string({ message: t("access.form.zookeeper_servers.placeholder") })
+      .trim()
+      .min(1, t("access.form.zookeeper_servers.placeholder")),
+    username: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    password: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="servers"
+        label={t("access.form.zookeeper_servers.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.zookeeper_servers.tooltip") }}></span>}
+      >
+        <Input placeholder={t("access.form.zookeeper_servers.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="username"
+        label={t("access.form.zookeeper_username.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("access.form.zookeeper_username.tooltip") }}></span>}
+      >
+        <Input autoComplete="new-password" placeholder={t("access.form.zookeeper_username.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="password" label={t("access.form.zookeeper_password.label")} rules={[formRule]}>
+        <Input.Password allowClear autoComplete="new-password" placeholder={t("access.form.zookeeper_password.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default AccessFormZooKeeperConfig;
@@ -6,24 +6,26 @@ import {
   CloseCircleOutlined as CloseCircleOutlinedIcon,
   DeleteOutlined as DeleteOutlinedIcon,
   PauseOutlined as PauseOutlinedIcon,
+  RocketOutlined as RocketOutlinedIcon,
   SelectOutlined as SelectOutlinedIcon,
   StopOutlined as StopOutlinedIcon,
   SyncOutlined as SyncOutlinedIcon,
 } from "@ant-design/icons";
 import { useRequest } from "ahooks";
-import { Button, Empty, Modal, Space, Table, type TableProps, Tag, Tooltip, notification } from "antd";
+import { Button, Empty, Flex, Modal, Space, Table, type TableProps, Tag, Tooltip, Typography, notification } from "antd";
 import dayjs from "dayjs";
 import { ClientResponseError } from "pocketbase";
 
-import { cancelRun as cancelWorkflowRun } from "@/api/workflows";
+import {
+  batchDeleteRuns as batchDeleteWorkflowRuns,
+  cancelRun as cancelWorkflowRun,
+  listRuns as listWorkflowRuns,
+  promoteRun as promoteWorkflowRun,
+} from "@/api/workflows";
+import CursorPagination from "@/components/CursorPagination";
 import { WORKFLOW_TRIGGERS } from "@/domain/workflow";
 import { WORKFLOW_RUN_STATUSES, type WorkflowRunModel } from "@/domain/workflowRun";
-import {
-  list as listWorkflowRuns,
-  remove as removeWorkflowRun,
-  subscribe as subscribeWorkflowRun,
-  unsubscribe as unsubscribeWorkflowRun,
-} from "@/repository/workflowRun";
+import { subscribe as subscribeWorkflowRun, unsubscribe as unsubscribeWorkflowRun } from "@/repository/workflowRun";
 import { getErrMsg } from "@/utils/error";
 import WorkflowRunDetailDrawer from "./WorkflowRunDetailDrawer";
 
@@ -45,7 +47,7 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
       align: "center",
       fixed: "left",
       width: 50,
-      render: (_, __, index) => (page - 1) * pageSize + index + 1,
+      render: (_, __, index) => (cursors.length - 1) * pageSize + index + 1,
     },
     {
       key: "id",
@@ -94,13 +96,19 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
       title: t("workflow_run.props.trigger"),
       ellipsis: true,
       render: (_, record) => {
+        let text = "";
         if (record.trigger === WORKFLOW_TRIGGERS.AUTO) {
-          return t("workflow_run.props.trigger.auto");
+          text = t("workflow_run.props.trigger.auto");
         } else if (record.trigger === WORKFLOW_TRIGGERS.MANUAL) {
-          return t("workflow_run.props.trigger.manual");
+          text = t("workflow_run.props.trigger.manual");
         }
 
-        return <></>;
+        return (
+          <Space size={4}>
+            <span>{text}</span>
+            {record.staging ? <Tag color="purple">{t("workflow_run.props.staging")}</Tag> : <></>}
+          </Space>
+        );
       },
     },
     {
@@ -131,13 +139,11 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
       key: "$action",
       align: "end",
       fixed: "right",
-      width: 120,
+      width: 160,
       render: (_, record) => {
+        const allowPromote = !!record.staging && record.status === WORKFLOW_RUN_STATUSES.SUCCEEDED;
         const allowCancel = record.status === WORKFLOW_RUN_STATUSES.PENDING || record.status === WORKFLOW_RUN_STATUSES.RUNNING;
-        const aloowDelete =
-          record.status === WORKFLOW_RUN_STATUSES.SUCCEEDED ||
-          record.status === WORKFLOW_RUN_STATUSES.FAILED ||
-          record.status === WORKFLOW_RUN_STATUSES.CANCELED;
+        const aloowDelete = isRunDeletable(record);
 
         return (
           <Space.Compact>
@@ -150,6 +156,18 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
               }
             />
 
+            <Tooltip title={t("workflow_run.action.promote")}>
+              <Button
+                color="primary"
+                disabled={!allowPromote}
+                icon={<RocketOutlinedIcon />}
+                variant="text"
+                onClick={() => {
+                  handlePromoteClick(record);
+                }}
+              />
+            </Tooltip>
+
             <Tooltip title={t("workflow_run.action.cancel")}>
               <Button
                 color="default"
@@ -180,28 +198,34 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
     },
   ];
   const [tableData, setTableData] = useState<WorkflowRunModel[]>([]);
-  const [tableTotal, setTableTotal] = useState<number>(0);
+  const [tableSelectedRowKeys, setTableSelectedRowKeys] = useState<string[]>([]);
 
-  const [page, setPage] = useState<number>(1);
+  // 列表接口基于游标分页，此处记录已访问过的各页游标以便向前翻页
+  const [cursors, setCursors] = useState<string[]>([""]);
+  const [nextCursor, setNextCursor] = useState<string>();
   const [pageSize, setPageSize] = useState<number>(10);
 
+  const resetCursors = () => {
+    setCursors([""]);
+    setTableSelectedRowKeys([]);
+  };
+
   const {
     loading,
     error: loadedError,
     run: refreshData,
   } = useRequest(
     () => {
-      return listWorkflowRuns({
-        workflowId: workflowId,
-        page: page,
-        perPage: pageSize,
+      return listWorkflowRuns(workflowId, {
+        cursor: cursors[cursors.length - 1],
+        limit: pageSize,
       });
     },
     {
-      refreshDeps: [workflowId, page, pageSize],
+      refreshDeps: [workflowId, cursors, pageSize],
       onSuccess: (res) => {
-        setTableData(res.items);
-        setTableTotal(res.totalItems);
+        setTableData(res.data.items);
+        setNextCursor(res.data.nextCursor);
       },
       onError: (err) => {
         if (err instanceof ClientResponseError && err.isAbort) {
@@ -241,6 +265,24 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
     };
   }, [tableData]);
 
+  const handlePromoteClick = (workflowRun: WorkflowRunModel) => {
+    modalApi.confirm({
+      title: t("workflow_run.action.promote"),
+      content: t("workflow_run.action.promote.confirm"),
+      onOk: async () => {
+        try {
+          const resp = await promoteWorkflowRun(workflowId, workflowRun.id);
+          if (resp) {
+            refreshData();
+          }
+        } catch (err) {
+          console.error(err);
+          notificationApi.error({ message: t("common.text.request_error"), description: getErrMsg(err) });
+        }
+      },
+    });
+  };
+
   const handleCancelClick = (workflowRun: WorkflowRunModel) => {
     modalApi.confirm({
       title: t("workflow_run.action.cancel"),
@@ -265,9 +307,10 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
       content: t("workflow_run.action.delete.confirm"),
       onOk: async () => {
         try {
-          const resp = await removeWorkflowRun(workflowRun);
+          const resp = await batchDeleteWorkflowRuns(workflowId, [workflowRun.id]);
           if (resp) {
             setTableData((prev) => prev.filter((item) => item.id !== workflowRun.id));
+            setTableSelectedRowKeys((prev) => prev.filter((key) => key !== workflowRun.id));
             refreshData();
           }
         } catch (err) {
@@ -278,12 +321,44 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
     });
   };
 
+  const handleBatchDeleteClick = () => {
+    const ids = [...tableSelectedRowKeys];
+    modalApi.confirm({
+      title: t("workflow_run.action.batch_delete"),
+      content: t("workflow_run.action.batch_delete.confirm", { count: ids.length }),
+      onOk: async () => {
+        try {
+          const resp = await batchDeleteWorkflowRuns(workflowId, ids);
+          if (resp) {
+            resetCursors();
+          }
+        } catch (err) {
+          console.error(err);
+          notificationApi.error({ message: t("common.text.request_error"), description: getErrMsg(err) });
+        }
+      },
+    });
+  };
+
   return (
     <>
       {ModelContextHolder}
       {NotificationContextHolder}
 
       <div className={className} style={style}>
+        {tableSelectedRowKeys.length > 0 ? (
+          <div className="mb-4">
+            <Flex align="center" gap="small">
+              <Typography.Text type="secondary">{t("workflow_run.selection.count", { count: tableSelectedRowKeys.length })}</Typography.Text>
+              <Button danger icon={<DeleteOutlinedIcon />} size="small" onClick={handleBatchDeleteClick}>
+                {t("workflow_run.action.batch_delete")}
+              </Button>
+            </Flex>
+          </div>
+        ) : (
+          <></>
+        )}
+
         <Table<WorkflowRunModel>
           columns={tableColumns}
           dataSource={tableData}
@@ -291,26 +366,47 @@ const WorkflowRuns = ({ className, style, workflowId }: WorkflowRunsProps) => {
           locale={{
             emptyText: <Empty image={Empty.PRESENTED_IMAGE_SIMPLE} description={loadedError ? getErrMsg(loadedError) : undefined} />,
           }}
-          pagination={{
-            current: page,
-            pageSize: pageSize,
-            total: tableTotal,
-            showSizeChanger: true,
-            onChange: (page: number, pageSize: number) => {
-              setPage(page);
-              setPageSize(pageSize);
-            },
-            onShowSizeChange: (page: number, pageSize: number) => {
-              setPage(page);
-              setPageSize(pageSize);
-            },
-          }}
+          pagination={false}
           rowKey={(record) => record.id}
+          rowSelection={{
+            selectedRowKeys: tableSelectedRowKeys,
+            getCheckboxProps: (record) => ({ disabled: !isRunDeletable(record) }),
+            onChange: (keys) => setTableSelectedRowKeys(keys as string[]),
+          }}
           scroll={{ x: "max(100%, 960px)" }}
         />
+
+        <CursorPagination
+          className="mt-4"
+          disabled={loading}
+          hasNext={!!nextCursor}
+          hasPrev={cursors.length > 1}
+          pageSize={pageSize}
+          onNext={() => {
+            setCursors((prev) => [...prev, nextCursor!]);
+            setTableSelectedRowKeys([]);
+          }}
+          onPrev={() => {
+            setCursors((prev) => prev.slice(0, -1));
+            setTableSelectedRowKeys([]);
+          }}
+          onPageSizeChange={(size) => {
+            resetCursors();
+            setPageSize(size);
+          }}
+        />
       </div>
     </>
   );
 };
 
+// 等待中或运行中的记录由调度器维护，服务端批量删除时同样会跳过
+const isRunDeletable = (record: WorkflowRunModel) => {
+  return (
+    record.status === WORKFLOW_RUN_STATUSES.SUCCEEDED ||
+    record.status === WORKFLOW_RUN_STATUSES.FAILED ||
+    record.status === WORKFLOW_RUN_STATUSES.CANCELED
+  );
+};
+
 export default WorkflowRuns;
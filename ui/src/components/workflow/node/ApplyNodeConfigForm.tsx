@@ -26,7 +26,8 @@ import AccessSelect from "@/components/access/AccessSelect";
 import ModalForm from "@/components/ModalForm";
 import MultipleInput from "@/components/MultipleInput";
 import ApplyDNSProviderSelect from "@/components/provider/ApplyDNSProviderSelect";
-import { ACCESS_USAGES, APPLY_DNS_PROVIDERS, accessProvidersMap, applyDNSProvidersMap } from "@/domain/provider";
+import Show from "@/components/Show";
+import { ACCESS_USAGES, APPLY_DNS_PROVIDERS, accessProvidersMap, applyCSRProvidersMap, applyDNSProvidersMap } from "@/domain/provider";
 import { type WorkflowNodeConfigForApply } from "@/domain/workflow";
 import { useAntdForm, useAntdFormName, useZustandShallowSelector } from "@/hooks";
 import { useAccessesStore } from "@/stores/access";
@@ -113,6 +114,12 @@ const ApplyNodeConfigForm = forwardRef<ApplyNodeConfigFormInstance, ApplyNodeCon
         .number({ message: t("workflow_node.apply.form.skip_before_expiry_days.placeholder") })
         .int(t("workflow_node.apply.form.skip_before_expiry_days.placeholder"))
         .gte(1, t("workflow_node.apply.form.skip_before_expiry_days.placeholder")),
+      csrProvider: z.string().nullish(),
+      csrProviderAccessId: z
+        .string()
+        .nullish()
+        .refine((v) => !fieldCSRProvider || !!v, t("workflow_node.apply.form.csr_provider_access.placeholder")),
+      csrProviderConfig: z.any(),
     });
     const formRule = createSchemaFieldRule(formSchema);
     const { form: formInst, formProps } = useAntdForm({
@@ -124,6 +131,7 @@ const ApplyNodeConfigForm = forwardRef<ApplyNodeConfigFormInstance, ApplyNodeCon
     const fieldProviderAccessId = Form.useWatch<string>("providerAccessId", formInst);
     const fieldDomains = Form.useWatch<string>("domains", formInst);
     const fieldNameservers = Form.useWatch<string>("nameservers", formInst);
+    const fieldCSRProvider = Form.useWatch<string>("csrProvider", formInst);
 
     const [nestedFormInst] = Form.useForm();
     const nestedFormName = useAntdFormName({ form: nestedFormInst, name: "workflowNodeApplyConfigFormProviderConfigForm" });
@@ -314,6 +322,44 @@ const ApplyNodeConfigForm = forwardRef<ApplyNodeConfigFormInstance, ApplyNodeCon
         </Divider>
 
         <Form className={className} style={style} {...formProps} disabled={disabled} layout="vertical" scrollToFirstError onValuesChange={handleFormChange}>
+          <Form.Item
+            name="csrProvider"
+            label={t("workflow_node.apply.form.csr_provider.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.apply.form.csr_provider.tooltip") }}></span>}
+          >
+            <Select
+              allowClear
+              options={Array.from(applyCSRProvidersMap.values()).map((e) => ({
+                label: t(e.name),
+                value: e.type,
+              }))}
+              placeholder={t("workflow_node.apply.form.csr_provider.placeholder")}
+              onChange={() => {
+                formInst.setFieldValue("csrProviderAccessId", undefined);
+                formInst.setFieldValue("csrProviderConfig", undefined);
+              }}
+            />
+          </Form.Item>
+
+          <Show when={!!fieldCSRProvider}>
+            <Form.Item name="csrProviderAccessId" label={t("workflow_node.apply.form.csr_provider_access.label")} rules={[formRule]}>
+              <AccessSelect
+                placeholder={t("workflow_node.apply.form.csr_provider_access.placeholder")}
+                filter={(record) => record.provider === applyCSRProvidersMap.get(fieldCSRProvider)?.provider}
+              />
+            </Form.Item>
+
+            <Form.Item
+              name={["csrProviderConfig", "enrollmentId"]}
+              label={t("workflow_node.apply.form.csr_provider_akamai_cps_enrollment_id.label")}
+              rules={[{ required: true, message: t("workflow_node.apply.form.csr_provider_akamai_cps_enrollment_id.placeholder") }]}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.apply.form.csr_provider_akamai_cps_enrollment_id.tooltip") }}></span>}
+            >
+              <InputNumber className="w-full" min={1} placeholder={t("workflow_node.apply.form.csr_provider_akamai_cps_enrollment_id.placeholder")} />
+            </Form.Item>
+          </Show>
+
           <Form.Item name="keyAlgorithm" label={t("workflow_node.apply.form.key_algorithm.label")} rules={[formRule]}>
             <Select
               options={["RSA2048", "RSA3072", "RSA4096", "RSA8192", "EC256", "EC384"].map((e) => ({
@@ -1,7 +1,7 @@
 import { forwardRef, memo, useEffect, useImperativeHandle, useMemo, useState } from "react";
 import { useTranslation } from "react-i18next";
-import { PlusOutlined as PlusOutlinedIcon, QuestionCircleOutlined as QuestionCircleOutlinedIcon } from "@ant-design/icons";
-import { Alert, Button, Divider, Flex, Form, type FormInstance, Select, Switch, Tooltip, Typography } from "antd";
+import { CloseOutlined as CloseOutlinedIcon, PlusOutlined as PlusOutlinedIcon, QuestionCircleOutlined as QuestionCircleOutlinedIcon } from "@ant-design/icons";
+import { Alert, Button, Card, Divider, Flex, Form, type FormInstance, Input, InputNumber, Select, Switch, Tooltip, Typography } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
@@ -17,11 +17,13 @@ import { useWorkflowStore } from "@/stores/workflow";
 
 import DeployNodeConfigForm1PanelConsoleConfig from "./DeployNodeConfigForm1PanelConsoleConfig";
 import DeployNodeConfigForm1PanelSiteConfig from "./DeployNodeConfigForm1PanelSiteConfig";
+import DeployNodeConfigFormAkamaiCPSConfig from "./DeployNodeConfigFormAkamaiCPSConfig";
 import DeployNodeConfigFormAliyunALBConfig from "./DeployNodeConfigFormAliyunALBConfig";
 import DeployNodeConfigFormAliyunCASDeployConfig from "./DeployNodeConfigFormAliyunCASDeployConfig";
 import DeployNodeConfigFormAliyunCDNConfig from "./DeployNodeConfigFormAliyunCDNConfig";
 import DeployNodeConfigFormAliyunCLBConfig from "./DeployNodeConfigFormAliyunCLBConfig";
 import DeployNodeConfigFormAliyunDCDNConfig from "./DeployNodeConfigFormAliyunDCDNConfig";
+import DeployNodeConfigFormAliyunDDoSConfig from "./DeployNodeConfigFormAliyunDDoSConfig";
 import DeployNodeConfigFormAliyunESAConfig from "./DeployNodeConfigFormAliyunESAConfig";
 import DeployNodeConfigFormAliyunFCConfig from "./DeployNodeConfigFormAliyunFCConfig";
 import DeployNodeConfigFormAliyunLiveConfig from "./DeployNodeConfigFormAliyunLiveConfig";
@@ -30,15 +32,34 @@ import DeployNodeConfigFormAliyunOSSConfig from "./DeployNodeConfigFormAliyunOSS
 import DeployNodeConfigFormAliyunVODConfig from "./DeployNodeConfigFormAliyunVODConfig";
 import DeployNodeConfigFormAliyunWAFConfig from "./DeployNodeConfigFormAliyunWAFConfig";
 import DeployNodeConfigFormAWSCloudFrontConfig from "./DeployNodeConfigFormAWSCloudFrontConfig";
+import DeployNodeConfigFormAWSELBConfig from "./DeployNodeConfigFormAWSELBConfig";
+import DeployNodeConfigFormBaiduCloudBLBConfig from "./DeployNodeConfigFormBaiduCloudBLBConfig";
 import DeployNodeConfigFormBaiduCloudCDNConfig from "./DeployNodeConfigFormBaiduCloudCDNConfig";
 import DeployNodeConfigFormBaishanCDNConfig from "./DeployNodeConfigFormBaishanCDNConfig";
 import DeployNodeConfigFormBaotaPanelConsoleConfig from "./DeployNodeConfigFormBaotaPanelConsoleConfig";
 import DeployNodeConfigFormBaotaPanelSiteConfig from "./DeployNodeConfigFormBaotaPanelSiteConfig";
 import DeployNodeConfigFormBytePlusCDNConfig from "./DeployNodeConfigFormBytePlusCDNConfig";
 import DeployNodeConfigFormCdnflyConfig from "./DeployNodeConfigFormCdnflyConfig";
+import DeployNodeConfigFormCiscoIOSXEConfig from "./DeployNodeConfigFormCiscoIOSXEConfig";
+import DeployNodeConfigFormCloudflareSaaSConfig from "./DeployNodeConfigFormCloudflareSaaSConfig";
+import DeployNodeConfigFormCloudflareSSLConfig from "./DeployNodeConfigFormCloudflareSSLConfig";
+import DeployNodeConfigFormConsulKVConfig from "./DeployNodeConfigFormConsulKVConfig";
+import DeployNodeConfigFormCPanelSSLConfig from "./DeployNodeConfigFormCPanelSSLConfig";
+import DeployNodeConfigFormDockerSwarmConfig from "./DeployNodeConfigFormDockerSwarmConfig";
 import DeployNodeConfigFormDogeCloudCDNConfig from "./DeployNodeConfigFormDogeCloudCDNConfig";
 import DeployNodeConfigFormEdgioApplicationsConfig from "./DeployNodeConfigFormEdgioApplicationsConfig";
+import DeployNodeConfigFormEtcdConfig from "./DeployNodeConfigFormEtcdConfig";
+import DeployNodeConfigFormF5BigIPConfig from "./DeployNodeConfigFormF5BigIPConfig";
+import DeployNodeConfigFormFastlyConfig from "./DeployNodeConfigFormFastlyConfig";
+import DeployNodeConfigFormFortinetFortiGateConfig from "./DeployNodeConfigFormFortinetFortiGateConfig";
+import DeployNodeConfigFormFTPConfig from "./DeployNodeConfigFormFTPConfig";
 import DeployNodeConfigFormGcoreCDNConfig from "./DeployNodeConfigFormGcoreCDNConfig";
+import DeployNodeConfigFormGCPCertificateManagerConfig from "./DeployNodeConfigFormGCPCertificateManagerConfig";
+import DeployNodeConfigFormGCPLoadBalancerConfig from "./DeployNodeConfigFormGCPLoadBalancerConfig";
+import DeployNodeConfigFormGitLabConfig from "./DeployNodeConfigFormGitLabConfig";
+import DeployNodeConfigFormGRPCAgentConfig from "./DeployNodeConfigFormGRPCAgentConfig";
+import DeployNodeConfigFormHAProxyConfig from "./DeployNodeConfigFormHAProxyConfig";
+import DeployNodeConfigFormHerokuConfig from "./DeployNodeConfigFormHerokuConfig";
 import DeployNodeConfigFormHuaweiCloudCDNConfig from "./DeployNodeConfigFormHuaweiCloudCDNConfig";
 import DeployNodeConfigFormHuaweiCloudELBConfig from "./DeployNodeConfigFormHuaweiCloudELBConfig";
 import DeployNodeConfigFormHuaweiCloudWAFConfig from "./DeployNodeConfigFormHuaweiCloudWAFConfig";
@@ -46,12 +67,38 @@ import DeployNodeConfigFormJDCloudALBConfig from "./DeployNodeConfigFormJDCloudA
 import DeployNodeConfigFormJDCloudCDNConfig from "./DeployNodeConfigFormJDCloudCDNConfig";
 import DeployNodeConfigFormJDCloudLiveConfig from "./DeployNodeConfigFormJDCloudLiveConfig";
 import DeployNodeConfigFormJDCloudVODConfig from "./DeployNodeConfigFormJDCloudVODConfig";
+import DeployNodeConfigFormJenkinsCredentialConfig from "./DeployNodeConfigFormJenkinsCredentialConfig";
+import DeployNodeConfigFormKempLoadMasterConfig from "./DeployNodeConfigFormKempLoadMasterConfig";
+import DeployNodeConfigFormKeyCDNConfig from "./DeployNodeConfigFormKeyCDNConfig";
+import DeployNodeConfigFormKSyunCDNConfig from "./DeployNodeConfigFormKSyunCDNConfig";
+import DeployNodeConfigFormKubernetesHarborConfig from "./DeployNodeConfigFormKubernetesHarborConfig";
+import DeployNodeConfigFormKubernetesIngressConfig from "./DeployNodeConfigFormKubernetesIngressConfig";
+import DeployNodeConfigFormKubernetesOpenShiftRouteConfig from "./DeployNodeConfigFormKubernetesOpenShiftRouteConfig";
 import DeployNodeConfigFormKubernetesSecretConfig from "./DeployNodeConfigFormKubernetesSecretConfig";
 import DeployNodeConfigFormLocalConfig from "./DeployNodeConfigFormLocalConfig";
+import DeployNodeConfigFormMikrotikConfig from "./DeployNodeConfigFormMikrotikConfig";
+import DeployNodeConfigFormNetlifySiteConfig from "./DeployNodeConfigFormNetlifySiteConfig";
+import DeployNodeConfigFormNomadVariableConfig from "./DeployNodeConfigFormNomadVariableConfig";
+import DeployNodeConfigFormOpenStackOctaviaConfig from "./DeployNodeConfigFormOpenStackOctaviaConfig";
+import DeployNodeConfigFormOPNsenseConfig from "./DeployNodeConfigFormOPNsenseConfig";
+import DeployNodeConfigFormOracleCloudCertificatesConfig from "./DeployNodeConfigFormOracleCloudCertificatesConfig";
+import DeployNodeConfigFormOracleCloudLBConfig from "./DeployNodeConfigFormOracleCloudLBConfig";
+import DeployNodeConfigFormOVHcloudIPLBConfig from "./DeployNodeConfigFormOVHcloudIPLBConfig";
+import DeployNodeConfigFormOVHcloudWebHostingConfig from "./DeployNodeConfigFormOVHcloudWebHostingConfig";
+import DeployNodeConfigFormPfSenseConfig from "./DeployNodeConfigFormPfSenseConfig";
+import DeployNodeConfigFormPleskConfig from "./DeployNodeConfigFormPleskConfig";
 import DeployNodeConfigFormQiniuCDNConfig from "./DeployNodeConfigFormQiniuCDNConfig";
+import DeployNodeConfigFormQiniuKodoConfig from "./DeployNodeConfigFormQiniuKodoConfig";
 import DeployNodeConfigFormQiniuPiliConfig from "./DeployNodeConfigFormQiniuPiliConfig";
+import DeployNodeConfigFormRancherHarvesterConfig from "./DeployNodeConfigFormRancherHarvesterConfig";
+import DeployNodeConfigFormRancherSecretConfig from "./DeployNodeConfigFormRancherSecretConfig";
 import DeployNodeConfigFormSafeLineConfig from "./DeployNodeConfigFormSafeLineConfig";
+import DeployNodeConfigFormSophosFirewallConfig from "./DeployNodeConfigFormSophosFirewallConfig";
 import DeployNodeConfigFormSSHConfig from "./DeployNodeConfigFormSSHConfig.tsx";
+import DeployNodeConfigFormSSHHarborConfig from "./DeployNodeConfigFormSSHHarborConfig";
+import DeployNodeConfigFormSSHMailServerConfig from "./DeployNodeConfigFormSSHMailServerConfig";
+import DeployNodeConfigFormSSHMinIOConfig from "./DeployNodeConfigFormSSHMinIOConfig";
+import DeployNodeConfigFormTencentCloudAPIGatewayConfig from "./DeployNodeConfigFormTencentCloudAPIGatewayConfig.tsx";
 import DeployNodeConfigFormTencentCloudCDNConfig from "./DeployNodeConfigFormTencentCloudCDNConfig.tsx";
 import DeployNodeConfigFormTencentCloudCLBConfig from "./DeployNodeConfigFormTencentCloudCLBConfig.tsx";
 import DeployNodeConfigFormTencentCloudCOSConfig from "./DeployNodeConfigFormTencentCloudCOSConfig.tsx";
@@ -62,8 +109,12 @@ import DeployNodeConfigFormTencentCloudSCFConfig from "./DeployNodeConfigFormTen
 import DeployNodeConfigFormTencentCloudSSLDeployConfig from "./DeployNodeConfigFormTencentCloudSSLDeployConfig";
 import DeployNodeConfigFormTencentCloudVODConfig from "./DeployNodeConfigFormTencentCloudVODConfig";
 import DeployNodeConfigFormTencentCloudWAFConfig from "./DeployNodeConfigFormTencentCloudWAFConfig";
+import DeployNodeConfigFormTrueNASConfig from "./DeployNodeConfigFormTrueNASConfig";
 import DeployNodeConfigFormUCloudUCDNConfig from "./DeployNodeConfigFormUCloudUCDNConfig.tsx";
 import DeployNodeConfigFormUCloudUS3Config from "./DeployNodeConfigFormUCloudUS3Config.tsx";
+import DeployNodeConfigFormUpyunCDNConfig from "./DeployNodeConfigFormUpyunCDNConfig.tsx";
+import DeployNodeConfigFormVaultConfig from "./DeployNodeConfigFormVaultConfig.tsx";
+import DeployNodeConfigFormVercelProjectConfig from "./DeployNodeConfigFormVercelProjectConfig";
 import DeployNodeConfigFormVolcEngineCDNConfig from "./DeployNodeConfigFormVolcEngineCDNConfig.tsx";
 import DeployNodeConfigFormVolcEngineCLBConfig from "./DeployNodeConfigFormVolcEngineCLBConfig.tsx";
 import DeployNodeConfigFormVolcEngineDCDNConfig from "./DeployNodeConfigFormVolcEngineDCDNConfig.tsx";
@@ -71,6 +122,10 @@ import DeployNodeConfigFormVolcEngineImageXConfig from "./DeployNodeConfigFormVo
 import DeployNodeConfigFormVolcEngineLiveConfig from "./DeployNodeConfigFormVolcEngineLiveConfig.tsx";
 import DeployNodeConfigFormVolcEngineTOSConfig from "./DeployNodeConfigFormVolcEngineTOSConfig.tsx";
 import DeployNodeConfigFormWebhookConfig from "./DeployNodeConfigFormWebhookConfig.tsx";
+import DeployNodeConfigFormWHMServiceConfig from "./DeployNodeConfigFormWHMServiceConfig";
+import DeployNodeConfigFormWinRMCertStoreConfig from "./DeployNodeConfigFormWinRMCertStoreConfig";
+import DeployNodeConfigFormWinRMIISConfig from "./DeployNodeConfigFormWinRMIISConfig";
+import DeployNodeConfigFormZooKeeperConfig from "./DeployNodeConfigFormZooKeeperConfig";
 
 type DeployNodeConfigFormFieldValues = Partial<WorkflowNodeConfigForDeploy>;
 
@@ -118,6 +173,57 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
         .nonempty(t("workflow_node.deploy.form.provider_access.placeholder")),
       providerConfig: z.any(),
       skipOnLastSucceeded: z.boolean().nullish(),
+      dryRun: z.boolean().nullish(),
+      cleanupCertificates: z.boolean().nullish(),
+      skipOnUnchanged: z.boolean().nullish(),
+      unchangedCheckAddr: z
+        .string()
+        .max(256, t("common.errmsg.string_max", { max: 256 }))
+        .nullish(),
+      retryCount: z
+        .number()
+        .int(t("workflow_node.deploy.form.retry_count.placeholder"))
+        .min(0, t("workflow_node.deploy.form.retry_count.placeholder"))
+        .max(10, t("workflow_node.deploy.form.retry_count.placeholder"))
+        .nullish(),
+      retryInterval: z
+        .number()
+        .int(t("workflow_node.deploy.form.retry_interval.placeholder"))
+        .min(1, t("workflow_node.deploy.form.retry_interval.placeholder"))
+        .max(300, t("workflow_node.deploy.form.retry_interval.placeholder"))
+        .nullish(),
+      batchTargets: z
+        .array(
+          z.object({
+            name: z
+              .string()
+              .max(64, t("common.errmsg.string_max", { max: 64 }))
+              .nullish(),
+            providerConfig: z
+              .string()
+              .nullish()
+              .refine(
+                (v) => {
+                  if (!v) return true;
+
+                  try {
+                    const obj = JSON.parse(v);
+                    return typeof obj === "object" && obj !== null && !Array.isArray(obj);
+                  } catch {
+                    return false;
+                  }
+                },
+                { message: t("workflow_node.deploy.form.batch_targets.provider_config.errmsg.json_invalid") }
+              ),
+          })
+        )
+        .nullish(),
+      batchConcurrency: z
+        .number()
+        .int(t("workflow_node.deploy.form.batch_concurrency.placeholder"))
+        .min(1, t("workflow_node.deploy.form.batch_concurrency.placeholder"))
+        .max(32, t("workflow_node.deploy.form.batch_concurrency.placeholder"))
+        .nullish(),
     });
     const formRule = createSchemaFieldRule(formSchema);
     const { form: formInst, formProps } = useAntdForm({
@@ -126,6 +232,9 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
     });
 
     const fieldProvider = Form.useWatch("provider", { form: formInst, preserve: true });
+    const fieldSkipOnUnchanged = Form.useWatch("skipOnUnchanged", { form: formInst, preserve: true });
+    const fieldRetryCount = Form.useWatch("retryCount", { form: formInst, preserve: true });
+    const fieldBatchTargets = Form.useWatch("batchTargets", { form: formInst, preserve: true });
 
     const [nestedFormInst] = Form.useForm();
     const nestedFormName = useAntdFormName({ form: nestedFormInst, name: "workflowNodeDeployConfigFormProviderConfigForm" });
@@ -146,6 +255,8 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigForm1PanelConsoleConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS["1PANEL_SITE"]:
           return <DeployNodeConfigForm1PanelSiteConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.AKAMAI_CPS:
+          return <DeployNodeConfigFormAkamaiCPSConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.ALIYUN_ALB:
           return <DeployNodeConfigFormAliyunALBConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.ALIYUN_CAS_DEPLOY:
@@ -156,6 +267,8 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormAliyunCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.ALIYUN_DCDN:
           return <DeployNodeConfigFormAliyunDCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.ALIYUN_DDOS:
+          return <DeployNodeConfigFormAliyunDDoSConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.ALIYUN_ESA:
           return <DeployNodeConfigFormAliyunESAConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.ALIYUN_FC:
@@ -172,6 +285,10 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormAliyunWAFConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.AWS_CLOUDFRONT:
           return <DeployNodeConfigFormAWSCloudFrontConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.AWS_ELB:
+          return <DeployNodeConfigFormAWSELBConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.BAIDUCLOUD_BLB:
+          return <DeployNodeConfigFormBaiduCloudBLBConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.BAIDUCLOUD_CDN:
           return <DeployNodeConfigFormBaiduCloudCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.BAISHAN_CDN:
@@ -184,12 +301,46 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormBytePlusCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.CDNFLY:
           return <DeployNodeConfigFormCdnflyConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.CISCO_IOSXE:
+          return <DeployNodeConfigFormCiscoIOSXEConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.CLOUDFLARE_SAAS:
+          return <DeployNodeConfigFormCloudflareSaaSConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.CLOUDFLARE_SSL:
+          return <DeployNodeConfigFormCloudflareSSLConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.CONSUL_KV:
+          return <DeployNodeConfigFormConsulKVConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.CPANEL_SSL:
+          return <DeployNodeConfigFormCPanelSSLConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.DOCKER_SWARM:
+          return <DeployNodeConfigFormDockerSwarmConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.DOGECLOUD_CDN:
           return <DeployNodeConfigFormDogeCloudCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.EDGIO_APPLICATIONS:
           return <DeployNodeConfigFormEdgioApplicationsConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.ETCD:
+          return <DeployNodeConfigFormEtcdConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.F5_BIGIP:
+          return <DeployNodeConfigFormF5BigIPConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.FASTLY:
+          return <DeployNodeConfigFormFastlyConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.FORTINET_FORTIGATE:
+          return <DeployNodeConfigFormFortinetFortiGateConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.FTP:
+          return <DeployNodeConfigFormFTPConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.GCORE_CDN:
           return <DeployNodeConfigFormGcoreCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.GCP_CERTIFICATEMANAGER:
+          return <DeployNodeConfigFormGCPCertificateManagerConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.GCP_LOADBALANCER:
+          return <DeployNodeConfigFormGCPLoadBalancerConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.GITLAB:
+          return <DeployNodeConfigFormGitLabConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.GRPC_AGENT:
+          return <DeployNodeConfigFormGRPCAgentConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.HAPROXY:
+          return <DeployNodeConfigFormHAProxyConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.HEROKU:
+          return <DeployNodeConfigFormHerokuConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.HUAWEICLOUD_CDN:
           return <DeployNodeConfigFormHuaweiCloudCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.HUAWEICLOUD_ELB:
@@ -204,18 +355,70 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormJDCloudLiveConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.JDCLOUD_VOD:
           return <DeployNodeConfigFormJDCloudVODConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.JENKINS_CREDENTIAL:
+          return <DeployNodeConfigFormJenkinsCredentialConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KEMP_LOADMASTER:
+          return <DeployNodeConfigFormKempLoadMasterConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KEYCDN:
+          return <DeployNodeConfigFormKeyCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KSYUN_CDN:
+          return <DeployNodeConfigFormKSyunCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KUBERNETES_HARBOR:
+          return <DeployNodeConfigFormKubernetesHarborConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KUBERNETES_INGRESS:
+          return <DeployNodeConfigFormKubernetesIngressConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.KUBERNETES_OPENSHIFT_ROUTE:
+          return <DeployNodeConfigFormKubernetesOpenShiftRouteConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.KUBERNETES_SECRET:
           return <DeployNodeConfigFormKubernetesSecretConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.LOCAL:
           return <DeployNodeConfigFormLocalConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.MIKROTIK:
+          return <DeployNodeConfigFormMikrotikConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.NETLIFY_SITE:
+          return <DeployNodeConfigFormNetlifySiteConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.NOMAD_VARIABLE:
+          return <DeployNodeConfigFormNomadVariableConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.OPENSTACK_OCTAVIA:
+          return <DeployNodeConfigFormOpenStackOctaviaConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.OPNSENSE:
+          return <DeployNodeConfigFormOPNsenseConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.ORACLECLOUD_CERTIFICATES:
+          return <DeployNodeConfigFormOracleCloudCertificatesConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.ORACLECLOUD_LB:
+          return <DeployNodeConfigFormOracleCloudLBConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.OVHCLOUD_IPLB:
+          return <DeployNodeConfigFormOVHcloudIPLBConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.OVHCLOUD_WEBHOSTING:
+          return <DeployNodeConfigFormOVHcloudWebHostingConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.PFSENSE:
+          return <DeployNodeConfigFormPfSenseConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.PLESK:
+          return <DeployNodeConfigFormPleskConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.QINIU_CDN:
           return <DeployNodeConfigFormQiniuCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.QINIU_KODO:
+          return <DeployNodeConfigFormQiniuKodoConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.QINIU_PILI:
           return <DeployNodeConfigFormQiniuPiliConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.RANCHER_HARVESTER:
+          return <DeployNodeConfigFormRancherHarvesterConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.RANCHER_SECRET:
+          return <DeployNodeConfigFormRancherSecretConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.SAFELINE:
           return <DeployNodeConfigFormSafeLineConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.SOPHOS_FIREWALL:
+          return <DeployNodeConfigFormSophosFirewallConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.SSH:
           return <DeployNodeConfigFormSSHConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.SSH_HARBOR:
+          return <DeployNodeConfigFormSSHHarborConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.SSH_MAILSERVER:
+          return <DeployNodeConfigFormSSHMailServerConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.SSH_MINIO:
+          return <DeployNodeConfigFormSSHMinIOConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.TENCENTCLOUD_APIGATEWAY:
+          return <DeployNodeConfigFormTencentCloudAPIGatewayConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.TENCENTCLOUD_CDN:
           return <DeployNodeConfigFormTencentCloudCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.TENCENTCLOUD_CLB:
@@ -236,10 +439,18 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormTencentCloudVODConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.TENCENTCLOUD_WAF:
           return <DeployNodeConfigFormTencentCloudWAFConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.TRUENAS:
+          return <DeployNodeConfigFormTrueNASConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.UCLOUD_UCDN:
           return <DeployNodeConfigFormUCloudUCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.UCLOUD_US3:
           return <DeployNodeConfigFormUCloudUS3Config {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.UPYUN_CDN:
+          return <DeployNodeConfigFormUpyunCDNConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.VAULT:
+          return <DeployNodeConfigFormVaultConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.VERCEL_PROJECT:
+          return <DeployNodeConfigFormVercelProjectConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.VOLCENGINE_CDN:
           return <DeployNodeConfigFormVolcEngineCDNConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.VOLCENGINE_CLB:
@@ -254,6 +465,14 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
           return <DeployNodeConfigFormVolcEngineTOSConfig {...nestedFormProps} />;
         case DEPLOY_PROVIDERS.WEBHOOK:
           return <DeployNodeConfigFormWebhookConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.WHM_SERVICE:
+          return <DeployNodeConfigFormWHMServiceConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.WINRM_CERTSTORE:
+          return <DeployNodeConfigFormWinRMCertStoreConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.WINRM_IIS:
+          return <DeployNodeConfigFormWinRMIISConfig {...nestedFormProps} />;
+        case DEPLOY_PROVIDERS.ZOOKEEPER:
+          return <DeployNodeConfigFormZooKeeperConfig {...nestedFormProps} />;
       }
     }, [disabled, initialValues?.providerConfig, fieldProvider, nestedFormInst, nestedFormName]);
 
@@ -272,7 +491,7 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
         const oldValues = formInst.getFieldsValue();
         const newValues: Record<string, unknown> = {};
         for (const key in oldValues) {
-          if (key === "provider" || key === "providerAccessId" || key === "certificate" || key === "skipOnLastSucceeded") {
+          if (key === "provider" || key === "providerAccessId" || key === "certificate" || key === "skipOnLastSucceeded" || key === "dryRun" || key === "cleanupCertificates" || key === "skipOnUnchanged" || key === "unchangedCheckAddr" || key === "retryCount" || key === "retryInterval" || key === "batchConcurrency") {
             newValues[key] = oldValues[key];
           } else {
             newValues[key] = undefined;
@@ -446,6 +665,118 @@ const DeployNodeConfigForm = forwardRef<DeployNodeConfigFormInstance, DeployNode
                 <div>{t("workflow_node.deploy.form.skip_on_last_succeeded.suffix")}</div>
               </Flex>
             </Form.Item>
+
+            <Form.Item
+              name="skipOnUnchanged"
+              label={t("workflow_node.deploy.form.skip_on_unchanged.label")}
+              rules={[formRule]}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.skip_on_unchanged.tooltip") }}></span>}
+            >
+              <Switch />
+            </Form.Item>
+
+            <Show when={!!fieldSkipOnUnchanged}>
+              <Form.Item
+                name="unchangedCheckAddr"
+                label={t("workflow_node.deploy.form.unchanged_check_addr.label")}
+                rules={[formRule]}
+                tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.unchanged_check_addr.tooltip") }}></span>}
+              >
+                <Input placeholder={t("workflow_node.deploy.form.unchanged_check_addr.placeholder")} />
+              </Form.Item>
+            </Show>
+
+            <Form.Item
+              name="dryRun"
+              label={t("workflow_node.deploy.form.dry_run.label")}
+              rules={[formRule]}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.dry_run.tooltip") }}></span>}
+            >
+              <Switch />
+            </Form.Item>
+
+            <Form.Item
+              name="cleanupCertificates"
+              label={t("workflow_node.deploy.form.cleanup_certificates.label")}
+              rules={[formRule]}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cleanup_certificates.tooltip") }}></span>}
+            >
+              <Switch />
+            </Form.Item>
+
+            <Form.Item
+              name="retryCount"
+              label={t("workflow_node.deploy.form.retry_count.label")}
+              rules={[formRule]}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.retry_count.tooltip") }}></span>}
+            >
+              <InputNumber className="w-full" min={0} max={10} placeholder={t("workflow_node.deploy.form.retry_count.placeholder")} />
+            </Form.Item>
+
+            <Show when={!!fieldRetryCount}>
+              <Form.Item
+                name="retryInterval"
+                label={t("workflow_node.deploy.form.retry_interval.label")}
+                rules={[formRule]}
+                tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.retry_interval.tooltip") }}></span>}
+              >
+                <InputNumber
+                  className="w-full"
+                  min={1}
+                  max={300}
+                  placeholder={t("workflow_node.deploy.form.retry_interval.placeholder")}
+                  addonAfter={t("workflow_node.deploy.form.retry_interval.unit")}
+                />
+              </Form.Item>
+            </Show>
+
+            <Form.Item
+              label={t("workflow_node.deploy.form.batch_targets.label")}
+              tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.batch_targets.tooltip") }}></span>}
+            >
+              <Form.List name="batchTargets">
+                {(fields, { add, remove }) => (
+                  <div className="flex flex-col gap-2">
+                    {fields.map((field) => (
+                      <Card
+                        key={field.key}
+                        size="small"
+                        title={t("workflow_node.deploy.form.batch_targets.item.title", { index: field.name + 1 })}
+                        extra={<Button icon={<CloseOutlinedIcon />} size="small" type="text" onClick={() => remove(field.name)} />}
+                      >
+                        <Form.Item name={[field.name, "name"]} label={t("workflow_node.deploy.form.batch_targets.name.label")} rules={[formRule]}>
+                          <Input placeholder={t("workflow_node.deploy.form.batch_targets.name.placeholder")} />
+                        </Form.Item>
+
+                        <Form.Item
+                          className="mb-0"
+                          name={[field.name, "providerConfig"]}
+                          label={t("workflow_node.deploy.form.batch_targets.provider_config.label")}
+                          rules={[formRule]}
+                          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.batch_targets.provider_config.tooltip") }}></span>}
+                        >
+                          <Input.TextArea autoSize={{ minRows: 3, maxRows: 10 }} placeholder={t("workflow_node.deploy.form.batch_targets.provider_config.placeholder")} />
+                        </Form.Item>
+                      </Card>
+                    ))}
+                    <Button block icon={<PlusOutlinedIcon />} type="dashed" onClick={() => add({ name: "", providerConfig: "{}" })}>
+                      {t("workflow_node.deploy.form.batch_targets.button")}
+                    </Button>
+                  </div>
+                )}
+              </Form.List>
+            </Form.Item>
+
+            <Show when={!!fieldBatchTargets?.length}>
+              <Form.Item
+                name="batchConcurrency"
+                label={t("workflow_node.deploy.form.batch_concurrency.label")}
+                rules={[formRule]}
+                tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.batch_concurrency.tooltip") }}></span>}
+              >
+                <InputNumber className="w-full" min={1} max={32} placeholder={t("workflow_node.deploy.form.batch_concurrency.placeholder")} />
+              </Form.Item>
+            </Show>
           </Form>
         </Show>
       </Form.Provider>
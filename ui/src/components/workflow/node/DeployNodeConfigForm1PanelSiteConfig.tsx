@@ -3,8 +3,11 @@ import { Form, type FormInstance, Input } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import { validDomainName } from "@/utils/validators";
+
 type DeployNodeConfigForm1PanelSiteConfigFieldValues = Nullish<{
-  websiteId: string | number;
+  websiteId?: string | number;
+  websiteDomain?: string;
 }>;
 
 export type DeployNodeConfigForm1PanelSiteConfigProps = {
@@ -28,10 +31,20 @@ const DeployNodeConfigForm1PanelSiteConfig = ({
 }: DeployNodeConfigForm1PanelSiteConfigProps) => {
   const { t } = useTranslation();
 
+  const fieldWebsiteDomain = Form.useWatch("websiteDomain", formInst);
+
   const formSchema = z.object({
-    websiteId: z.union([z.string(), z.number()]).refine((v) => {
-      return /^\d+$/.test(v + "") && +v > 0;
-    }, t("workflow_node.deploy.form.1panel_site_website_id.placeholder")),
+    websiteId: z
+      .union([z.string(), z.number()])
+      .nullish()
+      .refine((v) => {
+        if (!v && fieldWebsiteDomain) return true;
+        return /^\d+$/.test(v + "") && +v! > 0;
+      }, t("workflow_node.deploy.form.1panel_site_website_id.placeholder")),
+    websiteDomain: z
+      .string()
+      .nullish()
+      .refine((v) => !v || validDomainName(v), t("common.errmsg.domain_invalid")),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -56,6 +69,15 @@ const DeployNodeConfigForm1PanelSiteConfig = ({
       >
         <Input type="number" placeholder={t("workflow_node.deploy.form.1panel_site_website_id.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        name="websiteDomain"
+        label={t("workflow_node.deploy.form.1panel_site_website_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.1panel_site_website_domain.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.1panel_site_website_domain.placeholder")} />
+      </Form.Item>
     </Form>
   );
 };
@@ -0,0 +1,105 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormAWSELBConfigFieldValues = Nullish<{
+  region: string;
+  certificateSource: string;
+  listenerArn: string;
+  keepOldCertificate?: boolean;
+}>;
+
+export type DeployNodeConfigFormAWSELBConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormAWSELBConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormAWSELBConfigFieldValues) => void;
+};
+
+const CERTIFICATE_SOURCE_ACM = "acm" as const;
+const CERTIFICATE_SOURCE_IAM = "iam" as const;
+
+const initFormModel = (): DeployNodeConfigFormAWSELBConfigFieldValues => {
+  return {
+    certificateSource: CERTIFICATE_SOURCE_ACM,
+    keepOldCertificate: false,
+  };
+};
+
+const DeployNodeConfigFormAWSELBConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormAWSELBConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z
+      .string({ message: t("workflow_node.deploy.form.aws_elb_region.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.aws_elb_region.placeholder"))
+      .trim(),
+    certificateSource: z.union([z.literal(CERTIFICATE_SOURCE_ACM), z.literal(CERTIFICATE_SOURCE_IAM)], {
+      message: t("workflow_node.deploy.form.aws_elb_certificate_source.placeholder"),
+    }),
+    listenerArn: z
+      .string({ message: t("workflow_node.deploy.form.aws_elb_listener_arn.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.aws_elb_listener_arn.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    keepOldCertificate: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.aws_elb_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aws_elb_region.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.aws_elb_region.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="certificateSource" label={t("workflow_node.deploy.form.aws_elb_certificate_source.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.aws_elb_certificate_source.placeholder")}>
+          <Select.Option key={CERTIFICATE_SOURCE_ACM} value={CERTIFICATE_SOURCE_ACM}>
+            {t("workflow_node.deploy.form.aws_elb_certificate_source.option.acm.label")}
+          </Select.Option>
+          <Select.Option key={CERTIFICATE_SOURCE_IAM} value={CERTIFICATE_SOURCE_IAM}>
+            {t("workflow_node.deploy.form.aws_elb_certificate_source.option.iam.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="listenerArn"
+        label={t("workflow_node.deploy.form.aws_elb_listener_arn.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aws_elb_listener_arn.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.aws_elb_listener_arn.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keepOldCertificate"
+        label={t("workflow_node.deploy.form.aws_elb_keep_old_certificate.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aws_elb_keep_old_certificate.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormAWSELBConfig;
@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormAkamaiCPSConfigFieldValues = Nullish<{
+  enrollmentId: string | number;
+  acknowledgePostVerificationWarnings?: boolean;
+  acknowledgeChangeManagement?: boolean;
+}>;
+
+export type DeployNodeConfigFormAkamaiCPSConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormAkamaiCPSConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormAkamaiCPSConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormAkamaiCPSConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormAkamaiCPSConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormAkamaiCPSConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    enrollmentId: z
+      .union([z.string(), z.number()])
+      .refine((v) => /^\d+$/.test(v + "") && +v > 0, t("workflow_node.deploy.form.akamai_cps_enrollment_id.placeholder")),
+    acknowledgePostVerificationWarnings: z.boolean().nullish(),
+    acknowledgeChangeManagement: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="enrollmentId"
+        label={t("workflow_node.deploy.form.akamai_cps_enrollment_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.akamai_cps_enrollment_id.tooltip") }}></span>}
+      >
+        <Input type="number" placeholder={t("workflow_node.deploy.form.akamai_cps_enrollment_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="acknowledgePostVerificationWarnings"
+        label={t("workflow_node.deploy.form.akamai_cps_acknowledge_post_verification_warnings.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.akamai_cps_acknowledge_post_verification_warnings.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="acknowledgeChangeManagement"
+        label={t("workflow_node.deploy.form.akamai_cps_acknowledge_change_management.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.akamai_cps_acknowledge_change_management.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormAkamaiCPSConfig;
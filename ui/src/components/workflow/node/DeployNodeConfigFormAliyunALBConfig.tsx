@@ -12,6 +12,8 @@ type DeployNodeConfigFormAliyunALBConfigFieldValues = Nullish<{
   loadbalancerId?: string;
   listenerId?: string;
   domain?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunALBConfigProps = {
@@ -65,6 +67,11 @@ const DeployNodeConfigFormAliyunALBConfig = ({
         if (![RESOURCE_TYPE_LOADBALANCER, RESOURCE_TYPE_LISTENER].includes(fieldResourceType)) return true;
         return !v || validDomainName(v!, { allowWildcard: true });
       }, t("common.errmsg.domain_invalid")),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -135,6 +142,24 @@ const DeployNodeConfigFormAliyunALBConfig = ({
           <Input placeholder={t("workflow_node.deploy.form.aliyun_alb_snidomain.placeholder")} />
         </Form.Item>
       </Show>
+
+      <Form.Item
+        name="resourceGroupId"
+        label={t("workflow_node.deploy.form.aliyun_alb_resource_group_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_alb_resource_group_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_alb_resource_group_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certNameTemplate"
+        label={t("workflow_node.deploy.form.aliyun_alb_cert_name_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_alb_cert_name_template.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_alb_cert_name_template.placeholder")} />
+      </Form.Item>
     </Form>
   );
 };
@@ -13,6 +13,8 @@ type DeployNodeConfigFormAliyunCASDeployConfigFieldValues = Nullish<{
   region: string;
   resourceIds: string;
   contactIds?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunCASDeployConfigProps = {
@@ -58,6 +60,11 @@ const DeployNodeConfigFormAliyunCASDeployConfig = ({
           .split(MULTIPLE_INPUT_DELIMITER)
           .every((e) => /^[1-9]\d*$/.test(e));
       }, t("workflow_node.deploy.form.aliyun_cas_deploy_contact_ids.errmsg.invalid")),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -146,6 +153,24 @@ const DeployNodeConfigFormAliyunCASDeployConfig = ({
         </Space.Compact>
       </Form.Item>
 
+      <Form.Item
+        name="resourceGroupId"
+        label={t("workflow_node.deploy.form.aliyun_cas_deploy_resource_group_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_cas_deploy_resource_group_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_cas_deploy_resource_group_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certNameTemplate"
+        label={t("workflow_node.deploy.form.aliyun_cas_deploy_cert_name_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_cas_deploy_cert_name_template.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_cas_deploy_cert_name_template.placeholder")} />
+      </Form.Item>
+
       <Form.Item>
         <Alert type="info" message={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_cas_deploy.guide") }}></span>} />
       </Form.Item>
@@ -1,12 +1,13 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Switch } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
 import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormAliyunCDNConfigFieldValues = Nullish<{
-  domain: string;
+  domain?: string;
+  matchCoveredDomains?: boolean;
 }>;
 
 export type DeployNodeConfigFormAliyunCDNConfigProps = {
@@ -32,11 +33,16 @@ const DeployNodeConfigFormAliyunCDNConfig = ({
 
   const formSchema = z.object({
     domain: z
-      .string({ message: t("workflow_node.deploy.form.aliyun_cdn_domain.placeholder") })
-      .refine((v) => validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+      .string()
+      .nullish()
+      .refine((v) => !!v || !!fieldMatchCoveredDomains, t("workflow_node.deploy.form.aliyun_cdn_domain.placeholder"))
+      .refine((v) => !v || validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    matchCoveredDomains: z.boolean().nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldMatchCoveredDomains = Form.useWatch<boolean>("matchCoveredDomains", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -58,6 +64,15 @@ const DeployNodeConfigFormAliyunCDNConfig = ({
       >
         <Input placeholder={t("workflow_node.deploy.form.aliyun_cdn_domain.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        name="matchCoveredDomains"
+        label={t("workflow_node.deploy.form.aliyun_cdn_match_covered_domains.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_cdn_match_covered_domains.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
     </Form>
   );
 };
@@ -1,12 +1,24 @@
+import { memo } from "react";
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { FormOutlined as FormOutlinedIcon } from "@ant-design/icons";
+import { Button, Form, type FormInstance, Input, Select, Space, Switch } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import ModalForm from "@/components/ModalForm";
+import MultipleInput from "@/components/MultipleInput";
+import Show from "@/components/Show";
+import { useAntdForm } from "@/hooks";
 import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormAliyunDCDNConfigFieldValues = Nullish<{
-  domain: string;
+  domain?: string;
+  domains?: string;
+  matchCoveredDomains?: boolean;
+  certMode?: string;
+  casRegion?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunDCDNConfigProps = {
@@ -17,8 +29,15 @@ export type DeployNodeConfigFormAliyunDCDNConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormAliyunDCDNConfigFieldValues) => void;
 };
 
+const CERT_MODE_UPLOAD = "upload" as const;
+const CERT_MODE_CAS = "cas" as const;
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
 const initFormModel = (): DeployNodeConfigFormAliyunDCDNConfigFieldValues => {
-  return {};
+  return {
+    certMode: CERT_MODE_UPLOAD,
+  };
 };
 
 const DeployNodeConfigFormAliyunDCDNConfig = ({
@@ -32,11 +51,34 @@ const DeployNodeConfigFormAliyunDCDNConfig = ({
 
   const formSchema = z.object({
     domain: z
-      .string({ message: t("workflow_node.deploy.form.aliyun_dcdn_domain.placeholder") })
-      .refine((v) => validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+      .string()
+      .nullish()
+      .refine((v) => !!v || !!fieldDomains || !!fieldMatchCoveredDomains, t("workflow_node.deploy.form.aliyun_dcdn_domain.placeholder"))
+      .refine((v) => !v || validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    domains: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        if (!v) return true;
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => validDomainName(e, { allowWildcard: true }));
+      }, t("common.errmsg.domain_invalid")),
+    matchCoveredDomains: z.boolean().nullish(),
+    certMode: z.enum([CERT_MODE_UPLOAD, CERT_MODE_CAS]).nullish(),
+    casRegion: z.string().nullish(),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldDomains = Form.useWatch<string>("domains", formInst);
+  const fieldMatchCoveredDomains = Form.useWatch<boolean>("matchCoveredDomains", formInst);
+  const fieldCertMode = Form.useWatch<string>("certMode", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -58,8 +100,134 @@ const DeployNodeConfigFormAliyunDCDNConfig = ({
       >
         <Input placeholder={t("workflow_node.deploy.form.aliyun_dcdn_domain.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        label={t("workflow_node.deploy.form.aliyun_dcdn_domains.label")}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_domains.tooltip") }}></span>}
+      >
+        <Space.Compact style={{ width: "100%" }}>
+          <Form.Item name="domains" noStyle rules={[formRule]}>
+            <Input
+              allowClear
+              disabled={disabled}
+              value={fieldDomains}
+              placeholder={t("workflow_node.deploy.form.aliyun_dcdn_domains.placeholder")}
+              onChange={(e) => {
+                formInst.setFieldValue("domains", e.target.value);
+              }}
+            />
+          </Form.Item>
+          <DomainsModalInput
+            value={fieldDomains}
+            trigger={
+              <Button disabled={disabled}>
+                <FormOutlinedIcon />
+              </Button>
+            }
+            onChange={(value) => {
+              formInst.setFieldValue("domains", value);
+            }}
+          />
+        </Space.Compact>
+      </Form.Item>
+
+      <Form.Item
+        name="matchCoveredDomains"
+        label={t("workflow_node.deploy.form.aliyun_dcdn_match_covered_domains.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_match_covered_domains.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="certMode"
+        label={t("workflow_node.deploy.form.aliyun_dcdn_cert_mode.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_cert_mode.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.aliyun_dcdn_cert_mode.placeholder")}>
+          <Select.Option key={CERT_MODE_UPLOAD} value={CERT_MODE_UPLOAD}>
+            {t("workflow_node.deploy.form.aliyun_dcdn_cert_mode.option.upload.label")}
+          </Select.Option>
+          <Select.Option key={CERT_MODE_CAS} value={CERT_MODE_CAS}>
+            {t("workflow_node.deploy.form.aliyun_dcdn_cert_mode.option.cas.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCertMode === CERT_MODE_CAS}>
+        <Form.Item
+          name="casRegion"
+          label={t("workflow_node.deploy.form.aliyun_dcdn_cas_region.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_cas_region.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.aliyun_dcdn_cas_region.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldCertMode === CERT_MODE_CAS}>
+        <Form.Item
+          name="resourceGroupId"
+          label={t("workflow_node.deploy.form.aliyun_dcdn_resource_group_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_resource_group_id.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_dcdn_resource_group_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="certNameTemplate"
+          label={t("workflow_node.deploy.form.aliyun_dcdn_cert_name_template.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_dcdn_cert_name_template.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_dcdn_cert_name_template.placeholder")} />
+        </Form.Item>
+      </Show>
     </Form>
   );
 };
 
+const DomainsModalInput = memo(({ value, trigger, onChange }: { value?: string; trigger?: React.ReactNode; onChange?: (value: string) => void }) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    domains: z.array(z.string()).refine((v) => {
+      return v.every((e) => !e?.trim() || validDomainName(e.trim(), { allowWildcard: true }));
+    }, t("common.errmsg.domain_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+  const { form: formInst, formProps } = useAntdForm({
+    name: "workflowNodeDeployConfigFormAliyunDCDNDomainsModalInput",
+    initialValues: { domains: value?.split(MULTIPLE_INPUT_DELIMITER) },
+    onSubmit: (values) => {
+      onChange?.(
+        values.domains
+          .map((e) => e.trim())
+          .filter((e) => !!e)
+          .join(MULTIPLE_INPUT_DELIMITER)
+      );
+    },
+  });
+
+  return (
+    <ModalForm
+      {...formProps}
+      layout="vertical"
+      form={formInst}
+      modalProps={{ destroyOnClose: true }}
+      title={t("workflow_node.deploy.form.aliyun_dcdn_domains.multiple_input_modal.title")}
+      trigger={trigger}
+      validateTrigger="onSubmit"
+      width={480}
+    >
+      <Form.Item name="domains" rules={[formRule]}>
+        <MultipleInput placeholder={t("workflow_node.deploy.form.aliyun_dcdn_domains.multiple_input_modal.placeholder")} />
+      </Form.Item>
+    </ModalForm>
+  );
+});
+
 export default DeployNodeConfigFormAliyunDCDNConfig;
@@ -0,0 +1,113 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormAliyunDDoSConfigFieldValues = Nullish<{
+  region: string;
+  domain: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
+}>;
+
+export type DeployNodeConfigFormAliyunDDoSConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormAliyunDDoSConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormAliyunDDoSConfigFieldValues) => void;
+};
+
+const REGION_MAINLAND = "cn-hangzhou" as const;
+const REGION_OVERSEAS = "ap-southeast-1" as const;
+
+const initFormModel = (): DeployNodeConfigFormAliyunDDoSConfigFieldValues => {
+  return {
+    region: REGION_MAINLAND,
+  };
+};
+
+const DeployNodeConfigFormAliyunDDoSConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormAliyunDDoSConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z.enum([REGION_MAINLAND, REGION_OVERSEAS], { message: t("workflow_node.deploy.form.aliyun_ddos_region.placeholder") }),
+    domain: z
+      .string({ message: t("workflow_node.deploy.form.aliyun_ddos_domain.placeholder") })
+      .refine((v) => validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.aliyun_ddos_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_ddos_region.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.aliyun_ddos_region.placeholder")}>
+          <Select.Option key={REGION_MAINLAND} value={REGION_MAINLAND}>
+            {t("workflow_node.deploy.form.aliyun_ddos_region.option.mainland.label")}
+          </Select.Option>
+          <Select.Option key={REGION_OVERSEAS} value={REGION_OVERSEAS}>
+            {t("workflow_node.deploy.form.aliyun_ddos_region.option.overseas.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="domain"
+        label={t("workflow_node.deploy.form.aliyun_ddos_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_ddos_domain.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.aliyun_ddos_domain.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="resourceGroupId"
+        label={t("workflow_node.deploy.form.aliyun_ddos_resource_group_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_ddos_resource_group_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_ddos_resource_group_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certNameTemplate"
+        label={t("workflow_node.deploy.form.aliyun_ddos_cert_name_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_ddos_cert_name_template.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_ddos_cert_name_template.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormAliyunDDoSConfig;
@@ -1,11 +1,17 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import Show from "@/components/Show";
+
 type DeployNodeConfigFormAliyunESAConfigFieldValues = Nullish<{
   region: string;
-  siteId: string | number;
+  siteId?: string | number;
+  siteName?: string;
+  certMode?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunESAConfigProps = {
@@ -16,8 +22,13 @@ export type DeployNodeConfigFormAliyunESAConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormAliyunESAConfigFieldValues) => void;
 };
 
+const CERT_MODE_CAS = "cas" as const;
+const CERT_MODE_UPLOAD = "upload" as const;
+
 const initFormModel = (): DeployNodeConfigFormAliyunESAConfigFieldValues => {
-  return {};
+  return {
+    certMode: CERT_MODE_CAS,
+  };
 };
 
 const DeployNodeConfigFormAliyunESAConfig = ({
@@ -34,12 +45,26 @@ const DeployNodeConfigFormAliyunESAConfig = ({
       .string({ message: t("workflow_node.deploy.form.aliyun_esa_region.placeholder") })
       .nonempty(t("workflow_node.deploy.form.aliyun_esa_region.placeholder"))
       .trim(),
-    siteId: z.union([z.string(), z.number()]).refine((v) => {
-      return /^\d+$/.test(v + "") && +v > 0;
-    }, t("workflow_node.deploy.form.aliyun_esa_site_id.placeholder")),
+    siteId: z
+      .union([z.string(), z.number()])
+      .nullish()
+      .refine((v) => {
+        if (v == null || v === "") return !!fieldSiteName;
+        return /^\d+$/.test(v + "") && +v > 0;
+      }, t("workflow_node.deploy.form.aliyun_esa_site_id.placeholder")),
+    siteName: z.string().nullish(),
+    certMode: z.enum([CERT_MODE_CAS, CERT_MODE_UPLOAD]).nullish(),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldSiteName = Form.useWatch<string>("siteName", formInst);
+  const fieldCertMode = Form.useWatch<string>("certMode", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -70,6 +95,51 @@ const DeployNodeConfigFormAliyunESAConfig = ({
       >
         <Input type="number" placeholder={t("workflow_node.deploy.form.aliyun_esa_site_id.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        name="siteName"
+        label={t("workflow_node.deploy.form.aliyun_esa_site_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_esa_site_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_esa_site_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certMode"
+        label={t("workflow_node.deploy.form.aliyun_esa_cert_mode.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_esa_cert_mode.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.aliyun_esa_cert_mode.placeholder")}>
+          <Select.Option key={CERT_MODE_CAS} value={CERT_MODE_CAS}>
+            {t("workflow_node.deploy.form.aliyun_esa_cert_mode.option.cas.label")}
+          </Select.Option>
+          <Select.Option key={CERT_MODE_UPLOAD} value={CERT_MODE_UPLOAD}>
+            {t("workflow_node.deploy.form.aliyun_esa_cert_mode.option.upload.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCertMode === CERT_MODE_CAS}>
+        <Form.Item
+          name="resourceGroupId"
+          label={t("workflow_node.deploy.form.aliyun_esa_resource_group_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_esa_resource_group_id.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_esa_resource_group_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="certNameTemplate"
+          label={t("workflow_node.deploy.form.aliyun_esa_cert_name_template.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_esa_cert_name_template.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_esa_cert_name_template.placeholder")} />
+        </Form.Item>
+      </Show>
     </Form>
   );
 };
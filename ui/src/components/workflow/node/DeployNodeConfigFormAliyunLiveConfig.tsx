@@ -1,13 +1,19 @@
+import { memo } from "react";
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { FormOutlined as FormOutlinedIcon } from "@ant-design/icons";
+import { Button, Form, type FormInstance, Input, Space } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import ModalForm from "@/components/ModalForm";
+import MultipleInput from "@/components/MultipleInput";
+import { useAntdForm } from "@/hooks";
 import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormAliyunLiveConfigFieldValues = Nullish<{
   region: string;
-  domain: string;
+  domain?: string;
+  domains?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunLiveConfigProps = {
@@ -18,6 +24,8 @@ export type DeployNodeConfigFormAliyunLiveConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormAliyunLiveConfigFieldValues) => void;
 };
 
+const MULTIPLE_INPUT_DELIMITER = ";";
+
 const initFormModel = (): DeployNodeConfigFormAliyunLiveConfigFieldValues => {
   return {};
 };
@@ -37,11 +45,24 @@ const DeployNodeConfigFormAliyunLiveConfig = ({
       .nonempty(t("workflow_node.deploy.form.aliyun_live_region.placeholder"))
       .trim(),
     domain: z
-      .string({ message: t("workflow_node.deploy.form.aliyun_live_domain.placeholder") })
-      .refine((v) => validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+      .string()
+      .nullish()
+      .refine((v) => !!v || !!fieldDomains, t("workflow_node.deploy.form.aliyun_live_domain.placeholder"))
+      .refine((v) => !v || validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    domains: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        if (!v) return true;
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => validDomainName(e, { allowWildcard: true }));
+      }, t("common.errmsg.domain_invalid")),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldDomains = Form.useWatch<string>("domains", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -72,8 +93,78 @@ const DeployNodeConfigFormAliyunLiveConfig = ({
       >
         <Input placeholder={t("workflow_node.deploy.form.aliyun_live_domain.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        label={t("workflow_node.deploy.form.aliyun_live_domains.label")}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_live_domains.tooltip") }}></span>}
+      >
+        <Space.Compact style={{ width: "100%" }}>
+          <Form.Item name="domains" noStyle rules={[formRule]}>
+            <Input
+              allowClear
+              disabled={disabled}
+              value={fieldDomains}
+              placeholder={t("workflow_node.deploy.form.aliyun_live_domains.placeholder")}
+              onChange={(e) => {
+                formInst.setFieldValue("domains", e.target.value);
+              }}
+            />
+          </Form.Item>
+          <DomainsModalInput
+            value={fieldDomains}
+            trigger={
+              <Button disabled={disabled}>
+                <FormOutlinedIcon />
+              </Button>
+            }
+            onChange={(value) => {
+              formInst.setFieldValue("domains", value);
+            }}
+          />
+        </Space.Compact>
+      </Form.Item>
     </Form>
   );
 };
 
+const DomainsModalInput = memo(({ value, trigger, onChange }: { value?: string; trigger?: React.ReactNode; onChange?: (value: string) => void }) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    domains: z.array(z.string()).refine((v) => {
+      return v.every((e) => !e?.trim() || validDomainName(e.trim(), { allowWildcard: true }));
+    }, t("common.errmsg.domain_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+  const { form: formInst, formProps } = useAntdForm({
+    name: "workflowNodeDeployConfigFormAliyunLiveDomainsModalInput",
+    initialValues: { domains: value?.split(MULTIPLE_INPUT_DELIMITER) },
+    onSubmit: (values) => {
+      onChange?.(
+        values.domains
+          .map((e) => e.trim())
+          .filter((e) => !!e)
+          .join(MULTIPLE_INPUT_DELIMITER)
+      );
+    },
+  });
+
+  return (
+    <ModalForm
+      {...formProps}
+      layout="vertical"
+      form={formInst}
+      modalProps={{ destroyOnClose: true }}
+      title={t("workflow_node.deploy.form.aliyun_live_domains.multiple_input_modal.title")}
+      trigger={trigger}
+      validateTrigger="onSubmit"
+      width={480}
+    >
+      <Form.Item name="domains" rules={[formRule]}>
+        <MultipleInput placeholder={t("workflow_node.deploy.form.aliyun_live_domains.multiple_input_modal.placeholder")} />
+      </Form.Item>
+    </ModalForm>
+  );
+});
+
 export default DeployNodeConfigFormAliyunLiveConfig;
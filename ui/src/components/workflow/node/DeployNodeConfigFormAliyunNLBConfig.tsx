@@ -10,6 +10,8 @@ type DeployNodeConfigFormAliyunNLBConfigFieldValues = Nullish<{
   region: string;
   loadbalancerId?: string;
   listenerId?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunNLBConfigProps = {
@@ -56,6 +58,11 @@ const DeployNodeConfigFormAliyunNLBConfig = ({
       .trim()
       .nullish()
       .refine((v) => fieldResourceType !== RESOURCE_TYPE_LISTENER || !!v?.trim(), t("workflow_node.deploy.form.aliyun_nlb_listener_id.placeholder")),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -115,6 +122,24 @@ const DeployNodeConfigFormAliyunNLBConfig = ({
           <Input placeholder={t("workflow_node.deploy.form.aliyun_nlb_listener_id.placeholder")} />
         </Form.Item>
       </Show>
+
+      <Form.Item
+        name="resourceGroupId"
+        label={t("workflow_node.deploy.form.aliyun_nlb_resource_group_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_nlb_resource_group_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_nlb_resource_group_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certNameTemplate"
+        label={t("workflow_node.deploy.form.aliyun_nlb_cert_name_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_nlb_cert_name_template.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_nlb_cert_name_template.placeholder")} />
+      </Form.Item>
     </Form>
   );
 };
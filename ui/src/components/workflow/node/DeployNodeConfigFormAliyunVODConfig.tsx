@@ -1,13 +1,18 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import Show from "@/components/Show";
 import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormAliyunVODConfigFieldValues = Nullish<{
   region: string;
   domain: string;
+  certMode?: string;
+  casRegion?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunVODConfigProps = {
@@ -18,8 +23,13 @@ export type DeployNodeConfigFormAliyunVODConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormAliyunVODConfigFieldValues) => void;
 };
 
+const CERT_MODE_UPLOAD = "upload" as const;
+const CERT_MODE_CAS = "cas" as const;
+
 const initFormModel = (): DeployNodeConfigFormAliyunVODConfigFieldValues => {
-  return {};
+  return {
+    certMode: CERT_MODE_UPLOAD,
+  };
 };
 
 const DeployNodeConfigFormAliyunVODConfig = ({
@@ -39,9 +49,18 @@ const DeployNodeConfigFormAliyunVODConfig = ({
     domain: z
       .string({ message: t("workflow_node.deploy.form.aliyun_vod_domain.placeholder") })
       .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
+    certMode: z.enum([CERT_MODE_UPLOAD, CERT_MODE_CAS]).nullish(),
+    casRegion: z.string().nullish(),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldCertMode = Form.useWatch<string>("certMode", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -72,6 +91,53 @@ const DeployNodeConfigFormAliyunVODConfig = ({
       >
         <Input placeholder={t("workflow_node.deploy.form.aliyun_vod_domain.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        name="certMode"
+        label={t("workflow_node.deploy.form.aliyun_vod_cert_mode.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_vod_cert_mode.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.aliyun_vod_cert_mode.placeholder")}>
+          <Select.Option key={CERT_MODE_UPLOAD} value={CERT_MODE_UPLOAD}>
+            {t("workflow_node.deploy.form.aliyun_vod_cert_mode.option.upload.label")}
+          </Select.Option>
+          <Select.Option key={CERT_MODE_CAS} value={CERT_MODE_CAS}>
+            {t("workflow_node.deploy.form.aliyun_vod_cert_mode.option.cas.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCertMode === CERT_MODE_CAS}>
+        <Form.Item
+          name="casRegion"
+          label={t("workflow_node.deploy.form.aliyun_vod_cas_region.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_vod_cas_region.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.aliyun_vod_cas_region.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldCertMode === CERT_MODE_CAS}>
+        <Form.Item
+          name="resourceGroupId"
+          label={t("workflow_node.deploy.form.aliyun_vod_resource_group_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_vod_resource_group_id.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_vod_resource_group_id.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="certNameTemplate"
+          label={t("workflow_node.deploy.form.aliyun_vod_cert_name_template.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_vod_cert_name_template.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_vod_cert_name_template.placeholder")} />
+        </Form.Item>
+      </Show>
     </Form>
   );
 };
@@ -1,14 +1,21 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
+import Show from "@/components/Show";
 import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormAliyunWAFConfigFieldValues = Nullish<{
   region: string;
   instanceId: string;
   domain?: string;
+  tlsVersion?: string;
+  enableTLSv3?: boolean;
+  cipherSuite?: string;
+  customCiphers?: string;
+  resourceGroupId?: string;
+  certNameTemplate?: string;
 }>;
 
 export type DeployNodeConfigFormAliyunWAFConfigProps = {
@@ -19,6 +26,14 @@ export type DeployNodeConfigFormAliyunWAFConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormAliyunWAFConfigFieldValues) => void;
 };
 
+const TLS_VERSIONS = ["tlsv1", "tlsv1.1", "tlsv1.2"] as const;
+
+const CIPHER_SUITE_ALL = "1" as const;
+const CIPHER_SUITE_STRONG = "2" as const;
+const CIPHER_SUITE_CUSTOM = "99" as const;
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
 const initFormModel = (): DeployNodeConfigFormAliyunWAFConfigFieldValues => {
   return {};
 };
@@ -48,9 +63,26 @@ const DeployNodeConfigFormAliyunWAFConfig = ({
       .refine((v) => {
         return !v || validDomainName(v!, { allowWildcard: true });
       }, t("common.errmsg.domain_invalid")),
+    tlsVersion: z.enum(TLS_VERSIONS).nullish(),
+    enableTLSv3: z.boolean().nullish(),
+    cipherSuite: z.enum([CIPHER_SUITE_ALL, CIPHER_SUITE_STRONG, CIPHER_SUITE_CUSTOM]).nullish(),
+    customCiphers: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        if (fieldCipherSuite !== CIPHER_SUITE_CUSTOM) return true;
+        return !!v && v.split(MULTIPLE_INPUT_DELIMITER).some((e) => !!e.trim());
+      }, t("workflow_node.deploy.form.aliyun_waf_custom_ciphers.placeholder")),
+    resourceGroupId: z.string().nullish(),
+    certNameTemplate: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
+  const fieldCipherSuite = Form.useWatch("cipherSuite", formInst);
+
   const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
     onValuesChange?.(values);
   };
@@ -90,6 +122,75 @@ const DeployNodeConfigFormAliyunWAFConfig = ({
       >
         <Input placeholder={t("workflow_node.deploy.form.aliyun_waf_domain.placeholder")} />
       </Form.Item>
+
+      <Form.Item
+        name="tlsVersion"
+        label={t("workflow_node.deploy.form.aliyun_waf_tls_version.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_waf_tls_version.tooltip") }}></span>}
+      >
+        <Select allowClear placeholder={t("workflow_node.deploy.form.aliyun_waf_tls_version.placeholder")}>
+          {TLS_VERSIONS.map((s) => (
+            <Select.Option key={s} value={s}>
+              {t(`workflow_node.deploy.form.aliyun_waf_tls_version.option.${s}.label`)}
+            </Select.Option>
+          ))}
+        </Select>
+      </Form.Item>
+
+      <Form.Item name="enableTLSv3" label={t("workflow_node.deploy.form.aliyun_waf_enable_tlsv3.label")} rules={[formRule]}>
+        <Select allowClear placeholder={t("workflow_node.deploy.form.aliyun_waf_enable_tlsv3.placeholder")}>
+          <Select.Option key="true" value={true}>
+            {t("workflow_node.deploy.form.aliyun_waf_enable_tlsv3.option.true.label")}
+          </Select.Option>
+          <Select.Option key="false" value={false}>
+            {t("workflow_node.deploy.form.aliyun_waf_enable_tlsv3.option.false.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item name="cipherSuite" label={t("workflow_node.deploy.form.aliyun_waf_cipher_suite.label")} rules={[formRule]}>
+        <Select allowClear placeholder={t("workflow_node.deploy.form.aliyun_waf_cipher_suite.placeholder")}>
+          <Select.Option key={CIPHER_SUITE_ALL} value={CIPHER_SUITE_ALL}>
+            {t("workflow_node.deploy.form.aliyun_waf_cipher_suite.option.all.label")}
+          </Select.Option>
+          <Select.Option key={CIPHER_SUITE_STRONG} value={CIPHER_SUITE_STRONG}>
+            {t("workflow_node.deploy.form.aliyun_waf_cipher_suite.option.strong.label")}
+          </Select.Option>
+          <Select.Option key={CIPHER_SUITE_CUSTOM} value={CIPHER_SUITE_CUSTOM}>
+            {t("workflow_node.deploy.form.aliyun_waf_cipher_suite.option.custom.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldCipherSuite === CIPHER_SUITE_CUSTOM}>
+        <Form.Item
+          name="customCiphers"
+          label={t("workflow_node.deploy.form.aliyun_waf_custom_ciphers.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_waf_custom_ciphers.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.aliyun_waf_custom_ciphers.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        name="resourceGroupId"
+        label={t("workflow_node.deploy.form.aliyun_waf_resource_group_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_waf_resource_group_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_waf_resource_group_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certNameTemplate"
+        label={t("workflow_node.deploy.form.aliyun_waf_cert_name_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.aliyun_waf_cert_name_template.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.aliyun_waf_cert_name_template.placeholder")} />
+      </Form.Item>
     </Form>
   );
 };
@@ -0,0 +1,97 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validPortNumber } from "@/utils/validators";
+
+type DeployNodeConfigFormBaiduCloudBLBConfigFieldValues = Nullish<{
+  region: string;
+  loadbalancerId: string;
+  listenerPort: string | number;
+}>;
+
+export type DeployNodeConfigFormBaiduCloudBLBConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormBaiduCloudBLBConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormBaiduCloudBLBConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormBaiduCloudBLBConfigFieldValues => {
+  return {
+    listenerPort: 443,
+  };
+};
+
+const DeployNodeConfigFormBaiduCloudBLBConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormBaiduCloudBLBConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z
+      .string({ message: t("workflow_node.deploy.form.baiducloud_blb_region.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.baiducloud_blb_region.placeholder"))
+      .trim(),
+    loadbalancerId: z
+      .string({ message: t("workflow_node.deploy.form.baiducloud_blb_loadbalancer_id.placeholder") })
+      .min(1, t("workflow_node.deploy.form.baiducloud_blb_loadbalancer_id.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    listenerPort: z.union([
+      z.number().refine((v) => validPortNumber(v), t("workflow_node.deploy.form.baiducloud_blb_listener_port.placeholder")),
+      z.string().refine((v) => validPortNumber(v), t("workflow_node.deploy.form.baiducloud_blb_listener_port.placeholder")),
+    ]),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.baiducloud_blb_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.baiducloud_blb_region.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.baiducloud_blb_region.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="loadbalancerId"
+        label={t("workflow_node.deploy.form.baiducloud_blb_loadbalancer_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.baiducloud_blb_loadbalancer_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.baiducloud_blb_loadbalancer_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="listenerPort"
+        label={t("workflow_node.deploy.form.baiducloud_blb_listener_port.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.baiducloud_blb_listener_port.tooltip") }}></span>}
+      >
+        <Input type="number" min={1} max={65535} placeholder={t("workflow_node.deploy.form.baiducloud_blb_listener_port.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormBaiduCloudBLBConfig;
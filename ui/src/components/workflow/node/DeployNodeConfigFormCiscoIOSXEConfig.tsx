@@ -0,0 +1,79 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormCiscoIOSXEConfigFieldValues = Nullish<{
+  trustpointPrefix: string;
+  bindHttpSecureServer?: boolean;
+}>;
+
+export type DeployNodeConfigFormCiscoIOSXEConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormCiscoIOSXEConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormCiscoIOSXEConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormCiscoIOSXEConfigFieldValues => {
+  return {
+    trustpointPrefix: "CERTIMATE",
+    bindHttpSecureServer: true,
+  };
+};
+
+const DeployNodeConfigFormCiscoIOSXEConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormCiscoIOSXEConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    trustpointPrefix: z
+      .string({ message: t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.placeholder"))
+      .max(32, t("common.errmsg.string_max", { max: 32 }))
+      .regex(/^[A-Za-z0-9_-]+$/, t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.placeholder")),
+    bindHttpSecureServer: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="trustpointPrefix"
+        label={t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.cisco_iosxe_trustpoint_prefix.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="bindHttpSecureServer"
+        label={t("workflow_node.deploy.form.cisco_iosxe_bind_http_secure_server.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cisco_iosxe_bind_http_secure_server.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormCiscoIOSXEConfig;
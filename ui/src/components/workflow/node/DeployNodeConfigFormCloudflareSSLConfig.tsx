@@ -0,0 +1,102 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormCloudflareSSLConfigFieldValues = Nullish<{
+  zoneId: string;
+  certificateId?: string;
+  bundleMethod?: string;
+}>;
+
+export type DeployNodeConfigFormCloudflareSSLConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormCloudflareSSLConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormCloudflareSSLConfigFieldValues) => void;
+};
+
+const BUNDLE_METHOD_UBIQUITOUS = "ubiquitous" as const;
+const BUNDLE_METHOD_OPTIMAL = "optimal" as const;
+const BUNDLE_METHOD_FORCE = "force" as const;
+
+const initFormModel = (): DeployNodeConfigFormCloudflareSSLConfigFieldValues => {
+  return {
+    bundleMethod: BUNDLE_METHOD_UBIQUITOUS,
+  };
+};
+
+const DeployNodeConfigFormCloudflareSSLConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormCloudflareSSLConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    zoneId: z
+      .string({ message: t("workflow_node.deploy.form.cloudflare_ssl_zone_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.cloudflare_ssl_zone_id.placeholder"))
+      .trim(),
+    certificateId: z.string().nullish(),
+    bundleMethod: z.enum([BUNDLE_METHOD_UBIQUITOUS, BUNDLE_METHOD_OPTIMAL, BUNDLE_METHOD_FORCE]).nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="zoneId"
+        label={t("workflow_node.deploy.form.cloudflare_ssl_zone_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_ssl_zone_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.cloudflare_ssl_zone_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateId"
+        label={t("workflow_node.deploy.form.cloudflare_ssl_certificate_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_ssl_certificate_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.cloudflare_ssl_certificate_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="bundleMethod"
+        label={t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.placeholder")}>
+          <Select.Option key={BUNDLE_METHOD_UBIQUITOUS} value={BUNDLE_METHOD_UBIQUITOUS}>
+            {t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.option.ubiquitous.label")}
+          </Select.Option>
+          <Select.Option key={BUNDLE_METHOD_OPTIMAL} value={BUNDLE_METHOD_OPTIMAL}>
+            {t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.option.optimal.label")}
+          </Select.Option>
+          <Select.Option key={BUNDLE_METHOD_FORCE} value={BUNDLE_METHOD_FORCE}>
+            {t("workflow_node.deploy.form.cloudflare_ssl_bundle_method.option.force.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormCloudflareSSLConfig;
@@ -0,0 +1,106 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormCloudflareSaaSConfigFieldValues = Nullish<{
+  zoneId: string;
+  customHostname: string;
+  bundleMethod?: string;
+}>;
+
+export type DeployNodeConfigFormCloudflareSaaSConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormCloudflareSaaSConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormCloudflareSaaSConfigFieldValues) => void;
+};
+
+const BUNDLE_METHOD_UBIQUITOUS = "ubiquitous" as const;
+const BUNDLE_METHOD_OPTIMAL = "optimal" as const;
+const BUNDLE_METHOD_FORCE = "force" as const;
+
+const initFormModel = (): DeployNodeConfigFormCloudflareSaaSConfigFieldValues => {
+  return {
+    bundleMethod: BUNDLE_METHOD_UBIQUITOUS,
+  };
+};
+
+const DeployNodeConfigFormCloudflareSaaSConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormCloudflareSaaSConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    zoneId: z
+      .string({ message: t("workflow_node.deploy.form.cloudflare_saas_zone_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.cloudflare_saas_zone_id.placeholder"))
+      .trim(),
+    customHostname: z
+      .string({ message: t("workflow_node.deploy.form.cloudflare_saas_custom_hostname.placeholder") })
+      .refine((v) => validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    bundleMethod: z.enum([BUNDLE_METHOD_UBIQUITOUS, BUNDLE_METHOD_OPTIMAL, BUNDLE_METHOD_FORCE]).nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="zoneId"
+        label={t("workflow_node.deploy.form.cloudflare_saas_zone_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_saas_zone_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.cloudflare_saas_zone_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="customHostname"
+        label={t("workflow_node.deploy.form.cloudflare_saas_custom_hostname.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_saas_custom_hostname.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.cloudflare_saas_custom_hostname.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="bundleMethod"
+        label={t("workflow_node.deploy.form.cloudflare_saas_bundle_method.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.cloudflare_saas_bundle_method.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.cloudflare_saas_bundle_method.placeholder")}>
+          <Select.Option key={BUNDLE_METHOD_UBIQUITOUS} value={BUNDLE_METHOD_UBIQUITOUS}>
+            {t("workflow_node.deploy.form.cloudflare_saas_bundle_method.option.ubiquitous.label")}
+          </Select.Option>
+          <Select.Option key={BUNDLE_METHOD_OPTIMAL} value={BUNDLE_METHOD_OPTIMAL}>
+            {t("workflow_node.deploy.form.cloudflare_saas_bundle_method.option.optimal.label")}
+          </Select.Option>
+          <Select.Option key={BUNDLE_METHOD_FORCE} value={BUNDLE_METHOD_FORCE}>
+            {t("workflow_node.deploy.form.cloudflare_saas_bundle_method.option.force.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormCloudflareSaaSConfig;
@@ -0,0 +1,106 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormConsulKVConfigFieldValues = Nullish<{
+  datacenter?: string;
+  keyPrefix: string;
+  keyForCertificate?: string;
+  keyForPrivateKey?: string;
+  keyForCertificateChain?: string;
+}>;
+
+export type DeployNodeConfigFormConsulKVConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormConsulKVConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormConsulKVConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormConsulKVConfigFieldValues => {
+  return {
+    keyPrefix: "certimate/",
+  };
+};
+
+const DeployNodeConfigFormConsulKVConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormConsulKVConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    datacenter: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyPrefix: z
+      .string({ message: t("workflow_node.deploy.form.consul_key_prefix.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.consul_key_prefix.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    keyForCertificate: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForPrivateKey: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForCertificateChain: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="datacenter"
+        label={t("workflow_node.deploy.form.consul_datacenter.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.consul_datacenter.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.consul_datacenter.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keyPrefix"
+        label={t("workflow_node.deploy.form.consul_key_prefix.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.consul_key_prefix.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.consul_key_prefix.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForCertificate" label={t("workflow_node.deploy.form.consul_key_for_certificate.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.consul_key_for_certificate.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForPrivateKey" label={t("workflow_node.deploy.form.consul_key_for_private_key.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.consul_key_for_private_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForCertificateChain" label={t("workflow_node.deploy.form.consul_key_for_certificate_chain.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.consul_key_for_certificate_chain.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormConsulKVConfig;
@@ -0,0 +1,119 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormDockerSwarmConfigFieldValues = Nullish<{
+  serviceNames: string;
+  secretNamePrefix?: string;
+  certificateFileName?: string;
+  privateKeyFileName?: string;
+}>;
+
+export type DeployNodeConfigFormDockerSwarmConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormDockerSwarmConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormDockerSwarmConfigFieldValues) => void;
+};
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
+const initFormModel = (): DeployNodeConfigFormDockerSwarmConfigFieldValues => {
+  return {
+    secretNamePrefix: "certimate",
+    certificateFileName: "tls.crt",
+    privateKeyFileName: "tls.key",
+  };
+};
+
+const DeployNodeConfigFormDockerSwarmConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormDockerSwarmConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serviceNames: z
+      .string({ message: t("workflow_node.deploy.form.docker_swarm_service_names.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.docker_swarm_service_names.placeholder"))
+      .refine((v) => {
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => /^[a-zA-Z0-9][a-zA-Z0-9_.-]*$/.test(e.trim()));
+      }, t("workflow_node.deploy.form.docker_swarm_service_names.placeholder")),
+    secretNamePrefix: z
+      .string()
+      .max(32, t("common.errmsg.string_max", { max: 32 }))
+      .refine((v) => !v || /^[a-zA-Z0-9][a-zA-Z0-9_.-]*$/.test(v), t("workflow_node.deploy.form.docker_swarm_secret_name_prefix.errmsg.invalid"))
+      .nullish(),
+    certificateFileName: z
+      .string({ message: t("workflow_node.deploy.form.docker_swarm_certificate_file_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.docker_swarm_certificate_file_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    privateKeyFileName: z
+      .string({ message: t("workflow_node.deploy.form.docker_swarm_private_key_file_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.docker_swarm_private_key_file_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serviceNames"
+        label={t("workflow_node.deploy.form.docker_swarm_service_names.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.docker_swarm_service_names.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.docker_swarm_service_names.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="secretNamePrefix"
+        label={t("workflow_node.deploy.form.docker_swarm_secret_name_prefix.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.docker_swarm_secret_name_prefix.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.docker_swarm_secret_name_prefix.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateFileName"
+        label={t("workflow_node.deploy.form.docker_swarm_certificate_file_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.docker_swarm_certificate_file_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.docker_swarm_certificate_file_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="privateKeyFileName"
+        label={t("workflow_node.deploy.form.docker_swarm_private_key_file_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.docker_swarm_private_key_file_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.docker_swarm_private_key_file_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormDockerSwarmConfig;
@@ -0,0 +1,94 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormEtcdConfigFieldValues = Nullish<{
+  keyForCertificate: string;
+  keyForPrivateKey: string;
+  ttl?: string | number;
+}>;
+
+export type DeployNodeConfigFormEtcdConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormEtcdConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormEtcdConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormEtcdConfigFieldValues => {
+  return {
+    keyForCertificate: "/certimate/tls.crt",
+    keyForPrivateKey: "/certimate/tls.key",
+  };
+};
+
+const DeployNodeConfigFormEtcdConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormEtcdConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    keyForCertificate: z
+      .string({ message: t("workflow_node.deploy.form.etcd_key_for_certificate.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.etcd_key_for_certificate.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    keyForPrivateKey: z
+      .string({ message: t("workflow_node.deploy.form.etcd_key_for_private_key.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.etcd_key_for_private_key.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    ttl: z
+      .union([z.string(), z.number()])
+      .nullish()
+      .refine((v) => {
+        if (v == null || v === "") return true;
+        return /^\d+$/.test(v + "") && +v >= 0;
+      }, t("workflow_node.deploy.form.etcd_ttl.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="keyForCertificate"
+        label={t("workflow_node.deploy.form.etcd_key_for_certificate.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.etcd_key_for_certificate.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.etcd_key_for_certificate.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keyForPrivateKey"
+        label={t("workflow_node.deploy.form.etcd_key_for_private_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.etcd_key_for_private_key.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.etcd_key_for_private_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="ttl"
+        label={t("workflow_node.deploy.form.etcd_ttl.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.etcd_ttl.tooltip") }}></span>}
+      >
+        <Input type="number" allowClear min={0} placeholder={t("workflow_node.deploy.form.etcd_ttl.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormEtcdConfig;
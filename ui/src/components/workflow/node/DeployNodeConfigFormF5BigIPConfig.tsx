@@ -0,0 +1,134 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+
+type DeployNodeConfigFormF5BigIPConfigFieldValues = Nullish<{
+  resourceType: string;
+  partition?: string;
+  profileName: string;
+  parentProfileName?: string;
+  virtualServerName?: string;
+}>;
+
+export type DeployNodeConfigFormF5BigIPConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormF5BigIPConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormF5BigIPConfigFieldValues) => void;
+};
+
+const RESOURCE_TYPE_PROFILE = "profile" as const;
+const RESOURCE_TYPE_PROFILE_VERSION = "profile-version" as const;
+
+const initFormModel = (): DeployNodeConfigFormF5BigIPConfigFieldValues => {
+  return {
+    resourceType: RESOURCE_TYPE_PROFILE,
+    partition: "Common",
+  };
+};
+
+const DeployNodeConfigFormF5BigIPConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormF5BigIPConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    resourceType: z.union([z.literal(RESOURCE_TYPE_PROFILE), z.literal(RESOURCE_TYPE_PROFILE_VERSION)], {
+      message: t("workflow_node.deploy.form.f5_bigip_resource_type.placeholder"),
+    }),
+    partition: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    profileName: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.f5_bigip_profile_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    parentProfileName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    virtualServerName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_PROFILE_VERSION || !!v?.trim(), {
+        message: t("workflow_node.deploy.form.f5_bigip_virtual_server_name.placeholder"),
+      }),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldResourceType = Form.useWatch("resourceType", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="resourceType" label={t("workflow_node.deploy.form.f5_bigip_resource_type.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.f5_bigip_resource_type.placeholder")}>
+          <Select.Option key={RESOURCE_TYPE_PROFILE} value={RESOURCE_TYPE_PROFILE}>
+            {t("workflow_node.deploy.form.f5_bigip_resource_type.option.profile.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_PROFILE_VERSION} value={RESOURCE_TYPE_PROFILE_VERSION}>
+            {t("workflow_node.deploy.form.f5_bigip_resource_type.option.profile_version.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="partition"
+        label={t("workflow_node.deploy.form.f5_bigip_partition.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.f5_bigip_partition.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.f5_bigip_partition.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="profileName"
+        label={t("workflow_node.deploy.form.f5_bigip_profile_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.f5_bigip_profile_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.f5_bigip_profile_name.placeholder")} />
+      </Form.Item>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_PROFILE_VERSION}>
+        <Form.Item
+          name="parentProfileName"
+          label={t("workflow_node.deploy.form.f5_bigip_parent_profile_name.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.f5_bigip_parent_profile_name.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.f5_bigip_parent_profile_name.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        name="virtualServerName"
+        label={t("workflow_node.deploy.form.f5_bigip_virtual_server_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.f5_bigip_virtual_server_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.f5_bigip_virtual_server_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormF5BigIPConfig;
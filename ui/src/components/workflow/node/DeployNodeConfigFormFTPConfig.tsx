@@ -0,0 +1,231 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { CERTIFICATE_FORMATS } from "@/domain/certificate";
+
+type DeployNodeConfigFormFTPConfigFieldValues = Nullish<{
+  format: string;
+  certPath: string;
+  keyPath?: string | null;
+  pfxPassword?: string | null;
+  jksAlias?: string | null;
+  jksKeypass?: string | null;
+  jksStorepass?: string | null;
+  transferMode?: string | null;
+}>;
+
+export type DeployNodeConfigFormFTPConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormFTPConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormFTPConfigFieldValues) => void;
+};
+
+const FORMAT_PEM = CERTIFICATE_FORMATS.PEM;
+const FORMAT_PFX = CERTIFICATE_FORMATS.PFX;
+const FORMAT_JKS = CERTIFICATE_FORMATS.JKS;
+
+const TRANSFER_MODE_BINARY = "binary" as const;
+const TRANSFER_MODE_ASCII = "ascii" as const;
+
+const initFormModel = (): DeployNodeConfigFormFTPConfigFieldValues => {
+  return {
+    format: FORMAT_PEM,
+    certPath: "/ssl/cert.crt",
+    keyPath: "/ssl/cert.key",
+    transferMode: TRANSFER_MODE_BINARY,
+  };
+};
+
+const DeployNodeConfigFormFTPConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormFTPConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    format: z.union([z.literal(FORMAT_PEM), z.literal(FORMAT_PFX), z.literal(FORMAT_JKS)], {
+      message: t("workflow_node.deploy.form.ftp_format.placeholder"),
+    }),
+    certPath: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.ftp_cert_path.tooltip"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    keyPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldFormat !== FORMAT_PEM || !!v?.trim(), { message: t("workflow_node.deploy.form.ftp_key_path.tooltip") }),
+    pfxPassword: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldFormat !== FORMAT_PFX || !!v?.trim(), { message: t("workflow_node.deploy.form.ftp_pfx_password.tooltip") }),
+    jksAlias: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldFormat !== FORMAT_JKS || !!v?.trim(), { message: t("workflow_node.deploy.form.ftp_jks_alias.tooltip") }),
+    jksKeypass: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldFormat !== FORMAT_JKS || !!v?.trim(), { message: t("workflow_node.deploy.form.ftp_jks_keypass.tooltip") }),
+    jksStorepass: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldFormat !== FORMAT_JKS || !!v?.trim(), { message: t("workflow_node.deploy.form.ftp_jks_storepass.tooltip") }),
+    transferMode: z.union([z.literal(TRANSFER_MODE_BINARY), z.literal(TRANSFER_MODE_ASCII)]).nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldFormat = Form.useWatch("format", formInst);
+  const fieldCertPath = Form.useWatch("certPath", formInst);
+
+  const handleFormatSelect = (value: string) => {
+    if (fieldFormat === value) return;
+
+    switch (value) {
+      case FORMAT_PEM:
+        {
+          if (/(.pfx|.jks)$/.test(fieldCertPath)) {
+            formInst.setFieldValue("certPath", fieldCertPath.replace(/(.pfx|.jks)$/, ".crt"));
+          }
+        }
+        break;
+
+      case FORMAT_PFX:
+        {
+          if (/(.crt|.jks)$/.test(fieldCertPath)) {
+            formInst.setFieldValue("certPath", fieldCertPath.replace(/(.crt|.jks)$/, ".pfx"));
+          }
+        }
+        break;
+
+      case FORMAT_JKS:
+        {
+          if (/(.crt|.pfx)$/.test(fieldCertPath)) {
+            formInst.setFieldValue("certPath", fieldCertPath.replace(/(.crt|.pfx)$/, ".jks"));
+          }
+        }
+        break;
+    }
+  };
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="format" label={t("workflow_node.deploy.form.ftp_format.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.ftp_format.placeholder")} onSelect={handleFormatSelect}>
+          <Select.Option key={FORMAT_PEM} value={FORMAT_PEM}>
+            {t("workflow_node.deploy.form.ftp_format.option.pem.label")}
+          </Select.Option>
+          <Select.Option key={FORMAT_PFX} value={FORMAT_PFX}>
+            {t("workflow_node.deploy.form.ftp_format.option.pfx.label")}
+          </Select.Option>
+          <Select.Option key={FORMAT_JKS} value={FORMAT_JKS}>
+            {t("workflow_node.deploy.form.ftp_format.option.jks.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="certPath"
+        label={t("workflow_node.deploy.form.ftp_cert_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_cert_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ftp_cert_path.placeholder")} />
+      </Form.Item>
+
+      <Show when={fieldFormat === FORMAT_PEM}>
+        <Form.Item
+          name="keyPath"
+          label={t("workflow_node.deploy.form.ftp_key_path.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_key_path.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.ftp_key_path.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldFormat === FORMAT_PFX}>
+        <Form.Item
+          name="pfxPassword"
+          label={t("workflow_node.deploy.form.ftp_pfx_password.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_pfx_password.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.ftp_pfx_password.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldFormat === FORMAT_JKS}>
+        <Form.Item
+          name="jksAlias"
+          label={t("workflow_node.deploy.form.ftp_jks_alias.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_jks_alias.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.ftp_jks_alias.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="jksKeypass"
+          label={t("workflow_node.deploy.form.ftp_jks_keypass.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_jks_keypass.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.ftp_jks_keypass.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="jksStorepass"
+          label={t("workflow_node.deploy.form.ftp_jks_storepass.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_jks_storepass.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.ftp_jks_storepass.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldFormat === FORMAT_PEM}>
+        <Form.Item
+          name="transferMode"
+          label={t("workflow_node.deploy.form.ftp_transfer_mode.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ftp_transfer_mode.tooltip") }}></span>}
+        >
+          <Select placeholder={t("workflow_node.deploy.form.ftp_transfer_mode.placeholder")}>
+            <Select.Option key={TRANSFER_MODE_BINARY} value={TRANSFER_MODE_BINARY}>
+              {t("workflow_node.deploy.form.ftp_transfer_mode.option.binary.label")}
+            </Select.Option>
+            <Select.Option key={TRANSFER_MODE_ASCII} value={TRANSFER_MODE_ASCII}>
+              {t("workflow_node.deploy.form.ftp_transfer_mode.option.ascii.label")}
+            </Select.Option>
+          </Select>
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormFTPConfig;
@@ -0,0 +1,129 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormFastlyConfigFieldValues = Nullish<{
+  resourceType: string;
+  certificateId?: string;
+  domain?: string;
+  tlsConfigurationId?: string;
+}>;
+
+export type DeployNodeConfigFormFastlyConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormFastlyConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormFastlyConfigFieldValues) => void;
+};
+
+const RESOURCE_TYPE_CERTIFICATE = "certificate" as const;
+const RESOURCE_TYPE_DOMAIN = "domain" as const;
+const RESOURCE_TYPE_PLATFORM = "platform" as const;
+
+const initFormModel = (): DeployNodeConfigFormFastlyConfigFieldValues => {
+  return {
+    resourceType: RESOURCE_TYPE_CERTIFICATE,
+  };
+};
+
+const DeployNodeConfigFormFastlyConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormFastlyConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    resourceType: z.union([z.literal(RESOURCE_TYPE_CERTIFICATE), z.literal(RESOURCE_TYPE_DOMAIN), z.literal(RESOURCE_TYPE_PLATFORM)], {
+      message: t("workflow_node.deploy.form.fastly_resource_type.placeholder"),
+    }),
+    certificateId: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_CERTIFICATE || !!v?.trim(), t("workflow_node.deploy.form.fastly_certificate_id.placeholder")),
+    domain: z
+      .string()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_DOMAIN || (!!v && validDomainName(v, { allowWildcard: true })), t("common.errmsg.domain_invalid")),
+    tlsConfigurationId: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish()
+      .refine(
+        (v) => fieldResourceType !== RESOURCE_TYPE_PLATFORM || !!fieldCertificateId?.trim() || !!v?.trim(),
+        t("workflow_node.deploy.form.fastly_tls_configuration_id.placeholder")
+      ),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldResourceType = Form.useWatch("resourceType", formInst);
+  const fieldCertificateId = Form.useWatch("certificateId", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="resourceType" label={t("workflow_node.deploy.form.fastly_resource_type.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.fastly_resource_type.placeholder")}>
+          <Select.Option key={RESOURCE_TYPE_CERTIFICATE} value={RESOURCE_TYPE_CERTIFICATE}>
+            {t("workflow_node.deploy.form.fastly_resource_type.option.certificate.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_DOMAIN} value={RESOURCE_TYPE_DOMAIN}>
+            {t("workflow_node.deploy.form.fastly_resource_type.option.domain.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_PLATFORM} value={RESOURCE_TYPE_PLATFORM}>
+            {t("workflow_node.deploy.form.fastly_resource_type.option.platform.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_CERTIFICATE || fieldResourceType === RESOURCE_TYPE_PLATFORM}>
+        <Form.Item
+          name="certificateId"
+          label={t("workflow_node.deploy.form.fastly_certificate_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fastly_certificate_id.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.fastly_certificate_id.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_DOMAIN}>
+        <Form.Item
+          name="domain"
+          label={t("workflow_node.deploy.form.fastly_domain.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fastly_domain.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.fastly_domain.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_DOMAIN || fieldResourceType === RESOURCE_TYPE_PLATFORM}>
+        <Form.Item
+          name="tlsConfigurationId"
+          label={t("workflow_node.deploy.form.fastly_tls_configuration_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fastly_tls_configuration_id.tooltip") }}></span>}
+        >
+          <Input allowClear placeholder={t("workflow_node.deploy.form.fastly_tls_configuration_id.placeholder")} />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormFastlyConfig;
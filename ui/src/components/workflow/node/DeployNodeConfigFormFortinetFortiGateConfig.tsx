@@ -0,0 +1,125 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+
+type DeployNodeConfigFormFortinetFortiGateConfigFieldValues = Nullish<{
+  resourceType: string;
+  vdom?: string;
+  virtualServerName?: string;
+  deletePrevious?: boolean;
+}>;
+
+export type DeployNodeConfigFormFortinetFortiGateConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormFortinetFortiGateConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormFortinetFortiGateConfigFieldValues) => void;
+};
+
+const RESOURCE_TYPE_ADMIN = "admin" as const;
+const RESOURCE_TYPE_SSLVPN = "sslvpn" as const;
+const RESOURCE_TYPE_VIRTUAL_SERVER = "virtual-server" as const;
+
+const initFormModel = (): DeployNodeConfigFormFortinetFortiGateConfigFieldValues => {
+  return {
+    resourceType: RESOURCE_TYPE_SSLVPN,
+    deletePrevious: false,
+  };
+};
+
+const DeployNodeConfigFormFortinetFortiGateConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormFortinetFortiGateConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    resourceType: z.union([z.literal(RESOURCE_TYPE_ADMIN), z.literal(RESOURCE_TYPE_SSLVPN), z.literal(RESOURCE_TYPE_VIRTUAL_SERVER)], {
+      message: t("workflow_node.deploy.form.fortinet_fortigate_resource_type.placeholder"),
+    }),
+    vdom: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    virtualServerName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_VIRTUAL_SERVER || !!v?.trim(), {
+        message: t("workflow_node.deploy.form.fortinet_fortigate_virtual_server_name.placeholder"),
+      }),
+    deletePrevious: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldResourceType = Form.useWatch("resourceType", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="resourceType" label={t("workflow_node.deploy.form.fortinet_fortigate_resource_type.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.fortinet_fortigate_resource_type.placeholder")}>
+          <Select.Option key={RESOURCE_TYPE_ADMIN} value={RESOURCE_TYPE_ADMIN}>
+            {t("workflow_node.deploy.form.fortinet_fortigate_resource_type.option.admin.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_SSLVPN} value={RESOURCE_TYPE_SSLVPN}>
+            {t("workflow_node.deploy.form.fortinet_fortigate_resource_type.option.sslvpn.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_VIRTUAL_SERVER} value={RESOURCE_TYPE_VIRTUAL_SERVER}>
+            {t("workflow_node.deploy.form.fortinet_fortigate_resource_type.option.virtual_server.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="vdom"
+        label={t("workflow_node.deploy.form.fortinet_fortigate_vdom.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fortinet_fortigate_vdom.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.fortinet_fortigate_vdom.placeholder")} />
+      </Form.Item>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_VIRTUAL_SERVER}>
+        <Form.Item
+          name="virtualServerName"
+          label={t("workflow_node.deploy.form.fortinet_fortigate_virtual_server_name.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fortinet_fortigate_virtual_server_name.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.fortinet_fortigate_virtual_server_name.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        name="deletePrevious"
+        label={t("workflow_node.deploy.form.fortinet_fortigate_delete_previous.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.fortinet_fortigate_delete_previous.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormFortinetFortiGateConfig;
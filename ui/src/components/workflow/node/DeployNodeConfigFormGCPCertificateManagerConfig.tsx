@@ -0,0 +1,112 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormGCPCertificateManagerConfigFieldValues = Nullish<{
+  projectId?: string;
+  location?: string;
+  certificateMapId: string;
+  certificateMapEntryId: string;
+}>;
+
+export type DeployNodeConfigFormGCPCertificateManagerConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormGCPCertificateManagerConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormGCPCertificateManagerConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormGCPCertificateManagerConfigFieldValues => {
+  return {
+    location: "global",
+  };
+};
+
+const DeployNodeConfigFormGCPCertificateManagerConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormGCPCertificateManagerConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    projectId: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    location: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    certificateMapId: z
+      .string({ message: t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_id.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    certificateMapEntryId: z
+      .string({ message: t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_entry_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_entry_id.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="projectId"
+        label={t("workflow_node.deploy.form.gcp_certificatemanager_project_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_certificatemanager_project_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.gcp_certificatemanager_project_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="location"
+        label={t("workflow_node.deploy.form.gcp_certificatemanager_location.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_certificatemanager_location.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.gcp_certificatemanager_location.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateMapId"
+        label={t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateMapEntryId"
+        label={t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_entry_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_entry_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.gcp_certificatemanager_certificate_map_entry_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormGCPCertificateManagerConfig;
@@ -0,0 +1,115 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormGCPLoadBalancerConfigFieldValues = Nullish<{
+  projectId?: string;
+  targetHttpsProxyName: string;
+  certificateNamePrefix?: string;
+  keepRotations?: string | number;
+}>;
+
+export type DeployNodeConfigFormGCPLoadBalancerConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormGCPLoadBalancerConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormGCPLoadBalancerConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormGCPLoadBalancerConfigFieldValues => {
+  return {
+    certificateNamePrefix: "certimate",
+  };
+};
+
+const DeployNodeConfigFormGCPLoadBalancerConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormGCPLoadBalancerConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    projectId: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    targetHttpsProxyName: z
+      .string({ message: t("workflow_node.deploy.form.gcp_loadbalancer_target_https_proxy_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.gcp_loadbalancer_target_https_proxy_name.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    certificateNamePrefix: z
+      .string()
+      .max(32, t("common.errmsg.string_max", { max: 32 }))
+      .trim()
+      .nullish()
+      .refine((v) => !v || /^[a-z]([-a-z0-9]*[a-z0-9])?$/.test(v), t("workflow_node.deploy.form.gcp_loadbalancer_certificate_name_prefix.errmsg.invalid")),
+    keepRotations: z
+      .union([z.string(), z.number()])
+      .nullish()
+      .refine((v) => {
+        if (v == null || v === "") return true;
+        return /^\d+$/.test(v + "") && +v >= 0;
+      }, t("workflow_node.deploy.form.gcp_loadbalancer_keep_rotations.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="projectId"
+        label={t("workflow_node.deploy.form.gcp_loadbalancer_project_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_loadbalancer_project_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.gcp_loadbalancer_project_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="targetHttpsProxyName"
+        label={t("workflow_node.deploy.form.gcp_loadbalancer_target_https_proxy_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_loadbalancer_target_https_proxy_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.gcp_loadbalancer_target_https_proxy_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateNamePrefix"
+        label={t("workflow_node.deploy.form.gcp_loadbalancer_certificate_name_prefix.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_loadbalancer_certificate_name_prefix.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.gcp_loadbalancer_certificate_name_prefix.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keepRotations"
+        label={t("workflow_node.deploy.form.gcp_loadbalancer_keep_rotations.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gcp_loadbalancer_keep_rotations.tooltip") }}></span>}
+      >
+        <Input type="number" allowClear min={0} placeholder={t("workflow_node.deploy.form.gcp_loadbalancer_keep_rotations.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormGCPLoadBalancerConfig;
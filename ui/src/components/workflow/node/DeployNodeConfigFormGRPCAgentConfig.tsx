@@ -0,0 +1,58 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormGRPCAgentConfigFieldValues = Nullish<{
+  target?: string;
+}>;
+
+export type DeployNodeConfigFormGRPCAgentConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormGRPCAgentConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormGRPCAgentConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormGRPCAgentConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormGRPCAgentConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormGRPCAgentConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    target: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="target"
+        label={t("workflow_node.deploy.form.grpc_agent_target.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.grpc_agent_target.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.grpc_agent_target.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormGRPCAgentConfig;
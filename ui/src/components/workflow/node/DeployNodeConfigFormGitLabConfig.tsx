@@ -0,0 +1,233 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+
+type DeployNodeConfigFormGitLabConfigFieldValues = Nullish<{
+  resourceType: string;
+  projectId?: string;
+  groupId?: string;
+  variableType?: string;
+  variableKeyForCertificate?: string;
+  variableKeyForPrivateKey?: string;
+  variableEnvironmentScope?: string;
+  variableProtected?: boolean;
+  branch?: string;
+  filePathForCertificate?: string;
+  filePathForPrivateKey?: string;
+  commitMessage?: string;
+}>;
+
+export type DeployNodeConfigFormGitLabConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormGitLabConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormGitLabConfigFieldValues) => void;
+};
+
+const RESOURCE_TYPE_PROJECT_VARIABLE = "project-variable" as const;
+const RESOURCE_TYPE_GROUP_VARIABLE = "group-variable" as const;
+const RESOURCE_TYPE_REPOSITORY_FILE = "repository-file" as const;
+
+const VARIABLE_TYPE_FILE = "file" as const;
+const VARIABLE_TYPE_ENV_VAR = "env_var" as const;
+
+const initFormModel = (): DeployNodeConfigFormGitLabConfigFieldValues => {
+  return {
+    resourceType: RESOURCE_TYPE_PROJECT_VARIABLE,
+    variableType: VARIABLE_TYPE_FILE,
+    variableKeyForCertificate: "CERTIMATE_CERTIFICATE",
+    variableKeyForPrivateKey: "CERTIMATE_PRIVATE_KEY",
+    variableEnvironmentScope: "*",
+    branch: "main",
+  };
+};
+
+const DeployNodeConfigFormGitLabConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormGitLabConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    resourceType: z.union([z.literal(RESOURCE_TYPE_PROJECT_VARIABLE), z.literal(RESOURCE_TYPE_GROUP_VARIABLE), z.literal(RESOURCE_TYPE_REPOSITORY_FILE)], {
+      message: t("workflow_node.deploy.form.gitlab_resource_type.placeholder"),
+    }),
+    projectId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType === RESOURCE_TYPE_GROUP_VARIABLE || !!v, t("workflow_node.deploy.form.gitlab_project_id.placeholder")),
+    groupId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_GROUP_VARIABLE || !!v, t("workflow_node.deploy.form.gitlab_group_id.placeholder")),
+    variableType: z.union([z.literal(VARIABLE_TYPE_FILE), z.literal(VARIABLE_TYPE_ENV_VAR)]).nullish(),
+    variableKeyForCertificate: z
+      .string()
+      .max(255, t("common.errmsg.string_max", { max: 255 }))
+      .nullish()
+      .refine((v) => !v || /^[A-Za-z0-9_]+$/.test(v), t("workflow_node.deploy.form.gitlab_variable_key.errmsg.invalid")),
+    variableKeyForPrivateKey: z
+      .string()
+      .max(255, t("common.errmsg.string_max", { max: 255 }))
+      .nullish()
+      .refine((v) => !v || /^[A-Za-z0-9_]+$/.test(v), t("workflow_node.deploy.form.gitlab_variable_key.errmsg.invalid")),
+    variableEnvironmentScope: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    variableProtected: z.boolean().nullish(),
+    branch: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_REPOSITORY_FILE || !!v, t("workflow_node.deploy.form.gitlab_branch.placeholder")),
+    filePathForCertificate: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldResourceType !== RESOURCE_TYPE_REPOSITORY_FILE || !!v, t("workflow_node.deploy.form.gitlab_file_path_for_certificate.placeholder")),
+    filePathForPrivateKey: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    commitMessage: z
+      .string()
+      .max(1024, t("common.errmsg.string_max", { max: 1024 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldResourceType = Form.useWatch("resourceType", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item name="resourceType" label={t("workflow_node.deploy.form.gitlab_resource_type.label")} rules={[formRule]}>
+        <Select placeholder={t("workflow_node.deploy.form.gitlab_resource_type.placeholder")}>
+          <Select.Option key={RESOURCE_TYPE_PROJECT_VARIABLE} value={RESOURCE_TYPE_PROJECT_VARIABLE}>
+            {t("workflow_node.deploy.form.gitlab_resource_type.option.project_variable.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_GROUP_VARIABLE} value={RESOURCE_TYPE_GROUP_VARIABLE}>
+            {t("workflow_node.deploy.form.gitlab_resource_type.option.group_variable.label")}
+          </Select.Option>
+          <Select.Option key={RESOURCE_TYPE_REPOSITORY_FILE} value={RESOURCE_TYPE_REPOSITORY_FILE}>
+            {t("workflow_node.deploy.form.gitlab_resource_type.option.repository_file.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Show when={fieldResourceType !== RESOURCE_TYPE_GROUP_VARIABLE}>
+        <Form.Item
+          name="projectId"
+          label={t("workflow_node.deploy.form.gitlab_project_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_project_id.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_project_id.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_GROUP_VARIABLE}>
+        <Form.Item
+          name="groupId"
+          label={t("workflow_node.deploy.form.gitlab_group_id.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_group_id.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_group_id.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_PROJECT_VARIABLE || fieldResourceType === RESOURCE_TYPE_GROUP_VARIABLE}>
+        <Form.Item
+          name="variableType"
+          label={t("workflow_node.deploy.form.gitlab_variable_type.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_variable_type.tooltip") }}></span>}
+        >
+          <Select placeholder={t("workflow_node.deploy.form.gitlab_variable_type.placeholder")}>
+            <Select.Option key={VARIABLE_TYPE_FILE} value={VARIABLE_TYPE_FILE}>
+              {t("workflow_node.deploy.form.gitlab_variable_type.option.file.label")}
+            </Select.Option>
+            <Select.Option key={VARIABLE_TYPE_ENV_VAR} value={VARIABLE_TYPE_ENV_VAR}>
+              {t("workflow_node.deploy.form.gitlab_variable_type.option.env_var.label")}
+            </Select.Option>
+          </Select>
+        </Form.Item>
+
+        <Form.Item name="variableKeyForCertificate" label={t("workflow_node.deploy.form.gitlab_variable_key_for_certificate.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_variable_key_for_certificate.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="variableKeyForPrivateKey" label={t("workflow_node.deploy.form.gitlab_variable_key_for_private_key.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_variable_key_for_private_key.placeholder")} />
+        </Form.Item>
+
+        <Show when={fieldResourceType === RESOURCE_TYPE_PROJECT_VARIABLE}>
+          <Form.Item
+            name="variableEnvironmentScope"
+            label={t("workflow_node.deploy.form.gitlab_variable_environment_scope.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_variable_environment_scope.tooltip") }}></span>}
+          >
+            <Input placeholder={t("workflow_node.deploy.form.gitlab_variable_environment_scope.placeholder")} />
+          </Form.Item>
+        </Show>
+
+        <Form.Item
+          name="variableProtected"
+          label={t("workflow_node.deploy.form.gitlab_variable_protected.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_variable_protected.tooltip") }}></span>}
+        >
+          <Switch />
+        </Form.Item>
+      </Show>
+
+      <Show when={fieldResourceType === RESOURCE_TYPE_REPOSITORY_FILE}>
+        <Form.Item name="branch" label={t("workflow_node.deploy.form.gitlab_branch.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_branch.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="filePathForCertificate" label={t("workflow_node.deploy.form.gitlab_file_path_for_certificate.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_file_path_for_certificate.placeholder")} />
+        </Form.Item>
+
+        <Form.Item
+          name="filePathForPrivateKey"
+          label={t("workflow_node.deploy.form.gitlab_file_path_for_private_key.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.gitlab_file_path_for_private_key.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_file_path_for_private_key.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="commitMessage" label={t("workflow_node.deploy.form.gitlab_commit_message.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.gitlab_commit_message.placeholder")} />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormGitLabConfig;
@@ -0,0 +1,62 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormHAProxyConfigFieldValues = Nullish<{
+  certificateName: string;
+}>;
+
+export type DeployNodeConfigFormHAProxyConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormHAProxyConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormHAProxyConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormHAProxyConfigFieldValues => {
+  return {
+    certificateName: "certimate.pem",
+  };
+};
+
+const DeployNodeConfigFormHAProxyConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormHAProxyConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    certificateName: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.haproxy_certificate_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .refine((v) => !/[/\\]/.test(v), t("workflow_node.deploy.form.haproxy_certificate_name.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="certificateName"
+        label={t("workflow_node.deploy.form.haproxy_certificate_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.haproxy_certificate_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.haproxy_certificate_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormHAProxyConfig;
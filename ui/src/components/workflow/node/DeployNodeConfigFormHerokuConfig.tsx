@@ -0,0 +1,74 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormHerokuConfigFieldValues = Nullish<{
+  appName: string;
+  sniEndpointId?: string;
+}>;
+
+export type DeployNodeConfigFormHerokuConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormHerokuConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormHerokuConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormHerokuConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormHerokuConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormHerokuConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    appName: z
+      .string({ message: t("workflow_node.deploy.form.heroku_app_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.heroku_app_name.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    sniEndpointId: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="appName"
+        label={t("workflow_node.deploy.form.heroku_app_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.heroku_app_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.heroku_app_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="sniEndpointId"
+        label={t("workflow_node.deploy.form.heroku_sni_endpoint_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.heroku_sni_endpoint_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.heroku_sni_endpoint_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormHerokuConfig;
@@ -0,0 +1,144 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormJenkinsCredentialConfigFieldValues = Nullish<{
+  folderPath?: string;
+  credentialDomain?: string;
+  credentialId: string;
+  credentialScope?: string;
+  credentialDescription?: string;
+  keystorePassword: string;
+}>;
+
+export type DeployNodeConfigFormJenkinsCredentialConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormJenkinsCredentialConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormJenkinsCredentialConfigFieldValues) => void;
+};
+
+const SCOPE_GLOBAL = "GLOBAL" as const;
+const SCOPE_SYSTEM = "SYSTEM" as const;
+
+const initFormModel = (): DeployNodeConfigFormJenkinsCredentialConfigFieldValues => {
+  return {
+    credentialScope: SCOPE_GLOBAL,
+  };
+};
+
+const DeployNodeConfigFormJenkinsCredentialConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormJenkinsCredentialConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    folderPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    credentialDomain: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    credentialId: z
+      .string({ message: t("workflow_node.deploy.form.jenkins_credential_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.jenkins_credential_id.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    credentialScope: z.union([z.literal(SCOPE_GLOBAL), z.literal(SCOPE_SYSTEM)]).nullish(),
+    credentialDescription: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keystorePassword: z
+      .string({ message: t("workflow_node.deploy.form.jenkins_credential_keystore_password.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.jenkins_credential_keystore_password.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 })),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldFolderPath = Form.useWatch("folderPath", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="folderPath"
+        label={t("workflow_node.deploy.form.jenkins_credential_folder_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.jenkins_credential_folder_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.jenkins_credential_folder_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="credentialDomain"
+        label={t("workflow_node.deploy.form.jenkins_credential_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.jenkins_credential_domain.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.jenkins_credential_domain.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="credentialId"
+        label={t("workflow_node.deploy.form.jenkins_credential_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.jenkins_credential_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.jenkins_credential_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="credentialScope"
+        label={t("workflow_node.deploy.form.jenkins_credential_scope.label")}
+        rules={[formRule]}
+        hidden={!!fieldFolderPath}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.jenkins_credential_scope.tooltip") }}></span>}
+      >
+        <Select
+          options={[SCOPE_GLOBAL, SCOPE_SYSTEM].map((s) => ({
+            label: t(`workflow_node.deploy.form.jenkins_credential_scope.option.${s.toLowerCase()}.label`),
+            value: s,
+          }))}
+          placeholder={t("workflow_node.deploy.form.jenkins_credential_scope.placeholder")}
+        />
+      </Form.Item>
+
+      <Form.Item name="credentialDescription" label={t("workflow_node.deploy.form.jenkins_credential_description.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.jenkins_credential_description.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keystorePassword"
+        label={t("workflow_node.deploy.form.jenkins_credential_keystore_password.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.jenkins_credential_keystore_password.tooltip") }}></span>}
+      >
+        <Input.Password autoComplete="new-password" placeholder={t("workflow_node.deploy.form.jenkins_credential_keystore_password.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormJenkinsCredentialConfig;
@@ -0,0 +1,130 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, InputNumber, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validIPv4Address, validIPv6Address } from "@/utils/validators";
+
+type DeployNodeConfigFormKempLoadMasterConfigFieldValues = Nullish<{
+  certificateName: string;
+  intermediateName?: string;
+  virtualServiceAddress?: string;
+  virtualServicePort?: number;
+  virtualServiceProtocol?: string;
+}>;
+
+export type DeployNodeConfigFormKempLoadMasterConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormKempLoadMasterConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormKempLoadMasterConfigFieldValues) => void;
+};
+
+const PROTOCOL_TCP = "tcp" as const;
+const PROTOCOL_UDP = "udp" as const;
+
+const initFormModel = (): DeployNodeConfigFormKempLoadMasterConfigFieldValues => {
+  return {
+    certificateName: "certimate",
+    virtualServiceProtocol: PROTOCOL_TCP,
+  };
+};
+
+const DeployNodeConfigFormKempLoadMasterConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormKempLoadMasterConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    certificateName: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.kemp_loadmaster_certificate_name.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    intermediateName: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim()
+      .nullish(),
+    virtualServiceAddress: z
+      .string()
+      .nullish()
+      .refine((v) => !v || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.ip_invalid")),
+    virtualServicePort: z
+      .number()
+      .int()
+      .gte(1, t("common.errmsg.port_invalid"))
+      .lte(65535, t("common.errmsg.port_invalid"))
+      .nullish()
+      .refine((v) => !fieldVirtualServiceAddress || !!v, t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_port.placeholder")),
+    virtualServiceProtocol: z.union([z.literal(PROTOCOL_TCP), z.literal(PROTOCOL_UDP)]).nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldVirtualServiceAddress = Form.useWatch("virtualServiceAddress", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="certificateName"
+        label={t("workflow_node.deploy.form.kemp_loadmaster_certificate_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.kemp_loadmaster_certificate_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.kemp_loadmaster_certificate_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="intermediateName"
+        label={t("workflow_node.deploy.form.kemp_loadmaster_intermediate_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.kemp_loadmaster_intermediate_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.kemp_loadmaster_intermediate_name.placeholder")} />
+      </Form.Item>
+
+      <div className="flex space-x-2">
+        <div className="w-1/2">
+          <Form.Item
+            name="virtualServiceAddress"
+            label={t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_address.label")}
+            rules={[formRule]}
+            tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_address.tooltip") }}></span>}
+          >
+            <Input allowClear placeholder={t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_address.placeholder")} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/4">
+          <Form.Item name="virtualServicePort" label={t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_port.label")} rules={[formRule]}>
+            <InputNumber className="w-full" placeholder={t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_port.placeholder")} min={1} max={65535} />
+          </Form.Item>
+        </div>
+
+        <div className="w-1/4">
+          <Form.Item name="virtualServiceProtocol" label={t("workflow_node.deploy.form.kemp_loadmaster_virtual_service_protocol.label")} rules={[formRule]}>
+            <Select options={[PROTOCOL_TCP, PROTOCOL_UDP].map((s) => ({ label: s.toUpperCase(), value: s }))} />
+          </Form.Item>
+        </div>
+      </div>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormKempLoadMasterConfig;
@@ -0,0 +1,65 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormKeyCDNConfigFieldValues = Nullish<{
+  zoneAlias: string;
+}>;
+
+export type DeployNodeConfigFormKeyCDNConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormKeyCDNConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormKeyCDNConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormKeyCDNConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormKeyCDNConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormKeyCDNConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    zoneAlias: z
+      .string({ message: t("workflow_node.deploy.form.keycdn_zone_alias.placeholder") })
+      .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="zoneAlias"
+        label={t("workflow_node.deploy.form.keycdn_zone_alias.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.keycdn_zone_alias.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.keycdn_zone_alias.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormKeyCDNConfig;
@@ -0,0 +1,97 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormKubernetesHarborConfigFieldValues = Nullish<{
+  namespace: string;
+  secretName: string;
+  restartDeploymentName?: string;
+}>;
+
+export type DeployNodeConfigFormKubernetesHarborConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormKubernetesHarborConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormKubernetesHarborConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormKubernetesHarborConfigFieldValues => {
+  return {
+    namespace: "default",
+  };
+};
+
+const DeployNodeConfigFormKubernetesHarborConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormKubernetesHarborConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    namespace: z
+      .string({ message: t("workflow_node.deploy.form.k8s_namespace.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.k8s_namespace.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    secretName: z
+      .string({ message: t("workflow_node.deploy.form.k8s_harbor_secret_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.k8s_harbor_secret_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    restartDeploymentName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="namespace"
+        label={t("workflow_node.deploy.form.k8s_namespace.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_namespace.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.k8s_namespace.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="secretName"
+        label={t("workflow_node.deploy.form.k8s_harbor_secret_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_harbor_secret_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.k8s_harbor_secret_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="restartDeploymentName"
+        label={t("workflow_node.deploy.form.k8s_harbor_restart_deployment_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_harbor_restart_deployment_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.k8s_harbor_restart_deployment_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormKubernetesHarborConfig;
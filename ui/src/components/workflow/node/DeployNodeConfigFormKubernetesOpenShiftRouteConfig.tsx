@@ -0,0 +1,100 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormKubernetesOpenShiftRouteConfigFieldValues = Nullish<{
+  namespace: string;
+  routeName?: string;
+  labelSelector?: string;
+}>;
+
+export type DeployNodeConfigFormKubernetesOpenShiftRouteConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormKubernetesOpenShiftRouteConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormKubernetesOpenShiftRouteConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormKubernetesOpenShiftRouteConfigFieldValues => {
+  return {
+    namespace: "default",
+  };
+};
+
+const DeployNodeConfigFormKubernetesOpenShiftRouteConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormKubernetesOpenShiftRouteConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    namespace: z
+      .string({ message: t("workflow_node.deploy.form.k8s_namespace.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.k8s_namespace.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    routeName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => !!v?.trim() || !!fieldLabelSelector?.trim(), t("workflow_node.deploy.form.k8s_openshift_route_name.placeholder")),
+    labelSelector: z
+      .string()
+      .max(1024, t("common.errmsg.string_max", { max: 1024 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldLabelSelector = Form.useWatch("labelSelector", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="namespace"
+        label={t("workflow_node.deploy.form.k8s_namespace.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_namespace.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.k8s_namespace.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="routeName"
+        label={t("workflow_node.deploy.form.k8s_openshift_route_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_openshift_route_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.k8s_openshift_route_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="labelSelector"
+        label={t("workflow_node.deploy.form.k8s_openshift_route_label_selector.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.k8s_openshift_route_label_selector.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.k8s_openshift_route_label_selector.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormKubernetesOpenShiftRouteConfig;
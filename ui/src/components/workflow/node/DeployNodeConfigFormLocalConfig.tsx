@@ -332,7 +332,12 @@ Set-ItemProperty -Path $rdpCertPath -Name "SSLCertificateSHA1Hash" -Value "$thum
         </Select>
       </Form.Item>
 
-      <Form.Item name="preCommand" label={t("workflow_node.deploy.form.local_pre_command.label")} rules={[formRule]}>
+      <Form.Item
+        name="preCommand"
+        label={t("workflow_node.deploy.form.local_pre_command.label")}
+        extra={t("workflow_node.deploy.form.local_command_envs.extra")}
+        rules={[formRule]}
+      >
         <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("workflow_node.deploy.form.local_pre_command.placeholder")} />
       </Form.Item>
 
@@ -378,7 +383,7 @@ Set-ItemProperty -Path $rdpCertPath -Name "SSLCertificateSHA1Hash" -Value "$thum
             </div>
           </div>
         </label>
-        <Form.Item name="postCommand" rules={[formRule]}>
+        <Form.Item name="postCommand" extra={t("workflow_node.deploy.form.local_command_envs.extra")} rules={[formRule]}>
           <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("workflow_node.deploy.form.local_post_command.placeholder")} />
         </Form.Item>
       </Form.Item>
@@ -0,0 +1,72 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormMikrotikConfigFieldValues = Nullish<{
+  serviceNames: string;
+}>;
+
+export type DeployNodeConfigFormMikrotikConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormMikrotikConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormMikrotikConfigFieldValues) => void;
+};
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
+const initFormModel = (): DeployNodeConfigFormMikrotikConfigFieldValues => {
+  return {
+    serviceNames: "www-ssl",
+  };
+};
+
+const DeployNodeConfigFormMikrotikConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormMikrotikConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serviceNames: z
+      .string({ message: t("workflow_node.deploy.form.mikrotik_service_names.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.mikrotik_service_names.placeholder"))
+      .refine((v) => {
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => /^[a-z0-9-]+$/.test(e.trim()));
+      }, t("workflow_node.deploy.form.mikrotik_service_names.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serviceNames"
+        label={t("workflow_node.deploy.form.mikrotik_service_names.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.mikrotik_service_names.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.mikrotik_service_names.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormMikrotikConfig;
@@ -0,0 +1,113 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormNomadVariableConfigFieldValues = Nullish<{
+  namespace?: string;
+  variablePath: string;
+  keyForCertificate?: string;
+  keyForPrivateKey?: string;
+  keyForCertificateChain?: string;
+}>;
+
+export type DeployNodeConfigFormNomadVariableConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormNomadVariableConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormNomadVariableConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormNomadVariableConfigFieldValues => {
+  return {
+    namespace: "default",
+    variablePath: "nomad/jobs/",
+  };
+};
+
+const DeployNodeConfigFormNomadVariableConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormNomadVariableConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    namespace: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    variablePath: z
+      .string({ message: t("workflow_node.deploy.form.nomad_variable_path.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.nomad_variable_path.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    keyForCertificate: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForPrivateKey: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForCertificateChain: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="namespace"
+        label={t("workflow_node.deploy.form.nomad_namespace.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.nomad_namespace.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.nomad_namespace.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="variablePath"
+        label={t("workflow_node.deploy.form.nomad_variable_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.nomad_variable_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.nomad_variable_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForCertificate" label={t("workflow_node.deploy.form.nomad_key_for_certificate.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.nomad_key_for_certificate.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForPrivateKey" label={t("workflow_node.deploy.form.nomad_key_for_private_key.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.nomad_key_for_private_key.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="keyForCertificateChain" label={t("workflow_node.deploy.form.nomad_key_for_certificate_chain.label")} rules={[formRule]}>
+        <Input placeholder={t("workflow_node.deploy.form.nomad_key_for_certificate_chain.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormNomadVariableConfig;
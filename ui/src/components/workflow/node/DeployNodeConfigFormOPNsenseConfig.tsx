@@ -0,0 +1,85 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormOPNsenseConfigFieldValues = Nullish<{
+  certificateName: string;
+  restartWebGUI?: boolean;
+  reloadHAProxy?: boolean;
+}>;
+
+export type DeployNodeConfigFormOPNsenseConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormOPNsenseConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormOPNsenseConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormOPNsenseConfigFieldValues => {
+  return {
+    certificateName: "certimate",
+    restartWebGUI: true,
+    reloadHAProxy: false,
+  };
+};
+
+const DeployNodeConfigFormOPNsenseConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormOPNsenseConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    certificateName: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.opnsense_certificate_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    restartWebGUI: z.boolean().nullish(),
+    reloadHAProxy: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="certificateName"
+        label={t("workflow_node.deploy.form.opnsense_certificate_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.opnsense_certificate_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.opnsense_certificate_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="restartWebGUI"
+        label={t("workflow_node.deploy.form.opnsense_restart_webgui.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.opnsense_restart_webgui.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="reloadHAProxy"
+        label={t("workflow_node.deploy.form.opnsense_reload_haproxy.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.opnsense_reload_haproxy.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormOPNsenseConfig;
@@ -0,0 +1,79 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormOVHcloudIPLBConfigFieldValues = Nullish<{
+  serviceName: string;
+  frontendId?: string | number;
+}>;
+
+export type DeployNodeConfigFormOVHcloudIPLBConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormOVHcloudIPLBConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormOVHcloudIPLBConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormOVHcloudIPLBConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormOVHcloudIPLBConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormOVHcloudIPLBConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serviceName: z
+      .string({ message: t("workflow_node.deploy.form.ovhcloud_iplb_service_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.ovhcloud_iplb_service_name.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    frontendId: z
+      .union([z.string(), z.number()])
+      .nullish()
+      .refine((v) => v == null || v === "" || (/^\d+$/.test(v + "") && +v > 0), t("workflow_node.deploy.form.ovhcloud_iplb_frontend_id.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serviceName"
+        label={t("workflow_node.deploy.form.ovhcloud_iplb_service_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ovhcloud_iplb_service_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ovhcloud_iplb_service_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="frontendId"
+        label={t("workflow_node.deploy.form.ovhcloud_iplb_frontend_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ovhcloud_iplb_frontend_id.tooltip") }}></span>}
+      >
+        <Input type="number" allowClear placeholder={t("workflow_node.deploy.form.ovhcloud_iplb_frontend_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormOVHcloudIPLBConfig;
@@ -0,0 +1,65 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormOVHcloudWebHostingConfigFieldValues = Nullish<{
+  serviceName: string;
+}>;
+
+export type DeployNodeConfigFormOVHcloudWebHostingConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormOVHcloudWebHostingConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormOVHcloudWebHostingConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormOVHcloudWebHostingConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormOVHcloudWebHostingConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormOVHcloudWebHostingConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serviceName: z
+      .string({ message: t("workflow_node.deploy.form.ovhcloud_webhosting_service_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.ovhcloud_webhosting_service_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serviceName"
+        label={t("workflow_node.deploy.form.ovhcloud_webhosting_service_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ovhcloud_webhosting_service_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ovhcloud_webhosting_service_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormOVHcloudWebHostingConfig;
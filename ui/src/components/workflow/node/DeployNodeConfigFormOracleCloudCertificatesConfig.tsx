@@ -0,0 +1,98 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormOracleCloudCertificatesConfigFieldValues = Nullish<{
+  region: string;
+  compartmentId?: string;
+  certificateId?: string;
+}>;
+
+export type DeployNodeConfigFormOracleCloudCertificatesConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormOracleCloudCertificatesConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormOracleCloudCertificatesConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormOracleCloudCertificatesConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormOracleCloudCertificatesConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormOracleCloudCertificatesConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z
+      .string({ message: t("workflow_node.deploy.form.oraclecloud_certificates_region.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.oraclecloud_certificates_region.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    compartmentId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => !!v?.trim() || !!fieldCertificateId?.trim(), t("workflow_node.deploy.form.oraclecloud_certificates_compartment_id.placeholder")),
+    certificateId: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldCertificateId = Form.useWatch("certificateId", formInst);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.oraclecloud_certificates_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_certificates_region.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.oraclecloud_certificates_region.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certificateId"
+        label={t("workflow_node.deploy.form.oraclecloud_certificates_certificate_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_certificates_certificate_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.oraclecloud_certificates_certificate_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="compartmentId"
+        label={t("workflow_node.deploy.form.oraclecloud_certificates_compartment_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_certificates_compartment_id.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.oraclecloud_certificates_compartment_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormOracleCloudCertificatesConfig;
@@ -0,0 +1,95 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormOracleCloudLBConfigFieldValues = Nullish<{
+  region: string;
+  loadbalancerId: string;
+  listenerName: string;
+}>;
+
+export type DeployNodeConfigFormOracleCloudLBConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormOracleCloudLBConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormOracleCloudLBConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormOracleCloudLBConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormOracleCloudLBConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormOracleCloudLBConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z
+      .string({ message: t("workflow_node.deploy.form.oraclecloud_lb_region.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.oraclecloud_lb_region.placeholder"))
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .trim(),
+    loadbalancerId: z
+      .string({ message: t("workflow_node.deploy.form.oraclecloud_lb_loadbalancer_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.oraclecloud_lb_loadbalancer_id.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    listenerName: z
+      .string({ message: t("workflow_node.deploy.form.oraclecloud_lb_listener_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.oraclecloud_lb_listener_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.oraclecloud_lb_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_lb_region.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.oraclecloud_lb_region.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="loadbalancerId"
+        label={t("workflow_node.deploy.form.oraclecloud_lb_loadbalancer_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_lb_loadbalancer_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.oraclecloud_lb_loadbalancer_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="listenerName"
+        label={t("workflow_node.deploy.form.oraclecloud_lb_listener_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.oraclecloud_lb_listener_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.oraclecloud_lb_listener_name.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormOracleCloudLBConfig;
@@ -0,0 +1,79 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormPleskConfigFieldValues = Nullish<{
+  domainName: string;
+  assignToHosting?: boolean;
+  assignToMail?: boolean;
+}>;
+
+export type DeployNodeConfigFormPleskConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormPleskConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormPleskConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormPleskConfigFieldValues => {
+  return {
+    assignToHosting: true,
+    assignToMail: false,
+  };
+};
+
+const DeployNodeConfigFormPleskConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormPleskConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    domainName: z
+      .string({ message: t("workflow_node.deploy.form.plesk_domain_name.placeholder") })
+      .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
+    assignToHosting: z.boolean().nullish(),
+    assignToMail: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="domainName"
+        label={t("workflow_node.deploy.form.plesk_domain_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.plesk_domain_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.plesk_domain_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="assignToHosting" label={t("workflow_node.deploy.form.plesk_assign_to_hosting.label")} rules={[formRule]}>
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="assignToMail"
+        label={t("workflow_node.deploy.form.plesk_assign_to_mail.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.plesk_assign_to_mail.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormPleskConfig;
@@ -0,0 +1,67 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormRancherHarvesterConfigFieldValues = Nullish<{
+  clusterId: string;
+}>;
+
+export type DeployNodeConfigFormRancherHarvesterConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormRancherHarvesterConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormRancherHarvesterConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormRancherHarvesterConfigFieldValues => {
+  return {
+    clusterId: "local",
+  };
+};
+
+const DeployNodeConfigFormRancherHarvesterConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormRancherHarvesterConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    clusterId: z
+      .string({ message: t("workflow_node.deploy.form.rancher_cluster_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.rancher_cluster_id.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="clusterId"
+        label={t("workflow_node.deploy.form.rancher_cluster_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_cluster_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.rancher_cluster_id.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormRancherHarvesterConfig;
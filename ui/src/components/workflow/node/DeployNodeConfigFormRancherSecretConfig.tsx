@@ -0,0 +1,133 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormRancherSecretConfigFieldValues = Nullish<{
+  clusterId: string;
+  projectIds?: string;
+  namespace: string;
+  secretName: string;
+  allowPartialFailure?: boolean;
+}>;
+
+export type DeployNodeConfigFormRancherSecretConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormRancherSecretConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormRancherSecretConfigFieldValues) => void;
+};
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
+const initFormModel = (): DeployNodeConfigFormRancherSecretConfigFieldValues => {
+  return {
+    clusterId: "local",
+    namespace: "cattle-system",
+    secretName: "tls-rancher-ingress",
+  };
+};
+
+const DeployNodeConfigFormRancherSecretConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormRancherSecretConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    clusterId: z
+      .string({ message: t("workflow_node.deploy.form.rancher_secret_cluster_ids.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.rancher_secret_cluster_ids.placeholder"))
+      .max(1024, t("common.errmsg.string_max", { max: 1024 }))
+      .trim(),
+    projectIds: z
+      .string()
+      .max(1024, t("common.errmsg.string_max", { max: 1024 }))
+      .nullish()
+      .refine((v) => {
+        if (!v) return true;
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .filter((e) => !!e)
+          .every((e) => /^[^:]+:[^:]+$/.test(e.trim()));
+      }, t("workflow_node.deploy.form.rancher_secret_project_ids.errmsg.invalid")),
+    namespace: z
+      .string({ message: t("workflow_node.deploy.form.rancher_secret_namespace.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.rancher_secret_namespace.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    secretName: z
+      .string({ message: t("workflow_node.deploy.form.rancher_secret_name.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.rancher_secret_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    allowPartialFailure: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="clusterId"
+        label={t("workflow_node.deploy.form.rancher_secret_cluster_ids.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_secret_cluster_ids.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.rancher_secret_cluster_ids.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="projectIds"
+        label={t("workflow_node.deploy.form.rancher_secret_project_ids.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_secret_project_ids.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.rancher_secret_project_ids.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="namespace"
+        label={t("workflow_node.deploy.form.rancher_secret_namespace.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_secret_namespace.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.rancher_secret_namespace.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="secretName"
+        label={t("workflow_node.deploy.form.rancher_secret_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_secret_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.rancher_secret_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="allowPartialFailure"
+        label={t("workflow_node.deploy.form.rancher_secret_allow_partial_failure.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.rancher_secret_allow_partial_failure.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormRancherSecretConfig;
@@ -1,11 +1,12 @@
 import { useTranslation } from "react-i18next";
-import { DownOutlined as DownOutlinedIcon } from "@ant-design/icons";
-import { Button, Dropdown, Form, type FormInstance, Input, Select, Switch } from "antd";
+import { CloseOutlined as CloseOutlinedIcon, DownOutlined as DownOutlinedIcon, PlusOutlined as PlusOutlinedIcon } from "@ant-design/icons";
+import { Button, Card, Dropdown, Form, type FormInstance, Input, InputNumber, Select, Switch } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
 import Show from "@/components/Show";
 import { CERTIFICATE_FORMATS } from "@/domain/certificate";
+import { validDomainName, validIPv4Address, validIPv6Address, validPortNumber } from "@/utils/validators";
 
 type DeployNodeConfigFormSSHConfigFieldValues = Nullish<{
   format: string;
@@ -18,8 +19,24 @@ type DeployNodeConfigFormSSHConfigFieldValues = Nullish<{
   preCommand?: string | null;
   postCommand?: string | null;
   useSCP?: boolean;
+  useSudo?: boolean;
+  sudoPassword?: string | null;
+  hosts?: DeployNodeConfigFormSSHConfigHostFieldValues[] | null;
+  allowPartialFailure?: boolean;
 }>;
 
+type DeployNodeConfigFormSSHConfigHostFieldValues = {
+  sshHost: string;
+  sshPort?: number | null;
+  sshUsername?: string | null;
+  sshPassword?: string | null;
+  sshKey?: string | null;
+  sshKeyPassphrase?: string | null;
+  outputCertPath?: string | null;
+  outputKeyPath?: string | null;
+  postCommand?: string | null;
+};
+
 export type DeployNodeConfigFormSSHConfigProps = {
   form: FormInstance;
   formName: string;
@@ -91,11 +108,64 @@ const DeployNodeConfigFormSSHConfig = ({ form: formInst, formName, disabled, ini
       .max(20480, t("common.errmsg.string_max", { max: 20480 }))
       .nullish(),
     useSCP: z.boolean().nullish(),
+    useSudo: z.boolean().nullish(),
+    sudoPassword: z
+      .string()
+      .max(64, t("common.errmsg.string_max", { max: 64 }))
+      .nullish(),
+    hosts: z
+      .array(
+        z.object({
+          sshHost: z.string().refine((v) => validDomainName(v) || validIPv4Address(v) || validIPv6Address(v), t("common.errmsg.host_invalid")),
+          sshPort: z.preprocess(
+            (v) => (v == null || v === "" ? undefined : Number(v)),
+            z
+              .number()
+              .refine((v) => validPortNumber(v), t("common.errmsg.port_invalid"))
+              .nullish()
+          ),
+          sshUsername: z
+            .string()
+            .max(64, t("common.errmsg.string_max", { max: 64 }))
+            .trim()
+            .nullish(),
+          sshPassword: z
+            .string()
+            .max(64, t("common.errmsg.string_max", { max: 64 }))
+            .nullish(),
+          sshKey: z
+            .string()
+            .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+            .nullish(),
+          sshKeyPassphrase: z
+            .string()
+            .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+            .nullish(),
+          outputCertPath: z
+            .string()
+            .max(256, t("common.errmsg.string_max", { max: 256 }))
+            .trim()
+            .nullish(),
+          outputKeyPath: z
+            .string()
+            .max(256, t("common.errmsg.string_max", { max: 256 }))
+            .trim()
+            .nullish(),
+          postCommand: z
+            .string()
+            .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+            .nullish(),
+        })
+      )
+      .nullish(),
+    allowPartialFailure: z.boolean().nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
   const fieldFormat = Form.useWatch("format", formInst);
   const fieldCertPath = Form.useWatch("certPath", formInst);
+  const fieldUseSudo = Form.useWatch("useSudo", formInst);
+  const fieldHosts = Form.useWatch("hosts", formInst);
 
   const handleFormatSelect = (value: string) => {
     if (fieldFormat === value) return;
@@ -134,6 +204,118 @@ const DeployNodeConfigFormSSHConfig = ({ form: formInst, formName, disabled, ini
           formInst.setFieldValue("postCommand", "sudo service nginx reload");
         }
         break;
+
+      case "reload_teleport":
+        {
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 teleport.yaml 中 proxy_service.https_keypairs 的 cert_file 和 key_file 指向上方的证书和私钥文件路径
+sudo systemctl reload teleport`.trim()
+          );
+        }
+        break;
+
+      case "replace_openvpnas":
+        {
+          const certPath = formInst.getFieldValue("certPath") || "<your-cert-path>";
+          const keyPath = formInst.getFieldValue("keyPath") || "<your-key-path>";
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue(
+            "postCommand",
+            `# 将证书和私钥写入 OpenVPN Access Server 的配置数据库，并重启 Web 服务
+sudo /usr/local/openvpn_as/scripts/sacli --key "cs.priv_key" --value_file "${keyPath}" ConfigPut
+sudo /usr/local/openvpn_as/scripts/sacli --key "cs.cert" --value_file "${certPath}" ConfigPut
+sudo /usr/local/openvpn_as/scripts/sacli start`.trim()
+          );
+        }
+        break;
+
+      case "replace_jellyfin":
+        {
+          formInst.setFieldValue("format", FORMAT_PFX);
+          formInst.setFieldValue("certPath", "/etc/jellyfin/ssl/jellyfin.pfx");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 Jellyfin 控制台“网络”设置中已启用 HTTPS，且“自定义 SSL 证书路径”指向上方的证书文件路径
+sudo chown jellyfin:jellyfin /etc/jellyfin/ssl/jellyfin.pfx
+sudo systemctl restart jellyfin`.trim()
+          );
+        }
+        break;
+
+      case "replace_emby":
+        {
+          formInst.setFieldValue("format", FORMAT_PFX);
+          formInst.setFieldValue("certPath", "/var/lib/emby/ssl/emby.pfx");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 Emby 控制台“网络”设置中的“自定义 SSL 证书路径”指向上方的证书文件路径
+sudo chown emby:emby /var/lib/emby/ssl/emby.pfx
+sudo systemctl restart emby-server`.trim()
+          );
+        }
+        break;
+
+      case "replace_homeassistant":
+        {
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue("certPath", "/ssl/fullchain.pem");
+          formInst.setFieldValue("keyPath", "/ssl/privkey.pem");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 configuration.yaml 中 http.ssl_certificate 和 http.ssl_key 指向上方的证书和私钥文件路径
+# 适用于 Home Assistant OS 的 SSH 加载项；如为容器部署，请改为重启对应的容器
+ha core restart`.trim()
+          );
+        }
+        break;
+
+      case "replace_grafana":
+        {
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue("certPath", "/etc/grafana/ssl/grafana.crt");
+          formInst.setFieldValue("keyPath", "/etc/grafana/ssl/grafana.key");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 grafana.ini 中 [server] 的 protocol 为 https，且 cert_file 和 cert_key 指向上方的证书和私钥文件路径
+sudo chown -R root:grafana /etc/grafana/ssl
+sudo chmod 640 /etc/grafana/ssl/grafana.key
+sudo systemctl restart grafana-server`.trim()
+          );
+        }
+        break;
+
+      case "replace_kibana":
+        {
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue("certPath", "/etc/kibana/certs/kibana.crt");
+          formInst.setFieldValue("keyPath", "/etc/kibana/certs/kibana.key");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 kibana.yml 中 server.ssl.enabled 为 true，且 server.ssl.certificate 和 server.ssl.key 指向上方的证书和私钥文件路径
+sudo chown -R root:kibana /etc/kibana/certs
+sudo chmod 640 /etc/kibana/certs/kibana.key
+sudo systemctl restart kibana`.trim()
+          );
+        }
+        break;
+
+      case "replace_zabbix":
+        {
+          formInst.setFieldValue("format", FORMAT_PEM);
+          formInst.setFieldValue("certPath", "/etc/zabbix/ssl/zabbix.crt");
+          formInst.setFieldValue("keyPath", "/etc/zabbix/ssl/zabbix.key");
+          formInst.setFieldValue(
+            "postCommand",
+            `# 请确保 Zabbix 前端所在的 Web 服务器（nginx 或 Apache）的 SSL 配置指向上方的证书和私钥文件路径
+sudo chmod 600 /etc/zabbix/ssl/zabbix.key
+if systemctl is-active --quiet nginx; then sudo systemctl reload nginx; fi
+if systemctl is-active --quiet apache2; then sudo systemctl reload apache2; fi
+if systemctl is-active --quiet httpd; then sudo systemctl reload httpd; fi`.trim()
+          );
+        }
+        break;
     }
   };
 
@@ -247,6 +429,46 @@ const DeployNodeConfigFormSSHConfig = ({ form: formInst, formName, disabled, ini
                       label: t("workflow_node.deploy.form.ssh_preset_scripts.option.reload_nginx.label"),
                       onClick: () => handlePresetScriptClick("reload_nginx"),
                     },
+                    {
+                      key: "reload_teleport",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.reload_teleport.label"),
+                      onClick: () => handlePresetScriptClick("reload_teleport"),
+                    },
+                    {
+                      key: "replace_openvpnas",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_openvpnas.label"),
+                      onClick: () => handlePresetScriptClick("replace_openvpnas"),
+                    },
+                    {
+                      key: "replace_jellyfin",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_jellyfin.label"),
+                      onClick: () => handlePresetScriptClick("replace_jellyfin"),
+                    },
+                    {
+                      key: "replace_emby",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_emby.label"),
+                      onClick: () => handlePresetScriptClick("replace_emby"),
+                    },
+                    {
+                      key: "replace_homeassistant",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_homeassistant.label"),
+                      onClick: () => handlePresetScriptClick("replace_homeassistant"),
+                    },
+                    {
+                      key: "replace_grafana",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_grafana.label"),
+                      onClick: () => handlePresetScriptClick("replace_grafana"),
+                    },
+                    {
+                      key: "replace_kibana",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_kibana.label"),
+                      onClick: () => handlePresetScriptClick("replace_kibana"),
+                    },
+                    {
+                      key: "replace_zabbix",
+                      label: t("workflow_node.deploy.form.ssh_preset_scripts.option.replace_zabbix.label"),
+                      onClick: () => handlePresetScriptClick("replace_zabbix"),
+                    },
                   ],
                 }}
                 trigger={["click"]}
@@ -272,6 +494,136 @@ const DeployNodeConfigFormSSHConfig = ({ form: formInst, formName, disabled, ini
       >
         <Switch />
       </Form.Item>
+
+      <Form.Item
+        name="useSudo"
+        label={t("workflow_node.deploy.form.ssh_use_sudo.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_use_sudo.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Show when={!!fieldUseSudo}>
+        <Form.Item
+          name="sudoPassword"
+          label={t("workflow_node.deploy.form.ssh_sudo_password.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_sudo_password.tooltip") }}></span>}
+        >
+          <Input.Password autoComplete="new-password" placeholder={t("workflow_node.deploy.form.ssh_sudo_password.placeholder")} />
+        </Form.Item>
+      </Show>
+
+      <Form.Item
+        label={t("workflow_node.deploy.form.ssh_hosts.label")}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_hosts.tooltip") }}></span>}
+      >
+        <Form.List name="hosts">
+          {(fields, { add, remove }) => (
+            <div className="flex flex-col gap-2">
+              {fields.map((field) => (
+                <Card
+                  key={field.key}
+                  size="small"
+                  title={t("workflow_node.deploy.form.ssh_hosts.item.title", { index: field.name + 1 })}
+                  extra={<Button icon={<CloseOutlinedIcon />} size="small" type="text" onClick={() => remove(field.name)} />}
+                >
+                  <div className="flex space-x-2">
+                    <div className="w-2/3">
+                      <Form.Item name={[field.name, "sshHost"]} label={t("workflow_node.deploy.form.ssh_hosts_host.label")} rules={[formRule]}>
+                        <Input placeholder={t("workflow_node.deploy.form.ssh_hosts_host.placeholder")} />
+                      </Form.Item>
+                    </div>
+                    <div className="w-1/3">
+                      <Form.Item name={[field.name, "sshPort"]} label={t("workflow_node.deploy.form.ssh_hosts_port.label")} rules={[formRule]}>
+                        <InputNumber className="w-full" placeholder={t("workflow_node.deploy.form.ssh_hosts_port.placeholder")} min={1} max={65535} />
+                      </Form.Item>
+                    </div>
+                  </div>
+
+                  <div className="flex space-x-2">
+                    <div className="w-1/2">
+                      <Form.Item name={[field.name, "sshUsername"]} label={t("workflow_node.deploy.form.ssh_hosts_username.label")} rules={[formRule]}>
+                        <Input placeholder={t("workflow_node.deploy.form.ssh_hosts_username.placeholder")} />
+                      </Form.Item>
+                    </div>
+                    <div className="w-1/2">
+                      <Form.Item
+                        name={[field.name, "sshPassword"]}
+                        label={t("workflow_node.deploy.form.ssh_hosts_password.label")}
+                        rules={[formRule]}
+                        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_hosts_credentials.tooltip") }}></span>}
+                      >
+                        <Input.Password autoComplete="new-password" placeholder={t("workflow_node.deploy.form.ssh_hosts_password.placeholder")} />
+                      </Form.Item>
+                    </div>
+                  </div>
+
+                  <div className="flex space-x-2">
+                    <div className="w-1/2">
+                      <Form.Item
+                        name={[field.name, "sshKey"]}
+                        label={t("workflow_node.deploy.form.ssh_hosts_key.label")}
+                        rules={[formRule]}
+                        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_hosts_credentials.tooltip") }}></span>}
+                      >
+                        <Input.TextArea
+                          autoComplete="new-password"
+                          autoSize={{ minRows: 1, maxRows: 5 }}
+                          placeholder={t("workflow_node.deploy.form.ssh_hosts_key.placeholder")}
+                        />
+                      </Form.Item>
+                    </div>
+                    <div className="w-1/2">
+                      <Form.Item
+                        name={[field.name, "sshKeyPassphrase"]}
+                        label={t("workflow_node.deploy.form.ssh_hosts_key_passphrase.label")}
+                        rules={[formRule]}
+                      >
+                        <Input.Password autoComplete="new-password" placeholder={t("workflow_node.deploy.form.ssh_hosts_key_passphrase.placeholder")} />
+                      </Form.Item>
+                    </div>
+                  </div>
+
+                  <Form.Item name={[field.name, "outputCertPath"]} label={t("workflow_node.deploy.form.ssh_hosts_cert_path.label")} rules={[formRule]}>
+                    <Input placeholder={t("workflow_node.deploy.form.ssh_hosts_cert_path.placeholder")} />
+                  </Form.Item>
+
+                  <Show when={fieldFormat === FORMAT_PEM}>
+                    <Form.Item name={[field.name, "outputKeyPath"]} label={t("workflow_node.deploy.form.ssh_hosts_key_path.label")} rules={[formRule]}>
+                      <Input placeholder={t("workflow_node.deploy.form.ssh_hosts_key_path.placeholder")} />
+                    </Form.Item>
+                  </Show>
+
+                  <Form.Item
+                    className="mb-0"
+                    name={[field.name, "postCommand"]}
+                    label={t("workflow_node.deploy.form.ssh_hosts_post_command.label")}
+                    rules={[formRule]}
+                  >
+                    <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("workflow_node.deploy.form.ssh_hosts_post_command.placeholder")} />
+                  </Form.Item>
+                </Card>
+              ))}
+              <Button block icon={<PlusOutlinedIcon />} type="dashed" onClick={() => add({ sshHost: "" })}>
+                {t("workflow_node.deploy.form.ssh_hosts.button")}
+              </Button>
+            </div>
+          )}
+        </Form.List>
+      </Form.Item>
+
+      <Show when={!!fieldHosts?.length}>
+        <Form.Item
+          name="allowPartialFailure"
+          label={t("workflow_node.deploy.form.ssh_allow_partial_failure.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_allow_partial_failure.tooltip") }}></span>}
+        >
+          <Switch />
+        </Form.Item>
+      </Show>
     </Form>
   );
 };
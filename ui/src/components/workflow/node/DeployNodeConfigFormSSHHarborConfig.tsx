@@ -0,0 +1,104 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormSSHHarborConfigFieldValues = Nullish<{
+  harborDir: string;
+  certPath?: string;
+  keyPath?: string;
+  useSCP?: boolean;
+}>;
+
+export type DeployNodeConfigFormSSHHarborConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormSSHHarborConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormSSHHarborConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormSSHHarborConfigFieldValues => {
+  return {
+    harborDir: "/opt/harbor",
+    certPath: "/data/secret/cert/server.crt",
+    keyPath: "/data/secret/cert/server.key",
+  };
+};
+
+const DeployNodeConfigFormSSHHarborConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormSSHHarborConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    harborDir: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.ssh_harbor_dir.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    certPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    useSCP: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="harborDir"
+        label={t("workflow_node.deploy.form.ssh_harbor_dir.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_harbor_dir.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ssh_harbor_dir.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="certPath"
+        label={t("workflow_node.deploy.form.ssh_harbor_cert_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_harbor_cert_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ssh_harbor_cert_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="keyPath"
+        label={t("workflow_node.deploy.form.ssh_harbor_key_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_harbor_key_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ssh_harbor_key_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="useSCP"
+        label={t("workflow_node.deploy.form.ssh_use_scp.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_use_scp.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormSSHHarborConfig;
@@ -0,0 +1,111 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormSSHMailServerConfigFieldValues = Nullish<{
+  postfixCertPath?: string;
+  postfixKeyPath?: string;
+  dovecotCertPath?: string;
+  dovecotKeyPath?: string;
+  useSCP?: boolean;
+}>;
+
+export type DeployNodeConfigFormSSHMailServerConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormSSHMailServerConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormSSHMailServerConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormSSHMailServerConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormSSHMailServerConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormSSHMailServerConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    postfixCertPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    postfixKeyPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    dovecotCertPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    dovecotKeyPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    useSCP: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="postfixCertPath"
+        label={t("workflow_node.deploy.form.ssh_mailserver_postfix_cert_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_mailserver_postfix_cert_path.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.ssh_mailserver_postfix_cert_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="postfixKeyPath"
+        label={t("workflow_node.deploy.form.ssh_mailserver_postfix_key_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_mailserver_postfix_key_path.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.ssh_mailserver_postfix_key_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="dovecotCertPath"
+        label={t("workflow_node.deploy.form.ssh_mailserver_dovecot_cert_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_mailserver_dovecot_cert_path.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.ssh_mailserver_dovecot_cert_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="dovecotKeyPath"
+        label={t("workflow_node.deploy.form.ssh_mailserver_dovecot_key_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_mailserver_dovecot_key_path.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.ssh_mailserver_dovecot_key_path.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="useSCP"
+        label={t("workflow_node.deploy.form.ssh_use_scp.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_use_scp.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormSSHMailServerConfig;
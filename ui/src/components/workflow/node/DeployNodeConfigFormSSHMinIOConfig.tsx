@@ -0,0 +1,102 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormSSHMinIOConfigFieldValues = Nullish<{
+  certsDir?: string;
+  domain?: string;
+  postCommand?: string;
+  useSCP?: boolean;
+}>;
+
+export type DeployNodeConfigFormSSHMinIOConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormSSHMinIOConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormSSHMinIOConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormSSHMinIOConfigFieldValues => {
+  return {
+    certsDir: "/root/.minio/certs",
+    postCommand: "sudo systemctl restart minio",
+  };
+};
+
+const DeployNodeConfigFormSSHMinIOConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormSSHMinIOConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    certsDir: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    domain: z
+      .string()
+      .nullish()
+      .refine((v) => !v || validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    postCommand: z
+      .string()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+    useSCP: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="certsDir"
+        label={t("workflow_node.deploy.form.ssh_minio_certs_dir.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_minio_certs_dir.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.ssh_minio_certs_dir.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="domain"
+        label={t("workflow_node.deploy.form.ssh_minio_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_minio_domain.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.ssh_minio_domain.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="postCommand"
+        label={t("workflow_node.deploy.form.ssh_minio_post_command.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_minio_post_command.tooltip") }}></span>}
+      >
+        <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("workflow_node.deploy.form.ssh_minio_post_command.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="useSCP"
+        label={t("workflow_node.deploy.form.ssh_use_scp.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.ssh_use_scp.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormSSHMinIOConfig;
@@ -0,0 +1,79 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormSophosFirewallConfigFieldValues = Nullish<{
+  certificateNamePrefix: string;
+  bindWebAdmin?: boolean;
+}>;
+
+export type DeployNodeConfigFormSophosFirewallConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormSophosFirewallConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormSophosFirewallConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormSophosFirewallConfigFieldValues => {
+  return {
+    certificateNamePrefix: "certimate",
+  };
+};
+
+const DeployNodeConfigFormSophosFirewallConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormSophosFirewallConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    certificateNamePrefix: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.sophos_firewall_certificate_name_prefix.placeholder"))
+      .max(32, t("common.errmsg.string_max", { max: 32 }))
+      .trim()
+      .refine((v) => /^[A-Za-z0-9_]+$/.test(v), t("workflow_node.deploy.form.sophos_firewall_certificate_name_prefix.placeholder")),
+    bindWebAdmin: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="certificateNamePrefix"
+        label={t("workflow_node.deploy.form.sophos_firewall_certificate_name_prefix.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.sophos_firewall_certificate_name_prefix.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.sophos_firewall_certificate_name_prefix.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="bindWebAdmin"
+        label={t("workflow_node.deploy.form.sophos_firewall_bind_web_admin.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.sophos_firewall_bind_web_admin.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormSophosFirewallConfig;
@@ -0,0 +1,93 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormTencentCloudAPIGatewayConfigFieldValues = Nullish<{
+  region: string;
+  serviceId: string;
+  subDomain: string;
+}>;
+
+export type DeployNodeConfigFormTencentCloudAPIGatewayConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormTencentCloudAPIGatewayConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormTencentCloudAPIGatewayConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormTencentCloudAPIGatewayConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormTencentCloudAPIGatewayConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormTencentCloudAPIGatewayConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    region: z
+      .string({ message: t("workflow_node.deploy.form.tencentcloud_apigateway_region.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.tencentcloud_apigateway_region.placeholder"))
+      .trim(),
+    serviceId: z
+      .string({ message: t("workflow_node.deploy.form.tencentcloud_apigateway_service_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.tencentcloud_apigateway_service_id.placeholder"))
+      .trim(),
+    subDomain: z
+      .string({ message: t("workflow_node.deploy.form.tencentcloud_apigateway_sub_domain.placeholder") })
+      .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="region"
+        label={t("workflow_node.deploy.form.tencentcloud_apigateway_region.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.tencentcloud_apigateway_region.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.tencentcloud_apigateway_region.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="serviceId"
+        label={t("workflow_node.deploy.form.tencentcloud_apigateway_service_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.tencentcloud_apigateway_service_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.tencentcloud_apigateway_service_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="subDomain"
+        label={t("workflow_node.deploy.form.tencentcloud_apigateway_sub_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.tencentcloud_apigateway_sub_domain.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.tencentcloud_apigateway_sub_domain.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormTencentCloudAPIGatewayConfig;
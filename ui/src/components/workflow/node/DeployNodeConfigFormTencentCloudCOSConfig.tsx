@@ -1,5 +1,5 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
@@ -8,6 +8,7 @@ import { validDomainName } from "@/utils/validators";
 type DeployNodeConfigFormTencentCloudCOSConfigFieldValues = Nullish<{
   region: string;
   bucket: string;
+  domainType?: string;
   domain: string;
 }>;
 
@@ -19,8 +20,13 @@ export type DeployNodeConfigFormTencentCloudCOSConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormTencentCloudCOSConfigFieldValues) => void;
 };
 
+const DOMAIN_TYPE_ORIGIN = "origin" as const;
+const DOMAIN_TYPE_CDN = "cdn" as const;
+
 const initFormModel = (): DeployNodeConfigFormTencentCloudCOSConfigFieldValues => {
-  return {};
+  return {
+    domainType: DOMAIN_TYPE_ORIGIN,
+  };
 };
 
 const DeployNodeConfigFormTencentCloudCOSConfig = ({
@@ -41,6 +47,7 @@ const DeployNodeConfigFormTencentCloudCOSConfig = ({
       .string({ message: t("workflow_node.deploy.form.tencentcloud_cos_bucket.placeholder") })
       .nonempty(t("workflow_node.deploy.form.tencentcloud_cos_bucket.placeholder"))
       .trim(),
+    domainType: z.enum([DOMAIN_TYPE_ORIGIN, DOMAIN_TYPE_CDN]).nullish(),
     domain: z
       .string({ message: t("workflow_node.deploy.form.tencentcloud_cos_domain.placeholder") })
       .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
@@ -78,6 +85,22 @@ const DeployNodeConfigFormTencentCloudCOSConfig = ({
         <Input placeholder={t("workflow_node.deploy.form.tencentcloud_cos_bucket.placeholder")} />
       </Form.Item>
 
+      <Form.Item
+        name="domainType"
+        label={t("workflow_node.deploy.form.tencentcloud_cos_domain_type.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.tencentcloud_cos_domain_type.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.tencentcloud_cos_domain_type.placeholder")}>
+          <Select.Option key={DOMAIN_TYPE_ORIGIN} value={DOMAIN_TYPE_ORIGIN}>
+            {t("workflow_node.deploy.form.tencentcloud_cos_domain_type.option.origin.label")}
+          </Select.Option>
+          <Select.Option key={DOMAIN_TYPE_CDN} value={DOMAIN_TYPE_CDN}>
+            {t("workflow_node.deploy.form.tencentcloud_cos_domain_type.option.cdn.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
       <Form.Item
         name="domain"
         label={t("workflow_node.deploy.form.tencentcloud_cos_domain.label")}
@@ -1,5 +1,5 @@
 import { useTranslation } from "react-i18next";
-import { Form, type FormInstance, Input } from "antd";
+import { Form, type FormInstance, Input, Select } from "antd";
 import { createSchemaFieldRule } from "antd-zod";
 import { z } from "zod";
 
@@ -7,6 +7,7 @@ import { validDomainName } from "@/utils/validators";
 
 type DeployNodeConfigFormTencentCloudWAFConfigFieldValues = Nullish<{
   region: string;
+  domainType?: string;
   domain: string;
   domainId: string;
   instanceId: string;
@@ -20,8 +21,13 @@ export type DeployNodeConfigFormTencentCloudWAFConfigProps = {
   onValuesChange?: (values: DeployNodeConfigFormTencentCloudWAFConfigFieldValues) => void;
 };
 
+const DOMAIN_TYPE_SAAS = "saas" as const;
+const DOMAIN_TYPE_CLB = "clb" as const;
+
 const initFormModel = (): DeployNodeConfigFormTencentCloudWAFConfigFieldValues => {
-  return {};
+  return {
+    domainType: DOMAIN_TYPE_SAAS,
+  };
 };
 
 const DeployNodeConfigFormTencentCloudWAFConfig = ({
@@ -38,6 +44,7 @@ const DeployNodeConfigFormTencentCloudWAFConfig = ({
       .string({ message: t("workflow_node.deploy.form.tencentcloud_waf_region.placeholder") })
       .nonempty(t("workflow_node.deploy.form.tencentcloud_waf_region.placeholder"))
       .trim(),
+    domainType: z.enum([DOMAIN_TYPE_SAAS, DOMAIN_TYPE_CLB]).nullish(),
     domain: z
       .string({ message: t("workflow_node.deploy.form.tencentcloud_waf_domain.placeholder") })
       .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
@@ -74,6 +81,22 @@ const DeployNodeConfigFormTencentCloudWAFConfig = ({
         <Input placeholder={t("workflow_node.deploy.form.tencentcloud_waf_region.placeholder")} />
       </Form.Item>
 
+      <Form.Item
+        name="domainType"
+        label={t("workflow_node.deploy.form.tencentcloud_waf_domain_type.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.tencentcloud_waf_domain_type.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.tencentcloud_waf_domain_type.placeholder")}>
+          <Select.Option key={DOMAIN_TYPE_SAAS} value={DOMAIN_TYPE_SAAS}>
+            {t("workflow_node.deploy.form.tencentcloud_waf_domain_type.option.saas.label")}
+          </Select.Option>
+          <Select.Option key={DOMAIN_TYPE_CLB} value={DOMAIN_TYPE_CLB}>
+            {t("workflow_node.deploy.form.tencentcloud_waf_domain_type.option.clb.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
       <Form.Item
         name="domain"
         label={t("workflow_node.deploy.form.tencentcloud_waf_domain.label")}
@@ -0,0 +1,109 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormTrueNASConfigFieldValues = Nullish<{
+  updateUICertificate?: boolean;
+  updateS3Certificate?: boolean;
+  updateFTPCertificate?: boolean;
+  appNames?: string;
+  pruneExpired?: boolean;
+}>;
+
+export type DeployNodeConfigFormTrueNASConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormTrueNASConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormTrueNASConfigFieldValues) => void;
+};
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
+const initFormModel = (): DeployNodeConfigFormTrueNASConfigFieldValues => {
+  return {
+    updateUICertificate: true,
+    updateS3Certificate: false,
+    updateFTPCertificate: false,
+    pruneExpired: true,
+  };
+};
+
+const DeployNodeConfigFormTrueNASConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormTrueNASConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    updateUICertificate: z.boolean().nullish(),
+    updateS3Certificate: z.boolean().nullish(),
+    updateFTPCertificate: z.boolean().nullish(),
+    appNames: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        if (!v) return true;
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => /^[a-z0-9]([a-z0-9-]*[a-z0-9])?$/.test(e.trim()));
+      }, t("workflow_node.deploy.form.truenas_app_names.errmsg.invalid")),
+    pruneExpired: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="updateUICertificate"
+        label={t("workflow_node.deploy.form.truenas_update_ui_certificate.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.truenas_update_ui_certificate.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="updateS3Certificate"
+        label={t("workflow_node.deploy.form.truenas_update_s3_certificate.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.truenas_update_s3_certificate.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+
+      <Form.Item name="updateFTPCertificate" label={t("workflow_node.deploy.form.truenas_update_ftp_certificate.label")} rules={[formRule]}>
+        <Switch />
+      </Form.Item>
+
+      <Form.Item
+        name="appNames"
+        label={t("workflow_node.deploy.form.truenas_app_names.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.truenas_app_names.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.truenas_app_names.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="pruneExpired"
+        label={t("workflow_node.deploy.form.truenas_prune_expired.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.truenas_prune_expired.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormTrueNASConfig;
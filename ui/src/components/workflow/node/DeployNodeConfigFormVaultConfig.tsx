@@ -0,0 +1,150 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Select } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import Show from "@/components/Show";
+
+type DeployNodeConfigFormVaultConfigFieldValues = Nullish<{
+  secretsEngine: string;
+  mountPath?: string;
+  secretPath?: string;
+  keyForCertificate?: string;
+  keyForPrivateKey?: string;
+  keyForCertificateChain?: string;
+}>;
+
+export type DeployNodeConfigFormVaultConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormVaultConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormVaultConfigFieldValues) => void;
+};
+
+const SECRETS_ENGINE_KV2 = "kv-v2" as const;
+const SECRETS_ENGINE_PKI = "pki" as const;
+
+const initFormModel = (): DeployNodeConfigFormVaultConfigFieldValues => {
+  return {
+    secretsEngine: SECRETS_ENGINE_KV2,
+    mountPath: "secret",
+    secretPath: "certimate/",
+  };
+};
+
+const DeployNodeConfigFormVaultConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormVaultConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    secretsEngine: z.enum([SECRETS_ENGINE_KV2, SECRETS_ENGINE_PKI], { message: t("workflow_node.deploy.form.vault_secrets_engine.placeholder") }),
+    mountPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    secretPath: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish()
+      .refine((v) => fieldSecretsEngine !== SECRETS_ENGINE_KV2 || !!v, t("workflow_node.deploy.form.vault_secret_path.placeholder")),
+    keyForCertificate: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForPrivateKey: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+    keyForCertificateChain: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const fieldSecretsEngine = Form.useWatch("secretsEngine", formInst);
+
+  const handleSecretsEngineSelect = (value: string) => {
+    if (fieldSecretsEngine === value) return;
+
+    switch (value) {
+      case SECRETS_ENGINE_KV2:
+        formInst.setFieldValue("mountPath", "secret");
+        break;
+
+      case SECRETS_ENGINE_PKI:
+        formInst.setFieldValue("mountPath", "pki");
+        break;
+    }
+  };
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="secretsEngine"
+        label={t("workflow_node.deploy.form.vault_secrets_engine.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.vault_secrets_engine.tooltip") }}></span>}
+      >
+        <Select placeholder={t("workflow_node.deploy.form.vault_secrets_engine.placeholder")} onSelect={handleSecretsEngineSelect}>
+          <Select.Option key={SECRETS_ENGINE_KV2} value={SECRETS_ENGINE_KV2}>
+            {t("workflow_node.deploy.form.vault_secrets_engine.option.kv_v2.label")}
+          </Select.Option>
+          <Select.Option key={SECRETS_ENGINE_PKI} value={SECRETS_ENGINE_PKI}>
+            {t("workflow_node.deploy.form.vault_secrets_engine.option.pki.label")}
+          </Select.Option>
+        </Select>
+      </Form.Item>
+
+      <Form.Item
+        name="mountPath"
+        label={t("workflow_node.deploy.form.vault_mount_path.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.vault_mount_path.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.vault_mount_path.placeholder")} />
+      </Form.Item>
+
+      <Show when={fieldSecretsEngine === SECRETS_ENGINE_KV2}>
+        <Form.Item
+          name="secretPath"
+          label={t("workflow_node.deploy.form.vault_secret_path.label")}
+          rules={[formRule]}
+          tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.vault_secret_path.tooltip") }}></span>}
+        >
+          <Input placeholder={t("workflow_node.deploy.form.vault_secret_path.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="keyForCertificate" label={t("workflow_node.deploy.form.vault_key_for_certificate.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.vault_key_for_certificate.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="keyForPrivateKey" label={t("workflow_node.deploy.form.vault_key_for_private_key.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.vault_key_for_private_key.placeholder")} />
+        </Form.Item>
+
+        <Form.Item name="keyForCertificateChain" label={t("workflow_node.deploy.form.vault_key_for_certificate_chain.label")} rules={[formRule]}>
+          <Input placeholder={t("workflow_node.deploy.form.vault_key_for_certificate_chain.placeholder")} />
+        </Form.Item>
+      </Show>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormVaultConfig;
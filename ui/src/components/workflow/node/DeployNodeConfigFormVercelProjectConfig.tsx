@@ -0,0 +1,80 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormVercelProjectConfigFieldValues = Nullish<{
+  projectId: string;
+  domain: string;
+}>;
+
+export type DeployNodeConfigFormVercelProjectConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormVercelProjectConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormVercelProjectConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormVercelProjectConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormVercelProjectConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormVercelProjectConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    projectId: z
+      .string({ message: t("workflow_node.deploy.form.vercel_project_id.placeholder") })
+      .nonempty(t("workflow_node.deploy.form.vercel_project_id.placeholder"))
+      .max(128, t("common.errmsg.string_max", { max: 128 }))
+      .trim(),
+    domain: z
+      .string({ message: t("workflow_node.deploy.form.vercel_project_domain.placeholder") })
+      .refine((v) => validDomainName(v), t("common.errmsg.domain_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="projectId"
+        label={t("workflow_node.deploy.form.vercel_project_id.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.vercel_project_id.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.vercel_project_id.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="domain"
+        label={t("workflow_node.deploy.form.vercel_project_domain.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.vercel_project_domain.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.vercel_project_domain.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormVercelProjectConfig;
@@ -0,0 +1,86 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, Switch } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormWHMServiceConfigFieldValues = Nullish<{
+  serviceTypes: string;
+  restartServices?: boolean;
+}>;
+
+export type DeployNodeConfigFormWHMServiceConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormWHMServiceConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormWHMServiceConfigFieldValues) => void;
+};
+
+const MULTIPLE_INPUT_DELIMITER = ";";
+
+const SERVICE_TYPES = ["cpanel", "exim", "dovecot", "ftp"];
+
+const initFormModel = (): DeployNodeConfigFormWHMServiceConfigFieldValues => {
+  return {
+    serviceTypes: "cpanel",
+    restartServices: true,
+  };
+};
+
+const DeployNodeConfigFormWHMServiceConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormWHMServiceConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    serviceTypes: z
+      .string({ message: t("workflow_node.deploy.form.whm_service_service_types.placeholder") })
+      .refine((v) => {
+        if (!v) return false;
+        return String(v)
+          .split(MULTIPLE_INPUT_DELIMITER)
+          .every((e) => SERVICE_TYPES.includes(e.trim()));
+      }, t("workflow_node.deploy.form.whm_service_service_types.errmsg.invalid")),
+    restartServices: z.boolean().nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="serviceTypes"
+        label={t("workflow_node.deploy.form.whm_service_service_types.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.whm_service_service_types.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.whm_service_service_types.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="restartServices"
+        label={t("workflow_node.deploy.form.whm_service_restart_services.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.whm_service_restart_services.tooltip") }}></span>}
+      >
+        <Switch />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormWHMServiceConfig;
@@ -5,6 +5,8 @@ import { z } from "zod";
 
 type DeployNodeConfigFormWebhookConfigFieldValues = Nullish<{
   webhookData: string;
+  webhookTemplate?: string;
+  contentType?: string;
 }>;
 
 export type DeployNodeConfigFormWebhookConfigProps = {
@@ -33,14 +35,28 @@ const DeployNodeConfigFormWebhookConfig = ({ form: formInst, formName, disabled,
   const { t } = useTranslation();
 
   const formSchema = z.object({
-    webhookData: z.string({ message: t("workflow_node.deploy.form.webhook_data.placeholder") }).refine((v) => {
-      try {
-        JSON.parse(v);
-        return true;
-      } catch {
-        return false;
-      }
-    }, t("workflow_node.deploy.form.webhook_data.errmsg.json_invalid")),
+    webhookData: z
+      .string()
+      .nullish()
+      .refine((v) => {
+        // 使用回调数据模板时忽略该字段
+        if (formInst.getFieldValue("webhookTemplate")) return true;
+
+        try {
+          JSON.parse(v!);
+          return true;
+        } catch {
+          return false;
+        }
+      }, t("workflow_node.deploy.form.webhook_data.errmsg.json_invalid")),
+    webhookTemplate: z
+      .string()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+    contentType: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
   });
   const formRule = createSchemaFieldRule(formSchema);
 
@@ -94,6 +110,28 @@ const DeployNodeConfigFormWebhookConfig = ({ form: formInst, formName, disabled,
       <Form.Item>
         <Alert type="info" message={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.webhook_data.guide") }}></span>} />
       </Form.Item>
+
+      <Form.Item
+        name="webhookTemplate"
+        label={t("workflow_node.deploy.form.webhook_template.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.webhook_template.tooltip") }}></span>}
+      >
+        <Input.TextArea
+          autoSize={{ minRows: 3, maxRows: 10 }}
+          placeholder={t("workflow_node.deploy.form.webhook_template.placeholder")}
+          onChange={() => formInst.validateFields(["webhookData"]).catch(() => {})}
+        />
+      </Form.Item>
+
+      <Form.Item
+        name="contentType"
+        label={t("workflow_node.deploy.form.webhook_content_type.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.webhook_content_type.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.webhook_content_type.placeholder")} />
+      </Form.Item>
     </Form>
   );
 };
@@ -0,0 +1,78 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormWinRMCertStoreConfigFieldValues = Nullish<{
+  friendlyName?: string;
+  postCommand?: string;
+}>;
+
+export type DeployNodeConfigFormWinRMCertStoreConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormWinRMCertStoreConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormWinRMCertStoreConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormWinRMCertStoreConfigFieldValues => {
+  return {};
+};
+
+const DeployNodeConfigFormWinRMCertStoreConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormWinRMCertStoreConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    friendlyName: z
+      .string()
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .nullish(),
+    postCommand: z
+      .string()
+      .max(20480, t("common.errmsg.string_max", { max: 20480 }))
+      .nullish(),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="friendlyName"
+        label={t("workflow_node.deploy.form.winrm_certstore_friendly_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.winrm_certstore_friendly_name.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.winrm_certstore_friendly_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="postCommand"
+        label={t("workflow_node.deploy.form.winrm_certstore_post_command.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.winrm_certstore_post_command.tooltip") }}></span>}
+      >
+        <Input.TextArea autoSize={{ minRows: 1, maxRows: 5 }} placeholder={t("workflow_node.deploy.form.winrm_certstore_post_command.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormWinRMCertStoreConfig;
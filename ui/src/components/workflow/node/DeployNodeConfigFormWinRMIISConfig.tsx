@@ -0,0 +1,88 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input, InputNumber } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+import { validDomainName } from "@/utils/validators";
+
+type DeployNodeConfigFormWinRMIISConfigFieldValues = Nullish<{
+  siteName: string;
+  bindingHostname?: string;
+  bindingPort: number;
+}>;
+
+export type DeployNodeConfigFormWinRMIISConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormWinRMIISConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormWinRMIISConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormWinRMIISConfigFieldValues => {
+  return {
+    siteName: "Default Web Site",
+    bindingPort: 443,
+  };
+};
+
+const DeployNodeConfigFormWinRMIISConfig = ({ form: formInst, formName, disabled, initialValues, onValuesChange }: DeployNodeConfigFormWinRMIISConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    siteName: z
+      .string()
+      .min(1, t("workflow_node.deploy.form.winrm_iis_site_name.placeholder"))
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim(),
+    bindingHostname: z
+      .string()
+      .nullish()
+      .refine((v) => !v || validDomainName(v, { allowWildcard: true }), t("common.errmsg.domain_invalid")),
+    bindingPort: z
+      .number({ message: t("workflow_node.deploy.form.winrm_iis_binding_port.placeholder") })
+      .int()
+      .gte(1, t("common.errmsg.port_invalid"))
+      .lte(65535, t("common.errmsg.port_invalid")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="siteName"
+        label={t("workflow_node.deploy.form.winrm_iis_site_name.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.winrm_iis_site_name.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.winrm_iis_site_name.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="bindingHostname"
+        label={t("workflow_node.deploy.form.winrm_iis_binding_hostname.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.winrm_iis_binding_hostname.tooltip") }}></span>}
+      >
+        <Input allowClear placeholder={t("workflow_node.deploy.form.winrm_iis_binding_hostname.placeholder")} />
+      </Form.Item>
+
+      <Form.Item name="bindingPort" label={t("workflow_node.deploy.form.winrm_iis_binding_port.label")} rules={[formRule]}>
+        <InputNumber className="w-full" placeholder={t("workflow_node.deploy.form.winrm_iis_binding_port.placeholder")} min={1} max={65535} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormWinRMIISConfig;
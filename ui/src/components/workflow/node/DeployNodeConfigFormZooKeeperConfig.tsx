@@ -0,0 +1,83 @@
+import { useTranslation } from "react-i18next";
+import { Form, type FormInstance, Input } from "antd";
+import { createSchemaFieldRule } from "antd-zod";
+import { z } from "zod";
+
+type DeployNodeConfigFormZooKeeperConfigFieldValues = Nullish<{
+  pathForCertificate: string;
+  pathForPrivateKey: string;
+}>;
+
+export type DeployNodeConfigFormZooKeeperConfigProps = {
+  form: FormInstance;
+  formName: string;
+  disabled?: boolean;
+  initialValues?: DeployNodeConfigFormZooKeeperConfigFieldValues;
+  onValuesChange?: (values: DeployNodeConfigFormZooKeeperConfigFieldValues) => void;
+};
+
+const initFormModel = (): DeployNodeConfigFormZooKeeperConfigFieldValues => {
+  return {
+    pathForCertificate: "/certimate/tls.crt",
+    pathForPrivateKey: "/certimate/tls.key",
+  };
+};
+
+const DeployNodeConfigFormZooKeeperConfig = ({
+  form: formInst,
+  formName,
+  disabled,
+  initialValues,
+  onValuesChange,
+}: DeployNodeConfigFormZooKeeperConfigProps) => {
+  const { t } = useTranslation();
+
+  const formSchema = z.object({
+    pathForCertificate: z
+      .string({ message: t("workflow_node.deploy.form.zookeeper_path_for_certificate.placeholder") })
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .refine((v) => v.startsWith("/"), t("workflow_node.deploy.form.zookeeper_path_for_certificate.placeholder")),
+    pathForPrivateKey: z
+      .string({ message: t("workflow_node.deploy.form.zookeeper_path_for_private_key.placeholder") })
+      .max(256, t("common.errmsg.string_max", { max: 256 }))
+      .trim()
+      .refine((v) => v.startsWith("/"), t("workflow_node.deploy.form.zookeeper_path_for_private_key.placeholder")),
+  });
+  const formRule = createSchemaFieldRule(formSchema);
+
+  const handleFormChange = (_: unknown, values: z.infer<typeof formSchema>) => {
+    onValuesChange?.(values);
+  };
+
+  return (
+    <Form
+      form={formInst}
+      disabled={disabled}
+      initialValues={initialValues ?? initFormModel()}
+      layout="vertical"
+      name={formName}
+      onValuesChange={handleFormChange}
+    >
+      <Form.Item
+        name="pathForCertificate"
+        label={t("workflow_node.deploy.form.zookeeper_path_for_certificate.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.zookeeper_path_for_certificate.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.zookeeper_path_for_certificate.placeholder")} />
+      </Form.Item>
+
+      <Form.Item
+        name="pathForPrivateKey"
+        label={t("workflow_node.deploy.form.zookeeper_path_for_private_key.label")}
+        rules={[formRule]}
+        tooltip={<span dangerouslySetInnerHTML={{ __html: t("workflow_node.deploy.form.zookeeper_path_for_private_key.tooltip") }}></span>}
+      >
+        <Input placeholder={t("workflow_node.deploy.form.zookeeper_path_for_private_key.placeholder")} />
+      </Form.Item>
+    </Form>
+  );
+};
+
+export default DeployNodeConfigFormZooKeeperConfig;
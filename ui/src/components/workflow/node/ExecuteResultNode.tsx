@@ -5,9 +5,12 @@ import {
   CloseCircleOutlined as CloseCircleOutlinedIcon,
   MoreOutlined as MoreOutlinedIcon,
 } from "@ant-design/icons";
-import { Button, Card, Popover, theme } from "antd";
+import { Button, Card, Checkbox, Popover, Tooltip, theme } from "antd";
+import { produce } from "immer";
 
 import { WorkflowNodeType } from "@/domain/workflow";
+import { useZustandShallowSelector } from "@/hooks";
+import { useWorkflowStore } from "@/stores/workflow";
 import SharedNode, { type SharedNodeProps } from "./_SharedNode";
 import AddNode from "./AddNode";
 
@@ -21,6 +24,16 @@ const ExecuteResultNode = ({ node, disabled, branchId, branchIndex }: ConditionN
 
   const { token: themeToken } = theme.useToken();
 
+  const { updateNode } = useWorkflowStore(useZustandShallowSelector(["updateNode"]));
+
+  const handleRollbackChange = (checked: boolean) => {
+    updateNode(
+      produce(node, (draft) => {
+        draft.config = { ...draft.config, rollback: checked };
+      })
+    );
+  };
+
   return (
     <>
       <Popover
@@ -54,6 +67,15 @@ const ExecuteResultNode = ({ node, disabled, branchId, branchIndex }: ConditionN
               )}
             </div>
           </div>
+          {node.type === WorkflowNodeType.ExecuteFailure && (
+            <div className="border-t px-4 py-2 text-center">
+              <Tooltip title={t("workflow_node.execute_failure.form.rollback.tooltip")}>
+                <Checkbox checked={!!node.config?.rollback} disabled={disabled} onChange={(e) => handleRollbackChange(e.target.checked)}>
+                  {t("workflow_node.execute_failure.form.rollback.label")}
+                </Checkbox>
+              </Tooltip>
+            </div>
+          )}
         </Card>
       </Popover>
 
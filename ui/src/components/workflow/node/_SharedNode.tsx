@@ -5,15 +5,18 @@ import {
   EllipsisOutlined as EllipsisOutlinedIcon,
   FormOutlined as FormOutlinedIcon,
   MoreOutlined as MoreOutlinedIcon,
+  RollbackOutlined as RollbackOutlinedIcon,
 } from "@ant-design/icons";
 import { useControllableValue } from "ahooks";
-import { Button, Card, Drawer, Dropdown, Input, type InputRef, Modal, Popover, Space } from "antd";
+import { Button, Card, Drawer, Dropdown, Input, type InputRef, Modal, Popover, Space, notification } from "antd";
 import { produce } from "immer";
 import { isEqual } from "radash";
 
+import { rollbackNode as rollbackWorkflowNode } from "@/api/workflows";
 import { type WorkflowNode, WorkflowNodeType } from "@/domain/workflow";
 import { useZustandShallowSelector } from "@/hooks";
 import { useWorkflowStore } from "@/stores/workflow";
+import { getErrMsg } from "@/utils/error";
 
 import AddNode from "./AddNode";
 
@@ -77,9 +80,12 @@ const isBranchingNode = (node: WorkflowNode) => {
 const SharedNodeMenu = ({ trigger, node, disabled, branchId, branchIndex, afterUpdate, afterDelete }: SharedNodeMenuProps) => {
   const { t } = useTranslation();
 
-  const { updateNode, removeNode, removeBranch } = useWorkflowStore(useZustandShallowSelector(["updateNode", "removeNode", "removeBranch"]));
+  const { workflow, updateNode, removeNode, removeBranch } = useWorkflowStore(
+    useZustandShallowSelector(["workflow", "updateNode", "removeNode", "removeBranch"])
+  );
 
   const [modalApi, ModelContextHolder] = Modal.useModal();
+  const [notificationApi, NotificationContextHolder] = notification.useNotification();
 
   const nameInputRef = useRef<InputRef>(null);
   const nameRef = useRef<string>();
@@ -110,9 +116,26 @@ const SharedNodeMenu = ({ trigger, node, disabled, branchId, branchIndex, afterU
     afterDelete?.();
   };
 
+  const handleRollbackClick = () => {
+    modalApi.confirm({
+      title: t("workflow_node.action.rollback_node"),
+      content: t("workflow_node.action.rollback_node.confirm"),
+      onOk: async () => {
+        try {
+          await rollbackWorkflowNode(workflow.id!, node.id);
+          notificationApi.success({ message: t("workflow_node.action.rollback_node.succeeded") });
+        } catch (err) {
+          console.error(err);
+          notificationApi.error({ message: t("common.text.request_error"), description: getErrMsg(err) });
+        }
+      },
+    });
+  };
+
   return (
     <>
       {ModelContextHolder}
+      {NotificationContextHolder}
 
       <Dropdown
         menu={{
@@ -148,6 +171,17 @@ const SharedNodeMenu = ({ trigger, node, disabled, branchId, branchIndex, afterU
                 setTimeout(() => nameInputRef.current?.focus(), 1);
               },
             },
+            ...(node.type === WorkflowNodeType.Deploy
+              ? [
+                  {
+                    key: "rollback",
+                    disabled: !workflow.id,
+                    label: t("workflow_node.action.rollback_node"),
+                    icon: <RollbackOutlinedIcon />,
+                    onClick: handleRollbackClick,
+                  },
+                ]
+              : []),
             {
               type: "divider",
             },
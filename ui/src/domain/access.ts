@@ -8,6 +8,7 @@ export interface AccessModel extends BaseModel {
     (
       | AccessConfigFor1Panel
       | AccessConfigForACMEHttpReq
+      | AccessConfigForAkamai
       | AccessConfigForAliyun
       | AccessConfigForAWS
       | AccessConfigForAzure
@@ -17,32 +18,69 @@ export interface AccessModel extends BaseModel {
       | AccessConfigForBytePlus
       | AccessConfigForCacheFly
       | AccessConfigForCdnfly
+      | AccessConfigForCisco
       | AccessConfigForCloudflare
       | AccessConfigForClouDNS
       | AccessConfigForCMCCCloud
+      | AccessConfigForConsul
+      | AccessConfigForCPanel
       | AccessConfigForDNSLA
+      | AccessConfigForDocker
       | AccessConfigForDogeCloud
       | AccessConfigForEdgio
+      | AccessConfigForEtcd
+      | AccessConfigForF5
+      | AccessConfigForFastly
+      | AccessConfigForFortinet
+      | AccessConfigForFTP
       | AccessConfigForGcore
+      | AccessConfigForGCP
+      | AccessConfigForGitLab
       | AccessConfigForGname
       | AccessConfigForGoDaddy
+      | AccessConfigForGRPC
+      | AccessConfigForHAProxy
+      | AccessConfigForHeroku
       | AccessConfigForHuaweiCloud
       | AccessConfigForJDCloud
+      | AccessConfigForJenkins
+      | AccessConfigForKemp
+      | AccessConfigForKeyCDN
+      | AccessConfigForKSyun
       | AccessConfigForKubernetes
       | AccessConfigForLocal
+      | AccessConfigForMikrotik
       | AccessConfigForNamecheap
       | AccessConfigForNameDotCom
       | AccessConfigForNameSilo
+      | AccessConfigForNetlify
+      | AccessConfigForNomad
+      | AccessConfigForOpenStack
+      | AccessConfigForOPNsense
+      | AccessConfigForOracleCloud
+      | AccessConfigForOVHcloud
+      | AccessConfigForPfSense
+      | AccessConfigForPlesk
       | AccessConfigForPowerDNS
       | AccessConfigForQiniu
       | AccessConfigForRainYun
+      | AccessConfigForRancher
       | AccessConfigForSafeLine
+      | AccessConfigForSoftEther
+      | AccessConfigForSophos
       | AccessConfigForSSH
       | AccessConfigForTencentCloud
+      | AccessConfigForTrueNAS
       | AccessConfigForUCloud
+      | AccessConfigForUpyun
+      | AccessConfigForVault
+      | AccessConfigForVercel
       | AccessConfigForVolcEngine
       | AccessConfigForWebhook
       | AccessConfigForWestcn
+      | AccessConfigForWHM
+      | AccessConfigForWinRM
+      | AccessConfigForZooKeeper
     );
 }
 
@@ -60,14 +98,27 @@ export type AccessConfigForACMEHttpReq = {
   password?: string;
 };
 
+export type AccessConfigForAkamai = {
+  host: string;
+  clientToken: string;
+  clientSecret: string;
+  accessToken: string;
+};
+
 export type AccessConfigForAliyun = {
-  accessKeyId: string;
-  accessKeySecret: string;
+  credentialMode?: string;
+  accessKeyId?: string;
+  accessKeySecret?: string;
+  securityToken?: string;
+  ecsRamRoleName?: string;
+  roleArn?: string;
+  roleSessionName?: string;
 };
 
 export type AccessConfigForAWS = {
-  accessKeyId: string;
-  secretAccessKey: string;
+  credentialMode?: string;
+  accessKeyId?: string;
+  secretAccessKey?: string;
 };
 
 export type AccessConfigForAzure = {
@@ -107,6 +158,13 @@ export type AccessConfigForCdnfly = {
   apiSecret: string;
 };
 
+export type AccessConfigForCisco = {
+  host: string;
+  port?: number;
+  username: string;
+  password: string;
+};
+
 export type AccessConfigForCloudflare = {
   dnsApiToken: string;
 };
@@ -121,11 +179,32 @@ export type AccessConfigForCMCCCloud = {
   accessKeySecret: string;
 };
 
+export type AccessConfigForConsul = {
+  serverUrl: string;
+  token?: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForCPanel = {
+  serverUrl: string;
+  username: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForDNSLA = {
   apiId: string;
   apiSecret: string;
 };
 
+export type AccessConfigForDocker = {
+  dockerHost: string;
+  tlsCaCertificate?: string;
+  tlsCertificate?: string;
+  tlsPrivateKey?: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForDogeCloud = {
   accessKey: string;
   secretKey: string;
@@ -136,10 +215,55 @@ export type AccessConfigForEdgio = {
   clientSecret: string;
 };
 
+export type AccessConfigForEtcd = {
+  endpoints: string;
+  username?: string;
+  password?: string;
+  useTLS?: boolean;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForF5 = {
+  serverUrl: string;
+  username: string;
+  password: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForFastly = {
+  apiToken: string;
+};
+
+export type AccessConfigForFortinet = {
+  serverUrl: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForFTP = {
+  host: string;
+  port: number;
+  username?: string;
+  password?: string;
+  useExplicitTLS?: boolean;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForGcore = {
   apiToken: string;
 };
 
+export type AccessConfigForGCP = {
+  credentialMode?: string;
+  serviceAccountKey?: string;
+};
+
+export type AccessConfigForGitLab = {
+  serverUrl?: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForGname = {
   appId: string;
   appKey: string;
@@ -150,6 +274,25 @@ export type AccessConfigForGoDaddy = {
   apiSecret: string;
 };
 
+export type AccessConfigForGRPC = {
+  endpoint: string;
+  useTLS?: boolean;
+  authToken?: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForHAProxy = {
+  serverUrl: string;
+  apiVersion?: string;
+  username: string;
+  password: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForHeroku = {
+  apiKey: string;
+};
+
 export type AccessConfigForHuaweiCloud = {
   accessKeyId: string;
   secretAccessKey: string;
@@ -160,12 +303,42 @@ export type AccessConfigForJDCloud = {
   accessKeySecret: string;
 };
 
+export type AccessConfigForJenkins = {
+  serverUrl: string;
+  username: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForKemp = {
+  serverUrl: string;
+  username: string;
+  password: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForKeyCDN = {
+  apiKey: string;
+};
+
+export type AccessConfigForKSyun = {
+  accessKeyId: string;
+  secretAccessKey: string;
+};
+
 export type AccessConfigForKubernetes = {
   kubeConfig?: string;
 };
 
 export type AccessConfigForLocal = NonNullable<unknown>;
 
+export type AccessConfigForMikrotik = {
+  serverUrl: string;
+  username: string;
+  password?: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForNamecheap = {
   username: string;
   apiKey: string;
@@ -180,10 +353,61 @@ export type AccessConfigForNameSilo = {
   apiKey: string;
 };
 
+export type AccessConfigForNetlify = {
+  apiToken: string;
+};
+
+export type AccessConfigForNomad = {
+  serverUrl: string;
+  token?: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForNS1 = {
   apiKey: string;
 };
 
+export type AccessConfigForOpenStack = {
+  authUrl: string;
+  domainName?: string;
+  username: string;
+  password: string;
+  projectId: string;
+};
+
+export type AccessConfigForOPNsense = {
+  serverUrl: string;
+  apiKey: string;
+  apiSecret: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForOracleCloud = {
+  tenancyId: string;
+  userId: string;
+  fingerprint: string;
+  privateKey: string;
+};
+
+export type AccessConfigForOVHcloud = {
+  endpoint: string;
+  applicationKey: string;
+  applicationSecret: string;
+  consumerKey: string;
+};
+
+export type AccessConfigForPfSense = {
+  serverUrl: string;
+  apiKey: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForPlesk = {
+  serverUrl: string;
+  apiKey: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForPowerDNS = {
   apiUrl: string;
   apiKey: string;
@@ -198,12 +422,31 @@ export type AccessConfigForRainYun = {
   apiKey: string;
 };
 
+export type AccessConfigForRancher = {
+  serverUrl: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForSafeLine = {
   apiUrl: string;
   apiToken: string;
   allowInsecureConnections?: boolean;
 };
 
+export type AccessConfigForSoftEther = {
+  serverUrl: string;
+  adminPassword: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForSophos = {
+  serverUrl: string;
+  username: string;
+  password: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForSSH = {
   host: string;
   port: number;
@@ -211,6 +454,16 @@ export type AccessConfigForSSH = {
   password?: string;
   key?: string;
   keyPassphrase?: string;
+  jumpServers?: AccessConfigForSSHJumpServer[];
+};
+
+export type AccessConfigForSSHJumpServer = {
+  host: string;
+  port: number;
+  username: string;
+  password?: string;
+  key?: string;
+  keyPassphrase?: string;
 };
 
 export type AccessConfigForTencentCloud = {
@@ -218,12 +471,39 @@ export type AccessConfigForTencentCloud = {
   secretKey: string;
 };
 
+export type AccessConfigForTrueNAS = {
+  serverUrl: string;
+  apiKey: string;
+  allowInsecureConnections?: boolean;
+};
+
 export type AccessConfigForUCloud = {
   privateKey: string;
   publicKey: string;
   projectId?: string;
 };
 
+export type AccessConfigForUpyun = {
+  username: string;
+  password: string;
+};
+
+export type AccessConfigForVault = {
+  serverUrl: string;
+  namespace?: string;
+  authMethod: string;
+  token?: string;
+  appRoleMountPath?: string;
+  appRoleRoleId?: string;
+  appRoleSecretId?: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForVercel = {
+  apiAccessToken: string;
+  teamId?: string;
+};
+
 export type AccessConfigForVolcEngine = {
   accessKeyId: string;
   secretAccessKey: string;
@@ -231,6 +511,10 @@ export type AccessConfigForVolcEngine = {
 
 export type AccessConfigForWebhook = {
   url: string;
+  headers?: string;
+  hmacSecret?: string;
+  tlsClientCert?: string;
+  tlsClientKey?: string;
   allowInsecureConnections?: boolean;
 };
 
@@ -238,4 +522,27 @@ export type AccessConfigForWestcn = {
   username: string;
   apiPassword: string;
 };
+
+export type AccessConfigForWHM = {
+  serverUrl: string;
+  username: string;
+  apiToken: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForWinRM = {
+  host: string;
+  port?: number;
+  useHttps?: boolean;
+  authMethod?: string;
+  username: string;
+  password: string;
+  allowInsecureConnections?: boolean;
+};
+
+export type AccessConfigForZooKeeper = {
+  servers: string;
+  username?: string;
+  password?: string;
+};
 // #endregion
@@ -6,6 +6,7 @@
 export const ACCESS_PROVIDERS = Object.freeze({
   ["1PANEL"]: "1panel",
   ACMEHTTPREQ: "acmehttpreq",
+  AKAMAI: "akamai",
   ALIYUN: "aliyun",
   AWS: "aws",
   AZURE: "azure",
@@ -15,33 +16,70 @@ export const ACCESS_PROVIDERS = Object.freeze({
   BYTEPLUS: "byteplus",
   CACHEFLY: "cachefly",
   CDNFLY: "cdnfly",
+  CISCO: "cisco",
   CLOUDFLARE: "cloudflare",
   CLOUDNS: "cloudns",
   CMCCCLOUD: "cmcccloud",
+  CONSUL: "consul",
+  CPANEL: "cpanel",
   DNSLA: "dnsla",
+  DOCKER: "docker",
   DOGECLOUD: "dogecloud",
   GCORE: "gcore",
+  GCP: "gcp",
+  GITLAB: "gitlab",
   GNAME: "gname",
   GODADDY: "godaddy",
   EDGIO: "edgio",
+  ETCD: "etcd",
+  F5: "f5",
+  FASTLY: "fastly",
+  FORTINET: "fortinet",
+  FTP: "ftp",
+  GRPC: "grpc",
+  HAPROXY: "haproxy",
+  HEROKU: "heroku",
   HUAWEICLOUD: "huaweicloud",
   JDCLOUD: "jdcloud",
+  JENKINS: "jenkins",
+  KEMP: "kemp",
+  KEYCDN: "keycdn",
+  KSYUN: "ksyun",
   KUBERNETES: "k8s",
   LOCAL: "local",
+  MIKROTIK: "mikrotik",
   NAMECHEAP: "namecheap",
   NAMEDOTCOM: "namedotcom",
   NAMESILO: "namesilo",
+  NETLIFY: "netlify",
+  NOMAD: "nomad",
   NS1: "ns1",
+  OPENSTACK: "openstack",
+  OPNSENSE: "opnsense",
+  ORACLECLOUD: "oraclecloud",
+  OVHCLOUD: "ovhcloud",
+  PFSENSE: "pfsense",
+  PLESK: "plesk",
   POWERDNS: "powerdns",
   QINIU: "qiniu",
   RAINYUN: "rainyun",
+  RANCHER: "rancher",
   SAFELINE: "safeline",
+  SOFTETHER: "softether",
+  SOPHOS: "sophos",
   SSH: "ssh",
   TENCENTCLOUD: "tencentcloud",
+  TRUENAS: "truenas",
   UCLOUD: "ucloud",
+  UPYUN: "upyun",
+  VAULT: "vault",
+  VERCEL: "vercel",
   VOLCENGINE: "volcengine",
   WEBHOOK: "webhook",
   WESTCN: "westcn",
+  WHM: "whm",
+  WINRM: "winrm",
+  ZOOKEEPER: "zookeeper",
 } as const);
 
 export type AccessProviderType = (typeof ACCESS_PROVIDERS)[keyof typeof ACCESS_PROVIDERS];
@@ -68,31 +106,69 @@ export const accessProvidersMap: Map<AccessProvider["type"] | string, AccessProv
   [
     [ACCESS_PROVIDERS.LOCAL, "provider.local", "/imgs/providers/local.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.SSH, "provider.ssh", "/imgs/providers/ssh.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.FTP, "provider.ftp", "/imgs/providers/ftp.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.WEBHOOK, "provider.webhook", "/imgs/providers/webhook.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.GRPC, "provider.grpc", "/imgs/providers/grpc.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.GITLAB, "provider.gitlab", "/imgs/providers/gitlab.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.JENKINS, "provider.jenkins", "/imgs/providers/jenkins.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.KUBERNETES, "provider.kubernetes", "/imgs/providers/kubernetes.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.DOCKER, "provider.docker", "/imgs/providers/docker.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.RANCHER, "provider.rancher", "/imgs/providers/rancher.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.ETCD, "provider.etcd", "/imgs/providers/etcd.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.ZOOKEEPER, "provider.zookeeper", "/imgs/providers/zookeeper.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.WINRM, "provider.winrm", "/imgs/providers/winrm.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.VAULT, "provider.vault", "/imgs/providers/vault.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.CONSUL, "provider.consul", "/imgs/providers/consul.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.NOMAD, "provider.nomad", "/imgs/providers/nomad.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.MIKROTIK, "provider.mikrotik", "/imgs/providers/mikrotik.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.CISCO, "provider.cisco", "/imgs/providers/cisco.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.F5, "provider.f5", "/imgs/providers/f5.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.FORTINET, "provider.fortinet", "/imgs/providers/fortinet.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.HAPROXY, "provider.haproxy", "/imgs/providers/haproxy.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.OPNSENSE, "provider.opnsense", "/imgs/providers/opnsense.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.PFSENSE, "provider.pfsense", "/imgs/providers/pfsense.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.TRUENAS, "provider.truenas", "/imgs/providers/truenas.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.ALIYUN, "provider.aliyun", "/imgs/providers/aliyun.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.TENCENTCLOUD, "provider.tencentcloud", "/imgs/providers/tencentcloud.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.BAIDUCLOUD, "provider.baiducloud", "/imgs/providers/baiducloud.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.HUAWEICLOUD, "provider.huaweicloud", "/imgs/providers/huaweicloud.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.VOLCENGINE, "provider.volcengine", "/imgs/providers/volcengine.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.JDCLOUD, "provider.jdcloud", "/imgs/providers/jdcloud.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.KSYUN, "provider.ksyun", "/imgs/providers/ksyun.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.AWS, "provider.aws", "/imgs/providers/aws.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.GCORE, "provider.gcore", "/imgs/providers/gcore.png", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.CLOUDFLARE, "provider.cloudflare", "/imgs/providers/cloudflare.svg", [ACCESS_USAGES.APPLY, ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.GCP, "provider.gcp", "/imgs/providers/gcp.svg", [ACCESS_USAGES.DEPLOY]],
 
     [ACCESS_PROVIDERS.QINIU, "provider.qiniu", "/imgs/providers/qiniu.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.BAISHAN, "provider.baishan", "/imgs/providers/baishan.png", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.DOGECLOUD, "provider.dogecloud", "/imgs/providers/dogecloud.png", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.BYTEPLUS, "provider.byteplus", "/imgs/providers/byteplus.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.UCLOUD, "provider.ucloud", "/imgs/providers/ucloud.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.UPYUN, "provider.upyun", "/imgs/providers/upyun.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.SAFELINE, "provider.safeline", "/imgs/providers/safeline.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.SOFTETHER, "provider.softether", "/imgs/providers/softether.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.SOPHOS, "provider.sophos", "/imgs/providers/sophos.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.KEMP, "provider.kemp", "/imgs/providers/kemp.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS["1PANEL"], "provider.1panel", "/imgs/providers/1panel.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.BAOTAPANEL, "provider.baotapanel", "/imgs/providers/baotapanel.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.CPANEL, "provider.cpanel", "/imgs/providers/cpanel.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.WHM, "provider.whm", "/imgs/providers/cpanel.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.PLESK, "provider.plesk", "/imgs/providers/plesk.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.AKAMAI, "provider.akamai", "/imgs/providers/akamai.svg", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.CACHEFLY, "provider.cachefly", "/imgs/providers/cachefly.png", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.CDNFLY, "provider.cdnfly", "/imgs/providers/cdnfly.png", [ACCESS_USAGES.DEPLOY]],
     [ACCESS_PROVIDERS.EDGIO, "provider.edgio", "/imgs/providers/edgio.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.FASTLY, "provider.fastly", "/imgs/providers/fastly.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.KEYCDN, "provider.keycdn", "/imgs/providers/keycdn.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.HEROKU, "provider.heroku", "/imgs/providers/heroku.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.ORACLECLOUD, "provider.oraclecloud", "/imgs/providers/oraclecloud.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.OVHCLOUD, "provider.ovhcloud", "/imgs/providers/ovhcloud.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.NETLIFY, "provider.netlify", "/imgs/providers/netlify.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.VERCEL, "provider.vercel", "/imgs/providers/vercel.svg", [ACCESS_USAGES.DEPLOY]],
+    [ACCESS_PROVIDERS.OPENSTACK, "provider.openstack", "/imgs/providers/openstack.svg", [ACCESS_USAGES.DEPLOY]],
 
     [ACCESS_PROVIDERS.AZURE, "provider.azure", "/imgs/providers/azure.svg", [ACCESS_USAGES.APPLY]],
-    [ACCESS_PROVIDERS.CLOUDFLARE, "provider.cloudflare", "/imgs/providers/cloudflare.svg", [ACCESS_USAGES.APPLY]],
     [ACCESS_PROVIDERS.CLOUDNS, "provider.cloudns", "/imgs/providers/cloudns.png", [ACCESS_USAGES.APPLY]],
     [ACCESS_PROVIDERS.DNSLA, "provider.dnsla", "/imgs/providers/dnsla.svg", [ACCESS_USAGES.APPLY]],
     [ACCESS_PROVIDERS.GNAME, "provider.gname", "/imgs/providers/gname.png", [ACCESS_USAGES.APPLY]],
@@ -207,6 +283,41 @@ export const applyDNSProvidersMap: Map<ApplyDNSProvider["type"] | string, ApplyD
 );
 // #endregion
 
+// #region ApplyCSRProvider
+/*
+  注意：如果追加新的常量值，请保持以 ASCII 排序。
+  NOTICE: If you add new constant, please keep ASCII order.
+ */
+export const APPLY_CSR_PROVIDERS = Object.freeze({
+  AKAMAI_CPS: `${ACCESS_PROVIDERS.AKAMAI}-cps`,
+} as const);
+
+export type ApplyCSRProviderType = (typeof APPLY_CSR_PROVIDERS)[keyof typeof APPLY_CSR_PROVIDERS];
+
+export type ApplyCSRProvider = {
+  type: ApplyCSRProviderType;
+  name: string;
+  icon: string;
+  provider: AccessProviderType;
+};
+
+export const applyCSRProvidersMap: Map<ApplyCSRProvider["type"] | string, ApplyCSRProvider> = new Map(
+  /*
+   注意：此处的顺序决定显示在前端的顺序。
+   NOTICE: The following order determines the order displayed at the frontend.
+  */
+  [[APPLY_CSR_PROVIDERS.AKAMAI_CPS, "provider.akamai.cps"]].map(([type, name]) => [
+    type,
+    {
+      type: type as ApplyCSRProviderType,
+      name: name,
+      icon: accessProvidersMap.get(type.split("-")[0])!.icon,
+      provider: type.split("-")[0] as AccessProviderType,
+    },
+  ])
+);
+// #endregion
+
 // #region DeployProvider
 /*
   注意：如果追加新的常量值，请保持以 ASCII 排序。
@@ -215,11 +326,13 @@ export const applyDNSProvidersMap: Map<ApplyDNSProvider["type"] | string, ApplyD
 export const DEPLOY_PROVIDERS = Object.freeze({
   ["1PANEL_CONSOLE"]: `${ACCESS_PROVIDERS["1PANEL"]}-console`,
   ["1PANEL_SITE"]: `${ACCESS_PROVIDERS["1PANEL"]}-site`,
+  AKAMAI_CPS: `${ACCESS_PROVIDERS.AKAMAI}-cps`,
   ALIYUN_ALB: `${ACCESS_PROVIDERS.ALIYUN}-alb`,
   ALIYUN_CAS_DEPLOY: `${ACCESS_PROVIDERS.ALIYUN}-casdeploy`,
   ALIYUN_CDN: `${ACCESS_PROVIDERS.ALIYUN}-cdn`,
   ALIYUN_CLB: `${ACCESS_PROVIDERS.ALIYUN}-clb`,
   ALIYUN_DCDN: `${ACCESS_PROVIDERS.ALIYUN}-dcdn`,
+  ALIYUN_DDOS: `${ACCESS_PROVIDERS.ALIYUN}-ddos`,
   ALIYUN_ESA: `${ACCESS_PROVIDERS.ALIYUN}-esa`,
   ALIYUN_FC: `${ACCESS_PROVIDERS.ALIYUN}-fc`,
   ALIYUN_LIVE: `${ACCESS_PROVIDERS.ALIYUN}-live`,
@@ -228,6 +341,8 @@ export const DEPLOY_PROVIDERS = Object.freeze({
   ALIYUN_VOD: `${ACCESS_PROVIDERS.ALIYUN}-vod`,
   ALIYUN_WAF: `${ACCESS_PROVIDERS.ALIYUN}-waf`,
   AWS_CLOUDFRONT: `${ACCESS_PROVIDERS.AWS}-cloudfront`,
+  AWS_ELB: `${ACCESS_PROVIDERS.AWS}-elb`,
+  BAIDUCLOUD_BLB: `${ACCESS_PROVIDERS.BAIDUCLOUD}-blb`,
   BAIDUCLOUD_CDN: `${ACCESS_PROVIDERS.BAIDUCLOUD}-cdn`,
   BAISHAN_CDN: `${ACCESS_PROVIDERS.BAISHAN}-cdn`,
   BAOTAPANEL_CONSOLE: `${ACCESS_PROVIDERS.BAOTAPANEL}-console`,
@@ -235,9 +350,26 @@ export const DEPLOY_PROVIDERS = Object.freeze({
   BYTEPLUS_CDN: `${ACCESS_PROVIDERS.BYTEPLUS}-cdn`,
   CACHEFLY: `${ACCESS_PROVIDERS.CACHEFLY}`,
   CDNFLY: `${ACCESS_PROVIDERS.CDNFLY}`,
+  CISCO_IOSXE: `${ACCESS_PROVIDERS.CISCO}-iosxe`,
+  CLOUDFLARE_SAAS: `${ACCESS_PROVIDERS.CLOUDFLARE}-saas`,
+  CLOUDFLARE_SSL: `${ACCESS_PROVIDERS.CLOUDFLARE}-ssl`,
+  CONSUL_KV: `${ACCESS_PROVIDERS.CONSUL}-kv`,
+  CPANEL_SSL: `${ACCESS_PROVIDERS.CPANEL}-ssl`,
+  DOCKER_SWARM: `${ACCESS_PROVIDERS.DOCKER}-swarm`,
   DOGECLOUD_CDN: `${ACCESS_PROVIDERS.DOGECLOUD}-cdn`,
   EDGIO_APPLICATIONS: `${ACCESS_PROVIDERS.EDGIO}-applications`,
+  ETCD: `${ACCESS_PROVIDERS.ETCD}`,
+  F5_BIGIP: `${ACCESS_PROVIDERS.F5}-bigip`,
+  FASTLY: `${ACCESS_PROVIDERS.FASTLY}`,
+  FORTINET_FORTIGATE: `${ACCESS_PROVIDERS.FORTINET}-fortigate`,
+  FTP: `${ACCESS_PROVIDERS.FTP}`,
   GCORE_CDN: `${ACCESS_PROVIDERS.GCORE}-cdn`,
+  GCP_CERTIFICATEMANAGER: `${ACCESS_PROVIDERS.GCP}-certificatemanager`,
+  GCP_LOADBALANCER: `${ACCESS_PROVIDERS.GCP}-loadbalancer`,
+  GITLAB: `${ACCESS_PROVIDERS.GITLAB}`,
+  GRPC_AGENT: `${ACCESS_PROVIDERS.GRPC}-agent`,
+  HAPROXY: `${ACCESS_PROVIDERS.HAPROXY}`,
+  HEROKU: `${ACCESS_PROVIDERS.HEROKU}`,
   HUAWEICLOUD_CDN: `${ACCESS_PROVIDERS.HUAWEICLOUD}-cdn`,
   HUAWEICLOUD_ELB: `${ACCESS_PROVIDERS.HUAWEICLOUD}-elb`,
   HUAWEICLOUD_WAF: `${ACCESS_PROVIDERS.HUAWEICLOUD}-waf`,
@@ -245,12 +377,39 @@ export const DEPLOY_PROVIDERS = Object.freeze({
   JDCLOUD_CDN: `${ACCESS_PROVIDERS.JDCLOUD}-cdn`,
   JDCLOUD_LIVE: `${ACCESS_PROVIDERS.JDCLOUD}-live`,
   JDCLOUD_VOD: `${ACCESS_PROVIDERS.JDCLOUD}-vod`,
+  JENKINS_CREDENTIAL: `${ACCESS_PROVIDERS.JENKINS}-credential`,
+  KEMP_LOADMASTER: `${ACCESS_PROVIDERS.KEMP}-loadmaster`,
+  KEYCDN: `${ACCESS_PROVIDERS.KEYCDN}`,
+  KSYUN_CDN: `${ACCESS_PROVIDERS.KSYUN}-cdn`,
+  KUBERNETES_HARBOR: `${ACCESS_PROVIDERS.KUBERNETES}-harbor`,
+  KUBERNETES_INGRESS: `${ACCESS_PROVIDERS.KUBERNETES}-ingress`,
+  KUBERNETES_OPENSHIFT_ROUTE: `${ACCESS_PROVIDERS.KUBERNETES}-openshift-route`,
   KUBERNETES_SECRET: `${ACCESS_PROVIDERS.KUBERNETES}-secret`,
   LOCAL: `${ACCESS_PROVIDERS.LOCAL}`,
+  MIKROTIK: `${ACCESS_PROVIDERS.MIKROTIK}`,
+  NETLIFY_SITE: `${ACCESS_PROVIDERS.NETLIFY}-site`,
+  NOMAD_VARIABLE: `${ACCESS_PROVIDERS.NOMAD}-variable`,
+  OPENSTACK_OCTAVIA: `${ACCESS_PROVIDERS.OPENSTACK}-octavia`,
+  OPNSENSE: `${ACCESS_PROVIDERS.OPNSENSE}`,
+  ORACLECLOUD_CERTIFICATES: `${ACCESS_PROVIDERS.ORACLECLOUD}-certificates`,
+  ORACLECLOUD_LB: `${ACCESS_PROVIDERS.ORACLECLOUD}-lb`,
+  OVHCLOUD_IPLB: `${ACCESS_PROVIDERS.OVHCLOUD}-iplb`,
+  OVHCLOUD_WEBHOSTING: `${ACCESS_PROVIDERS.OVHCLOUD}-webhosting`,
+  PFSENSE: `${ACCESS_PROVIDERS.PFSENSE}`,
+  PLESK: `${ACCESS_PROVIDERS.PLESK}`,
   QINIU_CDN: `${ACCESS_PROVIDERS.QINIU}-cdn`,
+  QINIU_KODO: `${ACCESS_PROVIDERS.QINIU}-kodo`,
   QINIU_PILI: `${ACCESS_PROVIDERS.QINIU}-pili`,
+  RANCHER_HARVESTER: `${ACCESS_PROVIDERS.RANCHER}-harvester`,
+  RANCHER_SECRET: `${ACCESS_PROVIDERS.RANCHER}-secret`,
   SAFELINE: `${ACCESS_PROVIDERS.SAFELINE}`,
+  SOFTETHER: `${ACCESS_PROVIDERS.SOFTETHER}`,
+  SOPHOS_FIREWALL: `${ACCESS_PROVIDERS.SOPHOS}-firewall`,
   SSH: `${ACCESS_PROVIDERS.SSH}`,
+  SSH_HARBOR: `${ACCESS_PROVIDERS.SSH}-harbor`,
+  SSH_MAILSERVER: `${ACCESS_PROVIDERS.SSH}-mailserver`,
+  SSH_MINIO: `${ACCESS_PROVIDERS.SSH}-minio`,
+  TENCENTCLOUD_APIGATEWAY: `${ACCESS_PROVIDERS.TENCENTCLOUD}-apigateway`,
   TENCENTCLOUD_CDN: `${ACCESS_PROVIDERS.TENCENTCLOUD}-cdn`,
   TENCENTCLOUD_CLB: `${ACCESS_PROVIDERS.TENCENTCLOUD}-clb`,
   TENCENTCLOUD_COS: `${ACCESS_PROVIDERS.TENCENTCLOUD}-cos`,
@@ -261,8 +420,12 @@ export const DEPLOY_PROVIDERS = Object.freeze({
   TENCENTCLOUD_SSL_DEPLOY: `${ACCESS_PROVIDERS.TENCENTCLOUD}-ssldeploy`,
   TENCENTCLOUD_VOD: `${ACCESS_PROVIDERS.TENCENTCLOUD}-vod`,
   TENCENTCLOUD_WAF: `${ACCESS_PROVIDERS.TENCENTCLOUD}-waf`,
+  TRUENAS: `${ACCESS_PROVIDERS.TRUENAS}`,
   UCLOUD_UCDN: `${ACCESS_PROVIDERS.UCLOUD}-ucdn`,
   UCLOUD_US3: `${ACCESS_PROVIDERS.UCLOUD}-us3`,
+  UPYUN_CDN: `${ACCESS_PROVIDERS.UPYUN}-cdn`,
+  VAULT: `${ACCESS_PROVIDERS.VAULT}`,
+  VERCEL_PROJECT: `${ACCESS_PROVIDERS.VERCEL}-project`,
   VOLCENGINE_CDN: `${ACCESS_PROVIDERS.VOLCENGINE}-cdn`,
   VOLCENGINE_CLB: `${ACCESS_PROVIDERS.VOLCENGINE}-clb`,
   VOLCENGINE_DCDN: `${ACCESS_PROVIDERS.VOLCENGINE}-dcdn`,
@@ -270,6 +433,10 @@ export const DEPLOY_PROVIDERS = Object.freeze({
   VOLCENGINE_LIVE: `${ACCESS_PROVIDERS.VOLCENGINE}-live`,
   VOLCENGINE_TOS: `${ACCESS_PROVIDERS.VOLCENGINE}-tos`,
   WEBHOOK: `${ACCESS_PROVIDERS.WEBHOOK}`,
+  WHM_SERVICE: `${ACCESS_PROVIDERS.WHM}-service`,
+  WINRM_CERTSTORE: `${ACCESS_PROVIDERS.WINRM}-certstore`,
+  WINRM_IIS: `${ACCESS_PROVIDERS.WINRM}-iis`,
+  ZOOKEEPER: `${ACCESS_PROVIDERS.ZOOKEEPER}`,
 } as const);
 
 export type DeployProviderType = (typeof DEPLOY_PROVIDERS)[keyof typeof DEPLOY_PROVIDERS];
@@ -304,8 +471,39 @@ export const deployProvidersMap: Map<DeployProvider["type"] | string, DeployProv
   [
     [DEPLOY_PROVIDERS.LOCAL, "provider.local", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.SSH, "provider.ssh", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.SSH_MINIO, "provider.ssh.minio", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.SSH_HARBOR, "provider.ssh.harbor", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.SSH_MAILSERVER, "provider.ssh.mailserver", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.FTP, "provider.ftp", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.WEBHOOK, "provider.webhook", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.GRPC_AGENT, "provider.grpc.agent", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.GITLAB, "provider.gitlab", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.JENKINS_CREDENTIAL, "provider.jenkins.credential", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.KUBERNETES_SECRET, "provider.kubernetes.secret", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.KUBERNETES_INGRESS, "provider.kubernetes.ingress", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.KUBERNETES_OPENSHIFT_ROUTE, "provider.kubernetes.openshift_route", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.KUBERNETES_HARBOR, "provider.kubernetes.harbor", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.DOCKER_SWARM, "provider.docker.swarm", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.RANCHER_SECRET, "provider.rancher.secret", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.RANCHER_HARVESTER, "provider.rancher.harvester", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.ETCD, "provider.etcd", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.ZOOKEEPER, "provider.zookeeper", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.WINRM_IIS, "provider.winrm.iis", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.WINRM_CERTSTORE, "provider.winrm.certstore", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.VAULT, "provider.vault", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.CONSUL_KV, "provider.consul.kv", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.NOMAD_VARIABLE, "provider.nomad.variable", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.SOFTETHER, "provider.softether", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.SOPHOS_FIREWALL, "provider.sophos.firewall", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.KEMP_LOADMASTER, "provider.kemp.loadmaster", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.MIKROTIK, "provider.mikrotik", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.CISCO_IOSXE, "provider.cisco.iosxe", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.F5_BIGIP, "provider.f5.bigip", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.FORTINET_FORTIGATE, "provider.fortinet.fortigate", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.HAPROXY, "provider.haproxy", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.OPNSENSE, "provider.opnsense", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.PFSENSE, "provider.pfsense", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.TRUENAS, "provider.truenas", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.ALIYUN_OSS, "provider.aliyun.oss", DEPLOY_CATEGORIES.STORAGE],
     [DEPLOY_PROVIDERS.ALIYUN_CDN, "provider.aliyun.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.ALIYUN_DCDN, "provider.aliyun.dcdn", DEPLOY_CATEGORIES.CDN],
@@ -314,6 +512,7 @@ export const deployProvidersMap: Map<DeployProvider["type"] | string, DeployProv
     [DEPLOY_PROVIDERS.ALIYUN_ALB, "provider.aliyun.alb", DEPLOY_CATEGORIES.LOADBALANCE],
     [DEPLOY_PROVIDERS.ALIYUN_NLB, "provider.aliyun.nlb", DEPLOY_CATEGORIES.LOADBALANCE],
     [DEPLOY_PROVIDERS.ALIYUN_WAF, "provider.aliyun.waf", DEPLOY_CATEGORIES.FIREWALL],
+    [DEPLOY_PROVIDERS.ALIYUN_DDOS, "provider.aliyun.ddos", DEPLOY_CATEGORIES.FIREWALL],
     [DEPLOY_PROVIDERS.ALIYUN_LIVE, "provider.aliyun.live", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.ALIYUN_VOD, "provider.aliyun.vod", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.ALIYUN_FC, "provider.aliyun.fc", DEPLOY_CATEGORIES.SERVERLESS],
@@ -327,11 +526,13 @@ export const deployProvidersMap: Map<DeployProvider["type"] | string, DeployProv
     [DEPLOY_PROVIDERS.TENCENTCLOUD_CSS, "provider.tencentcloud.css", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.TENCENTCLOUD_VOD, "provider.tencentcloud.vod", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.TENCENTCLOUD_SCF, "provider.tencentcloud.scf", DEPLOY_CATEGORIES.SERVERLESS],
+    [DEPLOY_PROVIDERS.TENCENTCLOUD_APIGATEWAY, "provider.tencentcloud.apigateway", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.TENCENTCLOUD_SSL_DEPLOY, "provider.tencentcloud.ssl_deploy", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.HUAWEICLOUD_CDN, "provider.huaweicloud.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.HUAWEICLOUD_ELB, "provider.huaweicloud.elb", DEPLOY_CATEGORIES.LOADBALANCE],
     [DEPLOY_PROVIDERS.HUAWEICLOUD_WAF, "provider.huaweicloud.waf", DEPLOY_CATEGORIES.FIREWALL],
     [DEPLOY_PROVIDERS.BAIDUCLOUD_CDN, "provider.baiducloud.cdn", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.BAIDUCLOUD_BLB, "provider.baiducloud.blb", DEPLOY_CATEGORIES.LOADBALANCE],
     [DEPLOY_PROVIDERS.VOLCENGINE_TOS, "provider.volcengine.tos", DEPLOY_CATEGORIES.STORAGE],
     [DEPLOY_PROVIDERS.VOLCENGINE_CDN, "provider.volcengine.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.VOLCENGINE_DCDN, "provider.volcengine.dcdn", DEPLOY_CATEGORIES.CDN],
@@ -342,6 +543,8 @@ export const deployProvidersMap: Map<DeployProvider["type"] | string, DeployProv
     [DEPLOY_PROVIDERS.JDCLOUD_CDN, "provider.jdcloud.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.JDCLOUD_LIVE, "provider.jdcloud.live", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.JDCLOUD_VOD, "provider.jdcloud.vod", DEPLOY_CATEGORIES.AV],
+    [DEPLOY_PROVIDERS.KSYUN_CDN, "provider.ksyun.cdn", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.QINIU_KODO, "provider.qiniu.kodo", DEPLOY_CATEGORIES.STORAGE],
     [DEPLOY_PROVIDERS.QINIU_CDN, "provider.qiniu.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.QINIU_PILI, "provider.qiniu.pili", DEPLOY_CATEGORIES.AV],
     [DEPLOY_PROVIDERS.BAISHAN_CDN, "provider.baishan.cdn", DEPLOY_CATEGORIES.CDN],
@@ -349,15 +552,35 @@ export const deployProvidersMap: Map<DeployProvider["type"] | string, DeployProv
     [DEPLOY_PROVIDERS.BYTEPLUS_CDN, "provider.byteplus.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.UCLOUD_US3, "provider.ucloud.us3", DEPLOY_CATEGORIES.STORAGE],
     [DEPLOY_PROVIDERS.UCLOUD_UCDN, "provider.ucloud.ucdn", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.UPYUN_CDN, "provider.upyun.cdn", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.AWS_CLOUDFRONT, "provider.aws.cloudfront", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.AWS_ELB, "provider.aws.elb", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.GCP_CERTIFICATEMANAGER, "provider.gcp.certificatemanager", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.GCP_LOADBALANCER, "provider.gcp.loadbalancer", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.AKAMAI_CPS, "provider.akamai.cps", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.CACHEFLY, "provider.cachefly", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.CDNFLY, "provider.cdnfly", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.EDGIO_APPLICATIONS, "provider.edgio.applications", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.HEROKU, "provider.heroku", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.NETLIFY_SITE, "provider.netlify.site", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.VERCEL_PROJECT, "provider.vercel.project", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.FASTLY, "provider.fastly", DEPLOY_CATEGORIES.CDN],
     [DEPLOY_PROVIDERS.GCORE_CDN, "provider.gcore.cdn", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.KEYCDN, "provider.keycdn", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.CLOUDFLARE_SSL, "provider.cloudflare.ssl", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.CLOUDFLARE_SAAS, "provider.cloudflare.saas", DEPLOY_CATEGORIES.CDN],
+    [DEPLOY_PROVIDERS.OPENSTACK_OCTAVIA, "provider.openstack.octavia", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.ORACLECLOUD_LB, "provider.oraclecloud.lb", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.ORACLECLOUD_CERTIFICATES, "provider.oraclecloud.certificates", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.OVHCLOUD_IPLB, "provider.ovhcloud.iplb", DEPLOY_CATEGORIES.LOADBALANCE],
+    [DEPLOY_PROVIDERS.OVHCLOUD_WEBHOSTING, "provider.ovhcloud.webhosting", DEPLOY_CATEGORIES.WEBSITE],
     [DEPLOY_PROVIDERS["1PANEL_SITE"], "provider.1panel.site", DEPLOY_CATEGORIES.WEBSITE],
     [DEPLOY_PROVIDERS["1PANEL_CONSOLE"], "provider.1panel.console", DEPLOY_CATEGORIES.OTHER],
     [DEPLOY_PROVIDERS.BAOTAPANEL_SITE, "provider.baotapanel.site", DEPLOY_CATEGORIES.WEBSITE],
     [DEPLOY_PROVIDERS.BAOTAPANEL_CONSOLE, "provider.baotapanel.console", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.CPANEL_SSL, "provider.cpanel.ssl", DEPLOY_CATEGORIES.WEBSITE],
+    [DEPLOY_PROVIDERS.WHM_SERVICE, "provider.whm.service", DEPLOY_CATEGORIES.OTHER],
+    [DEPLOY_PROVIDERS.PLESK, "provider.plesk", DEPLOY_CATEGORIES.WEBSITE],
     [DEPLOY_PROVIDERS.SAFELINE, "provider.safeline", DEPLOY_CATEGORIES.FIREWALL],
   ].map(([type, name, category]) => [
     type,
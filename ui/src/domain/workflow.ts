@@ -95,7 +95,29 @@ const workflowNodeTypeDefaultOutputs: Map<WorkflowNodeType, WorkflowNodeIO[]> =
       },
     ],
   ],
-  [WorkflowNodeType.Deploy, []],
+  [
+    WorkflowNodeType.Deploy,
+    [
+      {
+        name: "deployResult",
+        type: "deployResult",
+        required: false,
+        label: "部署结果",
+      },
+      {
+        name: "deployedCertificate",
+        type: "string",
+        required: false,
+        label: "已部署的证书",
+      },
+      {
+        name: "previousCertificate",
+        type: "string",
+        required: false,
+        label: "部署前所用的证书",
+      },
+    ],
+  ],
   [WorkflowNodeType.Notify, []],
 ]);
 
@@ -133,6 +155,9 @@ export type WorkflowNodeConfigForApply = {
   disableFollowCNAME?: boolean;
   disableARI?: boolean;
   skipBeforeExpiryDays: number;
+  csrProvider?: string;
+  csrProviderAccessId?: string;
+  csrProviderConfig?: Record<string, unknown>;
 };
 
 export type WorkflowNodeConfigForUpload = {
@@ -148,6 +173,19 @@ export type WorkflowNodeConfigForDeploy = {
   providerAccessId: string;
   providerConfig: Record<string, unknown>;
   skipOnLastSucceeded: boolean;
+  dryRun?: boolean;
+  cleanupCertificates?: boolean;
+  skipOnUnchanged?: boolean;
+  unchangedCheckAddr?: string;
+  retryCount?: number;
+  retryInterval?: number;
+  batchTargets?: WorkflowNodeConfigForDeployTarget[];
+  batchConcurrency?: number;
+};
+
+export type WorkflowNodeConfigForDeployTarget = {
+  name?: string;
+  providerConfig?: string;
 };
 
 export type WorkflowNodeConfigForNotify = {
@@ -8,6 +8,8 @@ export interface WorkflowRunModel extends BaseModel {
   endedAt: ISO8601String;
   logs?: WorkflowRunLog[];
   error?: string;
+  staging?: boolean;
+  idempotencyKey?: string;
   expand?: {
     workflowId?: WorkflowModel;
   };
@@ -1,7 +1,13 @@
 import { useState } from "react";
 import { useTranslation } from "react-i18next";
 import { useNavigate, useSearchParams } from "react-router-dom";
-import { DeleteOutlined as DeleteOutlinedIcon, ReloadOutlined as ReloadOutlinedIcon, SelectOutlined as SelectOutlinedIcon } from "@ant-design/icons";
+import {
+  DeleteOutlined as DeleteOutlinedIcon,
+  InboxOutlined as InboxOutlinedIcon,
+  ReloadOutlined as ReloadOutlinedIcon,
+  SelectOutlined as SelectOutlinedIcon,
+  UndoOutlined as UndoOutlinedIcon,
+} from "@ant-design/icons";
 import { PageHeader } from "@ant-design/pro-components";
 import { useRequest } from "ahooks";
 import {
@@ -26,9 +32,16 @@ import {
 import dayjs from "dayjs";
 import { ClientResponseError } from "pocketbase";
 
+import {
+  type ListRequest as ListCertificateRequest,
+  batchDelete as batchDeleteCertificates,
+  batchUpdate as batchUpdateCertificates,
+  list as listCertificate,
+} from "@/api/certificates";
+import CursorPagination from "@/components/CursorPagination";
 import CertificateDetailDrawer from "@/components/certificate/CertificateDetailDrawer";
 import { CERTIFICATE_SOURCES, type CertificateModel } from "@/domain/certificate";
-import { type ListCertificateRequest, list as listCertificate, remove as removeCertificate } from "@/repository/certificate";
+import { listByIds as listWorkflowByIds } from "@/repository/workflow";
 import { getErrMsg } from "@/utils/error";
 
 const CertificateList = () => {
@@ -48,7 +61,7 @@ const CertificateList = () => {
       align: "center",
       fixed: "left",
       width: 50,
-      render: (_, __, index) => (page - 1) * pageSize + index + 1,
+      render: (_, __, index) => (cursors.length - 1) * pageSize + index + 1,
     },
     {
       key: "name",
@@ -64,13 +77,14 @@ const CertificateList = () => {
         const items: Required<MenuProps>["items"] = [
           ["expireSoon", "certificate.props.validity.filter.expire_soon"],
           ["expired", "certificate.props.validity.filter.expired"],
+          ["archived", "certificate.props.validity.filter.archived"],
         ].map(([key, label]) => {
           return {
             key,
             label: <Radio checked={filters["state"] === key}>{t(label)}</Radio>,
             onClick: () => {
               if (filters["state"] !== key) {
-                setPage(1);
+                resetCursors();
                 setFilters((prev) => ({ ...prev, state: key }));
                 setSelectedKeys([key]);
               }
@@ -81,7 +95,7 @@ const CertificateList = () => {
         });
 
         const handleResetClick = () => {
-          setPage(1);
+          resetCursors();
           setFilters((prev) => ({ ...prev, state: undefined }));
           setSelectedKeys([]);
           clearFilters?.();
@@ -154,7 +168,7 @@ const CertificateList = () => {
                   }
                 }}
               >
-                {record.expand?.workflowId?.name ?? <span className="font-mono">{t(`#${workflowId}`)}</span>}
+                {workflowNames[workflowId] ?? <span className="font-mono">{t(`#${workflowId}`)}</span>}
               </Typography.Link>
             </Space>
           );
@@ -205,7 +219,9 @@ const CertificateList = () => {
     },
   ];
   const [tableData, setTableData] = useState<CertificateModel[]>([]);
-  const [tableTotal, setTableTotal] = useState<number>(0);
+  const [tableSelectedRowKeys, setTableSelectedRowKeys] = useState<string[]>([]);
+
+  const [workflowNames, setWorkflowNames] = useState<Record<string, string>>({});
 
   const [filters, setFilters] = useState<Record<string, unknown>>(() => {
     return {
@@ -214,9 +230,16 @@ const CertificateList = () => {
     };
   });
 
-  const [page, setPage] = useState<number>(() => parseInt(+searchParams.get("page")! + "") || 1);
+  // 列表接口基于游标分页，此处记录已访问过的各页游标以便向前翻页
+  const [cursors, setCursors] = useState<string[]>([""]);
+  const [nextCursor, setNextCursor] = useState<string>();
   const [pageSize, setPageSize] = useState<number>(() => parseInt(+searchParams.get("perPage")! + "") || 10);
 
+  const resetCursors = () => {
+    setCursors([""]);
+    setTableSelectedRowKeys([]);
+  };
+
   const {
     loading,
     error: loadedError,
@@ -225,16 +248,27 @@ const CertificateList = () => {
     () => {
       return listCertificate({
         keyword: filters["keyword"] as string,
-        state: filters["state"] as ListCertificateRequest["state"],
-        page: page,
-        perPage: pageSize,
+        state: (filters["state"] as ListCertificateRequest["state"]) || undefined,
+        cursor: cursors[cursors.length - 1],
+        limit: pageSize,
       });
     },
     {
-      refreshDeps: [filters, page, pageSize],
+      refreshDeps: [filters, cursors, pageSize],
       onSuccess: (res) => {
-        setTableData(res.items);
-        setTableTotal(res.totalItems);
+        setTableData(res.data.items);
+        setNextCursor(res.data.nextCursor);
+
+        const workflowIds = [...new Set(res.data.items.map((item) => item.workflowId).filter((id) => !!id && !(id in workflowNames)))];
+        if (workflowIds.length > 0) {
+          listWorkflowByIds(workflowIds)
+            .then((workflows) => {
+              setWorkflowNames((prev) => ({ ...prev, ...Object.fromEntries(workflows.map((item) => [item.id, item.name])) }));
+            })
+            .catch((err) => {
+              console.error(err);
+            });
+        }
       },
       onError: (err) => {
         if (err instanceof ClientResponseError && err.isAbort) {
@@ -250,6 +284,7 @@ const CertificateList = () => {
   );
 
   const handleSearch = (value: string) => {
+    resetCursors();
     setFilters((prev) => ({ ...prev, keyword: value.trim() }));
   };
 
@@ -265,9 +300,10 @@ const CertificateList = () => {
       content: t("certificate.action.delete.confirm"),
       onOk: async () => {
         try {
-          const resp = await removeCertificate(certificate);
+          const resp = await batchDeleteCertificates([certificate.id]);
           if (resp) {
             setTableData((prev) => prev.filter((item) => item.id !== certificate.id));
+            setTableSelectedRowKeys((prev) => prev.filter((key) => key !== certificate.id));
             refreshData();
           }
         } catch (err) {
@@ -278,6 +314,46 @@ const CertificateList = () => {
     });
   };
 
+  const handleBatchArchiveClick = (archived: boolean) => {
+    const ids = [...tableSelectedRowKeys];
+    modalApi.confirm({
+      title: archived ? t("certificate.action.batch_archive") : t("certificate.action.batch_unarchive"),
+      content: archived
+        ? t("certificate.action.batch_archive.confirm", { count: ids.length })
+        : t("certificate.action.batch_unarchive.confirm", { count: ids.length }),
+      onOk: async () => {
+        try {
+          const resp = await batchUpdateCertificates(ids, { archived });
+          if (resp) {
+            resetCursors();
+          }
+        } catch (err) {
+          console.error(err);
+          notificationApi.error({ message: t("common.text.request_error"), description: getErrMsg(err) });
+        }
+      },
+    });
+  };
+
+  const handleBatchDeleteClick = () => {
+    const ids = [...tableSelectedRowKeys];
+    modalApi.confirm({
+      title: t("certificate.action.batch_delete"),
+      content: t("certificate.action.batch_delete.confirm", { count: ids.length }),
+      onOk: async () => {
+        try {
+          const resp = await batchDeleteCertificates(ids);
+          if (resp) {
+            resetCursors();
+          }
+        } catch (err) {
+          console.error(err);
+          notificationApi.error({ message: t("common.text.request_error"), description: getErrMsg(err) });
+        }
+      },
+    });
+  };
+
   return (
     <div className="p-4">
       {ModalContextHolder}
@@ -297,6 +373,28 @@ const CertificateList = () => {
           </Flex>
         </div>
 
+        {tableSelectedRowKeys.length > 0 ? (
+          <div className="mb-4">
+            <Flex align="center" gap="small">
+              <Typography.Text type="secondary">{t("certificate.selection.count", { count: tableSelectedRowKeys.length })}</Typography.Text>
+              {filters["state"] === "archived" ? (
+                <Button icon={<UndoOutlinedIcon />} size="small" onClick={() => handleBatchArchiveClick(false)}>
+                  {t("certificate.action.batch_unarchive")}
+                </Button>
+              ) : (
+                <Button icon={<InboxOutlinedIcon />} size="small" onClick={() => handleBatchArchiveClick(true)}>
+                  {t("certificate.action.batch_archive")}
+                </Button>
+              )}
+              <Button danger icon={<DeleteOutlinedIcon />} size="small" onClick={handleBatchDeleteClick}>
+                {t("certificate.action.batch_delete")}
+              </Button>
+            </Flex>
+          </div>
+        ) : (
+          <></>
+        )}
+
         <Table<CertificateModel>
           columns={tableColumns}
           dataSource={tableData}
@@ -304,23 +402,34 @@ const CertificateList = () => {
           locale={{
             emptyText: <Empty image={Empty.PRESENTED_IMAGE_SIMPLE} description={getErrMsg(loadedError ?? t("certificate.nodata"))} />,
           }}
-          pagination={{
-            current: page,
-            pageSize: pageSize,
-            total: tableTotal,
-            showSizeChanger: true,
-            onChange: (page: number, pageSize: number) => {
-              setPage(page);
-              setPageSize(pageSize);
-            },
-            onShowSizeChange: (page: number, pageSize: number) => {
-              setPage(page);
-              setPageSize(pageSize);
-            },
-          }}
+          pagination={false}
           rowKey={(record) => record.id}
+          rowSelection={{
+            selectedRowKeys: tableSelectedRowKeys,
+            onChange: (keys) => setTableSelectedRowKeys(keys as string[]),
+          }}
           scroll={{ x: "max(100%, 960px)" }}
         />
+
+        <CursorPagination
+          className="mt-4"
+          disabled={loading}
+          hasNext={!!nextCursor}
+          hasPrev={cursors.length > 1}
+          pageSize={pageSize}
+          onNext={() => {
+            setCursors((prev) => [...prev, nextCursor!]);
+            setTableSelectedRowKeys([]);
+          }}
+          onPrev={() => {
+            setCursors((prev) => prev.slice(0, -1));
+            setTableSelectedRowKeys([]);
+          }}
+          onPageSizeChange={(size) => {
+            resetCursors();
+            setPageSize(size);
+          }}
+        />
       </Card>
     </div>
   );
@@ -7,6 +7,7 @@ import {
   DeleteOutlined as DeleteOutlinedIcon,
   DownOutlined as DownOutlinedIcon,
   EllipsisOutlined as EllipsisOutlinedIcon,
+  ExperimentOutlined as ExperimentOutlinedIcon,
   HistoryOutlined as HistoryOutlinedIcon,
   UndoOutlined as UndoOutlinedIcon,
 } from "@ant-design/icons";
@@ -156,7 +157,7 @@ const WorkflowDetail = () => {
     });
   };
 
-  const handleRunClick = () => {
+  const handleRunClick = (staging = false) => {
     const { promise, resolve, reject } = Promise.withResolvers();
     if (workflow.hasDraft) {
       modalApi.confirm({
@@ -183,9 +184,11 @@ const WorkflowDetail = () => {
           }
         });
 
-        await startWorkflowRun(workflowId!);
+        await startWorkflowRun(workflowId!, { staging });
 
-        messageApi.info(t("workflow.detail.orchestration.action.run.prompt"));
+        messageApi.info(
+          staging ? t("workflow.detail.orchestration.action.run_staging.prompt") : t("workflow.detail.orchestration.action.run.prompt")
+        );
       } catch (err) {
         setIsPendingOrRunning(false);
         unsubscribeFn?.();
@@ -278,9 +281,34 @@ const WorkflowDetail = () => {
               </div>
               <div className="flex justify-end">
                 <Space>
-                  <Button disabled={!allowRun} icon={<CaretRightOutlinedIcon />} loading={isPendingOrRunning} type="primary" onClick={handleRunClick}>
-                    {t("workflow.detail.orchestration.action.run")}
-                  </Button>
+                  <Space.Compact>
+                    <Button
+                      disabled={!allowRun}
+                      icon={<CaretRightOutlinedIcon />}
+                      loading={isPendingOrRunning}
+                      type="primary"
+                      onClick={() => handleRunClick()}
+                    >
+                      {t("workflow.detail.orchestration.action.run")}
+                    </Button>
+
+                    <Dropdown
+                      disabled={!allowRun || isPendingOrRunning}
+                      menu={{
+                        items: [
+                          {
+                            key: "run_staging",
+                            label: t("workflow.detail.orchestration.action.run_staging"),
+                            icon: <ExperimentOutlinedIcon />,
+                            onClick: () => handleRunClick(true),
+                          },
+                        ],
+                      }}
+                      trigger={["click"]}
+                    >
+                      <Button disabled={!allowRun || isPendingOrRunning} icon={<DownOutlinedIcon />} type="primary" />
+                    </Dropdown>
+                  </Space.Compact>
 
                   <Space.Compact>
                     <Button color="primary" disabled={!allowRelease} variant="outlined" onClick={handleReleaseClick}>
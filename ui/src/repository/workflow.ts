@@ -30,6 +30,19 @@ export const list = async (request: ListWorkflowRequest) => {
   });
 };
 
+export const listByIds = async (ids: string[]) => {
+  const pb = getPocketBase();
+
+  if (ids.length === 0) {
+    return [];
+  }
+
+  return await pb.collection(COLLECTION_NAME_WORKFLOW).getFullList<WorkflowModel>({
+    filter: ids.map((id) => pb.filter("id={:id}", { id })).join(" || "),
+    requestKey: null,
+  });
+};
+
 export const get = async (id: string) => {
   return await getPocketBase().collection(COLLECTION_NAME_WORKFLOW).getOne<WorkflowModel>(id, {
     requestKey: null,
@@ -29,10 +29,6 @@ export const list = async (request: ListWorkflowRunsRequest) => {
   });
 };
 
-export const remove = async (record: MaybeModelRecordWithId<WorkflowRunModel>) => {
-  return await getPocketBase().collection(COLLECTION_NAME_WORKFLOW_RUN).delete(record.id);
-};
-
 export const subscribe = async (id: string, cb: (e: RecordSubscription<WorkflowRunModel>) => void) => {
   return getPocketBase().collection(COLLECTION_NAME_WORKFLOW_RUN).subscribe(id, cb);
 };